@@ -0,0 +1,356 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/basicauth"
+	"github.com/hesusruiz/vcbackend/ent"
+	"github.com/hesusruiz/vcbackend/ent/credential"
+	"github.com/hesusruiz/vcbackend/internal/openapi"
+	"github.com/hesusruiz/vcbackend/internal/problem"
+	"github.com/hesusruiz/vcbackend/vault"
+	"github.com/valyala/fasthttp/fasthttpadaptor"
+	"go.uber.org/zap"
+)
+
+// apiPrefix is the prefix of the versioned JSON API, described by its OpenAPI document
+const apiPrefix = "/api/v1"
+
+// addAPIRoutes registers the JSON API of the issuer, the verifier, the wallet and the core operations.
+// The requests and, if configured, the responses are validated against the OpenAPI document.
+func (s *Server) addAPIRoutes() {
+
+	validator, err := openapi.New()
+	if err != nil {
+		panic(err)
+	}
+	s.apiSpec = validator
+
+	api := s.Group(apiPrefix)
+
+	// The OpenAPI document, in YAML and in JSON
+	api.Get("/openapi.yaml", s.APIGetSpecYAML)
+	api.Get("/openapi.json", s.APIGetSpecJSON)
+
+	api.Use(s.validateAPI)
+
+	// The API authenticates with the status and the problem of the API, instead of plain text
+	operator := s.authOperators(apiAuthConfig(s.operatorAuthConfig()))
+	admin := s.apiAuth(s.verifierVault, s.adminAuthConfig("Verifier"))
+
+	// Issuer
+	issuer := api.Group("/issuer")
+	issuer.Get("/credentials", operator, s.IssuerAPISearchCredentials)
+	issuer.Get("/credentials/:id", s.rateLimit("credential", s.conf.Server.RateLimit.Credential), s.APIGetCredential)
+	issuer.Post("/credentials/:id/renew", operator, s.IssuerAPIRenewCredential)
+	issuer.Post("/credentials/:id/revoke", operator, s.IssuerAPIRevokeCredential)
+
+	issuer.Get("/credentialtypes", s.IssuerAPIListCredentialTypes)
+	issuer.Get("/credentialtypes/:name", s.IssuerAPIGetCredentialType)
+	issuer.Post("/credentialtypes/:name/credentials", operator, s.IssuerAPIIssueCredential)
+
+	issuer.Get("/templates", s.IssuerAPIListTemplates)
+	issuer.Post("/templates", operator, s.IssuerAPICreateTemplate)
+	issuer.Get("/templates/:id", s.IssuerAPIGetTemplate)
+	issuer.Put("/templates/:id", operator, s.IssuerAPIUpdateTemplate)
+	issuer.Delete("/templates/:id", operator, s.IssuerAPIDeleteTemplate)
+	issuer.Post("/templates/:id/publish", operator, s.IssuerAPIPublishTemplate)
+	issuer.Post("/templates/:id/archive", operator, s.IssuerAPIArchiveTemplate)
+	issuer.Post("/templates/:id/preview", operator, s.IssuerAPIPreviewTemplate)
+
+	issuer.Get("/issuancerequests", operator, s.IssuerAPIListIssuanceRequests)
+	issuer.Get("/issuancerequests/:id", operator, s.IssuerAPIGetIssuanceRequest)
+	issuer.Post("/issuancerequests/:id/approve", operator, s.IssuerAPIApproveIssuanceRequest)
+	issuer.Post("/issuancerequests/:id/reject", operator, s.IssuerAPIRejectIssuanceRequest)
+
+	holderLimit := s.rateLimit("credential", s.conf.Server.RateLimit.Credential)
+	issuer.Get("/holderchallenge", holderLimit, s.IssuerAPIHolderChallenge)
+	issuer.Post("/holderdids", s.apiAuth(s.issuerVault, s.holderAuthConfig()), holderLimit, bodyLimit(s.conf.Server.CredentialBodyLimit), s.IssuerAPIRegisterHolderDID)
+
+	// Verifier
+	siopLimit := s.rateLimit("siop", s.conf.Server.RateLimit.SIOP)
+	verifier := api.Group("/verifier")
+	verifier.Post("/sessions", siopLimit, s.APICreateSession)
+	verifier.Get("/sessions/:state", siopLimit, s.APIGetSession)
+
+	verifier.Get("/policies", admin, s.VerifierAPIListPolicies)
+	verifier.Post("/policies/reload", admin, s.VerifierAPIReloadPolicies)
+	verifier.Post("/policies/dryrun", admin, s.VerifierAPIDryRunPolicy)
+	verifier.Get("/policies/:service", admin, s.VerifierAPIGetPolicy)
+
+	verifier.Get("/clients", admin, s.VerifierAPIListClients)
+	verifier.Post("/clients", admin, s.VerifierAPICreateClient)
+	verifier.Get("/clients/:id", admin, s.VerifierAPIGetClient)
+	verifier.Put("/clients/:id", admin, s.VerifierAPIUpdateClient)
+	verifier.Delete("/clients/:id", admin, s.VerifierAPIDeleteClient)
+
+	// Wallet
+	holder := s.apiAuth(s.walletvault, s.walletAuthConfig())
+	wallet := api.Group("/wallet")
+	wallet.Get("/credentials", holder, s.APIListWalletCredentials)
+	wallet.Post("/presentations", holder, bodyLimit(s.conf.Server.CredentialBodyLimit), s.APIPresentCredential)
+
+	// Core
+	core := api.Group("/core")
+	core.Post("/dids", s.rateLimit("did", s.conf.Server.RateLimit.DID), s.APICreateDID)
+	core.Get("/templates", s.CoreAPIListCredentialTemplates)
+	core.Get("/templates/:id", s.CoreAPIGetCredentialTemplate)
+
+}
+
+// apiAuth authenticates with basic authentication, failing with a problem of the API
+func (s *Server) apiAuth(v *vault.Vault, cfg basicauth.Config) fiber.Handler {
+	return s.basicAuth(v, apiAuthConfig(cfg))
+}
+
+// adminAuthConfig authenticates the administrator with the password of the verifier in the configuration
+func (s *Server) adminAuthConfig(realm string) basicauth.Config {
+	return basicauth.Config{
+		Realm: realm,
+		Users: map[string]string{"admin": s.conf.Verifier.Password},
+	}
+}
+
+// apiAuthConfig changes the configuration of the authentication to fail with a problem of the API
+func apiAuthConfig(cfg basicauth.Config) basicauth.Config {
+	cfg.Unauthorized = func(c *fiber.Ctx) error {
+		return fiber.ErrUnauthorized
+	}
+	return cfg
+}
+
+// errorHandler sends the errors of the handlers as problem details, except to the browsers
+// navigating the pages, which receive the default error page of Fiber
+func (s *Server) errorHandler(c *fiber.Ctx, err error) error {
+
+	if ent.IsNotFound(err) {
+		err = fiber.NewError(fiber.StatusNotFound, err.Error())
+	}
+
+	api := strings.HasPrefix(c.Path(), apiPrefix)
+	if !api && c.Accepts(fiber.MIMEApplicationJSON, problem.ContentType, fiber.MIMETextHTML) == fiber.MIMETextHTML {
+		return fiber.DefaultErrorHandler(c, err)
+	}
+
+	p := problem.From(err)
+	p.Instance = c.Path()
+	if p.Status >= fiber.StatusInternalServerError && p.Code == problem.InternalError {
+		s.log(c).Errorw("internal error", zap.Error(err))
+	}
+	return problem.Send(c, p)
+}
+
+// validateAPI validates the request against the OpenAPI document and, if configured, the response
+func (s *Server) validateAPI(c *fiber.Ctx) error {
+
+	req := &http.Request{}
+	if err := fasthttpadaptor.ConvertRequest(c.Context(), req, true); err != nil {
+		return err
+	}
+
+	op, err := s.apiSpec.ValidateRequest(c.UserContext(), req)
+	if errors.Is(err, openapi.ErrUnknownOperation) {
+		return c.Next()
+	}
+	if err != nil {
+		return problem.New(fiber.StatusBadRequest, problem.InvalidRequest, err.Error())
+	}
+
+	if err := c.Next(); err != nil || !s.conf.Server.API.ValidateResponses {
+		return err
+	}
+
+	// The errors of the handlers are sent later as problems, which are always valid
+	header := http.Header{}
+	c.Response().Header.VisitAll(func(key, value []byte) {
+		header.Add(string(key), string(value))
+	})
+	if err := op.ValidateResponse(c.UserContext(), c.Response().StatusCode(), header, c.Response().Body()); err != nil {
+		s.log(c).Errorw("response does not match the OpenAPI document", "status", c.Response().StatusCode(), zap.Error(err))
+		return problem.New(fiber.StatusInternalServerError, problem.InvalidResponse, "the response does not match the OpenAPI document")
+	}
+
+	return nil
+}
+
+// APIGetSpecYAML returns the OpenAPI document of the API
+func (s *Server) APIGetSpecYAML(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderContentType, "application/yaml")
+	return c.Send(openapi.Spec)
+}
+
+// APIGetSpecJSON returns the OpenAPI document of the API in JSON
+func (s *Server) APIGetSpecJSON(c *fiber.Ctx) error {
+	return c.JSON(s.apiSpec.Document())
+}
+
+// APIGetCredential returns an issued credential with its status
+func (s *Server) APIGetCredential(c *fiber.Ctx) error {
+
+	cred, err := s.issuedCredential(c)
+	if err != nil {
+		return err
+	}
+
+	resp := fiber.Map{
+		"id":         cred.ID,
+		"format":     cred.Type,
+		"status":     cred.Status,
+		"credential": string(cred.Raw),
+	}
+	if cred.ExpiresAt != nil {
+		resp["expiresAt"] = cred.ExpiresAt
+	}
+	return c.JSON(resp)
+}
+
+// issuedCredential returns the credential of the issuer in the id parameter of the request.
+// The revoked credentials are not returned.
+func (s *Server) issuedCredential(c *fiber.Ctx) (*ent.Credential, error) {
+
+	cred, err := s.issuerVault.Client.Credential.Get(c.UserContext(), c.Params("id"))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, problem.New(fiber.StatusNotFound, problem.CredentialNotFound, "credential not found")
+		}
+		return nil, err
+	}
+	if cred.Status == credential.StatusRevoked {
+		return nil, problem.New(fiber.StatusGone, problem.CredentialRevoked, vault.ErrCredentialRevoked.Error())
+	}
+	return cred, nil
+}
+
+// APICreateSession starts a SIOP login, for the relying party in the client_id parameter if there is
+// one, and returns the authentication request for the wallet
+func (s *Server) APICreateSession(c *fiber.Ctx) error {
+
+	state := generateNonce()
+	const expiration = 200 * time.Second
+	if err := s.startSession(c.UserContext(), state, expiration); err != nil {
+		return err
+	}
+
+	client, err := s.startClientLogin(c, state)
+	if err != nil {
+		return err
+	}
+
+	request, err := s.siopRequest(c, "openid://", state, client)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"state":     state,
+		"request":   request,
+		"expiresAt": time.Now().Add(expiration),
+	})
+}
+
+// APIGetSession returns the status of a SIOP login, with the credential presented when it is completed
+func (s *Server) APIGetSession(c *fiber.Ctx) error {
+
+	state := c.Params("state")
+
+	status, credential, err := s.sessionStatus(c, state)
+	if err != nil {
+		return err
+	}
+	resp := fiber.Map{"state": state, "status": status}
+	if len(credential) > 0 {
+		resp["credential"] = credential
+	}
+	return c.JSON(resp)
+}
+
+// sessionStatus returns the status of a SIOP login: expired, pending or completed, with the credential
+// presented when it is completed
+func (s *Server) sessionStatus(c *fiber.Ctx, state string) (status string, credential string, err error) {
+
+	session, err := s.session(c.UserContext(), state)
+	if err != nil {
+		return "", "", err
+	}
+	switch {
+	case session == nil:
+		return "expired", "", nil
+	case !session.Completed():
+		return "pending", "", nil
+	default:
+		return "completed", session.Credential, nil
+	}
+}
+
+// APIListWalletCredentials returns the credentials of the wallet
+func (s *Server) APIListWalletCredentials(c *fiber.Ctx) error {
+
+	creds, err := s.Operations.GetAllCredentials()
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(creds)
+}
+
+// PresentationRequest is the body of the request to send a credential to a verifier
+type PresentationRequest struct {
+	CredentialID string `json:"credentialId"`
+	RedirectURI  string `json:"redirectUri"`
+	State        string `json:"state"`
+	Nonce        string `json:"nonce"`
+	ClientID     string `json:"clientId"`
+}
+
+// APIPresentCredential sends a credential of the wallet to the verifier of an authentication request
+func (s *Server) APIPresentCredential(c *fiber.Ctx) error {
+
+	req := &PresentationRequest{}
+	if err := json.Unmarshal(c.Body(), req); err != nil {
+		return problem.New(fiber.StatusBadRequest, problem.InvalidRequest, err.Error())
+	}
+
+	code, err := s.sendCredential(c, req.CredentialID, req.RedirectURI, req.State, req.Nonce, req.ClientID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return problem.New(fiber.StatusNotFound, problem.CredentialNotFound, "credential not found")
+		}
+		return err
+	}
+	s.log(c).Infow("credential sent", "state", req.State, "status", code)
+
+	return c.JSON(fiber.Map{
+		"state":  req.State,
+		"status": code,
+	})
+}
+
+// APICreateDID creates a did:key with the SSI Kit
+func (s *Server) APICreateDID(c *fiber.Ctx) error {
+
+	did, err := s.createDID(c)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"did": strings.TrimSpace(string(did)),
+	})
+}
+
+// createDID creates a did:key with the SSI Kit, returning its response
+func (s *Server) createDID(c *fiber.Ctx) ([]byte, error) {
+
+	agent := fiber.Post(s.ssiKit.custodianUrl + "/did/create")
+	agent.JSON(fiber.Map{
+		"method": "key",
+	})
+	agent.Set("accept", "application/json")
+
+	return s.callSSIKit(c, "createDID", agent)
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hesusruiz/vcbackend/internal/vc"
+	"github.com/hesusruiz/vcbackend/vault"
+)
+
+// TestAPIOperatorRoutes checks that the changes of the templates and the search of credentials in the
+// versioned API require an operator, and that the changes are recorded in the audit log
+func TestAPIOperatorRoutes(t *testing.T) {
+	s := newTestServer(t, t.TempDir())
+	s.conf.Verifier.Password = "AdminSecret"
+	s.Use(s.auditMiddleware)
+	s.addAPIRoutes()
+
+	if _, err := s.issuerVault.CreateUser("operator1", "Operator", operatorType, "secret"); err != nil {
+		t.Fatal(err)
+	}
+
+	// The credentials are user:password, or empty to send the request without authentication
+	send := func(method string, path string, body string, credentials string) int {
+		req := httptest.NewRequest(method, apiPrefix+path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if len(credentials) > 0 {
+			req.Header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(credentials)))
+		}
+		resp, err := s.App.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.StatusCode
+	}
+
+	template := `{"name": "employee", "content": "{\"type\": [\"VerifiableCredential\"]}"}`
+	for _, r := range []struct{ method, path, body string }{
+		{"GET", "/issuer/credentials", ""},
+		{"POST", "/issuer/templates", template},
+		{"PUT", "/issuer/templates/1", template},
+		{"DELETE", "/issuer/templates/1", ""},
+		{"POST", "/issuer/templates/1/publish", ""},
+		{"POST", "/issuer/templates/1/archive", ""},
+		{"POST", "/issuer/templates/1/preview", "{}"},
+	} {
+		if status := send(r.method, r.path, r.body, ""); status != http.StatusUnauthorized {
+			t.Errorf("%s %s without authentication: status = %d, want %d", r.method, r.path, status, http.StatusUnauthorized)
+		}
+	}
+
+	if status := send("POST", "/issuer/templates", template, "operator1:secret"); status != http.StatusCreated {
+		t.Fatalf("POST /issuer/templates: status = %d, want %d", status, http.StatusCreated)
+	}
+	entries, err := s.issuerVault.QueryAudit(vault.AuditQuery{Action: "template.create", Actor: "operator1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("audit entries of template.create = %d, want 1", len(entries))
+	}
+
+	// The wallet routes authenticate the holders in the wallet, and the verifier routes the administrator
+	// with the password of the verifier in the configuration
+	if _, err := s.walletvault.CreateUser("holder", "Holder", "naturalperson", "pass"); err != nil {
+		t.Fatal(err)
+	}
+	admin := "admin:" + s.conf.Verifier.Password
+	presentation := `{"credentialId": "unknown", "redirectUri": "https://rp.example.com/callback", "state": "state"}`
+	for _, r := range []struct {
+		method, path, body string
+		allowed            string
+		refused            []string
+	}{
+		{"POST", "/wallet/presentations", presentation, "holder:pass", []string{"", "holder:wrong", "operator1:secret"}},
+		{"GET", "/verifier/clients", "", admin, []string{"", "admin:", "admin:ThePassword", "operator1:secret"}},
+		{"GET", "/verifier/clients/unknown", "", admin, []string{"", "admin:wrong"}},
+		{"GET", "/verifier/policies", "", admin, []string{"", "admin:wrong", "holder:pass"}},
+		{"POST", "/verifier/policies/reload", "", admin, []string{"", "admin:wrong"}},
+	} {
+		for _, credentials := range r.refused {
+			if status := send(r.method, r.path, r.body, credentials); status != http.StatusUnauthorized {
+				t.Errorf("%s %s as %q: status = %d, want %d", r.method, r.path, credentials, status, http.StatusUnauthorized)
+			}
+		}
+		if status := send(r.method, r.path, r.body, r.allowed); status == http.StatusUnauthorized {
+			t.Errorf("%s %s as %q: status = %d", r.method, r.path, r.allowed, status)
+		}
+	}
+}
+
+// TestAPIRevokedCredential checks that the revoked credentials can not be retrieved from the issuer
+func TestAPIRevokedCredential(t *testing.T) {
+	s := newTestServer(t, t.TempDir())
+	s.Get(issuerPrefix+"/credential/:id", s.IssuerAPICredential)
+	s.addAPIRoutes()
+
+	raw := issueTestCredential(t, s)
+	cred, err := vc.Decode([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	get := func(path string) int {
+		resp, err := s.App.Test(httptest.NewRequest("GET", path, nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.StatusCode
+	}
+	paths := []string{apiPrefix + "/issuer/credentials/" + cred.ID(), issuerPrefix + "/credential/" + cred.ID()}
+	for _, path := range paths {
+		if status := get(path); status != http.StatusOK {
+			t.Errorf("GET %s of an active credential: status = %d, want %d", path, status, http.StatusOK)
+		}
+	}
+
+	// The revoked credentials are not returned, by the versioned API nor by the legacy route
+	if _, err := s.issuerVault.RevokeCredential(cred.ID()); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range paths {
+		if status := get(path); status != http.StatusGone {
+			t.Errorf("GET %s of a revoked credential: status = %d, want %d", path, status, http.StatusGone)
+		}
+	}
+}
@@ -0,0 +1,288 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/hesusruiz/vcbackend/internal/audit"
+	"github.com/hesusruiz/vcbackend/vault"
+	"go.uber.org/zap"
+)
+
+// ##########################################
+// ##########################################
+// Audit log of the issuer and the verifier
+
+// auditedRoutes are the actions recorded in the audit log, for each route (method and path)
+var auditedRoutes = map[string]string{
+	"POST " + issuerPrefix + "/newcredential":                     "credential.issue",
+	"POST " + issuerPrefix + "/credentialtypes/:name/credentials": "credential.issue",
+	"GET " + issuerPrefix + "/credential/:id":                     "credential.download",
+	"GET " + issuerPrefix + "/creddetails/:id":                    "credential.view",
+	"GET " + issuerPrefix + "/displayqrurl/:id":                   "credential.offer",
+	"POST " + issuerPrefix + "/renewcredential/:id":               "credential.renew",
+	"POST " + issuerPrefix + "/credential/:id/renew":              "credential.renew",
+	"POST " + issuerPrefix + "/credential/:id/revoke":             "credential.revoke",
+	"POST " + issuerPrefix + "/approvals/:id/approve":             "issuancerequest.approve",
+	"POST " + issuerPrefix + "/approvals/:id/reject":              "issuancerequest.reject",
+	"POST " + issuerPrefix + "/issuancerequests/:id/approve":      "issuancerequest.approve",
+	"POST " + issuerPrefix + "/issuancerequests/:id/reject":       "issuancerequest.reject",
+	"POST " + issuerPrefix + "/templates":                         "template.create",
+	"PUT " + issuerPrefix + "/templates/:id":                      "template.update",
+	"DELETE " + issuerPrefix + "/templates/:id":                   "template.delete",
+	"POST " + issuerPrefix + "/templates/:id/publish":             "template.publish",
+	"POST " + issuerPrefix + "/templates/:id/archive":             "template.archive",
+	"POST " + issuerPrefix + "/holderdid":                         "holder.register",
+	"POST " + verifierPrefix + "/authenticationresponse":          "verification.response",
+	"GET " + verifierPrefix + "/receivecredential/:state":         "verification.login",
+	"GET " + verifierPrefix + "/accessprotectedservice":           "verification.access",
+	"POST " + verifierPrefix + "/policies/reload":                 "policy.reload",
+	"POST " + verifierPrefix + "/clients":                         "client.create",
+	"PUT " + verifierPrefix + "/clients/:id":                      "client.update",
+	"DELETE " + verifierPrefix + "/clients/:id":                   "client.delete",
+	"POST " + registryPrefix + "/issuer":                          "trustedissuer.create",
+	"PUT " + registryPrefix + "/issuer/:did":                      "trustedissuer.update",
+	"DELETE " + registryPrefix + "/issuer/:did":                   "trustedissuer.delete",
+
+	// The same actions in the versioned JSON API
+	"GET " + apiPrefix + "/issuer/credentials/:id":                    "credential.download",
+	"POST " + apiPrefix + "/issuer/credentials/:id/renew":             "credential.renew",
+	"POST " + apiPrefix + "/issuer/credentials/:id/revoke":            "credential.revoke",
+	"POST " + apiPrefix + "/issuer/credentialtypes/:name/credentials": "credential.issue",
+	"POST " + apiPrefix + "/issuer/issuancerequests/:id/approve":      "issuancerequest.approve",
+	"POST " + apiPrefix + "/issuer/issuancerequests/:id/reject":       "issuancerequest.reject",
+	"POST " + apiPrefix + "/issuer/templates":                         "template.create",
+	"PUT " + apiPrefix + "/issuer/templates/:id":                      "template.update",
+	"DELETE " + apiPrefix + "/issuer/templates/:id":                   "template.delete",
+	"POST " + apiPrefix + "/issuer/templates/:id/publish":             "template.publish",
+	"POST " + apiPrefix + "/issuer/templates/:id/archive":             "template.archive",
+	"POST " + apiPrefix + "/issuer/holderdids":                        "holder.register",
+	"POST " + apiPrefix + "/verifier/policies/reload":                 "policy.reload",
+	"POST " + apiPrefix + "/verifier/clients":                         "client.create",
+	"PUT " + apiPrefix + "/verifier/clients/:id":                      "client.update",
+	"DELETE " + apiPrefix + "/verifier/clients/:id":                   "client.delete",
+}
+
+func (s *Server) addAuditRoutes(router fiber.Router, v *vault.Vault) {
+
+	// Only the administrator can read the audit log
+	auth := s.basicAuth(v, s.adminAuthConfig("Audit"))
+
+	router.Get("/audit", auth, s.auditAPIQuery(v))
+	router.Get("/audit/export", auth, s.auditAPIExport(v))
+	router.Get("/audit/verify", auth, s.auditAPIVerify(v))
+
+}
+
+// auditMiddleware records in the audit log of the issuer or the verifier the requests to the audited routes,
+// with the authenticated operator, the target of the action and its outcome
+func (s *Server) auditMiddleware(c *fiber.Ctx) error {
+
+	err := c.Next()
+
+	// The route is known only after the request has been handled
+	route := c.Route()
+	action, ok := auditedRoutes[route.Method+" "+route.Path]
+	if !ok {
+		return err
+	}
+
+	v := s.issuerVault
+	switch {
+	case strings.HasPrefix(route.Path, verifierPrefix), strings.HasPrefix(route.Path, apiPrefix+"/verifier"):
+		v = s.verifierVault
+	case strings.HasPrefix(route.Path, registryPrefix):
+		v = s.registryVault
+	}
+
+	// The status of the response is set later by the error handler, if the handler failed
+	status := c.Response().StatusCode()
+	if err != nil {
+		status = fiber.StatusInternalServerError
+		var e *fiber.Error
+		if errors.As(err, &e) {
+			status = e.Code
+		}
+	}
+
+	entry := &audit.Entry{
+		Actor:   operator(c),
+		Action:  action,
+		Outcome: audit.Success,
+		Details: map[string]any{
+			"method": route.Method,
+			"path":   c.Path(),
+			"status": status,
+		},
+	}
+	if len(entry.Actor) == 0 {
+		entry.Actor = "anonymous"
+	}
+	if requestID, ok := c.Locals("requestid").(string); ok {
+		entry.RequestID = requestID
+	}
+	for _, param := range []string{"id", "name", "state", "did"} {
+		if value := c.Params(param); len(value) > 0 {
+			entry.TargetType = param
+			entry.Target = value
+			break
+		}
+	}
+	if len(entry.Target) == 0 && len(c.Query("state")) > 0 {
+		entry.TargetType = "state"
+		entry.Target = c.Query("state")
+	}
+	if err != nil || status >= fiber.StatusBadRequest {
+		entry.Outcome = audit.Failure
+	}
+
+	if _, auditErr := v.Audit(entry); auditErr != nil {
+		s.log(c).Errorw("failed writing the audit log", "action", action, zap.Error(auditErr))
+	}
+
+	return err
+}
+
+// auditQuery builds a search in the audit log from the query parameters of the request
+func auditQuery(c *fiber.Ctx) (vault.AuditQuery, error) {
+
+	q := vault.AuditQuery{
+		Actor:  c.Query("actor"),
+		Action: c.Query("action"),
+		Target: c.Query("target"),
+	}
+
+	for param, target := range map[string]*int{
+		"after": &q.AfterSeq,
+		"limit": &q.Limit,
+	} {
+		if value := c.Query(param); len(value) > 0 {
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 0 {
+				return q, fiber.NewError(fiber.StatusBadRequest, param+" must be a positive number")
+			}
+			*target = n
+		}
+	}
+
+	for param, target := range map[string]**time.Time{
+		"from": &q.From,
+		"to":   &q.To,
+	} {
+		if value := c.Query(param); len(value) > 0 {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return q, fiber.NewError(fiber.StatusBadRequest, param+" must be a date in RFC3339 format")
+			}
+			*target = &t
+		}
+	}
+
+	return q, nil
+}
+
+// auditAPIQuery returns a page of the entries of the audit log matching the filters in the query parameters.
+// The next page starts after the sequence number of the last entry returned.
+func (s *Server) auditAPIQuery(v *vault.Vault) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+
+		q, err := auditQuery(c)
+		if err != nil {
+			return err
+		}
+		if q.Limit == 0 || q.Limit > 100 {
+			q.Limit = 100
+		}
+
+		entries, err := v.QueryAudit(q)
+		if err != nil {
+			return err
+		}
+
+		next := 0
+		if len(entries) == q.Limit {
+			next = entries[len(entries)-1].Seq
+		}
+
+		return c.JSON(fiber.Map{
+			"items": entries,
+			"next":  next,
+		})
+	}
+}
+
+// auditAPIExport streams all the entries of the audit log matching the filters, as JSON lines or CSV
+func (s *Server) auditAPIExport(v *vault.Vault) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+
+		q, err := auditQuery(c)
+		if err != nil {
+			return err
+		}
+
+		format := c.Query("format", "jsonl")
+		switch format {
+		case "jsonl":
+			c.Set(fiber.HeaderContentType, "application/x-ndjson")
+		case "csv":
+			c.Set(fiber.HeaderContentType, "text/csv")
+		default:
+			return fiber.NewError(fiber.StatusBadRequest, "format must be jsonl or csv")
+		}
+		c.Attachment("audit." + format)
+
+		c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+
+			var write func(e *audit.Entry) error
+			if format == "csv" {
+				cw := csv.NewWriter(w)
+				defer cw.Flush()
+				cw.Write([]string{"seq", "time", "actor", "action", "targetType", "target", "outcome", "requestId", "details", "prevHash", "hash"})
+				write = func(e *audit.Entry) error {
+					details, _ := json.Marshal(e.Details)
+					return cw.Write([]string{strconv.Itoa(e.Seq), e.Time.Format(time.RFC3339Nano), e.Actor, e.Action,
+						e.TargetType, e.Target, e.Outcome, e.RequestID, string(details), e.PrevHash, e.Hash})
+				}
+			} else {
+				enc := json.NewEncoder(w)
+				write = func(e *audit.Entry) error {
+					return enc.Encode(e)
+				}
+			}
+
+			if err := v.ExportAudit(q, write); err != nil {
+				s.log(c).Errorw("failed exporting the audit log", zap.Error(err))
+			}
+		})
+
+		return nil
+	}
+}
+
+// auditAPIVerify checks the hash chain of the audit log
+func (s *Server) auditAPIVerify(v *vault.Vault) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+
+		verified, bad, err := v.VerifyAudit()
+		if err != nil {
+			return err
+		}
+
+		result := fiber.Map{
+			"valid":    bad == 0,
+			"verified": verified,
+		}
+		if bad != 0 {
+			result["firstInvalid"] = bad
+			s.log(c).Errorw("the audit log has been tampered with", "seq", bad)
+		}
+
+		return c.JSON(result)
+	}
+}
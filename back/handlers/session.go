@@ -8,7 +8,7 @@ import (
 
 	"github.com/duo-labs/webauthn/webauthn"
 	"github.com/gorilla/sessions"
-	zlog "github.com/rs/zerolog/log"
+	"go.uber.org/zap"
 )
 
 // DefaultEncryptionKeyLength is the length of the generated encryption keys
@@ -98,7 +98,7 @@ func (store *SessionStore) GetWebauthnSession(key string, r *http.Request) (weba
 func (store *SessionStore) Set(key string, value interface{}, r *http.Request, w http.ResponseWriter) error {
 	session, err := store.Get(r, WebauthnSession)
 	if err != nil {
-		zlog.Err(err).Msg("error getting session")
+		zap.S().Errorw("error getting session", zap.Error(err))
 	}
 	session.Values[key] = value
 	session.Save(r, w)
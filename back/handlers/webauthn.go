@@ -10,6 +10,8 @@ import (
 
 	"github.com/duo-labs/webauthn/protocol"
 	"github.com/duo-labs/webauthn/webauthn"
+	"github.com/hesusruiz/vcbackend/internal/logging"
+	"go.uber.org/zap"
 
 	"github.com/hesusruiz/vcbackend/back/operations"
 	"github.com/hesusruiz/vcutils/yaml"
@@ -17,7 +19,6 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/session"
 	"github.com/gofiber/fiber/v2/utils"
-	zlog "github.com/rs/zerolog/log"
 
 	_ "github.com/mattn/go-sqlite3"
 )
@@ -58,7 +59,7 @@ func NewWebAuthnHandler(f *fiber.App, o *operations.Manager, cfg *yaml.YAML) *We
 	})
 
 	if err != nil {
-		zlog.Panic().Err(err).Msg("failed to create WebAuthn from config")
+		zap.S().Panicw("failed to create WebAuthn from config", zap.Error(err))
 	}
 
 	server.AddRoutes(f)
@@ -86,29 +87,28 @@ func (s *WebAuthnHandler) BeginRegistration(c *fiber.Ctx) error {
 	if usernameParam == "" {
 		errText := "must supply a valid username i.e. foo@bar.com"
 		err := fmt.Errorf(errText)
-		zlog.Err(err).Send()
+		logging.FromContext(c.UserContext()).Errorw("BeginRegistration failed", zap.Error(err))
 		return fiber.NewError(http.StatusBadRequest, errText)
 	}
 
 	username := utils.CopyString(usernameParam)
 	displayName := strings.Split(username, "@")[0]
 
-	zlog.Info().Str("username", username).Msg("BeginRegistration started")
+	logging.FromContext(c.UserContext()).Infow("BeginRegistration started", "username", username)
 
 	// get user from the Storage
 	user, err := s.Operations.User().GetOrCreate(username, displayName)
 	if err != nil {
 		return err
 	}
-	zlog.Info().Str("username", user.WebAuthnName()).Msg("User retrieved or created")
+	logging.FromContext(c.UserContext()).Infow("User retrieved or created", "username", user.WebAuthnName())
 
 	// We should exclude all the credentials already registered
 	registerOptions := func(credCreationOpts *protocol.PublicKeyCredentialCreationOptions) {
 		credCreationOpts.CredentialExcludeList = user.CredentialExcludeList()
 	}
 
-	fmt.Println("======== CREDENTIAL EXCLUDE LIST")
-	printJSON(user.CredentialExcludeList())
+	logging.FromContext(c.UserContext()).Debugw("credentials excluded", "excluded", len(user.CredentialExcludeList()))
 
 	// generate PublicKeyCredentialCreationOptions, session data
 	options, sessionData, err := s.WebAuthn.BeginRegistration(
@@ -117,10 +117,10 @@ func (s *WebAuthnHandler) BeginRegistration(c *fiber.Ctx) error {
 	)
 
 	if err != nil {
-		zlog.Error().Err(err).Msg("Error in BeginRegistration")
+		logging.FromContext(c.UserContext()).Errorw("Error in BeginRegistration", zap.Error(err))
 		return err
 	} else {
-		zlog.Info().Msg("Successful BeginRegistration")
+		logging.FromContext(c.UserContext()).Infow("Successful BeginRegistration")
 	}
 
 	// Use a session to track the request/reply
@@ -216,11 +216,11 @@ func (s *WebAuthnHandler) BeginLogin(c *fiber.Ctx) error {
 	username := c.Params("username")
 	if username == "" {
 		err := fmt.Errorf("must supply a valid username i.e. foo@bar.com")
-		zlog.Error().Err(err).Send()
+		logging.FromContext(c.UserContext()).Errorw("BeginLogin failed", zap.Error(err))
 		return err
 	}
 
-	zlog.Info().Str("username", username).Msg("BeginLogin started")
+	logging.FromContext(c.UserContext()).Infow("BeginLogin started", "username", username)
 
 	user, err := s.Operations.User().GetByName(username)
 
@@ -228,14 +228,14 @@ func (s *WebAuthnHandler) BeginLogin(c *fiber.Ctx) error {
 	// user, err := s.UserDB.GetUser(username)
 	// It is an error if the user doesn't exist
 	if err != nil {
-		zlog.Error().Err(err).Msg("Error in userDB.GetUser")
+		logging.FromContext(c.UserContext()).Errorw("Error in userDB.GetUser", zap.Error(err))
 		return err
 	}
 
 	// generate PublicKeyCredentialRequestOptions, session data
 	options, sessionData, err := s.WebAuthn.BeginLogin(user)
 	if err != nil {
-		zlog.Error().Err(err).Msg("Error in webAuthn.BeginLogin")
+		logging.FromContext(c.UserContext()).Errorw("Error in webAuthn.BeginLogin", zap.Error(err))
 		return err
 	}
 
@@ -271,18 +271,18 @@ func (s *WebAuthnHandler) FinishLogin(c *fiber.Ctx) error {
 	username := c.Params("username")
 	if username == "" {
 		err := fmt.Errorf("must supply a valid username i.e. foo@bar.com")
-		zlog.Error().Err(err).Send()
+		logging.FromContext(c.UserContext()).Errorw("FinishLogin failed", zap.Error(err))
 		return err
 	}
 
-	zlog.Info().Str("username", username).Msg("FinishLogin started")
+	logging.FromContext(c.UserContext()).Infow("FinishLogin started", "username", username)
 
 	// Get user from Storage
 	user, err := s.Operations.User().GetByName(username)
 
 	// It is an error if the user doesn't exist
 	if err != nil {
-		zlog.Error().Err(err).Msg("Error in userDB.GetUser")
+		logging.FromContext(c.UserContext()).Errorw("Error in userDB.GetUser", zap.Error(err))
 		return err
 	}
 
@@ -310,13 +310,13 @@ func (s *WebAuthnHandler) FinishLogin(c *fiber.Ctx) error {
 	// checks on the returned 'credential'
 	credential, err := s.WebAuthn.ValidateLogin(user, wasession, parsedResponse)
 	if err != nil {
-		zlog.Error().Err(err).Msg("Error calling webAuthn.FinishLogin")
+		logging.FromContext(c.UserContext()).Errorw("Error calling webAuthn.FinishLogin", zap.Error(err))
 		return err
 	}
-	printJSON(credential)
+	logging.FromContext(c.UserContext()).Debugw("authenticator validated", "signCount", credential.Authenticator.SignCount)
 
 	if credential.Authenticator.CloneWarning {
-		zlog.Warn().Msg("The authenticator may be cloned")
+		logging.FromContext(c.UserContext()).Warnw("The authenticator may be cloned")
 	}
 
 	sess.Set("username", username)
@@ -356,21 +356,21 @@ func (s *WebAuthnHandler) ListCredentials(c *fiber.Ctx) error {
 	v := sess.Get("username")
 	if v == nil {
 		err := fmt.Errorf("user not logged in")
-		zlog.Error().Err(err).Send()
+		logging.FromContext(c.UserContext()).Errorw("ListCredentials failed", zap.Error(err))
 		return fiber.ErrForbidden
 	}
 	username := v.(string)
 
-	zlog.Info().Str("username", username).Msg("User is logged in")
+	logging.FromContext(c.UserContext()).Infow("User is logged in", "username", username)
 
 	// Get user from Storage
 	user, err := s.Operations.User().GetByName(username)
 	if err != nil {
-		zlog.Warn().Msg("user not found")
+		logging.FromContext(c.UserContext()).Warnw("user not found")
 		return err
 	}
 	creds := user.WebAuthnCredentials()
-	printJSON(creds)
+	logging.FromContext(c.UserContext()).Debugw("credentials listed", "count", len(creds))
 
 	// handle successful login
 	return c.JSON(creds)
@@ -446,12 +446,3 @@ func ParseCredentialRequestResponse(car protocol.CredentialAssertionResponse) (*
 	}
 	return &par, nil
 }
-
-func printJSON(val any) {
-	out, err := json.MarshalIndent(val, "", "  ")
-	if err != nil {
-		zlog.Error().Err(err).Msg("Error in JSON Marshall")
-		return
-	}
-	fmt.Println(string(out))
-}
@@ -93,7 +93,12 @@ func (m *Manager) CreateServiceCredential(credIn map[string]any) (string, []byte
 	// 	return "", nil, fiber.NewError(fiber.StatusInternalServerError, "error getting public key for user")
 	// }
 
-	credIn["subjectDID"] = "did:key:" + usr.ID
+	// The credential is bound to the DID registered for the user
+	subjectDID, err := m.v.GetDIDForUser(usr.ID)
+	if err != nil || len(subjectDID) == 0 {
+		return "", nil, fiber.NewError(fiber.StatusUnprocessableEntity, "the user has no registered DID")
+	}
+	credIn["subjectDID"] = subjectDID
 
 	return m.v.CreateCredentialJWTFromMap(credIn)
 
@@ -3,8 +3,7 @@ package operations
 import (
 	"github.com/hesusruiz/vcbackend/vault"
 	"github.com/hesusruiz/vcutils/yaml"
-
-	zlog "github.com/rs/zerolog/log"
+	"go.uber.org/zap"
 )
 
 type Manager struct {
@@ -17,7 +16,7 @@ func NewManager(cfg *yaml.YAML) *Manager {
 	// Open the Vault
 	v, err := vault.New(cfg)
 	if err != nil {
-		zlog.Panic().Err(err).Send()
+		zap.S().Panicw("error opening the vault", zap.Error(err))
 	}
 
 	manager := &Manager{
@@ -1,20 +1,20 @@
 package operations
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/hesusruiz/vcbackend/internal/metrics"
+	"github.com/hesusruiz/vcbackend/internal/tracing"
 	"github.com/hesusruiz/vcbackend/vault"
 	"go.uber.org/zap"
 )
 
-var logger = zap.Must(zap.NewDevelopment())
-
 func SSIKitCreateDID(custodianURL string, v *vault.Vault, userid string) (string, error) {
-	defer logger.Sync()
-
 	// Create a new DID only if it does not exist
 	did, _ := v.GetDIDForUser(userid)
 	if len(did) > 0 {
@@ -29,14 +29,16 @@ func SSIKitCreateDID(custodianURL string, v *vault.Vault, userid string) (string
 	agent.JSON(bodyRequest)
 	agent.ContentType("application/json")
 	agent.Set("accept", "application/json")
-	code, returnBody, reqErr := agent.Bytes()
+	start := time.Now()
+	code, returnBody, reqErr := tracing.Do(context.Background(), "ssikit.createDID", agent)
+	metrics.ObserveSSIKit("createDID", start, len(reqErr) > 0 || code != http.StatusOK)
 	if len(reqErr) > 0 {
 		err := fmt.Errorf("error calling SSI Kit: %v", reqErr[0])
-		logger.Error("error calling SSI Kit", zap.Error(err))
+		zap.S().Errorw("error calling SSI Kit", zap.Error(err))
 		return "", err
 	}
 	if code != http.StatusOK {
-		logger.Error(fmt.Sprintf("Was not able to create the issuer. Status: %d, Message: %s", code, returnBody))
+		zap.S().Errorw("Was not able to create the issuer", "status", code, "message", string(returnBody))
 		return "", errors.New("issuer_not_created")
 	}
 
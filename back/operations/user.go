@@ -6,10 +6,10 @@ import (
 
 	"github.com/hesusruiz/vcbackend/ent"
 	"github.com/hesusruiz/vcbackend/ent/user"
+	"go.uber.org/zap"
 
 	"github.com/duo-labs/webauthn/protocol"
 	"github.com/duo-labs/webauthn/webauthn"
-	zlog "github.com/rs/zerolog/log"
 )
 
 // User represents the user model
@@ -128,7 +128,7 @@ func (u *User) WebAuthnCredentials() []webauthn.Credential {
 // with all a user's credentials
 func (u *User) CredentialExcludeList() []protocol.CredentialDescriptor {
 	if u.entuser == nil {
-		zlog.Panic().Msg("User model not initialized")
+		zap.S().Panic("User model not initialized")
 	}
 
 	credentialExcludeList := []protocol.CredentialDescriptor{}
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hesusruiz/vcbackend/internal/config"
+	"github.com/hesusruiz/vcbackend/internal/logging"
+	"github.com/hesusruiz/vcbackend/vault"
+	"github.com/hesusruiz/vcutils/yaml"
+	"go.uber.org/zap"
+)
+
+// ##########################################
+// ##########################################
+// Command line of vcbackend
+
+// command is a command of the command line, which returns the exit code of the program
+type command struct {
+	usage string
+	run   func(args []string) int
+}
+
+// commands are the commands of the command line. Without a command, the server is started.
+var commands = map[string]command{
+	"serve":      {"serve [-config file] [-prod] [-pass password]", serveCommand},
+	"config":     {"config check [-config file] [-print]", configCommand},
+	"migrate":    {"migrate up|down|status|baseline [-config file]", migrateCommand},
+	"issuer":     {"issuer create|list [-config file]", issuerCommand},
+	"credential": {"credential issue|list|show|revoke|verify [-config file]", credentialCommand},
+	"key":        {"key generate|list|rotate|import|export [-config file]", keyCommand},
+	"did":        {"did create|resolve [-config file]", didCommand},
+	"vault":      {"vault backup|restore|export|import [-config file]", vaultCommand},
+	"clientcert": {"clientcert add|list|remove [-config file]", clientCertCommand},
+}
+
+// usage prints the commands of the command line
+func usage() {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(os.Stderr, "usage: vcbackend <command> [arguments]")
+	fmt.Fprintln(os.Stderr, "\nThe commands are:")
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  vcbackend %s\n", commands[name].usage)
+	}
+	fmt.Fprintln(os.Stderr, "\nWithout a command, the server is started like with serve, whose flags are:")
+	flag.PrintDefaults()
+}
+
+// runCommand runs a command of the command line and returns the exit code
+func runCommand(name string, args []string) int {
+	cmd, ok := commands[name]
+	if !ok {
+		if name != "help" {
+			fmt.Fprintf(os.Stderr, "vcbackend: unknown command %q\n\n", name)
+		}
+		usage()
+		return 2
+	}
+	return cmd.run(args)
+}
+
+// serveCommand starts the server, with the flags after the command
+func serveCommand(args []string) int {
+	if err := flag.CommandLine.Parse(args); err != nil {
+		return 2
+	}
+	run()
+	return 0
+}
+
+// subcommand runs the subcommand in the first argument, from those of a command
+func subcommand(name string, args []string, subcommands map[string]func(args []string) int) int {
+	if len(args) > 0 {
+		if run, ok := subcommands[args[0]]; ok {
+			return run(args[1:])
+		}
+	}
+
+	names := make([]string, 0, len(subcommands))
+	for sub := range subcommands {
+		names = append(names, sub)
+	}
+	sort.Strings(names)
+	fmt.Fprintf(os.Stderr, "usage: vcbackend %s %s [flags]\n", name, strings.Join(names, "|"))
+	fmt.Fprintf(os.Stderr, "Run vcbackend %s <subcommand> -h for the flags of a subcommand.\n", name)
+	return 2
+}
+
+// cli has the flags common to the subcommands which operate on a vault
+type cli struct {
+	*flag.FlagSet
+	config *string
+	vault  *string
+}
+
+// newCLI creates the flags of a subcommand operating on the vault of a component, by default the one given
+func newCLI(name string, defaultVault string) *cli {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	c := &cli{
+		FlagSet: fs,
+		config:  fs.String("config", LookupEnvOrString("CONFIG_FILE", defaultConfigFile), "path to configuration file"),
+	}
+	if len(defaultVault) > 0 {
+		c.vault = fs.String("vault", defaultVault, "vault of the component: issuer, verifier, wallet or verifiableregistry")
+	}
+	return c
+}
+
+// parse parses the flags and checks the number of positional arguments
+func (c *cli) parse(args []string, positional ...string) bool {
+	if err := c.Parse(args); err != nil {
+		return false
+	}
+	if c.NArg() != len(positional) {
+		what := "no arguments"
+		if len(positional) > 0 {
+			what = strings.Join(positional, " ")
+		}
+		fmt.Fprintf(os.Stderr, "vcbackend %s: expects %s\n", c.Name(), what)
+		return false
+	}
+	return true
+}
+
+// load reads the configuration and returns the configuration of the vault of the component
+func (c *cli) load() (*config.Config, *yaml.YAML, error) {
+
+	conf, cfg, err := config.Load(*c.config, os.Environ())
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", *c.config, err)
+	}
+
+	// The standard output is for the results, and the log is only for the problems
+	if _, err := logging.Setup(logging.Options{
+		Level:             "WARN",
+		SensitiveData:     conf.Server.LogSensitiveData,
+		DisableStacktrace: true,
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	component := "issuer"
+	if c.vault != nil {
+		component = *c.vault
+	}
+	switch component {
+	case "issuer", "verifier", "wallet", "verifiableregistry":
+	default:
+		return nil, nil, fmt.Errorf("unknown vault %q", component)
+	}
+
+	return conf, yaml.New(cfg.Map(component)), nil
+}
+
+// open reads the configuration and opens the vault of the component
+func (c *cli) open() (*vault.Vault, *config.Config, error) {
+
+	conf, vaultConfig, err := c.load()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v, err := vault.New(vaultConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	return v, conf, nil
+}
+
+// openStore reads the configuration and opens the database of the vault of the component, without
+// opening the vault, which migrates and writes it. It returns the name of the driver of the database.
+func (c *cli) openStore() (*sql.DB, string, error) {
+
+	_, vaultConfig, err := c.load()
+	if err != nil {
+		return nil, "", err
+	}
+
+	driver := vaultConfig.String("store.driverName")
+	db, err := sql.Open(driver, vaultConfig.String("store.dataSourceName"))
+	if err != nil {
+		return nil, "", err
+	}
+	return db, driver, nil
+}
+
+// printJSON writes the result of a command to the standard output, as indented JSON
+func printJSON(result any) int {
+	b, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return fail(err)
+	}
+	fmt.Println(string(b))
+	return 0
+}
+
+// fail reports the error of a command and returns the exit code of a failure
+func fail(err error) int {
+	zap.L().Sync()
+	fmt.Fprintf(os.Stderr, "vcbackend: %v\n", err)
+	return 1
+}
+
+// readInput reads a file, or the standard input if the name is "-"
+func readInput(file string) ([]byte, error) {
+	if file == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(file)
+}
+
+// readData reads a YAML or JSON document from a file, or from the standard input if the name is "-"
+func readData(file string) (*yaml.YAML, error) {
+	src, err := readInput(file)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.ParseYamlBytes(src)
+}
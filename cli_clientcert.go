@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/hesusruiz/vcbackend/ent"
+	"github.com/hesusruiz/vcbackend/internal/tlsconfig"
+)
+
+// clientCertCommand implements the clientcert command, which registers the certificates of the clients
+// authenticated with mutual TLS, and the user of the vault which each one authenticates as
+func clientCertCommand(args []string) int {
+	return subcommand("clientcert", args, map[string]func([]string) int{
+		"add":    clientCertAdd,
+		"list":   clientCertList,
+		"remove": clientCertRemove,
+	})
+}
+
+// ClientCertInfo is a registered certificate of a client, as printed by the commands
+type ClientCertInfo struct {
+	Fingerprint string    `json:"fingerprint"`
+	User        string    `json:"user"`
+	Subject     string    `json:"subject"`
+	NotAfter    time.Time `json:"notAfter"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+func clientCertInfo(entry *ent.ClientCertificate) *ClientCertInfo {
+	return &ClientCertInfo{
+		Fingerprint: entry.ID,
+		User:        entry.User,
+		Subject:     entry.Subject,
+		NotAfter:    entry.NotAfter,
+		CreatedAt:   entry.CreatedAt,
+	}
+}
+
+// clientCertAdd registers the certificate of a client in a PEM file for a user
+func clientCertAdd(args []string) int {
+
+	c := newCLI("clientcert add", "issuer")
+	user := c.String("user", "", "user which the client authenticates as, like an operator or admin")
+	file := c.String("cert", "", "file with the certificate of the client in PEM, or - for the standard input")
+	if !c.parse(args) {
+		return 2
+	}
+	if len(*user) == 0 || len(*file) == 0 {
+		fmt.Fprintln(os.Stderr, "vcbackend clientcert add: the user and the certificate are required")
+		return 2
+	}
+
+	src, err := readInput(*file)
+	if err != nil {
+		return fail(err)
+	}
+	cert, err := tlsconfig.ParseCertificate(src)
+	if err != nil {
+		return fail(fmt.Errorf("%s: %w", *file, err))
+	}
+
+	v, _, err := c.open()
+	if err != nil {
+		return fail(err)
+	}
+	defer v.Client.Close()
+
+	entry, err := v.AddClientCertificate(*user, cert)
+	if err != nil {
+		return fail(err)
+	}
+	return printJSON(clientCertInfo(entry))
+}
+
+// clientCertList lists the registered certificates, of a user or of all of them
+func clientCertList(args []string) int {
+
+	c := newCLI("clientcert list", "issuer")
+	user := c.String("user", "", "user of the certificates, by default all")
+	if !c.parse(args) {
+		return 2
+	}
+
+	v, _, err := c.open()
+	if err != nil {
+		return fail(err)
+	}
+	defer v.Client.Close()
+
+	entries, err := v.ClientCertificates(*user)
+	if err != nil {
+		return fail(err)
+	}
+	list := []*ClientCertInfo{}
+	for _, entry := range entries {
+		list = append(list, clientCertInfo(entry))
+	}
+	return printJSON(list)
+}
+
+// clientCertRemove removes the registration of a certificate, given its fingerprint
+func clientCertRemove(args []string) int {
+
+	c := newCLI("clientcert remove", "issuer")
+	fingerprint := c.String("fingerprint", "", "SHA-256 fingerprint of the certificate, as listed")
+	if !c.parse(args) {
+		return 2
+	}
+	if len(*fingerprint) == 0 {
+		fmt.Fprintln(os.Stderr, "vcbackend clientcert remove: the fingerprint is required")
+		return 2
+	}
+
+	v, _, err := c.open()
+	if err != nil {
+		return fail(err)
+	}
+	defer v.Client.Close()
+
+	entry, err := v.RemoveClientCertificate(*fingerprint)
+	if err != nil {
+		return fail(err)
+	}
+	return printJSON(clientCertInfo(entry))
+}
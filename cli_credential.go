@@ -0,0 +1,267 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hesusruiz/vcbackend/ent/credential"
+	"github.com/hesusruiz/vcbackend/internal/vc"
+	"github.com/hesusruiz/vcbackend/vault"
+	"github.com/hesusruiz/vcutils/yaml"
+)
+
+// credentialCommand implements the credential command, which manages the credentials of the vault of the issuer
+func credentialCommand(args []string) int {
+	return subcommand("credential", args, map[string]func([]string) int{
+		"issue":  credentialIssue,
+		"list":   credentialList,
+		"show":   credentialShow,
+		"revoke": credentialRevoke,
+		"verify": credentialVerify,
+	})
+}
+
+// IssuedCredential is a credential created by the issue command
+type IssuedCredential struct {
+	ID         string `json:"id"`
+	Credential string `json:"credential"`
+}
+
+// credentialIssue issues a credential with the data in the flags, or the credentials in a file with a
+// list of credential data, with credName, issuerDID, subjectDID and claims like configs/samples/credentials.yaml
+func credentialIssue(args []string) int {
+
+	c := newCLI("credential issue", "")
+	credType := c.String("type", "", "type of the credential, the name of its template")
+	issuer := c.String("issuer", "", "DID of the issuer, by default the one of the configuration")
+	subject := c.String("subject", "", "DID of the holder")
+	claims := c.String("claims", "{}", "claims of the subject, in JSON")
+	file := c.String("file", "", "YAML file with a list of credential data, or - for the standard input")
+	if !c.parse(args) {
+		return 2
+	}
+
+	var items []map[string]any
+	if len(*file) > 0 {
+		data, err := readData(*file)
+		if err != nil {
+			return fail(err)
+		}
+		for _, item := range data.List("") {
+			if m, ok := item.(map[string]any); ok {
+				items = append(items, m)
+			}
+		}
+		if len(items) == 0 {
+			return fail(fmt.Errorf("%s: no credentials found", *file))
+		}
+	} else {
+		if len(*credType) == 0 {
+			fmt.Fprintln(os.Stderr, "vcbackend credential issue: the type or a file is required")
+			return 2
+		}
+		subjectClaims := map[string]any{}
+		if err := json.Unmarshal([]byte(*claims), &subjectClaims); err != nil {
+			fmt.Fprintf(os.Stderr, "vcbackend credential issue: invalid claims: %v\n", err)
+			return 2
+		}
+		item := map[string]any{"credName": *credType, "claims": subjectClaims}
+		if len(*issuer) > 0 {
+			item["issuerDID"] = *issuer
+		}
+		if len(*subject) > 0 {
+			item["subjectDID"] = *subject
+		}
+		items = append(items, item)
+	}
+
+	v, conf, err := c.open()
+	if err != nil {
+		return fail(err)
+	}
+	defer v.Client.Close()
+
+	// The credentials which can not be issued are reported, and the others are issued
+	issued := []*IssuedCredential{}
+	status := 0
+	for _, item := range items {
+		if len(yaml.New(item).String("issuerDID")) == 0 {
+			item["issuerDID"] = conf.Issuer.ID
+		}
+		id, raw, err := v.CreateCredentialJWTFromMap(item)
+		if err != nil {
+			status = fail(fmt.Errorf("issuing a %v credential: %w", item["credName"], err))
+			continue
+		}
+		issued = append(issued, &IssuedCredential{ID: id, Credential: string(raw)})
+	}
+
+	if printJSON(issued) != 0 {
+		return 1
+	}
+	return status
+}
+
+// credentialList searches the credentials of the vault, a page at a time
+func credentialList(args []string) int {
+
+	c := newCLI("credential list", "")
+	q := vault.CredentialQuery{}
+	c.StringVar(&q.Type, "type", "", "type of the credentials")
+	c.StringVar(&q.Subject, "subject", "", "DID of the holder")
+	c.StringVar(&q.Status, "status", "", "status of the credentials: active, revoked or expired")
+	c.StringVar(&q.Text, "text", "", "text in the id, type, issuer, subject, email or template")
+	c.StringVar(&q.Sort, "sort", "", "sort field: created_at (default), issued_at or expires_at")
+	c.BoolVar(&q.Desc, "desc", false, "sort in descending order")
+	c.IntVar(&q.Limit, "limit", 50, "maximum number of credentials")
+	c.StringVar(&q.Cursor, "cursor", "", "cursor of the page, the nextCursor of the previous one")
+	if !c.parse(args) {
+		return 2
+	}
+
+	v, _, err := c.open()
+	if err != nil {
+		return fail(err)
+	}
+	defer v.Client.Close()
+
+	page, err := v.SearchCredentials(q)
+	if err != nil {
+		return fail(err)
+	}
+	return printJSON(page)
+}
+
+// CredentialDetails is a credential with its metadata and its decoded claims
+type CredentialDetails struct {
+	*vault.CredentialSummary
+	Credential string         `json:"credential"`
+	Claims     map[string]any `json:"claims,omitempty"`
+}
+
+// credentialShow prints a credential with its metadata and its claims
+func credentialShow(args []string) int {
+
+	c := newCLI("credential show", "")
+	if !c.parse(args, "<id>") {
+		return 2
+	}
+
+	v, _, err := c.open()
+	if err != nil {
+		return fail(err)
+	}
+	defer v.Client.Close()
+
+	cred, err := v.CredentialByID(c.Arg(0))
+	if err != nil {
+		return fail(err)
+	}
+
+	details := &CredentialDetails{CredentialSummary: vault.Summary(cred), Credential: string(cred.Raw)}
+	if decoded, err := vc.Decode(cred.Raw); err == nil {
+		details.Claims = decoded.Claims
+	}
+	return printJSON(details)
+}
+
+// credentialRevoke revokes a credential
+func credentialRevoke(args []string) int {
+
+	c := newCLI("credential revoke", "")
+	if !c.parse(args, "<id>") {
+		return 2
+	}
+
+	v, _, err := c.open()
+	if err != nil {
+		return fail(err)
+	}
+	defer v.Client.Close()
+
+	cred, err := v.RevokeCredential(c.Arg(0))
+	if err != nil {
+		return fail(err)
+	}
+	return printJSON(vault.Summary(cred))
+}
+
+// Verification is the result of the verification of a credential
+type Verification struct {
+	ID       string   `json:"id,omitempty"`
+	Valid    bool     `json:"valid"`
+	Problems []string `json:"problems,omitempty"`
+}
+
+// credentialVerify verifies the signature, the dates and the status of a credential of the vault, or
+// of a JWT signed with a key of the vault. It fails if the credential is not valid.
+func credentialVerify(args []string) int {
+
+	c := newCLI("credential verify", "")
+	file := c.String("jwt", "", "file with a credential in a JWT, or - for the standard input, instead of an id")
+	if err := c.Parse(args); err != nil {
+		return 2
+	}
+	if (len(*file) > 0) == (c.NArg() == 1) || c.NArg() > 1 {
+		fmt.Fprintln(os.Stderr, "vcbackend credential verify: expects the id of a credential or a -jwt file")
+		return 2
+	}
+
+	var raw []byte
+	if len(*file) > 0 {
+		var err error
+		if raw, err = readInput(*file); err != nil {
+			return fail(err)
+		}
+	}
+
+	v, _, err := c.open()
+	if err != nil {
+		return fail(err)
+	}
+	defer v.Client.Close()
+
+	result := &Verification{}
+	if len(raw) == 0 {
+		cred, err := v.CredentialByID(c.Arg(0))
+		if err != nil {
+			return fail(err)
+		}
+		raw = cred.Raw
+	}
+
+	serialized := strings.TrimSpace(string(raw))
+	if _, err := v.CredentialFromJWT(serialized); err != nil {
+		result.Problems = append(result.Problems, "invalid signature: "+err.Error())
+	}
+
+	decoded, err := vc.Decode([]byte(serialized))
+	if err != nil {
+		result.Problems = append(result.Problems, err.Error())
+	} else {
+		result.ID = decoded.ID()
+		now := time.Now()
+		if exp := decoded.ExpiresAt(); exp != nil && now.After(*exp) {
+			result.Problems = append(result.Problems, "the credential expired at "+exp.Format(time.RFC3339))
+		}
+		if nbf := decoded.ValidFrom(); nbf != nil && now.Before(*nbf) {
+			result.Problems = append(result.Problems, "the credential is not valid until "+nbf.Format(time.RFC3339))
+		}
+	}
+
+	// The credentials issued by the vault may have been revoked
+	if len(result.ID) > 0 {
+		if cred, err := v.CredentialByID(result.ID); err == nil && cred.Status == credential.StatusRevoked {
+			result.Problems = append(result.Problems, "the credential is revoked")
+		}
+	}
+
+	result.Valid = len(result.Problems) == 0
+	if status := printJSON(result); status != 0 || !result.Valid {
+		return 1
+	}
+	return 0
+}
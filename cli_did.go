@@ -0,0 +1,140 @@
+package main
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/hesusruiz/vcbackend/back/operations"
+	"github.com/hesusruiz/vcbackend/internal/config"
+	"github.com/hesusruiz/vcbackend/internal/didkey"
+	"github.com/hesusruiz/vcbackend/internal/holder"
+)
+
+// didCommand implements the did command, which creates the DIDs of the users of a vault and resolves DIDs
+func didCommand(args []string) int {
+	return subcommand("did", args, map[string]func([]string) int{
+		"create":  didCreate,
+		"resolve": didResolve,
+	})
+}
+
+// DIDInfo is the DID of a user, as printed by the commands
+type DIDInfo struct {
+	User    string `json:"user"`
+	DID     string `json:"did"`
+	Created bool   `json:"created"`
+}
+
+// didCreate creates the DID of a user, a did:key of its signing key or one created by the SSI Kit.
+// The DID is not created if the user already has one.
+func didCreate(args []string) int {
+
+	c := newCLI("did create", "issuer")
+	userid := c.String("user", "", "identifier of the user, like the id of the issuer")
+	ssikit := c.Bool("ssikit", false, "create the DID with the custodian of the SSI Kit, like the server")
+	if !c.parse(args) {
+		return 2
+	}
+	if len(*userid) == 0 {
+		fmt.Fprintln(os.Stderr, "vcbackend did create: the user is required")
+		return 2
+	}
+
+	v, conf, err := c.open()
+	if err != nil {
+		return fail(err)
+	}
+	defer v.Client.Close()
+
+	if did, _ := v.GetDIDForUser(*userid); len(did) > 0 {
+		return printJSON(&DIDInfo{User: *userid, DID: did})
+	}
+
+	var did string
+	if *ssikit {
+		did, err = operations.SSIKitCreateDID(conf.SSIKit.CustodianURL, v, *userid)
+		if err != nil {
+			return fail(err)
+		}
+		return printJSON(&DIDInfo{User: *userid, DID: did, Created: true})
+	}
+
+	keys, err := v.PrivateKeysForUser(*userid)
+	if err != nil {
+		return fail(err)
+	}
+	pub, err := keys[0].GetPublicKey()
+	if err != nil {
+		return fail(err)
+	}
+	id, err := didkey.FromPublicKey(pub)
+	if err != nil {
+		return fail(err)
+	}
+	did = id.String()
+
+	if err := v.SetDIDForUser(*userid, did); err != nil {
+		return fail(err)
+	}
+	return printJSON(&DIDInfo{User: *userid, DID: did, Created: true})
+}
+
+// Resolution is a resolved DID, with the key which verifies its signatures
+type Resolution struct {
+	DID             string            `json:"did"`
+	VerificationKey map[string]string `json:"verificationKey"`
+}
+
+// didResolve resolves a DID, locally for did:key and with the resolver of the holder binding for the
+// other methods. It fails if the DID can not be resolved.
+func didResolve(args []string) int {
+
+	c := newCLI("did resolve", "")
+	if !c.parse(args, "<did>") {
+		return 2
+	}
+
+	conf, _, err := config.Load(*c.config, os.Environ())
+	if err != nil {
+		return fail(fmt.Errorf("%s: %w", *c.config, err))
+	}
+
+	resolver := holder.NewResolver(conf.Issuer.HolderBinding.ResolverURL)
+	key, err := resolver.VerificationKey(c.Arg(0))
+	if err != nil {
+		return fail(err)
+	}
+
+	jwk, err := publicJWK(key)
+	if err != nil {
+		return fail(err)
+	}
+	return printJSON(&Resolution{DID: c.Arg(0), VerificationKey: jwk})
+}
+
+// publicJWK returns a public key as a JWK
+func publicJWK(key crypto.PublicKey) (map[string]string, error) {
+	enc := base64.RawURLEncoding.EncodeToString
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		size := (k.Curve.Params().BitSize + 7) / 8
+		return map[string]string{
+			"kty": "EC",
+			"crv": k.Curve.Params().Name,
+			"x":   enc(k.X.FillBytes(make([]byte, size))),
+			"y":   enc(k.Y.FillBytes(make([]byte, size))),
+		}, nil
+	case ed25519.PublicKey:
+		return map[string]string{"kty": "OKP", "crv": "Ed25519", "x": enc(k)}, nil
+	case *rsa.PublicKey:
+		return map[string]string{"kty": "RSA", "n": enc(k.N.Bytes()), "e": enc(big.NewInt(int64(k.E)).Bytes())}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", key)
+	}
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/hesusruiz/vcbackend/ent"
+	"github.com/hesusruiz/vcbackend/vault"
+	"github.com/hesusruiz/vcutils/yaml"
+)
+
+// issuerTypes are the types of the users of the vault which issue credentials: the ones created with
+// the command and the issuer of the configuration, created by the server
+var issuerTypes = []string{"issuer", "legalperson"}
+
+// issuerCommand implements the issuer command, which manages the issuers of the vault of the issuer
+func issuerCommand(args []string) int {
+	return subcommand("issuer", args, map[string]func([]string) int{
+		"create": issuerCreate,
+		"list":   issuerList,
+	})
+}
+
+// IssuerInfo is an issuer, as printed by the commands
+type IssuerInfo struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	DID       string    `json:"did,omitempty"`
+	Keys      []string  `json:"keys"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// issuerInfo returns an issuer with its DID and its keys, the signing key first
+func issuerInfo(v *vault.Vault, usr *ent.User) *IssuerInfo {
+	info := &IssuerInfo{ID: usr.ID, Name: usr.Name, Keys: []string{}, CreatedAt: usr.CreatedAt}
+	info.DID, _ = v.GetDIDForUser(usr.ID)
+
+	keys, _ := v.PrivateKeysForUser(usr.ID)
+	for _, k := range keys {
+		info.Keys = append(info.Keys, k.GetKid())
+	}
+	return info
+}
+
+// issuerCreate creates an issuer with a signing key, or the issuers in a file with a list of
+// id, name and password
+func issuerCreate(args []string) int {
+
+	c := newCLI("issuer create", "")
+	id := c.String("id", "", "identifier of the issuer, usually its DID")
+	name := c.String("name", "", "name of the issuer")
+	password := c.String("password", os.Getenv("ISSUER_PASSWORD"), "password of the issuer, by default in ISSUER_PASSWORD")
+	file := c.String("file", "", "YAML file with a list of issuers, or - for the standard input")
+	if !c.parse(args) {
+		return 2
+	}
+
+	type issuerData struct{ id, name, password string }
+	var issuers []issuerData
+	if len(*file) > 0 {
+		data, err := readData(*file)
+		if err != nil {
+			return fail(err)
+		}
+		for _, item := range data.List("") {
+			iss := yaml.New(item)
+			issuers = append(issuers, issuerData{
+				id:       iss.String("id"),
+				name:     iss.String("name"),
+				password: iss.String("password"),
+			})
+		}
+	} else {
+		issuers = append(issuers, issuerData{id: *id, name: *name, password: *password})
+	}
+
+	for i, iss := range issuers {
+		if len(iss.id) == 0 || len(iss.name) == 0 || len(iss.password) == 0 {
+			fmt.Fprintf(os.Stderr, "vcbackend issuer create: issuer %d: the id, the name and the password are required\n", i)
+			return 2
+		}
+	}
+
+	v, _, err := c.open()
+	if err != nil {
+		return fail(err)
+	}
+	defer v.Client.Close()
+
+	// The issuers which can not be created are reported, and the others are created
+	created := []*IssuerInfo{}
+	status := 0
+	for _, iss := range issuers {
+		usr, err := v.CreateLegalPersonWithKey(iss.id, iss.name, iss.password)
+		if err != nil {
+			status = fail(fmt.Errorf("creating the issuer %s: %w", iss.id, err))
+			continue
+		}
+		created = append(created, issuerInfo(v, usr))
+	}
+
+	if printJSON(created) != 0 {
+		return 1
+	}
+	return status
+}
+
+// issuerList lists the issuers of the vault
+func issuerList(args []string) int {
+
+	c := newCLI("issuer list", "")
+	if !c.parse(args) {
+		return 2
+	}
+
+	v, _, err := c.open()
+	if err != nil {
+		return fail(err)
+	}
+	defer v.Client.Close()
+
+	users, err := v.ListUsers(issuerTypes...)
+	if err != nil {
+		return fail(err)
+	}
+
+	issuers := []*IssuerInfo{}
+	for _, usr := range users {
+		issuers = append(issuers, issuerInfo(v, usr))
+	}
+	return printJSON(issuers)
+}
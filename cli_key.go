@@ -0,0 +1,227 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/hesusruiz/vcbackend/internal/jwk"
+)
+
+// keyCommand implements the key command, which manages the signing keys of the users of a vault.
+// The newest key of a user is the one which signs, and the previous ones verify what they signed.
+func keyCommand(args []string) int {
+	return subcommand("key", args, map[string]func([]string) int{
+		"generate": keyGenerate,
+		"list":     keyList,
+		"rotate":   keyRotate,
+		"import":   keyImport,
+		"export":   keyExport,
+	})
+}
+
+// algorithms are the signature algorithms of the keys of each curve
+var algorithms = map[string]string{
+	jwk.P256:  "ES256",
+	jwk.P256K: "ES256K",
+	jwk.P384:  "ES384",
+}
+
+// jwkJSON returns a key with the names of the members of a JWK, without the private part unless asked
+func jwkJSON(k *jwk.JWK, private bool) map[string]string {
+	m := map[string]string{}
+	for name, value := range map[string]string{
+		"kid": k.Kid, "kty": k.Kty, "use": k.Use, "alg": k.Alg,
+		"crv": k.Crv, "x": k.X, "y": k.Y, "n": k.N, "e": k.E,
+	} {
+		if len(value) > 0 {
+			m[name] = value
+		}
+	}
+	if private && len(k.D) > 0 {
+		m["d"] = k.D
+	}
+	return m
+}
+
+// keyGenerate generates a P-256 key, without storing it, to be imported later
+func keyGenerate(args []string) int {
+
+	c := newCLI("key generate", "")
+	out := c.String("out", "", "file where the private key is written, instead of the standard output")
+	if !c.parse(args) {
+		return 2
+	}
+
+	k, err := jwk.NewECDSA()
+	if err != nil {
+		return fail(err)
+	}
+
+	if len(*out) == 0 {
+		return printJSON(jwkJSON(k, true))
+	}
+
+	b, err := json.MarshalIndent(jwkJSON(k, true), "", "  ")
+	if err != nil {
+		return fail(err)
+	}
+	if err := os.WriteFile(*out, append(b, '\n'), 0600); err != nil {
+		return fail(err)
+	}
+	return printJSON(jwkJSON(k, false))
+}
+
+// KeyInfo is a key of a user, as printed by the commands
+type KeyInfo struct {
+	Kid     string            `json:"kid"`
+	Signing bool              `json:"signing"`
+	JWK     map[string]string `json:"jwk"`
+}
+
+// keyList lists the keys of a user, the signing key first
+func keyList(args []string) int {
+
+	c := newCLI("key list", "issuer")
+	userid := c.String("user", "", "identifier of the user, like the DID of the issuer")
+	if !c.parse(args) {
+		return 2
+	}
+	if len(*userid) == 0 {
+		fmt.Fprintln(os.Stderr, "vcbackend key list: the user is required")
+		return 2
+	}
+
+	v, _, err := c.open()
+	if err != nil {
+		return fail(err)
+	}
+	defer v.Client.Close()
+
+	keys, err := v.PrivateKeysForUser(*userid)
+	if err != nil {
+		return fail(err)
+	}
+
+	list := []*KeyInfo{}
+	for i, k := range keys {
+		if k == nil {
+			continue
+		}
+		list = append(list, &KeyInfo{Kid: k.GetKid(), Signing: i == 0, JWK: jwkJSON(k, false)})
+	}
+	return printJSON(list)
+}
+
+// keyRotate creates a new signing key for a user. The previous keys are kept to verify what they signed.
+func keyRotate(args []string) int {
+
+	c := newCLI("key rotate", "issuer")
+	userid := c.String("user", "", "identifier of the user, like the DID of the issuer")
+	if !c.parse(args) {
+		return 2
+	}
+	if len(*userid) == 0 {
+		fmt.Fprintln(os.Stderr, "vcbackend key rotate: the user is required")
+		return 2
+	}
+
+	v, _, err := c.open()
+	if err != nil {
+		return fail(err)
+	}
+	defer v.Client.Close()
+
+	dbKey, err := v.NewKeyForUser(*userid)
+	if err != nil {
+		return fail(err)
+	}
+	k, err := jwk.NewFromBytes(dbKey.Jwk)
+	if err != nil {
+		return fail(err)
+	}
+	return printJSON(&KeyInfo{Kid: k.GetKid(), Signing: true, JWK: jwkJSON(k, false)})
+}
+
+// keyImport adds a private key in a JWK file to a user, which becomes its signing key
+func keyImport(args []string) int {
+
+	c := newCLI("key import", "issuer")
+	userid := c.String("user", "", "identifier of the user, like the DID of the issuer")
+	file := c.String("file", "", "file with the private key as a JWK, or - for the standard input")
+	if !c.parse(args) {
+		return 2
+	}
+	if len(*userid) == 0 || len(*file) == 0 {
+		fmt.Fprintln(os.Stderr, "vcbackend key import: the user and the file are required")
+		return 2
+	}
+
+	src, err := readInput(*file)
+	if err != nil {
+		return fail(err)
+	}
+	k, err := jwk.NewFromBytes(src)
+	if err != nil {
+		return fail(fmt.Errorf("%s: invalid JWK: %w", *file, err))
+	}
+
+	// Only elliptic curve keys can be stored, and they must be private keys of a known curve
+	if k.Kty != "EC" || len(k.D) == 0 {
+		return fail(fmt.Errorf("%s: the key must be a private EC key", *file))
+	}
+	if _, err := k.GetPrivateKey(); err != nil {
+		return fail(fmt.Errorf("%s: invalid key: %w", *file, err))
+	}
+	if len(k.Alg) == 0 {
+		k.Alg = algorithms[k.Crv]
+	}
+	if len(k.Alg) == 0 {
+		return fail(fmt.Errorf("%s: unsupported curve %q", *file, k.Crv))
+	}
+	if len(k.Kid) == 0 {
+		k.Kid = uuid.New().String()
+	}
+	if len(k.Use) == 0 {
+		k.Use = "sig"
+	}
+
+	v, _, err := c.open()
+	if err != nil {
+		return fail(err)
+	}
+	defer v.Client.Close()
+
+	if _, err := v.AddKeyToUser(*userid, k); err != nil {
+		return fail(err)
+	}
+	return printJSON(&KeyInfo{Kid: k.GetKid(), Signing: true, JWK: jwkJSON(k, false)})
+}
+
+// keyExport prints a key as a JWK, only with its public part unless asked
+func keyExport(args []string) int {
+
+	c := newCLI("key export", "issuer")
+	kid := c.String("kid", "", "identifier of the key")
+	private := c.Bool("private", false, "include the private part of the key")
+	if !c.parse(args) {
+		return 2
+	}
+	if len(*kid) == 0 {
+		fmt.Fprintln(os.Stderr, "vcbackend key export: the kid is required")
+		return 2
+	}
+
+	v, _, err := c.open()
+	if err != nil {
+		return fail(err)
+	}
+	defer v.Client.Close()
+
+	k, err := v.PrivateKeyByID(*kid)
+	if err != nil {
+		return fail(err)
+	}
+	return printJSON(jwkJSON(k, *private))
+}
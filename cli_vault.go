@@ -0,0 +1,243 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hesusruiz/vcbackend/internal/backup"
+	"github.com/hesusruiz/vcbackend/vault"
+)
+
+// vaultCommand implements the vault command, which makes backups of the vaults and restores them, and
+// exports the users of a vault with their keys and DIDs to import them into another instance
+func vaultCommand(args []string) int {
+	return subcommand("vault", args, map[string]func([]string) int{
+		"backup":  vaultBackup,
+		"restore": vaultRestore,
+		"export":  vaultExport,
+		"import":  vaultImport,
+	})
+}
+
+// ArchiveInfo describes a backup or an export, as printed by the commands
+type ArchiveInfo struct {
+	File          string         `json:"file"`
+	Kind          string         `json:"kind"`
+	User          string         `json:"user,omitempty"`
+	Driver        string         `json:"driver"`
+	SchemaVersion string         `json:"schemaVersion"`
+	CreatedAt     time.Time      `json:"createdAt"`
+	Rows          map[string]int `json:"rows"`
+}
+
+func archiveInfo(file string, a *backup.Archive) *ArchiveInfo {
+	return &ArchiveInfo{
+		File:          file,
+		Kind:          a.Kind,
+		Driver:        a.Driver,
+		SchemaVersion: a.SchemaVersion,
+		CreatedAt:     a.CreatedAt,
+		Rows:          a.Counts(),
+	}
+}
+
+// passphraseFlag adds the flag of the file with the passphrase of the archives
+func passphraseFlag(c *cli) *string {
+	return c.String("passphrase-file", "", "file with the passphrase of the archive, by default in BACKUP_PASSPHRASE")
+}
+
+// passphrase returns the passphrase of the archives, from the file or the environment
+func passphrase(file string) (string, error) {
+	if len(file) == 0 {
+		if p := os.Getenv("BACKUP_PASSPHRASE"); len(p) > 0 {
+			return p, nil
+		}
+		return "", errors.New("the passphrase is required, in BACKUP_PASSPHRASE or a -passphrase-file")
+	}
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+	p := strings.TrimRight(string(b), "\r\n")
+	if len(p) == 0 {
+		return "", fmt.Errorf("%s: empty passphrase", file)
+	}
+	return p, nil
+}
+
+// writeArchive encrypts an archive into a new file, readable only by its owner
+func writeArchive(file string, a *backup.Archive, pass string) error {
+	var buf bytes.Buffer
+	if err := backup.Write(&buf, a, pass); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// readArchive decrypts an archive from a file, or from the standard input if the name is "-"
+func readArchive(file string, pass string) (*backup.Archive, error) {
+	src, err := readInput(file)
+	if err != nil {
+		return nil, err
+	}
+	a, err := backup.Read(bytes.NewReader(src), pass)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	return a, nil
+}
+
+// vaultBackup writes a consistent backup of a vault while it is in use
+func vaultBackup(args []string) int {
+
+	c := newCLI("vault backup", "issuer")
+	out := c.String("out", "", "new file where the encrypted backup is written")
+	passFile := passphraseFlag(c)
+	if !c.parse(args) {
+		return 2
+	}
+	if len(*out) == 0 {
+		fmt.Fprintln(os.Stderr, "vcbackend vault backup: the output file is required")
+		return 2
+	}
+	pass, err := passphrase(*passFile)
+	if err != nil {
+		return fail(err)
+	}
+
+	db, driver, err := c.openStore()
+	if err != nil {
+		return fail(err)
+	}
+	defer db.Close()
+
+	a, err := vault.Backup(context.Background(), db, driver)
+	if err != nil {
+		return fail(err)
+	}
+	if err := writeArchive(*out, a, pass); err != nil {
+		return fail(err)
+	}
+	return printJSON(archiveInfo(*out, a))
+}
+
+// vaultRestore restores a backup into the empty database of a vault, which can be of another driver
+func vaultRestore(args []string) int {
+
+	c := newCLI("vault restore", "issuer")
+	in := c.String("in", "", "file with the encrypted backup, or - for the standard input")
+	passFile := passphraseFlag(c)
+	if !c.parse(args) {
+		return 2
+	}
+	if len(*in) == 0 {
+		fmt.Fprintln(os.Stderr, "vcbackend vault restore: the backup file is required")
+		return 2
+	}
+	pass, err := passphrase(*passFile)
+	if err != nil {
+		return fail(err)
+	}
+	a, err := readArchive(*in, pass)
+	if err != nil {
+		return fail(err)
+	}
+
+	db, driver, err := c.openStore()
+	if err != nil {
+		return fail(err)
+	}
+	defer db.Close()
+
+	if err := vault.Restore(context.Background(), db, driver, a); err != nil {
+		return fail(err)
+	}
+	return printJSON(archiveInfo(*in, a))
+}
+
+// vaultExport exports a user of a vault with its keys and DIDs, to move it to another instance
+func vaultExport(args []string) int {
+
+	c := newCLI("vault export", "issuer")
+	userid := c.String("user", "", "identifier of the user, like the id of the issuer")
+	out := c.String("out", "", "new file where the encrypted export is written")
+	passFile := passphraseFlag(c)
+	if !c.parse(args) {
+		return 2
+	}
+	if len(*userid) == 0 || len(*out) == 0 {
+		fmt.Fprintln(os.Stderr, "vcbackend vault export: the user and the output file are required")
+		return 2
+	}
+	pass, err := passphrase(*passFile)
+	if err != nil {
+		return fail(err)
+	}
+
+	v, _, err := c.open()
+	if err != nil {
+		return fail(err)
+	}
+	defer v.Client.Close()
+
+	a, err := v.ExportUser(*userid)
+	if err != nil {
+		return fail(err)
+	}
+	if err := writeArchive(*out, a, pass); err != nil {
+		return fail(err)
+	}
+	info := archiveInfo(*out, a)
+	info.User = *userid
+	return printJSON(info)
+}
+
+// vaultImport imports a user exported from another instance into a vault which does not have it
+func vaultImport(args []string) int {
+
+	c := newCLI("vault import", "issuer")
+	in := c.String("in", "", "file with the encrypted export, or - for the standard input")
+	passFile := passphraseFlag(c)
+	if !c.parse(args) {
+		return 2
+	}
+	if len(*in) == 0 {
+		fmt.Fprintln(os.Stderr, "vcbackend vault import: the export file is required")
+		return 2
+	}
+	pass, err := passphrase(*passFile)
+	if err != nil {
+		return fail(err)
+	}
+	a, err := readArchive(*in, pass)
+	if err != nil {
+		return fail(err)
+	}
+
+	v, _, err := c.open()
+	if err != nil {
+		return fail(err)
+	}
+	defer v.Client.Close()
+
+	userid, err := v.ImportUser(a)
+	if err != nil {
+		return fail(err)
+	}
+	info := archiveInfo(*in, a)
+	info.User = userid
+	return printJSON(info)
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/hesusruiz/vcbackend/internal/config"
+	"github.com/hesusruiz/vcutils/yaml"
+)
+
+// readConfiguration reads and validates the configuration, with the overrides in the environment.
+// The server does not start with an invalid configuration.
+func readConfiguration(configFile string) (*config.Config, *yaml.YAML) {
+	conf, cfg, err := config.Load(configFile, os.Environ())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", configFile, err)
+		os.Exit(1)
+	}
+	return conf, cfg
+}
+
+// configCommand implements the config command. "config check" validates the configuration like the
+// server at startup, and prints the resulting configuration without the secrets.
+func configCommand(args []string) int {
+
+	if len(args) == 0 || args[0] != "check" {
+		fmt.Fprintln(os.Stderr, "usage: vcbackend config check [-config file] [-print]")
+		return 2
+	}
+
+	flags := flag.NewFlagSet("config check", flag.ContinueOnError)
+	file := flags.String("config", LookupEnvOrString("CONFIG_FILE", defaultConfigFile), "path to configuration file")
+	print := flags.Bool("print", false, "print the configuration, without the secrets")
+	if err := flags.Parse(args[1:]); err != nil {
+		return 2
+	}
+
+	conf, _, err := config.Load(*file, os.Environ())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", *file, err)
+		return 1
+	}
+
+	if *print {
+		fmt.Println(conf)
+	}
+	fmt.Fprintf(os.Stderr, "%s: the configuration is valid\n", *file)
+	return 0
+}
@@ -0,0 +1,419 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/auditentry"
+)
+
+// AuditEntryCreate is the builder for creating a AuditEntry entity.
+type AuditEntryCreate struct {
+	config
+	mutation *AuditEntryMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aec *AuditEntryCreate) SetCreatedAt(t time.Time) *AuditEntryCreate {
+	aec.mutation.SetCreatedAt(t)
+	return aec
+}
+
+// SetActor sets the "actor" field.
+func (aec *AuditEntryCreate) SetActor(s string) *AuditEntryCreate {
+	aec.mutation.SetActor(s)
+	return aec
+}
+
+// SetAction sets the "action" field.
+func (aec *AuditEntryCreate) SetAction(s string) *AuditEntryCreate {
+	aec.mutation.SetAction(s)
+	return aec
+}
+
+// SetTargetType sets the "target_type" field.
+func (aec *AuditEntryCreate) SetTargetType(s string) *AuditEntryCreate {
+	aec.mutation.SetTargetType(s)
+	return aec
+}
+
+// SetNillableTargetType sets the "target_type" field if the given value is not nil.
+func (aec *AuditEntryCreate) SetNillableTargetType(s *string) *AuditEntryCreate {
+	if s != nil {
+		aec.SetTargetType(*s)
+	}
+	return aec
+}
+
+// SetTarget sets the "target" field.
+func (aec *AuditEntryCreate) SetTarget(s string) *AuditEntryCreate {
+	aec.mutation.SetTarget(s)
+	return aec
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (aec *AuditEntryCreate) SetNillableTarget(s *string) *AuditEntryCreate {
+	if s != nil {
+		aec.SetTarget(*s)
+	}
+	return aec
+}
+
+// SetOutcome sets the "outcome" field.
+func (aec *AuditEntryCreate) SetOutcome(a auditentry.Outcome) *AuditEntryCreate {
+	aec.mutation.SetOutcome(a)
+	return aec
+}
+
+// SetRequestID sets the "request_id" field.
+func (aec *AuditEntryCreate) SetRequestID(s string) *AuditEntryCreate {
+	aec.mutation.SetRequestID(s)
+	return aec
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (aec *AuditEntryCreate) SetNillableRequestID(s *string) *AuditEntryCreate {
+	if s != nil {
+		aec.SetRequestID(*s)
+	}
+	return aec
+}
+
+// SetDetails sets the "details" field.
+func (aec *AuditEntryCreate) SetDetails(m map[string]interface{}) *AuditEntryCreate {
+	aec.mutation.SetDetails(m)
+	return aec
+}
+
+// SetPrevHash sets the "prev_hash" field.
+func (aec *AuditEntryCreate) SetPrevHash(s string) *AuditEntryCreate {
+	aec.mutation.SetPrevHash(s)
+	return aec
+}
+
+// SetHash sets the "hash" field.
+func (aec *AuditEntryCreate) SetHash(s string) *AuditEntryCreate {
+	aec.mutation.SetHash(s)
+	return aec
+}
+
+// SetID sets the "id" field.
+func (aec *AuditEntryCreate) SetID(i int) *AuditEntryCreate {
+	aec.mutation.SetID(i)
+	return aec
+}
+
+// Mutation returns the AuditEntryMutation object of the builder.
+func (aec *AuditEntryCreate) Mutation() *AuditEntryMutation {
+	return aec.mutation
+}
+
+// Save creates the AuditEntry in the database.
+func (aec *AuditEntryCreate) Save(ctx context.Context) (*AuditEntry, error) {
+	var (
+		err  error
+		node *AuditEntry
+	)
+	if len(aec.hooks) == 0 {
+		if err = aec.check(); err != nil {
+			return nil, err
+		}
+		node, err = aec.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditEntryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = aec.check(); err != nil {
+				return nil, err
+			}
+			aec.mutation = mutation
+			if node, err = aec.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(aec.hooks) - 1; i >= 0; i-- {
+			if aec.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = aec.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, aec.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*AuditEntry)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from AuditEntryMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (aec *AuditEntryCreate) SaveX(ctx context.Context) *AuditEntry {
+	v, err := aec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aec *AuditEntryCreate) Exec(ctx context.Context) error {
+	_, err := aec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aec *AuditEntryCreate) ExecX(ctx context.Context) {
+	if err := aec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aec *AuditEntryCreate) check() error {
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuditEntry.created_at"`)}
+	}
+	if _, ok := aec.mutation.Actor(); !ok {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required field "AuditEntry.actor"`)}
+	}
+	if _, ok := aec.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "AuditEntry.action"`)}
+	}
+	if v, ok := aec.mutation.Action(); ok {
+		if err := auditentry.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AuditEntry.action": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.Outcome(); !ok {
+		return &ValidationError{Name: "outcome", err: errors.New(`ent: missing required field "AuditEntry.outcome"`)}
+	}
+	if v, ok := aec.mutation.Outcome(); ok {
+		if err := auditentry.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "AuditEntry.outcome": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.PrevHash(); !ok {
+		return &ValidationError{Name: "prev_hash", err: errors.New(`ent: missing required field "AuditEntry.prev_hash"`)}
+	}
+	if _, ok := aec.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "AuditEntry.hash"`)}
+	}
+	if v, ok := aec.mutation.ID(); ok {
+		if err := auditentry.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "AuditEntry.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aec *AuditEntryCreate) sqlSave(ctx context.Context) (*AuditEntry, error) {
+	_node, _spec := aec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, aec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	return _node, nil
+}
+
+func (aec *AuditEntryCreate) createSpec() (*AuditEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditEntry{config: aec.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: auditentry.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: auditentry.FieldID,
+			},
+		}
+	)
+	if id, ok := aec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := aec.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: auditentry.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := aec.mutation.Actor(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditentry.FieldActor,
+		})
+		_node.Actor = value
+	}
+	if value, ok := aec.mutation.Action(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditentry.FieldAction,
+		})
+		_node.Action = value
+	}
+	if value, ok := aec.mutation.TargetType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditentry.FieldTargetType,
+		})
+		_node.TargetType = value
+	}
+	if value, ok := aec.mutation.Target(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditentry.FieldTarget,
+		})
+		_node.Target = value
+	}
+	if value, ok := aec.mutation.Outcome(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: auditentry.FieldOutcome,
+		})
+		_node.Outcome = value
+	}
+	if value, ok := aec.mutation.RequestID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditentry.FieldRequestID,
+		})
+		_node.RequestID = value
+	}
+	if value, ok := aec.mutation.Details(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: auditentry.FieldDetails,
+		})
+		_node.Details = value
+	}
+	if value, ok := aec.mutation.PrevHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditentry.FieldPrevHash,
+		})
+		_node.PrevHash = value
+	}
+	if value, ok := aec.mutation.Hash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditentry.FieldHash,
+		})
+		_node.Hash = value
+	}
+	return _node, _spec
+}
+
+// AuditEntryCreateBulk is the builder for creating many AuditEntry entities in bulk.
+type AuditEntryCreateBulk struct {
+	config
+	builders []*AuditEntryCreate
+}
+
+// Save creates the AuditEntry entities in the database.
+func (aecb *AuditEntryCreateBulk) Save(ctx context.Context) ([]*AuditEntry, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(aecb.builders))
+	nodes := make([]*AuditEntry, len(aecb.builders))
+	mutators := make([]Mutator, len(aecb.builders))
+	for i := range aecb.builders {
+		func(i int, root context.Context) {
+			builder := aecb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, aecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, aecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, aecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aecb *AuditEntryCreateBulk) SaveX(ctx context.Context) []*AuditEntry {
+	v, err := aecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aecb *AuditEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := aecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aecb *AuditEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := aecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -9,11 +9,21 @@ import (
 
 	"github.com/hesusruiz/vcbackend/ent/migrate"
 
+	"github.com/hesusruiz/vcbackend/ent/auditentry"
+	"github.com/hesusruiz/vcbackend/ent/clientcertificate"
 	"github.com/hesusruiz/vcbackend/ent/credential"
+	"github.com/hesusruiz/vcbackend/ent/credentialtemplate"
 	"github.com/hesusruiz/vcbackend/ent/did"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequest"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequestevent"
+	"github.com/hesusruiz/vcbackend/ent/loginsession"
 	"github.com/hesusruiz/vcbackend/ent/naturalperson"
+	"github.com/hesusruiz/vcbackend/ent/presentationnonce"
 	"github.com/hesusruiz/vcbackend/ent/privatekey"
 	"github.com/hesusruiz/vcbackend/ent/publickey"
+	"github.com/hesusruiz/vcbackend/ent/ratecounter"
+	"github.com/hesusruiz/vcbackend/ent/relyingparty"
+	"github.com/hesusruiz/vcbackend/ent/trustedissuer"
 	"github.com/hesusruiz/vcbackend/ent/user"
 
 	"entgo.io/ent/dialect"
@@ -26,16 +36,36 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AuditEntry is the client for interacting with the AuditEntry builders.
+	AuditEntry *AuditEntryClient
+	// ClientCertificate is the client for interacting with the ClientCertificate builders.
+	ClientCertificate *ClientCertificateClient
 	// Credential is the client for interacting with the Credential builders.
 	Credential *CredentialClient
+	// CredentialTemplate is the client for interacting with the CredentialTemplate builders.
+	CredentialTemplate *CredentialTemplateClient
 	// DID is the client for interacting with the DID builders.
 	DID *DIDClient
+	// IssuanceRequest is the client for interacting with the IssuanceRequest builders.
+	IssuanceRequest *IssuanceRequestClient
+	// IssuanceRequestEvent is the client for interacting with the IssuanceRequestEvent builders.
+	IssuanceRequestEvent *IssuanceRequestEventClient
+	// LoginSession is the client for interacting with the LoginSession builders.
+	LoginSession *LoginSessionClient
 	// NaturalPerson is the client for interacting with the NaturalPerson builders.
 	NaturalPerson *NaturalPersonClient
+	// PresentationNonce is the client for interacting with the PresentationNonce builders.
+	PresentationNonce *PresentationNonceClient
 	// PrivateKey is the client for interacting with the PrivateKey builders.
 	PrivateKey *PrivateKeyClient
 	// PublicKey is the client for interacting with the PublicKey builders.
 	PublicKey *PublicKeyClient
+	// RateCounter is the client for interacting with the RateCounter builders.
+	RateCounter *RateCounterClient
+	// RelyingParty is the client for interacting with the RelyingParty builders.
+	RelyingParty *RelyingPartyClient
+	// TrustedIssuer is the client for interacting with the TrustedIssuer builders.
+	TrustedIssuer *TrustedIssuerClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 }
@@ -51,11 +81,21 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AuditEntry = NewAuditEntryClient(c.config)
+	c.ClientCertificate = NewClientCertificateClient(c.config)
 	c.Credential = NewCredentialClient(c.config)
+	c.CredentialTemplate = NewCredentialTemplateClient(c.config)
 	c.DID = NewDIDClient(c.config)
+	c.IssuanceRequest = NewIssuanceRequestClient(c.config)
+	c.IssuanceRequestEvent = NewIssuanceRequestEventClient(c.config)
+	c.LoginSession = NewLoginSessionClient(c.config)
 	c.NaturalPerson = NewNaturalPersonClient(c.config)
+	c.PresentationNonce = NewPresentationNonceClient(c.config)
 	c.PrivateKey = NewPrivateKeyClient(c.config)
 	c.PublicKey = NewPublicKeyClient(c.config)
+	c.RateCounter = NewRateCounterClient(c.config)
+	c.RelyingParty = NewRelyingPartyClient(c.config)
+	c.TrustedIssuer = NewTrustedIssuerClient(c.config)
 	c.User = NewUserClient(c.config)
 }
 
@@ -88,14 +128,24 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:           ctx,
-		config:        cfg,
-		Credential:    NewCredentialClient(cfg),
-		DID:           NewDIDClient(cfg),
-		NaturalPerson: NewNaturalPersonClient(cfg),
-		PrivateKey:    NewPrivateKeyClient(cfg),
-		PublicKey:     NewPublicKeyClient(cfg),
-		User:          NewUserClient(cfg),
+		ctx:                  ctx,
+		config:               cfg,
+		AuditEntry:           NewAuditEntryClient(cfg),
+		ClientCertificate:    NewClientCertificateClient(cfg),
+		Credential:           NewCredentialClient(cfg),
+		CredentialTemplate:   NewCredentialTemplateClient(cfg),
+		DID:                  NewDIDClient(cfg),
+		IssuanceRequest:      NewIssuanceRequestClient(cfg),
+		IssuanceRequestEvent: NewIssuanceRequestEventClient(cfg),
+		LoginSession:         NewLoginSessionClient(cfg),
+		NaturalPerson:        NewNaturalPersonClient(cfg),
+		PresentationNonce:    NewPresentationNonceClient(cfg),
+		PrivateKey:           NewPrivateKeyClient(cfg),
+		PublicKey:            NewPublicKeyClient(cfg),
+		RateCounter:          NewRateCounterClient(cfg),
+		RelyingParty:         NewRelyingPartyClient(cfg),
+		TrustedIssuer:        NewTrustedIssuerClient(cfg),
+		User:                 NewUserClient(cfg),
 	}, nil
 }
 
@@ -113,21 +163,31 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:           ctx,
-		config:        cfg,
-		Credential:    NewCredentialClient(cfg),
-		DID:           NewDIDClient(cfg),
-		NaturalPerson: NewNaturalPersonClient(cfg),
-		PrivateKey:    NewPrivateKeyClient(cfg),
-		PublicKey:     NewPublicKeyClient(cfg),
-		User:          NewUserClient(cfg),
+		ctx:                  ctx,
+		config:               cfg,
+		AuditEntry:           NewAuditEntryClient(cfg),
+		ClientCertificate:    NewClientCertificateClient(cfg),
+		Credential:           NewCredentialClient(cfg),
+		CredentialTemplate:   NewCredentialTemplateClient(cfg),
+		DID:                  NewDIDClient(cfg),
+		IssuanceRequest:      NewIssuanceRequestClient(cfg),
+		IssuanceRequestEvent: NewIssuanceRequestEventClient(cfg),
+		LoginSession:         NewLoginSessionClient(cfg),
+		NaturalPerson:        NewNaturalPersonClient(cfg),
+		PresentationNonce:    NewPresentationNonceClient(cfg),
+		PrivateKey:           NewPrivateKeyClient(cfg),
+		PublicKey:            NewPublicKeyClient(cfg),
+		RateCounter:          NewRateCounterClient(cfg),
+		RelyingParty:         NewRelyingPartyClient(cfg),
+		TrustedIssuer:        NewTrustedIssuerClient(cfg),
+		User:                 NewUserClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Credential.
+//		AuditEntry.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -149,14 +209,204 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
+	c.AuditEntry.Use(hooks...)
+	c.ClientCertificate.Use(hooks...)
 	c.Credential.Use(hooks...)
+	c.CredentialTemplate.Use(hooks...)
 	c.DID.Use(hooks...)
+	c.IssuanceRequest.Use(hooks...)
+	c.IssuanceRequestEvent.Use(hooks...)
+	c.LoginSession.Use(hooks...)
 	c.NaturalPerson.Use(hooks...)
+	c.PresentationNonce.Use(hooks...)
 	c.PrivateKey.Use(hooks...)
 	c.PublicKey.Use(hooks...)
+	c.RateCounter.Use(hooks...)
+	c.RelyingParty.Use(hooks...)
+	c.TrustedIssuer.Use(hooks...)
 	c.User.Use(hooks...)
 }
 
+// AuditEntryClient is a client for the AuditEntry schema.
+type AuditEntryClient struct {
+	config
+}
+
+// NewAuditEntryClient returns a client for the AuditEntry from the given config.
+func NewAuditEntryClient(c config) *AuditEntryClient {
+	return &AuditEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditentry.Hooks(f(g(h())))`.
+func (c *AuditEntryClient) Use(hooks ...Hook) {
+	c.hooks.AuditEntry = append(c.hooks.AuditEntry, hooks...)
+}
+
+// Create returns a builder for creating a AuditEntry entity.
+func (c *AuditEntryClient) Create() *AuditEntryCreate {
+	mutation := newAuditEntryMutation(c.config, OpCreate)
+	return &AuditEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditEntry entities.
+func (c *AuditEntryClient) CreateBulk(builders ...*AuditEntryCreate) *AuditEntryCreateBulk {
+	return &AuditEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditEntry.
+func (c *AuditEntryClient) Update() *AuditEntryUpdate {
+	mutation := newAuditEntryMutation(c.config, OpUpdate)
+	return &AuditEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditEntryClient) UpdateOne(ae *AuditEntry) *AuditEntryUpdateOne {
+	mutation := newAuditEntryMutation(c.config, OpUpdateOne, withAuditEntry(ae))
+	return &AuditEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditEntryClient) UpdateOneID(id int) *AuditEntryUpdateOne {
+	mutation := newAuditEntryMutation(c.config, OpUpdateOne, withAuditEntryID(id))
+	return &AuditEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditEntry.
+func (c *AuditEntryClient) Delete() *AuditEntryDelete {
+	mutation := newAuditEntryMutation(c.config, OpDelete)
+	return &AuditEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditEntryClient) DeleteOne(ae *AuditEntry) *AuditEntryDeleteOne {
+	return c.DeleteOneID(ae.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *AuditEntryClient) DeleteOneID(id int) *AuditEntryDeleteOne {
+	builder := c.Delete().Where(auditentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditEntry.
+func (c *AuditEntryClient) Query() *AuditEntryQuery {
+	return &AuditEntryQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a AuditEntry entity by its id.
+func (c *AuditEntryClient) Get(ctx context.Context, id int) (*AuditEntry, error) {
+	return c.Query().Where(auditentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditEntryClient) GetX(ctx context.Context, id int) *AuditEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditEntryClient) Hooks() []Hook {
+	return c.hooks.AuditEntry
+}
+
+// ClientCertificateClient is a client for the ClientCertificate schema.
+type ClientCertificateClient struct {
+	config
+}
+
+// NewClientCertificateClient returns a client for the ClientCertificate from the given config.
+func NewClientCertificateClient(c config) *ClientCertificateClient {
+	return &ClientCertificateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `clientcertificate.Hooks(f(g(h())))`.
+func (c *ClientCertificateClient) Use(hooks ...Hook) {
+	c.hooks.ClientCertificate = append(c.hooks.ClientCertificate, hooks...)
+}
+
+// Create returns a builder for creating a ClientCertificate entity.
+func (c *ClientCertificateClient) Create() *ClientCertificateCreate {
+	mutation := newClientCertificateMutation(c.config, OpCreate)
+	return &ClientCertificateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ClientCertificate entities.
+func (c *ClientCertificateClient) CreateBulk(builders ...*ClientCertificateCreate) *ClientCertificateCreateBulk {
+	return &ClientCertificateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ClientCertificate.
+func (c *ClientCertificateClient) Update() *ClientCertificateUpdate {
+	mutation := newClientCertificateMutation(c.config, OpUpdate)
+	return &ClientCertificateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ClientCertificateClient) UpdateOne(cc *ClientCertificate) *ClientCertificateUpdateOne {
+	mutation := newClientCertificateMutation(c.config, OpUpdateOne, withClientCertificate(cc))
+	return &ClientCertificateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ClientCertificateClient) UpdateOneID(id string) *ClientCertificateUpdateOne {
+	mutation := newClientCertificateMutation(c.config, OpUpdateOne, withClientCertificateID(id))
+	return &ClientCertificateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ClientCertificate.
+func (c *ClientCertificateClient) Delete() *ClientCertificateDelete {
+	mutation := newClientCertificateMutation(c.config, OpDelete)
+	return &ClientCertificateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ClientCertificateClient) DeleteOne(cc *ClientCertificate) *ClientCertificateDeleteOne {
+	return c.DeleteOneID(cc.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *ClientCertificateClient) DeleteOneID(id string) *ClientCertificateDeleteOne {
+	builder := c.Delete().Where(clientcertificate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ClientCertificateDeleteOne{builder}
+}
+
+// Query returns a query builder for ClientCertificate.
+func (c *ClientCertificateClient) Query() *ClientCertificateQuery {
+	return &ClientCertificateQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ClientCertificate entity by its id.
+func (c *ClientCertificateClient) Get(ctx context.Context, id string) (*ClientCertificate, error) {
+	return c.Query().Where(clientcertificate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ClientCertificateClient) GetX(ctx context.Context, id string) *ClientCertificate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ClientCertificateClient) Hooks() []Hook {
+	return c.hooks.ClientCertificate
+}
+
 // CredentialClient is a client for the Credential schema.
 type CredentialClient struct {
 	config
@@ -246,101 +496,589 @@ func (c *CredentialClient) GetX(ctx context.Context, id string) *Credential {
 func (c *CredentialClient) QueryAccount(cr *Credential) *UserQuery {
 	query := &UserQuery{config: c.config}
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
-		id := cr.ID
+		id := cr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(credential.Table, credential.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, credential.AccountTable, credential.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(cr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTemplate queries the template edge of a Credential.
+func (c *CredentialClient) QueryTemplate(cr *Credential) *CredentialTemplateQuery {
+	query := &CredentialTemplateQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := cr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(credential.Table, credential.FieldID, id),
+			sqlgraph.To(credentialtemplate.Table, credentialtemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, credential.TemplateTable, credential.TemplateColumn),
+		)
+		fromV = sqlgraph.Neighbors(cr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPredecessor queries the predecessor edge of a Credential.
+func (c *CredentialClient) QueryPredecessor(cr *Credential) *CredentialQuery {
+	query := &CredentialQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := cr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(credential.Table, credential.FieldID, id),
+			sqlgraph.To(credential.Table, credential.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, credential.PredecessorTable, credential.PredecessorColumn),
+		)
+		fromV = sqlgraph.Neighbors(cr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySuccessor queries the successor edge of a Credential.
+func (c *CredentialClient) QuerySuccessor(cr *Credential) *CredentialQuery {
+	query := &CredentialQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := cr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(credential.Table, credential.FieldID, id),
+			sqlgraph.To(credential.Table, credential.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, credential.SuccessorTable, credential.SuccessorColumn),
+		)
+		fromV = sqlgraph.Neighbors(cr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CredentialClient) Hooks() []Hook {
+	return c.hooks.Credential
+}
+
+// CredentialTemplateClient is a client for the CredentialTemplate schema.
+type CredentialTemplateClient struct {
+	config
+}
+
+// NewCredentialTemplateClient returns a client for the CredentialTemplate from the given config.
+func NewCredentialTemplateClient(c config) *CredentialTemplateClient {
+	return &CredentialTemplateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `credentialtemplate.Hooks(f(g(h())))`.
+func (c *CredentialTemplateClient) Use(hooks ...Hook) {
+	c.hooks.CredentialTemplate = append(c.hooks.CredentialTemplate, hooks...)
+}
+
+// Create returns a builder for creating a CredentialTemplate entity.
+func (c *CredentialTemplateClient) Create() *CredentialTemplateCreate {
+	mutation := newCredentialTemplateMutation(c.config, OpCreate)
+	return &CredentialTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CredentialTemplate entities.
+func (c *CredentialTemplateClient) CreateBulk(builders ...*CredentialTemplateCreate) *CredentialTemplateCreateBulk {
+	return &CredentialTemplateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CredentialTemplate.
+func (c *CredentialTemplateClient) Update() *CredentialTemplateUpdate {
+	mutation := newCredentialTemplateMutation(c.config, OpUpdate)
+	return &CredentialTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CredentialTemplateClient) UpdateOne(ct *CredentialTemplate) *CredentialTemplateUpdateOne {
+	mutation := newCredentialTemplateMutation(c.config, OpUpdateOne, withCredentialTemplate(ct))
+	return &CredentialTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CredentialTemplateClient) UpdateOneID(id string) *CredentialTemplateUpdateOne {
+	mutation := newCredentialTemplateMutation(c.config, OpUpdateOne, withCredentialTemplateID(id))
+	return &CredentialTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CredentialTemplate.
+func (c *CredentialTemplateClient) Delete() *CredentialTemplateDelete {
+	mutation := newCredentialTemplateMutation(c.config, OpDelete)
+	return &CredentialTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CredentialTemplateClient) DeleteOne(ct *CredentialTemplate) *CredentialTemplateDeleteOne {
+	return c.DeleteOneID(ct.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *CredentialTemplateClient) DeleteOneID(id string) *CredentialTemplateDeleteOne {
+	builder := c.Delete().Where(credentialtemplate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CredentialTemplateDeleteOne{builder}
+}
+
+// Query returns a query builder for CredentialTemplate.
+func (c *CredentialTemplateClient) Query() *CredentialTemplateQuery {
+	return &CredentialTemplateQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a CredentialTemplate entity by its id.
+func (c *CredentialTemplateClient) Get(ctx context.Context, id string) (*CredentialTemplate, error) {
+	return c.Query().Where(credentialtemplate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CredentialTemplateClient) GetX(ctx context.Context, id string) *CredentialTemplate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCredentials queries the credentials edge of a CredentialTemplate.
+func (c *CredentialTemplateClient) QueryCredentials(ct *CredentialTemplate) *CredentialQuery {
+	query := &CredentialQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ct.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(credentialtemplate.Table, credentialtemplate.FieldID, id),
+			sqlgraph.To(credential.Table, credential.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, credentialtemplate.CredentialsTable, credentialtemplate.CredentialsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ct.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CredentialTemplateClient) Hooks() []Hook {
+	return c.hooks.CredentialTemplate
+}
+
+// DIDClient is a client for the DID schema.
+type DIDClient struct {
+	config
+}
+
+// NewDIDClient returns a client for the DID from the given config.
+func NewDIDClient(c config) *DIDClient {
+	return &DIDClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `did.Hooks(f(g(h())))`.
+func (c *DIDClient) Use(hooks ...Hook) {
+	c.hooks.DID = append(c.hooks.DID, hooks...)
+}
+
+// Create returns a builder for creating a DID entity.
+func (c *DIDClient) Create() *DIDCreate {
+	mutation := newDIDMutation(c.config, OpCreate)
+	return &DIDCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DID entities.
+func (c *DIDClient) CreateBulk(builders ...*DIDCreate) *DIDCreateBulk {
+	return &DIDCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DID.
+func (c *DIDClient) Update() *DIDUpdate {
+	mutation := newDIDMutation(c.config, OpUpdate)
+	return &DIDUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DIDClient) UpdateOne(d *DID) *DIDUpdateOne {
+	mutation := newDIDMutation(c.config, OpUpdateOne, withDID(d))
+	return &DIDUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DIDClient) UpdateOneID(id string) *DIDUpdateOne {
+	mutation := newDIDMutation(c.config, OpUpdateOne, withDIDID(id))
+	return &DIDUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DID.
+func (c *DIDClient) Delete() *DIDDelete {
+	mutation := newDIDMutation(c.config, OpDelete)
+	return &DIDDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DIDClient) DeleteOne(d *DID) *DIDDeleteOne {
+	return c.DeleteOneID(d.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *DIDClient) DeleteOneID(id string) *DIDDeleteOne {
+	builder := c.Delete().Where(did.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DIDDeleteOne{builder}
+}
+
+// Query returns a query builder for DID.
+func (c *DIDClient) Query() *DIDQuery {
+	return &DIDQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a DID entity by its id.
+func (c *DIDClient) Get(ctx context.Context, id string) (*DID, error) {
+	return c.Query().Where(did.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DIDClient) GetX(ctx context.Context, id string) *DID {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a DID.
+func (c *DIDClient) QueryUser(d *DID) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(did.Table, did.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, did.UserTable, did.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DIDClient) Hooks() []Hook {
+	return c.hooks.DID
+}
+
+// IssuanceRequestClient is a client for the IssuanceRequest schema.
+type IssuanceRequestClient struct {
+	config
+}
+
+// NewIssuanceRequestClient returns a client for the IssuanceRequest from the given config.
+func NewIssuanceRequestClient(c config) *IssuanceRequestClient {
+	return &IssuanceRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `issuancerequest.Hooks(f(g(h())))`.
+func (c *IssuanceRequestClient) Use(hooks ...Hook) {
+	c.hooks.IssuanceRequest = append(c.hooks.IssuanceRequest, hooks...)
+}
+
+// Create returns a builder for creating a IssuanceRequest entity.
+func (c *IssuanceRequestClient) Create() *IssuanceRequestCreate {
+	mutation := newIssuanceRequestMutation(c.config, OpCreate)
+	return &IssuanceRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IssuanceRequest entities.
+func (c *IssuanceRequestClient) CreateBulk(builders ...*IssuanceRequestCreate) *IssuanceRequestCreateBulk {
+	return &IssuanceRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IssuanceRequest.
+func (c *IssuanceRequestClient) Update() *IssuanceRequestUpdate {
+	mutation := newIssuanceRequestMutation(c.config, OpUpdate)
+	return &IssuanceRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IssuanceRequestClient) UpdateOne(ir *IssuanceRequest) *IssuanceRequestUpdateOne {
+	mutation := newIssuanceRequestMutation(c.config, OpUpdateOne, withIssuanceRequest(ir))
+	return &IssuanceRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IssuanceRequestClient) UpdateOneID(id string) *IssuanceRequestUpdateOne {
+	mutation := newIssuanceRequestMutation(c.config, OpUpdateOne, withIssuanceRequestID(id))
+	return &IssuanceRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IssuanceRequest.
+func (c *IssuanceRequestClient) Delete() *IssuanceRequestDelete {
+	mutation := newIssuanceRequestMutation(c.config, OpDelete)
+	return &IssuanceRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IssuanceRequestClient) DeleteOne(ir *IssuanceRequest) *IssuanceRequestDeleteOne {
+	return c.DeleteOneID(ir.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *IssuanceRequestClient) DeleteOneID(id string) *IssuanceRequestDeleteOne {
+	builder := c.Delete().Where(issuancerequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IssuanceRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for IssuanceRequest.
+func (c *IssuanceRequestClient) Query() *IssuanceRequestQuery {
+	return &IssuanceRequestQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a IssuanceRequest entity by its id.
+func (c *IssuanceRequestClient) Get(ctx context.Context, id string) (*IssuanceRequest, error) {
+	return c.Query().Where(issuancerequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IssuanceRequestClient) GetX(ctx context.Context, id string) *IssuanceRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEvents queries the events edge of a IssuanceRequest.
+func (c *IssuanceRequestClient) QueryEvents(ir *IssuanceRequest) *IssuanceRequestEventQuery {
+	query := &IssuanceRequestEventQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ir.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(issuancerequest.Table, issuancerequest.FieldID, id),
+			sqlgraph.To(issuancerequestevent.Table, issuancerequestevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, issuancerequest.EventsTable, issuancerequest.EventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ir.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCredential queries the credential edge of a IssuanceRequest.
+func (c *IssuanceRequestClient) QueryCredential(ir *IssuanceRequest) *CredentialQuery {
+	query := &CredentialQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ir.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(issuancerequest.Table, issuancerequest.FieldID, id),
+			sqlgraph.To(credential.Table, credential.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, issuancerequest.CredentialTable, issuancerequest.CredentialColumn),
+		)
+		fromV = sqlgraph.Neighbors(ir.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *IssuanceRequestClient) Hooks() []Hook {
+	return c.hooks.IssuanceRequest
+}
+
+// IssuanceRequestEventClient is a client for the IssuanceRequestEvent schema.
+type IssuanceRequestEventClient struct {
+	config
+}
+
+// NewIssuanceRequestEventClient returns a client for the IssuanceRequestEvent from the given config.
+func NewIssuanceRequestEventClient(c config) *IssuanceRequestEventClient {
+	return &IssuanceRequestEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `issuancerequestevent.Hooks(f(g(h())))`.
+func (c *IssuanceRequestEventClient) Use(hooks ...Hook) {
+	c.hooks.IssuanceRequestEvent = append(c.hooks.IssuanceRequestEvent, hooks...)
+}
+
+// Create returns a builder for creating a IssuanceRequestEvent entity.
+func (c *IssuanceRequestEventClient) Create() *IssuanceRequestEventCreate {
+	mutation := newIssuanceRequestEventMutation(c.config, OpCreate)
+	return &IssuanceRequestEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IssuanceRequestEvent entities.
+func (c *IssuanceRequestEventClient) CreateBulk(builders ...*IssuanceRequestEventCreate) *IssuanceRequestEventCreateBulk {
+	return &IssuanceRequestEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IssuanceRequestEvent.
+func (c *IssuanceRequestEventClient) Update() *IssuanceRequestEventUpdate {
+	mutation := newIssuanceRequestEventMutation(c.config, OpUpdate)
+	return &IssuanceRequestEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IssuanceRequestEventClient) UpdateOne(ire *IssuanceRequestEvent) *IssuanceRequestEventUpdateOne {
+	mutation := newIssuanceRequestEventMutation(c.config, OpUpdateOne, withIssuanceRequestEvent(ire))
+	return &IssuanceRequestEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IssuanceRequestEventClient) UpdateOneID(id int) *IssuanceRequestEventUpdateOne {
+	mutation := newIssuanceRequestEventMutation(c.config, OpUpdateOne, withIssuanceRequestEventID(id))
+	return &IssuanceRequestEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IssuanceRequestEvent.
+func (c *IssuanceRequestEventClient) Delete() *IssuanceRequestEventDelete {
+	mutation := newIssuanceRequestEventMutation(c.config, OpDelete)
+	return &IssuanceRequestEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IssuanceRequestEventClient) DeleteOne(ire *IssuanceRequestEvent) *IssuanceRequestEventDeleteOne {
+	return c.DeleteOneID(ire.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *IssuanceRequestEventClient) DeleteOneID(id int) *IssuanceRequestEventDeleteOne {
+	builder := c.Delete().Where(issuancerequestevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IssuanceRequestEventDeleteOne{builder}
+}
+
+// Query returns a query builder for IssuanceRequestEvent.
+func (c *IssuanceRequestEventClient) Query() *IssuanceRequestEventQuery {
+	return &IssuanceRequestEventQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a IssuanceRequestEvent entity by its id.
+func (c *IssuanceRequestEventClient) Get(ctx context.Context, id int) (*IssuanceRequestEvent, error) {
+	return c.Query().Where(issuancerequestevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IssuanceRequestEventClient) GetX(ctx context.Context, id int) *IssuanceRequestEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRequest queries the request edge of a IssuanceRequestEvent.
+func (c *IssuanceRequestEventClient) QueryRequest(ire *IssuanceRequestEvent) *IssuanceRequestQuery {
+	query := &IssuanceRequestQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ire.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(credential.Table, credential.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, credential.AccountTable, credential.AccountColumn),
+			sqlgraph.From(issuancerequestevent.Table, issuancerequestevent.FieldID, id),
+			sqlgraph.To(issuancerequest.Table, issuancerequest.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, issuancerequestevent.RequestTable, issuancerequestevent.RequestColumn),
 		)
-		fromV = sqlgraph.Neighbors(cr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ire.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *CredentialClient) Hooks() []Hook {
-	return c.hooks.Credential
+func (c *IssuanceRequestEventClient) Hooks() []Hook {
+	return c.hooks.IssuanceRequestEvent
 }
 
-// DIDClient is a client for the DID schema.
-type DIDClient struct {
+// LoginSessionClient is a client for the LoginSession schema.
+type LoginSessionClient struct {
 	config
 }
 
-// NewDIDClient returns a client for the DID from the given config.
-func NewDIDClient(c config) *DIDClient {
-	return &DIDClient{config: c}
+// NewLoginSessionClient returns a client for the LoginSession from the given config.
+func NewLoginSessionClient(c config) *LoginSessionClient {
+	return &LoginSessionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `did.Hooks(f(g(h())))`.
-func (c *DIDClient) Use(hooks ...Hook) {
-	c.hooks.DID = append(c.hooks.DID, hooks...)
+// A call to `Use(f, g, h)` equals to `loginsession.Hooks(f(g(h())))`.
+func (c *LoginSessionClient) Use(hooks ...Hook) {
+	c.hooks.LoginSession = append(c.hooks.LoginSession, hooks...)
 }
 
-// Create returns a builder for creating a DID entity.
-func (c *DIDClient) Create() *DIDCreate {
-	mutation := newDIDMutation(c.config, OpCreate)
-	return &DIDCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a LoginSession entity.
+func (c *LoginSessionClient) Create() *LoginSessionCreate {
+	mutation := newLoginSessionMutation(c.config, OpCreate)
+	return &LoginSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of DID entities.
-func (c *DIDClient) CreateBulk(builders ...*DIDCreate) *DIDCreateBulk {
-	return &DIDCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of LoginSession entities.
+func (c *LoginSessionClient) CreateBulk(builders ...*LoginSessionCreate) *LoginSessionCreateBulk {
+	return &LoginSessionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for DID.
-func (c *DIDClient) Update() *DIDUpdate {
-	mutation := newDIDMutation(c.config, OpUpdate)
-	return &DIDUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for LoginSession.
+func (c *LoginSessionClient) Update() *LoginSessionUpdate {
+	mutation := newLoginSessionMutation(c.config, OpUpdate)
+	return &LoginSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DIDClient) UpdateOne(d *DID) *DIDUpdateOne {
-	mutation := newDIDMutation(c.config, OpUpdateOne, withDID(d))
-	return &DIDUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LoginSessionClient) UpdateOne(ls *LoginSession) *LoginSessionUpdateOne {
+	mutation := newLoginSessionMutation(c.config, OpUpdateOne, withLoginSession(ls))
+	return &LoginSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DIDClient) UpdateOneID(id string) *DIDUpdateOne {
-	mutation := newDIDMutation(c.config, OpUpdateOne, withDIDID(id))
-	return &DIDUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LoginSessionClient) UpdateOneID(id string) *LoginSessionUpdateOne {
+	mutation := newLoginSessionMutation(c.config, OpUpdateOne, withLoginSessionID(id))
+	return &LoginSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DID.
-func (c *DIDClient) Delete() *DIDDelete {
-	mutation := newDIDMutation(c.config, OpDelete)
-	return &DIDDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for LoginSession.
+func (c *LoginSessionClient) Delete() *LoginSessionDelete {
+	mutation := newLoginSessionMutation(c.config, OpDelete)
+	return &LoginSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DIDClient) DeleteOne(d *DID) *DIDDeleteOne {
-	return c.DeleteOneID(d.ID)
+func (c *LoginSessionClient) DeleteOne(ls *LoginSession) *LoginSessionDeleteOne {
+	return c.DeleteOneID(ls.ID)
 }
 
 // DeleteOne returns a builder for deleting the given entity by its id.
-func (c *DIDClient) DeleteOneID(id string) *DIDDeleteOne {
-	builder := c.Delete().Where(did.ID(id))
+func (c *LoginSessionClient) DeleteOneID(id string) *LoginSessionDeleteOne {
+	builder := c.Delete().Where(loginsession.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DIDDeleteOne{builder}
+	return &LoginSessionDeleteOne{builder}
 }
 
-// Query returns a query builder for DID.
-func (c *DIDClient) Query() *DIDQuery {
-	return &DIDQuery{
+// Query returns a query builder for LoginSession.
+func (c *LoginSessionClient) Query() *LoginSessionQuery {
+	return &LoginSessionQuery{
 		config: c.config,
 	}
 }
 
-// Get returns a DID entity by its id.
-func (c *DIDClient) Get(ctx context.Context, id string) (*DID, error) {
-	return c.Query().Where(did.ID(id)).Only(ctx)
+// Get returns a LoginSession entity by its id.
+func (c *LoginSessionClient) Get(ctx context.Context, id string) (*LoginSession, error) {
+	return c.Query().Where(loginsession.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DIDClient) GetX(ctx context.Context, id string) *DID {
+func (c *LoginSessionClient) GetX(ctx context.Context, id string) *LoginSession {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -348,25 +1086,9 @@ func (c *DIDClient) GetX(ctx context.Context, id string) *DID {
 	return obj
 }
 
-// QueryUser queries the user edge of a DID.
-func (c *DIDClient) QueryUser(d *DID) *UserQuery {
-	query := &UserQuery{config: c.config}
-	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
-		id := d.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(did.Table, did.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, did.UserTable, did.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *DIDClient) Hooks() []Hook {
-	return c.hooks.DID
+func (c *LoginSessionClient) Hooks() []Hook {
+	return c.hooks.LoginSession
 }
 
 // NaturalPersonClient is a client for the NaturalPerson schema.
@@ -491,6 +1213,96 @@ func (c *NaturalPersonClient) Hooks() []Hook {
 	return c.hooks.NaturalPerson
 }
 
+// PresentationNonceClient is a client for the PresentationNonce schema.
+type PresentationNonceClient struct {
+	config
+}
+
+// NewPresentationNonceClient returns a client for the PresentationNonce from the given config.
+func NewPresentationNonceClient(c config) *PresentationNonceClient {
+	return &PresentationNonceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `presentationnonce.Hooks(f(g(h())))`.
+func (c *PresentationNonceClient) Use(hooks ...Hook) {
+	c.hooks.PresentationNonce = append(c.hooks.PresentationNonce, hooks...)
+}
+
+// Create returns a builder for creating a PresentationNonce entity.
+func (c *PresentationNonceClient) Create() *PresentationNonceCreate {
+	mutation := newPresentationNonceMutation(c.config, OpCreate)
+	return &PresentationNonceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PresentationNonce entities.
+func (c *PresentationNonceClient) CreateBulk(builders ...*PresentationNonceCreate) *PresentationNonceCreateBulk {
+	return &PresentationNonceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PresentationNonce.
+func (c *PresentationNonceClient) Update() *PresentationNonceUpdate {
+	mutation := newPresentationNonceMutation(c.config, OpUpdate)
+	return &PresentationNonceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PresentationNonceClient) UpdateOne(pn *PresentationNonce) *PresentationNonceUpdateOne {
+	mutation := newPresentationNonceMutation(c.config, OpUpdateOne, withPresentationNonce(pn))
+	return &PresentationNonceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PresentationNonceClient) UpdateOneID(id string) *PresentationNonceUpdateOne {
+	mutation := newPresentationNonceMutation(c.config, OpUpdateOne, withPresentationNonceID(id))
+	return &PresentationNonceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PresentationNonce.
+func (c *PresentationNonceClient) Delete() *PresentationNonceDelete {
+	mutation := newPresentationNonceMutation(c.config, OpDelete)
+	return &PresentationNonceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PresentationNonceClient) DeleteOne(pn *PresentationNonce) *PresentationNonceDeleteOne {
+	return c.DeleteOneID(pn.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *PresentationNonceClient) DeleteOneID(id string) *PresentationNonceDeleteOne {
+	builder := c.Delete().Where(presentationnonce.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PresentationNonceDeleteOne{builder}
+}
+
+// Query returns a query builder for PresentationNonce.
+func (c *PresentationNonceClient) Query() *PresentationNonceQuery {
+	return &PresentationNonceQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a PresentationNonce entity by its id.
+func (c *PresentationNonceClient) Get(ctx context.Context, id string) (*PresentationNonce, error) {
+	return c.Query().Where(presentationnonce.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PresentationNonceClient) GetX(ctx context.Context, id string) *PresentationNonce {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PresentationNonceClient) Hooks() []Hook {
+	return c.hooks.PresentationNonce
+}
+
 // PrivateKeyClient is a client for the PrivateKey schema.
 type PrivateKeyClient struct {
 	config
@@ -687,6 +1499,276 @@ func (c *PublicKeyClient) Hooks() []Hook {
 	return c.hooks.PublicKey
 }
 
+// RateCounterClient is a client for the RateCounter schema.
+type RateCounterClient struct {
+	config
+}
+
+// NewRateCounterClient returns a client for the RateCounter from the given config.
+func NewRateCounterClient(c config) *RateCounterClient {
+	return &RateCounterClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ratecounter.Hooks(f(g(h())))`.
+func (c *RateCounterClient) Use(hooks ...Hook) {
+	c.hooks.RateCounter = append(c.hooks.RateCounter, hooks...)
+}
+
+// Create returns a builder for creating a RateCounter entity.
+func (c *RateCounterClient) Create() *RateCounterCreate {
+	mutation := newRateCounterMutation(c.config, OpCreate)
+	return &RateCounterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RateCounter entities.
+func (c *RateCounterClient) CreateBulk(builders ...*RateCounterCreate) *RateCounterCreateBulk {
+	return &RateCounterCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RateCounter.
+func (c *RateCounterClient) Update() *RateCounterUpdate {
+	mutation := newRateCounterMutation(c.config, OpUpdate)
+	return &RateCounterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RateCounterClient) UpdateOne(rc *RateCounter) *RateCounterUpdateOne {
+	mutation := newRateCounterMutation(c.config, OpUpdateOne, withRateCounter(rc))
+	return &RateCounterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RateCounterClient) UpdateOneID(id string) *RateCounterUpdateOne {
+	mutation := newRateCounterMutation(c.config, OpUpdateOne, withRateCounterID(id))
+	return &RateCounterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RateCounter.
+func (c *RateCounterClient) Delete() *RateCounterDelete {
+	mutation := newRateCounterMutation(c.config, OpDelete)
+	return &RateCounterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RateCounterClient) DeleteOne(rc *RateCounter) *RateCounterDeleteOne {
+	return c.DeleteOneID(rc.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *RateCounterClient) DeleteOneID(id string) *RateCounterDeleteOne {
+	builder := c.Delete().Where(ratecounter.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RateCounterDeleteOne{builder}
+}
+
+// Query returns a query builder for RateCounter.
+func (c *RateCounterClient) Query() *RateCounterQuery {
+	return &RateCounterQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a RateCounter entity by its id.
+func (c *RateCounterClient) Get(ctx context.Context, id string) (*RateCounter, error) {
+	return c.Query().Where(ratecounter.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RateCounterClient) GetX(ctx context.Context, id string) *RateCounter {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RateCounterClient) Hooks() []Hook {
+	return c.hooks.RateCounter
+}
+
+// RelyingPartyClient is a client for the RelyingParty schema.
+type RelyingPartyClient struct {
+	config
+}
+
+// NewRelyingPartyClient returns a client for the RelyingParty from the given config.
+func NewRelyingPartyClient(c config) *RelyingPartyClient {
+	return &RelyingPartyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `relyingparty.Hooks(f(g(h())))`.
+func (c *RelyingPartyClient) Use(hooks ...Hook) {
+	c.hooks.RelyingParty = append(c.hooks.RelyingParty, hooks...)
+}
+
+// Create returns a builder for creating a RelyingParty entity.
+func (c *RelyingPartyClient) Create() *RelyingPartyCreate {
+	mutation := newRelyingPartyMutation(c.config, OpCreate)
+	return &RelyingPartyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RelyingParty entities.
+func (c *RelyingPartyClient) CreateBulk(builders ...*RelyingPartyCreate) *RelyingPartyCreateBulk {
+	return &RelyingPartyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RelyingParty.
+func (c *RelyingPartyClient) Update() *RelyingPartyUpdate {
+	mutation := newRelyingPartyMutation(c.config, OpUpdate)
+	return &RelyingPartyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RelyingPartyClient) UpdateOne(rp *RelyingParty) *RelyingPartyUpdateOne {
+	mutation := newRelyingPartyMutation(c.config, OpUpdateOne, withRelyingParty(rp))
+	return &RelyingPartyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RelyingPartyClient) UpdateOneID(id string) *RelyingPartyUpdateOne {
+	mutation := newRelyingPartyMutation(c.config, OpUpdateOne, withRelyingPartyID(id))
+	return &RelyingPartyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RelyingParty.
+func (c *RelyingPartyClient) Delete() *RelyingPartyDelete {
+	mutation := newRelyingPartyMutation(c.config, OpDelete)
+	return &RelyingPartyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RelyingPartyClient) DeleteOne(rp *RelyingParty) *RelyingPartyDeleteOne {
+	return c.DeleteOneID(rp.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *RelyingPartyClient) DeleteOneID(id string) *RelyingPartyDeleteOne {
+	builder := c.Delete().Where(relyingparty.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RelyingPartyDeleteOne{builder}
+}
+
+// Query returns a query builder for RelyingParty.
+func (c *RelyingPartyClient) Query() *RelyingPartyQuery {
+	return &RelyingPartyQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a RelyingParty entity by its id.
+func (c *RelyingPartyClient) Get(ctx context.Context, id string) (*RelyingParty, error) {
+	return c.Query().Where(relyingparty.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RelyingPartyClient) GetX(ctx context.Context, id string) *RelyingParty {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RelyingPartyClient) Hooks() []Hook {
+	return c.hooks.RelyingParty
+}
+
+// TrustedIssuerClient is a client for the TrustedIssuer schema.
+type TrustedIssuerClient struct {
+	config
+}
+
+// NewTrustedIssuerClient returns a client for the TrustedIssuer from the given config.
+func NewTrustedIssuerClient(c config) *TrustedIssuerClient {
+	return &TrustedIssuerClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `trustedissuer.Hooks(f(g(h())))`.
+func (c *TrustedIssuerClient) Use(hooks ...Hook) {
+	c.hooks.TrustedIssuer = append(c.hooks.TrustedIssuer, hooks...)
+}
+
+// Create returns a builder for creating a TrustedIssuer entity.
+func (c *TrustedIssuerClient) Create() *TrustedIssuerCreate {
+	mutation := newTrustedIssuerMutation(c.config, OpCreate)
+	return &TrustedIssuerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TrustedIssuer entities.
+func (c *TrustedIssuerClient) CreateBulk(builders ...*TrustedIssuerCreate) *TrustedIssuerCreateBulk {
+	return &TrustedIssuerCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TrustedIssuer.
+func (c *TrustedIssuerClient) Update() *TrustedIssuerUpdate {
+	mutation := newTrustedIssuerMutation(c.config, OpUpdate)
+	return &TrustedIssuerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TrustedIssuerClient) UpdateOne(ti *TrustedIssuer) *TrustedIssuerUpdateOne {
+	mutation := newTrustedIssuerMutation(c.config, OpUpdateOne, withTrustedIssuer(ti))
+	return &TrustedIssuerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TrustedIssuerClient) UpdateOneID(id string) *TrustedIssuerUpdateOne {
+	mutation := newTrustedIssuerMutation(c.config, OpUpdateOne, withTrustedIssuerID(id))
+	return &TrustedIssuerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TrustedIssuer.
+func (c *TrustedIssuerClient) Delete() *TrustedIssuerDelete {
+	mutation := newTrustedIssuerMutation(c.config, OpDelete)
+	return &TrustedIssuerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TrustedIssuerClient) DeleteOne(ti *TrustedIssuer) *TrustedIssuerDeleteOne {
+	return c.DeleteOneID(ti.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *TrustedIssuerClient) DeleteOneID(id string) *TrustedIssuerDeleteOne {
+	builder := c.Delete().Where(trustedissuer.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TrustedIssuerDeleteOne{builder}
+}
+
+// Query returns a query builder for TrustedIssuer.
+func (c *TrustedIssuerClient) Query() *TrustedIssuerQuery {
+	return &TrustedIssuerQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a TrustedIssuer entity by its id.
+func (c *TrustedIssuerClient) Get(ctx context.Context, id string) (*TrustedIssuer, error) {
+	return c.Query().Where(trustedissuer.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TrustedIssuerClient) GetX(ctx context.Context, id string) *TrustedIssuer {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TrustedIssuerClient) Hooks() []Hook {
+	return c.hooks.TrustedIssuer
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
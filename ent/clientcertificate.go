@@ -0,0 +1,133 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/hesusruiz/vcbackend/ent/clientcertificate"
+)
+
+// ClientCertificate is the model entity for the ClientCertificate schema.
+type ClientCertificate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// User holds the value of the "user" field.
+	User string `json:"user,omitempty"`
+	// Subject holds the value of the "subject" field.
+	Subject string `json:"subject,omitempty"`
+	// NotAfter holds the value of the "not_after" field.
+	NotAfter time.Time `json:"not_after,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ClientCertificate) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case clientcertificate.FieldID, clientcertificate.FieldUser, clientcertificate.FieldSubject:
+			values[i] = new(sql.NullString)
+		case clientcertificate.FieldNotAfter, clientcertificate.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ClientCertificate", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ClientCertificate fields.
+func (cc *ClientCertificate) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case clientcertificate.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				cc.ID = value.String
+			}
+		case clientcertificate.FieldUser:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user", values[i])
+			} else if value.Valid {
+				cc.User = value.String
+			}
+		case clientcertificate.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				cc.Subject = value.String
+			}
+		case clientcertificate.FieldNotAfter:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field not_after", values[i])
+			} else if value.Valid {
+				cc.NotAfter = value.Time
+			}
+		case clientcertificate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cc.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this ClientCertificate.
+// Note that you need to call ClientCertificate.Unwrap() before calling this method if this ClientCertificate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cc *ClientCertificate) Update() *ClientCertificateUpdateOne {
+	return (&ClientCertificateClient{config: cc.config}).UpdateOne(cc)
+}
+
+// Unwrap unwraps the ClientCertificate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cc *ClientCertificate) Unwrap() *ClientCertificate {
+	_tx, ok := cc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ClientCertificate is not a transactional entity")
+	}
+	cc.config.driver = _tx.drv
+	return cc
+}
+
+// String implements the fmt.Stringer.
+func (cc *ClientCertificate) String() string {
+	var builder strings.Builder
+	builder.WriteString("ClientCertificate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cc.ID))
+	builder.WriteString("user=")
+	builder.WriteString(cc.User)
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(cc.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("not_after=")
+	builder.WriteString(cc.NotAfter.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cc.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ClientCertificates is a parsable slice of ClientCertificate.
+type ClientCertificates []*ClientCertificate
+
+func (cc ClientCertificates) config(cfg config) {
+	for _i := range cc {
+		cc[_i].config = cfg
+	}
+}
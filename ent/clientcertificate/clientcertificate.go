@@ -0,0 +1,48 @@
+// Code generated by ent, DO NOT EDIT.
+
+package clientcertificate
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the clientcertificate type in the database.
+	Label = "client_certificate"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUser holds the string denoting the user field in the database.
+	FieldUser = "user"
+	// FieldSubject holds the string denoting the subject field in the database.
+	FieldSubject = "subject"
+	// FieldNotAfter holds the string denoting the not_after field in the database.
+	FieldNotAfter = "not_after"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the clientcertificate in the database.
+	Table = "client_certificates"
+)
+
+// Columns holds all SQL columns for clientcertificate fields.
+var Columns = []string{
+	FieldID,
+	FieldUser,
+	FieldSubject,
+	FieldNotAfter,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
@@ -0,0 +1,312 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/clientcertificate"
+)
+
+// ClientCertificateCreate is the builder for creating a ClientCertificate entity.
+type ClientCertificateCreate struct {
+	config
+	mutation *ClientCertificateMutation
+	hooks    []Hook
+}
+
+// SetUser sets the "user" field.
+func (ccc *ClientCertificateCreate) SetUser(s string) *ClientCertificateCreate {
+	ccc.mutation.SetUser(s)
+	return ccc
+}
+
+// SetSubject sets the "subject" field.
+func (ccc *ClientCertificateCreate) SetSubject(s string) *ClientCertificateCreate {
+	ccc.mutation.SetSubject(s)
+	return ccc
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (ccc *ClientCertificateCreate) SetNillableSubject(s *string) *ClientCertificateCreate {
+	if s != nil {
+		ccc.SetSubject(*s)
+	}
+	return ccc
+}
+
+// SetNotAfter sets the "not_after" field.
+func (ccc *ClientCertificateCreate) SetNotAfter(t time.Time) *ClientCertificateCreate {
+	ccc.mutation.SetNotAfter(t)
+	return ccc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ccc *ClientCertificateCreate) SetCreatedAt(t time.Time) *ClientCertificateCreate {
+	ccc.mutation.SetCreatedAt(t)
+	return ccc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ccc *ClientCertificateCreate) SetNillableCreatedAt(t *time.Time) *ClientCertificateCreate {
+	if t != nil {
+		ccc.SetCreatedAt(*t)
+	}
+	return ccc
+}
+
+// SetID sets the "id" field.
+func (ccc *ClientCertificateCreate) SetID(s string) *ClientCertificateCreate {
+	ccc.mutation.SetID(s)
+	return ccc
+}
+
+// Mutation returns the ClientCertificateMutation object of the builder.
+func (ccc *ClientCertificateCreate) Mutation() *ClientCertificateMutation {
+	return ccc.mutation
+}
+
+// Save creates the ClientCertificate in the database.
+func (ccc *ClientCertificateCreate) Save(ctx context.Context) (*ClientCertificate, error) {
+	var (
+		err  error
+		node *ClientCertificate
+	)
+	ccc.defaults()
+	if len(ccc.hooks) == 0 {
+		if err = ccc.check(); err != nil {
+			return nil, err
+		}
+		node, err = ccc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ClientCertificateMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ccc.check(); err != nil {
+				return nil, err
+			}
+			ccc.mutation = mutation
+			if node, err = ccc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ccc.hooks) - 1; i >= 0; i-- {
+			if ccc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ccc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ccc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ClientCertificate)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ClientCertificateMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ccc *ClientCertificateCreate) SaveX(ctx context.Context) *ClientCertificate {
+	v, err := ccc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccc *ClientCertificateCreate) Exec(ctx context.Context) error {
+	_, err := ccc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccc *ClientCertificateCreate) ExecX(ctx context.Context) {
+	if err := ccc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ccc *ClientCertificateCreate) defaults() {
+	if _, ok := ccc.mutation.CreatedAt(); !ok {
+		v := clientcertificate.DefaultCreatedAt()
+		ccc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ccc *ClientCertificateCreate) check() error {
+	if _, ok := ccc.mutation.User(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required field "ClientCertificate.user"`)}
+	}
+	if _, ok := ccc.mutation.NotAfter(); !ok {
+		return &ValidationError{Name: "not_after", err: errors.New(`ent: missing required field "ClientCertificate.not_after"`)}
+	}
+	if _, ok := ccc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ClientCertificate.created_at"`)}
+	}
+	return nil
+}
+
+func (ccc *ClientCertificateCreate) sqlSave(ctx context.Context) (*ClientCertificate, error) {
+	_node, _spec := ccc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ccc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ClientCertificate.ID type: %T", _spec.ID.Value)
+		}
+	}
+	return _node, nil
+}
+
+func (ccc *ClientCertificateCreate) createSpec() (*ClientCertificate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ClientCertificate{config: ccc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: clientcertificate.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: clientcertificate.FieldID,
+			},
+		}
+	)
+	if id, ok := ccc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ccc.mutation.User(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: clientcertificate.FieldUser,
+		})
+		_node.User = value
+	}
+	if value, ok := ccc.mutation.Subject(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: clientcertificate.FieldSubject,
+		})
+		_node.Subject = value
+	}
+	if value, ok := ccc.mutation.NotAfter(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: clientcertificate.FieldNotAfter,
+		})
+		_node.NotAfter = value
+	}
+	if value, ok := ccc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: clientcertificate.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// ClientCertificateCreateBulk is the builder for creating many ClientCertificate entities in bulk.
+type ClientCertificateCreateBulk struct {
+	config
+	builders []*ClientCertificateCreate
+}
+
+// Save creates the ClientCertificate entities in the database.
+func (cccb *ClientCertificateCreateBulk) Save(ctx context.Context) ([]*ClientCertificate, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(cccb.builders))
+	nodes := make([]*ClientCertificate, len(cccb.builders))
+	mutators := make([]Mutator, len(cccb.builders))
+	for i := range cccb.builders {
+		func(i int, root context.Context) {
+			builder := cccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ClientCertificateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cccb *ClientCertificateCreateBulk) SaveX(ctx context.Context) []*ClientCertificate {
+	v, err := cccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cccb *ClientCertificateCreateBulk) Exec(ctx context.Context) error {
+	_, err := cccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cccb *ClientCertificateCreateBulk) ExecX(ctx context.Context) {
+	if err := cccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
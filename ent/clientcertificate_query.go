@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/clientcertificate"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+)
+
+// ClientCertificateQuery is the builder for querying ClientCertificate entities.
+type ClientCertificateQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.ClientCertificate
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ClientCertificateQuery builder.
+func (ccq *ClientCertificateQuery) Where(ps ...predicate.ClientCertificate) *ClientCertificateQuery {
+	ccq.predicates = append(ccq.predicates, ps...)
+	return ccq
+}
+
+// Limit adds a limit step to the query.
+func (ccq *ClientCertificateQuery) Limit(limit int) *ClientCertificateQuery {
+	ccq.limit = &limit
+	return ccq
+}
+
+// Offset adds an offset step to the query.
+func (ccq *ClientCertificateQuery) Offset(offset int) *ClientCertificateQuery {
+	ccq.offset = &offset
+	return ccq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ccq *ClientCertificateQuery) Unique(unique bool) *ClientCertificateQuery {
+	ccq.unique = &unique
+	return ccq
+}
+
+// Order adds an order step to the query.
+func (ccq *ClientCertificateQuery) Order(o ...OrderFunc) *ClientCertificateQuery {
+	ccq.order = append(ccq.order, o...)
+	return ccq
+}
+
+// First returns the first ClientCertificate entity from the query.
+// Returns a *NotFoundError when no ClientCertificate was found.
+func (ccq *ClientCertificateQuery) First(ctx context.Context) (*ClientCertificate, error) {
+	nodes, err := ccq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{clientcertificate.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ccq *ClientCertificateQuery) FirstX(ctx context.Context) *ClientCertificate {
+	node, err := ccq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ClientCertificate ID from the query.
+// Returns a *NotFoundError when no ClientCertificate ID was found.
+func (ccq *ClientCertificateQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = ccq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{clientcertificate.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ccq *ClientCertificateQuery) FirstIDX(ctx context.Context) string {
+	id, err := ccq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ClientCertificate entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ClientCertificate entity is found.
+// Returns a *NotFoundError when no ClientCertificate entities are found.
+func (ccq *ClientCertificateQuery) Only(ctx context.Context) (*ClientCertificate, error) {
+	nodes, err := ccq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{clientcertificate.Label}
+	default:
+		return nil, &NotSingularError{clientcertificate.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ccq *ClientCertificateQuery) OnlyX(ctx context.Context) *ClientCertificate {
+	node, err := ccq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ClientCertificate ID in the query.
+// Returns a *NotSingularError when more than one ClientCertificate ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ccq *ClientCertificateQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = ccq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{clientcertificate.Label}
+	default:
+		err = &NotSingularError{clientcertificate.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ccq *ClientCertificateQuery) OnlyIDX(ctx context.Context) string {
+	id, err := ccq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ClientCertificates.
+func (ccq *ClientCertificateQuery) All(ctx context.Context) ([]*ClientCertificate, error) {
+	if err := ccq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return ccq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ccq *ClientCertificateQuery) AllX(ctx context.Context) []*ClientCertificate {
+	nodes, err := ccq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ClientCertificate IDs.
+func (ccq *ClientCertificateQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := ccq.Select(clientcertificate.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ccq *ClientCertificateQuery) IDsX(ctx context.Context) []string {
+	ids, err := ccq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ccq *ClientCertificateQuery) Count(ctx context.Context) (int, error) {
+	if err := ccq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return ccq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ccq *ClientCertificateQuery) CountX(ctx context.Context) int {
+	count, err := ccq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ccq *ClientCertificateQuery) Exist(ctx context.Context) (bool, error) {
+	if err := ccq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return ccq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ccq *ClientCertificateQuery) ExistX(ctx context.Context) bool {
+	exist, err := ccq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ClientCertificateQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ccq *ClientCertificateQuery) Clone() *ClientCertificateQuery {
+	if ccq == nil {
+		return nil
+	}
+	return &ClientCertificateQuery{
+		config:     ccq.config,
+		limit:      ccq.limit,
+		offset:     ccq.offset,
+		order:      append([]OrderFunc{}, ccq.order...),
+		predicates: append([]predicate.ClientCertificate{}, ccq.predicates...),
+		// clone intermediate query.
+		sql:    ccq.sql.Clone(),
+		path:   ccq.path,
+		unique: ccq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		User string `json:"user,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ClientCertificate.Query().
+//		GroupBy(clientcertificate.FieldUser).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ccq *ClientCertificateQuery) GroupBy(field string, fields ...string) *ClientCertificateGroupBy {
+	grbuild := &ClientCertificateGroupBy{config: ccq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ccq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ccq.sqlQuery(ctx), nil
+	}
+	grbuild.label = clientcertificate.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		User string `json:"user,omitempty"`
+//	}
+//
+//	client.ClientCertificate.Query().
+//		Select(clientcertificate.FieldUser).
+//		Scan(ctx, &v)
+func (ccq *ClientCertificateQuery) Select(fields ...string) *ClientCertificateSelect {
+	ccq.fields = append(ccq.fields, fields...)
+	selbuild := &ClientCertificateSelect{ClientCertificateQuery: ccq}
+	selbuild.label = clientcertificate.Label
+	selbuild.flds, selbuild.scan = &ccq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (ccq *ClientCertificateQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range ccq.fields {
+		if !clientcertificate.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ccq.path != nil {
+		prev, err := ccq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ccq.sql = prev
+	}
+	return nil
+}
+
+func (ccq *ClientCertificateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ClientCertificate, error) {
+	var (
+		nodes = []*ClientCertificate{}
+		_spec = ccq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*ClientCertificate).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &ClientCertificate{config: ccq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ccq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ccq *ClientCertificateQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ccq.querySpec()
+	_spec.Node.Columns = ccq.fields
+	if len(ccq.fields) > 0 {
+		_spec.Unique = ccq.unique != nil && *ccq.unique
+	}
+	return sqlgraph.CountNodes(ctx, ccq.driver, _spec)
+}
+
+func (ccq *ClientCertificateQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := ccq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (ccq *ClientCertificateQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   clientcertificate.Table,
+			Columns: clientcertificate.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: clientcertificate.FieldID,
+			},
+		},
+		From:   ccq.sql,
+		Unique: true,
+	}
+	if unique := ccq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := ccq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, clientcertificate.FieldID)
+		for i := range fields {
+			if fields[i] != clientcertificate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ccq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ccq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ccq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ccq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ccq *ClientCertificateQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ccq.driver.Dialect())
+	t1 := builder.Table(clientcertificate.Table)
+	columns := ccq.fields
+	if len(columns) == 0 {
+		columns = clientcertificate.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ccq.sql != nil {
+		selector = ccq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ccq.unique != nil && *ccq.unique {
+		selector.Distinct()
+	}
+	for _, p := range ccq.predicates {
+		p(selector)
+	}
+	for _, p := range ccq.order {
+		p(selector)
+	}
+	if offset := ccq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ccq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ClientCertificateGroupBy is the group-by builder for ClientCertificate entities.
+type ClientCertificateGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ccgb *ClientCertificateGroupBy) Aggregate(fns ...AggregateFunc) *ClientCertificateGroupBy {
+	ccgb.fns = append(ccgb.fns, fns...)
+	return ccgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (ccgb *ClientCertificateGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := ccgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	ccgb.sql = query
+	return ccgb.sqlScan(ctx, v)
+}
+
+func (ccgb *ClientCertificateGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range ccgb.fields {
+		if !clientcertificate.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := ccgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ccgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ccgb *ClientCertificateGroupBy) sqlQuery() *sql.Selector {
+	selector := ccgb.sql.Select()
+	aggregation := make([]string, 0, len(ccgb.fns))
+	for _, fn := range ccgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(ccgb.fields)+len(ccgb.fns))
+		for _, f := range ccgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(ccgb.fields...)...)
+}
+
+// ClientCertificateSelect is the builder for selecting fields of ClientCertificate entities.
+type ClientCertificateSelect struct {
+	*ClientCertificateQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ccs *ClientCertificateSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ccs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ccs.sql = ccs.ClientCertificateQuery.sqlQuery(ctx)
+	return ccs.sqlScan(ctx, v)
+}
+
+func (ccs *ClientCertificateSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ccs.sql.Query()
+	if err := ccs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,364 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/clientcertificate"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+)
+
+// ClientCertificateUpdate is the builder for updating ClientCertificate entities.
+type ClientCertificateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ClientCertificateMutation
+}
+
+// Where appends a list predicates to the ClientCertificateUpdate builder.
+func (ccu *ClientCertificateUpdate) Where(ps ...predicate.ClientCertificate) *ClientCertificateUpdate {
+	ccu.mutation.Where(ps...)
+	return ccu
+}
+
+// SetUser sets the "user" field.
+func (ccu *ClientCertificateUpdate) SetUser(s string) *ClientCertificateUpdate {
+	ccu.mutation.SetUser(s)
+	return ccu
+}
+
+// SetSubject sets the "subject" field.
+func (ccu *ClientCertificateUpdate) SetSubject(s string) *ClientCertificateUpdate {
+	ccu.mutation.SetSubject(s)
+	return ccu
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (ccu *ClientCertificateUpdate) SetNillableSubject(s *string) *ClientCertificateUpdate {
+	if s != nil {
+		ccu.SetSubject(*s)
+	}
+	return ccu
+}
+
+// ClearSubject clears the value of the "subject" field.
+func (ccu *ClientCertificateUpdate) ClearSubject() *ClientCertificateUpdate {
+	ccu.mutation.ClearSubject()
+	return ccu
+}
+
+// SetNotAfter sets the "not_after" field.
+func (ccu *ClientCertificateUpdate) SetNotAfter(t time.Time) *ClientCertificateUpdate {
+	ccu.mutation.SetNotAfter(t)
+	return ccu
+}
+
+// Mutation returns the ClientCertificateMutation object of the builder.
+func (ccu *ClientCertificateUpdate) Mutation() *ClientCertificateMutation {
+	return ccu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ccu *ClientCertificateUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ccu.hooks) == 0 {
+		affected, err = ccu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ClientCertificateMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ccu.mutation = mutation
+			affected, err = ccu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ccu.hooks) - 1; i >= 0; i-- {
+			if ccu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ccu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ccu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccu *ClientCertificateUpdate) SaveX(ctx context.Context) int {
+	affected, err := ccu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ccu *ClientCertificateUpdate) Exec(ctx context.Context) error {
+	_, err := ccu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccu *ClientCertificateUpdate) ExecX(ctx context.Context) {
+	if err := ccu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ccu *ClientCertificateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   clientcertificate.Table,
+			Columns: clientcertificate.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: clientcertificate.FieldID,
+			},
+		},
+	}
+	if ps := ccu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ccu.mutation.User(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: clientcertificate.FieldUser,
+		})
+	}
+	if value, ok := ccu.mutation.Subject(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: clientcertificate.FieldSubject,
+		})
+	}
+	if ccu.mutation.SubjectCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: clientcertificate.FieldSubject,
+		})
+	}
+	if value, ok := ccu.mutation.NotAfter(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: clientcertificate.FieldNotAfter,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ccu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{clientcertificate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ClientCertificateUpdateOne is the builder for updating a single ClientCertificate entity.
+type ClientCertificateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ClientCertificateMutation
+}
+
+// SetUser sets the "user" field.
+func (ccuo *ClientCertificateUpdateOne) SetUser(s string) *ClientCertificateUpdateOne {
+	ccuo.mutation.SetUser(s)
+	return ccuo
+}
+
+// SetSubject sets the "subject" field.
+func (ccuo *ClientCertificateUpdateOne) SetSubject(s string) *ClientCertificateUpdateOne {
+	ccuo.mutation.SetSubject(s)
+	return ccuo
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (ccuo *ClientCertificateUpdateOne) SetNillableSubject(s *string) *ClientCertificateUpdateOne {
+	if s != nil {
+		ccuo.SetSubject(*s)
+	}
+	return ccuo
+}
+
+// ClearSubject clears the value of the "subject" field.
+func (ccuo *ClientCertificateUpdateOne) ClearSubject() *ClientCertificateUpdateOne {
+	ccuo.mutation.ClearSubject()
+	return ccuo
+}
+
+// SetNotAfter sets the "not_after" field.
+func (ccuo *ClientCertificateUpdateOne) SetNotAfter(t time.Time) *ClientCertificateUpdateOne {
+	ccuo.mutation.SetNotAfter(t)
+	return ccuo
+}
+
+// Mutation returns the ClientCertificateMutation object of the builder.
+func (ccuo *ClientCertificateUpdateOne) Mutation() *ClientCertificateMutation {
+	return ccuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ccuo *ClientCertificateUpdateOne) Select(field string, fields ...string) *ClientCertificateUpdateOne {
+	ccuo.fields = append([]string{field}, fields...)
+	return ccuo
+}
+
+// Save executes the query and returns the updated ClientCertificate entity.
+func (ccuo *ClientCertificateUpdateOne) Save(ctx context.Context) (*ClientCertificate, error) {
+	var (
+		err  error
+		node *ClientCertificate
+	)
+	if len(ccuo.hooks) == 0 {
+		node, err = ccuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ClientCertificateMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ccuo.mutation = mutation
+			node, err = ccuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ccuo.hooks) - 1; i >= 0; i-- {
+			if ccuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ccuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ccuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ClientCertificate)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ClientCertificateMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccuo *ClientCertificateUpdateOne) SaveX(ctx context.Context) *ClientCertificate {
+	node, err := ccuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ccuo *ClientCertificateUpdateOne) Exec(ctx context.Context) error {
+	_, err := ccuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccuo *ClientCertificateUpdateOne) ExecX(ctx context.Context) {
+	if err := ccuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ccuo *ClientCertificateUpdateOne) sqlSave(ctx context.Context) (_node *ClientCertificate, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   clientcertificate.Table,
+			Columns: clientcertificate.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: clientcertificate.FieldID,
+			},
+		},
+	}
+	id, ok := ccuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ClientCertificate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ccuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, clientcertificate.FieldID)
+		for _, f := range fields {
+			if !clientcertificate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != clientcertificate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ccuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ccuo.mutation.User(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: clientcertificate.FieldUser,
+		})
+	}
+	if value, ok := ccuo.mutation.Subject(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: clientcertificate.FieldSubject,
+		})
+	}
+	if ccuo.mutation.SubjectCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: clientcertificate.FieldSubject,
+		})
+	}
+	if value, ok := ccuo.mutation.NotAfter(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: clientcertificate.FieldNotAfter,
+		})
+	}
+	_node = &ClientCertificate{config: ccuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ccuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{clientcertificate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
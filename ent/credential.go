@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/hesusruiz/vcbackend/ent/credential"
+	"github.com/hesusruiz/vcbackend/ent/credentialtemplate"
 	"github.com/hesusruiz/vcbackend/ent/user"
 )
 
@@ -22,24 +23,60 @@ type Credential struct {
 	Type string `json:"type,omitempty"`
 	// Raw holds the value of the "raw" field.
 	Raw []uint8 `json:"raw,omitempty"`
+	// Status holds the value of the "status" field.
+	Status credential.Status `json:"status,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// RevokedAt holds the value of the "revoked_at" field.
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	// RenewalDueAt holds the value of the "renewal_due_at" field.
+	RenewalDueAt *time.Time `json:"renewal_due_at,omitempty"`
+	// RenewalStartedAt holds the value of the "renewal_started_at" field.
+	RenewalStartedAt *time.Time `json:"renewal_started_at,omitempty"`
+	// IssuanceData holds the value of the "issuance_data" field.
+	IssuanceData map[string]interface{} `json:"issuance_data,omitempty"`
+	// Types holds the value of the "types" field.
+	Types []string `json:"types,omitempty"`
+	// CredentialType holds the value of the "credential_type" field.
+	CredentialType string `json:"credential_type,omitempty"`
+	// Issuer holds the value of the "issuer" field.
+	Issuer string `json:"issuer,omitempty"`
+	// Subject holds the value of the "subject" field.
+	Subject string `json:"subject,omitempty"`
+	// HolderEmail holds the value of the "holder_email" field.
+	HolderEmail string `json:"holder_email,omitempty"`
+	// TemplateName holds the value of the "template_name" field.
+	TemplateName string `json:"template_name,omitempty"`
+	// IssuedAt holds the value of the "issued_at" field.
+	IssuedAt *time.Time `json:"issued_at,omitempty"`
+	// IndexedAt holds the value of the "indexed_at" field.
+	IndexedAt *time.Time `json:"indexed_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CredentialQuery when eager-loading is set.
-	Edges                      CredentialEdges `json:"edges"`
-	natural_person_credentials *string
-	user_credentials           *string
+	Edges                           CredentialEdges `json:"edges"`
+	credential_successor            *string
+	credential_template_credentials *string
+	natural_person_credentials      *string
+	user_credentials                *string
 }
 
 // CredentialEdges holds the relations/edges for other nodes in the graph.
 type CredentialEdges struct {
 	// Account holds the value of the account edge.
 	Account *User `json:"account,omitempty"`
+	// Template holds the value of the template edge.
+	Template *CredentialTemplate `json:"template,omitempty"`
+	// Predecessor holds the value of the predecessor edge.
+	Predecessor *Credential `json:"predecessor,omitempty"`
+	// Successor holds the value of the successor edge.
+	Successor *Credential `json:"successor,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [4]bool
 }
 
 // AccountOrErr returns the Account value or an error if the edge
@@ -56,20 +93,66 @@ func (e CredentialEdges) AccountOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "account"}
 }
 
+// TemplateOrErr returns the Template value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CredentialEdges) TemplateOrErr() (*CredentialTemplate, error) {
+	if e.loadedTypes[1] {
+		if e.Template == nil {
+			// The edge template was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: credentialtemplate.Label}
+		}
+		return e.Template, nil
+	}
+	return nil, &NotLoadedError{edge: "template"}
+}
+
+// PredecessorOrErr returns the Predecessor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CredentialEdges) PredecessorOrErr() (*Credential, error) {
+	if e.loadedTypes[2] {
+		if e.Predecessor == nil {
+			// The edge predecessor was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: credential.Label}
+		}
+		return e.Predecessor, nil
+	}
+	return nil, &NotLoadedError{edge: "predecessor"}
+}
+
+// SuccessorOrErr returns the Successor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CredentialEdges) SuccessorOrErr() (*Credential, error) {
+	if e.loadedTypes[3] {
+		if e.Successor == nil {
+			// The edge successor was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: credential.Label}
+		}
+		return e.Successor, nil
+	}
+	return nil, &NotLoadedError{edge: "successor"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Credential) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case credential.FieldRaw:
+		case credential.FieldRaw, credential.FieldIssuanceData, credential.FieldTypes:
 			values[i] = new([]byte)
-		case credential.FieldID, credential.FieldType:
+		case credential.FieldID, credential.FieldType, credential.FieldStatus, credential.FieldCredentialType, credential.FieldIssuer, credential.FieldSubject, credential.FieldHolderEmail, credential.FieldTemplateName:
 			values[i] = new(sql.NullString)
-		case credential.FieldCreatedAt, credential.FieldUpdatedAt:
+		case credential.FieldExpiresAt, credential.FieldRevokedAt, credential.FieldRenewalDueAt, credential.FieldRenewalStartedAt, credential.FieldIssuedAt, credential.FieldIndexedAt, credential.FieldCreatedAt, credential.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
-		case credential.ForeignKeys[0]: // natural_person_credentials
+		case credential.ForeignKeys[0]: // credential_successor
 			values[i] = new(sql.NullString)
-		case credential.ForeignKeys[1]: // user_credentials
+		case credential.ForeignKeys[1]: // credential_template_credentials
+			values[i] = new(sql.NullString)
+		case credential.ForeignKeys[2]: // natural_person_credentials
+			values[i] = new(sql.NullString)
+		case credential.ForeignKeys[3]: // user_credentials
 			values[i] = new(sql.NullString)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type Credential", columns[i])
@@ -106,6 +189,100 @@ func (c *Credential) assignValues(columns []string, values []interface{}) error
 					return fmt.Errorf("unmarshal field raw: %w", err)
 				}
 			}
+		case credential.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				c.Status = credential.Status(value.String)
+			}
+		case credential.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				c.ExpiresAt = new(time.Time)
+				*c.ExpiresAt = value.Time
+			}
+		case credential.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				c.RevokedAt = new(time.Time)
+				*c.RevokedAt = value.Time
+			}
+		case credential.FieldRenewalDueAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field renewal_due_at", values[i])
+			} else if value.Valid {
+				c.RenewalDueAt = new(time.Time)
+				*c.RenewalDueAt = value.Time
+			}
+		case credential.FieldRenewalStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field renewal_started_at", values[i])
+			} else if value.Valid {
+				c.RenewalStartedAt = new(time.Time)
+				*c.RenewalStartedAt = value.Time
+			}
+		case credential.FieldIssuanceData:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field issuance_data", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.IssuanceData); err != nil {
+					return fmt.Errorf("unmarshal field issuance_data: %w", err)
+				}
+			}
+		case credential.FieldTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.Types); err != nil {
+					return fmt.Errorf("unmarshal field types: %w", err)
+				}
+			}
+		case credential.FieldCredentialType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field credential_type", values[i])
+			} else if value.Valid {
+				c.CredentialType = value.String
+			}
+		case credential.FieldIssuer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field issuer", values[i])
+			} else if value.Valid {
+				c.Issuer = value.String
+			}
+		case credential.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				c.Subject = value.String
+			}
+		case credential.FieldHolderEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field holder_email", values[i])
+			} else if value.Valid {
+				c.HolderEmail = value.String
+			}
+		case credential.FieldTemplateName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field template_name", values[i])
+			} else if value.Valid {
+				c.TemplateName = value.String
+			}
+		case credential.FieldIssuedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field issued_at", values[i])
+			} else if value.Valid {
+				c.IssuedAt = new(time.Time)
+				*c.IssuedAt = value.Time
+			}
+		case credential.FieldIndexedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field indexed_at", values[i])
+			} else if value.Valid {
+				c.IndexedAt = new(time.Time)
+				*c.IndexedAt = value.Time
+			}
 		case credential.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -119,13 +296,27 @@ func (c *Credential) assignValues(columns []string, values []interface{}) error
 				c.UpdatedAt = value.Time
 			}
 		case credential.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field credential_successor", values[i])
+			} else if value.Valid {
+				c.credential_successor = new(string)
+				*c.credential_successor = value.String
+			}
+		case credential.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field credential_template_credentials", values[i])
+			} else if value.Valid {
+				c.credential_template_credentials = new(string)
+				*c.credential_template_credentials = value.String
+			}
+		case credential.ForeignKeys[2]:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field natural_person_credentials", values[i])
 			} else if value.Valid {
 				c.natural_person_credentials = new(string)
 				*c.natural_person_credentials = value.String
 			}
-		case credential.ForeignKeys[1]:
+		case credential.ForeignKeys[3]:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field user_credentials", values[i])
 			} else if value.Valid {
@@ -142,6 +333,21 @@ func (c *Credential) QueryAccount() *UserQuery {
 	return (&CredentialClient{config: c.config}).QueryAccount(c)
 }
 
+// QueryTemplate queries the "template" edge of the Credential entity.
+func (c *Credential) QueryTemplate() *CredentialTemplateQuery {
+	return (&CredentialClient{config: c.config}).QueryTemplate(c)
+}
+
+// QueryPredecessor queries the "predecessor" edge of the Credential entity.
+func (c *Credential) QueryPredecessor() *CredentialQuery {
+	return (&CredentialClient{config: c.config}).QueryPredecessor(c)
+}
+
+// QuerySuccessor queries the "successor" edge of the Credential entity.
+func (c *Credential) QuerySuccessor() *CredentialQuery {
+	return (&CredentialClient{config: c.config}).QuerySuccessor(c)
+}
+
 // Update returns a builder for updating this Credential.
 // Note that you need to call Credential.Unwrap() before calling this method if this Credential
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -171,6 +377,60 @@ func (c *Credential) String() string {
 	builder.WriteString("raw=")
 	builder.WriteString(fmt.Sprintf("%v", c.Raw))
 	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", c.Status))
+	builder.WriteString(", ")
+	if v := c.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := c.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := c.RenewalDueAt; v != nil {
+		builder.WriteString("renewal_due_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := c.RenewalStartedAt; v != nil {
+		builder.WriteString("renewal_started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("issuance_data=")
+	builder.WriteString(fmt.Sprintf("%v", c.IssuanceData))
+	builder.WriteString(", ")
+	builder.WriteString("types=")
+	builder.WriteString(fmt.Sprintf("%v", c.Types))
+	builder.WriteString(", ")
+	builder.WriteString("credential_type=")
+	builder.WriteString(c.CredentialType)
+	builder.WriteString(", ")
+	builder.WriteString("issuer=")
+	builder.WriteString(c.Issuer)
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(c.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("holder_email=")
+	builder.WriteString(c.HolderEmail)
+	builder.WriteString(", ")
+	builder.WriteString("template_name=")
+	builder.WriteString(c.TemplateName)
+	builder.WriteString(", ")
+	if v := c.IssuedAt; v != nil {
+		builder.WriteString("issued_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := c.IndexedAt; v != nil {
+		builder.WriteString("indexed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -3,6 +3,7 @@
 package credential
 
 import (
+	"fmt"
 	"time"
 )
 
@@ -15,12 +16,46 @@ const (
 	FieldType = "type"
 	// FieldRaw holds the string denoting the raw field in the database.
 	FieldRaw = "raw"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldRevokedAt holds the string denoting the revoked_at field in the database.
+	FieldRevokedAt = "revoked_at"
+	// FieldRenewalDueAt holds the string denoting the renewal_due_at field in the database.
+	FieldRenewalDueAt = "renewal_due_at"
+	// FieldRenewalStartedAt holds the string denoting the renewal_started_at field in the database.
+	FieldRenewalStartedAt = "renewal_started_at"
+	// FieldIssuanceData holds the string denoting the issuance_data field in the database.
+	FieldIssuanceData = "issuance_data"
+	// FieldTypes holds the string denoting the types field in the database.
+	FieldTypes = "types"
+	// FieldCredentialType holds the string denoting the credential_type field in the database.
+	FieldCredentialType = "credential_type"
+	// FieldIssuer holds the string denoting the issuer field in the database.
+	FieldIssuer = "issuer"
+	// FieldSubject holds the string denoting the subject field in the database.
+	FieldSubject = "subject"
+	// FieldHolderEmail holds the string denoting the holder_email field in the database.
+	FieldHolderEmail = "holder_email"
+	// FieldTemplateName holds the string denoting the template_name field in the database.
+	FieldTemplateName = "template_name"
+	// FieldIssuedAt holds the string denoting the issued_at field in the database.
+	FieldIssuedAt = "issued_at"
+	// FieldIndexedAt holds the string denoting the indexed_at field in the database.
+	FieldIndexedAt = "indexed_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// EdgeAccount holds the string denoting the account edge name in mutations.
 	EdgeAccount = "account"
+	// EdgeTemplate holds the string denoting the template edge name in mutations.
+	EdgeTemplate = "template"
+	// EdgePredecessor holds the string denoting the predecessor edge name in mutations.
+	EdgePredecessor = "predecessor"
+	// EdgeSuccessor holds the string denoting the successor edge name in mutations.
+	EdgeSuccessor = "successor"
 	// Table holds the table name of the credential in the database.
 	Table = "credentials"
 	// AccountTable is the table that holds the account relation/edge.
@@ -30,6 +65,21 @@ const (
 	AccountInverseTable = "users"
 	// AccountColumn is the table column denoting the account relation/edge.
 	AccountColumn = "user_credentials"
+	// TemplateTable is the table that holds the template relation/edge.
+	TemplateTable = "credentials"
+	// TemplateInverseTable is the table name for the CredentialTemplate entity.
+	// It exists in this package in order to avoid circular dependency with the "credentialtemplate" package.
+	TemplateInverseTable = "credential_templates"
+	// TemplateColumn is the table column denoting the template relation/edge.
+	TemplateColumn = "credential_template_credentials"
+	// PredecessorTable is the table that holds the predecessor relation/edge.
+	PredecessorTable = "credentials"
+	// PredecessorColumn is the table column denoting the predecessor relation/edge.
+	PredecessorColumn = "credential_successor"
+	// SuccessorTable is the table that holds the successor relation/edge.
+	SuccessorTable = "credentials"
+	// SuccessorColumn is the table column denoting the successor relation/edge.
+	SuccessorColumn = "credential_successor"
 )
 
 // Columns holds all SQL columns for credential fields.
@@ -37,6 +87,20 @@ var Columns = []string{
 	FieldID,
 	FieldType,
 	FieldRaw,
+	FieldStatus,
+	FieldExpiresAt,
+	FieldRevokedAt,
+	FieldRenewalDueAt,
+	FieldRenewalStartedAt,
+	FieldIssuanceData,
+	FieldTypes,
+	FieldCredentialType,
+	FieldIssuer,
+	FieldSubject,
+	FieldHolderEmail,
+	FieldTemplateName,
+	FieldIssuedAt,
+	FieldIndexedAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -44,6 +108,8 @@ var Columns = []string{
 // ForeignKeys holds the SQL foreign-keys that are owned by the "credentials"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
+	"credential_successor",
+	"credential_template_credentials",
 	"natural_person_credentials",
 	"user_credentials",
 }
@@ -71,3 +137,29 @@ var (
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
 	DefaultUpdatedAt func() time.Time
 )
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusActive is the default value of the Status enum.
+const DefaultStatus = StatusActive
+
+// Status values.
+const (
+	StatusActive  Status = "active"
+	StatusRevoked Status = "revoked"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusActive, StatusRevoked:
+		return nil
+	default:
+		return fmt.Errorf("credential: invalid enum value for status field: %q", s)
+	}
+}
@@ -88,36 +88,1160 @@ func Type(v string) predicate.Credential {
 	})
 }
 
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// RevokedAt applies equality check predicate on the "revoked_at" field. It's identical to RevokedAtEQ.
+func RevokedAt(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRevokedAt), v))
+	})
+}
+
+// RenewalDueAt applies equality check predicate on the "renewal_due_at" field. It's identical to RenewalDueAtEQ.
+func RenewalDueAt(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRenewalDueAt), v))
+	})
+}
+
+// RenewalStartedAt applies equality check predicate on the "renewal_started_at" field. It's identical to RenewalStartedAtEQ.
+func RenewalStartedAt(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRenewalStartedAt), v))
+	})
+}
+
+// CredentialType applies equality check predicate on the "credential_type" field. It's identical to CredentialTypeEQ.
+func CredentialType(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCredentialType), v))
+	})
+}
+
+// Issuer applies equality check predicate on the "issuer" field. It's identical to IssuerEQ.
+func Issuer(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIssuer), v))
+	})
+}
+
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSubject), v))
+	})
+}
+
+// HolderEmail applies equality check predicate on the "holder_email" field. It's identical to HolderEmailEQ.
+func HolderEmail(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHolderEmail), v))
+	})
+}
+
+// TemplateName applies equality check predicate on the "template_name" field. It's identical to TemplateNameEQ.
+func TemplateName(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTemplateName), v))
+	})
+}
+
+// IssuedAt applies equality check predicate on the "issued_at" field. It's identical to IssuedAtEQ.
+func IssuedAt(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIssuedAt), v))
+	})
+}
+
+// IndexedAt applies equality check predicate on the "indexed_at" field. It's identical to IndexedAtEQ.
+func IndexedAt(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIndexedAt), v))
+	})
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Credential {
 	return predicate.Credential(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldType), v))
+	})
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldType), v))
+	})
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.Credential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Credential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldType), v...))
+	})
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.Credential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Credential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldType), v...))
+	})
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldType), v))
+	})
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldType), v))
+	})
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldType), v))
+	})
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldType), v))
+	})
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldType), v))
+	})
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldType), v))
+	})
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldType), v))
+	})
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldType), v))
+	})
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldType), v))
+	})
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Credential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Credential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Credential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Credential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStatus), v...))
+	})
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Credential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Credential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExpiresAt), v...))
+	})
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Credential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Credential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExpiresAt), v...))
+	})
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldExpiresAt)))
+	})
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldExpiresAt)))
+	})
+}
+
+// RevokedAtEQ applies the EQ predicate on the "revoked_at" field.
+func RevokedAtEQ(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRevokedAt), v))
+	})
+}
+
+// RevokedAtNEQ applies the NEQ predicate on the "revoked_at" field.
+func RevokedAtNEQ(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRevokedAt), v))
+	})
+}
+
+// RevokedAtIn applies the In predicate on the "revoked_at" field.
+func RevokedAtIn(vs ...time.Time) predicate.Credential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Credential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRevokedAt), v...))
+	})
+}
+
+// RevokedAtNotIn applies the NotIn predicate on the "revoked_at" field.
+func RevokedAtNotIn(vs ...time.Time) predicate.Credential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Credential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRevokedAt), v...))
+	})
+}
+
+// RevokedAtGT applies the GT predicate on the "revoked_at" field.
+func RevokedAtGT(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRevokedAt), v))
+	})
+}
+
+// RevokedAtGTE applies the GTE predicate on the "revoked_at" field.
+func RevokedAtGTE(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRevokedAt), v))
+	})
+}
+
+// RevokedAtLT applies the LT predicate on the "revoked_at" field.
+func RevokedAtLT(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRevokedAt), v))
+	})
+}
+
+// RevokedAtLTE applies the LTE predicate on the "revoked_at" field.
+func RevokedAtLTE(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRevokedAt), v))
+	})
+}
+
+// RevokedAtIsNil applies the IsNil predicate on the "revoked_at" field.
+func RevokedAtIsNil() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRevokedAt)))
+	})
+}
+
+// RevokedAtNotNil applies the NotNil predicate on the "revoked_at" field.
+func RevokedAtNotNil() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRevokedAt)))
+	})
+}
+
+// RenewalDueAtEQ applies the EQ predicate on the "renewal_due_at" field.
+func RenewalDueAtEQ(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRenewalDueAt), v))
+	})
+}
+
+// RenewalDueAtNEQ applies the NEQ predicate on the "renewal_due_at" field.
+func RenewalDueAtNEQ(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRenewalDueAt), v))
+	})
+}
+
+// RenewalDueAtIn applies the In predicate on the "renewal_due_at" field.
+func RenewalDueAtIn(vs ...time.Time) predicate.Credential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Credential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRenewalDueAt), v...))
+	})
+}
+
+// RenewalDueAtNotIn applies the NotIn predicate on the "renewal_due_at" field.
+func RenewalDueAtNotIn(vs ...time.Time) predicate.Credential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Credential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRenewalDueAt), v...))
+	})
+}
+
+// RenewalDueAtGT applies the GT predicate on the "renewal_due_at" field.
+func RenewalDueAtGT(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRenewalDueAt), v))
+	})
+}
+
+// RenewalDueAtGTE applies the GTE predicate on the "renewal_due_at" field.
+func RenewalDueAtGTE(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRenewalDueAt), v))
+	})
+}
+
+// RenewalDueAtLT applies the LT predicate on the "renewal_due_at" field.
+func RenewalDueAtLT(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRenewalDueAt), v))
+	})
+}
+
+// RenewalDueAtLTE applies the LTE predicate on the "renewal_due_at" field.
+func RenewalDueAtLTE(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRenewalDueAt), v))
+	})
+}
+
+// RenewalDueAtIsNil applies the IsNil predicate on the "renewal_due_at" field.
+func RenewalDueAtIsNil() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRenewalDueAt)))
+	})
+}
+
+// RenewalDueAtNotNil applies the NotNil predicate on the "renewal_due_at" field.
+func RenewalDueAtNotNil() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRenewalDueAt)))
+	})
+}
+
+// RenewalStartedAtEQ applies the EQ predicate on the "renewal_started_at" field.
+func RenewalStartedAtEQ(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRenewalStartedAt), v))
+	})
+}
+
+// RenewalStartedAtNEQ applies the NEQ predicate on the "renewal_started_at" field.
+func RenewalStartedAtNEQ(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRenewalStartedAt), v))
+	})
+}
+
+// RenewalStartedAtIn applies the In predicate on the "renewal_started_at" field.
+func RenewalStartedAtIn(vs ...time.Time) predicate.Credential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Credential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRenewalStartedAt), v...))
+	})
+}
+
+// RenewalStartedAtNotIn applies the NotIn predicate on the "renewal_started_at" field.
+func RenewalStartedAtNotIn(vs ...time.Time) predicate.Credential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Credential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRenewalStartedAt), v...))
+	})
+}
+
+// RenewalStartedAtGT applies the GT predicate on the "renewal_started_at" field.
+func RenewalStartedAtGT(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRenewalStartedAt), v))
+	})
+}
+
+// RenewalStartedAtGTE applies the GTE predicate on the "renewal_started_at" field.
+func RenewalStartedAtGTE(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRenewalStartedAt), v))
+	})
+}
+
+// RenewalStartedAtLT applies the LT predicate on the "renewal_started_at" field.
+func RenewalStartedAtLT(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRenewalStartedAt), v))
+	})
+}
+
+// RenewalStartedAtLTE applies the LTE predicate on the "renewal_started_at" field.
+func RenewalStartedAtLTE(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRenewalStartedAt), v))
+	})
+}
+
+// RenewalStartedAtIsNil applies the IsNil predicate on the "renewal_started_at" field.
+func RenewalStartedAtIsNil() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRenewalStartedAt)))
+	})
+}
+
+// RenewalStartedAtNotNil applies the NotNil predicate on the "renewal_started_at" field.
+func RenewalStartedAtNotNil() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRenewalStartedAt)))
+	})
+}
+
+// IssuanceDataIsNil applies the IsNil predicate on the "issuance_data" field.
+func IssuanceDataIsNil() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldIssuanceData)))
+	})
+}
+
+// IssuanceDataNotNil applies the NotNil predicate on the "issuance_data" field.
+func IssuanceDataNotNil() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldIssuanceData)))
+	})
+}
+
+// TypesIsNil applies the IsNil predicate on the "types" field.
+func TypesIsNil() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTypes)))
+	})
+}
+
+// TypesNotNil applies the NotNil predicate on the "types" field.
+func TypesNotNil() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTypes)))
+	})
+}
+
+// CredentialTypeEQ applies the EQ predicate on the "credential_type" field.
+func CredentialTypeEQ(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCredentialType), v))
+	})
+}
+
+// CredentialTypeNEQ applies the NEQ predicate on the "credential_type" field.
+func CredentialTypeNEQ(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCredentialType), v))
+	})
+}
+
+// CredentialTypeIn applies the In predicate on the "credential_type" field.
+func CredentialTypeIn(vs ...string) predicate.Credential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Credential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCredentialType), v...))
+	})
+}
+
+// CredentialTypeNotIn applies the NotIn predicate on the "credential_type" field.
+func CredentialTypeNotIn(vs ...string) predicate.Credential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Credential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCredentialType), v...))
+	})
+}
+
+// CredentialTypeGT applies the GT predicate on the "credential_type" field.
+func CredentialTypeGT(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCredentialType), v))
+	})
+}
+
+// CredentialTypeGTE applies the GTE predicate on the "credential_type" field.
+func CredentialTypeGTE(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCredentialType), v))
+	})
+}
+
+// CredentialTypeLT applies the LT predicate on the "credential_type" field.
+func CredentialTypeLT(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCredentialType), v))
+	})
+}
+
+// CredentialTypeLTE applies the LTE predicate on the "credential_type" field.
+func CredentialTypeLTE(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCredentialType), v))
+	})
+}
+
+// CredentialTypeContains applies the Contains predicate on the "credential_type" field.
+func CredentialTypeContains(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCredentialType), v))
+	})
+}
+
+// CredentialTypeHasPrefix applies the HasPrefix predicate on the "credential_type" field.
+func CredentialTypeHasPrefix(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCredentialType), v))
+	})
+}
+
+// CredentialTypeHasSuffix applies the HasSuffix predicate on the "credential_type" field.
+func CredentialTypeHasSuffix(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCredentialType), v))
+	})
+}
+
+// CredentialTypeIsNil applies the IsNil predicate on the "credential_type" field.
+func CredentialTypeIsNil() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCredentialType)))
+	})
+}
+
+// CredentialTypeNotNil applies the NotNil predicate on the "credential_type" field.
+func CredentialTypeNotNil() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCredentialType)))
+	})
+}
+
+// CredentialTypeEqualFold applies the EqualFold predicate on the "credential_type" field.
+func CredentialTypeEqualFold(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCredentialType), v))
+	})
+}
+
+// CredentialTypeContainsFold applies the ContainsFold predicate on the "credential_type" field.
+func CredentialTypeContainsFold(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCredentialType), v))
+	})
+}
+
+// IssuerEQ applies the EQ predicate on the "issuer" field.
+func IssuerEQ(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIssuer), v))
+	})
+}
+
+// IssuerNEQ applies the NEQ predicate on the "issuer" field.
+func IssuerNEQ(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldIssuer), v))
+	})
+}
+
+// IssuerIn applies the In predicate on the "issuer" field.
+func IssuerIn(vs ...string) predicate.Credential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Credential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldIssuer), v...))
+	})
+}
+
+// IssuerNotIn applies the NotIn predicate on the "issuer" field.
+func IssuerNotIn(vs ...string) predicate.Credential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Credential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldIssuer), v...))
+	})
+}
+
+// IssuerGT applies the GT predicate on the "issuer" field.
+func IssuerGT(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldIssuer), v))
+	})
+}
+
+// IssuerGTE applies the GTE predicate on the "issuer" field.
+func IssuerGTE(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldIssuer), v))
+	})
+}
+
+// IssuerLT applies the LT predicate on the "issuer" field.
+func IssuerLT(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldIssuer), v))
+	})
+}
+
+// IssuerLTE applies the LTE predicate on the "issuer" field.
+func IssuerLTE(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldIssuer), v))
+	})
+}
+
+// IssuerContains applies the Contains predicate on the "issuer" field.
+func IssuerContains(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldIssuer), v))
+	})
+}
+
+// IssuerHasPrefix applies the HasPrefix predicate on the "issuer" field.
+func IssuerHasPrefix(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldIssuer), v))
+	})
+}
+
+// IssuerHasSuffix applies the HasSuffix predicate on the "issuer" field.
+func IssuerHasSuffix(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldIssuer), v))
+	})
+}
+
+// IssuerIsNil applies the IsNil predicate on the "issuer" field.
+func IssuerIsNil() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldIssuer)))
+	})
+}
+
+// IssuerNotNil applies the NotNil predicate on the "issuer" field.
+func IssuerNotNil() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldIssuer)))
+	})
+}
+
+// IssuerEqualFold applies the EqualFold predicate on the "issuer" field.
+func IssuerEqualFold(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldIssuer), v))
+	})
+}
+
+// IssuerContainsFold applies the ContainsFold predicate on the "issuer" field.
+func IssuerContainsFold(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldIssuer), v))
+	})
+}
+
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSubject), v))
+	})
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSubject), v))
+	})
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.Credential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Credential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSubject), v...))
+	})
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.Credential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Credential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSubject), v...))
+	})
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSubject), v))
+	})
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSubject), v))
+	})
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSubject), v))
+	})
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSubject), v))
+	})
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSubject), v))
+	})
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSubject), v))
+	})
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSubject), v))
+	})
+}
+
+// SubjectIsNil applies the IsNil predicate on the "subject" field.
+func SubjectIsNil() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSubject)))
+	})
+}
+
+// SubjectNotNil applies the NotNil predicate on the "subject" field.
+func SubjectNotNil() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSubject)))
+	})
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSubject), v))
+	})
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSubject), v))
+	})
+}
+
+// HolderEmailEQ applies the EQ predicate on the "holder_email" field.
+func HolderEmailEQ(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHolderEmail), v))
+	})
+}
+
+// HolderEmailNEQ applies the NEQ predicate on the "holder_email" field.
+func HolderEmailNEQ(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldHolderEmail), v))
+	})
+}
+
+// HolderEmailIn applies the In predicate on the "holder_email" field.
+func HolderEmailIn(vs ...string) predicate.Credential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Credential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldHolderEmail), v...))
+	})
+}
+
+// HolderEmailNotIn applies the NotIn predicate on the "holder_email" field.
+func HolderEmailNotIn(vs ...string) predicate.Credential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Credential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldHolderEmail), v...))
+	})
+}
+
+// HolderEmailGT applies the GT predicate on the "holder_email" field.
+func HolderEmailGT(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldHolderEmail), v))
+	})
+}
+
+// HolderEmailGTE applies the GTE predicate on the "holder_email" field.
+func HolderEmailGTE(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldHolderEmail), v))
+	})
+}
+
+// HolderEmailLT applies the LT predicate on the "holder_email" field.
+func HolderEmailLT(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldHolderEmail), v))
+	})
+}
+
+// HolderEmailLTE applies the LTE predicate on the "holder_email" field.
+func HolderEmailLTE(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldHolderEmail), v))
 	})
 }
 
-// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
-func UpdatedAt(v time.Time) predicate.Credential {
+// HolderEmailContains applies the Contains predicate on the "holder_email" field.
+func HolderEmailContains(v string) predicate.Credential {
 	return predicate.Credential(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+		s.Where(sql.Contains(s.C(FieldHolderEmail), v))
 	})
 }
 
-// TypeEQ applies the EQ predicate on the "type" field.
-func TypeEQ(v string) predicate.Credential {
+// HolderEmailHasPrefix applies the HasPrefix predicate on the "holder_email" field.
+func HolderEmailHasPrefix(v string) predicate.Credential {
 	return predicate.Credential(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldType), v))
+		s.Where(sql.HasPrefix(s.C(FieldHolderEmail), v))
 	})
 }
 
-// TypeNEQ applies the NEQ predicate on the "type" field.
-func TypeNEQ(v string) predicate.Credential {
+// HolderEmailHasSuffix applies the HasSuffix predicate on the "holder_email" field.
+func HolderEmailHasSuffix(v string) predicate.Credential {
 	return predicate.Credential(func(s *sql.Selector) {
-		s.Where(sql.NEQ(s.C(FieldType), v))
+		s.Where(sql.HasSuffix(s.C(FieldHolderEmail), v))
 	})
 }
 
-// TypeIn applies the In predicate on the "type" field.
-func TypeIn(vs ...string) predicate.Credential {
+// HolderEmailIsNil applies the IsNil predicate on the "holder_email" field.
+func HolderEmailIsNil() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldHolderEmail)))
+	})
+}
+
+// HolderEmailNotNil applies the NotNil predicate on the "holder_email" field.
+func HolderEmailNotNil() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldHolderEmail)))
+	})
+}
+
+// HolderEmailEqualFold applies the EqualFold predicate on the "holder_email" field.
+func HolderEmailEqualFold(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldHolderEmail), v))
+	})
+}
+
+// HolderEmailContainsFold applies the ContainsFold predicate on the "holder_email" field.
+func HolderEmailContainsFold(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldHolderEmail), v))
+	})
+}
+
+// TemplateNameEQ applies the EQ predicate on the "template_name" field.
+func TemplateNameEQ(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTemplateName), v))
+	})
+}
+
+// TemplateNameNEQ applies the NEQ predicate on the "template_name" field.
+func TemplateNameNEQ(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTemplateName), v))
+	})
+}
+
+// TemplateNameIn applies the In predicate on the "template_name" field.
+func TemplateNameIn(vs ...string) predicate.Credential {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -129,12 +1253,12 @@ func TypeIn(vs ...string) predicate.Credential {
 			s.Where(sql.False())
 			return
 		}
-		s.Where(sql.In(s.C(FieldType), v...))
+		s.Where(sql.In(s.C(FieldTemplateName), v...))
 	})
 }
 
-// TypeNotIn applies the NotIn predicate on the "type" field.
-func TypeNotIn(vs ...string) predicate.Credential {
+// TemplateNameNotIn applies the NotIn predicate on the "template_name" field.
+func TemplateNameNotIn(vs ...string) predicate.Credential {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
@@ -146,70 +1270,264 @@ func TypeNotIn(vs ...string) predicate.Credential {
 			s.Where(sql.False())
 			return
 		}
-		s.Where(sql.NotIn(s.C(FieldType), v...))
+		s.Where(sql.NotIn(s.C(FieldTemplateName), v...))
 	})
 }
 
-// TypeGT applies the GT predicate on the "type" field.
-func TypeGT(v string) predicate.Credential {
+// TemplateNameGT applies the GT predicate on the "template_name" field.
+func TemplateNameGT(v string) predicate.Credential {
 	return predicate.Credential(func(s *sql.Selector) {
-		s.Where(sql.GT(s.C(FieldType), v))
+		s.Where(sql.GT(s.C(FieldTemplateName), v))
 	})
 }
 
-// TypeGTE applies the GTE predicate on the "type" field.
-func TypeGTE(v string) predicate.Credential {
+// TemplateNameGTE applies the GTE predicate on the "template_name" field.
+func TemplateNameGTE(v string) predicate.Credential {
 	return predicate.Credential(func(s *sql.Selector) {
-		s.Where(sql.GTE(s.C(FieldType), v))
+		s.Where(sql.GTE(s.C(FieldTemplateName), v))
 	})
 }
 
-// TypeLT applies the LT predicate on the "type" field.
-func TypeLT(v string) predicate.Credential {
+// TemplateNameLT applies the LT predicate on the "template_name" field.
+func TemplateNameLT(v string) predicate.Credential {
 	return predicate.Credential(func(s *sql.Selector) {
-		s.Where(sql.LT(s.C(FieldType), v))
+		s.Where(sql.LT(s.C(FieldTemplateName), v))
 	})
 }
 
-// TypeLTE applies the LTE predicate on the "type" field.
-func TypeLTE(v string) predicate.Credential {
+// TemplateNameLTE applies the LTE predicate on the "template_name" field.
+func TemplateNameLTE(v string) predicate.Credential {
 	return predicate.Credential(func(s *sql.Selector) {
-		s.Where(sql.LTE(s.C(FieldType), v))
+		s.Where(sql.LTE(s.C(FieldTemplateName), v))
 	})
 }
 
-// TypeContains applies the Contains predicate on the "type" field.
-func TypeContains(v string) predicate.Credential {
+// TemplateNameContains applies the Contains predicate on the "template_name" field.
+func TemplateNameContains(v string) predicate.Credential {
 	return predicate.Credential(func(s *sql.Selector) {
-		s.Where(sql.Contains(s.C(FieldType), v))
+		s.Where(sql.Contains(s.C(FieldTemplateName), v))
 	})
 }
 
-// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
-func TypeHasPrefix(v string) predicate.Credential {
+// TemplateNameHasPrefix applies the HasPrefix predicate on the "template_name" field.
+func TemplateNameHasPrefix(v string) predicate.Credential {
 	return predicate.Credential(func(s *sql.Selector) {
-		s.Where(sql.HasPrefix(s.C(FieldType), v))
+		s.Where(sql.HasPrefix(s.C(FieldTemplateName), v))
 	})
 }
 
-// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
-func TypeHasSuffix(v string) predicate.Credential {
+// TemplateNameHasSuffix applies the HasSuffix predicate on the "template_name" field.
+func TemplateNameHasSuffix(v string) predicate.Credential {
 	return predicate.Credential(func(s *sql.Selector) {
-		s.Where(sql.HasSuffix(s.C(FieldType), v))
+		s.Where(sql.HasSuffix(s.C(FieldTemplateName), v))
 	})
 }
 
-// TypeEqualFold applies the EqualFold predicate on the "type" field.
-func TypeEqualFold(v string) predicate.Credential {
+// TemplateNameIsNil applies the IsNil predicate on the "template_name" field.
+func TemplateNameIsNil() predicate.Credential {
 	return predicate.Credential(func(s *sql.Selector) {
-		s.Where(sql.EqualFold(s.C(FieldType), v))
+		s.Where(sql.IsNull(s.C(FieldTemplateName)))
 	})
 }
 
-// TypeContainsFold applies the ContainsFold predicate on the "type" field.
-func TypeContainsFold(v string) predicate.Credential {
+// TemplateNameNotNil applies the NotNil predicate on the "template_name" field.
+func TemplateNameNotNil() predicate.Credential {
 	return predicate.Credential(func(s *sql.Selector) {
-		s.Where(sql.ContainsFold(s.C(FieldType), v))
+		s.Where(sql.NotNull(s.C(FieldTemplateName)))
+	})
+}
+
+// TemplateNameEqualFold applies the EqualFold predicate on the "template_name" field.
+func TemplateNameEqualFold(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTemplateName), v))
+	})
+}
+
+// TemplateNameContainsFold applies the ContainsFold predicate on the "template_name" field.
+func TemplateNameContainsFold(v string) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTemplateName), v))
+	})
+}
+
+// IssuedAtEQ applies the EQ predicate on the "issued_at" field.
+func IssuedAtEQ(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIssuedAt), v))
+	})
+}
+
+// IssuedAtNEQ applies the NEQ predicate on the "issued_at" field.
+func IssuedAtNEQ(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldIssuedAt), v))
+	})
+}
+
+// IssuedAtIn applies the In predicate on the "issued_at" field.
+func IssuedAtIn(vs ...time.Time) predicate.Credential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Credential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldIssuedAt), v...))
+	})
+}
+
+// IssuedAtNotIn applies the NotIn predicate on the "issued_at" field.
+func IssuedAtNotIn(vs ...time.Time) predicate.Credential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Credential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldIssuedAt), v...))
+	})
+}
+
+// IssuedAtGT applies the GT predicate on the "issued_at" field.
+func IssuedAtGT(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldIssuedAt), v))
+	})
+}
+
+// IssuedAtGTE applies the GTE predicate on the "issued_at" field.
+func IssuedAtGTE(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldIssuedAt), v))
+	})
+}
+
+// IssuedAtLT applies the LT predicate on the "issued_at" field.
+func IssuedAtLT(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldIssuedAt), v))
+	})
+}
+
+// IssuedAtLTE applies the LTE predicate on the "issued_at" field.
+func IssuedAtLTE(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldIssuedAt), v))
+	})
+}
+
+// IssuedAtIsNil applies the IsNil predicate on the "issued_at" field.
+func IssuedAtIsNil() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldIssuedAt)))
+	})
+}
+
+// IssuedAtNotNil applies the NotNil predicate on the "issued_at" field.
+func IssuedAtNotNil() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldIssuedAt)))
+	})
+}
+
+// IndexedAtEQ applies the EQ predicate on the "indexed_at" field.
+func IndexedAtEQ(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIndexedAt), v))
+	})
+}
+
+// IndexedAtNEQ applies the NEQ predicate on the "indexed_at" field.
+func IndexedAtNEQ(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldIndexedAt), v))
+	})
+}
+
+// IndexedAtIn applies the In predicate on the "indexed_at" field.
+func IndexedAtIn(vs ...time.Time) predicate.Credential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Credential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldIndexedAt), v...))
+	})
+}
+
+// IndexedAtNotIn applies the NotIn predicate on the "indexed_at" field.
+func IndexedAtNotIn(vs ...time.Time) predicate.Credential {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Credential(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldIndexedAt), v...))
+	})
+}
+
+// IndexedAtGT applies the GT predicate on the "indexed_at" field.
+func IndexedAtGT(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldIndexedAt), v))
+	})
+}
+
+// IndexedAtGTE applies the GTE predicate on the "indexed_at" field.
+func IndexedAtGTE(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldIndexedAt), v))
+	})
+}
+
+// IndexedAtLT applies the LT predicate on the "indexed_at" field.
+func IndexedAtLT(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldIndexedAt), v))
+	})
+}
+
+// IndexedAtLTE applies the LTE predicate on the "indexed_at" field.
+func IndexedAtLTE(v time.Time) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldIndexedAt), v))
+	})
+}
+
+// IndexedAtIsNil applies the IsNil predicate on the "indexed_at" field.
+func IndexedAtIsNil() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldIndexedAt)))
+	})
+}
+
+// IndexedAtNotNil applies the NotNil predicate on the "indexed_at" field.
+func IndexedAtNotNil() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldIndexedAt)))
 	})
 }
 
@@ -393,6 +1711,90 @@ func HasAccountWith(preds ...predicate.User) predicate.Credential {
 	})
 }
 
+// HasTemplate applies the HasEdge predicate on the "template" edge.
+func HasTemplate() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TemplateTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TemplateTable, TemplateColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTemplateWith applies the HasEdge predicate on the "template" edge with a given conditions (other predicates).
+func HasTemplateWith(preds ...predicate.CredentialTemplate) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TemplateInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TemplateTable, TemplateColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPredecessor applies the HasEdge predicate on the "predecessor" edge.
+func HasPredecessor() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PredecessorTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, PredecessorTable, PredecessorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPredecessorWith applies the HasEdge predicate on the "predecessor" edge with a given conditions (other predicates).
+func HasPredecessorWith(preds ...predicate.Credential) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, PredecessorTable, PredecessorColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSuccessor applies the HasEdge predicate on the "successor" edge.
+func HasSuccessor() predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(SuccessorTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, SuccessorTable, SuccessorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSuccessorWith applies the HasEdge predicate on the "successor" edge with a given conditions (other predicates).
+func HasSuccessorWith(preds ...predicate.Credential) predicate.Credential {
+	return predicate.Credential(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, SuccessorTable, SuccessorColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Credential) predicate.Credential {
 	return predicate.Credential(func(s *sql.Selector) {
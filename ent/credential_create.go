@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/hesusruiz/vcbackend/ent/credential"
+	"github.com/hesusruiz/vcbackend/ent/credentialtemplate"
 	"github.com/hesusruiz/vcbackend/ent/user"
 )
 
@@ -41,6 +42,186 @@ func (cc *CredentialCreate) SetRaw(u []uint8) *CredentialCreate {
 	return cc
 }
 
+// SetStatus sets the "status" field.
+func (cc *CredentialCreate) SetStatus(c credential.Status) *CredentialCreate {
+	cc.mutation.SetStatus(c)
+	return cc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableStatus(c *credential.Status) *CredentialCreate {
+	if c != nil {
+		cc.SetStatus(*c)
+	}
+	return cc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (cc *CredentialCreate) SetExpiresAt(t time.Time) *CredentialCreate {
+	cc.mutation.SetExpiresAt(t)
+	return cc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableExpiresAt(t *time.Time) *CredentialCreate {
+	if t != nil {
+		cc.SetExpiresAt(*t)
+	}
+	return cc
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (cc *CredentialCreate) SetRevokedAt(t time.Time) *CredentialCreate {
+	cc.mutation.SetRevokedAt(t)
+	return cc
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableRevokedAt(t *time.Time) *CredentialCreate {
+	if t != nil {
+		cc.SetRevokedAt(*t)
+	}
+	return cc
+}
+
+// SetRenewalDueAt sets the "renewal_due_at" field.
+func (cc *CredentialCreate) SetRenewalDueAt(t time.Time) *CredentialCreate {
+	cc.mutation.SetRenewalDueAt(t)
+	return cc
+}
+
+// SetNillableRenewalDueAt sets the "renewal_due_at" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableRenewalDueAt(t *time.Time) *CredentialCreate {
+	if t != nil {
+		cc.SetRenewalDueAt(*t)
+	}
+	return cc
+}
+
+// SetRenewalStartedAt sets the "renewal_started_at" field.
+func (cc *CredentialCreate) SetRenewalStartedAt(t time.Time) *CredentialCreate {
+	cc.mutation.SetRenewalStartedAt(t)
+	return cc
+}
+
+// SetNillableRenewalStartedAt sets the "renewal_started_at" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableRenewalStartedAt(t *time.Time) *CredentialCreate {
+	if t != nil {
+		cc.SetRenewalStartedAt(*t)
+	}
+	return cc
+}
+
+// SetIssuanceData sets the "issuance_data" field.
+func (cc *CredentialCreate) SetIssuanceData(m map[string]interface{}) *CredentialCreate {
+	cc.mutation.SetIssuanceData(m)
+	return cc
+}
+
+// SetTypes sets the "types" field.
+func (cc *CredentialCreate) SetTypes(s []string) *CredentialCreate {
+	cc.mutation.SetTypes(s)
+	return cc
+}
+
+// SetCredentialType sets the "credential_type" field.
+func (cc *CredentialCreate) SetCredentialType(s string) *CredentialCreate {
+	cc.mutation.SetCredentialType(s)
+	return cc
+}
+
+// SetNillableCredentialType sets the "credential_type" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableCredentialType(s *string) *CredentialCreate {
+	if s != nil {
+		cc.SetCredentialType(*s)
+	}
+	return cc
+}
+
+// SetIssuer sets the "issuer" field.
+func (cc *CredentialCreate) SetIssuer(s string) *CredentialCreate {
+	cc.mutation.SetIssuer(s)
+	return cc
+}
+
+// SetNillableIssuer sets the "issuer" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableIssuer(s *string) *CredentialCreate {
+	if s != nil {
+		cc.SetIssuer(*s)
+	}
+	return cc
+}
+
+// SetSubject sets the "subject" field.
+func (cc *CredentialCreate) SetSubject(s string) *CredentialCreate {
+	cc.mutation.SetSubject(s)
+	return cc
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableSubject(s *string) *CredentialCreate {
+	if s != nil {
+		cc.SetSubject(*s)
+	}
+	return cc
+}
+
+// SetHolderEmail sets the "holder_email" field.
+func (cc *CredentialCreate) SetHolderEmail(s string) *CredentialCreate {
+	cc.mutation.SetHolderEmail(s)
+	return cc
+}
+
+// SetNillableHolderEmail sets the "holder_email" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableHolderEmail(s *string) *CredentialCreate {
+	if s != nil {
+		cc.SetHolderEmail(*s)
+	}
+	return cc
+}
+
+// SetTemplateName sets the "template_name" field.
+func (cc *CredentialCreate) SetTemplateName(s string) *CredentialCreate {
+	cc.mutation.SetTemplateName(s)
+	return cc
+}
+
+// SetNillableTemplateName sets the "template_name" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableTemplateName(s *string) *CredentialCreate {
+	if s != nil {
+		cc.SetTemplateName(*s)
+	}
+	return cc
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (cc *CredentialCreate) SetIssuedAt(t time.Time) *CredentialCreate {
+	cc.mutation.SetIssuedAt(t)
+	return cc
+}
+
+// SetNillableIssuedAt sets the "issued_at" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableIssuedAt(t *time.Time) *CredentialCreate {
+	if t != nil {
+		cc.SetIssuedAt(*t)
+	}
+	return cc
+}
+
+// SetIndexedAt sets the "indexed_at" field.
+func (cc *CredentialCreate) SetIndexedAt(t time.Time) *CredentialCreate {
+	cc.mutation.SetIndexedAt(t)
+	return cc
+}
+
+// SetNillableIndexedAt sets the "indexed_at" field if the given value is not nil.
+func (cc *CredentialCreate) SetNillableIndexedAt(t *time.Time) *CredentialCreate {
+	if t != nil {
+		cc.SetIndexedAt(*t)
+	}
+	return cc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (cc *CredentialCreate) SetCreatedAt(t time.Time) *CredentialCreate {
 	cc.mutation.SetCreatedAt(t)
@@ -94,6 +275,63 @@ func (cc *CredentialCreate) SetAccount(u *User) *CredentialCreate {
 	return cc.SetAccountID(u.ID)
 }
 
+// SetTemplateID sets the "template" edge to the CredentialTemplate entity by ID.
+func (cc *CredentialCreate) SetTemplateID(id string) *CredentialCreate {
+	cc.mutation.SetTemplateID(id)
+	return cc
+}
+
+// SetNillableTemplateID sets the "template" edge to the CredentialTemplate entity by ID if the given value is not nil.
+func (cc *CredentialCreate) SetNillableTemplateID(id *string) *CredentialCreate {
+	if id != nil {
+		cc = cc.SetTemplateID(*id)
+	}
+	return cc
+}
+
+// SetTemplate sets the "template" edge to the CredentialTemplate entity.
+func (cc *CredentialCreate) SetTemplate(c *CredentialTemplate) *CredentialCreate {
+	return cc.SetTemplateID(c.ID)
+}
+
+// SetPredecessorID sets the "predecessor" edge to the Credential entity by ID.
+func (cc *CredentialCreate) SetPredecessorID(id string) *CredentialCreate {
+	cc.mutation.SetPredecessorID(id)
+	return cc
+}
+
+// SetNillablePredecessorID sets the "predecessor" edge to the Credential entity by ID if the given value is not nil.
+func (cc *CredentialCreate) SetNillablePredecessorID(id *string) *CredentialCreate {
+	if id != nil {
+		cc = cc.SetPredecessorID(*id)
+	}
+	return cc
+}
+
+// SetPredecessor sets the "predecessor" edge to the Credential entity.
+func (cc *CredentialCreate) SetPredecessor(c *Credential) *CredentialCreate {
+	return cc.SetPredecessorID(c.ID)
+}
+
+// SetSuccessorID sets the "successor" edge to the Credential entity by ID.
+func (cc *CredentialCreate) SetSuccessorID(id string) *CredentialCreate {
+	cc.mutation.SetSuccessorID(id)
+	return cc
+}
+
+// SetNillableSuccessorID sets the "successor" edge to the Credential entity by ID if the given value is not nil.
+func (cc *CredentialCreate) SetNillableSuccessorID(id *string) *CredentialCreate {
+	if id != nil {
+		cc = cc.SetSuccessorID(*id)
+	}
+	return cc
+}
+
+// SetSuccessor sets the "successor" edge to the Credential entity.
+func (cc *CredentialCreate) SetSuccessor(c *Credential) *CredentialCreate {
+	return cc.SetSuccessorID(c.ID)
+}
+
 // Mutation returns the CredentialMutation object of the builder.
 func (cc *CredentialCreate) Mutation() *CredentialMutation {
 	return cc.mutation
@@ -175,6 +413,10 @@ func (cc *CredentialCreate) defaults() {
 		v := credential.DefaultType
 		cc.mutation.SetType(v)
 	}
+	if _, ok := cc.mutation.Status(); !ok {
+		v := credential.DefaultStatus
+		cc.mutation.SetStatus(v)
+	}
 	if _, ok := cc.mutation.CreatedAt(); !ok {
 		v := credential.DefaultCreatedAt()
 		cc.mutation.SetCreatedAt(v)
@@ -193,6 +435,14 @@ func (cc *CredentialCreate) check() error {
 	if _, ok := cc.mutation.Raw(); !ok {
 		return &ValidationError{Name: "raw", err: errors.New(`ent: missing required field "Credential.raw"`)}
 	}
+	if _, ok := cc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Credential.status"`)}
+	}
+	if v, ok := cc.mutation.Status(); ok {
+		if err := credential.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Credential.status": %w`, err)}
+		}
+	}
 	if _, ok := cc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Credential.created_at"`)}
 	}
@@ -251,6 +501,118 @@ func (cc *CredentialCreate) createSpec() (*Credential, *sqlgraph.CreateSpec) {
 		})
 		_node.Raw = value
 	}
+	if value, ok := cc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: credential.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := cc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: credential.FieldExpiresAt,
+		})
+		_node.ExpiresAt = &value
+	}
+	if value, ok := cc.mutation.RevokedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: credential.FieldRevokedAt,
+		})
+		_node.RevokedAt = &value
+	}
+	if value, ok := cc.mutation.RenewalDueAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: credential.FieldRenewalDueAt,
+		})
+		_node.RenewalDueAt = &value
+	}
+	if value, ok := cc.mutation.RenewalStartedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: credential.FieldRenewalStartedAt,
+		})
+		_node.RenewalStartedAt = &value
+	}
+	if value, ok := cc.mutation.IssuanceData(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: credential.FieldIssuanceData,
+		})
+		_node.IssuanceData = value
+	}
+	if value, ok := cc.mutation.Types(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: credential.FieldTypes,
+		})
+		_node.Types = value
+	}
+	if value, ok := cc.mutation.CredentialType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: credential.FieldCredentialType,
+		})
+		_node.CredentialType = value
+	}
+	if value, ok := cc.mutation.Issuer(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: credential.FieldIssuer,
+		})
+		_node.Issuer = value
+	}
+	if value, ok := cc.mutation.Subject(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: credential.FieldSubject,
+		})
+		_node.Subject = value
+	}
+	if value, ok := cc.mutation.HolderEmail(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: credential.FieldHolderEmail,
+		})
+		_node.HolderEmail = value
+	}
+	if value, ok := cc.mutation.TemplateName(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: credential.FieldTemplateName,
+		})
+		_node.TemplateName = value
+	}
+	if value, ok := cc.mutation.IssuedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: credential.FieldIssuedAt,
+		})
+		_node.IssuedAt = &value
+	}
+	if value, ok := cc.mutation.IndexedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: credential.FieldIndexedAt,
+		})
+		_node.IndexedAt = &value
+	}
 	if value, ok := cc.mutation.CreatedAt(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -287,6 +649,65 @@ func (cc *CredentialCreate) createSpec() (*Credential, *sqlgraph.CreateSpec) {
 		_node.user_credentials = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := cc.mutation.TemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   credential.TemplateTable,
+			Columns: []string{credential.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credentialtemplate.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.credential_template_credentials = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.PredecessorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   credential.PredecessorTable,
+			Columns: []string{credential.PredecessorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credential.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.credential_successor = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.SuccessorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   credential.SuccessorTable,
+			Columns: []string{credential.SuccessorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credential.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -11,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/hesusruiz/vcbackend/ent/credential"
+	"github.com/hesusruiz/vcbackend/ent/credentialtemplate"
 	"github.com/hesusruiz/vcbackend/ent/predicate"
 	"github.com/hesusruiz/vcbackend/ent/user"
 )
@@ -25,8 +27,11 @@ type CredentialQuery struct {
 	fields     []string
 	predicates []predicate.Credential
 	// eager-loading edges.
-	withAccount *UserQuery
-	withFKs     bool
+	withAccount     *UserQuery
+	withTemplate    *CredentialTemplateQuery
+	withPredecessor *CredentialQuery
+	withSuccessor   *CredentialQuery
+	withFKs         bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -85,6 +90,72 @@ func (cq *CredentialQuery) QueryAccount() *UserQuery {
 	return query
 }
 
+// QueryTemplate chains the current query on the "template" edge.
+func (cq *CredentialQuery) QueryTemplate() *CredentialTemplateQuery {
+	query := &CredentialTemplateQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(credential.Table, credential.FieldID, selector),
+			sqlgraph.To(credentialtemplate.Table, credentialtemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, credential.TemplateTable, credential.TemplateColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPredecessor chains the current query on the "predecessor" edge.
+func (cq *CredentialQuery) QueryPredecessor() *CredentialQuery {
+	query := &CredentialQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(credential.Table, credential.FieldID, selector),
+			sqlgraph.To(credential.Table, credential.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, credential.PredecessorTable, credential.PredecessorColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySuccessor chains the current query on the "successor" edge.
+func (cq *CredentialQuery) QuerySuccessor() *CredentialQuery {
+	query := &CredentialQuery{config: cq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(credential.Table, credential.FieldID, selector),
+			sqlgraph.To(credential.Table, credential.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, credential.SuccessorTable, credential.SuccessorColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Credential entity from the query.
 // Returns a *NotFoundError when no Credential was found.
 func (cq *CredentialQuery) First(ctx context.Context) (*Credential, error) {
@@ -261,12 +332,15 @@ func (cq *CredentialQuery) Clone() *CredentialQuery {
 		return nil
 	}
 	return &CredentialQuery{
-		config:      cq.config,
-		limit:       cq.limit,
-		offset:      cq.offset,
-		order:       append([]OrderFunc{}, cq.order...),
-		predicates:  append([]predicate.Credential{}, cq.predicates...),
-		withAccount: cq.withAccount.Clone(),
+		config:          cq.config,
+		limit:           cq.limit,
+		offset:          cq.offset,
+		order:           append([]OrderFunc{}, cq.order...),
+		predicates:      append([]predicate.Credential{}, cq.predicates...),
+		withAccount:     cq.withAccount.Clone(),
+		withTemplate:    cq.withTemplate.Clone(),
+		withPredecessor: cq.withPredecessor.Clone(),
+		withSuccessor:   cq.withSuccessor.Clone(),
 		// clone intermediate query.
 		sql:    cq.sql.Clone(),
 		path:   cq.path,
@@ -285,6 +359,39 @@ func (cq *CredentialQuery) WithAccount(opts ...func(*UserQuery)) *CredentialQuer
 	return cq
 }
 
+// WithTemplate tells the query-builder to eager-load the nodes that are connected to
+// the "template" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CredentialQuery) WithTemplate(opts ...func(*CredentialTemplateQuery)) *CredentialQuery {
+	query := &CredentialTemplateQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withTemplate = query
+	return cq
+}
+
+// WithPredecessor tells the query-builder to eager-load the nodes that are connected to
+// the "predecessor" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CredentialQuery) WithPredecessor(opts ...func(*CredentialQuery)) *CredentialQuery {
+	query := &CredentialQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withPredecessor = query
+	return cq
+}
+
+// WithSuccessor tells the query-builder to eager-load the nodes that are connected to
+// the "successor" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CredentialQuery) WithSuccessor(opts ...func(*CredentialQuery)) *CredentialQuery {
+	query := &CredentialQuery{config: cq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withSuccessor = query
+	return cq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -354,11 +461,14 @@ func (cq *CredentialQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*C
 		nodes       = []*Credential{}
 		withFKs     = cq.withFKs
 		_spec       = cq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [4]bool{
 			cq.withAccount != nil,
+			cq.withTemplate != nil,
+			cq.withPredecessor != nil,
+			cq.withSuccessor != nil,
 		}
 	)
-	if cq.withAccount != nil {
+	if cq.withAccount != nil || cq.withTemplate != nil || cq.withPredecessor != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -412,6 +522,92 @@ func (cq *CredentialQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*C
 		}
 	}
 
+	if query := cq.withTemplate; query != nil {
+		ids := make([]string, 0, len(nodes))
+		nodeids := make(map[string][]*Credential)
+		for i := range nodes {
+			if nodes[i].credential_template_credentials == nil {
+				continue
+			}
+			fk := *nodes[i].credential_template_credentials
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(credentialtemplate.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "credential_template_credentials" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Template = n
+			}
+		}
+	}
+
+	if query := cq.withPredecessor; query != nil {
+		ids := make([]string, 0, len(nodes))
+		nodeids := make(map[string][]*Credential)
+		for i := range nodes {
+			if nodes[i].credential_successor == nil {
+				continue
+			}
+			fk := *nodes[i].credential_successor
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(credential.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "credential_successor" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Predecessor = n
+			}
+		}
+	}
+
+	if query := cq.withSuccessor; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[string]*Credential)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.Credential(func(s *sql.Selector) {
+			s.Where(sql.InValues(credential.SuccessorColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.credential_successor
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "credential_successor" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "credential_successor" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Successor = n
+		}
+	}
+
 	return nodes, nil
 }
 
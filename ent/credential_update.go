@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/hesusruiz/vcbackend/ent/credential"
+	"github.com/hesusruiz/vcbackend/ent/credentialtemplate"
 	"github.com/hesusruiz/vcbackend/ent/predicate"
 	"github.com/hesusruiz/vcbackend/ent/user"
 )
@@ -49,6 +50,264 @@ func (cu *CredentialUpdate) SetRaw(u []uint8) *CredentialUpdate {
 	return cu
 }
 
+// SetStatus sets the "status" field.
+func (cu *CredentialUpdate) SetStatus(c credential.Status) *CredentialUpdate {
+	cu.mutation.SetStatus(c)
+	return cu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableStatus(c *credential.Status) *CredentialUpdate {
+	if c != nil {
+		cu.SetStatus(*c)
+	}
+	return cu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (cu *CredentialUpdate) SetExpiresAt(t time.Time) *CredentialUpdate {
+	cu.mutation.SetExpiresAt(t)
+	return cu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableExpiresAt(t *time.Time) *CredentialUpdate {
+	if t != nil {
+		cu.SetExpiresAt(*t)
+	}
+	return cu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (cu *CredentialUpdate) ClearExpiresAt() *CredentialUpdate {
+	cu.mutation.ClearExpiresAt()
+	return cu
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (cu *CredentialUpdate) SetRevokedAt(t time.Time) *CredentialUpdate {
+	cu.mutation.SetRevokedAt(t)
+	return cu
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableRevokedAt(t *time.Time) *CredentialUpdate {
+	if t != nil {
+		cu.SetRevokedAt(*t)
+	}
+	return cu
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (cu *CredentialUpdate) ClearRevokedAt() *CredentialUpdate {
+	cu.mutation.ClearRevokedAt()
+	return cu
+}
+
+// SetRenewalDueAt sets the "renewal_due_at" field.
+func (cu *CredentialUpdate) SetRenewalDueAt(t time.Time) *CredentialUpdate {
+	cu.mutation.SetRenewalDueAt(t)
+	return cu
+}
+
+// SetNillableRenewalDueAt sets the "renewal_due_at" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableRenewalDueAt(t *time.Time) *CredentialUpdate {
+	if t != nil {
+		cu.SetRenewalDueAt(*t)
+	}
+	return cu
+}
+
+// ClearRenewalDueAt clears the value of the "renewal_due_at" field.
+func (cu *CredentialUpdate) ClearRenewalDueAt() *CredentialUpdate {
+	cu.mutation.ClearRenewalDueAt()
+	return cu
+}
+
+// SetRenewalStartedAt sets the "renewal_started_at" field.
+func (cu *CredentialUpdate) SetRenewalStartedAt(t time.Time) *CredentialUpdate {
+	cu.mutation.SetRenewalStartedAt(t)
+	return cu
+}
+
+// SetNillableRenewalStartedAt sets the "renewal_started_at" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableRenewalStartedAt(t *time.Time) *CredentialUpdate {
+	if t != nil {
+		cu.SetRenewalStartedAt(*t)
+	}
+	return cu
+}
+
+// ClearRenewalStartedAt clears the value of the "renewal_started_at" field.
+func (cu *CredentialUpdate) ClearRenewalStartedAt() *CredentialUpdate {
+	cu.mutation.ClearRenewalStartedAt()
+	return cu
+}
+
+// SetIssuanceData sets the "issuance_data" field.
+func (cu *CredentialUpdate) SetIssuanceData(m map[string]interface{}) *CredentialUpdate {
+	cu.mutation.SetIssuanceData(m)
+	return cu
+}
+
+// ClearIssuanceData clears the value of the "issuance_data" field.
+func (cu *CredentialUpdate) ClearIssuanceData() *CredentialUpdate {
+	cu.mutation.ClearIssuanceData()
+	return cu
+}
+
+// SetTypes sets the "types" field.
+func (cu *CredentialUpdate) SetTypes(s []string) *CredentialUpdate {
+	cu.mutation.SetTypes(s)
+	return cu
+}
+
+// ClearTypes clears the value of the "types" field.
+func (cu *CredentialUpdate) ClearTypes() *CredentialUpdate {
+	cu.mutation.ClearTypes()
+	return cu
+}
+
+// SetCredentialType sets the "credential_type" field.
+func (cu *CredentialUpdate) SetCredentialType(s string) *CredentialUpdate {
+	cu.mutation.SetCredentialType(s)
+	return cu
+}
+
+// SetNillableCredentialType sets the "credential_type" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableCredentialType(s *string) *CredentialUpdate {
+	if s != nil {
+		cu.SetCredentialType(*s)
+	}
+	return cu
+}
+
+// ClearCredentialType clears the value of the "credential_type" field.
+func (cu *CredentialUpdate) ClearCredentialType() *CredentialUpdate {
+	cu.mutation.ClearCredentialType()
+	return cu
+}
+
+// SetIssuer sets the "issuer" field.
+func (cu *CredentialUpdate) SetIssuer(s string) *CredentialUpdate {
+	cu.mutation.SetIssuer(s)
+	return cu
+}
+
+// SetNillableIssuer sets the "issuer" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableIssuer(s *string) *CredentialUpdate {
+	if s != nil {
+		cu.SetIssuer(*s)
+	}
+	return cu
+}
+
+// ClearIssuer clears the value of the "issuer" field.
+func (cu *CredentialUpdate) ClearIssuer() *CredentialUpdate {
+	cu.mutation.ClearIssuer()
+	return cu
+}
+
+// SetSubject sets the "subject" field.
+func (cu *CredentialUpdate) SetSubject(s string) *CredentialUpdate {
+	cu.mutation.SetSubject(s)
+	return cu
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableSubject(s *string) *CredentialUpdate {
+	if s != nil {
+		cu.SetSubject(*s)
+	}
+	return cu
+}
+
+// ClearSubject clears the value of the "subject" field.
+func (cu *CredentialUpdate) ClearSubject() *CredentialUpdate {
+	cu.mutation.ClearSubject()
+	return cu
+}
+
+// SetHolderEmail sets the "holder_email" field.
+func (cu *CredentialUpdate) SetHolderEmail(s string) *CredentialUpdate {
+	cu.mutation.SetHolderEmail(s)
+	return cu
+}
+
+// SetNillableHolderEmail sets the "holder_email" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableHolderEmail(s *string) *CredentialUpdate {
+	if s != nil {
+		cu.SetHolderEmail(*s)
+	}
+	return cu
+}
+
+// ClearHolderEmail clears the value of the "holder_email" field.
+func (cu *CredentialUpdate) ClearHolderEmail() *CredentialUpdate {
+	cu.mutation.ClearHolderEmail()
+	return cu
+}
+
+// SetTemplateName sets the "template_name" field.
+func (cu *CredentialUpdate) SetTemplateName(s string) *CredentialUpdate {
+	cu.mutation.SetTemplateName(s)
+	return cu
+}
+
+// SetNillableTemplateName sets the "template_name" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableTemplateName(s *string) *CredentialUpdate {
+	if s != nil {
+		cu.SetTemplateName(*s)
+	}
+	return cu
+}
+
+// ClearTemplateName clears the value of the "template_name" field.
+func (cu *CredentialUpdate) ClearTemplateName() *CredentialUpdate {
+	cu.mutation.ClearTemplateName()
+	return cu
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (cu *CredentialUpdate) SetIssuedAt(t time.Time) *CredentialUpdate {
+	cu.mutation.SetIssuedAt(t)
+	return cu
+}
+
+// SetNillableIssuedAt sets the "issued_at" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableIssuedAt(t *time.Time) *CredentialUpdate {
+	if t != nil {
+		cu.SetIssuedAt(*t)
+	}
+	return cu
+}
+
+// ClearIssuedAt clears the value of the "issued_at" field.
+func (cu *CredentialUpdate) ClearIssuedAt() *CredentialUpdate {
+	cu.mutation.ClearIssuedAt()
+	return cu
+}
+
+// SetIndexedAt sets the "indexed_at" field.
+func (cu *CredentialUpdate) SetIndexedAt(t time.Time) *CredentialUpdate {
+	cu.mutation.SetIndexedAt(t)
+	return cu
+}
+
+// SetNillableIndexedAt sets the "indexed_at" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableIndexedAt(t *time.Time) *CredentialUpdate {
+	if t != nil {
+		cu.SetIndexedAt(*t)
+	}
+	return cu
+}
+
+// ClearIndexedAt clears the value of the "indexed_at" field.
+func (cu *CredentialUpdate) ClearIndexedAt() *CredentialUpdate {
+	cu.mutation.ClearIndexedAt()
+	return cu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (cu *CredentialUpdate) SetUpdatedAt(t time.Time) *CredentialUpdate {
 	cu.mutation.SetUpdatedAt(t)
@@ -82,6 +341,63 @@ func (cu *CredentialUpdate) SetAccount(u *User) *CredentialUpdate {
 	return cu.SetAccountID(u.ID)
 }
 
+// SetTemplateID sets the "template" edge to the CredentialTemplate entity by ID.
+func (cu *CredentialUpdate) SetTemplateID(id string) *CredentialUpdate {
+	cu.mutation.SetTemplateID(id)
+	return cu
+}
+
+// SetNillableTemplateID sets the "template" edge to the CredentialTemplate entity by ID if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableTemplateID(id *string) *CredentialUpdate {
+	if id != nil {
+		cu = cu.SetTemplateID(*id)
+	}
+	return cu
+}
+
+// SetTemplate sets the "template" edge to the CredentialTemplate entity.
+func (cu *CredentialUpdate) SetTemplate(c *CredentialTemplate) *CredentialUpdate {
+	return cu.SetTemplateID(c.ID)
+}
+
+// SetPredecessorID sets the "predecessor" edge to the Credential entity by ID.
+func (cu *CredentialUpdate) SetPredecessorID(id string) *CredentialUpdate {
+	cu.mutation.SetPredecessorID(id)
+	return cu
+}
+
+// SetNillablePredecessorID sets the "predecessor" edge to the Credential entity by ID if the given value is not nil.
+func (cu *CredentialUpdate) SetNillablePredecessorID(id *string) *CredentialUpdate {
+	if id != nil {
+		cu = cu.SetPredecessorID(*id)
+	}
+	return cu
+}
+
+// SetPredecessor sets the "predecessor" edge to the Credential entity.
+func (cu *CredentialUpdate) SetPredecessor(c *Credential) *CredentialUpdate {
+	return cu.SetPredecessorID(c.ID)
+}
+
+// SetSuccessorID sets the "successor" edge to the Credential entity by ID.
+func (cu *CredentialUpdate) SetSuccessorID(id string) *CredentialUpdate {
+	cu.mutation.SetSuccessorID(id)
+	return cu
+}
+
+// SetNillableSuccessorID sets the "successor" edge to the Credential entity by ID if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableSuccessorID(id *string) *CredentialUpdate {
+	if id != nil {
+		cu = cu.SetSuccessorID(*id)
+	}
+	return cu
+}
+
+// SetSuccessor sets the "successor" edge to the Credential entity.
+func (cu *CredentialUpdate) SetSuccessor(c *Credential) *CredentialUpdate {
+	return cu.SetSuccessorID(c.ID)
+}
+
 // Mutation returns the CredentialMutation object of the builder.
 func (cu *CredentialUpdate) Mutation() *CredentialMutation {
 	return cu.mutation
@@ -93,6 +409,24 @@ func (cu *CredentialUpdate) ClearAccount() *CredentialUpdate {
 	return cu
 }
 
+// ClearTemplate clears the "template" edge to the CredentialTemplate entity.
+func (cu *CredentialUpdate) ClearTemplate() *CredentialUpdate {
+	cu.mutation.ClearTemplate()
+	return cu
+}
+
+// ClearPredecessor clears the "predecessor" edge to the Credential entity.
+func (cu *CredentialUpdate) ClearPredecessor() *CredentialUpdate {
+	cu.mutation.ClearPredecessor()
+	return cu
+}
+
+// ClearSuccessor clears the "successor" edge to the Credential entity.
+func (cu *CredentialUpdate) ClearSuccessor() *CredentialUpdate {
+	cu.mutation.ClearSuccessor()
+	return cu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (cu *CredentialUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -100,6 +434,9 @@ func (cu *CredentialUpdate) Save(ctx context.Context) (int, error) {
 		affected int
 	)
 	if len(cu.hooks) == 0 {
+		if err = cu.check(); err != nil {
+			return 0, err
+		}
 		affected, err = cu.sqlSave(ctx)
 	} else {
 		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
@@ -107,6 +444,9 @@ func (cu *CredentialUpdate) Save(ctx context.Context) (int, error) {
 			if !ok {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
+			if err = cu.check(); err != nil {
+				return 0, err
+			}
 			cu.mutation = mutation
 			affected, err = cu.sqlSave(ctx)
 			mutation.done = true
@@ -147,6 +487,16 @@ func (cu *CredentialUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (cu *CredentialUpdate) check() error {
+	if v, ok := cu.mutation.Status(); ok {
+		if err := credential.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Credential.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (cu *CredentialUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
@@ -179,84 +529,623 @@ func (cu *CredentialUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: credential.FieldRaw,
 		})
 	}
-	if value, ok := cu.mutation.UpdatedAt(); ok {
+	if value, ok := cu.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: credential.FieldStatus,
+		})
+	}
+	if value, ok := cu.mutation.ExpiresAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
 			Value:  value,
-			Column: credential.FieldUpdatedAt,
+			Column: credential.FieldExpiresAt,
 		})
 	}
-	if cu.mutation.AccountCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   credential.AccountTable,
-			Columns: []string{credential.AccountColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: &sqlgraph.FieldSpec{
-					Type:   field.TypeString,
-					Column: user.FieldID,
-				},
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	if cu.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: credential.FieldExpiresAt,
+		})
 	}
-	if nodes := cu.mutation.AccountIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   credential.AccountTable,
-			Columns: []string{credential.AccountColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: &sqlgraph.FieldSpec{
-					Type:   field.TypeString,
-					Column: user.FieldID,
-				},
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	if value, ok := cu.mutation.RevokedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: credential.FieldRevokedAt,
+		})
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{credential.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+	if cu.mutation.RevokedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: credential.FieldRevokedAt,
+		})
+	}
+	if value, ok := cu.mutation.RenewalDueAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: credential.FieldRenewalDueAt,
+		})
+	}
+	if cu.mutation.RenewalDueAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: credential.FieldRenewalDueAt,
+		})
+	}
+	if value, ok := cu.mutation.RenewalStartedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: credential.FieldRenewalStartedAt,
+		})
+	}
+	if cu.mutation.RenewalStartedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: credential.FieldRenewalStartedAt,
+		})
+	}
+	if value, ok := cu.mutation.IssuanceData(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: credential.FieldIssuanceData,
+		})
+	}
+	if cu.mutation.IssuanceDataCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: credential.FieldIssuanceData,
+		})
+	}
+	if value, ok := cu.mutation.Types(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: credential.FieldTypes,
+		})
+	}
+	if cu.mutation.TypesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: credential.FieldTypes,
+		})
+	}
+	if value, ok := cu.mutation.CredentialType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: credential.FieldCredentialType,
+		})
+	}
+	if cu.mutation.CredentialTypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: credential.FieldCredentialType,
+		})
+	}
+	if value, ok := cu.mutation.Issuer(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: credential.FieldIssuer,
+		})
+	}
+	if cu.mutation.IssuerCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: credential.FieldIssuer,
+		})
+	}
+	if value, ok := cu.mutation.Subject(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: credential.FieldSubject,
+		})
+	}
+	if cu.mutation.SubjectCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: credential.FieldSubject,
+		})
+	}
+	if value, ok := cu.mutation.HolderEmail(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: credential.FieldHolderEmail,
+		})
+	}
+	if cu.mutation.HolderEmailCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: credential.FieldHolderEmail,
+		})
+	}
+	if value, ok := cu.mutation.TemplateName(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: credential.FieldTemplateName,
+		})
+	}
+	if cu.mutation.TemplateNameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: credential.FieldTemplateName,
+		})
+	}
+	if value, ok := cu.mutation.IssuedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: credential.FieldIssuedAt,
+		})
+	}
+	if cu.mutation.IssuedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: credential.FieldIssuedAt,
+		})
+	}
+	if value, ok := cu.mutation.IndexedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: credential.FieldIndexedAt,
+		})
+	}
+	if cu.mutation.IndexedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: credential.FieldIndexedAt,
+		})
+	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: credential.FieldUpdatedAt,
+		})
+	}
+	if cu.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   credential.AccountTable,
+			Columns: []string{credential.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   credential.AccountTable,
+			Columns: []string{credential.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.TemplateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   credential.TemplateTable,
+			Columns: []string{credential.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credentialtemplate.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.TemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   credential.TemplateTable,
+			Columns: []string{credential.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credentialtemplate.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.PredecessorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   credential.PredecessorTable,
+			Columns: []string{credential.PredecessorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credential.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.PredecessorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   credential.PredecessorTable,
+			Columns: []string{credential.PredecessorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credential.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.SuccessorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   credential.SuccessorTable,
+			Columns: []string{credential.SuccessorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credential.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.SuccessorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   credential.SuccessorTable,
+			Columns: []string{credential.SuccessorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credential.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{credential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// CredentialUpdateOne is the builder for updating a single Credential entity.
+type CredentialUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CredentialMutation
+}
+
+// SetType sets the "type" field.
+func (cuo *CredentialUpdateOne) SetType(s string) *CredentialUpdateOne {
+	cuo.mutation.SetType(s)
+	return cuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableType(s *string) *CredentialUpdateOne {
+	if s != nil {
+		cuo.SetType(*s)
+	}
+	return cuo
+}
+
+// SetRaw sets the "raw" field.
+func (cuo *CredentialUpdateOne) SetRaw(u []uint8) *CredentialUpdateOne {
+	cuo.mutation.SetRaw(u)
+	return cuo
+}
+
+// SetStatus sets the "status" field.
+func (cuo *CredentialUpdateOne) SetStatus(c credential.Status) *CredentialUpdateOne {
+	cuo.mutation.SetStatus(c)
+	return cuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableStatus(c *credential.Status) *CredentialUpdateOne {
+	if c != nil {
+		cuo.SetStatus(*c)
+	}
+	return cuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (cuo *CredentialUpdateOne) SetExpiresAt(t time.Time) *CredentialUpdateOne {
+	cuo.mutation.SetExpiresAt(t)
+	return cuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableExpiresAt(t *time.Time) *CredentialUpdateOne {
+	if t != nil {
+		cuo.SetExpiresAt(*t)
+	}
+	return cuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (cuo *CredentialUpdateOne) ClearExpiresAt() *CredentialUpdateOne {
+	cuo.mutation.ClearExpiresAt()
+	return cuo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (cuo *CredentialUpdateOne) SetRevokedAt(t time.Time) *CredentialUpdateOne {
+	cuo.mutation.SetRevokedAt(t)
+	return cuo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableRevokedAt(t *time.Time) *CredentialUpdateOne {
+	if t != nil {
+		cuo.SetRevokedAt(*t)
+	}
+	return cuo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (cuo *CredentialUpdateOne) ClearRevokedAt() *CredentialUpdateOne {
+	cuo.mutation.ClearRevokedAt()
+	return cuo
+}
+
+// SetRenewalDueAt sets the "renewal_due_at" field.
+func (cuo *CredentialUpdateOne) SetRenewalDueAt(t time.Time) *CredentialUpdateOne {
+	cuo.mutation.SetRenewalDueAt(t)
+	return cuo
+}
+
+// SetNillableRenewalDueAt sets the "renewal_due_at" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableRenewalDueAt(t *time.Time) *CredentialUpdateOne {
+	if t != nil {
+		cuo.SetRenewalDueAt(*t)
+	}
+	return cuo
+}
+
+// ClearRenewalDueAt clears the value of the "renewal_due_at" field.
+func (cuo *CredentialUpdateOne) ClearRenewalDueAt() *CredentialUpdateOne {
+	cuo.mutation.ClearRenewalDueAt()
+	return cuo
+}
+
+// SetRenewalStartedAt sets the "renewal_started_at" field.
+func (cuo *CredentialUpdateOne) SetRenewalStartedAt(t time.Time) *CredentialUpdateOne {
+	cuo.mutation.SetRenewalStartedAt(t)
+	return cuo
+}
+
+// SetNillableRenewalStartedAt sets the "renewal_started_at" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableRenewalStartedAt(t *time.Time) *CredentialUpdateOne {
+	if t != nil {
+		cuo.SetRenewalStartedAt(*t)
+	}
+	return cuo
+}
+
+// ClearRenewalStartedAt clears the value of the "renewal_started_at" field.
+func (cuo *CredentialUpdateOne) ClearRenewalStartedAt() *CredentialUpdateOne {
+	cuo.mutation.ClearRenewalStartedAt()
+	return cuo
+}
+
+// SetIssuanceData sets the "issuance_data" field.
+func (cuo *CredentialUpdateOne) SetIssuanceData(m map[string]interface{}) *CredentialUpdateOne {
+	cuo.mutation.SetIssuanceData(m)
+	return cuo
+}
+
+// ClearIssuanceData clears the value of the "issuance_data" field.
+func (cuo *CredentialUpdateOne) ClearIssuanceData() *CredentialUpdateOne {
+	cuo.mutation.ClearIssuanceData()
+	return cuo
+}
+
+// SetTypes sets the "types" field.
+func (cuo *CredentialUpdateOne) SetTypes(s []string) *CredentialUpdateOne {
+	cuo.mutation.SetTypes(s)
+	return cuo
+}
+
+// ClearTypes clears the value of the "types" field.
+func (cuo *CredentialUpdateOne) ClearTypes() *CredentialUpdateOne {
+	cuo.mutation.ClearTypes()
+	return cuo
+}
+
+// SetCredentialType sets the "credential_type" field.
+func (cuo *CredentialUpdateOne) SetCredentialType(s string) *CredentialUpdateOne {
+	cuo.mutation.SetCredentialType(s)
+	return cuo
+}
+
+// SetNillableCredentialType sets the "credential_type" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableCredentialType(s *string) *CredentialUpdateOne {
+	if s != nil {
+		cuo.SetCredentialType(*s)
+	}
+	return cuo
+}
+
+// ClearCredentialType clears the value of the "credential_type" field.
+func (cuo *CredentialUpdateOne) ClearCredentialType() *CredentialUpdateOne {
+	cuo.mutation.ClearCredentialType()
+	return cuo
+}
+
+// SetIssuer sets the "issuer" field.
+func (cuo *CredentialUpdateOne) SetIssuer(s string) *CredentialUpdateOne {
+	cuo.mutation.SetIssuer(s)
+	return cuo
+}
+
+// SetNillableIssuer sets the "issuer" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableIssuer(s *string) *CredentialUpdateOne {
+	if s != nil {
+		cuo.SetIssuer(*s)
+	}
+	return cuo
+}
+
+// ClearIssuer clears the value of the "issuer" field.
+func (cuo *CredentialUpdateOne) ClearIssuer() *CredentialUpdateOne {
+	cuo.mutation.ClearIssuer()
+	return cuo
+}
+
+// SetSubject sets the "subject" field.
+func (cuo *CredentialUpdateOne) SetSubject(s string) *CredentialUpdateOne {
+	cuo.mutation.SetSubject(s)
+	return cuo
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableSubject(s *string) *CredentialUpdateOne {
+	if s != nil {
+		cuo.SetSubject(*s)
+	}
+	return cuo
+}
+
+// ClearSubject clears the value of the "subject" field.
+func (cuo *CredentialUpdateOne) ClearSubject() *CredentialUpdateOne {
+	cuo.mutation.ClearSubject()
+	return cuo
+}
+
+// SetHolderEmail sets the "holder_email" field.
+func (cuo *CredentialUpdateOne) SetHolderEmail(s string) *CredentialUpdateOne {
+	cuo.mutation.SetHolderEmail(s)
+	return cuo
+}
+
+// SetNillableHolderEmail sets the "holder_email" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableHolderEmail(s *string) *CredentialUpdateOne {
+	if s != nil {
+		cuo.SetHolderEmail(*s)
+	}
+	return cuo
+}
+
+// ClearHolderEmail clears the value of the "holder_email" field.
+func (cuo *CredentialUpdateOne) ClearHolderEmail() *CredentialUpdateOne {
+	cuo.mutation.ClearHolderEmail()
+	return cuo
+}
+
+// SetTemplateName sets the "template_name" field.
+func (cuo *CredentialUpdateOne) SetTemplateName(s string) *CredentialUpdateOne {
+	cuo.mutation.SetTemplateName(s)
+	return cuo
+}
+
+// SetNillableTemplateName sets the "template_name" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableTemplateName(s *string) *CredentialUpdateOne {
+	if s != nil {
+		cuo.SetTemplateName(*s)
+	}
+	return cuo
+}
+
+// ClearTemplateName clears the value of the "template_name" field.
+func (cuo *CredentialUpdateOne) ClearTemplateName() *CredentialUpdateOne {
+	cuo.mutation.ClearTemplateName()
+	return cuo
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (cuo *CredentialUpdateOne) SetIssuedAt(t time.Time) *CredentialUpdateOne {
+	cuo.mutation.SetIssuedAt(t)
+	return cuo
+}
+
+// SetNillableIssuedAt sets the "issued_at" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableIssuedAt(t *time.Time) *CredentialUpdateOne {
+	if t != nil {
+		cuo.SetIssuedAt(*t)
 	}
-	return n, nil
+	return cuo
 }
 
-// CredentialUpdateOne is the builder for updating a single Credential entity.
-type CredentialUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *CredentialMutation
+// ClearIssuedAt clears the value of the "issued_at" field.
+func (cuo *CredentialUpdateOne) ClearIssuedAt() *CredentialUpdateOne {
+	cuo.mutation.ClearIssuedAt()
+	return cuo
 }
 
-// SetType sets the "type" field.
-func (cuo *CredentialUpdateOne) SetType(s string) *CredentialUpdateOne {
-	cuo.mutation.SetType(s)
+// SetIndexedAt sets the "indexed_at" field.
+func (cuo *CredentialUpdateOne) SetIndexedAt(t time.Time) *CredentialUpdateOne {
+	cuo.mutation.SetIndexedAt(t)
 	return cuo
 }
 
-// SetNillableType sets the "type" field if the given value is not nil.
-func (cuo *CredentialUpdateOne) SetNillableType(s *string) *CredentialUpdateOne {
-	if s != nil {
-		cuo.SetType(*s)
+// SetNillableIndexedAt sets the "indexed_at" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableIndexedAt(t *time.Time) *CredentialUpdateOne {
+	if t != nil {
+		cuo.SetIndexedAt(*t)
 	}
 	return cuo
 }
 
-// SetRaw sets the "raw" field.
-func (cuo *CredentialUpdateOne) SetRaw(u []uint8) *CredentialUpdateOne {
-	cuo.mutation.SetRaw(u)
+// ClearIndexedAt clears the value of the "indexed_at" field.
+func (cuo *CredentialUpdateOne) ClearIndexedAt() *CredentialUpdateOne {
+	cuo.mutation.ClearIndexedAt()
 	return cuo
 }
 
@@ -293,6 +1182,63 @@ func (cuo *CredentialUpdateOne) SetAccount(u *User) *CredentialUpdateOne {
 	return cuo.SetAccountID(u.ID)
 }
 
+// SetTemplateID sets the "template" edge to the CredentialTemplate entity by ID.
+func (cuo *CredentialUpdateOne) SetTemplateID(id string) *CredentialUpdateOne {
+	cuo.mutation.SetTemplateID(id)
+	return cuo
+}
+
+// SetNillableTemplateID sets the "template" edge to the CredentialTemplate entity by ID if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableTemplateID(id *string) *CredentialUpdateOne {
+	if id != nil {
+		cuo = cuo.SetTemplateID(*id)
+	}
+	return cuo
+}
+
+// SetTemplate sets the "template" edge to the CredentialTemplate entity.
+func (cuo *CredentialUpdateOne) SetTemplate(c *CredentialTemplate) *CredentialUpdateOne {
+	return cuo.SetTemplateID(c.ID)
+}
+
+// SetPredecessorID sets the "predecessor" edge to the Credential entity by ID.
+func (cuo *CredentialUpdateOne) SetPredecessorID(id string) *CredentialUpdateOne {
+	cuo.mutation.SetPredecessorID(id)
+	return cuo
+}
+
+// SetNillablePredecessorID sets the "predecessor" edge to the Credential entity by ID if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillablePredecessorID(id *string) *CredentialUpdateOne {
+	if id != nil {
+		cuo = cuo.SetPredecessorID(*id)
+	}
+	return cuo
+}
+
+// SetPredecessor sets the "predecessor" edge to the Credential entity.
+func (cuo *CredentialUpdateOne) SetPredecessor(c *Credential) *CredentialUpdateOne {
+	return cuo.SetPredecessorID(c.ID)
+}
+
+// SetSuccessorID sets the "successor" edge to the Credential entity by ID.
+func (cuo *CredentialUpdateOne) SetSuccessorID(id string) *CredentialUpdateOne {
+	cuo.mutation.SetSuccessorID(id)
+	return cuo
+}
+
+// SetNillableSuccessorID sets the "successor" edge to the Credential entity by ID if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableSuccessorID(id *string) *CredentialUpdateOne {
+	if id != nil {
+		cuo = cuo.SetSuccessorID(*id)
+	}
+	return cuo
+}
+
+// SetSuccessor sets the "successor" edge to the Credential entity.
+func (cuo *CredentialUpdateOne) SetSuccessor(c *Credential) *CredentialUpdateOne {
+	return cuo.SetSuccessorID(c.ID)
+}
+
 // Mutation returns the CredentialMutation object of the builder.
 func (cuo *CredentialUpdateOne) Mutation() *CredentialMutation {
 	return cuo.mutation
@@ -304,6 +1250,24 @@ func (cuo *CredentialUpdateOne) ClearAccount() *CredentialUpdateOne {
 	return cuo
 }
 
+// ClearTemplate clears the "template" edge to the CredentialTemplate entity.
+func (cuo *CredentialUpdateOne) ClearTemplate() *CredentialUpdateOne {
+	cuo.mutation.ClearTemplate()
+	return cuo
+}
+
+// ClearPredecessor clears the "predecessor" edge to the Credential entity.
+func (cuo *CredentialUpdateOne) ClearPredecessor() *CredentialUpdateOne {
+	cuo.mutation.ClearPredecessor()
+	return cuo
+}
+
+// ClearSuccessor clears the "successor" edge to the Credential entity.
+func (cuo *CredentialUpdateOne) ClearSuccessor() *CredentialUpdateOne {
+	cuo.mutation.ClearSuccessor()
+	return cuo
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (cuo *CredentialUpdateOne) Select(field string, fields ...string) *CredentialUpdateOne {
@@ -318,6 +1282,9 @@ func (cuo *CredentialUpdateOne) Save(ctx context.Context) (*Credential, error) {
 		node *Credential
 	)
 	if len(cuo.hooks) == 0 {
+		if err = cuo.check(); err != nil {
+			return nil, err
+		}
 		node, err = cuo.sqlSave(ctx)
 	} else {
 		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
@@ -325,6 +1292,9 @@ func (cuo *CredentialUpdateOne) Save(ctx context.Context) (*Credential, error) {
 			if !ok {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
+			if err = cuo.check(); err != nil {
+				return nil, err
+			}
 			cuo.mutation = mutation
 			node, err = cuo.sqlSave(ctx)
 			mutation.done = true
@@ -371,6 +1341,16 @@ func (cuo *CredentialUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CredentialUpdateOne) check() error {
+	if v, ok := cuo.mutation.Status(); ok {
+		if err := credential.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Credential.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (cuo *CredentialUpdateOne) sqlSave(ctx context.Context) (_node *Credential, err error) {
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
@@ -420,6 +1400,182 @@ func (cuo *CredentialUpdateOne) sqlSave(ctx context.Context) (_node *Credential,
 			Column: credential.FieldRaw,
 		})
 	}
+	if value, ok := cuo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: credential.FieldStatus,
+		})
+	}
+	if value, ok := cuo.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: credential.FieldExpiresAt,
+		})
+	}
+	if cuo.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: credential.FieldExpiresAt,
+		})
+	}
+	if value, ok := cuo.mutation.RevokedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: credential.FieldRevokedAt,
+		})
+	}
+	if cuo.mutation.RevokedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: credential.FieldRevokedAt,
+		})
+	}
+	if value, ok := cuo.mutation.RenewalDueAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: credential.FieldRenewalDueAt,
+		})
+	}
+	if cuo.mutation.RenewalDueAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: credential.FieldRenewalDueAt,
+		})
+	}
+	if value, ok := cuo.mutation.RenewalStartedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: credential.FieldRenewalStartedAt,
+		})
+	}
+	if cuo.mutation.RenewalStartedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: credential.FieldRenewalStartedAt,
+		})
+	}
+	if value, ok := cuo.mutation.IssuanceData(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: credential.FieldIssuanceData,
+		})
+	}
+	if cuo.mutation.IssuanceDataCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: credential.FieldIssuanceData,
+		})
+	}
+	if value, ok := cuo.mutation.Types(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: credential.FieldTypes,
+		})
+	}
+	if cuo.mutation.TypesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: credential.FieldTypes,
+		})
+	}
+	if value, ok := cuo.mutation.CredentialType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: credential.FieldCredentialType,
+		})
+	}
+	if cuo.mutation.CredentialTypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: credential.FieldCredentialType,
+		})
+	}
+	if value, ok := cuo.mutation.Issuer(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: credential.FieldIssuer,
+		})
+	}
+	if cuo.mutation.IssuerCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: credential.FieldIssuer,
+		})
+	}
+	if value, ok := cuo.mutation.Subject(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: credential.FieldSubject,
+		})
+	}
+	if cuo.mutation.SubjectCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: credential.FieldSubject,
+		})
+	}
+	if value, ok := cuo.mutation.HolderEmail(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: credential.FieldHolderEmail,
+		})
+	}
+	if cuo.mutation.HolderEmailCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: credential.FieldHolderEmail,
+		})
+	}
+	if value, ok := cuo.mutation.TemplateName(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: credential.FieldTemplateName,
+		})
+	}
+	if cuo.mutation.TemplateNameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: credential.FieldTemplateName,
+		})
+	}
+	if value, ok := cuo.mutation.IssuedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: credential.FieldIssuedAt,
+		})
+	}
+	if cuo.mutation.IssuedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: credential.FieldIssuedAt,
+		})
+	}
+	if value, ok := cuo.mutation.IndexedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: credential.FieldIndexedAt,
+		})
+	}
+	if cuo.mutation.IndexedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: credential.FieldIndexedAt,
+		})
+	}
 	if value, ok := cuo.mutation.UpdatedAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -462,6 +1618,111 @@ func (cuo *CredentialUpdateOne) sqlSave(ctx context.Context) (_node *Credential,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cuo.mutation.TemplateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   credential.TemplateTable,
+			Columns: []string{credential.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credentialtemplate.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.TemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   credential.TemplateTable,
+			Columns: []string{credential.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credentialtemplate.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.PredecessorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   credential.PredecessorTable,
+			Columns: []string{credential.PredecessorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credential.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.PredecessorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   credential.PredecessorTable,
+			Columns: []string{credential.PredecessorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credential.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.SuccessorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   credential.SuccessorTable,
+			Columns: []string{credential.SuccessorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credential.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.SuccessorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   credential.SuccessorTable,
+			Columns: []string{credential.SuccessorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credential.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Credential{config: cuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
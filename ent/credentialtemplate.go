@@ -0,0 +1,237 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/hesusruiz/vcbackend/ent/credentialtemplate"
+)
+
+// CredentialTemplate is the model entity for the CredentialTemplate schema.
+type CredentialTemplate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// Status holds the value of the "status" field.
+	Status credentialtemplate.Status `json:"status,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// SampleData holds the value of the "sample_data" field.
+	SampleData map[string]interface{} `json:"sample_data,omitempty"`
+	// Builtin holds the value of the "builtin" field.
+	Builtin bool `json:"builtin,omitempty"`
+	// PublishedAt holds the value of the "published_at" field.
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CredentialTemplateQuery when eager-loading is set.
+	Edges CredentialTemplateEdges `json:"edges"`
+}
+
+// CredentialTemplateEdges holds the relations/edges for other nodes in the graph.
+type CredentialTemplateEdges struct {
+	// Credentials holds the value of the credentials edge.
+	Credentials []*Credential `json:"credentials,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CredentialsOrErr returns the Credentials value or an error if the edge
+// was not loaded in eager-loading.
+func (e CredentialTemplateEdges) CredentialsOrErr() ([]*Credential, error) {
+	if e.loadedTypes[0] {
+		return e.Credentials, nil
+	}
+	return nil, &NotLoadedError{edge: "credentials"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CredentialTemplate) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case credentialtemplate.FieldSampleData:
+			values[i] = new([]byte)
+		case credentialtemplate.FieldBuiltin:
+			values[i] = new(sql.NullBool)
+		case credentialtemplate.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case credentialtemplate.FieldID, credentialtemplate.FieldName, credentialtemplate.FieldStatus, credentialtemplate.FieldDescription, credentialtemplate.FieldContent:
+			values[i] = new(sql.NullString)
+		case credentialtemplate.FieldPublishedAt, credentialtemplate.FieldCreatedAt, credentialtemplate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type CredentialTemplate", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CredentialTemplate fields.
+func (ct *CredentialTemplate) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case credentialtemplate.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ct.ID = value.String
+			}
+		case credentialtemplate.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ct.Name = value.String
+			}
+		case credentialtemplate.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				ct.Version = int(value.Int64)
+			}
+		case credentialtemplate.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ct.Status = credentialtemplate.Status(value.String)
+			}
+		case credentialtemplate.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				ct.Description = value.String
+			}
+		case credentialtemplate.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				ct.Content = value.String
+			}
+		case credentialtemplate.FieldSampleData:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field sample_data", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ct.SampleData); err != nil {
+					return fmt.Errorf("unmarshal field sample_data: %w", err)
+				}
+			}
+		case credentialtemplate.FieldBuiltin:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field builtin", values[i])
+			} else if value.Valid {
+				ct.Builtin = value.Bool
+			}
+		case credentialtemplate.FieldPublishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field published_at", values[i])
+			} else if value.Valid {
+				ct.PublishedAt = new(time.Time)
+				*ct.PublishedAt = value.Time
+			}
+		case credentialtemplate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ct.CreatedAt = value.Time
+			}
+		case credentialtemplate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ct.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryCredentials queries the "credentials" edge of the CredentialTemplate entity.
+func (ct *CredentialTemplate) QueryCredentials() *CredentialQuery {
+	return (&CredentialTemplateClient{config: ct.config}).QueryCredentials(ct)
+}
+
+// Update returns a builder for updating this CredentialTemplate.
+// Note that you need to call CredentialTemplate.Unwrap() before calling this method if this CredentialTemplate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ct *CredentialTemplate) Update() *CredentialTemplateUpdateOne {
+	return (&CredentialTemplateClient{config: ct.config}).UpdateOne(ct)
+}
+
+// Unwrap unwraps the CredentialTemplate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ct *CredentialTemplate) Unwrap() *CredentialTemplate {
+	_tx, ok := ct.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CredentialTemplate is not a transactional entity")
+	}
+	ct.config.driver = _tx.drv
+	return ct
+}
+
+// String implements the fmt.Stringer.
+func (ct *CredentialTemplate) String() string {
+	var builder strings.Builder
+	builder.WriteString("CredentialTemplate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ct.ID))
+	builder.WriteString("name=")
+	builder.WriteString(ct.Name)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", ct.Version))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ct.Status))
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(ct.Description)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(ct.Content)
+	builder.WriteString(", ")
+	builder.WriteString("sample_data=")
+	builder.WriteString(fmt.Sprintf("%v", ct.SampleData))
+	builder.WriteString(", ")
+	builder.WriteString("builtin=")
+	builder.WriteString(fmt.Sprintf("%v", ct.Builtin))
+	builder.WriteString(", ")
+	if v := ct.PublishedAt; v != nil {
+		builder.WriteString("published_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ct.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ct.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CredentialTemplates is a parsable slice of CredentialTemplate.
+type CredentialTemplates []*CredentialTemplate
+
+func (ct CredentialTemplates) config(cfg config) {
+	for _i := range ct {
+		ct[_i].config = cfg
+	}
+}
@@ -0,0 +1,507 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/credential"
+	"github.com/hesusruiz/vcbackend/ent/credentialtemplate"
+)
+
+// CredentialTemplateCreate is the builder for creating a CredentialTemplate entity.
+type CredentialTemplateCreate struct {
+	config
+	mutation *CredentialTemplateMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (ctc *CredentialTemplateCreate) SetName(s string) *CredentialTemplateCreate {
+	ctc.mutation.SetName(s)
+	return ctc
+}
+
+// SetVersion sets the "version" field.
+func (ctc *CredentialTemplateCreate) SetVersion(i int) *CredentialTemplateCreate {
+	ctc.mutation.SetVersion(i)
+	return ctc
+}
+
+// SetStatus sets the "status" field.
+func (ctc *CredentialTemplateCreate) SetStatus(c credentialtemplate.Status) *CredentialTemplateCreate {
+	ctc.mutation.SetStatus(c)
+	return ctc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ctc *CredentialTemplateCreate) SetNillableStatus(c *credentialtemplate.Status) *CredentialTemplateCreate {
+	if c != nil {
+		ctc.SetStatus(*c)
+	}
+	return ctc
+}
+
+// SetDescription sets the "description" field.
+func (ctc *CredentialTemplateCreate) SetDescription(s string) *CredentialTemplateCreate {
+	ctc.mutation.SetDescription(s)
+	return ctc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ctc *CredentialTemplateCreate) SetNillableDescription(s *string) *CredentialTemplateCreate {
+	if s != nil {
+		ctc.SetDescription(*s)
+	}
+	return ctc
+}
+
+// SetContent sets the "content" field.
+func (ctc *CredentialTemplateCreate) SetContent(s string) *CredentialTemplateCreate {
+	ctc.mutation.SetContent(s)
+	return ctc
+}
+
+// SetSampleData sets the "sample_data" field.
+func (ctc *CredentialTemplateCreate) SetSampleData(m map[string]interface{}) *CredentialTemplateCreate {
+	ctc.mutation.SetSampleData(m)
+	return ctc
+}
+
+// SetBuiltin sets the "builtin" field.
+func (ctc *CredentialTemplateCreate) SetBuiltin(b bool) *CredentialTemplateCreate {
+	ctc.mutation.SetBuiltin(b)
+	return ctc
+}
+
+// SetNillableBuiltin sets the "builtin" field if the given value is not nil.
+func (ctc *CredentialTemplateCreate) SetNillableBuiltin(b *bool) *CredentialTemplateCreate {
+	if b != nil {
+		ctc.SetBuiltin(*b)
+	}
+	return ctc
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (ctc *CredentialTemplateCreate) SetPublishedAt(t time.Time) *CredentialTemplateCreate {
+	ctc.mutation.SetPublishedAt(t)
+	return ctc
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (ctc *CredentialTemplateCreate) SetNillablePublishedAt(t *time.Time) *CredentialTemplateCreate {
+	if t != nil {
+		ctc.SetPublishedAt(*t)
+	}
+	return ctc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ctc *CredentialTemplateCreate) SetCreatedAt(t time.Time) *CredentialTemplateCreate {
+	ctc.mutation.SetCreatedAt(t)
+	return ctc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ctc *CredentialTemplateCreate) SetNillableCreatedAt(t *time.Time) *CredentialTemplateCreate {
+	if t != nil {
+		ctc.SetCreatedAt(*t)
+	}
+	return ctc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ctc *CredentialTemplateCreate) SetUpdatedAt(t time.Time) *CredentialTemplateCreate {
+	ctc.mutation.SetUpdatedAt(t)
+	return ctc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ctc *CredentialTemplateCreate) SetNillableUpdatedAt(t *time.Time) *CredentialTemplateCreate {
+	if t != nil {
+		ctc.SetUpdatedAt(*t)
+	}
+	return ctc
+}
+
+// SetID sets the "id" field.
+func (ctc *CredentialTemplateCreate) SetID(s string) *CredentialTemplateCreate {
+	ctc.mutation.SetID(s)
+	return ctc
+}
+
+// AddCredentialIDs adds the "credentials" edge to the Credential entity by IDs.
+func (ctc *CredentialTemplateCreate) AddCredentialIDs(ids ...string) *CredentialTemplateCreate {
+	ctc.mutation.AddCredentialIDs(ids...)
+	return ctc
+}
+
+// AddCredentials adds the "credentials" edges to the Credential entity.
+func (ctc *CredentialTemplateCreate) AddCredentials(c ...*Credential) *CredentialTemplateCreate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ctc.AddCredentialIDs(ids...)
+}
+
+// Mutation returns the CredentialTemplateMutation object of the builder.
+func (ctc *CredentialTemplateCreate) Mutation() *CredentialTemplateMutation {
+	return ctc.mutation
+}
+
+// Save creates the CredentialTemplate in the database.
+func (ctc *CredentialTemplateCreate) Save(ctx context.Context) (*CredentialTemplate, error) {
+	var (
+		err  error
+		node *CredentialTemplate
+	)
+	ctc.defaults()
+	if len(ctc.hooks) == 0 {
+		if err = ctc.check(); err != nil {
+			return nil, err
+		}
+		node, err = ctc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CredentialTemplateMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ctc.check(); err != nil {
+				return nil, err
+			}
+			ctc.mutation = mutation
+			if node, err = ctc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ctc.hooks) - 1; i >= 0; i-- {
+			if ctc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ctc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ctc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*CredentialTemplate)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from CredentialTemplateMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ctc *CredentialTemplateCreate) SaveX(ctx context.Context) *CredentialTemplate {
+	v, err := ctc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ctc *CredentialTemplateCreate) Exec(ctx context.Context) error {
+	_, err := ctc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctc *CredentialTemplateCreate) ExecX(ctx context.Context) {
+	if err := ctc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ctc *CredentialTemplateCreate) defaults() {
+	if _, ok := ctc.mutation.Status(); !ok {
+		v := credentialtemplate.DefaultStatus
+		ctc.mutation.SetStatus(v)
+	}
+	if _, ok := ctc.mutation.Builtin(); !ok {
+		v := credentialtemplate.DefaultBuiltin
+		ctc.mutation.SetBuiltin(v)
+	}
+	if _, ok := ctc.mutation.CreatedAt(); !ok {
+		v := credentialtemplate.DefaultCreatedAt()
+		ctc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ctc.mutation.UpdatedAt(); !ok {
+		v := credentialtemplate.DefaultUpdatedAt()
+		ctc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ctc *CredentialTemplateCreate) check() error {
+	if _, ok := ctc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "CredentialTemplate.name"`)}
+	}
+	if v, ok := ctc.mutation.Name(); ok {
+		if err := credentialtemplate.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CredentialTemplate.name": %w`, err)}
+		}
+	}
+	if _, ok := ctc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "CredentialTemplate.version"`)}
+	}
+	if v, ok := ctc.mutation.Version(); ok {
+		if err := credentialtemplate.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "CredentialTemplate.version": %w`, err)}
+		}
+	}
+	if _, ok := ctc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "CredentialTemplate.status"`)}
+	}
+	if v, ok := ctc.mutation.Status(); ok {
+		if err := credentialtemplate.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CredentialTemplate.status": %w`, err)}
+		}
+	}
+	if _, ok := ctc.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "CredentialTemplate.content"`)}
+	}
+	if v, ok := ctc.mutation.Content(); ok {
+		if err := credentialtemplate.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "CredentialTemplate.content": %w`, err)}
+		}
+	}
+	if _, ok := ctc.mutation.Builtin(); !ok {
+		return &ValidationError{Name: "builtin", err: errors.New(`ent: missing required field "CredentialTemplate.builtin"`)}
+	}
+	if _, ok := ctc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CredentialTemplate.created_at"`)}
+	}
+	if _, ok := ctc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CredentialTemplate.updated_at"`)}
+	}
+	return nil
+}
+
+func (ctc *CredentialTemplateCreate) sqlSave(ctx context.Context) (*CredentialTemplate, error) {
+	_node, _spec := ctc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ctc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected CredentialTemplate.ID type: %T", _spec.ID.Value)
+		}
+	}
+	return _node, nil
+}
+
+func (ctc *CredentialTemplateCreate) createSpec() (*CredentialTemplate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CredentialTemplate{config: ctc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: credentialtemplate.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: credentialtemplate.FieldID,
+			},
+		}
+	)
+	if id, ok := ctc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ctc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: credentialtemplate.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := ctc.mutation.Version(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: credentialtemplate.FieldVersion,
+		})
+		_node.Version = value
+	}
+	if value, ok := ctc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: credentialtemplate.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := ctc.mutation.Description(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: credentialtemplate.FieldDescription,
+		})
+		_node.Description = value
+	}
+	if value, ok := ctc.mutation.Content(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: credentialtemplate.FieldContent,
+		})
+		_node.Content = value
+	}
+	if value, ok := ctc.mutation.SampleData(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: credentialtemplate.FieldSampleData,
+		})
+		_node.SampleData = value
+	}
+	if value, ok := ctc.mutation.Builtin(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: credentialtemplate.FieldBuiltin,
+		})
+		_node.Builtin = value
+	}
+	if value, ok := ctc.mutation.PublishedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: credentialtemplate.FieldPublishedAt,
+		})
+		_node.PublishedAt = &value
+	}
+	if value, ok := ctc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: credentialtemplate.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := ctc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: credentialtemplate.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if nodes := ctc.mutation.CredentialsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   credentialtemplate.CredentialsTable,
+			Columns: []string{credentialtemplate.CredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credential.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CredentialTemplateCreateBulk is the builder for creating many CredentialTemplate entities in bulk.
+type CredentialTemplateCreateBulk struct {
+	config
+	builders []*CredentialTemplateCreate
+}
+
+// Save creates the CredentialTemplate entities in the database.
+func (ctcb *CredentialTemplateCreateBulk) Save(ctx context.Context) ([]*CredentialTemplate, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ctcb.builders))
+	nodes := make([]*CredentialTemplate, len(ctcb.builders))
+	mutators := make([]Mutator, len(ctcb.builders))
+	for i := range ctcb.builders {
+		func(i int, root context.Context) {
+			builder := ctcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CredentialTemplateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ctcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ctcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ctcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ctcb *CredentialTemplateCreateBulk) SaveX(ctx context.Context) []*CredentialTemplate {
+	v, err := ctcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ctcb *CredentialTemplateCreateBulk) Exec(ctx context.Context) error {
+	_, err := ctcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctcb *CredentialTemplateCreateBulk) ExecX(ctx context.Context) {
+	if err := ctcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
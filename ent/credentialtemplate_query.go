@@ -0,0 +1,598 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/credential"
+	"github.com/hesusruiz/vcbackend/ent/credentialtemplate"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+)
+
+// CredentialTemplateQuery is the builder for querying CredentialTemplate entities.
+type CredentialTemplateQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.CredentialTemplate
+	// eager-loading edges.
+	withCredentials *CredentialQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CredentialTemplateQuery builder.
+func (ctq *CredentialTemplateQuery) Where(ps ...predicate.CredentialTemplate) *CredentialTemplateQuery {
+	ctq.predicates = append(ctq.predicates, ps...)
+	return ctq
+}
+
+// Limit adds a limit step to the query.
+func (ctq *CredentialTemplateQuery) Limit(limit int) *CredentialTemplateQuery {
+	ctq.limit = &limit
+	return ctq
+}
+
+// Offset adds an offset step to the query.
+func (ctq *CredentialTemplateQuery) Offset(offset int) *CredentialTemplateQuery {
+	ctq.offset = &offset
+	return ctq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ctq *CredentialTemplateQuery) Unique(unique bool) *CredentialTemplateQuery {
+	ctq.unique = &unique
+	return ctq
+}
+
+// Order adds an order step to the query.
+func (ctq *CredentialTemplateQuery) Order(o ...OrderFunc) *CredentialTemplateQuery {
+	ctq.order = append(ctq.order, o...)
+	return ctq
+}
+
+// QueryCredentials chains the current query on the "credentials" edge.
+func (ctq *CredentialTemplateQuery) QueryCredentials() *CredentialQuery {
+	query := &CredentialQuery{config: ctq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ctq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ctq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(credentialtemplate.Table, credentialtemplate.FieldID, selector),
+			sqlgraph.To(credential.Table, credential.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, credentialtemplate.CredentialsTable, credentialtemplate.CredentialsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ctq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CredentialTemplate entity from the query.
+// Returns a *NotFoundError when no CredentialTemplate was found.
+func (ctq *CredentialTemplateQuery) First(ctx context.Context) (*CredentialTemplate, error) {
+	nodes, err := ctq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{credentialtemplate.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ctq *CredentialTemplateQuery) FirstX(ctx context.Context) *CredentialTemplate {
+	node, err := ctq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CredentialTemplate ID from the query.
+// Returns a *NotFoundError when no CredentialTemplate ID was found.
+func (ctq *CredentialTemplateQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = ctq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{credentialtemplate.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ctq *CredentialTemplateQuery) FirstIDX(ctx context.Context) string {
+	id, err := ctq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CredentialTemplate entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CredentialTemplate entity is found.
+// Returns a *NotFoundError when no CredentialTemplate entities are found.
+func (ctq *CredentialTemplateQuery) Only(ctx context.Context) (*CredentialTemplate, error) {
+	nodes, err := ctq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{credentialtemplate.Label}
+	default:
+		return nil, &NotSingularError{credentialtemplate.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ctq *CredentialTemplateQuery) OnlyX(ctx context.Context) *CredentialTemplate {
+	node, err := ctq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CredentialTemplate ID in the query.
+// Returns a *NotSingularError when more than one CredentialTemplate ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ctq *CredentialTemplateQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = ctq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{credentialtemplate.Label}
+	default:
+		err = &NotSingularError{credentialtemplate.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ctq *CredentialTemplateQuery) OnlyIDX(ctx context.Context) string {
+	id, err := ctq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CredentialTemplates.
+func (ctq *CredentialTemplateQuery) All(ctx context.Context) ([]*CredentialTemplate, error) {
+	if err := ctq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return ctq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ctq *CredentialTemplateQuery) AllX(ctx context.Context) []*CredentialTemplate {
+	nodes, err := ctq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CredentialTemplate IDs.
+func (ctq *CredentialTemplateQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := ctq.Select(credentialtemplate.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ctq *CredentialTemplateQuery) IDsX(ctx context.Context) []string {
+	ids, err := ctq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ctq *CredentialTemplateQuery) Count(ctx context.Context) (int, error) {
+	if err := ctq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return ctq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ctq *CredentialTemplateQuery) CountX(ctx context.Context) int {
+	count, err := ctq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ctq *CredentialTemplateQuery) Exist(ctx context.Context) (bool, error) {
+	if err := ctq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return ctq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ctq *CredentialTemplateQuery) ExistX(ctx context.Context) bool {
+	exist, err := ctq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CredentialTemplateQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ctq *CredentialTemplateQuery) Clone() *CredentialTemplateQuery {
+	if ctq == nil {
+		return nil
+	}
+	return &CredentialTemplateQuery{
+		config:          ctq.config,
+		limit:           ctq.limit,
+		offset:          ctq.offset,
+		order:           append([]OrderFunc{}, ctq.order...),
+		predicates:      append([]predicate.CredentialTemplate{}, ctq.predicates...),
+		withCredentials: ctq.withCredentials.Clone(),
+		// clone intermediate query.
+		sql:    ctq.sql.Clone(),
+		path:   ctq.path,
+		unique: ctq.unique,
+	}
+}
+
+// WithCredentials tells the query-builder to eager-load the nodes that are connected to
+// the "credentials" edge. The optional arguments are used to configure the query builder of the edge.
+func (ctq *CredentialTemplateQuery) WithCredentials(opts ...func(*CredentialQuery)) *CredentialTemplateQuery {
+	query := &CredentialQuery{config: ctq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	ctq.withCredentials = query
+	return ctq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CredentialTemplate.Query().
+//		GroupBy(credentialtemplate.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ctq *CredentialTemplateQuery) GroupBy(field string, fields ...string) *CredentialTemplateGroupBy {
+	grbuild := &CredentialTemplateGroupBy{config: ctq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ctq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ctq.sqlQuery(ctx), nil
+	}
+	grbuild.label = credentialtemplate.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.CredentialTemplate.Query().
+//		Select(credentialtemplate.FieldName).
+//		Scan(ctx, &v)
+func (ctq *CredentialTemplateQuery) Select(fields ...string) *CredentialTemplateSelect {
+	ctq.fields = append(ctq.fields, fields...)
+	selbuild := &CredentialTemplateSelect{CredentialTemplateQuery: ctq}
+	selbuild.label = credentialtemplate.Label
+	selbuild.flds, selbuild.scan = &ctq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (ctq *CredentialTemplateQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range ctq.fields {
+		if !credentialtemplate.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ctq.path != nil {
+		prev, err := ctq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ctq.sql = prev
+	}
+	return nil
+}
+
+func (ctq *CredentialTemplateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CredentialTemplate, error) {
+	var (
+		nodes       = []*CredentialTemplate{}
+		_spec       = ctq.querySpec()
+		loadedTypes = [1]bool{
+			ctq.withCredentials != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*CredentialTemplate).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &CredentialTemplate{config: ctq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ctq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := ctq.withCredentials; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[string]*CredentialTemplate)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Credentials = []*Credential{}
+		}
+		query.withFKs = true
+		query.Where(predicate.Credential(func(s *sql.Selector) {
+			s.Where(sql.InValues(credentialtemplate.CredentialsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.credential_template_credentials
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "credential_template_credentials" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "credential_template_credentials" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Credentials = append(node.Edges.Credentials, n)
+		}
+	}
+
+	return nodes, nil
+}
+
+func (ctq *CredentialTemplateQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ctq.querySpec()
+	_spec.Node.Columns = ctq.fields
+	if len(ctq.fields) > 0 {
+		_spec.Unique = ctq.unique != nil && *ctq.unique
+	}
+	return sqlgraph.CountNodes(ctx, ctq.driver, _spec)
+}
+
+func (ctq *CredentialTemplateQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := ctq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (ctq *CredentialTemplateQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   credentialtemplate.Table,
+			Columns: credentialtemplate.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: credentialtemplate.FieldID,
+			},
+		},
+		From:   ctq.sql,
+		Unique: true,
+	}
+	if unique := ctq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := ctq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, credentialtemplate.FieldID)
+		for i := range fields {
+			if fields[i] != credentialtemplate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ctq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ctq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ctq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ctq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ctq *CredentialTemplateQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ctq.driver.Dialect())
+	t1 := builder.Table(credentialtemplate.Table)
+	columns := ctq.fields
+	if len(columns) == 0 {
+		columns = credentialtemplate.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ctq.sql != nil {
+		selector = ctq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ctq.unique != nil && *ctq.unique {
+		selector.Distinct()
+	}
+	for _, p := range ctq.predicates {
+		p(selector)
+	}
+	for _, p := range ctq.order {
+		p(selector)
+	}
+	if offset := ctq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ctq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CredentialTemplateGroupBy is the group-by builder for CredentialTemplate entities.
+type CredentialTemplateGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ctgb *CredentialTemplateGroupBy) Aggregate(fns ...AggregateFunc) *CredentialTemplateGroupBy {
+	ctgb.fns = append(ctgb.fns, fns...)
+	return ctgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (ctgb *CredentialTemplateGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := ctgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	ctgb.sql = query
+	return ctgb.sqlScan(ctx, v)
+}
+
+func (ctgb *CredentialTemplateGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range ctgb.fields {
+		if !credentialtemplate.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := ctgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ctgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ctgb *CredentialTemplateGroupBy) sqlQuery() *sql.Selector {
+	selector := ctgb.sql.Select()
+	aggregation := make([]string, 0, len(ctgb.fns))
+	for _, fn := range ctgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(ctgb.fields)+len(ctgb.fns))
+		for _, f := range ctgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(ctgb.fields...)...)
+}
+
+// CredentialTemplateSelect is the builder for selecting fields of CredentialTemplate entities.
+type CredentialTemplateSelect struct {
+	*CredentialTemplateQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cts *CredentialTemplateSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := cts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	cts.sql = cts.CredentialTemplateQuery.sqlQuery(ctx)
+	return cts.sqlScan(ctx, v)
+}
+
+func (cts *CredentialTemplateSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := cts.sql.Query()
+	if err := cts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,803 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/credential"
+	"github.com/hesusruiz/vcbackend/ent/credentialtemplate"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+)
+
+// CredentialTemplateUpdate is the builder for updating CredentialTemplate entities.
+type CredentialTemplateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CredentialTemplateMutation
+}
+
+// Where appends a list predicates to the CredentialTemplateUpdate builder.
+func (ctu *CredentialTemplateUpdate) Where(ps ...predicate.CredentialTemplate) *CredentialTemplateUpdate {
+	ctu.mutation.Where(ps...)
+	return ctu
+}
+
+// SetStatus sets the "status" field.
+func (ctu *CredentialTemplateUpdate) SetStatus(c credentialtemplate.Status) *CredentialTemplateUpdate {
+	ctu.mutation.SetStatus(c)
+	return ctu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ctu *CredentialTemplateUpdate) SetNillableStatus(c *credentialtemplate.Status) *CredentialTemplateUpdate {
+	if c != nil {
+		ctu.SetStatus(*c)
+	}
+	return ctu
+}
+
+// SetDescription sets the "description" field.
+func (ctu *CredentialTemplateUpdate) SetDescription(s string) *CredentialTemplateUpdate {
+	ctu.mutation.SetDescription(s)
+	return ctu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ctu *CredentialTemplateUpdate) SetNillableDescription(s *string) *CredentialTemplateUpdate {
+	if s != nil {
+		ctu.SetDescription(*s)
+	}
+	return ctu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (ctu *CredentialTemplateUpdate) ClearDescription() *CredentialTemplateUpdate {
+	ctu.mutation.ClearDescription()
+	return ctu
+}
+
+// SetContent sets the "content" field.
+func (ctu *CredentialTemplateUpdate) SetContent(s string) *CredentialTemplateUpdate {
+	ctu.mutation.SetContent(s)
+	return ctu
+}
+
+// SetSampleData sets the "sample_data" field.
+func (ctu *CredentialTemplateUpdate) SetSampleData(m map[string]interface{}) *CredentialTemplateUpdate {
+	ctu.mutation.SetSampleData(m)
+	return ctu
+}
+
+// ClearSampleData clears the value of the "sample_data" field.
+func (ctu *CredentialTemplateUpdate) ClearSampleData() *CredentialTemplateUpdate {
+	ctu.mutation.ClearSampleData()
+	return ctu
+}
+
+// SetBuiltin sets the "builtin" field.
+func (ctu *CredentialTemplateUpdate) SetBuiltin(b bool) *CredentialTemplateUpdate {
+	ctu.mutation.SetBuiltin(b)
+	return ctu
+}
+
+// SetNillableBuiltin sets the "builtin" field if the given value is not nil.
+func (ctu *CredentialTemplateUpdate) SetNillableBuiltin(b *bool) *CredentialTemplateUpdate {
+	if b != nil {
+		ctu.SetBuiltin(*b)
+	}
+	return ctu
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (ctu *CredentialTemplateUpdate) SetPublishedAt(t time.Time) *CredentialTemplateUpdate {
+	ctu.mutation.SetPublishedAt(t)
+	return ctu
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (ctu *CredentialTemplateUpdate) SetNillablePublishedAt(t *time.Time) *CredentialTemplateUpdate {
+	if t != nil {
+		ctu.SetPublishedAt(*t)
+	}
+	return ctu
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (ctu *CredentialTemplateUpdate) ClearPublishedAt() *CredentialTemplateUpdate {
+	ctu.mutation.ClearPublishedAt()
+	return ctu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ctu *CredentialTemplateUpdate) SetUpdatedAt(t time.Time) *CredentialTemplateUpdate {
+	ctu.mutation.SetUpdatedAt(t)
+	return ctu
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ctu *CredentialTemplateUpdate) SetNillableUpdatedAt(t *time.Time) *CredentialTemplateUpdate {
+	if t != nil {
+		ctu.SetUpdatedAt(*t)
+	}
+	return ctu
+}
+
+// AddCredentialIDs adds the "credentials" edge to the Credential entity by IDs.
+func (ctu *CredentialTemplateUpdate) AddCredentialIDs(ids ...string) *CredentialTemplateUpdate {
+	ctu.mutation.AddCredentialIDs(ids...)
+	return ctu
+}
+
+// AddCredentials adds the "credentials" edges to the Credential entity.
+func (ctu *CredentialTemplateUpdate) AddCredentials(c ...*Credential) *CredentialTemplateUpdate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ctu.AddCredentialIDs(ids...)
+}
+
+// Mutation returns the CredentialTemplateMutation object of the builder.
+func (ctu *CredentialTemplateUpdate) Mutation() *CredentialTemplateMutation {
+	return ctu.mutation
+}
+
+// ClearCredentials clears all "credentials" edges to the Credential entity.
+func (ctu *CredentialTemplateUpdate) ClearCredentials() *CredentialTemplateUpdate {
+	ctu.mutation.ClearCredentials()
+	return ctu
+}
+
+// RemoveCredentialIDs removes the "credentials" edge to Credential entities by IDs.
+func (ctu *CredentialTemplateUpdate) RemoveCredentialIDs(ids ...string) *CredentialTemplateUpdate {
+	ctu.mutation.RemoveCredentialIDs(ids...)
+	return ctu
+}
+
+// RemoveCredentials removes "credentials" edges to Credential entities.
+func (ctu *CredentialTemplateUpdate) RemoveCredentials(c ...*Credential) *CredentialTemplateUpdate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ctu.RemoveCredentialIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ctu *CredentialTemplateUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ctu.hooks) == 0 {
+		if err = ctu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = ctu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CredentialTemplateMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ctu.check(); err != nil {
+				return 0, err
+			}
+			ctu.mutation = mutation
+			affected, err = ctu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ctu.hooks) - 1; i >= 0; i-- {
+			if ctu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ctu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ctu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ctu *CredentialTemplateUpdate) SaveX(ctx context.Context) int {
+	affected, err := ctu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ctu *CredentialTemplateUpdate) Exec(ctx context.Context) error {
+	_, err := ctu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctu *CredentialTemplateUpdate) ExecX(ctx context.Context) {
+	if err := ctu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ctu *CredentialTemplateUpdate) check() error {
+	if v, ok := ctu.mutation.Status(); ok {
+		if err := credentialtemplate.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CredentialTemplate.status": %w`, err)}
+		}
+	}
+	if v, ok := ctu.mutation.Content(); ok {
+		if err := credentialtemplate.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "CredentialTemplate.content": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ctu *CredentialTemplateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   credentialtemplate.Table,
+			Columns: credentialtemplate.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: credentialtemplate.FieldID,
+			},
+		},
+	}
+	if ps := ctu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ctu.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: credentialtemplate.FieldStatus,
+		})
+	}
+	if value, ok := ctu.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: credentialtemplate.FieldDescription,
+		})
+	}
+	if ctu.mutation.DescriptionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: credentialtemplate.FieldDescription,
+		})
+	}
+	if value, ok := ctu.mutation.Content(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: credentialtemplate.FieldContent,
+		})
+	}
+	if value, ok := ctu.mutation.SampleData(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: credentialtemplate.FieldSampleData,
+		})
+	}
+	if ctu.mutation.SampleDataCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: credentialtemplate.FieldSampleData,
+		})
+	}
+	if value, ok := ctu.mutation.Builtin(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: credentialtemplate.FieldBuiltin,
+		})
+	}
+	if value, ok := ctu.mutation.PublishedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: credentialtemplate.FieldPublishedAt,
+		})
+	}
+	if ctu.mutation.PublishedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: credentialtemplate.FieldPublishedAt,
+		})
+	}
+	if value, ok := ctu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: credentialtemplate.FieldUpdatedAt,
+		})
+	}
+	if ctu.mutation.CredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   credentialtemplate.CredentialsTable,
+			Columns: []string{credentialtemplate.CredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credential.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ctu.mutation.RemovedCredentialsIDs(); len(nodes) > 0 && !ctu.mutation.CredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   credentialtemplate.CredentialsTable,
+			Columns: []string{credentialtemplate.CredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credential.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ctu.mutation.CredentialsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   credentialtemplate.CredentialsTable,
+			Columns: []string{credentialtemplate.CredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credential.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ctu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{credentialtemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// CredentialTemplateUpdateOne is the builder for updating a single CredentialTemplate entity.
+type CredentialTemplateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CredentialTemplateMutation
+}
+
+// SetStatus sets the "status" field.
+func (ctuo *CredentialTemplateUpdateOne) SetStatus(c credentialtemplate.Status) *CredentialTemplateUpdateOne {
+	ctuo.mutation.SetStatus(c)
+	return ctuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ctuo *CredentialTemplateUpdateOne) SetNillableStatus(c *credentialtemplate.Status) *CredentialTemplateUpdateOne {
+	if c != nil {
+		ctuo.SetStatus(*c)
+	}
+	return ctuo
+}
+
+// SetDescription sets the "description" field.
+func (ctuo *CredentialTemplateUpdateOne) SetDescription(s string) *CredentialTemplateUpdateOne {
+	ctuo.mutation.SetDescription(s)
+	return ctuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ctuo *CredentialTemplateUpdateOne) SetNillableDescription(s *string) *CredentialTemplateUpdateOne {
+	if s != nil {
+		ctuo.SetDescription(*s)
+	}
+	return ctuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (ctuo *CredentialTemplateUpdateOne) ClearDescription() *CredentialTemplateUpdateOne {
+	ctuo.mutation.ClearDescription()
+	return ctuo
+}
+
+// SetContent sets the "content" field.
+func (ctuo *CredentialTemplateUpdateOne) SetContent(s string) *CredentialTemplateUpdateOne {
+	ctuo.mutation.SetContent(s)
+	return ctuo
+}
+
+// SetSampleData sets the "sample_data" field.
+func (ctuo *CredentialTemplateUpdateOne) SetSampleData(m map[string]interface{}) *CredentialTemplateUpdateOne {
+	ctuo.mutation.SetSampleData(m)
+	return ctuo
+}
+
+// ClearSampleData clears the value of the "sample_data" field.
+func (ctuo *CredentialTemplateUpdateOne) ClearSampleData() *CredentialTemplateUpdateOne {
+	ctuo.mutation.ClearSampleData()
+	return ctuo
+}
+
+// SetBuiltin sets the "builtin" field.
+func (ctuo *CredentialTemplateUpdateOne) SetBuiltin(b bool) *CredentialTemplateUpdateOne {
+	ctuo.mutation.SetBuiltin(b)
+	return ctuo
+}
+
+// SetNillableBuiltin sets the "builtin" field if the given value is not nil.
+func (ctuo *CredentialTemplateUpdateOne) SetNillableBuiltin(b *bool) *CredentialTemplateUpdateOne {
+	if b != nil {
+		ctuo.SetBuiltin(*b)
+	}
+	return ctuo
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (ctuo *CredentialTemplateUpdateOne) SetPublishedAt(t time.Time) *CredentialTemplateUpdateOne {
+	ctuo.mutation.SetPublishedAt(t)
+	return ctuo
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (ctuo *CredentialTemplateUpdateOne) SetNillablePublishedAt(t *time.Time) *CredentialTemplateUpdateOne {
+	if t != nil {
+		ctuo.SetPublishedAt(*t)
+	}
+	return ctuo
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (ctuo *CredentialTemplateUpdateOne) ClearPublishedAt() *CredentialTemplateUpdateOne {
+	ctuo.mutation.ClearPublishedAt()
+	return ctuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ctuo *CredentialTemplateUpdateOne) SetUpdatedAt(t time.Time) *CredentialTemplateUpdateOne {
+	ctuo.mutation.SetUpdatedAt(t)
+	return ctuo
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ctuo *CredentialTemplateUpdateOne) SetNillableUpdatedAt(t *time.Time) *CredentialTemplateUpdateOne {
+	if t != nil {
+		ctuo.SetUpdatedAt(*t)
+	}
+	return ctuo
+}
+
+// AddCredentialIDs adds the "credentials" edge to the Credential entity by IDs.
+func (ctuo *CredentialTemplateUpdateOne) AddCredentialIDs(ids ...string) *CredentialTemplateUpdateOne {
+	ctuo.mutation.AddCredentialIDs(ids...)
+	return ctuo
+}
+
+// AddCredentials adds the "credentials" edges to the Credential entity.
+func (ctuo *CredentialTemplateUpdateOne) AddCredentials(c ...*Credential) *CredentialTemplateUpdateOne {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ctuo.AddCredentialIDs(ids...)
+}
+
+// Mutation returns the CredentialTemplateMutation object of the builder.
+func (ctuo *CredentialTemplateUpdateOne) Mutation() *CredentialTemplateMutation {
+	return ctuo.mutation
+}
+
+// ClearCredentials clears all "credentials" edges to the Credential entity.
+func (ctuo *CredentialTemplateUpdateOne) ClearCredentials() *CredentialTemplateUpdateOne {
+	ctuo.mutation.ClearCredentials()
+	return ctuo
+}
+
+// RemoveCredentialIDs removes the "credentials" edge to Credential entities by IDs.
+func (ctuo *CredentialTemplateUpdateOne) RemoveCredentialIDs(ids ...string) *CredentialTemplateUpdateOne {
+	ctuo.mutation.RemoveCredentialIDs(ids...)
+	return ctuo
+}
+
+// RemoveCredentials removes "credentials" edges to Credential entities.
+func (ctuo *CredentialTemplateUpdateOne) RemoveCredentials(c ...*Credential) *CredentialTemplateUpdateOne {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ctuo.RemoveCredentialIDs(ids...)
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ctuo *CredentialTemplateUpdateOne) Select(field string, fields ...string) *CredentialTemplateUpdateOne {
+	ctuo.fields = append([]string{field}, fields...)
+	return ctuo
+}
+
+// Save executes the query and returns the updated CredentialTemplate entity.
+func (ctuo *CredentialTemplateUpdateOne) Save(ctx context.Context) (*CredentialTemplate, error) {
+	var (
+		err  error
+		node *CredentialTemplate
+	)
+	if len(ctuo.hooks) == 0 {
+		if err = ctuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = ctuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CredentialTemplateMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ctuo.check(); err != nil {
+				return nil, err
+			}
+			ctuo.mutation = mutation
+			node, err = ctuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ctuo.hooks) - 1; i >= 0; i-- {
+			if ctuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ctuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ctuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*CredentialTemplate)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from CredentialTemplateMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ctuo *CredentialTemplateUpdateOne) SaveX(ctx context.Context) *CredentialTemplate {
+	node, err := ctuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ctuo *CredentialTemplateUpdateOne) Exec(ctx context.Context) error {
+	_, err := ctuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ctuo *CredentialTemplateUpdateOne) ExecX(ctx context.Context) {
+	if err := ctuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ctuo *CredentialTemplateUpdateOne) check() error {
+	if v, ok := ctuo.mutation.Status(); ok {
+		if err := credentialtemplate.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CredentialTemplate.status": %w`, err)}
+		}
+	}
+	if v, ok := ctuo.mutation.Content(); ok {
+		if err := credentialtemplate.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "CredentialTemplate.content": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ctuo *CredentialTemplateUpdateOne) sqlSave(ctx context.Context) (_node *CredentialTemplate, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   credentialtemplate.Table,
+			Columns: credentialtemplate.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: credentialtemplate.FieldID,
+			},
+		},
+	}
+	id, ok := ctuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CredentialTemplate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ctuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, credentialtemplate.FieldID)
+		for _, f := range fields {
+			if !credentialtemplate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != credentialtemplate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ctuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ctuo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: credentialtemplate.FieldStatus,
+		})
+	}
+	if value, ok := ctuo.mutation.Description(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: credentialtemplate.FieldDescription,
+		})
+	}
+	if ctuo.mutation.DescriptionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: credentialtemplate.FieldDescription,
+		})
+	}
+	if value, ok := ctuo.mutation.Content(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: credentialtemplate.FieldContent,
+		})
+	}
+	if value, ok := ctuo.mutation.SampleData(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: credentialtemplate.FieldSampleData,
+		})
+	}
+	if ctuo.mutation.SampleDataCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: credentialtemplate.FieldSampleData,
+		})
+	}
+	if value, ok := ctuo.mutation.Builtin(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: credentialtemplate.FieldBuiltin,
+		})
+	}
+	if value, ok := ctuo.mutation.PublishedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: credentialtemplate.FieldPublishedAt,
+		})
+	}
+	if ctuo.mutation.PublishedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: credentialtemplate.FieldPublishedAt,
+		})
+	}
+	if value, ok := ctuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: credentialtemplate.FieldUpdatedAt,
+		})
+	}
+	if ctuo.mutation.CredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   credentialtemplate.CredentialsTable,
+			Columns: []string{credentialtemplate.CredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credential.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ctuo.mutation.RemovedCredentialsIDs(); len(nodes) > 0 && !ctuo.mutation.CredentialsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   credentialtemplate.CredentialsTable,
+			Columns: []string{credentialtemplate.CredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credential.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ctuo.mutation.CredentialsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   credentialtemplate.CredentialsTable,
+			Columns: []string{credentialtemplate.CredentialsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credential.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CredentialTemplate{config: ctuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ctuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{credentialtemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
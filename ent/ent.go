@@ -10,11 +10,21 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/hesusruiz/vcbackend/ent/auditentry"
+	"github.com/hesusruiz/vcbackend/ent/clientcertificate"
 	"github.com/hesusruiz/vcbackend/ent/credential"
+	"github.com/hesusruiz/vcbackend/ent/credentialtemplate"
 	"github.com/hesusruiz/vcbackend/ent/did"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequest"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequestevent"
+	"github.com/hesusruiz/vcbackend/ent/loginsession"
 	"github.com/hesusruiz/vcbackend/ent/naturalperson"
+	"github.com/hesusruiz/vcbackend/ent/presentationnonce"
 	"github.com/hesusruiz/vcbackend/ent/privatekey"
 	"github.com/hesusruiz/vcbackend/ent/publickey"
+	"github.com/hesusruiz/vcbackend/ent/ratecounter"
+	"github.com/hesusruiz/vcbackend/ent/relyingparty"
+	"github.com/hesusruiz/vcbackend/ent/trustedissuer"
 	"github.com/hesusruiz/vcbackend/ent/user"
 )
 
@@ -36,12 +46,22 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
-		credential.Table:    credential.ValidColumn,
-		did.Table:           did.ValidColumn,
-		naturalperson.Table: naturalperson.ValidColumn,
-		privatekey.Table:    privatekey.ValidColumn,
-		publickey.Table:     publickey.ValidColumn,
-		user.Table:          user.ValidColumn,
+		auditentry.Table:           auditentry.ValidColumn,
+		clientcertificate.Table:    clientcertificate.ValidColumn,
+		credential.Table:           credential.ValidColumn,
+		credentialtemplate.Table:   credentialtemplate.ValidColumn,
+		did.Table:                  did.ValidColumn,
+		issuancerequest.Table:      issuancerequest.ValidColumn,
+		issuancerequestevent.Table: issuancerequestevent.ValidColumn,
+		loginsession.Table:         loginsession.ValidColumn,
+		naturalperson.Table:        naturalperson.ValidColumn,
+		presentationnonce.Table:    presentationnonce.ValidColumn,
+		privatekey.Table:           privatekey.ValidColumn,
+		publickey.Table:            publickey.ValidColumn,
+		ratecounter.Table:          ratecounter.ValidColumn,
+		relyingparty.Table:         relyingparty.ValidColumn,
+		trustedissuer.Table:        trustedissuer.ValidColumn,
+		user.Table:                 user.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
@@ -9,6 +9,32 @@ import (
 	"github.com/hesusruiz/vcbackend/ent"
 )
 
+// The AuditEntryFunc type is an adapter to allow the use of ordinary
+// function as AuditEntry mutator.
+type AuditEntryFunc func(context.Context, *ent.AuditEntryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditEntryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.AuditEntryMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditEntryMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ClientCertificateFunc type is an adapter to allow the use of ordinary
+// function as ClientCertificate mutator.
+type ClientCertificateFunc func(context.Context, *ent.ClientCertificateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ClientCertificateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ClientCertificateMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ClientCertificateMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The CredentialFunc type is an adapter to allow the use of ordinary
 // function as Credential mutator.
 type CredentialFunc func(context.Context, *ent.CredentialMutation) (ent.Value, error)
@@ -22,6 +48,19 @@ func (f CredentialFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return f(ctx, mv)
 }
 
+// The CredentialTemplateFunc type is an adapter to allow the use of ordinary
+// function as CredentialTemplate mutator.
+type CredentialTemplateFunc func(context.Context, *ent.CredentialTemplateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CredentialTemplateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.CredentialTemplateMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CredentialTemplateMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The DIDFunc type is an adapter to allow the use of ordinary
 // function as DID mutator.
 type DIDFunc func(context.Context, *ent.DIDMutation) (ent.Value, error)
@@ -35,6 +74,45 @@ func (f DIDFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return f(ctx, mv)
 }
 
+// The IssuanceRequestFunc type is an adapter to allow the use of ordinary
+// function as IssuanceRequest mutator.
+type IssuanceRequestFunc func(context.Context, *ent.IssuanceRequestMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IssuanceRequestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.IssuanceRequestMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IssuanceRequestMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The IssuanceRequestEventFunc type is an adapter to allow the use of ordinary
+// function as IssuanceRequestEvent mutator.
+type IssuanceRequestEventFunc func(context.Context, *ent.IssuanceRequestEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IssuanceRequestEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.IssuanceRequestEventMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IssuanceRequestEventMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The LoginSessionFunc type is an adapter to allow the use of ordinary
+// function as LoginSession mutator.
+type LoginSessionFunc func(context.Context, *ent.LoginSessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LoginSessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.LoginSessionMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LoginSessionMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The NaturalPersonFunc type is an adapter to allow the use of ordinary
 // function as NaturalPerson mutator.
 type NaturalPersonFunc func(context.Context, *ent.NaturalPersonMutation) (ent.Value, error)
@@ -48,6 +126,19 @@ func (f NaturalPersonFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return f(ctx, mv)
 }
 
+// The PresentationNonceFunc type is an adapter to allow the use of ordinary
+// function as PresentationNonce mutator.
+type PresentationNonceFunc func(context.Context, *ent.PresentationNonceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PresentationNonceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.PresentationNonceMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PresentationNonceMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The PrivateKeyFunc type is an adapter to allow the use of ordinary
 // function as PrivateKey mutator.
 type PrivateKeyFunc func(context.Context, *ent.PrivateKeyMutation) (ent.Value, error)
@@ -74,6 +165,45 @@ func (f PublicKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return f(ctx, mv)
 }
 
+// The RateCounterFunc type is an adapter to allow the use of ordinary
+// function as RateCounter mutator.
+type RateCounterFunc func(context.Context, *ent.RateCounterMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RateCounterFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.RateCounterMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RateCounterMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The RelyingPartyFunc type is an adapter to allow the use of ordinary
+// function as RelyingParty mutator.
+type RelyingPartyFunc func(context.Context, *ent.RelyingPartyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RelyingPartyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.RelyingPartyMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RelyingPartyMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The TrustedIssuerFunc type is an adapter to allow the use of ordinary
+// function as TrustedIssuer mutator.
+type TrustedIssuerFunc func(context.Context, *ent.TrustedIssuerMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TrustedIssuerFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.TrustedIssuerMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TrustedIssuerMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/hesusruiz/vcbackend/ent/credential"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequest"
+)
+
+// IssuanceRequest is the model entity for the IssuanceRequest schema.
+type IssuanceRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CredentialType holds the value of the "credential_type" field.
+	CredentialType string `json:"credential_type,omitempty"`
+	// Claims holds the value of the "claims" field.
+	Claims map[string]interface{} `json:"claims,omitempty"`
+	// SubjectDid holds the value of the "subject_did" field.
+	SubjectDid string `json:"subject_did,omitempty"`
+	// Predecessor holds the value of the "predecessor" field.
+	Predecessor string `json:"predecessor,omitempty"`
+	// RevokePredecessor holds the value of the "revoke_predecessor" field.
+	RevokePredecessor bool `json:"revoke_predecessor,omitempty"`
+	// Status holds the value of the "status" field.
+	Status issuancerequest.Status `json:"status,omitempty"`
+	// RequestedBy holds the value of the "requested_by" field.
+	RequestedBy string `json:"requested_by,omitempty"`
+	// DecidedBy holds the value of the "decided_by" field.
+	DecidedBy string `json:"decided_by,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the IssuanceRequestQuery when eager-loading is set.
+	Edges                       IssuanceRequestEdges `json:"edges"`
+	issuance_request_credential *string
+}
+
+// IssuanceRequestEdges holds the relations/edges for other nodes in the graph.
+type IssuanceRequestEdges struct {
+	// Events holds the value of the events edge.
+	Events []*IssuanceRequestEvent `json:"events,omitempty"`
+	// Credential holds the value of the credential edge.
+	Credential *Credential `json:"credential,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// EventsOrErr returns the Events value or an error if the edge
+// was not loaded in eager-loading.
+func (e IssuanceRequestEdges) EventsOrErr() ([]*IssuanceRequestEvent, error) {
+	if e.loadedTypes[0] {
+		return e.Events, nil
+	}
+	return nil, &NotLoadedError{edge: "events"}
+}
+
+// CredentialOrErr returns the Credential value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e IssuanceRequestEdges) CredentialOrErr() (*Credential, error) {
+	if e.loadedTypes[1] {
+		if e.Credential == nil {
+			// The edge credential was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: credential.Label}
+		}
+		return e.Credential, nil
+	}
+	return nil, &NotLoadedError{edge: "credential"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*IssuanceRequest) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case issuancerequest.FieldClaims:
+			values[i] = new([]byte)
+		case issuancerequest.FieldRevokePredecessor:
+			values[i] = new(sql.NullBool)
+		case issuancerequest.FieldID, issuancerequest.FieldCredentialType, issuancerequest.FieldSubjectDid, issuancerequest.FieldPredecessor, issuancerequest.FieldStatus, issuancerequest.FieldRequestedBy, issuancerequest.FieldDecidedBy, issuancerequest.FieldReason:
+			values[i] = new(sql.NullString)
+		case issuancerequest.FieldCreatedAt, issuancerequest.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case issuancerequest.ForeignKeys[0]: // issuance_request_credential
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type IssuanceRequest", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the IssuanceRequest fields.
+func (ir *IssuanceRequest) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case issuancerequest.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ir.ID = value.String
+			}
+		case issuancerequest.FieldCredentialType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field credential_type", values[i])
+			} else if value.Valid {
+				ir.CredentialType = value.String
+			}
+		case issuancerequest.FieldClaims:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field claims", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ir.Claims); err != nil {
+					return fmt.Errorf("unmarshal field claims: %w", err)
+				}
+			}
+		case issuancerequest.FieldSubjectDid:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_did", values[i])
+			} else if value.Valid {
+				ir.SubjectDid = value.String
+			}
+		case issuancerequest.FieldPredecessor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field predecessor", values[i])
+			} else if value.Valid {
+				ir.Predecessor = value.String
+			}
+		case issuancerequest.FieldRevokePredecessor:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field revoke_predecessor", values[i])
+			} else if value.Valid {
+				ir.RevokePredecessor = value.Bool
+			}
+		case issuancerequest.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ir.Status = issuancerequest.Status(value.String)
+			}
+		case issuancerequest.FieldRequestedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field requested_by", values[i])
+			} else if value.Valid {
+				ir.RequestedBy = value.String
+			}
+		case issuancerequest.FieldDecidedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field decided_by", values[i])
+			} else if value.Valid {
+				ir.DecidedBy = value.String
+			}
+		case issuancerequest.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				ir.Reason = value.String
+			}
+		case issuancerequest.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ir.CreatedAt = value.Time
+			}
+		case issuancerequest.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ir.UpdatedAt = value.Time
+			}
+		case issuancerequest.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field issuance_request_credential", values[i])
+			} else if value.Valid {
+				ir.issuance_request_credential = new(string)
+				*ir.issuance_request_credential = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryEvents queries the "events" edge of the IssuanceRequest entity.
+func (ir *IssuanceRequest) QueryEvents() *IssuanceRequestEventQuery {
+	return (&IssuanceRequestClient{config: ir.config}).QueryEvents(ir)
+}
+
+// QueryCredential queries the "credential" edge of the IssuanceRequest entity.
+func (ir *IssuanceRequest) QueryCredential() *CredentialQuery {
+	return (&IssuanceRequestClient{config: ir.config}).QueryCredential(ir)
+}
+
+// Update returns a builder for updating this IssuanceRequest.
+// Note that you need to call IssuanceRequest.Unwrap() before calling this method if this IssuanceRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ir *IssuanceRequest) Update() *IssuanceRequestUpdateOne {
+	return (&IssuanceRequestClient{config: ir.config}).UpdateOne(ir)
+}
+
+// Unwrap unwraps the IssuanceRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ir *IssuanceRequest) Unwrap() *IssuanceRequest {
+	_tx, ok := ir.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: IssuanceRequest is not a transactional entity")
+	}
+	ir.config.driver = _tx.drv
+	return ir
+}
+
+// String implements the fmt.Stringer.
+func (ir *IssuanceRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("IssuanceRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ir.ID))
+	builder.WriteString("credential_type=")
+	builder.WriteString(ir.CredentialType)
+	builder.WriteString(", ")
+	builder.WriteString("claims=")
+	builder.WriteString(fmt.Sprintf("%v", ir.Claims))
+	builder.WriteString(", ")
+	builder.WriteString("subject_did=")
+	builder.WriteString(ir.SubjectDid)
+	builder.WriteString(", ")
+	builder.WriteString("predecessor=")
+	builder.WriteString(ir.Predecessor)
+	builder.WriteString(", ")
+	builder.WriteString("revoke_predecessor=")
+	builder.WriteString(fmt.Sprintf("%v", ir.RevokePredecessor))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ir.Status))
+	builder.WriteString(", ")
+	builder.WriteString("requested_by=")
+	builder.WriteString(ir.RequestedBy)
+	builder.WriteString(", ")
+	builder.WriteString("decided_by=")
+	builder.WriteString(ir.DecidedBy)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(ir.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ir.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ir.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IssuanceRequests is a parsable slice of IssuanceRequest.
+type IssuanceRequests []*IssuanceRequest
+
+func (ir IssuanceRequests) config(cfg config) {
+	for _i := range ir {
+		ir[_i].config = cfg
+	}
+}
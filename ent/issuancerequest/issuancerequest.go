@@ -0,0 +1,136 @@
+// Code generated by ent, DO NOT EDIT.
+
+package issuancerequest
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the issuancerequest type in the database.
+	Label = "issuance_request"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCredentialType holds the string denoting the credential_type field in the database.
+	FieldCredentialType = "credential_type"
+	// FieldClaims holds the string denoting the claims field in the database.
+	FieldClaims = "claims"
+	// FieldSubjectDid holds the string denoting the subject_did field in the database.
+	FieldSubjectDid = "subject_did"
+	// FieldPredecessor holds the string denoting the predecessor field in the database.
+	FieldPredecessor = "predecessor"
+	// FieldRevokePredecessor holds the string denoting the revoke_predecessor field in the database.
+	FieldRevokePredecessor = "revoke_predecessor"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldRequestedBy holds the string denoting the requested_by field in the database.
+	FieldRequestedBy = "requested_by"
+	// FieldDecidedBy holds the string denoting the decided_by field in the database.
+	FieldDecidedBy = "decided_by"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeEvents holds the string denoting the events edge name in mutations.
+	EdgeEvents = "events"
+	// EdgeCredential holds the string denoting the credential edge name in mutations.
+	EdgeCredential = "credential"
+	// Table holds the table name of the issuancerequest in the database.
+	Table = "issuance_requests"
+	// EventsTable is the table that holds the events relation/edge.
+	EventsTable = "issuance_request_events"
+	// EventsInverseTable is the table name for the IssuanceRequestEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "issuancerequestevent" package.
+	EventsInverseTable = "issuance_request_events"
+	// EventsColumn is the table column denoting the events relation/edge.
+	EventsColumn = "issuance_request_events"
+	// CredentialTable is the table that holds the credential relation/edge.
+	CredentialTable = "issuance_requests"
+	// CredentialInverseTable is the table name for the Credential entity.
+	// It exists in this package in order to avoid circular dependency with the "credential" package.
+	CredentialInverseTable = "credentials"
+	// CredentialColumn is the table column denoting the credential relation/edge.
+	CredentialColumn = "issuance_request_credential"
+)
+
+// Columns holds all SQL columns for issuancerequest fields.
+var Columns = []string{
+	FieldID,
+	FieldCredentialType,
+	FieldClaims,
+	FieldSubjectDid,
+	FieldPredecessor,
+	FieldRevokePredecessor,
+	FieldStatus,
+	FieldRequestedBy,
+	FieldDecidedBy,
+	FieldReason,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "issuance_requests"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"issuance_request_credential",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CredentialTypeValidator is a validator for the "credential_type" field. It is called by the builders before save.
+	CredentialTypeValidator func(string) error
+	// DefaultRevokePredecessor holds the default value on creation for the "revoke_predecessor" field.
+	DefaultRevokePredecessor bool
+	// RequestedByValidator is a validator for the "requested_by" field. It is called by the builders before save.
+	RequestedByValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending  Status = "pending"
+	StatusApproved Status = "approved"
+	StatusRejected Status = "rejected"
+	StatusIssued   Status = "issued"
+	StatusFailed   Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusApproved, StatusRejected, StatusIssued, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("issuancerequest: invalid enum value for status field: %q", s)
+	}
+}
@@ -0,0 +1,1169 @@
+// Code generated by ent, DO NOT EDIT.
+
+package issuancerequest
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// CredentialType applies equality check predicate on the "credential_type" field. It's identical to CredentialTypeEQ.
+func CredentialType(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCredentialType), v))
+	})
+}
+
+// SubjectDid applies equality check predicate on the "subject_did" field. It's identical to SubjectDidEQ.
+func SubjectDid(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSubjectDid), v))
+	})
+}
+
+// Predecessor applies equality check predicate on the "predecessor" field. It's identical to PredecessorEQ.
+func Predecessor(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPredecessor), v))
+	})
+}
+
+// RevokePredecessor applies equality check predicate on the "revoke_predecessor" field. It's identical to RevokePredecessorEQ.
+func RevokePredecessor(v bool) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRevokePredecessor), v))
+	})
+}
+
+// RequestedBy applies equality check predicate on the "requested_by" field. It's identical to RequestedByEQ.
+func RequestedBy(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRequestedBy), v))
+	})
+}
+
+// DecidedBy applies equality check predicate on the "decided_by" field. It's identical to DecidedByEQ.
+func DecidedBy(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDecidedBy), v))
+	})
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReason), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// CredentialTypeEQ applies the EQ predicate on the "credential_type" field.
+func CredentialTypeEQ(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCredentialType), v))
+	})
+}
+
+// CredentialTypeNEQ applies the NEQ predicate on the "credential_type" field.
+func CredentialTypeNEQ(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCredentialType), v))
+	})
+}
+
+// CredentialTypeIn applies the In predicate on the "credential_type" field.
+func CredentialTypeIn(vs ...string) predicate.IssuanceRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCredentialType), v...))
+	})
+}
+
+// CredentialTypeNotIn applies the NotIn predicate on the "credential_type" field.
+func CredentialTypeNotIn(vs ...string) predicate.IssuanceRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCredentialType), v...))
+	})
+}
+
+// CredentialTypeGT applies the GT predicate on the "credential_type" field.
+func CredentialTypeGT(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCredentialType), v))
+	})
+}
+
+// CredentialTypeGTE applies the GTE predicate on the "credential_type" field.
+func CredentialTypeGTE(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCredentialType), v))
+	})
+}
+
+// CredentialTypeLT applies the LT predicate on the "credential_type" field.
+func CredentialTypeLT(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCredentialType), v))
+	})
+}
+
+// CredentialTypeLTE applies the LTE predicate on the "credential_type" field.
+func CredentialTypeLTE(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCredentialType), v))
+	})
+}
+
+// CredentialTypeContains applies the Contains predicate on the "credential_type" field.
+func CredentialTypeContains(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCredentialType), v))
+	})
+}
+
+// CredentialTypeHasPrefix applies the HasPrefix predicate on the "credential_type" field.
+func CredentialTypeHasPrefix(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCredentialType), v))
+	})
+}
+
+// CredentialTypeHasSuffix applies the HasSuffix predicate on the "credential_type" field.
+func CredentialTypeHasSuffix(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCredentialType), v))
+	})
+}
+
+// CredentialTypeEqualFold applies the EqualFold predicate on the "credential_type" field.
+func CredentialTypeEqualFold(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCredentialType), v))
+	})
+}
+
+// CredentialTypeContainsFold applies the ContainsFold predicate on the "credential_type" field.
+func CredentialTypeContainsFold(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCredentialType), v))
+	})
+}
+
+// SubjectDidEQ applies the EQ predicate on the "subject_did" field.
+func SubjectDidEQ(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSubjectDid), v))
+	})
+}
+
+// SubjectDidNEQ applies the NEQ predicate on the "subject_did" field.
+func SubjectDidNEQ(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSubjectDid), v))
+	})
+}
+
+// SubjectDidIn applies the In predicate on the "subject_did" field.
+func SubjectDidIn(vs ...string) predicate.IssuanceRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSubjectDid), v...))
+	})
+}
+
+// SubjectDidNotIn applies the NotIn predicate on the "subject_did" field.
+func SubjectDidNotIn(vs ...string) predicate.IssuanceRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSubjectDid), v...))
+	})
+}
+
+// SubjectDidGT applies the GT predicate on the "subject_did" field.
+func SubjectDidGT(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSubjectDid), v))
+	})
+}
+
+// SubjectDidGTE applies the GTE predicate on the "subject_did" field.
+func SubjectDidGTE(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSubjectDid), v))
+	})
+}
+
+// SubjectDidLT applies the LT predicate on the "subject_did" field.
+func SubjectDidLT(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSubjectDid), v))
+	})
+}
+
+// SubjectDidLTE applies the LTE predicate on the "subject_did" field.
+func SubjectDidLTE(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSubjectDid), v))
+	})
+}
+
+// SubjectDidContains applies the Contains predicate on the "subject_did" field.
+func SubjectDidContains(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSubjectDid), v))
+	})
+}
+
+// SubjectDidHasPrefix applies the HasPrefix predicate on the "subject_did" field.
+func SubjectDidHasPrefix(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSubjectDid), v))
+	})
+}
+
+// SubjectDidHasSuffix applies the HasSuffix predicate on the "subject_did" field.
+func SubjectDidHasSuffix(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSubjectDid), v))
+	})
+}
+
+// SubjectDidIsNil applies the IsNil predicate on the "subject_did" field.
+func SubjectDidIsNil() predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSubjectDid)))
+	})
+}
+
+// SubjectDidNotNil applies the NotNil predicate on the "subject_did" field.
+func SubjectDidNotNil() predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSubjectDid)))
+	})
+}
+
+// SubjectDidEqualFold applies the EqualFold predicate on the "subject_did" field.
+func SubjectDidEqualFold(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSubjectDid), v))
+	})
+}
+
+// SubjectDidContainsFold applies the ContainsFold predicate on the "subject_did" field.
+func SubjectDidContainsFold(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSubjectDid), v))
+	})
+}
+
+// PredecessorEQ applies the EQ predicate on the "predecessor" field.
+func PredecessorEQ(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPredecessor), v))
+	})
+}
+
+// PredecessorNEQ applies the NEQ predicate on the "predecessor" field.
+func PredecessorNEQ(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPredecessor), v))
+	})
+}
+
+// PredecessorIn applies the In predicate on the "predecessor" field.
+func PredecessorIn(vs ...string) predicate.IssuanceRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPredecessor), v...))
+	})
+}
+
+// PredecessorNotIn applies the NotIn predicate on the "predecessor" field.
+func PredecessorNotIn(vs ...string) predicate.IssuanceRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPredecessor), v...))
+	})
+}
+
+// PredecessorGT applies the GT predicate on the "predecessor" field.
+func PredecessorGT(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPredecessor), v))
+	})
+}
+
+// PredecessorGTE applies the GTE predicate on the "predecessor" field.
+func PredecessorGTE(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPredecessor), v))
+	})
+}
+
+// PredecessorLT applies the LT predicate on the "predecessor" field.
+func PredecessorLT(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPredecessor), v))
+	})
+}
+
+// PredecessorLTE applies the LTE predicate on the "predecessor" field.
+func PredecessorLTE(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPredecessor), v))
+	})
+}
+
+// PredecessorContains applies the Contains predicate on the "predecessor" field.
+func PredecessorContains(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldPredecessor), v))
+	})
+}
+
+// PredecessorHasPrefix applies the HasPrefix predicate on the "predecessor" field.
+func PredecessorHasPrefix(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldPredecessor), v))
+	})
+}
+
+// PredecessorHasSuffix applies the HasSuffix predicate on the "predecessor" field.
+func PredecessorHasSuffix(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldPredecessor), v))
+	})
+}
+
+// PredecessorIsNil applies the IsNil predicate on the "predecessor" field.
+func PredecessorIsNil() predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPredecessor)))
+	})
+}
+
+// PredecessorNotNil applies the NotNil predicate on the "predecessor" field.
+func PredecessorNotNil() predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPredecessor)))
+	})
+}
+
+// PredecessorEqualFold applies the EqualFold predicate on the "predecessor" field.
+func PredecessorEqualFold(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldPredecessor), v))
+	})
+}
+
+// PredecessorContainsFold applies the ContainsFold predicate on the "predecessor" field.
+func PredecessorContainsFold(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldPredecessor), v))
+	})
+}
+
+// RevokePredecessorEQ applies the EQ predicate on the "revoke_predecessor" field.
+func RevokePredecessorEQ(v bool) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRevokePredecessor), v))
+	})
+}
+
+// RevokePredecessorNEQ applies the NEQ predicate on the "revoke_predecessor" field.
+func RevokePredecessorNEQ(v bool) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRevokePredecessor), v))
+	})
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.IssuanceRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.IssuanceRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStatus), v...))
+	})
+}
+
+// RequestedByEQ applies the EQ predicate on the "requested_by" field.
+func RequestedByEQ(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRequestedBy), v))
+	})
+}
+
+// RequestedByNEQ applies the NEQ predicate on the "requested_by" field.
+func RequestedByNEQ(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRequestedBy), v))
+	})
+}
+
+// RequestedByIn applies the In predicate on the "requested_by" field.
+func RequestedByIn(vs ...string) predicate.IssuanceRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRequestedBy), v...))
+	})
+}
+
+// RequestedByNotIn applies the NotIn predicate on the "requested_by" field.
+func RequestedByNotIn(vs ...string) predicate.IssuanceRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRequestedBy), v...))
+	})
+}
+
+// RequestedByGT applies the GT predicate on the "requested_by" field.
+func RequestedByGT(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRequestedBy), v))
+	})
+}
+
+// RequestedByGTE applies the GTE predicate on the "requested_by" field.
+func RequestedByGTE(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRequestedBy), v))
+	})
+}
+
+// RequestedByLT applies the LT predicate on the "requested_by" field.
+func RequestedByLT(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRequestedBy), v))
+	})
+}
+
+// RequestedByLTE applies the LTE predicate on the "requested_by" field.
+func RequestedByLTE(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRequestedBy), v))
+	})
+}
+
+// RequestedByContains applies the Contains predicate on the "requested_by" field.
+func RequestedByContains(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldRequestedBy), v))
+	})
+}
+
+// RequestedByHasPrefix applies the HasPrefix predicate on the "requested_by" field.
+func RequestedByHasPrefix(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldRequestedBy), v))
+	})
+}
+
+// RequestedByHasSuffix applies the HasSuffix predicate on the "requested_by" field.
+func RequestedByHasSuffix(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldRequestedBy), v))
+	})
+}
+
+// RequestedByEqualFold applies the EqualFold predicate on the "requested_by" field.
+func RequestedByEqualFold(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldRequestedBy), v))
+	})
+}
+
+// RequestedByContainsFold applies the ContainsFold predicate on the "requested_by" field.
+func RequestedByContainsFold(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldRequestedBy), v))
+	})
+}
+
+// DecidedByEQ applies the EQ predicate on the "decided_by" field.
+func DecidedByEQ(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDecidedBy), v))
+	})
+}
+
+// DecidedByNEQ applies the NEQ predicate on the "decided_by" field.
+func DecidedByNEQ(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDecidedBy), v))
+	})
+}
+
+// DecidedByIn applies the In predicate on the "decided_by" field.
+func DecidedByIn(vs ...string) predicate.IssuanceRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDecidedBy), v...))
+	})
+}
+
+// DecidedByNotIn applies the NotIn predicate on the "decided_by" field.
+func DecidedByNotIn(vs ...string) predicate.IssuanceRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDecidedBy), v...))
+	})
+}
+
+// DecidedByGT applies the GT predicate on the "decided_by" field.
+func DecidedByGT(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDecidedBy), v))
+	})
+}
+
+// DecidedByGTE applies the GTE predicate on the "decided_by" field.
+func DecidedByGTE(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDecidedBy), v))
+	})
+}
+
+// DecidedByLT applies the LT predicate on the "decided_by" field.
+func DecidedByLT(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDecidedBy), v))
+	})
+}
+
+// DecidedByLTE applies the LTE predicate on the "decided_by" field.
+func DecidedByLTE(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDecidedBy), v))
+	})
+}
+
+// DecidedByContains applies the Contains predicate on the "decided_by" field.
+func DecidedByContains(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDecidedBy), v))
+	})
+}
+
+// DecidedByHasPrefix applies the HasPrefix predicate on the "decided_by" field.
+func DecidedByHasPrefix(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDecidedBy), v))
+	})
+}
+
+// DecidedByHasSuffix applies the HasSuffix predicate on the "decided_by" field.
+func DecidedByHasSuffix(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDecidedBy), v))
+	})
+}
+
+// DecidedByIsNil applies the IsNil predicate on the "decided_by" field.
+func DecidedByIsNil() predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDecidedBy)))
+	})
+}
+
+// DecidedByNotNil applies the NotNil predicate on the "decided_by" field.
+func DecidedByNotNil() predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDecidedBy)))
+	})
+}
+
+// DecidedByEqualFold applies the EqualFold predicate on the "decided_by" field.
+func DecidedByEqualFold(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDecidedBy), v))
+	})
+}
+
+// DecidedByContainsFold applies the ContainsFold predicate on the "decided_by" field.
+func DecidedByContainsFold(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDecidedBy), v))
+	})
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReason), v))
+	})
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldReason), v))
+	})
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.IssuanceRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldReason), v...))
+	})
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.IssuanceRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldReason), v...))
+	})
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldReason), v))
+	})
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldReason), v))
+	})
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldReason), v))
+	})
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldReason), v))
+	})
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldReason), v))
+	})
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldReason), v))
+	})
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldReason), v))
+	})
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldReason)))
+	})
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldReason)))
+	})
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldReason), v))
+	})
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldReason), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.IssuanceRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.IssuanceRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.IssuanceRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.IssuanceRequest {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// HasEvents applies the HasEdge predicate on the "events" edge.
+func HasEvents() predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(EventsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EventsTable, EventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEventsWith applies the HasEdge predicate on the "events" edge with a given conditions (other predicates).
+func HasEventsWith(preds ...predicate.IssuanceRequestEvent) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(EventsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EventsTable, EventsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCredential applies the HasEdge predicate on the "credential" edge.
+func HasCredential() predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CredentialTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, CredentialTable, CredentialColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCredentialWith applies the HasEdge predicate on the "credential" edge with a given conditions (other predicates).
+func HasCredentialWith(preds ...predicate.Credential) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(CredentialInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, CredentialTable, CredentialColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.IssuanceRequest) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.IssuanceRequest) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.IssuanceRequest) predicate.IssuanceRequest {
+	return predicate.IssuanceRequest(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,572 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/credential"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequest"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequestevent"
+)
+
+// IssuanceRequestCreate is the builder for creating a IssuanceRequest entity.
+type IssuanceRequestCreate struct {
+	config
+	mutation *IssuanceRequestMutation
+	hooks    []Hook
+}
+
+// SetCredentialType sets the "credential_type" field.
+func (irc *IssuanceRequestCreate) SetCredentialType(s string) *IssuanceRequestCreate {
+	irc.mutation.SetCredentialType(s)
+	return irc
+}
+
+// SetClaims sets the "claims" field.
+func (irc *IssuanceRequestCreate) SetClaims(m map[string]interface{}) *IssuanceRequestCreate {
+	irc.mutation.SetClaims(m)
+	return irc
+}
+
+// SetSubjectDid sets the "subject_did" field.
+func (irc *IssuanceRequestCreate) SetSubjectDid(s string) *IssuanceRequestCreate {
+	irc.mutation.SetSubjectDid(s)
+	return irc
+}
+
+// SetNillableSubjectDid sets the "subject_did" field if the given value is not nil.
+func (irc *IssuanceRequestCreate) SetNillableSubjectDid(s *string) *IssuanceRequestCreate {
+	if s != nil {
+		irc.SetSubjectDid(*s)
+	}
+	return irc
+}
+
+// SetPredecessor sets the "predecessor" field.
+func (irc *IssuanceRequestCreate) SetPredecessor(s string) *IssuanceRequestCreate {
+	irc.mutation.SetPredecessor(s)
+	return irc
+}
+
+// SetNillablePredecessor sets the "predecessor" field if the given value is not nil.
+func (irc *IssuanceRequestCreate) SetNillablePredecessor(s *string) *IssuanceRequestCreate {
+	if s != nil {
+		irc.SetPredecessor(*s)
+	}
+	return irc
+}
+
+// SetRevokePredecessor sets the "revoke_predecessor" field.
+func (irc *IssuanceRequestCreate) SetRevokePredecessor(b bool) *IssuanceRequestCreate {
+	irc.mutation.SetRevokePredecessor(b)
+	return irc
+}
+
+// SetNillableRevokePredecessor sets the "revoke_predecessor" field if the given value is not nil.
+func (irc *IssuanceRequestCreate) SetNillableRevokePredecessor(b *bool) *IssuanceRequestCreate {
+	if b != nil {
+		irc.SetRevokePredecessor(*b)
+	}
+	return irc
+}
+
+// SetStatus sets the "status" field.
+func (irc *IssuanceRequestCreate) SetStatus(i issuancerequest.Status) *IssuanceRequestCreate {
+	irc.mutation.SetStatus(i)
+	return irc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (irc *IssuanceRequestCreate) SetNillableStatus(i *issuancerequest.Status) *IssuanceRequestCreate {
+	if i != nil {
+		irc.SetStatus(*i)
+	}
+	return irc
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (irc *IssuanceRequestCreate) SetRequestedBy(s string) *IssuanceRequestCreate {
+	irc.mutation.SetRequestedBy(s)
+	return irc
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (irc *IssuanceRequestCreate) SetDecidedBy(s string) *IssuanceRequestCreate {
+	irc.mutation.SetDecidedBy(s)
+	return irc
+}
+
+// SetNillableDecidedBy sets the "decided_by" field if the given value is not nil.
+func (irc *IssuanceRequestCreate) SetNillableDecidedBy(s *string) *IssuanceRequestCreate {
+	if s != nil {
+		irc.SetDecidedBy(*s)
+	}
+	return irc
+}
+
+// SetReason sets the "reason" field.
+func (irc *IssuanceRequestCreate) SetReason(s string) *IssuanceRequestCreate {
+	irc.mutation.SetReason(s)
+	return irc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (irc *IssuanceRequestCreate) SetNillableReason(s *string) *IssuanceRequestCreate {
+	if s != nil {
+		irc.SetReason(*s)
+	}
+	return irc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (irc *IssuanceRequestCreate) SetCreatedAt(t time.Time) *IssuanceRequestCreate {
+	irc.mutation.SetCreatedAt(t)
+	return irc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (irc *IssuanceRequestCreate) SetNillableCreatedAt(t *time.Time) *IssuanceRequestCreate {
+	if t != nil {
+		irc.SetCreatedAt(*t)
+	}
+	return irc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (irc *IssuanceRequestCreate) SetUpdatedAt(t time.Time) *IssuanceRequestCreate {
+	irc.mutation.SetUpdatedAt(t)
+	return irc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (irc *IssuanceRequestCreate) SetNillableUpdatedAt(t *time.Time) *IssuanceRequestCreate {
+	if t != nil {
+		irc.SetUpdatedAt(*t)
+	}
+	return irc
+}
+
+// SetID sets the "id" field.
+func (irc *IssuanceRequestCreate) SetID(s string) *IssuanceRequestCreate {
+	irc.mutation.SetID(s)
+	return irc
+}
+
+// AddEventIDs adds the "events" edge to the IssuanceRequestEvent entity by IDs.
+func (irc *IssuanceRequestCreate) AddEventIDs(ids ...int) *IssuanceRequestCreate {
+	irc.mutation.AddEventIDs(ids...)
+	return irc
+}
+
+// AddEvents adds the "events" edges to the IssuanceRequestEvent entity.
+func (irc *IssuanceRequestCreate) AddEvents(i ...*IssuanceRequestEvent) *IssuanceRequestCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return irc.AddEventIDs(ids...)
+}
+
+// SetCredentialID sets the "credential" edge to the Credential entity by ID.
+func (irc *IssuanceRequestCreate) SetCredentialID(id string) *IssuanceRequestCreate {
+	irc.mutation.SetCredentialID(id)
+	return irc
+}
+
+// SetNillableCredentialID sets the "credential" edge to the Credential entity by ID if the given value is not nil.
+func (irc *IssuanceRequestCreate) SetNillableCredentialID(id *string) *IssuanceRequestCreate {
+	if id != nil {
+		irc = irc.SetCredentialID(*id)
+	}
+	return irc
+}
+
+// SetCredential sets the "credential" edge to the Credential entity.
+func (irc *IssuanceRequestCreate) SetCredential(c *Credential) *IssuanceRequestCreate {
+	return irc.SetCredentialID(c.ID)
+}
+
+// Mutation returns the IssuanceRequestMutation object of the builder.
+func (irc *IssuanceRequestCreate) Mutation() *IssuanceRequestMutation {
+	return irc.mutation
+}
+
+// Save creates the IssuanceRequest in the database.
+func (irc *IssuanceRequestCreate) Save(ctx context.Context) (*IssuanceRequest, error) {
+	var (
+		err  error
+		node *IssuanceRequest
+	)
+	irc.defaults()
+	if len(irc.hooks) == 0 {
+		if err = irc.check(); err != nil {
+			return nil, err
+		}
+		node, err = irc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*IssuanceRequestMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = irc.check(); err != nil {
+				return nil, err
+			}
+			irc.mutation = mutation
+			if node, err = irc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(irc.hooks) - 1; i >= 0; i-- {
+			if irc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = irc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, irc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*IssuanceRequest)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from IssuanceRequestMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (irc *IssuanceRequestCreate) SaveX(ctx context.Context) *IssuanceRequest {
+	v, err := irc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (irc *IssuanceRequestCreate) Exec(ctx context.Context) error {
+	_, err := irc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (irc *IssuanceRequestCreate) ExecX(ctx context.Context) {
+	if err := irc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (irc *IssuanceRequestCreate) defaults() {
+	if _, ok := irc.mutation.RevokePredecessor(); !ok {
+		v := issuancerequest.DefaultRevokePredecessor
+		irc.mutation.SetRevokePredecessor(v)
+	}
+	if _, ok := irc.mutation.Status(); !ok {
+		v := issuancerequest.DefaultStatus
+		irc.mutation.SetStatus(v)
+	}
+	if _, ok := irc.mutation.CreatedAt(); !ok {
+		v := issuancerequest.DefaultCreatedAt()
+		irc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := irc.mutation.UpdatedAt(); !ok {
+		v := issuancerequest.DefaultUpdatedAt()
+		irc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (irc *IssuanceRequestCreate) check() error {
+	if _, ok := irc.mutation.CredentialType(); !ok {
+		return &ValidationError{Name: "credential_type", err: errors.New(`ent: missing required field "IssuanceRequest.credential_type"`)}
+	}
+	if v, ok := irc.mutation.CredentialType(); ok {
+		if err := issuancerequest.CredentialTypeValidator(v); err != nil {
+			return &ValidationError{Name: "credential_type", err: fmt.Errorf(`ent: validator failed for field "IssuanceRequest.credential_type": %w`, err)}
+		}
+	}
+	if _, ok := irc.mutation.Claims(); !ok {
+		return &ValidationError{Name: "claims", err: errors.New(`ent: missing required field "IssuanceRequest.claims"`)}
+	}
+	if _, ok := irc.mutation.RevokePredecessor(); !ok {
+		return &ValidationError{Name: "revoke_predecessor", err: errors.New(`ent: missing required field "IssuanceRequest.revoke_predecessor"`)}
+	}
+	if _, ok := irc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "IssuanceRequest.status"`)}
+	}
+	if v, ok := irc.mutation.Status(); ok {
+		if err := issuancerequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "IssuanceRequest.status": %w`, err)}
+		}
+	}
+	if _, ok := irc.mutation.RequestedBy(); !ok {
+		return &ValidationError{Name: "requested_by", err: errors.New(`ent: missing required field "IssuanceRequest.requested_by"`)}
+	}
+	if v, ok := irc.mutation.RequestedBy(); ok {
+		if err := issuancerequest.RequestedByValidator(v); err != nil {
+			return &ValidationError{Name: "requested_by", err: fmt.Errorf(`ent: validator failed for field "IssuanceRequest.requested_by": %w`, err)}
+		}
+	}
+	if _, ok := irc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "IssuanceRequest.created_at"`)}
+	}
+	if _, ok := irc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "IssuanceRequest.updated_at"`)}
+	}
+	return nil
+}
+
+func (irc *IssuanceRequestCreate) sqlSave(ctx context.Context) (*IssuanceRequest, error) {
+	_node, _spec := irc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, irc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected IssuanceRequest.ID type: %T", _spec.ID.Value)
+		}
+	}
+	return _node, nil
+}
+
+func (irc *IssuanceRequestCreate) createSpec() (*IssuanceRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &IssuanceRequest{config: irc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: issuancerequest.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: issuancerequest.FieldID,
+			},
+		}
+	)
+	if id, ok := irc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := irc.mutation.CredentialType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: issuancerequest.FieldCredentialType,
+		})
+		_node.CredentialType = value
+	}
+	if value, ok := irc.mutation.Claims(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: issuancerequest.FieldClaims,
+		})
+		_node.Claims = value
+	}
+	if value, ok := irc.mutation.SubjectDid(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: issuancerequest.FieldSubjectDid,
+		})
+		_node.SubjectDid = value
+	}
+	if value, ok := irc.mutation.Predecessor(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: issuancerequest.FieldPredecessor,
+		})
+		_node.Predecessor = value
+	}
+	if value, ok := irc.mutation.RevokePredecessor(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: issuancerequest.FieldRevokePredecessor,
+		})
+		_node.RevokePredecessor = value
+	}
+	if value, ok := irc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: issuancerequest.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := irc.mutation.RequestedBy(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: issuancerequest.FieldRequestedBy,
+		})
+		_node.RequestedBy = value
+	}
+	if value, ok := irc.mutation.DecidedBy(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: issuancerequest.FieldDecidedBy,
+		})
+		_node.DecidedBy = value
+	}
+	if value, ok := irc.mutation.Reason(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: issuancerequest.FieldReason,
+		})
+		_node.Reason = value
+	}
+	if value, ok := irc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: issuancerequest.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := irc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: issuancerequest.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if nodes := irc.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   issuancerequest.EventsTable,
+			Columns: []string{issuancerequest.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: issuancerequestevent.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := irc.mutation.CredentialIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   issuancerequest.CredentialTable,
+			Columns: []string{issuancerequest.CredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credential.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.issuance_request_credential = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// IssuanceRequestCreateBulk is the builder for creating many IssuanceRequest entities in bulk.
+type IssuanceRequestCreateBulk struct {
+	config
+	builders []*IssuanceRequestCreate
+}
+
+// Save creates the IssuanceRequest entities in the database.
+func (ircb *IssuanceRequestCreateBulk) Save(ctx context.Context) ([]*IssuanceRequest, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ircb.builders))
+	nodes := make([]*IssuanceRequest, len(ircb.builders))
+	mutators := make([]Mutator, len(ircb.builders))
+	for i := range ircb.builders {
+		func(i int, root context.Context) {
+			builder := ircb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IssuanceRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ircb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ircb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ircb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ircb *IssuanceRequestCreateBulk) SaveX(ctx context.Context) []*IssuanceRequest {
+	v, err := ircb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ircb *IssuanceRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := ircb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ircb *IssuanceRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := ircb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequest"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+)
+
+// IssuanceRequestDelete is the builder for deleting a IssuanceRequest entity.
+type IssuanceRequestDelete struct {
+	config
+	hooks    []Hook
+	mutation *IssuanceRequestMutation
+}
+
+// Where appends a list predicates to the IssuanceRequestDelete builder.
+func (ird *IssuanceRequestDelete) Where(ps ...predicate.IssuanceRequest) *IssuanceRequestDelete {
+	ird.mutation.Where(ps...)
+	return ird
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ird *IssuanceRequestDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ird.hooks) == 0 {
+		affected, err = ird.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*IssuanceRequestMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ird.mutation = mutation
+			affected, err = ird.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ird.hooks) - 1; i >= 0; i-- {
+			if ird.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ird.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ird.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ird *IssuanceRequestDelete) ExecX(ctx context.Context) int {
+	n, err := ird.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ird *IssuanceRequestDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: issuancerequest.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: issuancerequest.FieldID,
+			},
+		},
+	}
+	if ps := ird.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ird.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// IssuanceRequestDeleteOne is the builder for deleting a single IssuanceRequest entity.
+type IssuanceRequestDeleteOne struct {
+	ird *IssuanceRequestDelete
+}
+
+// Exec executes the deletion query.
+func (irdo *IssuanceRequestDeleteOne) Exec(ctx context.Context) error {
+	n, err := irdo.ird.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{issuancerequest.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (irdo *IssuanceRequestDeleteOne) ExecX(ctx context.Context) {
+	irdo.ird.ExecX(ctx)
+}
@@ -0,0 +1,672 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/credential"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequest"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequestevent"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+)
+
+// IssuanceRequestQuery is the builder for querying IssuanceRequest entities.
+type IssuanceRequestQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.IssuanceRequest
+	// eager-loading edges.
+	withEvents     *IssuanceRequestEventQuery
+	withCredential *CredentialQuery
+	withFKs        bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the IssuanceRequestQuery builder.
+func (irq *IssuanceRequestQuery) Where(ps ...predicate.IssuanceRequest) *IssuanceRequestQuery {
+	irq.predicates = append(irq.predicates, ps...)
+	return irq
+}
+
+// Limit adds a limit step to the query.
+func (irq *IssuanceRequestQuery) Limit(limit int) *IssuanceRequestQuery {
+	irq.limit = &limit
+	return irq
+}
+
+// Offset adds an offset step to the query.
+func (irq *IssuanceRequestQuery) Offset(offset int) *IssuanceRequestQuery {
+	irq.offset = &offset
+	return irq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (irq *IssuanceRequestQuery) Unique(unique bool) *IssuanceRequestQuery {
+	irq.unique = &unique
+	return irq
+}
+
+// Order adds an order step to the query.
+func (irq *IssuanceRequestQuery) Order(o ...OrderFunc) *IssuanceRequestQuery {
+	irq.order = append(irq.order, o...)
+	return irq
+}
+
+// QueryEvents chains the current query on the "events" edge.
+func (irq *IssuanceRequestQuery) QueryEvents() *IssuanceRequestEventQuery {
+	query := &IssuanceRequestEventQuery{config: irq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := irq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := irq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(issuancerequest.Table, issuancerequest.FieldID, selector),
+			sqlgraph.To(issuancerequestevent.Table, issuancerequestevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, issuancerequest.EventsTable, issuancerequest.EventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(irq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCredential chains the current query on the "credential" edge.
+func (irq *IssuanceRequestQuery) QueryCredential() *CredentialQuery {
+	query := &CredentialQuery{config: irq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := irq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := irq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(issuancerequest.Table, issuancerequest.FieldID, selector),
+			sqlgraph.To(credential.Table, credential.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, issuancerequest.CredentialTable, issuancerequest.CredentialColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(irq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first IssuanceRequest entity from the query.
+// Returns a *NotFoundError when no IssuanceRequest was found.
+func (irq *IssuanceRequestQuery) First(ctx context.Context) (*IssuanceRequest, error) {
+	nodes, err := irq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{issuancerequest.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (irq *IssuanceRequestQuery) FirstX(ctx context.Context) *IssuanceRequest {
+	node, err := irq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first IssuanceRequest ID from the query.
+// Returns a *NotFoundError when no IssuanceRequest ID was found.
+func (irq *IssuanceRequestQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = irq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{issuancerequest.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (irq *IssuanceRequestQuery) FirstIDX(ctx context.Context) string {
+	id, err := irq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single IssuanceRequest entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one IssuanceRequest entity is found.
+// Returns a *NotFoundError when no IssuanceRequest entities are found.
+func (irq *IssuanceRequestQuery) Only(ctx context.Context) (*IssuanceRequest, error) {
+	nodes, err := irq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{issuancerequest.Label}
+	default:
+		return nil, &NotSingularError{issuancerequest.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (irq *IssuanceRequestQuery) OnlyX(ctx context.Context) *IssuanceRequest {
+	node, err := irq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only IssuanceRequest ID in the query.
+// Returns a *NotSingularError when more than one IssuanceRequest ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (irq *IssuanceRequestQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = irq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{issuancerequest.Label}
+	default:
+		err = &NotSingularError{issuancerequest.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (irq *IssuanceRequestQuery) OnlyIDX(ctx context.Context) string {
+	id, err := irq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of IssuanceRequests.
+func (irq *IssuanceRequestQuery) All(ctx context.Context) ([]*IssuanceRequest, error) {
+	if err := irq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return irq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (irq *IssuanceRequestQuery) AllX(ctx context.Context) []*IssuanceRequest {
+	nodes, err := irq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of IssuanceRequest IDs.
+func (irq *IssuanceRequestQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := irq.Select(issuancerequest.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (irq *IssuanceRequestQuery) IDsX(ctx context.Context) []string {
+	ids, err := irq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (irq *IssuanceRequestQuery) Count(ctx context.Context) (int, error) {
+	if err := irq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return irq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (irq *IssuanceRequestQuery) CountX(ctx context.Context) int {
+	count, err := irq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (irq *IssuanceRequestQuery) Exist(ctx context.Context) (bool, error) {
+	if err := irq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return irq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (irq *IssuanceRequestQuery) ExistX(ctx context.Context) bool {
+	exist, err := irq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the IssuanceRequestQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (irq *IssuanceRequestQuery) Clone() *IssuanceRequestQuery {
+	if irq == nil {
+		return nil
+	}
+	return &IssuanceRequestQuery{
+		config:         irq.config,
+		limit:          irq.limit,
+		offset:         irq.offset,
+		order:          append([]OrderFunc{}, irq.order...),
+		predicates:     append([]predicate.IssuanceRequest{}, irq.predicates...),
+		withEvents:     irq.withEvents.Clone(),
+		withCredential: irq.withCredential.Clone(),
+		// clone intermediate query.
+		sql:    irq.sql.Clone(),
+		path:   irq.path,
+		unique: irq.unique,
+	}
+}
+
+// WithEvents tells the query-builder to eager-load the nodes that are connected to
+// the "events" edge. The optional arguments are used to configure the query builder of the edge.
+func (irq *IssuanceRequestQuery) WithEvents(opts ...func(*IssuanceRequestEventQuery)) *IssuanceRequestQuery {
+	query := &IssuanceRequestEventQuery{config: irq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	irq.withEvents = query
+	return irq
+}
+
+// WithCredential tells the query-builder to eager-load the nodes that are connected to
+// the "credential" edge. The optional arguments are used to configure the query builder of the edge.
+func (irq *IssuanceRequestQuery) WithCredential(opts ...func(*CredentialQuery)) *IssuanceRequestQuery {
+	query := &CredentialQuery{config: irq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	irq.withCredential = query
+	return irq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CredentialType string `json:"credential_type,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.IssuanceRequest.Query().
+//		GroupBy(issuancerequest.FieldCredentialType).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (irq *IssuanceRequestQuery) GroupBy(field string, fields ...string) *IssuanceRequestGroupBy {
+	grbuild := &IssuanceRequestGroupBy{config: irq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := irq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return irq.sqlQuery(ctx), nil
+	}
+	grbuild.label = issuancerequest.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CredentialType string `json:"credential_type,omitempty"`
+//	}
+//
+//	client.IssuanceRequest.Query().
+//		Select(issuancerequest.FieldCredentialType).
+//		Scan(ctx, &v)
+func (irq *IssuanceRequestQuery) Select(fields ...string) *IssuanceRequestSelect {
+	irq.fields = append(irq.fields, fields...)
+	selbuild := &IssuanceRequestSelect{IssuanceRequestQuery: irq}
+	selbuild.label = issuancerequest.Label
+	selbuild.flds, selbuild.scan = &irq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (irq *IssuanceRequestQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range irq.fields {
+		if !issuancerequest.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if irq.path != nil {
+		prev, err := irq.path(ctx)
+		if err != nil {
+			return err
+		}
+		irq.sql = prev
+	}
+	return nil
+}
+
+func (irq *IssuanceRequestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*IssuanceRequest, error) {
+	var (
+		nodes       = []*IssuanceRequest{}
+		withFKs     = irq.withFKs
+		_spec       = irq.querySpec()
+		loadedTypes = [2]bool{
+			irq.withEvents != nil,
+			irq.withCredential != nil,
+		}
+	)
+	if irq.withCredential != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, issuancerequest.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*IssuanceRequest).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &IssuanceRequest{config: irq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, irq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := irq.withEvents; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[string]*IssuanceRequest)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Events = []*IssuanceRequestEvent{}
+		}
+		query.withFKs = true
+		query.Where(predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+			s.Where(sql.InValues(issuancerequest.EventsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.issuance_request_events
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "issuance_request_events" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "issuance_request_events" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Events = append(node.Edges.Events, n)
+		}
+	}
+
+	if query := irq.withCredential; query != nil {
+		ids := make([]string, 0, len(nodes))
+		nodeids := make(map[string][]*IssuanceRequest)
+		for i := range nodes {
+			if nodes[i].issuance_request_credential == nil {
+				continue
+			}
+			fk := *nodes[i].issuance_request_credential
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(credential.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "issuance_request_credential" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Credential = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (irq *IssuanceRequestQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := irq.querySpec()
+	_spec.Node.Columns = irq.fields
+	if len(irq.fields) > 0 {
+		_spec.Unique = irq.unique != nil && *irq.unique
+	}
+	return sqlgraph.CountNodes(ctx, irq.driver, _spec)
+}
+
+func (irq *IssuanceRequestQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := irq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (irq *IssuanceRequestQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   issuancerequest.Table,
+			Columns: issuancerequest.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: issuancerequest.FieldID,
+			},
+		},
+		From:   irq.sql,
+		Unique: true,
+	}
+	if unique := irq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := irq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, issuancerequest.FieldID)
+		for i := range fields {
+			if fields[i] != issuancerequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := irq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := irq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := irq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := irq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (irq *IssuanceRequestQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(irq.driver.Dialect())
+	t1 := builder.Table(issuancerequest.Table)
+	columns := irq.fields
+	if len(columns) == 0 {
+		columns = issuancerequest.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if irq.sql != nil {
+		selector = irq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if irq.unique != nil && *irq.unique {
+		selector.Distinct()
+	}
+	for _, p := range irq.predicates {
+		p(selector)
+	}
+	for _, p := range irq.order {
+		p(selector)
+	}
+	if offset := irq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := irq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// IssuanceRequestGroupBy is the group-by builder for IssuanceRequest entities.
+type IssuanceRequestGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (irgb *IssuanceRequestGroupBy) Aggregate(fns ...AggregateFunc) *IssuanceRequestGroupBy {
+	irgb.fns = append(irgb.fns, fns...)
+	return irgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (irgb *IssuanceRequestGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := irgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	irgb.sql = query
+	return irgb.sqlScan(ctx, v)
+}
+
+func (irgb *IssuanceRequestGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range irgb.fields {
+		if !issuancerequest.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := irgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := irgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (irgb *IssuanceRequestGroupBy) sqlQuery() *sql.Selector {
+	selector := irgb.sql.Select()
+	aggregation := make([]string, 0, len(irgb.fns))
+	for _, fn := range irgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(irgb.fields)+len(irgb.fns))
+		for _, f := range irgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(irgb.fields...)...)
+}
+
+// IssuanceRequestSelect is the builder for selecting fields of IssuanceRequest entities.
+type IssuanceRequestSelect struct {
+	*IssuanceRequestQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (irs *IssuanceRequestSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := irs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	irs.sql = irs.IssuanceRequestQuery.sqlQuery(ctx)
+	return irs.sqlScan(ctx, v)
+}
+
+func (irs *IssuanceRequestSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := irs.sql.Query()
+	if err := irs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,820 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/credential"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequest"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequestevent"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+)
+
+// IssuanceRequestUpdate is the builder for updating IssuanceRequest entities.
+type IssuanceRequestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *IssuanceRequestMutation
+}
+
+// Where appends a list predicates to the IssuanceRequestUpdate builder.
+func (iru *IssuanceRequestUpdate) Where(ps ...predicate.IssuanceRequest) *IssuanceRequestUpdate {
+	iru.mutation.Where(ps...)
+	return iru
+}
+
+// SetStatus sets the "status" field.
+func (iru *IssuanceRequestUpdate) SetStatus(i issuancerequest.Status) *IssuanceRequestUpdate {
+	iru.mutation.SetStatus(i)
+	return iru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iru *IssuanceRequestUpdate) SetNillableStatus(i *issuancerequest.Status) *IssuanceRequestUpdate {
+	if i != nil {
+		iru.SetStatus(*i)
+	}
+	return iru
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (iru *IssuanceRequestUpdate) SetDecidedBy(s string) *IssuanceRequestUpdate {
+	iru.mutation.SetDecidedBy(s)
+	return iru
+}
+
+// SetNillableDecidedBy sets the "decided_by" field if the given value is not nil.
+func (iru *IssuanceRequestUpdate) SetNillableDecidedBy(s *string) *IssuanceRequestUpdate {
+	if s != nil {
+		iru.SetDecidedBy(*s)
+	}
+	return iru
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (iru *IssuanceRequestUpdate) ClearDecidedBy() *IssuanceRequestUpdate {
+	iru.mutation.ClearDecidedBy()
+	return iru
+}
+
+// SetReason sets the "reason" field.
+func (iru *IssuanceRequestUpdate) SetReason(s string) *IssuanceRequestUpdate {
+	iru.mutation.SetReason(s)
+	return iru
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (iru *IssuanceRequestUpdate) SetNillableReason(s *string) *IssuanceRequestUpdate {
+	if s != nil {
+		iru.SetReason(*s)
+	}
+	return iru
+}
+
+// ClearReason clears the value of the "reason" field.
+func (iru *IssuanceRequestUpdate) ClearReason() *IssuanceRequestUpdate {
+	iru.mutation.ClearReason()
+	return iru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iru *IssuanceRequestUpdate) SetUpdatedAt(t time.Time) *IssuanceRequestUpdate {
+	iru.mutation.SetUpdatedAt(t)
+	return iru
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (iru *IssuanceRequestUpdate) SetNillableUpdatedAt(t *time.Time) *IssuanceRequestUpdate {
+	if t != nil {
+		iru.SetUpdatedAt(*t)
+	}
+	return iru
+}
+
+// AddEventIDs adds the "events" edge to the IssuanceRequestEvent entity by IDs.
+func (iru *IssuanceRequestUpdate) AddEventIDs(ids ...int) *IssuanceRequestUpdate {
+	iru.mutation.AddEventIDs(ids...)
+	return iru
+}
+
+// AddEvents adds the "events" edges to the IssuanceRequestEvent entity.
+func (iru *IssuanceRequestUpdate) AddEvents(i ...*IssuanceRequestEvent) *IssuanceRequestUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iru.AddEventIDs(ids...)
+}
+
+// SetCredentialID sets the "credential" edge to the Credential entity by ID.
+func (iru *IssuanceRequestUpdate) SetCredentialID(id string) *IssuanceRequestUpdate {
+	iru.mutation.SetCredentialID(id)
+	return iru
+}
+
+// SetNillableCredentialID sets the "credential" edge to the Credential entity by ID if the given value is not nil.
+func (iru *IssuanceRequestUpdate) SetNillableCredentialID(id *string) *IssuanceRequestUpdate {
+	if id != nil {
+		iru = iru.SetCredentialID(*id)
+	}
+	return iru
+}
+
+// SetCredential sets the "credential" edge to the Credential entity.
+func (iru *IssuanceRequestUpdate) SetCredential(c *Credential) *IssuanceRequestUpdate {
+	return iru.SetCredentialID(c.ID)
+}
+
+// Mutation returns the IssuanceRequestMutation object of the builder.
+func (iru *IssuanceRequestUpdate) Mutation() *IssuanceRequestMutation {
+	return iru.mutation
+}
+
+// ClearEvents clears all "events" edges to the IssuanceRequestEvent entity.
+func (iru *IssuanceRequestUpdate) ClearEvents() *IssuanceRequestUpdate {
+	iru.mutation.ClearEvents()
+	return iru
+}
+
+// RemoveEventIDs removes the "events" edge to IssuanceRequestEvent entities by IDs.
+func (iru *IssuanceRequestUpdate) RemoveEventIDs(ids ...int) *IssuanceRequestUpdate {
+	iru.mutation.RemoveEventIDs(ids...)
+	return iru
+}
+
+// RemoveEvents removes "events" edges to IssuanceRequestEvent entities.
+func (iru *IssuanceRequestUpdate) RemoveEvents(i ...*IssuanceRequestEvent) *IssuanceRequestUpdate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iru.RemoveEventIDs(ids...)
+}
+
+// ClearCredential clears the "credential" edge to the Credential entity.
+func (iru *IssuanceRequestUpdate) ClearCredential() *IssuanceRequestUpdate {
+	iru.mutation.ClearCredential()
+	return iru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iru *IssuanceRequestUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(iru.hooks) == 0 {
+		if err = iru.check(); err != nil {
+			return 0, err
+		}
+		affected, err = iru.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*IssuanceRequestMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = iru.check(); err != nil {
+				return 0, err
+			}
+			iru.mutation = mutation
+			affected, err = iru.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(iru.hooks) - 1; i >= 0; i-- {
+			if iru.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = iru.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iru.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iru *IssuanceRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := iru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iru *IssuanceRequestUpdate) Exec(ctx context.Context) error {
+	_, err := iru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iru *IssuanceRequestUpdate) ExecX(ctx context.Context) {
+	if err := iru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iru *IssuanceRequestUpdate) check() error {
+	if v, ok := iru.mutation.Status(); ok {
+		if err := issuancerequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "IssuanceRequest.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (iru *IssuanceRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   issuancerequest.Table,
+			Columns: issuancerequest.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: issuancerequest.FieldID,
+			},
+		},
+	}
+	if ps := iru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if iru.mutation.SubjectDidCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: issuancerequest.FieldSubjectDid,
+		})
+	}
+	if iru.mutation.PredecessorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: issuancerequest.FieldPredecessor,
+		})
+	}
+	if value, ok := iru.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: issuancerequest.FieldStatus,
+		})
+	}
+	if value, ok := iru.mutation.DecidedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: issuancerequest.FieldDecidedBy,
+		})
+	}
+	if iru.mutation.DecidedByCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: issuancerequest.FieldDecidedBy,
+		})
+	}
+	if value, ok := iru.mutation.Reason(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: issuancerequest.FieldReason,
+		})
+	}
+	if iru.mutation.ReasonCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: issuancerequest.FieldReason,
+		})
+	}
+	if value, ok := iru.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: issuancerequest.FieldUpdatedAt,
+		})
+	}
+	if iru.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   issuancerequest.EventsTable,
+			Columns: []string{issuancerequest.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: issuancerequestevent.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iru.mutation.RemovedEventsIDs(); len(nodes) > 0 && !iru.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   issuancerequest.EventsTable,
+			Columns: []string{issuancerequest.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: issuancerequestevent.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iru.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   issuancerequest.EventsTable,
+			Columns: []string{issuancerequest.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: issuancerequestevent.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iru.mutation.CredentialCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   issuancerequest.CredentialTable,
+			Columns: []string{issuancerequest.CredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credential.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iru.mutation.CredentialIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   issuancerequest.CredentialTable,
+			Columns: []string{issuancerequest.CredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credential.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{issuancerequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// IssuanceRequestUpdateOne is the builder for updating a single IssuanceRequest entity.
+type IssuanceRequestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *IssuanceRequestMutation
+}
+
+// SetStatus sets the "status" field.
+func (iruo *IssuanceRequestUpdateOne) SetStatus(i issuancerequest.Status) *IssuanceRequestUpdateOne {
+	iruo.mutation.SetStatus(i)
+	return iruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iruo *IssuanceRequestUpdateOne) SetNillableStatus(i *issuancerequest.Status) *IssuanceRequestUpdateOne {
+	if i != nil {
+		iruo.SetStatus(*i)
+	}
+	return iruo
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (iruo *IssuanceRequestUpdateOne) SetDecidedBy(s string) *IssuanceRequestUpdateOne {
+	iruo.mutation.SetDecidedBy(s)
+	return iruo
+}
+
+// SetNillableDecidedBy sets the "decided_by" field if the given value is not nil.
+func (iruo *IssuanceRequestUpdateOne) SetNillableDecidedBy(s *string) *IssuanceRequestUpdateOne {
+	if s != nil {
+		iruo.SetDecidedBy(*s)
+	}
+	return iruo
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (iruo *IssuanceRequestUpdateOne) ClearDecidedBy() *IssuanceRequestUpdateOne {
+	iruo.mutation.ClearDecidedBy()
+	return iruo
+}
+
+// SetReason sets the "reason" field.
+func (iruo *IssuanceRequestUpdateOne) SetReason(s string) *IssuanceRequestUpdateOne {
+	iruo.mutation.SetReason(s)
+	return iruo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (iruo *IssuanceRequestUpdateOne) SetNillableReason(s *string) *IssuanceRequestUpdateOne {
+	if s != nil {
+		iruo.SetReason(*s)
+	}
+	return iruo
+}
+
+// ClearReason clears the value of the "reason" field.
+func (iruo *IssuanceRequestUpdateOne) ClearReason() *IssuanceRequestUpdateOne {
+	iruo.mutation.ClearReason()
+	return iruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iruo *IssuanceRequestUpdateOne) SetUpdatedAt(t time.Time) *IssuanceRequestUpdateOne {
+	iruo.mutation.SetUpdatedAt(t)
+	return iruo
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (iruo *IssuanceRequestUpdateOne) SetNillableUpdatedAt(t *time.Time) *IssuanceRequestUpdateOne {
+	if t != nil {
+		iruo.SetUpdatedAt(*t)
+	}
+	return iruo
+}
+
+// AddEventIDs adds the "events" edge to the IssuanceRequestEvent entity by IDs.
+func (iruo *IssuanceRequestUpdateOne) AddEventIDs(ids ...int) *IssuanceRequestUpdateOne {
+	iruo.mutation.AddEventIDs(ids...)
+	return iruo
+}
+
+// AddEvents adds the "events" edges to the IssuanceRequestEvent entity.
+func (iruo *IssuanceRequestUpdateOne) AddEvents(i ...*IssuanceRequestEvent) *IssuanceRequestUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iruo.AddEventIDs(ids...)
+}
+
+// SetCredentialID sets the "credential" edge to the Credential entity by ID.
+func (iruo *IssuanceRequestUpdateOne) SetCredentialID(id string) *IssuanceRequestUpdateOne {
+	iruo.mutation.SetCredentialID(id)
+	return iruo
+}
+
+// SetNillableCredentialID sets the "credential" edge to the Credential entity by ID if the given value is not nil.
+func (iruo *IssuanceRequestUpdateOne) SetNillableCredentialID(id *string) *IssuanceRequestUpdateOne {
+	if id != nil {
+		iruo = iruo.SetCredentialID(*id)
+	}
+	return iruo
+}
+
+// SetCredential sets the "credential" edge to the Credential entity.
+func (iruo *IssuanceRequestUpdateOne) SetCredential(c *Credential) *IssuanceRequestUpdateOne {
+	return iruo.SetCredentialID(c.ID)
+}
+
+// Mutation returns the IssuanceRequestMutation object of the builder.
+func (iruo *IssuanceRequestUpdateOne) Mutation() *IssuanceRequestMutation {
+	return iruo.mutation
+}
+
+// ClearEvents clears all "events" edges to the IssuanceRequestEvent entity.
+func (iruo *IssuanceRequestUpdateOne) ClearEvents() *IssuanceRequestUpdateOne {
+	iruo.mutation.ClearEvents()
+	return iruo
+}
+
+// RemoveEventIDs removes the "events" edge to IssuanceRequestEvent entities by IDs.
+func (iruo *IssuanceRequestUpdateOne) RemoveEventIDs(ids ...int) *IssuanceRequestUpdateOne {
+	iruo.mutation.RemoveEventIDs(ids...)
+	return iruo
+}
+
+// RemoveEvents removes "events" edges to IssuanceRequestEvent entities.
+func (iruo *IssuanceRequestUpdateOne) RemoveEvents(i ...*IssuanceRequestEvent) *IssuanceRequestUpdateOne {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iruo.RemoveEventIDs(ids...)
+}
+
+// ClearCredential clears the "credential" edge to the Credential entity.
+func (iruo *IssuanceRequestUpdateOne) ClearCredential() *IssuanceRequestUpdateOne {
+	iruo.mutation.ClearCredential()
+	return iruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iruo *IssuanceRequestUpdateOne) Select(field string, fields ...string) *IssuanceRequestUpdateOne {
+	iruo.fields = append([]string{field}, fields...)
+	return iruo
+}
+
+// Save executes the query and returns the updated IssuanceRequest entity.
+func (iruo *IssuanceRequestUpdateOne) Save(ctx context.Context) (*IssuanceRequest, error) {
+	var (
+		err  error
+		node *IssuanceRequest
+	)
+	if len(iruo.hooks) == 0 {
+		if err = iruo.check(); err != nil {
+			return nil, err
+		}
+		node, err = iruo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*IssuanceRequestMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = iruo.check(); err != nil {
+				return nil, err
+			}
+			iruo.mutation = mutation
+			node, err = iruo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(iruo.hooks) - 1; i >= 0; i-- {
+			if iruo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = iruo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, iruo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*IssuanceRequest)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from IssuanceRequestMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iruo *IssuanceRequestUpdateOne) SaveX(ctx context.Context) *IssuanceRequest {
+	node, err := iruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iruo *IssuanceRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := iruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iruo *IssuanceRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := iruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iruo *IssuanceRequestUpdateOne) check() error {
+	if v, ok := iruo.mutation.Status(); ok {
+		if err := issuancerequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "IssuanceRequest.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (iruo *IssuanceRequestUpdateOne) sqlSave(ctx context.Context) (_node *IssuanceRequest, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   issuancerequest.Table,
+			Columns: issuancerequest.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: issuancerequest.FieldID,
+			},
+		},
+	}
+	id, ok := iruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "IssuanceRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, issuancerequest.FieldID)
+		for _, f := range fields {
+			if !issuancerequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != issuancerequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if iruo.mutation.SubjectDidCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: issuancerequest.FieldSubjectDid,
+		})
+	}
+	if iruo.mutation.PredecessorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: issuancerequest.FieldPredecessor,
+		})
+	}
+	if value, ok := iruo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: issuancerequest.FieldStatus,
+		})
+	}
+	if value, ok := iruo.mutation.DecidedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: issuancerequest.FieldDecidedBy,
+		})
+	}
+	if iruo.mutation.DecidedByCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: issuancerequest.FieldDecidedBy,
+		})
+	}
+	if value, ok := iruo.mutation.Reason(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: issuancerequest.FieldReason,
+		})
+	}
+	if iruo.mutation.ReasonCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: issuancerequest.FieldReason,
+		})
+	}
+	if value, ok := iruo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: issuancerequest.FieldUpdatedAt,
+		})
+	}
+	if iruo.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   issuancerequest.EventsTable,
+			Columns: []string{issuancerequest.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: issuancerequestevent.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iruo.mutation.RemovedEventsIDs(); len(nodes) > 0 && !iruo.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   issuancerequest.EventsTable,
+			Columns: []string{issuancerequest.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: issuancerequestevent.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iruo.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   issuancerequest.EventsTable,
+			Columns: []string{issuancerequest.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: issuancerequestevent.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iruo.mutation.CredentialCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   issuancerequest.CredentialTable,
+			Columns: []string{issuancerequest.CredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credential.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iruo.mutation.CredentialIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   issuancerequest.CredentialTable,
+			Columns: []string{issuancerequest.CredentialColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: credential.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &IssuanceRequest{config: iruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{issuancerequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
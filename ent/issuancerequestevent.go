@@ -0,0 +1,188 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequest"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequestevent"
+)
+
+// IssuanceRequestEvent is the model entity for the IssuanceRequestEvent schema.
+type IssuanceRequestEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// FromStatus holds the value of the "from_status" field.
+	FromStatus string `json:"from_status,omitempty"`
+	// ToStatus holds the value of the "to_status" field.
+	ToStatus string `json:"to_status,omitempty"`
+	// Actor holds the value of the "actor" field.
+	Actor string `json:"actor,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the IssuanceRequestEventQuery when eager-loading is set.
+	Edges                   IssuanceRequestEventEdges `json:"edges"`
+	issuance_request_events *string
+}
+
+// IssuanceRequestEventEdges holds the relations/edges for other nodes in the graph.
+type IssuanceRequestEventEdges struct {
+	// Request holds the value of the request edge.
+	Request *IssuanceRequest `json:"request,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// RequestOrErr returns the Request value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e IssuanceRequestEventEdges) RequestOrErr() (*IssuanceRequest, error) {
+	if e.loadedTypes[0] {
+		if e.Request == nil {
+			// The edge request was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: issuancerequest.Label}
+		}
+		return e.Request, nil
+	}
+	return nil, &NotLoadedError{edge: "request"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*IssuanceRequestEvent) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case issuancerequestevent.FieldID:
+			values[i] = new(sql.NullInt64)
+		case issuancerequestevent.FieldFromStatus, issuancerequestevent.FieldToStatus, issuancerequestevent.FieldActor, issuancerequestevent.FieldReason:
+			values[i] = new(sql.NullString)
+		case issuancerequestevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case issuancerequestevent.ForeignKeys[0]: // issuance_request_events
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type IssuanceRequestEvent", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the IssuanceRequestEvent fields.
+func (ire *IssuanceRequestEvent) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case issuancerequestevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ire.ID = int(value.Int64)
+		case issuancerequestevent.FieldFromStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_status", values[i])
+			} else if value.Valid {
+				ire.FromStatus = value.String
+			}
+		case issuancerequestevent.FieldToStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_status", values[i])
+			} else if value.Valid {
+				ire.ToStatus = value.String
+			}
+		case issuancerequestevent.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				ire.Actor = value.String
+			}
+		case issuancerequestevent.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				ire.Reason = value.String
+			}
+		case issuancerequestevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ire.CreatedAt = value.Time
+			}
+		case issuancerequestevent.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field issuance_request_events", values[i])
+			} else if value.Valid {
+				ire.issuance_request_events = new(string)
+				*ire.issuance_request_events = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// QueryRequest queries the "request" edge of the IssuanceRequestEvent entity.
+func (ire *IssuanceRequestEvent) QueryRequest() *IssuanceRequestQuery {
+	return (&IssuanceRequestEventClient{config: ire.config}).QueryRequest(ire)
+}
+
+// Update returns a builder for updating this IssuanceRequestEvent.
+// Note that you need to call IssuanceRequestEvent.Unwrap() before calling this method if this IssuanceRequestEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ire *IssuanceRequestEvent) Update() *IssuanceRequestEventUpdateOne {
+	return (&IssuanceRequestEventClient{config: ire.config}).UpdateOne(ire)
+}
+
+// Unwrap unwraps the IssuanceRequestEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ire *IssuanceRequestEvent) Unwrap() *IssuanceRequestEvent {
+	_tx, ok := ire.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: IssuanceRequestEvent is not a transactional entity")
+	}
+	ire.config.driver = _tx.drv
+	return ire
+}
+
+// String implements the fmt.Stringer.
+func (ire *IssuanceRequestEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("IssuanceRequestEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ire.ID))
+	builder.WriteString("from_status=")
+	builder.WriteString(ire.FromStatus)
+	builder.WriteString(", ")
+	builder.WriteString("to_status=")
+	builder.WriteString(ire.ToStatus)
+	builder.WriteString(", ")
+	builder.WriteString("actor=")
+	builder.WriteString(ire.Actor)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(ire.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ire.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IssuanceRequestEvents is a parsable slice of IssuanceRequestEvent.
+type IssuanceRequestEvents []*IssuanceRequestEvent
+
+func (ire IssuanceRequestEvents) config(cfg config) {
+	for _i := range ire {
+		ire[_i].config = cfg
+	}
+}
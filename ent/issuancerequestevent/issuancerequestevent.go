@@ -0,0 +1,71 @@
+// Code generated by ent, DO NOT EDIT.
+
+package issuancerequestevent
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the issuancerequestevent type in the database.
+	Label = "issuance_request_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldFromStatus holds the string denoting the from_status field in the database.
+	FieldFromStatus = "from_status"
+	// FieldToStatus holds the string denoting the to_status field in the database.
+	FieldToStatus = "to_status"
+	// FieldActor holds the string denoting the actor field in the database.
+	FieldActor = "actor"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeRequest holds the string denoting the request edge name in mutations.
+	EdgeRequest = "request"
+	// Table holds the table name of the issuancerequestevent in the database.
+	Table = "issuance_request_events"
+	// RequestTable is the table that holds the request relation/edge.
+	RequestTable = "issuance_request_events"
+	// RequestInverseTable is the table name for the IssuanceRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "issuancerequest" package.
+	RequestInverseTable = "issuance_requests"
+	// RequestColumn is the table column denoting the request relation/edge.
+	RequestColumn = "issuance_request_events"
+)
+
+// Columns holds all SQL columns for issuancerequestevent fields.
+var Columns = []string{
+	FieldID,
+	FieldFromStatus,
+	FieldToStatus,
+	FieldActor,
+	FieldReason,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "issuance_request_events"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"issuance_request_events",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
@@ -0,0 +1,725 @@
+// Code generated by ent, DO NOT EDIT.
+
+package issuancerequestevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// FromStatus applies equality check predicate on the "from_status" field. It's identical to FromStatusEQ.
+func FromStatus(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFromStatus), v))
+	})
+}
+
+// ToStatus applies equality check predicate on the "to_status" field. It's identical to ToStatusEQ.
+func ToStatus(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldToStatus), v))
+	})
+}
+
+// Actor applies equality check predicate on the "actor" field. It's identical to ActorEQ.
+func Actor(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActor), v))
+	})
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReason), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// FromStatusEQ applies the EQ predicate on the "from_status" field.
+func FromStatusEQ(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFromStatus), v))
+	})
+}
+
+// FromStatusNEQ applies the NEQ predicate on the "from_status" field.
+func FromStatusNEQ(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldFromStatus), v))
+	})
+}
+
+// FromStatusIn applies the In predicate on the "from_status" field.
+func FromStatusIn(vs ...string) predicate.IssuanceRequestEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldFromStatus), v...))
+	})
+}
+
+// FromStatusNotIn applies the NotIn predicate on the "from_status" field.
+func FromStatusNotIn(vs ...string) predicate.IssuanceRequestEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldFromStatus), v...))
+	})
+}
+
+// FromStatusGT applies the GT predicate on the "from_status" field.
+func FromStatusGT(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldFromStatus), v))
+	})
+}
+
+// FromStatusGTE applies the GTE predicate on the "from_status" field.
+func FromStatusGTE(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldFromStatus), v))
+	})
+}
+
+// FromStatusLT applies the LT predicate on the "from_status" field.
+func FromStatusLT(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldFromStatus), v))
+	})
+}
+
+// FromStatusLTE applies the LTE predicate on the "from_status" field.
+func FromStatusLTE(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldFromStatus), v))
+	})
+}
+
+// FromStatusContains applies the Contains predicate on the "from_status" field.
+func FromStatusContains(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldFromStatus), v))
+	})
+}
+
+// FromStatusHasPrefix applies the HasPrefix predicate on the "from_status" field.
+func FromStatusHasPrefix(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldFromStatus), v))
+	})
+}
+
+// FromStatusHasSuffix applies the HasSuffix predicate on the "from_status" field.
+func FromStatusHasSuffix(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldFromStatus), v))
+	})
+}
+
+// FromStatusIsNil applies the IsNil predicate on the "from_status" field.
+func FromStatusIsNil() predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldFromStatus)))
+	})
+}
+
+// FromStatusNotNil applies the NotNil predicate on the "from_status" field.
+func FromStatusNotNil() predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldFromStatus)))
+	})
+}
+
+// FromStatusEqualFold applies the EqualFold predicate on the "from_status" field.
+func FromStatusEqualFold(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldFromStatus), v))
+	})
+}
+
+// FromStatusContainsFold applies the ContainsFold predicate on the "from_status" field.
+func FromStatusContainsFold(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldFromStatus), v))
+	})
+}
+
+// ToStatusEQ applies the EQ predicate on the "to_status" field.
+func ToStatusEQ(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldToStatus), v))
+	})
+}
+
+// ToStatusNEQ applies the NEQ predicate on the "to_status" field.
+func ToStatusNEQ(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldToStatus), v))
+	})
+}
+
+// ToStatusIn applies the In predicate on the "to_status" field.
+func ToStatusIn(vs ...string) predicate.IssuanceRequestEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldToStatus), v...))
+	})
+}
+
+// ToStatusNotIn applies the NotIn predicate on the "to_status" field.
+func ToStatusNotIn(vs ...string) predicate.IssuanceRequestEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldToStatus), v...))
+	})
+}
+
+// ToStatusGT applies the GT predicate on the "to_status" field.
+func ToStatusGT(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldToStatus), v))
+	})
+}
+
+// ToStatusGTE applies the GTE predicate on the "to_status" field.
+func ToStatusGTE(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldToStatus), v))
+	})
+}
+
+// ToStatusLT applies the LT predicate on the "to_status" field.
+func ToStatusLT(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldToStatus), v))
+	})
+}
+
+// ToStatusLTE applies the LTE predicate on the "to_status" field.
+func ToStatusLTE(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldToStatus), v))
+	})
+}
+
+// ToStatusContains applies the Contains predicate on the "to_status" field.
+func ToStatusContains(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldToStatus), v))
+	})
+}
+
+// ToStatusHasPrefix applies the HasPrefix predicate on the "to_status" field.
+func ToStatusHasPrefix(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldToStatus), v))
+	})
+}
+
+// ToStatusHasSuffix applies the HasSuffix predicate on the "to_status" field.
+func ToStatusHasSuffix(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldToStatus), v))
+	})
+}
+
+// ToStatusEqualFold applies the EqualFold predicate on the "to_status" field.
+func ToStatusEqualFold(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldToStatus), v))
+	})
+}
+
+// ToStatusContainsFold applies the ContainsFold predicate on the "to_status" field.
+func ToStatusContainsFold(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldToStatus), v))
+	})
+}
+
+// ActorEQ applies the EQ predicate on the "actor" field.
+func ActorEQ(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldActor), v))
+	})
+}
+
+// ActorNEQ applies the NEQ predicate on the "actor" field.
+func ActorNEQ(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldActor), v))
+	})
+}
+
+// ActorIn applies the In predicate on the "actor" field.
+func ActorIn(vs ...string) predicate.IssuanceRequestEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldActor), v...))
+	})
+}
+
+// ActorNotIn applies the NotIn predicate on the "actor" field.
+func ActorNotIn(vs ...string) predicate.IssuanceRequestEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldActor), v...))
+	})
+}
+
+// ActorGT applies the GT predicate on the "actor" field.
+func ActorGT(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldActor), v))
+	})
+}
+
+// ActorGTE applies the GTE predicate on the "actor" field.
+func ActorGTE(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldActor), v))
+	})
+}
+
+// ActorLT applies the LT predicate on the "actor" field.
+func ActorLT(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldActor), v))
+	})
+}
+
+// ActorLTE applies the LTE predicate on the "actor" field.
+func ActorLTE(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldActor), v))
+	})
+}
+
+// ActorContains applies the Contains predicate on the "actor" field.
+func ActorContains(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldActor), v))
+	})
+}
+
+// ActorHasPrefix applies the HasPrefix predicate on the "actor" field.
+func ActorHasPrefix(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldActor), v))
+	})
+}
+
+// ActorHasSuffix applies the HasSuffix predicate on the "actor" field.
+func ActorHasSuffix(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldActor), v))
+	})
+}
+
+// ActorEqualFold applies the EqualFold predicate on the "actor" field.
+func ActorEqualFold(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldActor), v))
+	})
+}
+
+// ActorContainsFold applies the ContainsFold predicate on the "actor" field.
+func ActorContainsFold(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldActor), v))
+	})
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReason), v))
+	})
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldReason), v))
+	})
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.IssuanceRequestEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldReason), v...))
+	})
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.IssuanceRequestEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldReason), v...))
+	})
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldReason), v))
+	})
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldReason), v))
+	})
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldReason), v))
+	})
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldReason), v))
+	})
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldReason), v))
+	})
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldReason), v))
+	})
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldReason), v))
+	})
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldReason)))
+	})
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldReason)))
+	})
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldReason), v))
+	})
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldReason), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.IssuanceRequestEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.IssuanceRequestEvent {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// HasRequest applies the HasEdge predicate on the "request" edge.
+func HasRequest() predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(RequestTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RequestTable, RequestColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRequestWith applies the HasEdge predicate on the "request" edge with a given conditions (other predicates).
+func HasRequestWith(preds ...predicate.IssuanceRequest) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(RequestInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RequestTable, RequestColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.IssuanceRequestEvent) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.IssuanceRequestEvent) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.IssuanceRequestEvent) predicate.IssuanceRequestEvent {
+	return predicate.IssuanceRequestEvent(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
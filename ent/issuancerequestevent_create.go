@@ -0,0 +1,363 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequest"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequestevent"
+)
+
+// IssuanceRequestEventCreate is the builder for creating a IssuanceRequestEvent entity.
+type IssuanceRequestEventCreate struct {
+	config
+	mutation *IssuanceRequestEventMutation
+	hooks    []Hook
+}
+
+// SetFromStatus sets the "from_status" field.
+func (irec *IssuanceRequestEventCreate) SetFromStatus(s string) *IssuanceRequestEventCreate {
+	irec.mutation.SetFromStatus(s)
+	return irec
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (irec *IssuanceRequestEventCreate) SetNillableFromStatus(s *string) *IssuanceRequestEventCreate {
+	if s != nil {
+		irec.SetFromStatus(*s)
+	}
+	return irec
+}
+
+// SetToStatus sets the "to_status" field.
+func (irec *IssuanceRequestEventCreate) SetToStatus(s string) *IssuanceRequestEventCreate {
+	irec.mutation.SetToStatus(s)
+	return irec
+}
+
+// SetActor sets the "actor" field.
+func (irec *IssuanceRequestEventCreate) SetActor(s string) *IssuanceRequestEventCreate {
+	irec.mutation.SetActor(s)
+	return irec
+}
+
+// SetReason sets the "reason" field.
+func (irec *IssuanceRequestEventCreate) SetReason(s string) *IssuanceRequestEventCreate {
+	irec.mutation.SetReason(s)
+	return irec
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (irec *IssuanceRequestEventCreate) SetNillableReason(s *string) *IssuanceRequestEventCreate {
+	if s != nil {
+		irec.SetReason(*s)
+	}
+	return irec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (irec *IssuanceRequestEventCreate) SetCreatedAt(t time.Time) *IssuanceRequestEventCreate {
+	irec.mutation.SetCreatedAt(t)
+	return irec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (irec *IssuanceRequestEventCreate) SetNillableCreatedAt(t *time.Time) *IssuanceRequestEventCreate {
+	if t != nil {
+		irec.SetCreatedAt(*t)
+	}
+	return irec
+}
+
+// SetRequestID sets the "request" edge to the IssuanceRequest entity by ID.
+func (irec *IssuanceRequestEventCreate) SetRequestID(id string) *IssuanceRequestEventCreate {
+	irec.mutation.SetRequestID(id)
+	return irec
+}
+
+// SetNillableRequestID sets the "request" edge to the IssuanceRequest entity by ID if the given value is not nil.
+func (irec *IssuanceRequestEventCreate) SetNillableRequestID(id *string) *IssuanceRequestEventCreate {
+	if id != nil {
+		irec = irec.SetRequestID(*id)
+	}
+	return irec
+}
+
+// SetRequest sets the "request" edge to the IssuanceRequest entity.
+func (irec *IssuanceRequestEventCreate) SetRequest(i *IssuanceRequest) *IssuanceRequestEventCreate {
+	return irec.SetRequestID(i.ID)
+}
+
+// Mutation returns the IssuanceRequestEventMutation object of the builder.
+func (irec *IssuanceRequestEventCreate) Mutation() *IssuanceRequestEventMutation {
+	return irec.mutation
+}
+
+// Save creates the IssuanceRequestEvent in the database.
+func (irec *IssuanceRequestEventCreate) Save(ctx context.Context) (*IssuanceRequestEvent, error) {
+	var (
+		err  error
+		node *IssuanceRequestEvent
+	)
+	irec.defaults()
+	if len(irec.hooks) == 0 {
+		if err = irec.check(); err != nil {
+			return nil, err
+		}
+		node, err = irec.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*IssuanceRequestEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = irec.check(); err != nil {
+				return nil, err
+			}
+			irec.mutation = mutation
+			if node, err = irec.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(irec.hooks) - 1; i >= 0; i-- {
+			if irec.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = irec.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, irec.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*IssuanceRequestEvent)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from IssuanceRequestEventMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (irec *IssuanceRequestEventCreate) SaveX(ctx context.Context) *IssuanceRequestEvent {
+	v, err := irec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (irec *IssuanceRequestEventCreate) Exec(ctx context.Context) error {
+	_, err := irec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (irec *IssuanceRequestEventCreate) ExecX(ctx context.Context) {
+	if err := irec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (irec *IssuanceRequestEventCreate) defaults() {
+	if _, ok := irec.mutation.CreatedAt(); !ok {
+		v := issuancerequestevent.DefaultCreatedAt()
+		irec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (irec *IssuanceRequestEventCreate) check() error {
+	if _, ok := irec.mutation.ToStatus(); !ok {
+		return &ValidationError{Name: "to_status", err: errors.New(`ent: missing required field "IssuanceRequestEvent.to_status"`)}
+	}
+	if _, ok := irec.mutation.Actor(); !ok {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required field "IssuanceRequestEvent.actor"`)}
+	}
+	if _, ok := irec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "IssuanceRequestEvent.created_at"`)}
+	}
+	return nil
+}
+
+func (irec *IssuanceRequestEventCreate) sqlSave(ctx context.Context) (*IssuanceRequestEvent, error) {
+	_node, _spec := irec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, irec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (irec *IssuanceRequestEventCreate) createSpec() (*IssuanceRequestEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &IssuanceRequestEvent{config: irec.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: issuancerequestevent.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: issuancerequestevent.FieldID,
+			},
+		}
+	)
+	if value, ok := irec.mutation.FromStatus(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: issuancerequestevent.FieldFromStatus,
+		})
+		_node.FromStatus = value
+	}
+	if value, ok := irec.mutation.ToStatus(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: issuancerequestevent.FieldToStatus,
+		})
+		_node.ToStatus = value
+	}
+	if value, ok := irec.mutation.Actor(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: issuancerequestevent.FieldActor,
+		})
+		_node.Actor = value
+	}
+	if value, ok := irec.mutation.Reason(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: issuancerequestevent.FieldReason,
+		})
+		_node.Reason = value
+	}
+	if value, ok := irec.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: issuancerequestevent.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := irec.mutation.RequestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   issuancerequestevent.RequestTable,
+			Columns: []string{issuancerequestevent.RequestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: issuancerequest.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.issuance_request_events = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// IssuanceRequestEventCreateBulk is the builder for creating many IssuanceRequestEvent entities in bulk.
+type IssuanceRequestEventCreateBulk struct {
+	config
+	builders []*IssuanceRequestEventCreate
+}
+
+// Save creates the IssuanceRequestEvent entities in the database.
+func (irecb *IssuanceRequestEventCreateBulk) Save(ctx context.Context) ([]*IssuanceRequestEvent, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(irecb.builders))
+	nodes := make([]*IssuanceRequestEvent, len(irecb.builders))
+	mutators := make([]Mutator, len(irecb.builders))
+	for i := range irecb.builders {
+		func(i int, root context.Context) {
+			builder := irecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IssuanceRequestEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, irecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, irecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, irecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (irecb *IssuanceRequestEventCreateBulk) SaveX(ctx context.Context) []*IssuanceRequestEvent {
+	v, err := irecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (irecb *IssuanceRequestEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := irecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (irecb *IssuanceRequestEventCreateBulk) ExecX(ctx context.Context) {
+	if err := irecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
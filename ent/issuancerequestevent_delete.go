@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequestevent"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+)
+
+// IssuanceRequestEventDelete is the builder for deleting a IssuanceRequestEvent entity.
+type IssuanceRequestEventDelete struct {
+	config
+	hooks    []Hook
+	mutation *IssuanceRequestEventMutation
+}
+
+// Where appends a list predicates to the IssuanceRequestEventDelete builder.
+func (ired *IssuanceRequestEventDelete) Where(ps ...predicate.IssuanceRequestEvent) *IssuanceRequestEventDelete {
+	ired.mutation.Where(ps...)
+	return ired
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ired *IssuanceRequestEventDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ired.hooks) == 0 {
+		affected, err = ired.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*IssuanceRequestEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ired.mutation = mutation
+			affected, err = ired.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ired.hooks) - 1; i >= 0; i-- {
+			if ired.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ired.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ired.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ired *IssuanceRequestEventDelete) ExecX(ctx context.Context) int {
+	n, err := ired.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ired *IssuanceRequestEventDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: issuancerequestevent.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: issuancerequestevent.FieldID,
+			},
+		},
+	}
+	if ps := ired.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ired.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// IssuanceRequestEventDeleteOne is the builder for deleting a single IssuanceRequestEvent entity.
+type IssuanceRequestEventDeleteOne struct {
+	ired *IssuanceRequestEventDelete
+}
+
+// Exec executes the deletion query.
+func (iredo *IssuanceRequestEventDeleteOne) Exec(ctx context.Context) error {
+	n, err := iredo.ired.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{issuancerequestevent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iredo *IssuanceRequestEventDeleteOne) ExecX(ctx context.Context) {
+	iredo.ired.ExecX(ctx)
+}
@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequest"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequestevent"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+)
+
+// IssuanceRequestEventQuery is the builder for querying IssuanceRequestEvent entities.
+type IssuanceRequestEventQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.IssuanceRequestEvent
+	// eager-loading edges.
+	withRequest *IssuanceRequestQuery
+	withFKs     bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the IssuanceRequestEventQuery builder.
+func (ireq *IssuanceRequestEventQuery) Where(ps ...predicate.IssuanceRequestEvent) *IssuanceRequestEventQuery {
+	ireq.predicates = append(ireq.predicates, ps...)
+	return ireq
+}
+
+// Limit adds a limit step to the query.
+func (ireq *IssuanceRequestEventQuery) Limit(limit int) *IssuanceRequestEventQuery {
+	ireq.limit = &limit
+	return ireq
+}
+
+// Offset adds an offset step to the query.
+func (ireq *IssuanceRequestEventQuery) Offset(offset int) *IssuanceRequestEventQuery {
+	ireq.offset = &offset
+	return ireq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ireq *IssuanceRequestEventQuery) Unique(unique bool) *IssuanceRequestEventQuery {
+	ireq.unique = &unique
+	return ireq
+}
+
+// Order adds an order step to the query.
+func (ireq *IssuanceRequestEventQuery) Order(o ...OrderFunc) *IssuanceRequestEventQuery {
+	ireq.order = append(ireq.order, o...)
+	return ireq
+}
+
+// QueryRequest chains the current query on the "request" edge.
+func (ireq *IssuanceRequestEventQuery) QueryRequest() *IssuanceRequestQuery {
+	query := &IssuanceRequestQuery{config: ireq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ireq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ireq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(issuancerequestevent.Table, issuancerequestevent.FieldID, selector),
+			sqlgraph.To(issuancerequest.Table, issuancerequest.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, issuancerequestevent.RequestTable, issuancerequestevent.RequestColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ireq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first IssuanceRequestEvent entity from the query.
+// Returns a *NotFoundError when no IssuanceRequestEvent was found.
+func (ireq *IssuanceRequestEventQuery) First(ctx context.Context) (*IssuanceRequestEvent, error) {
+	nodes, err := ireq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{issuancerequestevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ireq *IssuanceRequestEventQuery) FirstX(ctx context.Context) *IssuanceRequestEvent {
+	node, err := ireq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first IssuanceRequestEvent ID from the query.
+// Returns a *NotFoundError when no IssuanceRequestEvent ID was found.
+func (ireq *IssuanceRequestEventQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ireq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{issuancerequestevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ireq *IssuanceRequestEventQuery) FirstIDX(ctx context.Context) int {
+	id, err := ireq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single IssuanceRequestEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one IssuanceRequestEvent entity is found.
+// Returns a *NotFoundError when no IssuanceRequestEvent entities are found.
+func (ireq *IssuanceRequestEventQuery) Only(ctx context.Context) (*IssuanceRequestEvent, error) {
+	nodes, err := ireq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{issuancerequestevent.Label}
+	default:
+		return nil, &NotSingularError{issuancerequestevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ireq *IssuanceRequestEventQuery) OnlyX(ctx context.Context) *IssuanceRequestEvent {
+	node, err := ireq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only IssuanceRequestEvent ID in the query.
+// Returns a *NotSingularError when more than one IssuanceRequestEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ireq *IssuanceRequestEventQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ireq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{issuancerequestevent.Label}
+	default:
+		err = &NotSingularError{issuancerequestevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ireq *IssuanceRequestEventQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ireq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of IssuanceRequestEvents.
+func (ireq *IssuanceRequestEventQuery) All(ctx context.Context) ([]*IssuanceRequestEvent, error) {
+	if err := ireq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return ireq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ireq *IssuanceRequestEventQuery) AllX(ctx context.Context) []*IssuanceRequestEvent {
+	nodes, err := ireq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of IssuanceRequestEvent IDs.
+func (ireq *IssuanceRequestEventQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := ireq.Select(issuancerequestevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ireq *IssuanceRequestEventQuery) IDsX(ctx context.Context) []int {
+	ids, err := ireq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ireq *IssuanceRequestEventQuery) Count(ctx context.Context) (int, error) {
+	if err := ireq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return ireq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ireq *IssuanceRequestEventQuery) CountX(ctx context.Context) int {
+	count, err := ireq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ireq *IssuanceRequestEventQuery) Exist(ctx context.Context) (bool, error) {
+	if err := ireq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return ireq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ireq *IssuanceRequestEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := ireq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the IssuanceRequestEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ireq *IssuanceRequestEventQuery) Clone() *IssuanceRequestEventQuery {
+	if ireq == nil {
+		return nil
+	}
+	return &IssuanceRequestEventQuery{
+		config:      ireq.config,
+		limit:       ireq.limit,
+		offset:      ireq.offset,
+		order:       append([]OrderFunc{}, ireq.order...),
+		predicates:  append([]predicate.IssuanceRequestEvent{}, ireq.predicates...),
+		withRequest: ireq.withRequest.Clone(),
+		// clone intermediate query.
+		sql:    ireq.sql.Clone(),
+		path:   ireq.path,
+		unique: ireq.unique,
+	}
+}
+
+// WithRequest tells the query-builder to eager-load the nodes that are connected to
+// the "request" edge. The optional arguments are used to configure the query builder of the edge.
+func (ireq *IssuanceRequestEventQuery) WithRequest(opts ...func(*IssuanceRequestQuery)) *IssuanceRequestEventQuery {
+	query := &IssuanceRequestQuery{config: ireq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	ireq.withRequest = query
+	return ireq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		FromStatus string `json:"from_status,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.IssuanceRequestEvent.Query().
+//		GroupBy(issuancerequestevent.FieldFromStatus).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ireq *IssuanceRequestEventQuery) GroupBy(field string, fields ...string) *IssuanceRequestEventGroupBy {
+	grbuild := &IssuanceRequestEventGroupBy{config: ireq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ireq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ireq.sqlQuery(ctx), nil
+	}
+	grbuild.label = issuancerequestevent.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		FromStatus string `json:"from_status,omitempty"`
+//	}
+//
+//	client.IssuanceRequestEvent.Query().
+//		Select(issuancerequestevent.FieldFromStatus).
+//		Scan(ctx, &v)
+func (ireq *IssuanceRequestEventQuery) Select(fields ...string) *IssuanceRequestEventSelect {
+	ireq.fields = append(ireq.fields, fields...)
+	selbuild := &IssuanceRequestEventSelect{IssuanceRequestEventQuery: ireq}
+	selbuild.label = issuancerequestevent.Label
+	selbuild.flds, selbuild.scan = &ireq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (ireq *IssuanceRequestEventQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range ireq.fields {
+		if !issuancerequestevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ireq.path != nil {
+		prev, err := ireq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ireq.sql = prev
+	}
+	return nil
+}
+
+func (ireq *IssuanceRequestEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*IssuanceRequestEvent, error) {
+	var (
+		nodes       = []*IssuanceRequestEvent{}
+		withFKs     = ireq.withFKs
+		_spec       = ireq.querySpec()
+		loadedTypes = [1]bool{
+			ireq.withRequest != nil,
+		}
+	)
+	if ireq.withRequest != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, issuancerequestevent.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*IssuanceRequestEvent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &IssuanceRequestEvent{config: ireq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ireq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := ireq.withRequest; query != nil {
+		ids := make([]string, 0, len(nodes))
+		nodeids := make(map[string][]*IssuanceRequestEvent)
+		for i := range nodes {
+			if nodes[i].issuance_request_events == nil {
+				continue
+			}
+			fk := *nodes[i].issuance_request_events
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(issuancerequest.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "issuance_request_events" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Request = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (ireq *IssuanceRequestEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ireq.querySpec()
+	_spec.Node.Columns = ireq.fields
+	if len(ireq.fields) > 0 {
+		_spec.Unique = ireq.unique != nil && *ireq.unique
+	}
+	return sqlgraph.CountNodes(ctx, ireq.driver, _spec)
+}
+
+func (ireq *IssuanceRequestEventQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := ireq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (ireq *IssuanceRequestEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   issuancerequestevent.Table,
+			Columns: issuancerequestevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: issuancerequestevent.FieldID,
+			},
+		},
+		From:   ireq.sql,
+		Unique: true,
+	}
+	if unique := ireq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := ireq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, issuancerequestevent.FieldID)
+		for i := range fields {
+			if fields[i] != issuancerequestevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ireq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ireq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ireq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ireq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ireq *IssuanceRequestEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ireq.driver.Dialect())
+	t1 := builder.Table(issuancerequestevent.Table)
+	columns := ireq.fields
+	if len(columns) == 0 {
+		columns = issuancerequestevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ireq.sql != nil {
+		selector = ireq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ireq.unique != nil && *ireq.unique {
+		selector.Distinct()
+	}
+	for _, p := range ireq.predicates {
+		p(selector)
+	}
+	for _, p := range ireq.order {
+		p(selector)
+	}
+	if offset := ireq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ireq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// IssuanceRequestEventGroupBy is the group-by builder for IssuanceRequestEvent entities.
+type IssuanceRequestEventGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (iregb *IssuanceRequestEventGroupBy) Aggregate(fns ...AggregateFunc) *IssuanceRequestEventGroupBy {
+	iregb.fns = append(iregb.fns, fns...)
+	return iregb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (iregb *IssuanceRequestEventGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := iregb.path(ctx)
+	if err != nil {
+		return err
+	}
+	iregb.sql = query
+	return iregb.sqlScan(ctx, v)
+}
+
+func (iregb *IssuanceRequestEventGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range iregb.fields {
+		if !issuancerequestevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := iregb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := iregb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (iregb *IssuanceRequestEventGroupBy) sqlQuery() *sql.Selector {
+	selector := iregb.sql.Select()
+	aggregation := make([]string, 0, len(iregb.fns))
+	for _, fn := range iregb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(iregb.fields)+len(iregb.fns))
+		for _, f := range iregb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(iregb.fields...)...)
+}
+
+// IssuanceRequestEventSelect is the builder for selecting fields of IssuanceRequestEvent entities.
+type IssuanceRequestEventSelect struct {
+	*IssuanceRequestEventQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ires *IssuanceRequestEventSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ires.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ires.sql = ires.IssuanceRequestEventQuery.sqlQuery(ctx)
+	return ires.sqlScan(ctx, v)
+}
+
+func (ires *IssuanceRequestEventSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ires.sql.Query()
+	if err := ires.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
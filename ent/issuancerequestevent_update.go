@@ -0,0 +1,390 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequest"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequestevent"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+)
+
+// IssuanceRequestEventUpdate is the builder for updating IssuanceRequestEvent entities.
+type IssuanceRequestEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *IssuanceRequestEventMutation
+}
+
+// Where appends a list predicates to the IssuanceRequestEventUpdate builder.
+func (ireu *IssuanceRequestEventUpdate) Where(ps ...predicate.IssuanceRequestEvent) *IssuanceRequestEventUpdate {
+	ireu.mutation.Where(ps...)
+	return ireu
+}
+
+// SetRequestID sets the "request" edge to the IssuanceRequest entity by ID.
+func (ireu *IssuanceRequestEventUpdate) SetRequestID(id string) *IssuanceRequestEventUpdate {
+	ireu.mutation.SetRequestID(id)
+	return ireu
+}
+
+// SetNillableRequestID sets the "request" edge to the IssuanceRequest entity by ID if the given value is not nil.
+func (ireu *IssuanceRequestEventUpdate) SetNillableRequestID(id *string) *IssuanceRequestEventUpdate {
+	if id != nil {
+		ireu = ireu.SetRequestID(*id)
+	}
+	return ireu
+}
+
+// SetRequest sets the "request" edge to the IssuanceRequest entity.
+func (ireu *IssuanceRequestEventUpdate) SetRequest(i *IssuanceRequest) *IssuanceRequestEventUpdate {
+	return ireu.SetRequestID(i.ID)
+}
+
+// Mutation returns the IssuanceRequestEventMutation object of the builder.
+func (ireu *IssuanceRequestEventUpdate) Mutation() *IssuanceRequestEventMutation {
+	return ireu.mutation
+}
+
+// ClearRequest clears the "request" edge to the IssuanceRequest entity.
+func (ireu *IssuanceRequestEventUpdate) ClearRequest() *IssuanceRequestEventUpdate {
+	ireu.mutation.ClearRequest()
+	return ireu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ireu *IssuanceRequestEventUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ireu.hooks) == 0 {
+		affected, err = ireu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*IssuanceRequestEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ireu.mutation = mutation
+			affected, err = ireu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ireu.hooks) - 1; i >= 0; i-- {
+			if ireu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ireu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ireu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ireu *IssuanceRequestEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := ireu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ireu *IssuanceRequestEventUpdate) Exec(ctx context.Context) error {
+	_, err := ireu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ireu *IssuanceRequestEventUpdate) ExecX(ctx context.Context) {
+	if err := ireu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ireu *IssuanceRequestEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   issuancerequestevent.Table,
+			Columns: issuancerequestevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: issuancerequestevent.FieldID,
+			},
+		},
+	}
+	if ps := ireu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ireu.mutation.FromStatusCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: issuancerequestevent.FieldFromStatus,
+		})
+	}
+	if ireu.mutation.ReasonCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: issuancerequestevent.FieldReason,
+		})
+	}
+	if ireu.mutation.RequestCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   issuancerequestevent.RequestTable,
+			Columns: []string{issuancerequestevent.RequestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: issuancerequest.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ireu.mutation.RequestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   issuancerequestevent.RequestTable,
+			Columns: []string{issuancerequestevent.RequestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: issuancerequest.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ireu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{issuancerequestevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// IssuanceRequestEventUpdateOne is the builder for updating a single IssuanceRequestEvent entity.
+type IssuanceRequestEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *IssuanceRequestEventMutation
+}
+
+// SetRequestID sets the "request" edge to the IssuanceRequest entity by ID.
+func (ireuo *IssuanceRequestEventUpdateOne) SetRequestID(id string) *IssuanceRequestEventUpdateOne {
+	ireuo.mutation.SetRequestID(id)
+	return ireuo
+}
+
+// SetNillableRequestID sets the "request" edge to the IssuanceRequest entity by ID if the given value is not nil.
+func (ireuo *IssuanceRequestEventUpdateOne) SetNillableRequestID(id *string) *IssuanceRequestEventUpdateOne {
+	if id != nil {
+		ireuo = ireuo.SetRequestID(*id)
+	}
+	return ireuo
+}
+
+// SetRequest sets the "request" edge to the IssuanceRequest entity.
+func (ireuo *IssuanceRequestEventUpdateOne) SetRequest(i *IssuanceRequest) *IssuanceRequestEventUpdateOne {
+	return ireuo.SetRequestID(i.ID)
+}
+
+// Mutation returns the IssuanceRequestEventMutation object of the builder.
+func (ireuo *IssuanceRequestEventUpdateOne) Mutation() *IssuanceRequestEventMutation {
+	return ireuo.mutation
+}
+
+// ClearRequest clears the "request" edge to the IssuanceRequest entity.
+func (ireuo *IssuanceRequestEventUpdateOne) ClearRequest() *IssuanceRequestEventUpdateOne {
+	ireuo.mutation.ClearRequest()
+	return ireuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ireuo *IssuanceRequestEventUpdateOne) Select(field string, fields ...string) *IssuanceRequestEventUpdateOne {
+	ireuo.fields = append([]string{field}, fields...)
+	return ireuo
+}
+
+// Save executes the query and returns the updated IssuanceRequestEvent entity.
+func (ireuo *IssuanceRequestEventUpdateOne) Save(ctx context.Context) (*IssuanceRequestEvent, error) {
+	var (
+		err  error
+		node *IssuanceRequestEvent
+	)
+	if len(ireuo.hooks) == 0 {
+		node, err = ireuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*IssuanceRequestEventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ireuo.mutation = mutation
+			node, err = ireuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ireuo.hooks) - 1; i >= 0; i-- {
+			if ireuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ireuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ireuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*IssuanceRequestEvent)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from IssuanceRequestEventMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ireuo *IssuanceRequestEventUpdateOne) SaveX(ctx context.Context) *IssuanceRequestEvent {
+	node, err := ireuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ireuo *IssuanceRequestEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := ireuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ireuo *IssuanceRequestEventUpdateOne) ExecX(ctx context.Context) {
+	if err := ireuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ireuo *IssuanceRequestEventUpdateOne) sqlSave(ctx context.Context) (_node *IssuanceRequestEvent, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   issuancerequestevent.Table,
+			Columns: issuancerequestevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: issuancerequestevent.FieldID,
+			},
+		},
+	}
+	id, ok := ireuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "IssuanceRequestEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ireuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, issuancerequestevent.FieldID)
+		for _, f := range fields {
+			if !issuancerequestevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != issuancerequestevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ireuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ireuo.mutation.FromStatusCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: issuancerequestevent.FieldFromStatus,
+		})
+	}
+	if ireuo.mutation.ReasonCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: issuancerequestevent.FieldReason,
+		})
+	}
+	if ireuo.mutation.RequestCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   issuancerequestevent.RequestTable,
+			Columns: []string{issuancerequestevent.RequestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: issuancerequest.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ireuo.mutation.RequestIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   issuancerequestevent.RequestTable,
+			Columns: []string{issuancerequestevent.RequestColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: issuancerequest.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &IssuanceRequestEvent{config: ireuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ireuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{issuancerequestevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,161 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/hesusruiz/vcbackend/ent/loginsession"
+)
+
+// LoginSession is the model entity for the LoginSession schema.
+type LoginSession struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID string `json:"client_id,omitempty"`
+	// ReturnURL holds the value of the "return_url" field.
+	ReturnURL string `json:"return_url,omitempty"`
+	// Credential holds the value of the "credential" field.
+	Credential *string `json:"credential,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LoginSession) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case loginsession.FieldID, loginsession.FieldClientID, loginsession.FieldReturnURL, loginsession.FieldCredential:
+			values[i] = new(sql.NullString)
+		case loginsession.FieldCreatedAt, loginsession.FieldExpiresAt, loginsession.FieldCompletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type LoginSession", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LoginSession fields.
+func (ls *LoginSession) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case loginsession.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ls.ID = value.String
+			}
+		case loginsession.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				ls.ClientID = value.String
+			}
+		case loginsession.FieldReturnURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field return_url", values[i])
+			} else if value.Valid {
+				ls.ReturnURL = value.String
+			}
+		case loginsession.FieldCredential:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field credential", values[i])
+			} else if value.Valid {
+				ls.Credential = new(string)
+				*ls.Credential = value.String
+			}
+		case loginsession.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ls.CreatedAt = value.Time
+			}
+		case loginsession.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				ls.ExpiresAt = value.Time
+			}
+		case loginsession.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				ls.CompletedAt = new(time.Time)
+				*ls.CompletedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this LoginSession.
+// Note that you need to call LoginSession.Unwrap() before calling this method if this LoginSession
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ls *LoginSession) Update() *LoginSessionUpdateOne {
+	return (&LoginSessionClient{config: ls.config}).UpdateOne(ls)
+}
+
+// Unwrap unwraps the LoginSession entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ls *LoginSession) Unwrap() *LoginSession {
+	_tx, ok := ls.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LoginSession is not a transactional entity")
+	}
+	ls.config.driver = _tx.drv
+	return ls
+}
+
+// String implements the fmt.Stringer.
+func (ls *LoginSession) String() string {
+	var builder strings.Builder
+	builder.WriteString("LoginSession(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ls.ID))
+	builder.WriteString("client_id=")
+	builder.WriteString(ls.ClientID)
+	builder.WriteString(", ")
+	builder.WriteString("return_url=")
+	builder.WriteString(ls.ReturnURL)
+	builder.WriteString(", ")
+	if v := ls.Credential; v != nil {
+		builder.WriteString("credential=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ls.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(ls.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ls.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LoginSessions is a parsable slice of LoginSession.
+type LoginSessions []*LoginSession
+
+func (ls LoginSessions) config(cfg config) {
+	for _i := range ls {
+		ls[_i].config = cfg
+	}
+}
@@ -0,0 +1,54 @@
+// Code generated by ent, DO NOT EDIT.
+
+package loginsession
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the loginsession type in the database.
+	Label = "login_session"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldClientID holds the string denoting the client_id field in the database.
+	FieldClientID = "client_id"
+	// FieldReturnURL holds the string denoting the return_url field in the database.
+	FieldReturnURL = "return_url"
+	// FieldCredential holds the string denoting the credential field in the database.
+	FieldCredential = "credential"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldCompletedAt holds the string denoting the completed_at field in the database.
+	FieldCompletedAt = "completed_at"
+	// Table holds the table name of the loginsession in the database.
+	Table = "login_sessions"
+)
+
+// Columns holds all SQL columns for loginsession fields.
+var Columns = []string{
+	FieldID,
+	FieldClientID,
+	FieldReturnURL,
+	FieldCredential,
+	FieldCreatedAt,
+	FieldExpiresAt,
+	FieldCompletedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
@@ -0,0 +1,772 @@
+// Code generated by ent, DO NOT EDIT.
+
+package loginsession
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldClientID), v))
+	})
+}
+
+// ReturnURL applies equality check predicate on the "return_url" field. It's identical to ReturnURLEQ.
+func ReturnURL(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReturnURL), v))
+	})
+}
+
+// Credential applies equality check predicate on the "credential" field. It's identical to CredentialEQ.
+func Credential(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCredential), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCompletedAt), v))
+	})
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldClientID), v))
+	})
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldClientID), v))
+	})
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...string) predicate.LoginSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LoginSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldClientID), v...))
+	})
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...string) predicate.LoginSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LoginSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldClientID), v...))
+	})
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldClientID), v))
+	})
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldClientID), v))
+	})
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldClientID), v))
+	})
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldClientID), v))
+	})
+}
+
+// ClientIDContains applies the Contains predicate on the "client_id" field.
+func ClientIDContains(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldClientID), v))
+	})
+}
+
+// ClientIDHasPrefix applies the HasPrefix predicate on the "client_id" field.
+func ClientIDHasPrefix(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldClientID), v))
+	})
+}
+
+// ClientIDHasSuffix applies the HasSuffix predicate on the "client_id" field.
+func ClientIDHasSuffix(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldClientID), v))
+	})
+}
+
+// ClientIDIsNil applies the IsNil predicate on the "client_id" field.
+func ClientIDIsNil() predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldClientID)))
+	})
+}
+
+// ClientIDNotNil applies the NotNil predicate on the "client_id" field.
+func ClientIDNotNil() predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldClientID)))
+	})
+}
+
+// ClientIDEqualFold applies the EqualFold predicate on the "client_id" field.
+func ClientIDEqualFold(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldClientID), v))
+	})
+}
+
+// ClientIDContainsFold applies the ContainsFold predicate on the "client_id" field.
+func ClientIDContainsFold(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldClientID), v))
+	})
+}
+
+// ReturnURLEQ applies the EQ predicate on the "return_url" field.
+func ReturnURLEQ(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReturnURL), v))
+	})
+}
+
+// ReturnURLNEQ applies the NEQ predicate on the "return_url" field.
+func ReturnURLNEQ(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldReturnURL), v))
+	})
+}
+
+// ReturnURLIn applies the In predicate on the "return_url" field.
+func ReturnURLIn(vs ...string) predicate.LoginSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LoginSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldReturnURL), v...))
+	})
+}
+
+// ReturnURLNotIn applies the NotIn predicate on the "return_url" field.
+func ReturnURLNotIn(vs ...string) predicate.LoginSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LoginSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldReturnURL), v...))
+	})
+}
+
+// ReturnURLGT applies the GT predicate on the "return_url" field.
+func ReturnURLGT(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldReturnURL), v))
+	})
+}
+
+// ReturnURLGTE applies the GTE predicate on the "return_url" field.
+func ReturnURLGTE(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldReturnURL), v))
+	})
+}
+
+// ReturnURLLT applies the LT predicate on the "return_url" field.
+func ReturnURLLT(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldReturnURL), v))
+	})
+}
+
+// ReturnURLLTE applies the LTE predicate on the "return_url" field.
+func ReturnURLLTE(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldReturnURL), v))
+	})
+}
+
+// ReturnURLContains applies the Contains predicate on the "return_url" field.
+func ReturnURLContains(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldReturnURL), v))
+	})
+}
+
+// ReturnURLHasPrefix applies the HasPrefix predicate on the "return_url" field.
+func ReturnURLHasPrefix(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldReturnURL), v))
+	})
+}
+
+// ReturnURLHasSuffix applies the HasSuffix predicate on the "return_url" field.
+func ReturnURLHasSuffix(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldReturnURL), v))
+	})
+}
+
+// ReturnURLIsNil applies the IsNil predicate on the "return_url" field.
+func ReturnURLIsNil() predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldReturnURL)))
+	})
+}
+
+// ReturnURLNotNil applies the NotNil predicate on the "return_url" field.
+func ReturnURLNotNil() predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldReturnURL)))
+	})
+}
+
+// ReturnURLEqualFold applies the EqualFold predicate on the "return_url" field.
+func ReturnURLEqualFold(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldReturnURL), v))
+	})
+}
+
+// ReturnURLContainsFold applies the ContainsFold predicate on the "return_url" field.
+func ReturnURLContainsFold(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldReturnURL), v))
+	})
+}
+
+// CredentialEQ applies the EQ predicate on the "credential" field.
+func CredentialEQ(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCredential), v))
+	})
+}
+
+// CredentialNEQ applies the NEQ predicate on the "credential" field.
+func CredentialNEQ(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCredential), v))
+	})
+}
+
+// CredentialIn applies the In predicate on the "credential" field.
+func CredentialIn(vs ...string) predicate.LoginSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LoginSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCredential), v...))
+	})
+}
+
+// CredentialNotIn applies the NotIn predicate on the "credential" field.
+func CredentialNotIn(vs ...string) predicate.LoginSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LoginSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCredential), v...))
+	})
+}
+
+// CredentialGT applies the GT predicate on the "credential" field.
+func CredentialGT(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCredential), v))
+	})
+}
+
+// CredentialGTE applies the GTE predicate on the "credential" field.
+func CredentialGTE(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCredential), v))
+	})
+}
+
+// CredentialLT applies the LT predicate on the "credential" field.
+func CredentialLT(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCredential), v))
+	})
+}
+
+// CredentialLTE applies the LTE predicate on the "credential" field.
+func CredentialLTE(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCredential), v))
+	})
+}
+
+// CredentialContains applies the Contains predicate on the "credential" field.
+func CredentialContains(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCredential), v))
+	})
+}
+
+// CredentialHasPrefix applies the HasPrefix predicate on the "credential" field.
+func CredentialHasPrefix(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCredential), v))
+	})
+}
+
+// CredentialHasSuffix applies the HasSuffix predicate on the "credential" field.
+func CredentialHasSuffix(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCredential), v))
+	})
+}
+
+// CredentialIsNil applies the IsNil predicate on the "credential" field.
+func CredentialIsNil() predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCredential)))
+	})
+}
+
+// CredentialNotNil applies the NotNil predicate on the "credential" field.
+func CredentialNotNil() predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCredential)))
+	})
+}
+
+// CredentialEqualFold applies the EqualFold predicate on the "credential" field.
+func CredentialEqualFold(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCredential), v))
+	})
+}
+
+// CredentialContainsFold applies the ContainsFold predicate on the "credential" field.
+func CredentialContainsFold(v string) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCredential), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LoginSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LoginSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LoginSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LoginSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.LoginSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LoginSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExpiresAt), v...))
+	})
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.LoginSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LoginSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExpiresAt), v...))
+	})
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExpiresAt), v))
+	})
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCompletedAt), v))
+	})
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCompletedAt), v))
+	})
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.LoginSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LoginSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCompletedAt), v...))
+	})
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.LoginSession {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LoginSession(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCompletedAt), v...))
+	})
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCompletedAt), v))
+	})
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCompletedAt), v))
+	})
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCompletedAt), v))
+	})
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCompletedAt), v))
+	})
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCompletedAt)))
+	})
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCompletedAt)))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LoginSession) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LoginSession) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LoginSession) predicate.LoginSession {
+	return predicate.LoginSession(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,361 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/loginsession"
+)
+
+// LoginSessionCreate is the builder for creating a LoginSession entity.
+type LoginSessionCreate struct {
+	config
+	mutation *LoginSessionMutation
+	hooks    []Hook
+}
+
+// SetClientID sets the "client_id" field.
+func (lsc *LoginSessionCreate) SetClientID(s string) *LoginSessionCreate {
+	lsc.mutation.SetClientID(s)
+	return lsc
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (lsc *LoginSessionCreate) SetNillableClientID(s *string) *LoginSessionCreate {
+	if s != nil {
+		lsc.SetClientID(*s)
+	}
+	return lsc
+}
+
+// SetReturnURL sets the "return_url" field.
+func (lsc *LoginSessionCreate) SetReturnURL(s string) *LoginSessionCreate {
+	lsc.mutation.SetReturnURL(s)
+	return lsc
+}
+
+// SetNillableReturnURL sets the "return_url" field if the given value is not nil.
+func (lsc *LoginSessionCreate) SetNillableReturnURL(s *string) *LoginSessionCreate {
+	if s != nil {
+		lsc.SetReturnURL(*s)
+	}
+	return lsc
+}
+
+// SetCredential sets the "credential" field.
+func (lsc *LoginSessionCreate) SetCredential(s string) *LoginSessionCreate {
+	lsc.mutation.SetCredential(s)
+	return lsc
+}
+
+// SetNillableCredential sets the "credential" field if the given value is not nil.
+func (lsc *LoginSessionCreate) SetNillableCredential(s *string) *LoginSessionCreate {
+	if s != nil {
+		lsc.SetCredential(*s)
+	}
+	return lsc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lsc *LoginSessionCreate) SetCreatedAt(t time.Time) *LoginSessionCreate {
+	lsc.mutation.SetCreatedAt(t)
+	return lsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lsc *LoginSessionCreate) SetNillableCreatedAt(t *time.Time) *LoginSessionCreate {
+	if t != nil {
+		lsc.SetCreatedAt(*t)
+	}
+	return lsc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (lsc *LoginSessionCreate) SetExpiresAt(t time.Time) *LoginSessionCreate {
+	lsc.mutation.SetExpiresAt(t)
+	return lsc
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (lsc *LoginSessionCreate) SetCompletedAt(t time.Time) *LoginSessionCreate {
+	lsc.mutation.SetCompletedAt(t)
+	return lsc
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (lsc *LoginSessionCreate) SetNillableCompletedAt(t *time.Time) *LoginSessionCreate {
+	if t != nil {
+		lsc.SetCompletedAt(*t)
+	}
+	return lsc
+}
+
+// SetID sets the "id" field.
+func (lsc *LoginSessionCreate) SetID(s string) *LoginSessionCreate {
+	lsc.mutation.SetID(s)
+	return lsc
+}
+
+// Mutation returns the LoginSessionMutation object of the builder.
+func (lsc *LoginSessionCreate) Mutation() *LoginSessionMutation {
+	return lsc.mutation
+}
+
+// Save creates the LoginSession in the database.
+func (lsc *LoginSessionCreate) Save(ctx context.Context) (*LoginSession, error) {
+	var (
+		err  error
+		node *LoginSession
+	)
+	lsc.defaults()
+	if len(lsc.hooks) == 0 {
+		if err = lsc.check(); err != nil {
+			return nil, err
+		}
+		node, err = lsc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LoginSessionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = lsc.check(); err != nil {
+				return nil, err
+			}
+			lsc.mutation = mutation
+			if node, err = lsc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(lsc.hooks) - 1; i >= 0; i-- {
+			if lsc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = lsc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, lsc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*LoginSession)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from LoginSessionMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lsc *LoginSessionCreate) SaveX(ctx context.Context) *LoginSession {
+	v, err := lsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lsc *LoginSessionCreate) Exec(ctx context.Context) error {
+	_, err := lsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lsc *LoginSessionCreate) ExecX(ctx context.Context) {
+	if err := lsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lsc *LoginSessionCreate) defaults() {
+	if _, ok := lsc.mutation.CreatedAt(); !ok {
+		v := loginsession.DefaultCreatedAt()
+		lsc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lsc *LoginSessionCreate) check() error {
+	if _, ok := lsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LoginSession.created_at"`)}
+	}
+	if _, ok := lsc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "LoginSession.expires_at"`)}
+	}
+	return nil
+}
+
+func (lsc *LoginSessionCreate) sqlSave(ctx context.Context) (*LoginSession, error) {
+	_node, _spec := lsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected LoginSession.ID type: %T", _spec.ID.Value)
+		}
+	}
+	return _node, nil
+}
+
+func (lsc *LoginSessionCreate) createSpec() (*LoginSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoginSession{config: lsc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: loginsession.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: loginsession.FieldID,
+			},
+		}
+	)
+	if id, ok := lsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := lsc.mutation.ClientID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: loginsession.FieldClientID,
+		})
+		_node.ClientID = value
+	}
+	if value, ok := lsc.mutation.ReturnURL(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: loginsession.FieldReturnURL,
+		})
+		_node.ReturnURL = value
+	}
+	if value, ok := lsc.mutation.Credential(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: loginsession.FieldCredential,
+		})
+		_node.Credential = &value
+	}
+	if value, ok := lsc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: loginsession.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := lsc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: loginsession.FieldExpiresAt,
+		})
+		_node.ExpiresAt = value
+	}
+	if value, ok := lsc.mutation.CompletedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: loginsession.FieldCompletedAt,
+		})
+		_node.CompletedAt = &value
+	}
+	return _node, _spec
+}
+
+// LoginSessionCreateBulk is the builder for creating many LoginSession entities in bulk.
+type LoginSessionCreateBulk struct {
+	config
+	builders []*LoginSessionCreate
+}
+
+// Save creates the LoginSession entities in the database.
+func (lscb *LoginSessionCreateBulk) Save(ctx context.Context) ([]*LoginSession, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(lscb.builders))
+	nodes := make([]*LoginSession, len(lscb.builders))
+	mutators := make([]Mutator, len(lscb.builders))
+	for i := range lscb.builders {
+		func(i int, root context.Context) {
+			builder := lscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoginSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lscb *LoginSessionCreateBulk) SaveX(ctx context.Context) []*LoginSession {
+	v, err := lscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lscb *LoginSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := lscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lscb *LoginSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := lscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/loginsession"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+)
+
+// LoginSessionDelete is the builder for deleting a LoginSession entity.
+type LoginSessionDelete struct {
+	config
+	hooks    []Hook
+	mutation *LoginSessionMutation
+}
+
+// Where appends a list predicates to the LoginSessionDelete builder.
+func (lsd *LoginSessionDelete) Where(ps ...predicate.LoginSession) *LoginSessionDelete {
+	lsd.mutation.Where(ps...)
+	return lsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (lsd *LoginSessionDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(lsd.hooks) == 0 {
+		affected, err = lsd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LoginSessionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			lsd.mutation = mutation
+			affected, err = lsd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(lsd.hooks) - 1; i >= 0; i-- {
+			if lsd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = lsd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, lsd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lsd *LoginSessionDelete) ExecX(ctx context.Context) int {
+	n, err := lsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (lsd *LoginSessionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: loginsession.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: loginsession.FieldID,
+			},
+		},
+	}
+	if ps := lsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, lsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// LoginSessionDeleteOne is the builder for deleting a single LoginSession entity.
+type LoginSessionDeleteOne struct {
+	lsd *LoginSessionDelete
+}
+
+// Exec executes the deletion query.
+func (lsdo *LoginSessionDeleteOne) Exec(ctx context.Context) error {
+	n, err := lsdo.lsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{loginsession.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lsdo *LoginSessionDeleteOne) ExecX(ctx context.Context) {
+	lsdo.lsd.ExecX(ctx)
+}
@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/loginsession"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+)
+
+// LoginSessionQuery is the builder for querying LoginSession entities.
+type LoginSessionQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.LoginSession
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LoginSessionQuery builder.
+func (lsq *LoginSessionQuery) Where(ps ...predicate.LoginSession) *LoginSessionQuery {
+	lsq.predicates = append(lsq.predicates, ps...)
+	return lsq
+}
+
+// Limit adds a limit step to the query.
+func (lsq *LoginSessionQuery) Limit(limit int) *LoginSessionQuery {
+	lsq.limit = &limit
+	return lsq
+}
+
+// Offset adds an offset step to the query.
+func (lsq *LoginSessionQuery) Offset(offset int) *LoginSessionQuery {
+	lsq.offset = &offset
+	return lsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (lsq *LoginSessionQuery) Unique(unique bool) *LoginSessionQuery {
+	lsq.unique = &unique
+	return lsq
+}
+
+// Order adds an order step to the query.
+func (lsq *LoginSessionQuery) Order(o ...OrderFunc) *LoginSessionQuery {
+	lsq.order = append(lsq.order, o...)
+	return lsq
+}
+
+// First returns the first LoginSession entity from the query.
+// Returns a *NotFoundError when no LoginSession was found.
+func (lsq *LoginSessionQuery) First(ctx context.Context) (*LoginSession, error) {
+	nodes, err := lsq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{loginsession.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (lsq *LoginSessionQuery) FirstX(ctx context.Context) *LoginSession {
+	node, err := lsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LoginSession ID from the query.
+// Returns a *NotFoundError when no LoginSession ID was found.
+func (lsq *LoginSessionQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = lsq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{loginsession.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (lsq *LoginSessionQuery) FirstIDX(ctx context.Context) string {
+	id, err := lsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LoginSession entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LoginSession entity is found.
+// Returns a *NotFoundError when no LoginSession entities are found.
+func (lsq *LoginSessionQuery) Only(ctx context.Context) (*LoginSession, error) {
+	nodes, err := lsq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{loginsession.Label}
+	default:
+		return nil, &NotSingularError{loginsession.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (lsq *LoginSessionQuery) OnlyX(ctx context.Context) *LoginSession {
+	node, err := lsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LoginSession ID in the query.
+// Returns a *NotSingularError when more than one LoginSession ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (lsq *LoginSessionQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = lsq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{loginsession.Label}
+	default:
+		err = &NotSingularError{loginsession.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (lsq *LoginSessionQuery) OnlyIDX(ctx context.Context) string {
+	id, err := lsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LoginSessions.
+func (lsq *LoginSessionQuery) All(ctx context.Context) ([]*LoginSession, error) {
+	if err := lsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return lsq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (lsq *LoginSessionQuery) AllX(ctx context.Context) []*LoginSession {
+	nodes, err := lsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LoginSession IDs.
+func (lsq *LoginSessionQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := lsq.Select(loginsession.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (lsq *LoginSessionQuery) IDsX(ctx context.Context) []string {
+	ids, err := lsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (lsq *LoginSessionQuery) Count(ctx context.Context) (int, error) {
+	if err := lsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return lsq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (lsq *LoginSessionQuery) CountX(ctx context.Context) int {
+	count, err := lsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (lsq *LoginSessionQuery) Exist(ctx context.Context) (bool, error) {
+	if err := lsq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return lsq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (lsq *LoginSessionQuery) ExistX(ctx context.Context) bool {
+	exist, err := lsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LoginSessionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (lsq *LoginSessionQuery) Clone() *LoginSessionQuery {
+	if lsq == nil {
+		return nil
+	}
+	return &LoginSessionQuery{
+		config:     lsq.config,
+		limit:      lsq.limit,
+		offset:     lsq.offset,
+		order:      append([]OrderFunc{}, lsq.order...),
+		predicates: append([]predicate.LoginSession{}, lsq.predicates...),
+		// clone intermediate query.
+		sql:    lsq.sql.Clone(),
+		path:   lsq.path,
+		unique: lsq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ClientID string `json:"client_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LoginSession.Query().
+//		GroupBy(loginsession.FieldClientID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (lsq *LoginSessionQuery) GroupBy(field string, fields ...string) *LoginSessionGroupBy {
+	grbuild := &LoginSessionGroupBy{config: lsq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := lsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return lsq.sqlQuery(ctx), nil
+	}
+	grbuild.label = loginsession.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ClientID string `json:"client_id,omitempty"`
+//	}
+//
+//	client.LoginSession.Query().
+//		Select(loginsession.FieldClientID).
+//		Scan(ctx, &v)
+func (lsq *LoginSessionQuery) Select(fields ...string) *LoginSessionSelect {
+	lsq.fields = append(lsq.fields, fields...)
+	selbuild := &LoginSessionSelect{LoginSessionQuery: lsq}
+	selbuild.label = loginsession.Label
+	selbuild.flds, selbuild.scan = &lsq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (lsq *LoginSessionQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range lsq.fields {
+		if !loginsession.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if lsq.path != nil {
+		prev, err := lsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		lsq.sql = prev
+	}
+	return nil
+}
+
+func (lsq *LoginSessionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LoginSession, error) {
+	var (
+		nodes = []*LoginSession{}
+		_spec = lsq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*LoginSession).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &LoginSession{config: lsq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, lsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (lsq *LoginSessionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := lsq.querySpec()
+	_spec.Node.Columns = lsq.fields
+	if len(lsq.fields) > 0 {
+		_spec.Unique = lsq.unique != nil && *lsq.unique
+	}
+	return sqlgraph.CountNodes(ctx, lsq.driver, _spec)
+}
+
+func (lsq *LoginSessionQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := lsq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (lsq *LoginSessionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   loginsession.Table,
+			Columns: loginsession.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: loginsession.FieldID,
+			},
+		},
+		From:   lsq.sql,
+		Unique: true,
+	}
+	if unique := lsq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := lsq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loginsession.FieldID)
+		for i := range fields {
+			if fields[i] != loginsession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := lsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := lsq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := lsq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := lsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (lsq *LoginSessionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(lsq.driver.Dialect())
+	t1 := builder.Table(loginsession.Table)
+	columns := lsq.fields
+	if len(columns) == 0 {
+		columns = loginsession.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if lsq.sql != nil {
+		selector = lsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if lsq.unique != nil && *lsq.unique {
+		selector.Distinct()
+	}
+	for _, p := range lsq.predicates {
+		p(selector)
+	}
+	for _, p := range lsq.order {
+		p(selector)
+	}
+	if offset := lsq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := lsq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// LoginSessionGroupBy is the group-by builder for LoginSession entities.
+type LoginSessionGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (lsgb *LoginSessionGroupBy) Aggregate(fns ...AggregateFunc) *LoginSessionGroupBy {
+	lsgb.fns = append(lsgb.fns, fns...)
+	return lsgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (lsgb *LoginSessionGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := lsgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	lsgb.sql = query
+	return lsgb.sqlScan(ctx, v)
+}
+
+func (lsgb *LoginSessionGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range lsgb.fields {
+		if !loginsession.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := lsgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lsgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (lsgb *LoginSessionGroupBy) sqlQuery() *sql.Selector {
+	selector := lsgb.sql.Select()
+	aggregation := make([]string, 0, len(lsgb.fns))
+	for _, fn := range lsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(lsgb.fields)+len(lsgb.fns))
+		for _, f := range lsgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(lsgb.fields...)...)
+}
+
+// LoginSessionSelect is the builder for selecting fields of LoginSession entities.
+type LoginSessionSelect struct {
+	*LoginSessionQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lss *LoginSessionSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := lss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	lss.sql = lss.LoginSessionQuery.sqlQuery(ctx)
+	return lss.sqlScan(ctx, v)
+}
+
+func (lss *LoginSessionSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := lss.sql.Query()
+	if err := lss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,536 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/loginsession"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+)
+
+// LoginSessionUpdate is the builder for updating LoginSession entities.
+type LoginSessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LoginSessionMutation
+}
+
+// Where appends a list predicates to the LoginSessionUpdate builder.
+func (lsu *LoginSessionUpdate) Where(ps ...predicate.LoginSession) *LoginSessionUpdate {
+	lsu.mutation.Where(ps...)
+	return lsu
+}
+
+// SetClientID sets the "client_id" field.
+func (lsu *LoginSessionUpdate) SetClientID(s string) *LoginSessionUpdate {
+	lsu.mutation.SetClientID(s)
+	return lsu
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (lsu *LoginSessionUpdate) SetNillableClientID(s *string) *LoginSessionUpdate {
+	if s != nil {
+		lsu.SetClientID(*s)
+	}
+	return lsu
+}
+
+// ClearClientID clears the value of the "client_id" field.
+func (lsu *LoginSessionUpdate) ClearClientID() *LoginSessionUpdate {
+	lsu.mutation.ClearClientID()
+	return lsu
+}
+
+// SetReturnURL sets the "return_url" field.
+func (lsu *LoginSessionUpdate) SetReturnURL(s string) *LoginSessionUpdate {
+	lsu.mutation.SetReturnURL(s)
+	return lsu
+}
+
+// SetNillableReturnURL sets the "return_url" field if the given value is not nil.
+func (lsu *LoginSessionUpdate) SetNillableReturnURL(s *string) *LoginSessionUpdate {
+	if s != nil {
+		lsu.SetReturnURL(*s)
+	}
+	return lsu
+}
+
+// ClearReturnURL clears the value of the "return_url" field.
+func (lsu *LoginSessionUpdate) ClearReturnURL() *LoginSessionUpdate {
+	lsu.mutation.ClearReturnURL()
+	return lsu
+}
+
+// SetCredential sets the "credential" field.
+func (lsu *LoginSessionUpdate) SetCredential(s string) *LoginSessionUpdate {
+	lsu.mutation.SetCredential(s)
+	return lsu
+}
+
+// SetNillableCredential sets the "credential" field if the given value is not nil.
+func (lsu *LoginSessionUpdate) SetNillableCredential(s *string) *LoginSessionUpdate {
+	if s != nil {
+		lsu.SetCredential(*s)
+	}
+	return lsu
+}
+
+// ClearCredential clears the value of the "credential" field.
+func (lsu *LoginSessionUpdate) ClearCredential() *LoginSessionUpdate {
+	lsu.mutation.ClearCredential()
+	return lsu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (lsu *LoginSessionUpdate) SetExpiresAt(t time.Time) *LoginSessionUpdate {
+	lsu.mutation.SetExpiresAt(t)
+	return lsu
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (lsu *LoginSessionUpdate) SetCompletedAt(t time.Time) *LoginSessionUpdate {
+	lsu.mutation.SetCompletedAt(t)
+	return lsu
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (lsu *LoginSessionUpdate) SetNillableCompletedAt(t *time.Time) *LoginSessionUpdate {
+	if t != nil {
+		lsu.SetCompletedAt(*t)
+	}
+	return lsu
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (lsu *LoginSessionUpdate) ClearCompletedAt() *LoginSessionUpdate {
+	lsu.mutation.ClearCompletedAt()
+	return lsu
+}
+
+// Mutation returns the LoginSessionMutation object of the builder.
+func (lsu *LoginSessionUpdate) Mutation() *LoginSessionMutation {
+	return lsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lsu *LoginSessionUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(lsu.hooks) == 0 {
+		affected, err = lsu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LoginSessionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			lsu.mutation = mutation
+			affected, err = lsu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(lsu.hooks) - 1; i >= 0; i-- {
+			if lsu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = lsu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, lsu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lsu *LoginSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := lsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lsu *LoginSessionUpdate) Exec(ctx context.Context) error {
+	_, err := lsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lsu *LoginSessionUpdate) ExecX(ctx context.Context) {
+	if err := lsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (lsu *LoginSessionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   loginsession.Table,
+			Columns: loginsession.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: loginsession.FieldID,
+			},
+		},
+	}
+	if ps := lsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lsu.mutation.ClientID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: loginsession.FieldClientID,
+		})
+	}
+	if lsu.mutation.ClientIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: loginsession.FieldClientID,
+		})
+	}
+	if value, ok := lsu.mutation.ReturnURL(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: loginsession.FieldReturnURL,
+		})
+	}
+	if lsu.mutation.ReturnURLCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: loginsession.FieldReturnURL,
+		})
+	}
+	if value, ok := lsu.mutation.Credential(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: loginsession.FieldCredential,
+		})
+	}
+	if lsu.mutation.CredentialCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: loginsession.FieldCredential,
+		})
+	}
+	if value, ok := lsu.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: loginsession.FieldExpiresAt,
+		})
+	}
+	if value, ok := lsu.mutation.CompletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: loginsession.FieldCompletedAt,
+		})
+	}
+	if lsu.mutation.CompletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: loginsession.FieldCompletedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// LoginSessionUpdateOne is the builder for updating a single LoginSession entity.
+type LoginSessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LoginSessionMutation
+}
+
+// SetClientID sets the "client_id" field.
+func (lsuo *LoginSessionUpdateOne) SetClientID(s string) *LoginSessionUpdateOne {
+	lsuo.mutation.SetClientID(s)
+	return lsuo
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (lsuo *LoginSessionUpdateOne) SetNillableClientID(s *string) *LoginSessionUpdateOne {
+	if s != nil {
+		lsuo.SetClientID(*s)
+	}
+	return lsuo
+}
+
+// ClearClientID clears the value of the "client_id" field.
+func (lsuo *LoginSessionUpdateOne) ClearClientID() *LoginSessionUpdateOne {
+	lsuo.mutation.ClearClientID()
+	return lsuo
+}
+
+// SetReturnURL sets the "return_url" field.
+func (lsuo *LoginSessionUpdateOne) SetReturnURL(s string) *LoginSessionUpdateOne {
+	lsuo.mutation.SetReturnURL(s)
+	return lsuo
+}
+
+// SetNillableReturnURL sets the "return_url" field if the given value is not nil.
+func (lsuo *LoginSessionUpdateOne) SetNillableReturnURL(s *string) *LoginSessionUpdateOne {
+	if s != nil {
+		lsuo.SetReturnURL(*s)
+	}
+	return lsuo
+}
+
+// ClearReturnURL clears the value of the "return_url" field.
+func (lsuo *LoginSessionUpdateOne) ClearReturnURL() *LoginSessionUpdateOne {
+	lsuo.mutation.ClearReturnURL()
+	return lsuo
+}
+
+// SetCredential sets the "credential" field.
+func (lsuo *LoginSessionUpdateOne) SetCredential(s string) *LoginSessionUpdateOne {
+	lsuo.mutation.SetCredential(s)
+	return lsuo
+}
+
+// SetNillableCredential sets the "credential" field if the given value is not nil.
+func (lsuo *LoginSessionUpdateOne) SetNillableCredential(s *string) *LoginSessionUpdateOne {
+	if s != nil {
+		lsuo.SetCredential(*s)
+	}
+	return lsuo
+}
+
+// ClearCredential clears the value of the "credential" field.
+func (lsuo *LoginSessionUpdateOne) ClearCredential() *LoginSessionUpdateOne {
+	lsuo.mutation.ClearCredential()
+	return lsuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (lsuo *LoginSessionUpdateOne) SetExpiresAt(t time.Time) *LoginSessionUpdateOne {
+	lsuo.mutation.SetExpiresAt(t)
+	return lsuo
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (lsuo *LoginSessionUpdateOne) SetCompletedAt(t time.Time) *LoginSessionUpdateOne {
+	lsuo.mutation.SetCompletedAt(t)
+	return lsuo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (lsuo *LoginSessionUpdateOne) SetNillableCompletedAt(t *time.Time) *LoginSessionUpdateOne {
+	if t != nil {
+		lsuo.SetCompletedAt(*t)
+	}
+	return lsuo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (lsuo *LoginSessionUpdateOne) ClearCompletedAt() *LoginSessionUpdateOne {
+	lsuo.mutation.ClearCompletedAt()
+	return lsuo
+}
+
+// Mutation returns the LoginSessionMutation object of the builder.
+func (lsuo *LoginSessionUpdateOne) Mutation() *LoginSessionMutation {
+	return lsuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (lsuo *LoginSessionUpdateOne) Select(field string, fields ...string) *LoginSessionUpdateOne {
+	lsuo.fields = append([]string{field}, fields...)
+	return lsuo
+}
+
+// Save executes the query and returns the updated LoginSession entity.
+func (lsuo *LoginSessionUpdateOne) Save(ctx context.Context) (*LoginSession, error) {
+	var (
+		err  error
+		node *LoginSession
+	)
+	if len(lsuo.hooks) == 0 {
+		node, err = lsuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LoginSessionMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			lsuo.mutation = mutation
+			node, err = lsuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(lsuo.hooks) - 1; i >= 0; i-- {
+			if lsuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = lsuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, lsuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*LoginSession)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from LoginSessionMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lsuo *LoginSessionUpdateOne) SaveX(ctx context.Context) *LoginSession {
+	node, err := lsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (lsuo *LoginSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := lsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lsuo *LoginSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := lsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (lsuo *LoginSessionUpdateOne) sqlSave(ctx context.Context) (_node *LoginSession, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   loginsession.Table,
+			Columns: loginsession.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: loginsession.FieldID,
+			},
+		},
+	}
+	id, ok := lsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LoginSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := lsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loginsession.FieldID)
+		for _, f := range fields {
+			if !loginsession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != loginsession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := lsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lsuo.mutation.ClientID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: loginsession.FieldClientID,
+		})
+	}
+	if lsuo.mutation.ClientIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: loginsession.FieldClientID,
+		})
+	}
+	if value, ok := lsuo.mutation.ReturnURL(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: loginsession.FieldReturnURL,
+		})
+	}
+	if lsuo.mutation.ReturnURLCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: loginsession.FieldReturnURL,
+		})
+	}
+	if value, ok := lsuo.mutation.Credential(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: loginsession.FieldCredential,
+		})
+	}
+	if lsuo.mutation.CredentialCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: loginsession.FieldCredential,
+		})
+	}
+	if value, ok := lsuo.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: loginsession.FieldExpiresAt,
+		})
+	}
+	if value, ok := lsuo.mutation.CompletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: loginsession.FieldCompletedAt,
+		})
+	}
+	if lsuo.mutation.CompletedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: loginsession.FieldCompletedAt,
+		})
+	}
+	_node = &LoginSession{config: lsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, lsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
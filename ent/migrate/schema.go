@@ -8,13 +8,92 @@ import (
 )
 
 var (
+	// AuditEntriesColumns holds the columns for the "audit_entries" table.
+	AuditEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "actor", Type: field.TypeString},
+		{Name: "action", Type: field.TypeString},
+		{Name: "target_type", Type: field.TypeString, Nullable: true},
+		{Name: "target", Type: field.TypeString, Nullable: true},
+		{Name: "outcome", Type: field.TypeEnum, Enums: []string{"success", "failure"}},
+		{Name: "request_id", Type: field.TypeString, Nullable: true},
+		{Name: "details", Type: field.TypeJSON, Nullable: true},
+		{Name: "prev_hash", Type: field.TypeString},
+		{Name: "hash", Type: field.TypeString, Unique: true},
+	}
+	// AuditEntriesTable holds the schema information for the "audit_entries" table.
+	AuditEntriesTable = &schema.Table{
+		Name:       "audit_entries",
+		Columns:    AuditEntriesColumns,
+		PrimaryKey: []*schema.Column{AuditEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditentry_action",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[3]},
+			},
+			{
+				Name:    "auditentry_actor",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[2]},
+			},
+			{
+				Name:    "auditentry_target",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[5]},
+			},
+			{
+				Name:    "auditentry_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[1]},
+			},
+		},
+	}
+	// ClientCertificatesColumns holds the columns for the "client_certificates" table.
+	ClientCertificatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user", Type: field.TypeString},
+		{Name: "subject", Type: field.TypeString, Nullable: true},
+		{Name: "not_after", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ClientCertificatesTable holds the schema information for the "client_certificates" table.
+	ClientCertificatesTable = &schema.Table{
+		Name:       "client_certificates",
+		Columns:    ClientCertificatesColumns,
+		PrimaryKey: []*schema.Column{ClientCertificatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "clientcertificate_user",
+				Unique:  false,
+				Columns: []*schema.Column{ClientCertificatesColumns[1]},
+			},
+		},
+	}
 	// CredentialsColumns holds the columns for the "credentials" table.
 	CredentialsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
 		{Name: "type", Type: field.TypeString, Default: "jwt_vc"},
 		{Name: "raw", Type: field.TypeJSON},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "revoked"}, Default: "active"},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "renewal_due_at", Type: field.TypeTime, Nullable: true},
+		{Name: "renewal_started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "issuance_data", Type: field.TypeJSON, Nullable: true},
+		{Name: "types", Type: field.TypeJSON, Nullable: true},
+		{Name: "credential_type", Type: field.TypeString, Nullable: true},
+		{Name: "issuer", Type: field.TypeString, Nullable: true},
+		{Name: "subject", Type: field.TypeString, Nullable: true},
+		{Name: "holder_email", Type: field.TypeString, Nullable: true},
+		{Name: "template_name", Type: field.TypeString, Nullable: true},
+		{Name: "issued_at", Type: field.TypeTime, Nullable: true},
+		{Name: "indexed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "credential_successor", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "credential_template_credentials", Type: field.TypeString, Nullable: true},
 		{Name: "natural_person_credentials", Type: field.TypeString, Nullable: true},
 		{Name: "user_credentials", Type: field.TypeString, Nullable: true},
 	}
@@ -24,19 +103,90 @@ var (
 		Columns:    CredentialsColumns,
 		PrimaryKey: []*schema.Column{CredentialsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "credentials_credentials_successor",
+				Columns:    []*schema.Column{CredentialsColumns[19]},
+				RefColumns: []*schema.Column{CredentialsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "credentials_credential_templates_credentials",
+				Columns:    []*schema.Column{CredentialsColumns[20]},
+				RefColumns: []*schema.Column{CredentialTemplatesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "credentials_natural_persons_credentials",
-				Columns:    []*schema.Column{CredentialsColumns[5]},
+				Columns:    []*schema.Column{CredentialsColumns[21]},
 				RefColumns: []*schema.Column{NaturalPersonsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "credentials_users_credentials",
-				Columns:    []*schema.Column{CredentialsColumns[6]},
+				Columns:    []*schema.Column{CredentialsColumns[22]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "credential_status_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{CredentialsColumns[3], CredentialsColumns[4]},
+			},
+			{
+				Name:    "credential_credential_type",
+				Unique:  false,
+				Columns: []*schema.Column{CredentialsColumns[10]},
+			},
+			{
+				Name:    "credential_subject",
+				Unique:  false,
+				Columns: []*schema.Column{CredentialsColumns[12]},
+			},
+			{
+				Name:    "credential_holder_email",
+				Unique:  false,
+				Columns: []*schema.Column{CredentialsColumns[13]},
+			},
+			{
+				Name:    "credential_issued_at",
+				Unique:  false,
+				Columns: []*schema.Column{CredentialsColumns[15]},
+			},
+			{
+				Name:    "credential_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{CredentialsColumns[17]},
+			},
+		},
+	}
+	// CredentialTemplatesColumns holds the columns for the "credential_templates" table.
+	CredentialTemplatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"draft", "published", "archived"}, Default: "draft"},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "sample_data", Type: field.TypeJSON, Nullable: true},
+		{Name: "builtin", Type: field.TypeBool, Default: false},
+		{Name: "published_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// CredentialTemplatesTable holds the schema information for the "credential_templates" table.
+	CredentialTemplatesTable = &schema.Table{
+		Name:       "credential_templates",
+		Columns:    CredentialTemplatesColumns,
+		PrimaryKey: []*schema.Column{CredentialTemplatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "credentialtemplate_name_version",
+				Unique:  true,
+				Columns: []*schema.Column{CredentialTemplatesColumns[1], CredentialTemplatesColumns[2]},
+			},
+		},
 	}
 	// DiDsColumns holds the columns for the "di_ds" table.
 	DiDsColumns = []*schema.Column{
@@ -60,6 +210,90 @@ var (
 			},
 		},
 	}
+	// IssuanceRequestsColumns holds the columns for the "issuance_requests" table.
+	IssuanceRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "credential_type", Type: field.TypeString},
+		{Name: "claims", Type: field.TypeJSON},
+		{Name: "subject_did", Type: field.TypeString, Nullable: true},
+		{Name: "predecessor", Type: field.TypeString, Nullable: true},
+		{Name: "revoke_predecessor", Type: field.TypeBool, Default: false},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "approved", "rejected", "issued", "failed"}, Default: "pending"},
+		{Name: "requested_by", Type: field.TypeString},
+		{Name: "decided_by", Type: field.TypeString, Nullable: true},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "issuance_request_credential", Type: field.TypeString, Nullable: true},
+	}
+	// IssuanceRequestsTable holds the schema information for the "issuance_requests" table.
+	IssuanceRequestsTable = &schema.Table{
+		Name:       "issuance_requests",
+		Columns:    IssuanceRequestsColumns,
+		PrimaryKey: []*schema.Column{IssuanceRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "issuance_requests_credentials_credential",
+				Columns:    []*schema.Column{IssuanceRequestsColumns[12]},
+				RefColumns: []*schema.Column{CredentialsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "issuancerequest_status",
+				Unique:  false,
+				Columns: []*schema.Column{IssuanceRequestsColumns[6]},
+			},
+		},
+	}
+	// IssuanceRequestEventsColumns holds the columns for the "issuance_request_events" table.
+	IssuanceRequestEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "from_status", Type: field.TypeString, Nullable: true},
+		{Name: "to_status", Type: field.TypeString},
+		{Name: "actor", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "issuance_request_events", Type: field.TypeString, Nullable: true},
+	}
+	// IssuanceRequestEventsTable holds the schema information for the "issuance_request_events" table.
+	IssuanceRequestEventsTable = &schema.Table{
+		Name:       "issuance_request_events",
+		Columns:    IssuanceRequestEventsColumns,
+		PrimaryKey: []*schema.Column{IssuanceRequestEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "issuance_request_events_issuance_requests_events",
+				Columns:    []*schema.Column{IssuanceRequestEventsColumns[6]},
+				RefColumns: []*schema.Column{IssuanceRequestsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// LoginSessionsColumns holds the columns for the "login_sessions" table.
+	LoginSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "client_id", Type: field.TypeString, Nullable: true},
+		{Name: "return_url", Type: field.TypeString, Nullable: true},
+		{Name: "credential", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+	}
+	// LoginSessionsTable holds the schema information for the "login_sessions" table.
+	LoginSessionsTable = &schema.Table{
+		Name:       "login_sessions",
+		Columns:    LoginSessionsColumns,
+		PrimaryKey: []*schema.Column{LoginSessionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "loginsession_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{LoginSessionsColumns[5]},
+			},
+		},
+	}
 	// NaturalPersonsColumns holds the columns for the "natural_persons" table.
 	NaturalPersonsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -76,6 +310,34 @@ var (
 		Columns:    NaturalPersonsColumns,
 		PrimaryKey: []*schema.Column{NaturalPersonsColumns[0]},
 	}
+	// PresentationNoncesColumns holds the columns for the "presentation_nonces" table.
+	PresentationNoncesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "state", Type: field.TypeString},
+		{Name: "client_id", Type: field.TypeString, Nullable: true},
+		{Name: "audience", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "consumed_at", Type: field.TypeTime, Nullable: true},
+	}
+	// PresentationNoncesTable holds the schema information for the "presentation_nonces" table.
+	PresentationNoncesTable = &schema.Table{
+		Name:       "presentation_nonces",
+		Columns:    PresentationNoncesColumns,
+		PrimaryKey: []*schema.Column{PresentationNoncesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "presentationnonce_state",
+				Unique:  false,
+				Columns: []*schema.Column{PresentationNoncesColumns[1]},
+			},
+			{
+				Name:    "presentationnonce_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{PresentationNoncesColumns[5]},
+			},
+		},
+	}
 	// PrivateKeysColumns holds the columns for the "private_keys" table.
 	PrivateKeysColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -122,6 +384,59 @@ var (
 		Columns:    PublicKeysColumns,
 		PrimaryKey: []*schema.Column{PublicKeysColumns[0]},
 	}
+	// RateCountersColumns holds the columns for the "rate_counters" table.
+	RateCountersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "count", Type: field.TypeInt, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// RateCountersTable holds the schema information for the "rate_counters" table.
+	RateCountersTable = &schema.Table{
+		Name:       "rate_counters",
+		Columns:    RateCountersColumns,
+		PrimaryKey: []*schema.Column{RateCountersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ratecounter_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{RateCountersColumns[2]},
+			},
+		},
+	}
+	// RelyingPartiesColumns holds the columns for the "relying_parties" table.
+	RelyingPartiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "redirect_uris", Type: field.TypeJSON},
+		{Name: "credential_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "presentation_definition", Type: field.TypeJSON, Nullable: true},
+		{Name: "scope", Type: field.TypeString, Nullable: true},
+		{Name: "audience", Type: field.TypeString, Nullable: true},
+		{Name: "token_lifetime", Type: field.TypeString, Nullable: true},
+		{Name: "signing_alg", Type: field.TypeString, Nullable: true},
+		{Name: "service", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// RelyingPartiesTable holds the schema information for the "relying_parties" table.
+	RelyingPartiesTable = &schema.Table{
+		Name:       "relying_parties",
+		Columns:    RelyingPartiesColumns,
+		PrimaryKey: []*schema.Column{RelyingPartiesColumns[0]},
+	}
+	// TrustedIssuersColumns holds the columns for the "trusted_issuers" table.
+	TrustedIssuersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "credentials", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// TrustedIssuersTable holds the schema information for the "trusted_issuers" table.
+	TrustedIssuersTable = &schema.Table{
+		Name:       "trusted_issuers",
+		Columns:    TrustedIssuersColumns,
+		PrimaryKey: []*schema.Column{TrustedIssuersColumns[0]},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -129,6 +444,7 @@ var (
 		{Name: "displayname", Type: field.TypeString, Nullable: true},
 		{Name: "type", Type: field.TypeString},
 		{Name: "password", Type: field.TypeBytes},
+		{Name: "roles", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -140,19 +456,33 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AuditEntriesTable,
+		ClientCertificatesTable,
 		CredentialsTable,
+		CredentialTemplatesTable,
 		DiDsTable,
+		IssuanceRequestsTable,
+		IssuanceRequestEventsTable,
+		LoginSessionsTable,
 		NaturalPersonsTable,
+		PresentationNoncesTable,
 		PrivateKeysTable,
 		PublicKeysTable,
+		RateCountersTable,
+		RelyingPartiesTable,
+		TrustedIssuersTable,
 		UsersTable,
 	}
 )
 
 func init() {
-	CredentialsTable.ForeignKeys[0].RefTable = NaturalPersonsTable
-	CredentialsTable.ForeignKeys[1].RefTable = UsersTable
+	CredentialsTable.ForeignKeys[0].RefTable = CredentialsTable
+	CredentialsTable.ForeignKeys[1].RefTable = CredentialTemplatesTable
+	CredentialsTable.ForeignKeys[2].RefTable = NaturalPersonsTable
+	CredentialsTable.ForeignKeys[3].RefTable = UsersTable
 	DiDsTable.ForeignKeys[0].RefTable = UsersTable
+	IssuanceRequestsTable.ForeignKeys[0].RefTable = CredentialsTable
+	IssuanceRequestEventsTable.ForeignKeys[0].RefTable = IssuanceRequestsTable
 	PrivateKeysTable.ForeignKeys[0].RefTable = NaturalPersonsTable
 	PrivateKeysTable.ForeignKeys[1].RefTable = UsersTable
 }
@@ -9,13 +9,24 @@ import (
 	"sync"
 	"time"
 
+	"github.com/hesusruiz/vcbackend/ent/auditentry"
+	"github.com/hesusruiz/vcbackend/ent/clientcertificate"
 	"github.com/hesusruiz/vcbackend/ent/credential"
+	"github.com/hesusruiz/vcbackend/ent/credentialtemplate"
 	"github.com/hesusruiz/vcbackend/ent/did"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequest"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequestevent"
+	"github.com/hesusruiz/vcbackend/ent/loginsession"
 	"github.com/hesusruiz/vcbackend/ent/naturalperson"
 	"github.com/hesusruiz/vcbackend/ent/predicate"
+	"github.com/hesusruiz/vcbackend/ent/presentationnonce"
 	"github.com/hesusruiz/vcbackend/ent/privatekey"
 	"github.com/hesusruiz/vcbackend/ent/publickey"
+	"github.com/hesusruiz/vcbackend/ent/ratecounter"
+	"github.com/hesusruiz/vcbackend/ent/relyingparty"
+	"github.com/hesusruiz/vcbackend/ent/trustedissuer"
 	"github.com/hesusruiz/vcbackend/ent/user"
+	"github.com/hesusruiz/vcbackend/internal/til"
 
 	"entgo.io/ent"
 )
@@ -29,43 +40,57 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeCredential    = "Credential"
-	TypeDID           = "DID"
-	TypeNaturalPerson = "NaturalPerson"
-	TypePrivateKey    = "PrivateKey"
-	TypePublicKey     = "PublicKey"
-	TypeUser          = "User"
+	TypeAuditEntry           = "AuditEntry"
+	TypeClientCertificate    = "ClientCertificate"
+	TypeCredential           = "Credential"
+	TypeCredentialTemplate   = "CredentialTemplate"
+	TypeDID                  = "DID"
+	TypeIssuanceRequest      = "IssuanceRequest"
+	TypeIssuanceRequestEvent = "IssuanceRequestEvent"
+	TypeLoginSession         = "LoginSession"
+	TypeNaturalPerson        = "NaturalPerson"
+	TypePresentationNonce    = "PresentationNonce"
+	TypePrivateKey           = "PrivateKey"
+	TypePublicKey            = "PublicKey"
+	TypeRateCounter          = "RateCounter"
+	TypeRelyingParty         = "RelyingParty"
+	TypeTrustedIssuer        = "TrustedIssuer"
+	TypeUser                 = "User"
 )
 
-// CredentialMutation represents an operation that mutates the Credential nodes in the graph.
-type CredentialMutation struct {
+// AuditEntryMutation represents an operation that mutates the AuditEntry nodes in the graph.
+type AuditEntryMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *string
-	_type          *string
-	raw            *[]uint8
-	created_at     *time.Time
-	updated_at     *time.Time
-	clearedFields  map[string]struct{}
-	account        *string
-	clearedaccount bool
-	done           bool
-	oldValue       func(context.Context) (*Credential, error)
-	predicates     []predicate.Credential
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	actor         *string
+	action        *string
+	target_type   *string
+	target        *string
+	outcome       *auditentry.Outcome
+	request_id    *string
+	details       *map[string]interface{}
+	prev_hash     *string
+	hash          *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*AuditEntry, error)
+	predicates    []predicate.AuditEntry
 }
 
-var _ ent.Mutation = (*CredentialMutation)(nil)
+var _ ent.Mutation = (*AuditEntryMutation)(nil)
 
-// credentialOption allows management of the mutation configuration using functional options.
-type credentialOption func(*CredentialMutation)
+// auditentryOption allows management of the mutation configuration using functional options.
+type auditentryOption func(*AuditEntryMutation)
 
-// newCredentialMutation creates new mutation for the Credential entity.
-func newCredentialMutation(c config, op Op, opts ...credentialOption) *CredentialMutation {
-	m := &CredentialMutation{
+// newAuditEntryMutation creates new mutation for the AuditEntry entity.
+func newAuditEntryMutation(c config, op Op, opts ...auditentryOption) *AuditEntryMutation {
+	m := &AuditEntryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCredential,
+		typ:           TypeAuditEntry,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -74,20 +99,20 @@ func newCredentialMutation(c config, op Op, opts ...credentialOption) *Credentia
 	return m
 }
 
-// withCredentialID sets the ID field of the mutation.
-func withCredentialID(id string) credentialOption {
-	return func(m *CredentialMutation) {
+// withAuditEntryID sets the ID field of the mutation.
+func withAuditEntryID(id int) auditentryOption {
+	return func(m *AuditEntryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Credential
+			value *AuditEntry
 		)
-		m.oldValue = func(ctx context.Context) (*Credential, error) {
+		m.oldValue = func(ctx context.Context) (*AuditEntry, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Credential.Get(ctx, id)
+					value, err = m.Client().AuditEntry.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -96,10 +121,10 @@ func withCredentialID(id string) credentialOption {
 	}
 }
 
-// withCredential sets the old Credential of the mutation.
-func withCredential(node *Credential) credentialOption {
-	return func(m *CredentialMutation) {
-		m.oldValue = func(context.Context) (*Credential, error) {
+// withAuditEntry sets the old AuditEntry of the mutation.
+func withAuditEntry(node *AuditEntry) auditentryOption {
+	return func(m *AuditEntryMutation) {
+		m.oldValue = func(context.Context) (*AuditEntry, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -108,7 +133,7 @@ func withCredential(node *Credential) credentialOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CredentialMutation) Client() *Client {
+func (m AuditEntryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -116,7 +141,7 @@ func (m CredentialMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CredentialMutation) Tx() (*Tx, error) {
+func (m AuditEntryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -126,14 +151,14 @@ func (m CredentialMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Credential entities.
-func (m *CredentialMutation) SetID(id string) {
+// operation is only accepted on creation of AuditEntry entities.
+func (m *AuditEntryMutation) SetID(id int) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CredentialMutation) ID() (id string, exists bool) {
+func (m *AuditEntryMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -144,475 +169,804 @@ func (m *CredentialMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CredentialMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *AuditEntryMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []string{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Credential.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditEntry.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetType sets the "type" field.
-func (m *CredentialMutation) SetType(s string) {
-	m._type = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *AuditEntryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *CredentialMutation) GetType() (r string, exists bool) {
-	v := m._type
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuditEntryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Credential entity.
-// If the Credential object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CredentialMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *AuditEntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *CredentialMutation) ResetType() {
-	m._type = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuditEntryMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetRaw sets the "raw" field.
-func (m *CredentialMutation) SetRaw(u []uint8) {
-	m.raw = &u
+// SetActor sets the "actor" field.
+func (m *AuditEntryMutation) SetActor(s string) {
+	m.actor = &s
 }
 
-// Raw returns the value of the "raw" field in the mutation.
-func (m *CredentialMutation) Raw() (r []uint8, exists bool) {
-	v := m.raw
+// Actor returns the value of the "actor" field in the mutation.
+func (m *AuditEntryMutation) Actor() (r string, exists bool) {
+	v := m.actor
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRaw returns the old "raw" field's value of the Credential entity.
-// If the Credential object wasn't provided to the builder, the object is fetched from the database.
+// OldActor returns the old "actor" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CredentialMutation) OldRaw(ctx context.Context) (v []uint8, err error) {
+func (m *AuditEntryMutation) OldActor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRaw is only allowed on UpdateOne operations")
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRaw requires an ID field in the mutation")
+		return v, errors.New("OldActor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRaw: %w", err)
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
 	}
-	return oldValue.Raw, nil
+	return oldValue.Actor, nil
 }
 
-// ResetRaw resets all changes to the "raw" field.
-func (m *CredentialMutation) ResetRaw() {
-	m.raw = nil
+// ResetActor resets all changes to the "actor" field.
+func (m *AuditEntryMutation) ResetActor() {
+	m.actor = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *CredentialMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetAction sets the "action" field.
+func (m *AuditEntryMutation) SetAction(s string) {
+	m.action = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CredentialMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Action returns the value of the "action" field in the mutation.
+func (m *AuditEntryMutation) Action() (r string, exists bool) {
+	v := m.action
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Credential entity.
-// If the Credential object wasn't provided to the builder, the object is fetched from the database.
+// OldAction returns the old "action" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CredentialMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuditEntryMutation) OldAction(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldAction requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Action, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CredentialMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetAction resets all changes to the "action" field.
+func (m *AuditEntryMutation) ResetAction() {
+	m.action = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *CredentialMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetTargetType sets the "target_type" field.
+func (m *AuditEntryMutation) SetTargetType(s string) {
+	m.target_type = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *CredentialMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// TargetType returns the value of the "target_type" field in the mutation.
+func (m *AuditEntryMutation) TargetType() (r string, exists bool) {
+	v := m.target_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Credential entity.
-// If the Credential object wasn't provided to the builder, the object is fetched from the database.
+// OldTargetType returns the old "target_type" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CredentialMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuditEntryMutation) OldTargetType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargetType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTargetType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTargetType: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.TargetType, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *CredentialMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearTargetType clears the value of the "target_type" field.
+func (m *AuditEntryMutation) ClearTargetType() {
+	m.target_type = nil
+	m.clearedFields[auditentry.FieldTargetType] = struct{}{}
 }
 
-// SetAccountID sets the "account" edge to the User entity by id.
-func (m *CredentialMutation) SetAccountID(id string) {
-	m.account = &id
+// TargetTypeCleared returns if the "target_type" field was cleared in this mutation.
+func (m *AuditEntryMutation) TargetTypeCleared() bool {
+	_, ok := m.clearedFields[auditentry.FieldTargetType]
+	return ok
 }
 
-// ClearAccount clears the "account" edge to the User entity.
-func (m *CredentialMutation) ClearAccount() {
-	m.clearedaccount = true
+// ResetTargetType resets all changes to the "target_type" field.
+func (m *AuditEntryMutation) ResetTargetType() {
+	m.target_type = nil
+	delete(m.clearedFields, auditentry.FieldTargetType)
 }
 
-// AccountCleared reports if the "account" edge to the User entity was cleared.
-func (m *CredentialMutation) AccountCleared() bool {
-	return m.clearedaccount
+// SetTarget sets the "target" field.
+func (m *AuditEntryMutation) SetTarget(s string) {
+	m.target = &s
 }
 
-// AccountID returns the "account" edge ID in the mutation.
-func (m *CredentialMutation) AccountID() (id string, exists bool) {
-	if m.account != nil {
-		return *m.account, true
+// Target returns the value of the "target" field in the mutation.
+func (m *AuditEntryMutation) Target() (r string, exists bool) {
+	v := m.target
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// AccountIDs returns the "account" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// AccountID instead. It exists only for internal usage by the builders.
-func (m *CredentialMutation) AccountIDs() (ids []string) {
-	if id := m.account; id != nil {
-		ids = append(ids, *id)
+// OldTarget returns the old "target" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldTarget(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTarget is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTarget requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTarget: %w", err)
+	}
+	return oldValue.Target, nil
 }
 
-// ResetAccount resets all changes to the "account" edge.
-func (m *CredentialMutation) ResetAccount() {
-	m.account = nil
-	m.clearedaccount = false
+// ClearTarget clears the value of the "target" field.
+func (m *AuditEntryMutation) ClearTarget() {
+	m.target = nil
+	m.clearedFields[auditentry.FieldTarget] = struct{}{}
 }
 
-// Where appends a list predicates to the CredentialMutation builder.
-func (m *CredentialMutation) Where(ps ...predicate.Credential) {
-	m.predicates = append(m.predicates, ps...)
+// TargetCleared returns if the "target" field was cleared in this mutation.
+func (m *AuditEntryMutation) TargetCleared() bool {
+	_, ok := m.clearedFields[auditentry.FieldTarget]
+	return ok
 }
 
-// Op returns the operation name.
-func (m *CredentialMutation) Op() Op {
-	return m.op
+// ResetTarget resets all changes to the "target" field.
+func (m *AuditEntryMutation) ResetTarget() {
+	m.target = nil
+	delete(m.clearedFields, auditentry.FieldTarget)
 }
 
-// Type returns the node type of this mutation (Credential).
-func (m *CredentialMutation) Type() string {
-	return m.typ
+// SetOutcome sets the "outcome" field.
+func (m *AuditEntryMutation) SetOutcome(a auditentry.Outcome) {
+	m.outcome = &a
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *CredentialMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m._type != nil {
-		fields = append(fields, credential.FieldType)
+// Outcome returns the value of the "outcome" field in the mutation.
+func (m *AuditEntryMutation) Outcome() (r auditentry.Outcome, exists bool) {
+	v := m.outcome
+	if v == nil {
+		return
 	}
-	if m.raw != nil {
-		fields = append(fields, credential.FieldRaw)
+	return *v, true
+}
+
+// OldOutcome returns the old "outcome" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldOutcome(ctx context.Context) (v auditentry.Outcome, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutcome is only allowed on UpdateOne operations")
 	}
-	if m.created_at != nil {
-		fields = append(fields, credential.FieldCreatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutcome requires an ID field in the mutation")
 	}
-	if m.updated_at != nil {
-		fields = append(fields, credential.FieldUpdatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutcome: %w", err)
 	}
-	return fields
+	return oldValue.Outcome, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *CredentialMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case credential.FieldType:
-		return m.GetType()
-	case credential.FieldRaw:
-		return m.Raw()
-	case credential.FieldCreatedAt:
-		return m.CreatedAt()
-	case credential.FieldUpdatedAt:
-		return m.UpdatedAt()
-	}
-	return nil, false
+// ResetOutcome resets all changes to the "outcome" field.
+func (m *AuditEntryMutation) ResetOutcome() {
+	m.outcome = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *CredentialMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case credential.FieldType:
-		return m.OldType(ctx)
-	case credential.FieldRaw:
-		return m.OldRaw(ctx)
-	case credential.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case credential.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+// SetRequestID sets the "request_id" field.
+func (m *AuditEntryMutation) SetRequestID(s string) {
+	m.request_id = &s
+}
+
+// RequestID returns the value of the "request_id" field in the mutation.
+func (m *AuditEntryMutation) RequestID() (r string, exists bool) {
+	v := m.request_id
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Credential field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *CredentialMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case credential.FieldType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case credential.FieldRaw:
-		v, ok := value.([]uint8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRaw(v)
-		return nil
-	case credential.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case credential.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
+// OldRequestID returns the old "request_id" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldRequestID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestID is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Credential field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestID: %w", err)
+	}
+	return oldValue.RequestID, nil
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (m *AuditEntryMutation) ClearRequestID() {
+	m.request_id = nil
+	m.clearedFields[auditentry.FieldRequestID] = struct{}{}
+}
+
+// RequestIDCleared returns if the "request_id" field was cleared in this mutation.
+func (m *AuditEntryMutation) RequestIDCleared() bool {
+	_, ok := m.clearedFields[auditentry.FieldRequestID]
+	return ok
+}
+
+// ResetRequestID resets all changes to the "request_id" field.
+func (m *AuditEntryMutation) ResetRequestID() {
+	m.request_id = nil
+	delete(m.clearedFields, auditentry.FieldRequestID)
+}
+
+// SetDetails sets the "details" field.
+func (m *AuditEntryMutation) SetDetails(value map[string]interface{}) {
+	m.details = &value
+}
+
+// Details returns the value of the "details" field in the mutation.
+func (m *AuditEntryMutation) Details() (r map[string]interface{}, exists bool) {
+	v := m.details
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetails returns the old "details" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldDetails(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetails requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
+	}
+	return oldValue.Details, nil
+}
+
+// ClearDetails clears the value of the "details" field.
+func (m *AuditEntryMutation) ClearDetails() {
+	m.details = nil
+	m.clearedFields[auditentry.FieldDetails] = struct{}{}
+}
+
+// DetailsCleared returns if the "details" field was cleared in this mutation.
+func (m *AuditEntryMutation) DetailsCleared() bool {
+	_, ok := m.clearedFields[auditentry.FieldDetails]
+	return ok
+}
+
+// ResetDetails resets all changes to the "details" field.
+func (m *AuditEntryMutation) ResetDetails() {
+	m.details = nil
+	delete(m.clearedFields, auditentry.FieldDetails)
+}
+
+// SetPrevHash sets the "prev_hash" field.
+func (m *AuditEntryMutation) SetPrevHash(s string) {
+	m.prev_hash = &s
+}
+
+// PrevHash returns the value of the "prev_hash" field in the mutation.
+func (m *AuditEntryMutation) PrevHash() (r string, exists bool) {
+	v := m.prev_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrevHash returns the old "prev_hash" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldPrevHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrevHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrevHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrevHash: %w", err)
+	}
+	return oldValue.PrevHash, nil
+}
+
+// ResetPrevHash resets all changes to the "prev_hash" field.
+func (m *AuditEntryMutation) ResetPrevHash() {
+	m.prev_hash = nil
+}
+
+// SetHash sets the "hash" field.
+func (m *AuditEntryMutation) SetHash(s string) {
+	m.hash = &s
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *AuditEntryMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the AuditEntry entity.
+// If the AuditEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEntryMutation) OldHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *AuditEntryMutation) ResetHash() {
+	m.hash = nil
+}
+
+// Where appends a list predicates to the AuditEntryMutation builder.
+func (m *AuditEntryMutation) Where(ps ...predicate.AuditEntry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *AuditEntryMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (AuditEntry).
+func (m *AuditEntryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuditEntryMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.created_at != nil {
+		fields = append(fields, auditentry.FieldCreatedAt)
+	}
+	if m.actor != nil {
+		fields = append(fields, auditentry.FieldActor)
+	}
+	if m.action != nil {
+		fields = append(fields, auditentry.FieldAction)
+	}
+	if m.target_type != nil {
+		fields = append(fields, auditentry.FieldTargetType)
+	}
+	if m.target != nil {
+		fields = append(fields, auditentry.FieldTarget)
+	}
+	if m.outcome != nil {
+		fields = append(fields, auditentry.FieldOutcome)
+	}
+	if m.request_id != nil {
+		fields = append(fields, auditentry.FieldRequestID)
+	}
+	if m.details != nil {
+		fields = append(fields, auditentry.FieldDetails)
+	}
+	if m.prev_hash != nil {
+		fields = append(fields, auditentry.FieldPrevHash)
+	}
+	if m.hash != nil {
+		fields = append(fields, auditentry.FieldHash)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuditEntryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case auditentry.FieldCreatedAt:
+		return m.CreatedAt()
+	case auditentry.FieldActor:
+		return m.Actor()
+	case auditentry.FieldAction:
+		return m.Action()
+	case auditentry.FieldTargetType:
+		return m.TargetType()
+	case auditentry.FieldTarget:
+		return m.Target()
+	case auditentry.FieldOutcome:
+		return m.Outcome()
+	case auditentry.FieldRequestID:
+		return m.RequestID()
+	case auditentry.FieldDetails:
+		return m.Details()
+	case auditentry.FieldPrevHash:
+		return m.PrevHash()
+	case auditentry.FieldHash:
+		return m.Hash()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuditEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case auditentry.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case auditentry.FieldActor:
+		return m.OldActor(ctx)
+	case auditentry.FieldAction:
+		return m.OldAction(ctx)
+	case auditentry.FieldTargetType:
+		return m.OldTargetType(ctx)
+	case auditentry.FieldTarget:
+		return m.OldTarget(ctx)
+	case auditentry.FieldOutcome:
+		return m.OldOutcome(ctx)
+	case auditentry.FieldRequestID:
+		return m.OldRequestID(ctx)
+	case auditentry.FieldDetails:
+		return m.OldDetails(ctx)
+	case auditentry.FieldPrevHash:
+		return m.OldPrevHash(ctx)
+	case auditentry.FieldHash:
+		return m.OldHash(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuditEntry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditEntryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case auditentry.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case auditentry.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case auditentry.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case auditentry.FieldTargetType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetType(v)
+		return nil
+	case auditentry.FieldTarget:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTarget(v)
+		return nil
+	case auditentry.FieldOutcome:
+		v, ok := value.(auditentry.Outcome)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutcome(v)
+		return nil
+	case auditentry.FieldRequestID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestID(v)
+		return nil
+	case auditentry.FieldDetails:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetails(v)
+		return nil
+	case auditentry.FieldPrevHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrevHash(v)
+		return nil
+	case auditentry.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEntry field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CredentialMutation) AddedFields() []string {
+func (m *AuditEntryMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CredentialMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AuditEntryMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CredentialMutation) AddField(name string, value ent.Value) error {
+func (m *AuditEntryMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Credential numeric field %s", name)
+	return fmt.Errorf("unknown AuditEntry numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CredentialMutation) ClearedFields() []string {
-	return nil
+func (m *AuditEntryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditentry.FieldTargetType) {
+		fields = append(fields, auditentry.FieldTargetType)
+	}
+	if m.FieldCleared(auditentry.FieldTarget) {
+		fields = append(fields, auditentry.FieldTarget)
+	}
+	if m.FieldCleared(auditentry.FieldRequestID) {
+		fields = append(fields, auditentry.FieldRequestID)
+	}
+	if m.FieldCleared(auditentry.FieldDetails) {
+		fields = append(fields, auditentry.FieldDetails)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CredentialMutation) FieldCleared(name string) bool {
+func (m *AuditEntryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CredentialMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Credential nullable field %s", name)
+func (m *AuditEntryMutation) ClearField(name string) error {
+	switch name {
+	case auditentry.FieldTargetType:
+		m.ClearTargetType()
+		return nil
+	case auditentry.FieldTarget:
+		m.ClearTarget()
+		return nil
+	case auditentry.FieldRequestID:
+		m.ClearRequestID()
+		return nil
+	case auditentry.FieldDetails:
+		m.ClearDetails()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditEntry nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CredentialMutation) ResetField(name string) error {
+func (m *AuditEntryMutation) ResetField(name string) error {
 	switch name {
-	case credential.FieldType:
-		m.ResetType()
+	case auditentry.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case credential.FieldRaw:
-		m.ResetRaw()
+	case auditentry.FieldActor:
+		m.ResetActor()
 		return nil
-	case credential.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case auditentry.FieldAction:
+		m.ResetAction()
 		return nil
-	case credential.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case auditentry.FieldTargetType:
+		m.ResetTargetType()
+		return nil
+	case auditentry.FieldTarget:
+		m.ResetTarget()
+		return nil
+	case auditentry.FieldOutcome:
+		m.ResetOutcome()
+		return nil
+	case auditentry.FieldRequestID:
+		m.ResetRequestID()
+		return nil
+	case auditentry.FieldDetails:
+		m.ResetDetails()
+		return nil
+	case auditentry.FieldPrevHash:
+		m.ResetPrevHash()
+		return nil
+	case auditentry.FieldHash:
+		m.ResetHash()
 		return nil
 	}
-	return fmt.Errorf("unknown Credential field %s", name)
+	return fmt.Errorf("unknown AuditEntry field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CredentialMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.account != nil {
-		edges = append(edges, credential.EdgeAccount)
-	}
+func (m *AuditEntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CredentialMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case credential.EdgeAccount:
-		if id := m.account; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *AuditEntryMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CredentialMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *AuditEntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CredentialMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	}
+func (m *AuditEntryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CredentialMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedaccount {
-		edges = append(edges, credential.EdgeAccount)
-	}
+func (m *AuditEntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CredentialMutation) EdgeCleared(name string) bool {
-	switch name {
-	case credential.EdgeAccount:
-		return m.clearedaccount
-	}
+func (m *AuditEntryMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CredentialMutation) ClearEdge(name string) error {
-	switch name {
-	case credential.EdgeAccount:
-		m.ClearAccount()
-		return nil
-	}
-	return fmt.Errorf("unknown Credential unique edge %s", name)
+func (m *AuditEntryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditEntry unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CredentialMutation) ResetEdge(name string) error {
-	switch name {
-	case credential.EdgeAccount:
-		m.ResetAccount()
-		return nil
-	}
-	return fmt.Errorf("unknown Credential edge %s", name)
+func (m *AuditEntryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditEntry edge %s", name)
 }
 
-// DIDMutation represents an operation that mutates the DID nodes in the graph.
-type DIDMutation struct {
+// ClientCertificateMutation represents an operation that mutates the ClientCertificate nodes in the graph.
+type ClientCertificateMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *string
-	method        *string
+	user          *string
+	subject       *string
+	not_after     *time.Time
 	created_at    *time.Time
-	updated_at    *time.Time
 	clearedFields map[string]struct{}
-	user          *string
-	cleareduser   bool
 	done          bool
-	oldValue      func(context.Context) (*DID, error)
-	predicates    []predicate.DID
+	oldValue      func(context.Context) (*ClientCertificate, error)
+	predicates    []predicate.ClientCertificate
 }
 
-var _ ent.Mutation = (*DIDMutation)(nil)
+var _ ent.Mutation = (*ClientCertificateMutation)(nil)
 
-// didOption allows management of the mutation configuration using functional options.
-type didOption func(*DIDMutation)
+// clientcertificateOption allows management of the mutation configuration using functional options.
+type clientcertificateOption func(*ClientCertificateMutation)
 
-// newDIDMutation creates new mutation for the DID entity.
-func newDIDMutation(c config, op Op, opts ...didOption) *DIDMutation {
-	m := &DIDMutation{
+// newClientCertificateMutation creates new mutation for the ClientCertificate entity.
+func newClientCertificateMutation(c config, op Op, opts ...clientcertificateOption) *ClientCertificateMutation {
+	m := &ClientCertificateMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDID,
+		typ:           TypeClientCertificate,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -621,20 +975,20 @@ func newDIDMutation(c config, op Op, opts ...didOption) *DIDMutation {
 	return m
 }
 
-// withDIDID sets the ID field of the mutation.
-func withDIDID(id string) didOption {
-	return func(m *DIDMutation) {
+// withClientCertificateID sets the ID field of the mutation.
+func withClientCertificateID(id string) clientcertificateOption {
+	return func(m *ClientCertificateMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DID
+			value *ClientCertificate
 		)
-		m.oldValue = func(ctx context.Context) (*DID, error) {
+		m.oldValue = func(ctx context.Context) (*ClientCertificate, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DID.Get(ctx, id)
+					value, err = m.Client().ClientCertificate.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -643,10 +997,10 @@ func withDIDID(id string) didOption {
 	}
 }
 
-// withDID sets the old DID of the mutation.
-func withDID(node *DID) didOption {
-	return func(m *DIDMutation) {
-		m.oldValue = func(context.Context) (*DID, error) {
+// withClientCertificate sets the old ClientCertificate of the mutation.
+func withClientCertificate(node *ClientCertificate) clientcertificateOption {
+	return func(m *ClientCertificateMutation) {
+		m.oldValue = func(context.Context) (*ClientCertificate, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -655,7 +1009,7 @@ func withDID(node *DID) didOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DIDMutation) Client() *Client {
+func (m ClientCertificateMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -663,7 +1017,7 @@ func (m DIDMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DIDMutation) Tx() (*Tx, error) {
+func (m ClientCertificateMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -673,14 +1027,14 @@ func (m DIDMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DID entities.
-func (m *DIDMutation) SetID(id string) {
+// operation is only accepted on creation of ClientCertificate entities.
+func (m *ClientCertificateMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DIDMutation) ID() (id string, exists bool) {
+func (m *ClientCertificateMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -691,7 +1045,7 @@ func (m *DIDMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DIDMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *ClientCertificateMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -700,200 +1054,200 @@ func (m *DIDMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DID.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ClientCertificate.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetMethod sets the "method" field.
-func (m *DIDMutation) SetMethod(s string) {
-	m.method = &s
+// SetUser sets the "user" field.
+func (m *ClientCertificateMutation) SetUser(s string) {
+	m.user = &s
 }
 
-// Method returns the value of the "method" field in the mutation.
-func (m *DIDMutation) Method() (r string, exists bool) {
-	v := m.method
+// User returns the value of the "user" field in the mutation.
+func (m *ClientCertificateMutation) User() (r string, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMethod returns the old "method" field's value of the DID entity.
-// If the DID object wasn't provided to the builder, the object is fetched from the database.
+// OldUser returns the old "user" field's value of the ClientCertificate entity.
+// If the ClientCertificate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DIDMutation) OldMethod(ctx context.Context) (v string, err error) {
+func (m *ClientCertificateMutation) OldUser(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMethod is only allowed on UpdateOne operations")
+		return v, errors.New("OldUser is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMethod requires an ID field in the mutation")
+		return v, errors.New("OldUser requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMethod: %w", err)
+		return v, fmt.Errorf("querying old value for OldUser: %w", err)
 	}
-	return oldValue.Method, nil
-}
-
-// ClearMethod clears the value of the "method" field.
-func (m *DIDMutation) ClearMethod() {
-	m.method = nil
-	m.clearedFields[did.FieldMethod] = struct{}{}
-}
-
-// MethodCleared returns if the "method" field was cleared in this mutation.
-func (m *DIDMutation) MethodCleared() bool {
-	_, ok := m.clearedFields[did.FieldMethod]
-	return ok
+	return oldValue.User, nil
 }
 
-// ResetMethod resets all changes to the "method" field.
-func (m *DIDMutation) ResetMethod() {
-	m.method = nil
-	delete(m.clearedFields, did.FieldMethod)
+// ResetUser resets all changes to the "user" field.
+func (m *ClientCertificateMutation) ResetUser() {
+	m.user = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *DIDMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetSubject sets the "subject" field.
+func (m *ClientCertificateMutation) SetSubject(s string) {
+	m.subject = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DIDMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Subject returns the value of the "subject" field in the mutation.
+func (m *ClientCertificateMutation) Subject() (r string, exists bool) {
+	v := m.subject
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the DID entity.
-// If the DID object wasn't provided to the builder, the object is fetched from the database.
+// OldSubject returns the old "subject" field's value of the ClientCertificate entity.
+// If the ClientCertificate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DIDMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ClientCertificateMutation) OldSubject(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldSubject requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Subject, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DIDMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearSubject clears the value of the "subject" field.
+func (m *ClientCertificateMutation) ClearSubject() {
+	m.subject = nil
+	m.clearedFields[clientcertificate.FieldSubject] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *DIDMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SubjectCleared returns if the "subject" field was cleared in this mutation.
+func (m *ClientCertificateMutation) SubjectCleared() bool {
+	_, ok := m.clearedFields[clientcertificate.FieldSubject]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *DIDMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetSubject resets all changes to the "subject" field.
+func (m *ClientCertificateMutation) ResetSubject() {
+	m.subject = nil
+	delete(m.clearedFields, clientcertificate.FieldSubject)
+}
+
+// SetNotAfter sets the "not_after" field.
+func (m *ClientCertificateMutation) SetNotAfter(t time.Time) {
+	m.not_after = &t
+}
+
+// NotAfter returns the value of the "not_after" field in the mutation.
+func (m *ClientCertificateMutation) NotAfter() (r time.Time, exists bool) {
+	v := m.not_after
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the DID entity.
-// If the DID object wasn't provided to the builder, the object is fetched from the database.
+// OldNotAfter returns the old "not_after" field's value of the ClientCertificate entity.
+// If the ClientCertificate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DIDMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ClientCertificateMutation) OldNotAfter(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldNotAfter is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldNotAfter requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldNotAfter: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *DIDMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *DIDMutation) SetUserID(id string) {
-	m.user = &id
+	return oldValue.NotAfter, nil
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *DIDMutation) ClearUser() {
-	m.cleareduser = true
+// ResetNotAfter resets all changes to the "not_after" field.
+func (m *ClientCertificateMutation) ResetNotAfter() {
+	m.not_after = nil
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *DIDMutation) UserCleared() bool {
-	return m.cleareduser
+// SetCreatedAt sets the "created_at" field.
+func (m *ClientCertificateMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *DIDMutation) UserID() (id string, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ClientCertificateMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *DIDMutation) UserIDs() (ids []string) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// OldCreatedAt returns the old "created_at" field's value of the ClientCertificate entity.
+// If the ClientCertificate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClientCertificateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *DIDMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ClientCertificateMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the DIDMutation builder.
-func (m *DIDMutation) Where(ps ...predicate.DID) {
+// Where appends a list predicates to the ClientCertificateMutation builder.
+func (m *ClientCertificateMutation) Where(ps ...predicate.ClientCertificate) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *DIDMutation) Op() Op {
+func (m *ClientCertificateMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (DID).
-func (m *DIDMutation) Type() string {
+// Type returns the node type of this mutation (ClientCertificate).
+func (m *ClientCertificateMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DIDMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.method != nil {
-		fields = append(fields, did.FieldMethod)
+func (m *ClientCertificateMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.user != nil {
+		fields = append(fields, clientcertificate.FieldUser)
 	}
-	if m.created_at != nil {
-		fields = append(fields, did.FieldCreatedAt)
+	if m.subject != nil {
+		fields = append(fields, clientcertificate.FieldSubject)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, did.FieldUpdatedAt)
+	if m.not_after != nil {
+		fields = append(fields, clientcertificate.FieldNotAfter)
+	}
+	if m.created_at != nil {
+		fields = append(fields, clientcertificate.FieldCreatedAt)
 	}
 	return fields
 }
@@ -901,14 +1255,16 @@ func (m *DIDMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DIDMutation) Field(name string) (ent.Value, bool) {
+func (m *ClientCertificateMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case did.FieldMethod:
-		return m.Method()
-	case did.FieldCreatedAt:
+	case clientcertificate.FieldUser:
+		return m.User()
+	case clientcertificate.FieldSubject:
+		return m.Subject()
+	case clientcertificate.FieldNotAfter:
+		return m.NotAfter()
+	case clientcertificate.FieldCreatedAt:
 		return m.CreatedAt()
-	case did.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -916,226 +1272,224 @@ func (m *DIDMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DIDMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ClientCertificateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case did.FieldMethod:
-		return m.OldMethod(ctx)
-	case did.FieldCreatedAt:
+	case clientcertificate.FieldUser:
+		return m.OldUser(ctx)
+	case clientcertificate.FieldSubject:
+		return m.OldSubject(ctx)
+	case clientcertificate.FieldNotAfter:
+		return m.OldNotAfter(ctx)
+	case clientcertificate.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case did.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown DID field %s", name)
+	return nil, fmt.Errorf("unknown ClientCertificate field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DIDMutation) SetField(name string, value ent.Value) error {
+func (m *ClientCertificateMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case did.FieldMethod:
+	case clientcertificate.FieldUser:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMethod(v)
+		m.SetUser(v)
 		return nil
-	case did.FieldCreatedAt:
+	case clientcertificate.FieldSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubject(v)
+		return nil
+	case clientcertificate.FieldNotAfter:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetNotAfter(v)
 		return nil
-	case did.FieldUpdatedAt:
+	case clientcertificate.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DID field %s", name)
+	return fmt.Errorf("unknown ClientCertificate field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DIDMutation) AddedFields() []string {
+func (m *ClientCertificateMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DIDMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ClientCertificateMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DIDMutation) AddField(name string, value ent.Value) error {
+func (m *ClientCertificateMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown DID numeric field %s", name)
+	return fmt.Errorf("unknown ClientCertificate numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DIDMutation) ClearedFields() []string {
+func (m *ClientCertificateMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(did.FieldMethod) {
-		fields = append(fields, did.FieldMethod)
+	if m.FieldCleared(clientcertificate.FieldSubject) {
+		fields = append(fields, clientcertificate.FieldSubject)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DIDMutation) FieldCleared(name string) bool {
+func (m *ClientCertificateMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DIDMutation) ClearField(name string) error {
+func (m *ClientCertificateMutation) ClearField(name string) error {
 	switch name {
-	case did.FieldMethod:
-		m.ClearMethod()
+	case clientcertificate.FieldSubject:
+		m.ClearSubject()
 		return nil
 	}
-	return fmt.Errorf("unknown DID nullable field %s", name)
+	return fmt.Errorf("unknown ClientCertificate nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DIDMutation) ResetField(name string) error {
+func (m *ClientCertificateMutation) ResetField(name string) error {
 	switch name {
-	case did.FieldMethod:
-		m.ResetMethod()
+	case clientcertificate.FieldUser:
+		m.ResetUser()
 		return nil
-	case did.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case clientcertificate.FieldSubject:
+		m.ResetSubject()
 		return nil
-	case did.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case clientcertificate.FieldNotAfter:
+		m.ResetNotAfter()
+		return nil
+	case clientcertificate.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown DID field %s", name)
+	return fmt.Errorf("unknown ClientCertificate field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DIDMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, did.EdgeUser)
-	}
+func (m *ClientCertificateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DIDMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case did.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *ClientCertificateMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DIDMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *ClientCertificateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DIDMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	}
+func (m *ClientCertificateMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DIDMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, did.EdgeUser)
-	}
+func (m *ClientCertificateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DIDMutation) EdgeCleared(name string) bool {
-	switch name {
-	case did.EdgeUser:
-		return m.cleareduser
-	}
+func (m *ClientCertificateMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DIDMutation) ClearEdge(name string) error {
-	switch name {
-	case did.EdgeUser:
-		m.ClearUser()
-		return nil
-	}
-	return fmt.Errorf("unknown DID unique edge %s", name)
+func (m *ClientCertificateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ClientCertificate unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DIDMutation) ResetEdge(name string) error {
-	switch name {
-	case did.EdgeUser:
-		m.ResetUser()
-		return nil
-	}
-	return fmt.Errorf("unknown DID edge %s", name)
+func (m *ClientCertificateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ClientCertificate edge %s", name)
 }
 
-// NaturalPersonMutation represents an operation that mutates the NaturalPerson nodes in the graph.
-type NaturalPersonMutation struct {
+// CredentialMutation represents an operation that mutates the Credential nodes in the graph.
+type CredentialMutation struct {
 	config
 	op                 Op
 	typ                string
 	id                 *string
-	name               *string
-	displayname        *string
 	_type              *string
-	password           *[]byte
+	raw                *[]uint8
+	status             *credential.Status
+	expires_at         *time.Time
+	revoked_at         *time.Time
+	renewal_due_at     *time.Time
+	renewal_started_at *time.Time
+	issuance_data      *map[string]interface{}
+	types              *[]string
+	credential_type    *string
+	issuer             *string
+	subject            *string
+	holder_email       *string
+	template_name      *string
+	issued_at          *time.Time
+	indexed_at         *time.Time
 	created_at         *time.Time
 	updated_at         *time.Time
 	clearedFields      map[string]struct{}
-	keys               map[string]struct{}
-	removedkeys        map[string]struct{}
-	clearedkeys        bool
-	credentials        map[string]struct{}
-	removedcredentials map[string]struct{}
-	clearedcredentials bool
+	account            *string
+	clearedaccount     bool
+	template           *string
+	clearedtemplate    bool
+	predecessor        *string
+	clearedpredecessor bool
+	successor          *string
+	clearedsuccessor   bool
 	done               bool
-	oldValue           func(context.Context) (*NaturalPerson, error)
-	predicates         []predicate.NaturalPerson
+	oldValue           func(context.Context) (*Credential, error)
+	predicates         []predicate.Credential
 }
 
-var _ ent.Mutation = (*NaturalPersonMutation)(nil)
+var _ ent.Mutation = (*CredentialMutation)(nil)
 
-// naturalpersonOption allows management of the mutation configuration using functional options.
-type naturalpersonOption func(*NaturalPersonMutation)
+// credentialOption allows management of the mutation configuration using functional options.
+type credentialOption func(*CredentialMutation)
 
-// newNaturalPersonMutation creates new mutation for the NaturalPerson entity.
-func newNaturalPersonMutation(c config, op Op, opts ...naturalpersonOption) *NaturalPersonMutation {
-	m := &NaturalPersonMutation{
+// newCredentialMutation creates new mutation for the Credential entity.
+func newCredentialMutation(c config, op Op, opts ...credentialOption) *CredentialMutation {
+	m := &CredentialMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNaturalPerson,
+		typ:           TypeCredential,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1144,20 +1498,20 @@ func newNaturalPersonMutation(c config, op Op, opts ...naturalpersonOption) *Nat
 	return m
 }
 
-// withNaturalPersonID sets the ID field of the mutation.
-func withNaturalPersonID(id string) naturalpersonOption {
-	return func(m *NaturalPersonMutation) {
+// withCredentialID sets the ID field of the mutation.
+func withCredentialID(id string) credentialOption {
+	return func(m *CredentialMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *NaturalPerson
+			value *Credential
 		)
-		m.oldValue = func(ctx context.Context) (*NaturalPerson, error) {
+		m.oldValue = func(ctx context.Context) (*Credential, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().NaturalPerson.Get(ctx, id)
+					value, err = m.Client().Credential.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1166,10 +1520,10 @@ func withNaturalPersonID(id string) naturalpersonOption {
 	}
 }
 
-// withNaturalPerson sets the old NaturalPerson of the mutation.
-func withNaturalPerson(node *NaturalPerson) naturalpersonOption {
-	return func(m *NaturalPersonMutation) {
-		m.oldValue = func(context.Context) (*NaturalPerson, error) {
+// withCredential sets the old Credential of the mutation.
+func withCredential(node *Credential) credentialOption {
+	return func(m *CredentialMutation) {
+		m.oldValue = func(context.Context) (*Credential, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1178,7 +1532,7 @@ func withNaturalPerson(node *NaturalPerson) naturalpersonOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NaturalPersonMutation) Client() *Client {
+func (m CredentialMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1186,7 +1540,7 @@ func (m NaturalPersonMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NaturalPersonMutation) Tx() (*Tx, error) {
+func (m CredentialMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1196,14 +1550,14 @@ func (m NaturalPersonMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of NaturalPerson entities.
-func (m *NaturalPersonMutation) SetID(id string) {
+// operation is only accepted on creation of Credential entities.
+func (m *CredentialMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *NaturalPersonMutation) ID() (id string, exists bool) {
+func (m *CredentialMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1214,7 +1568,7 @@ func (m *NaturalPersonMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *NaturalPersonMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *CredentialMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1223,386 +1577,8328 @@ func (m *NaturalPersonMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().NaturalPerson.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Credential.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *NaturalPersonMutation) SetName(s string) {
-	m.name = &s
+// SetType sets the "type" field.
+func (m *CredentialMutation) SetType(s string) {
+	m._type = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *NaturalPersonMutation) Name() (r string, exists bool) {
-	v := m.name
+// GetType returns the value of the "type" field in the mutation.
+func (m *CredentialMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the NaturalPerson entity.
-// If the NaturalPerson object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NaturalPersonMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *CredentialMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Type, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *NaturalPersonMutation) ResetName() {
-	m.name = nil
+// ResetType resets all changes to the "type" field.
+func (m *CredentialMutation) ResetType() {
+	m._type = nil
 }
 
-// SetDisplayname sets the "displayname" field.
-func (m *NaturalPersonMutation) SetDisplayname(s string) {
-	m.displayname = &s
+// SetRaw sets the "raw" field.
+func (m *CredentialMutation) SetRaw(u []uint8) {
+	m.raw = &u
 }
 
-// Displayname returns the value of the "displayname" field in the mutation.
-func (m *NaturalPersonMutation) Displayname() (r string, exists bool) {
-	v := m.displayname
+// Raw returns the value of the "raw" field in the mutation.
+func (m *CredentialMutation) Raw() (r []uint8, exists bool) {
+	v := m.raw
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDisplayname returns the old "displayname" field's value of the NaturalPerson entity.
-// If the NaturalPerson object wasn't provided to the builder, the object is fetched from the database.
+// OldRaw returns the old "raw" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NaturalPersonMutation) OldDisplayname(ctx context.Context) (v string, err error) {
+func (m *CredentialMutation) OldRaw(ctx context.Context) (v []uint8, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayname is only allowed on UpdateOne operations")
+		return v, errors.New("OldRaw is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayname requires an ID field in the mutation")
+		return v, errors.New("OldRaw requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayname: %w", err)
+		return v, fmt.Errorf("querying old value for OldRaw: %w", err)
 	}
-	return oldValue.Displayname, nil
-}
-
-// ClearDisplayname clears the value of the "displayname" field.
-func (m *NaturalPersonMutation) ClearDisplayname() {
-	m.displayname = nil
-	m.clearedFields[naturalperson.FieldDisplayname] = struct{}{}
-}
-
-// DisplaynameCleared returns if the "displayname" field was cleared in this mutation.
-func (m *NaturalPersonMutation) DisplaynameCleared() bool {
-	_, ok := m.clearedFields[naturalperson.FieldDisplayname]
-	return ok
+	return oldValue.Raw, nil
 }
 
-// ResetDisplayname resets all changes to the "displayname" field.
-func (m *NaturalPersonMutation) ResetDisplayname() {
-	m.displayname = nil
-	delete(m.clearedFields, naturalperson.FieldDisplayname)
+// ResetRaw resets all changes to the "raw" field.
+func (m *CredentialMutation) ResetRaw() {
+	m.raw = nil
 }
 
-// SetType sets the "type" field.
-func (m *NaturalPersonMutation) SetType(s string) {
-	m._type = &s
+// SetStatus sets the "status" field.
+func (m *CredentialMutation) SetStatus(c credential.Status) {
+	m.status = &c
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *NaturalPersonMutation) GetType() (r string, exists bool) {
-	v := m._type
+// Status returns the value of the "status" field in the mutation.
+func (m *CredentialMutation) Status() (r credential.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the NaturalPerson entity.
-// If the NaturalPerson object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NaturalPersonMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *CredentialMutation) OldStatus(ctx context.Context) (v credential.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.Status, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *NaturalPersonMutation) ResetType() {
-	m._type = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *CredentialMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetPassword sets the "password" field.
-func (m *NaturalPersonMutation) SetPassword(b []byte) {
-	m.password = &b
+// SetExpiresAt sets the "expires_at" field.
+func (m *CredentialMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *NaturalPersonMutation) Password() (r []byte, exists bool) {
-	v := m.password
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *CredentialMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPassword returns the old "password" field's value of the NaturalPerson entity.
-// If the NaturalPerson object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NaturalPersonMutation) OldPassword(ctx context.Context) (v []byte, err error) {
+func (m *CredentialMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.Password, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *NaturalPersonMutation) ResetPassword() {
-	m.password = nil
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *CredentialMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[credential.FieldExpiresAt] = struct{}{}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *NaturalPersonMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *CredentialMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[credential.FieldExpiresAt]
+	return ok
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *NaturalPersonMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *CredentialMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, credential.FieldExpiresAt)
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *CredentialMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *CredentialMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the NaturalPerson entity.
-// If the NaturalPerson object wasn't provided to the builder, the object is fetched from the database.
+// OldRevokedAt returns the old "revoked_at" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NaturalPersonMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CredentialMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.RevokedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *NaturalPersonMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *CredentialMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[credential.FieldRevokedAt] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *NaturalPersonMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *CredentialMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[credential.FieldRevokedAt]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *NaturalPersonMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *CredentialMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, credential.FieldRevokedAt)
+}
+
+// SetRenewalDueAt sets the "renewal_due_at" field.
+func (m *CredentialMutation) SetRenewalDueAt(t time.Time) {
+	m.renewal_due_at = &t
+}
+
+// RenewalDueAt returns the value of the "renewal_due_at" field in the mutation.
+func (m *CredentialMutation) RenewalDueAt() (r time.Time, exists bool) {
+	v := m.renewal_due_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the NaturalPerson entity.
-// If the NaturalPerson object wasn't provided to the builder, the object is fetched from the database.
+// OldRenewalDueAt returns the old "renewal_due_at" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NaturalPersonMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CredentialMutation) OldRenewalDueAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRenewalDueAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldRenewalDueAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRenewalDueAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.RenewalDueAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *NaturalPersonMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearRenewalDueAt clears the value of the "renewal_due_at" field.
+func (m *CredentialMutation) ClearRenewalDueAt() {
+	m.renewal_due_at = nil
+	m.clearedFields[credential.FieldRenewalDueAt] = struct{}{}
 }
 
-// AddKeyIDs adds the "keys" edge to the PrivateKey entity by ids.
-func (m *NaturalPersonMutation) AddKeyIDs(ids ...string) {
-	if m.keys == nil {
-		m.keys = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.keys[ids[i]] = struct{}{}
-	}
+// RenewalDueAtCleared returns if the "renewal_due_at" field was cleared in this mutation.
+func (m *CredentialMutation) RenewalDueAtCleared() bool {
+	_, ok := m.clearedFields[credential.FieldRenewalDueAt]
+	return ok
 }
 
-// ClearKeys clears the "keys" edge to the PrivateKey entity.
-func (m *NaturalPersonMutation) ClearKeys() {
-	m.clearedkeys = true
+// ResetRenewalDueAt resets all changes to the "renewal_due_at" field.
+func (m *CredentialMutation) ResetRenewalDueAt() {
+	m.renewal_due_at = nil
+	delete(m.clearedFields, credential.FieldRenewalDueAt)
 }
 
-// KeysCleared reports if the "keys" edge to the PrivateKey entity was cleared.
-func (m *NaturalPersonMutation) KeysCleared() bool {
-	return m.clearedkeys
+// SetRenewalStartedAt sets the "renewal_started_at" field.
+func (m *CredentialMutation) SetRenewalStartedAt(t time.Time) {
+	m.renewal_started_at = &t
 }
 
-// RemoveKeyIDs removes the "keys" edge to the PrivateKey entity by IDs.
-func (m *NaturalPersonMutation) RemoveKeyIDs(ids ...string) {
-	if m.removedkeys == nil {
-		m.removedkeys = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.keys, ids[i])
-		m.removedkeys[ids[i]] = struct{}{}
+// RenewalStartedAt returns the value of the "renewal_started_at" field in the mutation.
+func (m *CredentialMutation) RenewalStartedAt() (r time.Time, exists bool) {
+	v := m.renewal_started_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedKeys returns the removed IDs of the "keys" edge to the PrivateKey entity.
-func (m *NaturalPersonMutation) RemovedKeysIDs() (ids []string) {
-	for id := range m.removedkeys {
-		ids = append(ids, id)
+// OldRenewalStartedAt returns the old "renewal_started_at" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialMutation) OldRenewalStartedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRenewalStartedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// KeysIDs returns the "keys" edge IDs in the mutation.
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRenewalStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRenewalStartedAt: %w", err)
+	}
+	return oldValue.RenewalStartedAt, nil
+}
+
+// ClearRenewalStartedAt clears the value of the "renewal_started_at" field.
+func (m *CredentialMutation) ClearRenewalStartedAt() {
+	m.renewal_started_at = nil
+	m.clearedFields[credential.FieldRenewalStartedAt] = struct{}{}
+}
+
+// RenewalStartedAtCleared returns if the "renewal_started_at" field was cleared in this mutation.
+func (m *CredentialMutation) RenewalStartedAtCleared() bool {
+	_, ok := m.clearedFields[credential.FieldRenewalStartedAt]
+	return ok
+}
+
+// ResetRenewalStartedAt resets all changes to the "renewal_started_at" field.
+func (m *CredentialMutation) ResetRenewalStartedAt() {
+	m.renewal_started_at = nil
+	delete(m.clearedFields, credential.FieldRenewalStartedAt)
+}
+
+// SetIssuanceData sets the "issuance_data" field.
+func (m *CredentialMutation) SetIssuanceData(value map[string]interface{}) {
+	m.issuance_data = &value
+}
+
+// IssuanceData returns the value of the "issuance_data" field in the mutation.
+func (m *CredentialMutation) IssuanceData() (r map[string]interface{}, exists bool) {
+	v := m.issuance_data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssuanceData returns the old "issuance_data" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialMutation) OldIssuanceData(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssuanceData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssuanceData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssuanceData: %w", err)
+	}
+	return oldValue.IssuanceData, nil
+}
+
+// ClearIssuanceData clears the value of the "issuance_data" field.
+func (m *CredentialMutation) ClearIssuanceData() {
+	m.issuance_data = nil
+	m.clearedFields[credential.FieldIssuanceData] = struct{}{}
+}
+
+// IssuanceDataCleared returns if the "issuance_data" field was cleared in this mutation.
+func (m *CredentialMutation) IssuanceDataCleared() bool {
+	_, ok := m.clearedFields[credential.FieldIssuanceData]
+	return ok
+}
+
+// ResetIssuanceData resets all changes to the "issuance_data" field.
+func (m *CredentialMutation) ResetIssuanceData() {
+	m.issuance_data = nil
+	delete(m.clearedFields, credential.FieldIssuanceData)
+}
+
+// SetTypes sets the "types" field.
+func (m *CredentialMutation) SetTypes(s []string) {
+	m.types = &s
+}
+
+// Types returns the value of the "types" field in the mutation.
+func (m *CredentialMutation) Types() (r []string, exists bool) {
+	v := m.types
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTypes returns the old "types" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialMutation) OldTypes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTypes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTypes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTypes: %w", err)
+	}
+	return oldValue.Types, nil
+}
+
+// ClearTypes clears the value of the "types" field.
+func (m *CredentialMutation) ClearTypes() {
+	m.types = nil
+	m.clearedFields[credential.FieldTypes] = struct{}{}
+}
+
+// TypesCleared returns if the "types" field was cleared in this mutation.
+func (m *CredentialMutation) TypesCleared() bool {
+	_, ok := m.clearedFields[credential.FieldTypes]
+	return ok
+}
+
+// ResetTypes resets all changes to the "types" field.
+func (m *CredentialMutation) ResetTypes() {
+	m.types = nil
+	delete(m.clearedFields, credential.FieldTypes)
+}
+
+// SetCredentialType sets the "credential_type" field.
+func (m *CredentialMutation) SetCredentialType(s string) {
+	m.credential_type = &s
+}
+
+// CredentialType returns the value of the "credential_type" field in the mutation.
+func (m *CredentialMutation) CredentialType() (r string, exists bool) {
+	v := m.credential_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCredentialType returns the old "credential_type" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialMutation) OldCredentialType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCredentialType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCredentialType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCredentialType: %w", err)
+	}
+	return oldValue.CredentialType, nil
+}
+
+// ClearCredentialType clears the value of the "credential_type" field.
+func (m *CredentialMutation) ClearCredentialType() {
+	m.credential_type = nil
+	m.clearedFields[credential.FieldCredentialType] = struct{}{}
+}
+
+// CredentialTypeCleared returns if the "credential_type" field was cleared in this mutation.
+func (m *CredentialMutation) CredentialTypeCleared() bool {
+	_, ok := m.clearedFields[credential.FieldCredentialType]
+	return ok
+}
+
+// ResetCredentialType resets all changes to the "credential_type" field.
+func (m *CredentialMutation) ResetCredentialType() {
+	m.credential_type = nil
+	delete(m.clearedFields, credential.FieldCredentialType)
+}
+
+// SetIssuer sets the "issuer" field.
+func (m *CredentialMutation) SetIssuer(s string) {
+	m.issuer = &s
+}
+
+// Issuer returns the value of the "issuer" field in the mutation.
+func (m *CredentialMutation) Issuer() (r string, exists bool) {
+	v := m.issuer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssuer returns the old "issuer" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialMutation) OldIssuer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssuer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssuer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssuer: %w", err)
+	}
+	return oldValue.Issuer, nil
+}
+
+// ClearIssuer clears the value of the "issuer" field.
+func (m *CredentialMutation) ClearIssuer() {
+	m.issuer = nil
+	m.clearedFields[credential.FieldIssuer] = struct{}{}
+}
+
+// IssuerCleared returns if the "issuer" field was cleared in this mutation.
+func (m *CredentialMutation) IssuerCleared() bool {
+	_, ok := m.clearedFields[credential.FieldIssuer]
+	return ok
+}
+
+// ResetIssuer resets all changes to the "issuer" field.
+func (m *CredentialMutation) ResetIssuer() {
+	m.issuer = nil
+	delete(m.clearedFields, credential.FieldIssuer)
+}
+
+// SetSubject sets the "subject" field.
+func (m *CredentialMutation) SetSubject(s string) {
+	m.subject = &s
+}
+
+// Subject returns the value of the "subject" field in the mutation.
+func (m *CredentialMutation) Subject() (r string, exists bool) {
+	v := m.subject
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubject returns the old "subject" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialMutation) OldSubject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
+	}
+	return oldValue.Subject, nil
+}
+
+// ClearSubject clears the value of the "subject" field.
+func (m *CredentialMutation) ClearSubject() {
+	m.subject = nil
+	m.clearedFields[credential.FieldSubject] = struct{}{}
+}
+
+// SubjectCleared returns if the "subject" field was cleared in this mutation.
+func (m *CredentialMutation) SubjectCleared() bool {
+	_, ok := m.clearedFields[credential.FieldSubject]
+	return ok
+}
+
+// ResetSubject resets all changes to the "subject" field.
+func (m *CredentialMutation) ResetSubject() {
+	m.subject = nil
+	delete(m.clearedFields, credential.FieldSubject)
+}
+
+// SetHolderEmail sets the "holder_email" field.
+func (m *CredentialMutation) SetHolderEmail(s string) {
+	m.holder_email = &s
+}
+
+// HolderEmail returns the value of the "holder_email" field in the mutation.
+func (m *CredentialMutation) HolderEmail() (r string, exists bool) {
+	v := m.holder_email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHolderEmail returns the old "holder_email" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialMutation) OldHolderEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHolderEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHolderEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHolderEmail: %w", err)
+	}
+	return oldValue.HolderEmail, nil
+}
+
+// ClearHolderEmail clears the value of the "holder_email" field.
+func (m *CredentialMutation) ClearHolderEmail() {
+	m.holder_email = nil
+	m.clearedFields[credential.FieldHolderEmail] = struct{}{}
+}
+
+// HolderEmailCleared returns if the "holder_email" field was cleared in this mutation.
+func (m *CredentialMutation) HolderEmailCleared() bool {
+	_, ok := m.clearedFields[credential.FieldHolderEmail]
+	return ok
+}
+
+// ResetHolderEmail resets all changes to the "holder_email" field.
+func (m *CredentialMutation) ResetHolderEmail() {
+	m.holder_email = nil
+	delete(m.clearedFields, credential.FieldHolderEmail)
+}
+
+// SetTemplateName sets the "template_name" field.
+func (m *CredentialMutation) SetTemplateName(s string) {
+	m.template_name = &s
+}
+
+// TemplateName returns the value of the "template_name" field in the mutation.
+func (m *CredentialMutation) TemplateName() (r string, exists bool) {
+	v := m.template_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTemplateName returns the old "template_name" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialMutation) OldTemplateName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTemplateName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTemplateName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTemplateName: %w", err)
+	}
+	return oldValue.TemplateName, nil
+}
+
+// ClearTemplateName clears the value of the "template_name" field.
+func (m *CredentialMutation) ClearTemplateName() {
+	m.template_name = nil
+	m.clearedFields[credential.FieldTemplateName] = struct{}{}
+}
+
+// TemplateNameCleared returns if the "template_name" field was cleared in this mutation.
+func (m *CredentialMutation) TemplateNameCleared() bool {
+	_, ok := m.clearedFields[credential.FieldTemplateName]
+	return ok
+}
+
+// ResetTemplateName resets all changes to the "template_name" field.
+func (m *CredentialMutation) ResetTemplateName() {
+	m.template_name = nil
+	delete(m.clearedFields, credential.FieldTemplateName)
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (m *CredentialMutation) SetIssuedAt(t time.Time) {
+	m.issued_at = &t
+}
+
+// IssuedAt returns the value of the "issued_at" field in the mutation.
+func (m *CredentialMutation) IssuedAt() (r time.Time, exists bool) {
+	v := m.issued_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssuedAt returns the old "issued_at" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialMutation) OldIssuedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssuedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssuedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssuedAt: %w", err)
+	}
+	return oldValue.IssuedAt, nil
+}
+
+// ClearIssuedAt clears the value of the "issued_at" field.
+func (m *CredentialMutation) ClearIssuedAt() {
+	m.issued_at = nil
+	m.clearedFields[credential.FieldIssuedAt] = struct{}{}
+}
+
+// IssuedAtCleared returns if the "issued_at" field was cleared in this mutation.
+func (m *CredentialMutation) IssuedAtCleared() bool {
+	_, ok := m.clearedFields[credential.FieldIssuedAt]
+	return ok
+}
+
+// ResetIssuedAt resets all changes to the "issued_at" field.
+func (m *CredentialMutation) ResetIssuedAt() {
+	m.issued_at = nil
+	delete(m.clearedFields, credential.FieldIssuedAt)
+}
+
+// SetIndexedAt sets the "indexed_at" field.
+func (m *CredentialMutation) SetIndexedAt(t time.Time) {
+	m.indexed_at = &t
+}
+
+// IndexedAt returns the value of the "indexed_at" field in the mutation.
+func (m *CredentialMutation) IndexedAt() (r time.Time, exists bool) {
+	v := m.indexed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIndexedAt returns the old "indexed_at" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialMutation) OldIndexedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIndexedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIndexedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIndexedAt: %w", err)
+	}
+	return oldValue.IndexedAt, nil
+}
+
+// ClearIndexedAt clears the value of the "indexed_at" field.
+func (m *CredentialMutation) ClearIndexedAt() {
+	m.indexed_at = nil
+	m.clearedFields[credential.FieldIndexedAt] = struct{}{}
+}
+
+// IndexedAtCleared returns if the "indexed_at" field was cleared in this mutation.
+func (m *CredentialMutation) IndexedAtCleared() bool {
+	_, ok := m.clearedFields[credential.FieldIndexedAt]
+	return ok
+}
+
+// ResetIndexedAt resets all changes to the "indexed_at" field.
+func (m *CredentialMutation) ResetIndexedAt() {
+	m.indexed_at = nil
+	delete(m.clearedFields, credential.FieldIndexedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CredentialMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CredentialMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CredentialMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CredentialMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CredentialMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Credential entity.
+// If the Credential object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CredentialMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetAccountID sets the "account" edge to the User entity by id.
+func (m *CredentialMutation) SetAccountID(id string) {
+	m.account = &id
+}
+
+// ClearAccount clears the "account" edge to the User entity.
+func (m *CredentialMutation) ClearAccount() {
+	m.clearedaccount = true
+}
+
+// AccountCleared reports if the "account" edge to the User entity was cleared.
+func (m *CredentialMutation) AccountCleared() bool {
+	return m.clearedaccount
+}
+
+// AccountID returns the "account" edge ID in the mutation.
+func (m *CredentialMutation) AccountID() (id string, exists bool) {
+	if m.account != nil {
+		return *m.account, true
+	}
+	return
+}
+
+// AccountIDs returns the "account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AccountID instead. It exists only for internal usage by the builders.
+func (m *CredentialMutation) AccountIDs() (ids []string) {
+	if id := m.account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAccount resets all changes to the "account" edge.
+func (m *CredentialMutation) ResetAccount() {
+	m.account = nil
+	m.clearedaccount = false
+}
+
+// SetTemplateID sets the "template" edge to the CredentialTemplate entity by id.
+func (m *CredentialMutation) SetTemplateID(id string) {
+	m.template = &id
+}
+
+// ClearTemplate clears the "template" edge to the CredentialTemplate entity.
+func (m *CredentialMutation) ClearTemplate() {
+	m.clearedtemplate = true
+}
+
+// TemplateCleared reports if the "template" edge to the CredentialTemplate entity was cleared.
+func (m *CredentialMutation) TemplateCleared() bool {
+	return m.clearedtemplate
+}
+
+// TemplateID returns the "template" edge ID in the mutation.
+func (m *CredentialMutation) TemplateID() (id string, exists bool) {
+	if m.template != nil {
+		return *m.template, true
+	}
+	return
+}
+
+// TemplateIDs returns the "template" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TemplateID instead. It exists only for internal usage by the builders.
+func (m *CredentialMutation) TemplateIDs() (ids []string) {
+	if id := m.template; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTemplate resets all changes to the "template" edge.
+func (m *CredentialMutation) ResetTemplate() {
+	m.template = nil
+	m.clearedtemplate = false
+}
+
+// SetPredecessorID sets the "predecessor" edge to the Credential entity by id.
+func (m *CredentialMutation) SetPredecessorID(id string) {
+	m.predecessor = &id
+}
+
+// ClearPredecessor clears the "predecessor" edge to the Credential entity.
+func (m *CredentialMutation) ClearPredecessor() {
+	m.clearedpredecessor = true
+}
+
+// PredecessorCleared reports if the "predecessor" edge to the Credential entity was cleared.
+func (m *CredentialMutation) PredecessorCleared() bool {
+	return m.clearedpredecessor
+}
+
+// PredecessorID returns the "predecessor" edge ID in the mutation.
+func (m *CredentialMutation) PredecessorID() (id string, exists bool) {
+	if m.predecessor != nil {
+		return *m.predecessor, true
+	}
+	return
+}
+
+// PredecessorIDs returns the "predecessor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PredecessorID instead. It exists only for internal usage by the builders.
+func (m *CredentialMutation) PredecessorIDs() (ids []string) {
+	if id := m.predecessor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPredecessor resets all changes to the "predecessor" edge.
+func (m *CredentialMutation) ResetPredecessor() {
+	m.predecessor = nil
+	m.clearedpredecessor = false
+}
+
+// SetSuccessorID sets the "successor" edge to the Credential entity by id.
+func (m *CredentialMutation) SetSuccessorID(id string) {
+	m.successor = &id
+}
+
+// ClearSuccessor clears the "successor" edge to the Credential entity.
+func (m *CredentialMutation) ClearSuccessor() {
+	m.clearedsuccessor = true
+}
+
+// SuccessorCleared reports if the "successor" edge to the Credential entity was cleared.
+func (m *CredentialMutation) SuccessorCleared() bool {
+	return m.clearedsuccessor
+}
+
+// SuccessorID returns the "successor" edge ID in the mutation.
+func (m *CredentialMutation) SuccessorID() (id string, exists bool) {
+	if m.successor != nil {
+		return *m.successor, true
+	}
+	return
+}
+
+// SuccessorIDs returns the "successor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SuccessorID instead. It exists only for internal usage by the builders.
+func (m *CredentialMutation) SuccessorIDs() (ids []string) {
+	if id := m.successor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSuccessor resets all changes to the "successor" edge.
+func (m *CredentialMutation) ResetSuccessor() {
+	m.successor = nil
+	m.clearedsuccessor = false
+}
+
+// Where appends a list predicates to the CredentialMutation builder.
+func (m *CredentialMutation) Where(ps ...predicate.Credential) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *CredentialMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Credential).
+func (m *CredentialMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CredentialMutation) Fields() []string {
+	fields := make([]string, 0, 18)
+	if m._type != nil {
+		fields = append(fields, credential.FieldType)
+	}
+	if m.raw != nil {
+		fields = append(fields, credential.FieldRaw)
+	}
+	if m.status != nil {
+		fields = append(fields, credential.FieldStatus)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, credential.FieldExpiresAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, credential.FieldRevokedAt)
+	}
+	if m.renewal_due_at != nil {
+		fields = append(fields, credential.FieldRenewalDueAt)
+	}
+	if m.renewal_started_at != nil {
+		fields = append(fields, credential.FieldRenewalStartedAt)
+	}
+	if m.issuance_data != nil {
+		fields = append(fields, credential.FieldIssuanceData)
+	}
+	if m.types != nil {
+		fields = append(fields, credential.FieldTypes)
+	}
+	if m.credential_type != nil {
+		fields = append(fields, credential.FieldCredentialType)
+	}
+	if m.issuer != nil {
+		fields = append(fields, credential.FieldIssuer)
+	}
+	if m.subject != nil {
+		fields = append(fields, credential.FieldSubject)
+	}
+	if m.holder_email != nil {
+		fields = append(fields, credential.FieldHolderEmail)
+	}
+	if m.template_name != nil {
+		fields = append(fields, credential.FieldTemplateName)
+	}
+	if m.issued_at != nil {
+		fields = append(fields, credential.FieldIssuedAt)
+	}
+	if m.indexed_at != nil {
+		fields = append(fields, credential.FieldIndexedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, credential.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, credential.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CredentialMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case credential.FieldType:
+		return m.GetType()
+	case credential.FieldRaw:
+		return m.Raw()
+	case credential.FieldStatus:
+		return m.Status()
+	case credential.FieldExpiresAt:
+		return m.ExpiresAt()
+	case credential.FieldRevokedAt:
+		return m.RevokedAt()
+	case credential.FieldRenewalDueAt:
+		return m.RenewalDueAt()
+	case credential.FieldRenewalStartedAt:
+		return m.RenewalStartedAt()
+	case credential.FieldIssuanceData:
+		return m.IssuanceData()
+	case credential.FieldTypes:
+		return m.Types()
+	case credential.FieldCredentialType:
+		return m.CredentialType()
+	case credential.FieldIssuer:
+		return m.Issuer()
+	case credential.FieldSubject:
+		return m.Subject()
+	case credential.FieldHolderEmail:
+		return m.HolderEmail()
+	case credential.FieldTemplateName:
+		return m.TemplateName()
+	case credential.FieldIssuedAt:
+		return m.IssuedAt()
+	case credential.FieldIndexedAt:
+		return m.IndexedAt()
+	case credential.FieldCreatedAt:
+		return m.CreatedAt()
+	case credential.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CredentialMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case credential.FieldType:
+		return m.OldType(ctx)
+	case credential.FieldRaw:
+		return m.OldRaw(ctx)
+	case credential.FieldStatus:
+		return m.OldStatus(ctx)
+	case credential.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case credential.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case credential.FieldRenewalDueAt:
+		return m.OldRenewalDueAt(ctx)
+	case credential.FieldRenewalStartedAt:
+		return m.OldRenewalStartedAt(ctx)
+	case credential.FieldIssuanceData:
+		return m.OldIssuanceData(ctx)
+	case credential.FieldTypes:
+		return m.OldTypes(ctx)
+	case credential.FieldCredentialType:
+		return m.OldCredentialType(ctx)
+	case credential.FieldIssuer:
+		return m.OldIssuer(ctx)
+	case credential.FieldSubject:
+		return m.OldSubject(ctx)
+	case credential.FieldHolderEmail:
+		return m.OldHolderEmail(ctx)
+	case credential.FieldTemplateName:
+		return m.OldTemplateName(ctx)
+	case credential.FieldIssuedAt:
+		return m.OldIssuedAt(ctx)
+	case credential.FieldIndexedAt:
+		return m.OldIndexedAt(ctx)
+	case credential.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case credential.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Credential field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CredentialMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case credential.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case credential.FieldRaw:
+		v, ok := value.([]uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRaw(v)
+		return nil
+	case credential.FieldStatus:
+		v, ok := value.(credential.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case credential.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case credential.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	case credential.FieldRenewalDueAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRenewalDueAt(v)
+		return nil
+	case credential.FieldRenewalStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRenewalStartedAt(v)
+		return nil
+	case credential.FieldIssuanceData:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssuanceData(v)
+		return nil
+	case credential.FieldTypes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTypes(v)
+		return nil
+	case credential.FieldCredentialType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCredentialType(v)
+		return nil
+	case credential.FieldIssuer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssuer(v)
+		return nil
+	case credential.FieldSubject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubject(v)
+		return nil
+	case credential.FieldHolderEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHolderEmail(v)
+		return nil
+	case credential.FieldTemplateName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTemplateName(v)
+		return nil
+	case credential.FieldIssuedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssuedAt(v)
+		return nil
+	case credential.FieldIndexedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIndexedAt(v)
+		return nil
+	case credential.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case credential.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Credential field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CredentialMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CredentialMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CredentialMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Credential numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CredentialMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(credential.FieldExpiresAt) {
+		fields = append(fields, credential.FieldExpiresAt)
+	}
+	if m.FieldCleared(credential.FieldRevokedAt) {
+		fields = append(fields, credential.FieldRevokedAt)
+	}
+	if m.FieldCleared(credential.FieldRenewalDueAt) {
+		fields = append(fields, credential.FieldRenewalDueAt)
+	}
+	if m.FieldCleared(credential.FieldRenewalStartedAt) {
+		fields = append(fields, credential.FieldRenewalStartedAt)
+	}
+	if m.FieldCleared(credential.FieldIssuanceData) {
+		fields = append(fields, credential.FieldIssuanceData)
+	}
+	if m.FieldCleared(credential.FieldTypes) {
+		fields = append(fields, credential.FieldTypes)
+	}
+	if m.FieldCleared(credential.FieldCredentialType) {
+		fields = append(fields, credential.FieldCredentialType)
+	}
+	if m.FieldCleared(credential.FieldIssuer) {
+		fields = append(fields, credential.FieldIssuer)
+	}
+	if m.FieldCleared(credential.FieldSubject) {
+		fields = append(fields, credential.FieldSubject)
+	}
+	if m.FieldCleared(credential.FieldHolderEmail) {
+		fields = append(fields, credential.FieldHolderEmail)
+	}
+	if m.FieldCleared(credential.FieldTemplateName) {
+		fields = append(fields, credential.FieldTemplateName)
+	}
+	if m.FieldCleared(credential.FieldIssuedAt) {
+		fields = append(fields, credential.FieldIssuedAt)
+	}
+	if m.FieldCleared(credential.FieldIndexedAt) {
+		fields = append(fields, credential.FieldIndexedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CredentialMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CredentialMutation) ClearField(name string) error {
+	switch name {
+	case credential.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case credential.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	case credential.FieldRenewalDueAt:
+		m.ClearRenewalDueAt()
+		return nil
+	case credential.FieldRenewalStartedAt:
+		m.ClearRenewalStartedAt()
+		return nil
+	case credential.FieldIssuanceData:
+		m.ClearIssuanceData()
+		return nil
+	case credential.FieldTypes:
+		m.ClearTypes()
+		return nil
+	case credential.FieldCredentialType:
+		m.ClearCredentialType()
+		return nil
+	case credential.FieldIssuer:
+		m.ClearIssuer()
+		return nil
+	case credential.FieldSubject:
+		m.ClearSubject()
+		return nil
+	case credential.FieldHolderEmail:
+		m.ClearHolderEmail()
+		return nil
+	case credential.FieldTemplateName:
+		m.ClearTemplateName()
+		return nil
+	case credential.FieldIssuedAt:
+		m.ClearIssuedAt()
+		return nil
+	case credential.FieldIndexedAt:
+		m.ClearIndexedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Credential nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CredentialMutation) ResetField(name string) error {
+	switch name {
+	case credential.FieldType:
+		m.ResetType()
+		return nil
+	case credential.FieldRaw:
+		m.ResetRaw()
+		return nil
+	case credential.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case credential.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case credential.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case credential.FieldRenewalDueAt:
+		m.ResetRenewalDueAt()
+		return nil
+	case credential.FieldRenewalStartedAt:
+		m.ResetRenewalStartedAt()
+		return nil
+	case credential.FieldIssuanceData:
+		m.ResetIssuanceData()
+		return nil
+	case credential.FieldTypes:
+		m.ResetTypes()
+		return nil
+	case credential.FieldCredentialType:
+		m.ResetCredentialType()
+		return nil
+	case credential.FieldIssuer:
+		m.ResetIssuer()
+		return nil
+	case credential.FieldSubject:
+		m.ResetSubject()
+		return nil
+	case credential.FieldHolderEmail:
+		m.ResetHolderEmail()
+		return nil
+	case credential.FieldTemplateName:
+		m.ResetTemplateName()
+		return nil
+	case credential.FieldIssuedAt:
+		m.ResetIssuedAt()
+		return nil
+	case credential.FieldIndexedAt:
+		m.ResetIndexedAt()
+		return nil
+	case credential.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case credential.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Credential field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CredentialMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.account != nil {
+		edges = append(edges, credential.EdgeAccount)
+	}
+	if m.template != nil {
+		edges = append(edges, credential.EdgeTemplate)
+	}
+	if m.predecessor != nil {
+		edges = append(edges, credential.EdgePredecessor)
+	}
+	if m.successor != nil {
+		edges = append(edges, credential.EdgeSuccessor)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CredentialMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case credential.EdgeAccount:
+		if id := m.account; id != nil {
+			return []ent.Value{*id}
+		}
+	case credential.EdgeTemplate:
+		if id := m.template; id != nil {
+			return []ent.Value{*id}
+		}
+	case credential.EdgePredecessor:
+		if id := m.predecessor; id != nil {
+			return []ent.Value{*id}
+		}
+	case credential.EdgeSuccessor:
+		if id := m.successor; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CredentialMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CredentialMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CredentialMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedaccount {
+		edges = append(edges, credential.EdgeAccount)
+	}
+	if m.clearedtemplate {
+		edges = append(edges, credential.EdgeTemplate)
+	}
+	if m.clearedpredecessor {
+		edges = append(edges, credential.EdgePredecessor)
+	}
+	if m.clearedsuccessor {
+		edges = append(edges, credential.EdgeSuccessor)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CredentialMutation) EdgeCleared(name string) bool {
+	switch name {
+	case credential.EdgeAccount:
+		return m.clearedaccount
+	case credential.EdgeTemplate:
+		return m.clearedtemplate
+	case credential.EdgePredecessor:
+		return m.clearedpredecessor
+	case credential.EdgeSuccessor:
+		return m.clearedsuccessor
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CredentialMutation) ClearEdge(name string) error {
+	switch name {
+	case credential.EdgeAccount:
+		m.ClearAccount()
+		return nil
+	case credential.EdgeTemplate:
+		m.ClearTemplate()
+		return nil
+	case credential.EdgePredecessor:
+		m.ClearPredecessor()
+		return nil
+	case credential.EdgeSuccessor:
+		m.ClearSuccessor()
+		return nil
+	}
+	return fmt.Errorf("unknown Credential unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CredentialMutation) ResetEdge(name string) error {
+	switch name {
+	case credential.EdgeAccount:
+		m.ResetAccount()
+		return nil
+	case credential.EdgeTemplate:
+		m.ResetTemplate()
+		return nil
+	case credential.EdgePredecessor:
+		m.ResetPredecessor()
+		return nil
+	case credential.EdgeSuccessor:
+		m.ResetSuccessor()
+		return nil
+	}
+	return fmt.Errorf("unknown Credential edge %s", name)
+}
+
+// CredentialTemplateMutation represents an operation that mutates the CredentialTemplate nodes in the graph.
+type CredentialTemplateMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *string
+	name               *string
+	version            *int
+	addversion         *int
+	status             *credentialtemplate.Status
+	description        *string
+	content            *string
+	sample_data        *map[string]interface{}
+	builtin            *bool
+	published_at       *time.Time
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	credentials        map[string]struct{}
+	removedcredentials map[string]struct{}
+	clearedcredentials bool
+	done               bool
+	oldValue           func(context.Context) (*CredentialTemplate, error)
+	predicates         []predicate.CredentialTemplate
+}
+
+var _ ent.Mutation = (*CredentialTemplateMutation)(nil)
+
+// credentialtemplateOption allows management of the mutation configuration using functional options.
+type credentialtemplateOption func(*CredentialTemplateMutation)
+
+// newCredentialTemplateMutation creates new mutation for the CredentialTemplate entity.
+func newCredentialTemplateMutation(c config, op Op, opts ...credentialtemplateOption) *CredentialTemplateMutation {
+	m := &CredentialTemplateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCredentialTemplate,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCredentialTemplateID sets the ID field of the mutation.
+func withCredentialTemplateID(id string) credentialtemplateOption {
+	return func(m *CredentialTemplateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CredentialTemplate
+		)
+		m.oldValue = func(ctx context.Context) (*CredentialTemplate, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CredentialTemplate.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCredentialTemplate sets the old CredentialTemplate of the mutation.
+func withCredentialTemplate(node *CredentialTemplate) credentialtemplateOption {
+	return func(m *CredentialTemplateMutation) {
+		m.oldValue = func(context.Context) (*CredentialTemplate, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CredentialTemplateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CredentialTemplateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of CredentialTemplate entities.
+func (m *CredentialTemplateMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CredentialTemplateMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CredentialTemplateMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CredentialTemplate.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *CredentialTemplateMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *CredentialTemplateMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the CredentialTemplate entity.
+// If the CredentialTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialTemplateMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *CredentialTemplateMutation) ResetName() {
+	m.name = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *CredentialTemplateMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *CredentialTemplateMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the CredentialTemplate entity.
+// If the CredentialTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialTemplateMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *CredentialTemplateMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *CredentialTemplateMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *CredentialTemplateMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *CredentialTemplateMutation) SetStatus(c credentialtemplate.Status) {
+	m.status = &c
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *CredentialTemplateMutation) Status() (r credentialtemplate.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the CredentialTemplate entity.
+// If the CredentialTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialTemplateMutation) OldStatus(ctx context.Context) (v credentialtemplate.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *CredentialTemplateMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *CredentialTemplateMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *CredentialTemplateMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the CredentialTemplate entity.
+// If the CredentialTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialTemplateMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *CredentialTemplateMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[credentialtemplate.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *CredentialTemplateMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[credentialtemplate.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *CredentialTemplateMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, credentialtemplate.FieldDescription)
+}
+
+// SetContent sets the "content" field.
+func (m *CredentialTemplateMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *CredentialTemplateMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the CredentialTemplate entity.
+// If the CredentialTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialTemplateMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *CredentialTemplateMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetSampleData sets the "sample_data" field.
+func (m *CredentialTemplateMutation) SetSampleData(value map[string]interface{}) {
+	m.sample_data = &value
+}
+
+// SampleData returns the value of the "sample_data" field in the mutation.
+func (m *CredentialTemplateMutation) SampleData() (r map[string]interface{}, exists bool) {
+	v := m.sample_data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSampleData returns the old "sample_data" field's value of the CredentialTemplate entity.
+// If the CredentialTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialTemplateMutation) OldSampleData(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSampleData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSampleData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSampleData: %w", err)
+	}
+	return oldValue.SampleData, nil
+}
+
+// ClearSampleData clears the value of the "sample_data" field.
+func (m *CredentialTemplateMutation) ClearSampleData() {
+	m.sample_data = nil
+	m.clearedFields[credentialtemplate.FieldSampleData] = struct{}{}
+}
+
+// SampleDataCleared returns if the "sample_data" field was cleared in this mutation.
+func (m *CredentialTemplateMutation) SampleDataCleared() bool {
+	_, ok := m.clearedFields[credentialtemplate.FieldSampleData]
+	return ok
+}
+
+// ResetSampleData resets all changes to the "sample_data" field.
+func (m *CredentialTemplateMutation) ResetSampleData() {
+	m.sample_data = nil
+	delete(m.clearedFields, credentialtemplate.FieldSampleData)
+}
+
+// SetBuiltin sets the "builtin" field.
+func (m *CredentialTemplateMutation) SetBuiltin(b bool) {
+	m.builtin = &b
+}
+
+// Builtin returns the value of the "builtin" field in the mutation.
+func (m *CredentialTemplateMutation) Builtin() (r bool, exists bool) {
+	v := m.builtin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuiltin returns the old "builtin" field's value of the CredentialTemplate entity.
+// If the CredentialTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialTemplateMutation) OldBuiltin(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuiltin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuiltin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuiltin: %w", err)
+	}
+	return oldValue.Builtin, nil
+}
+
+// ResetBuiltin resets all changes to the "builtin" field.
+func (m *CredentialTemplateMutation) ResetBuiltin() {
+	m.builtin = nil
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (m *CredentialTemplateMutation) SetPublishedAt(t time.Time) {
+	m.published_at = &t
+}
+
+// PublishedAt returns the value of the "published_at" field in the mutation.
+func (m *CredentialTemplateMutation) PublishedAt() (r time.Time, exists bool) {
+	v := m.published_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublishedAt returns the old "published_at" field's value of the CredentialTemplate entity.
+// If the CredentialTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialTemplateMutation) OldPublishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublishedAt: %w", err)
+	}
+	return oldValue.PublishedAt, nil
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (m *CredentialTemplateMutation) ClearPublishedAt() {
+	m.published_at = nil
+	m.clearedFields[credentialtemplate.FieldPublishedAt] = struct{}{}
+}
+
+// PublishedAtCleared returns if the "published_at" field was cleared in this mutation.
+func (m *CredentialTemplateMutation) PublishedAtCleared() bool {
+	_, ok := m.clearedFields[credentialtemplate.FieldPublishedAt]
+	return ok
+}
+
+// ResetPublishedAt resets all changes to the "published_at" field.
+func (m *CredentialTemplateMutation) ResetPublishedAt() {
+	m.published_at = nil
+	delete(m.clearedFields, credentialtemplate.FieldPublishedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CredentialTemplateMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CredentialTemplateMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the CredentialTemplate entity.
+// If the CredentialTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialTemplateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CredentialTemplateMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CredentialTemplateMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CredentialTemplateMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the CredentialTemplate entity.
+// If the CredentialTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CredentialTemplateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CredentialTemplateMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddCredentialIDs adds the "credentials" edge to the Credential entity by ids.
+func (m *CredentialTemplateMutation) AddCredentialIDs(ids ...string) {
+	if m.credentials == nil {
+		m.credentials = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.credentials[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCredentials clears the "credentials" edge to the Credential entity.
+func (m *CredentialTemplateMutation) ClearCredentials() {
+	m.clearedcredentials = true
+}
+
+// CredentialsCleared reports if the "credentials" edge to the Credential entity was cleared.
+func (m *CredentialTemplateMutation) CredentialsCleared() bool {
+	return m.clearedcredentials
+}
+
+// RemoveCredentialIDs removes the "credentials" edge to the Credential entity by IDs.
+func (m *CredentialTemplateMutation) RemoveCredentialIDs(ids ...string) {
+	if m.removedcredentials == nil {
+		m.removedcredentials = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.credentials, ids[i])
+		m.removedcredentials[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCredentials returns the removed IDs of the "credentials" edge to the Credential entity.
+func (m *CredentialTemplateMutation) RemovedCredentialsIDs() (ids []string) {
+	for id := range m.removedcredentials {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CredentialsIDs returns the "credentials" edge IDs in the mutation.
+func (m *CredentialTemplateMutation) CredentialsIDs() (ids []string) {
+	for id := range m.credentials {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCredentials resets all changes to the "credentials" edge.
+func (m *CredentialTemplateMutation) ResetCredentials() {
+	m.credentials = nil
+	m.clearedcredentials = false
+	m.removedcredentials = nil
+}
+
+// Where appends a list predicates to the CredentialTemplateMutation builder.
+func (m *CredentialTemplateMutation) Where(ps ...predicate.CredentialTemplate) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *CredentialTemplateMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (CredentialTemplate).
+func (m *CredentialTemplateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CredentialTemplateMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.name != nil {
+		fields = append(fields, credentialtemplate.FieldName)
+	}
+	if m.version != nil {
+		fields = append(fields, credentialtemplate.FieldVersion)
+	}
+	if m.status != nil {
+		fields = append(fields, credentialtemplate.FieldStatus)
+	}
+	if m.description != nil {
+		fields = append(fields, credentialtemplate.FieldDescription)
+	}
+	if m.content != nil {
+		fields = append(fields, credentialtemplate.FieldContent)
+	}
+	if m.sample_data != nil {
+		fields = append(fields, credentialtemplate.FieldSampleData)
+	}
+	if m.builtin != nil {
+		fields = append(fields, credentialtemplate.FieldBuiltin)
+	}
+	if m.published_at != nil {
+		fields = append(fields, credentialtemplate.FieldPublishedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, credentialtemplate.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, credentialtemplate.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CredentialTemplateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case credentialtemplate.FieldName:
+		return m.Name()
+	case credentialtemplate.FieldVersion:
+		return m.Version()
+	case credentialtemplate.FieldStatus:
+		return m.Status()
+	case credentialtemplate.FieldDescription:
+		return m.Description()
+	case credentialtemplate.FieldContent:
+		return m.Content()
+	case credentialtemplate.FieldSampleData:
+		return m.SampleData()
+	case credentialtemplate.FieldBuiltin:
+		return m.Builtin()
+	case credentialtemplate.FieldPublishedAt:
+		return m.PublishedAt()
+	case credentialtemplate.FieldCreatedAt:
+		return m.CreatedAt()
+	case credentialtemplate.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CredentialTemplateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case credentialtemplate.FieldName:
+		return m.OldName(ctx)
+	case credentialtemplate.FieldVersion:
+		return m.OldVersion(ctx)
+	case credentialtemplate.FieldStatus:
+		return m.OldStatus(ctx)
+	case credentialtemplate.FieldDescription:
+		return m.OldDescription(ctx)
+	case credentialtemplate.FieldContent:
+		return m.OldContent(ctx)
+	case credentialtemplate.FieldSampleData:
+		return m.OldSampleData(ctx)
+	case credentialtemplate.FieldBuiltin:
+		return m.OldBuiltin(ctx)
+	case credentialtemplate.FieldPublishedAt:
+		return m.OldPublishedAt(ctx)
+	case credentialtemplate.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case credentialtemplate.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CredentialTemplate field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CredentialTemplateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case credentialtemplate.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case credentialtemplate.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case credentialtemplate.FieldStatus:
+		v, ok := value.(credentialtemplate.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case credentialtemplate.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case credentialtemplate.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case credentialtemplate.FieldSampleData:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSampleData(v)
+		return nil
+	case credentialtemplate.FieldBuiltin:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuiltin(v)
+		return nil
+	case credentialtemplate.FieldPublishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublishedAt(v)
+		return nil
+	case credentialtemplate.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case credentialtemplate.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CredentialTemplate field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CredentialTemplateMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, credentialtemplate.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CredentialTemplateMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case credentialtemplate.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CredentialTemplateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case credentialtemplate.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CredentialTemplate numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CredentialTemplateMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(credentialtemplate.FieldDescription) {
+		fields = append(fields, credentialtemplate.FieldDescription)
+	}
+	if m.FieldCleared(credentialtemplate.FieldSampleData) {
+		fields = append(fields, credentialtemplate.FieldSampleData)
+	}
+	if m.FieldCleared(credentialtemplate.FieldPublishedAt) {
+		fields = append(fields, credentialtemplate.FieldPublishedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CredentialTemplateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CredentialTemplateMutation) ClearField(name string) error {
+	switch name {
+	case credentialtemplate.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case credentialtemplate.FieldSampleData:
+		m.ClearSampleData()
+		return nil
+	case credentialtemplate.FieldPublishedAt:
+		m.ClearPublishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CredentialTemplate nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CredentialTemplateMutation) ResetField(name string) error {
+	switch name {
+	case credentialtemplate.FieldName:
+		m.ResetName()
+		return nil
+	case credentialtemplate.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case credentialtemplate.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case credentialtemplate.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case credentialtemplate.FieldContent:
+		m.ResetContent()
+		return nil
+	case credentialtemplate.FieldSampleData:
+		m.ResetSampleData()
+		return nil
+	case credentialtemplate.FieldBuiltin:
+		m.ResetBuiltin()
+		return nil
+	case credentialtemplate.FieldPublishedAt:
+		m.ResetPublishedAt()
+		return nil
+	case credentialtemplate.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case credentialtemplate.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CredentialTemplate field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CredentialTemplateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.credentials != nil {
+		edges = append(edges, credentialtemplate.EdgeCredentials)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CredentialTemplateMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case credentialtemplate.EdgeCredentials:
+		ids := make([]ent.Value, 0, len(m.credentials))
+		for id := range m.credentials {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CredentialTemplateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedcredentials != nil {
+		edges = append(edges, credentialtemplate.EdgeCredentials)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CredentialTemplateMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case credentialtemplate.EdgeCredentials:
+		ids := make([]ent.Value, 0, len(m.removedcredentials))
+		for id := range m.removedcredentials {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CredentialTemplateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcredentials {
+		edges = append(edges, credentialtemplate.EdgeCredentials)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CredentialTemplateMutation) EdgeCleared(name string) bool {
+	switch name {
+	case credentialtemplate.EdgeCredentials:
+		return m.clearedcredentials
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CredentialTemplateMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown CredentialTemplate unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CredentialTemplateMutation) ResetEdge(name string) error {
+	switch name {
+	case credentialtemplate.EdgeCredentials:
+		m.ResetCredentials()
+		return nil
+	}
+	return fmt.Errorf("unknown CredentialTemplate edge %s", name)
+}
+
+// DIDMutation represents an operation that mutates the DID nodes in the graph.
+type DIDMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	method        *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *string
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*DID, error)
+	predicates    []predicate.DID
+}
+
+var _ ent.Mutation = (*DIDMutation)(nil)
+
+// didOption allows management of the mutation configuration using functional options.
+type didOption func(*DIDMutation)
+
+// newDIDMutation creates new mutation for the DID entity.
+func newDIDMutation(c config, op Op, opts ...didOption) *DIDMutation {
+	m := &DIDMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDID,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDIDID sets the ID field of the mutation.
+func withDIDID(id string) didOption {
+	return func(m *DIDMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DID
+		)
+		m.oldValue = func(ctx context.Context) (*DID, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DID.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDID sets the old DID of the mutation.
+func withDID(node *DID) didOption {
+	return func(m *DIDMutation) {
+		m.oldValue = func(context.Context) (*DID, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DIDMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DIDMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DID entities.
+func (m *DIDMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DIDMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DIDMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DID.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMethod sets the "method" field.
+func (m *DIDMutation) SetMethod(s string) {
+	m.method = &s
+}
+
+// Method returns the value of the "method" field in the mutation.
+func (m *DIDMutation) Method() (r string, exists bool) {
+	v := m.method
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMethod returns the old "method" field's value of the DID entity.
+// If the DID object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DIDMutation) OldMethod(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMethod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMethod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMethod: %w", err)
+	}
+	return oldValue.Method, nil
+}
+
+// ClearMethod clears the value of the "method" field.
+func (m *DIDMutation) ClearMethod() {
+	m.method = nil
+	m.clearedFields[did.FieldMethod] = struct{}{}
+}
+
+// MethodCleared returns if the "method" field was cleared in this mutation.
+func (m *DIDMutation) MethodCleared() bool {
+	_, ok := m.clearedFields[did.FieldMethod]
+	return ok
+}
+
+// ResetMethod resets all changes to the "method" field.
+func (m *DIDMutation) ResetMethod() {
+	m.method = nil
+	delete(m.clearedFields, did.FieldMethod)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DIDMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DIDMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DID entity.
+// If the DID object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DIDMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DIDMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DIDMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DIDMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the DID entity.
+// If the DID object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DIDMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DIDMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *DIDMutation) SetUserID(id string) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *DIDMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *DIDMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *DIDMutation) UserID() (id string, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *DIDMutation) UserIDs() (ids []string) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *DIDMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the DIDMutation builder.
+func (m *DIDMutation) Where(ps ...predicate.DID) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *DIDMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (DID).
+func (m *DIDMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DIDMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.method != nil {
+		fields = append(fields, did.FieldMethod)
+	}
+	if m.created_at != nil {
+		fields = append(fields, did.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, did.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DIDMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case did.FieldMethod:
+		return m.Method()
+	case did.FieldCreatedAt:
+		return m.CreatedAt()
+	case did.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DIDMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case did.FieldMethod:
+		return m.OldMethod(ctx)
+	case did.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case did.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DID field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DIDMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case did.FieldMethod:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMethod(v)
+		return nil
+	case did.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case did.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DID field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DIDMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DIDMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DIDMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DID numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DIDMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(did.FieldMethod) {
+		fields = append(fields, did.FieldMethod)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DIDMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DIDMutation) ClearField(name string) error {
+	switch name {
+	case did.FieldMethod:
+		m.ClearMethod()
+		return nil
+	}
+	return fmt.Errorf("unknown DID nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DIDMutation) ResetField(name string) error {
+	switch name {
+	case did.FieldMethod:
+		m.ResetMethod()
+		return nil
+	case did.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case did.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DID field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DIDMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, did.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DIDMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case did.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DIDMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DIDMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DIDMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, did.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DIDMutation) EdgeCleared(name string) bool {
+	switch name {
+	case did.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DIDMutation) ClearEdge(name string) error {
+	switch name {
+	case did.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown DID unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DIDMutation) ResetEdge(name string) error {
+	switch name {
+	case did.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown DID edge %s", name)
+}
+
+// IssuanceRequestMutation represents an operation that mutates the IssuanceRequest nodes in the graph.
+type IssuanceRequestMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *string
+	credential_type    *string
+	claims             *map[string]interface{}
+	subject_did        *string
+	predecessor        *string
+	revoke_predecessor *bool
+	status             *issuancerequest.Status
+	requested_by       *string
+	decided_by         *string
+	reason             *string
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	events             map[int]struct{}
+	removedevents      map[int]struct{}
+	clearedevents      bool
+	credential         *string
+	clearedcredential  bool
+	done               bool
+	oldValue           func(context.Context) (*IssuanceRequest, error)
+	predicates         []predicate.IssuanceRequest
+}
+
+var _ ent.Mutation = (*IssuanceRequestMutation)(nil)
+
+// issuancerequestOption allows management of the mutation configuration using functional options.
+type issuancerequestOption func(*IssuanceRequestMutation)
+
+// newIssuanceRequestMutation creates new mutation for the IssuanceRequest entity.
+func newIssuanceRequestMutation(c config, op Op, opts ...issuancerequestOption) *IssuanceRequestMutation {
+	m := &IssuanceRequestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIssuanceRequest,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIssuanceRequestID sets the ID field of the mutation.
+func withIssuanceRequestID(id string) issuancerequestOption {
+	return func(m *IssuanceRequestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IssuanceRequest
+		)
+		m.oldValue = func(ctx context.Context) (*IssuanceRequest, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IssuanceRequest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIssuanceRequest sets the old IssuanceRequest of the mutation.
+func withIssuanceRequest(node *IssuanceRequest) issuancerequestOption {
+	return func(m *IssuanceRequestMutation) {
+		m.oldValue = func(context.Context) (*IssuanceRequest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IssuanceRequestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IssuanceRequestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of IssuanceRequest entities.
+func (m *IssuanceRequestMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IssuanceRequestMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IssuanceRequestMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IssuanceRequest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCredentialType sets the "credential_type" field.
+func (m *IssuanceRequestMutation) SetCredentialType(s string) {
+	m.credential_type = &s
+}
+
+// CredentialType returns the value of the "credential_type" field in the mutation.
+func (m *IssuanceRequestMutation) CredentialType() (r string, exists bool) {
+	v := m.credential_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCredentialType returns the old "credential_type" field's value of the IssuanceRequest entity.
+// If the IssuanceRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IssuanceRequestMutation) OldCredentialType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCredentialType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCredentialType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCredentialType: %w", err)
+	}
+	return oldValue.CredentialType, nil
+}
+
+// ResetCredentialType resets all changes to the "credential_type" field.
+func (m *IssuanceRequestMutation) ResetCredentialType() {
+	m.credential_type = nil
+}
+
+// SetClaims sets the "claims" field.
+func (m *IssuanceRequestMutation) SetClaims(value map[string]interface{}) {
+	m.claims = &value
+}
+
+// Claims returns the value of the "claims" field in the mutation.
+func (m *IssuanceRequestMutation) Claims() (r map[string]interface{}, exists bool) {
+	v := m.claims
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaims returns the old "claims" field's value of the IssuanceRequest entity.
+// If the IssuanceRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IssuanceRequestMutation) OldClaims(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaims is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaims requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaims: %w", err)
+	}
+	return oldValue.Claims, nil
+}
+
+// ResetClaims resets all changes to the "claims" field.
+func (m *IssuanceRequestMutation) ResetClaims() {
+	m.claims = nil
+}
+
+// SetSubjectDid sets the "subject_did" field.
+func (m *IssuanceRequestMutation) SetSubjectDid(s string) {
+	m.subject_did = &s
+}
+
+// SubjectDid returns the value of the "subject_did" field in the mutation.
+func (m *IssuanceRequestMutation) SubjectDid() (r string, exists bool) {
+	v := m.subject_did
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubjectDid returns the old "subject_did" field's value of the IssuanceRequest entity.
+// If the IssuanceRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IssuanceRequestMutation) OldSubjectDid(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubjectDid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubjectDid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubjectDid: %w", err)
+	}
+	return oldValue.SubjectDid, nil
+}
+
+// ClearSubjectDid clears the value of the "subject_did" field.
+func (m *IssuanceRequestMutation) ClearSubjectDid() {
+	m.subject_did = nil
+	m.clearedFields[issuancerequest.FieldSubjectDid] = struct{}{}
+}
+
+// SubjectDidCleared returns if the "subject_did" field was cleared in this mutation.
+func (m *IssuanceRequestMutation) SubjectDidCleared() bool {
+	_, ok := m.clearedFields[issuancerequest.FieldSubjectDid]
+	return ok
+}
+
+// ResetSubjectDid resets all changes to the "subject_did" field.
+func (m *IssuanceRequestMutation) ResetSubjectDid() {
+	m.subject_did = nil
+	delete(m.clearedFields, issuancerequest.FieldSubjectDid)
+}
+
+// SetPredecessor sets the "predecessor" field.
+func (m *IssuanceRequestMutation) SetPredecessor(s string) {
+	m.predecessor = &s
+}
+
+// Predecessor returns the value of the "predecessor" field in the mutation.
+func (m *IssuanceRequestMutation) Predecessor() (r string, exists bool) {
+	v := m.predecessor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPredecessor returns the old "predecessor" field's value of the IssuanceRequest entity.
+// If the IssuanceRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IssuanceRequestMutation) OldPredecessor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPredecessor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPredecessor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPredecessor: %w", err)
+	}
+	return oldValue.Predecessor, nil
+}
+
+// ClearPredecessor clears the value of the "predecessor" field.
+func (m *IssuanceRequestMutation) ClearPredecessor() {
+	m.predecessor = nil
+	m.clearedFields[issuancerequest.FieldPredecessor] = struct{}{}
+}
+
+// PredecessorCleared returns if the "predecessor" field was cleared in this mutation.
+func (m *IssuanceRequestMutation) PredecessorCleared() bool {
+	_, ok := m.clearedFields[issuancerequest.FieldPredecessor]
+	return ok
+}
+
+// ResetPredecessor resets all changes to the "predecessor" field.
+func (m *IssuanceRequestMutation) ResetPredecessor() {
+	m.predecessor = nil
+	delete(m.clearedFields, issuancerequest.FieldPredecessor)
+}
+
+// SetRevokePredecessor sets the "revoke_predecessor" field.
+func (m *IssuanceRequestMutation) SetRevokePredecessor(b bool) {
+	m.revoke_predecessor = &b
+}
+
+// RevokePredecessor returns the value of the "revoke_predecessor" field in the mutation.
+func (m *IssuanceRequestMutation) RevokePredecessor() (r bool, exists bool) {
+	v := m.revoke_predecessor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokePredecessor returns the old "revoke_predecessor" field's value of the IssuanceRequest entity.
+// If the IssuanceRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IssuanceRequestMutation) OldRevokePredecessor(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokePredecessor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokePredecessor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokePredecessor: %w", err)
+	}
+	return oldValue.RevokePredecessor, nil
+}
+
+// ResetRevokePredecessor resets all changes to the "revoke_predecessor" field.
+func (m *IssuanceRequestMutation) ResetRevokePredecessor() {
+	m.revoke_predecessor = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *IssuanceRequestMutation) SetStatus(i issuancerequest.Status) {
+	m.status = &i
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *IssuanceRequestMutation) Status() (r issuancerequest.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the IssuanceRequest entity.
+// If the IssuanceRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IssuanceRequestMutation) OldStatus(ctx context.Context) (v issuancerequest.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *IssuanceRequestMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (m *IssuanceRequestMutation) SetRequestedBy(s string) {
+	m.requested_by = &s
+}
+
+// RequestedBy returns the value of the "requested_by" field in the mutation.
+func (m *IssuanceRequestMutation) RequestedBy() (r string, exists bool) {
+	v := m.requested_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestedBy returns the old "requested_by" field's value of the IssuanceRequest entity.
+// If the IssuanceRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IssuanceRequestMutation) OldRequestedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestedBy: %w", err)
+	}
+	return oldValue.RequestedBy, nil
+}
+
+// ResetRequestedBy resets all changes to the "requested_by" field.
+func (m *IssuanceRequestMutation) ResetRequestedBy() {
+	m.requested_by = nil
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (m *IssuanceRequestMutation) SetDecidedBy(s string) {
+	m.decided_by = &s
+}
+
+// DecidedBy returns the value of the "decided_by" field in the mutation.
+func (m *IssuanceRequestMutation) DecidedBy() (r string, exists bool) {
+	v := m.decided_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDecidedBy returns the old "decided_by" field's value of the IssuanceRequest entity.
+// If the IssuanceRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IssuanceRequestMutation) OldDecidedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDecidedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDecidedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDecidedBy: %w", err)
+	}
+	return oldValue.DecidedBy, nil
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (m *IssuanceRequestMutation) ClearDecidedBy() {
+	m.decided_by = nil
+	m.clearedFields[issuancerequest.FieldDecidedBy] = struct{}{}
+}
+
+// DecidedByCleared returns if the "decided_by" field was cleared in this mutation.
+func (m *IssuanceRequestMutation) DecidedByCleared() bool {
+	_, ok := m.clearedFields[issuancerequest.FieldDecidedBy]
+	return ok
+}
+
+// ResetDecidedBy resets all changes to the "decided_by" field.
+func (m *IssuanceRequestMutation) ResetDecidedBy() {
+	m.decided_by = nil
+	delete(m.clearedFields, issuancerequest.FieldDecidedBy)
+}
+
+// SetReason sets the "reason" field.
+func (m *IssuanceRequestMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *IssuanceRequestMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the IssuanceRequest entity.
+// If the IssuanceRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IssuanceRequestMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *IssuanceRequestMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[issuancerequest.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *IssuanceRequestMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[issuancerequest.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *IssuanceRequestMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, issuancerequest.FieldReason)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IssuanceRequestMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IssuanceRequestMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the IssuanceRequest entity.
+// If the IssuanceRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IssuanceRequestMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IssuanceRequestMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *IssuanceRequestMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *IssuanceRequestMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the IssuanceRequest entity.
+// If the IssuanceRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IssuanceRequestMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *IssuanceRequestMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddEventIDs adds the "events" edge to the IssuanceRequestEvent entity by ids.
+func (m *IssuanceRequestMutation) AddEventIDs(ids ...int) {
+	if m.events == nil {
+		m.events = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEvents clears the "events" edge to the IssuanceRequestEvent entity.
+func (m *IssuanceRequestMutation) ClearEvents() {
+	m.clearedevents = true
+}
+
+// EventsCleared reports if the "events" edge to the IssuanceRequestEvent entity was cleared.
+func (m *IssuanceRequestMutation) EventsCleared() bool {
+	return m.clearedevents
+}
+
+// RemoveEventIDs removes the "events" edge to the IssuanceRequestEvent entity by IDs.
+func (m *IssuanceRequestMutation) RemoveEventIDs(ids ...int) {
+	if m.removedevents == nil {
+		m.removedevents = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.events, ids[i])
+		m.removedevents[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEvents returns the removed IDs of the "events" edge to the IssuanceRequestEvent entity.
+func (m *IssuanceRequestMutation) RemovedEventsIDs() (ids []int) {
+	for id := range m.removedevents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EventsIDs returns the "events" edge IDs in the mutation.
+func (m *IssuanceRequestMutation) EventsIDs() (ids []int) {
+	for id := range m.events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEvents resets all changes to the "events" edge.
+func (m *IssuanceRequestMutation) ResetEvents() {
+	m.events = nil
+	m.clearedevents = false
+	m.removedevents = nil
+}
+
+// SetCredentialID sets the "credential" edge to the Credential entity by id.
+func (m *IssuanceRequestMutation) SetCredentialID(id string) {
+	m.credential = &id
+}
+
+// ClearCredential clears the "credential" edge to the Credential entity.
+func (m *IssuanceRequestMutation) ClearCredential() {
+	m.clearedcredential = true
+}
+
+// CredentialCleared reports if the "credential" edge to the Credential entity was cleared.
+func (m *IssuanceRequestMutation) CredentialCleared() bool {
+	return m.clearedcredential
+}
+
+// CredentialID returns the "credential" edge ID in the mutation.
+func (m *IssuanceRequestMutation) CredentialID() (id string, exists bool) {
+	if m.credential != nil {
+		return *m.credential, true
+	}
+	return
+}
+
+// CredentialIDs returns the "credential" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CredentialID instead. It exists only for internal usage by the builders.
+func (m *IssuanceRequestMutation) CredentialIDs() (ids []string) {
+	if id := m.credential; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCredential resets all changes to the "credential" edge.
+func (m *IssuanceRequestMutation) ResetCredential() {
+	m.credential = nil
+	m.clearedcredential = false
+}
+
+// Where appends a list predicates to the IssuanceRequestMutation builder.
+func (m *IssuanceRequestMutation) Where(ps ...predicate.IssuanceRequest) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *IssuanceRequestMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (IssuanceRequest).
+func (m *IssuanceRequestMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IssuanceRequestMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.credential_type != nil {
+		fields = append(fields, issuancerequest.FieldCredentialType)
+	}
+	if m.claims != nil {
+		fields = append(fields, issuancerequest.FieldClaims)
+	}
+	if m.subject_did != nil {
+		fields = append(fields, issuancerequest.FieldSubjectDid)
+	}
+	if m.predecessor != nil {
+		fields = append(fields, issuancerequest.FieldPredecessor)
+	}
+	if m.revoke_predecessor != nil {
+		fields = append(fields, issuancerequest.FieldRevokePredecessor)
+	}
+	if m.status != nil {
+		fields = append(fields, issuancerequest.FieldStatus)
+	}
+	if m.requested_by != nil {
+		fields = append(fields, issuancerequest.FieldRequestedBy)
+	}
+	if m.decided_by != nil {
+		fields = append(fields, issuancerequest.FieldDecidedBy)
+	}
+	if m.reason != nil {
+		fields = append(fields, issuancerequest.FieldReason)
+	}
+	if m.created_at != nil {
+		fields = append(fields, issuancerequest.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, issuancerequest.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IssuanceRequestMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case issuancerequest.FieldCredentialType:
+		return m.CredentialType()
+	case issuancerequest.FieldClaims:
+		return m.Claims()
+	case issuancerequest.FieldSubjectDid:
+		return m.SubjectDid()
+	case issuancerequest.FieldPredecessor:
+		return m.Predecessor()
+	case issuancerequest.FieldRevokePredecessor:
+		return m.RevokePredecessor()
+	case issuancerequest.FieldStatus:
+		return m.Status()
+	case issuancerequest.FieldRequestedBy:
+		return m.RequestedBy()
+	case issuancerequest.FieldDecidedBy:
+		return m.DecidedBy()
+	case issuancerequest.FieldReason:
+		return m.Reason()
+	case issuancerequest.FieldCreatedAt:
+		return m.CreatedAt()
+	case issuancerequest.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IssuanceRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case issuancerequest.FieldCredentialType:
+		return m.OldCredentialType(ctx)
+	case issuancerequest.FieldClaims:
+		return m.OldClaims(ctx)
+	case issuancerequest.FieldSubjectDid:
+		return m.OldSubjectDid(ctx)
+	case issuancerequest.FieldPredecessor:
+		return m.OldPredecessor(ctx)
+	case issuancerequest.FieldRevokePredecessor:
+		return m.OldRevokePredecessor(ctx)
+	case issuancerequest.FieldStatus:
+		return m.OldStatus(ctx)
+	case issuancerequest.FieldRequestedBy:
+		return m.OldRequestedBy(ctx)
+	case issuancerequest.FieldDecidedBy:
+		return m.OldDecidedBy(ctx)
+	case issuancerequest.FieldReason:
+		return m.OldReason(ctx)
+	case issuancerequest.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case issuancerequest.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown IssuanceRequest field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IssuanceRequestMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case issuancerequest.FieldCredentialType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCredentialType(v)
+		return nil
+	case issuancerequest.FieldClaims:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaims(v)
+		return nil
+	case issuancerequest.FieldSubjectDid:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubjectDid(v)
+		return nil
+	case issuancerequest.FieldPredecessor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPredecessor(v)
+		return nil
+	case issuancerequest.FieldRevokePredecessor:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokePredecessor(v)
+		return nil
+	case issuancerequest.FieldStatus:
+		v, ok := value.(issuancerequest.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case issuancerequest.FieldRequestedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestedBy(v)
+		return nil
+	case issuancerequest.FieldDecidedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDecidedBy(v)
+		return nil
+	case issuancerequest.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case issuancerequest.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case issuancerequest.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IssuanceRequest field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IssuanceRequestMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IssuanceRequestMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IssuanceRequestMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown IssuanceRequest numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IssuanceRequestMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(issuancerequest.FieldSubjectDid) {
+		fields = append(fields, issuancerequest.FieldSubjectDid)
+	}
+	if m.FieldCleared(issuancerequest.FieldPredecessor) {
+		fields = append(fields, issuancerequest.FieldPredecessor)
+	}
+	if m.FieldCleared(issuancerequest.FieldDecidedBy) {
+		fields = append(fields, issuancerequest.FieldDecidedBy)
+	}
+	if m.FieldCleared(issuancerequest.FieldReason) {
+		fields = append(fields, issuancerequest.FieldReason)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IssuanceRequestMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IssuanceRequestMutation) ClearField(name string) error {
+	switch name {
+	case issuancerequest.FieldSubjectDid:
+		m.ClearSubjectDid()
+		return nil
+	case issuancerequest.FieldPredecessor:
+		m.ClearPredecessor()
+		return nil
+	case issuancerequest.FieldDecidedBy:
+		m.ClearDecidedBy()
+		return nil
+	case issuancerequest.FieldReason:
+		m.ClearReason()
+		return nil
+	}
+	return fmt.Errorf("unknown IssuanceRequest nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IssuanceRequestMutation) ResetField(name string) error {
+	switch name {
+	case issuancerequest.FieldCredentialType:
+		m.ResetCredentialType()
+		return nil
+	case issuancerequest.FieldClaims:
+		m.ResetClaims()
+		return nil
+	case issuancerequest.FieldSubjectDid:
+		m.ResetSubjectDid()
+		return nil
+	case issuancerequest.FieldPredecessor:
+		m.ResetPredecessor()
+		return nil
+	case issuancerequest.FieldRevokePredecessor:
+		m.ResetRevokePredecessor()
+		return nil
+	case issuancerequest.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case issuancerequest.FieldRequestedBy:
+		m.ResetRequestedBy()
+		return nil
+	case issuancerequest.FieldDecidedBy:
+		m.ResetDecidedBy()
+		return nil
+	case issuancerequest.FieldReason:
+		m.ResetReason()
+		return nil
+	case issuancerequest.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case issuancerequest.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown IssuanceRequest field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IssuanceRequestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.events != nil {
+		edges = append(edges, issuancerequest.EdgeEvents)
+	}
+	if m.credential != nil {
+		edges = append(edges, issuancerequest.EdgeCredential)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IssuanceRequestMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case issuancerequest.EdgeEvents:
+		ids := make([]ent.Value, 0, len(m.events))
+		for id := range m.events {
+			ids = append(ids, id)
+		}
+		return ids
+	case issuancerequest.EdgeCredential:
+		if id := m.credential; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IssuanceRequestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedevents != nil {
+		edges = append(edges, issuancerequest.EdgeEvents)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IssuanceRequestMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case issuancerequest.EdgeEvents:
+		ids := make([]ent.Value, 0, len(m.removedevents))
+		for id := range m.removedevents {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IssuanceRequestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedevents {
+		edges = append(edges, issuancerequest.EdgeEvents)
+	}
+	if m.clearedcredential {
+		edges = append(edges, issuancerequest.EdgeCredential)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IssuanceRequestMutation) EdgeCleared(name string) bool {
+	switch name {
+	case issuancerequest.EdgeEvents:
+		return m.clearedevents
+	case issuancerequest.EdgeCredential:
+		return m.clearedcredential
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IssuanceRequestMutation) ClearEdge(name string) error {
+	switch name {
+	case issuancerequest.EdgeCredential:
+		m.ClearCredential()
+		return nil
+	}
+	return fmt.Errorf("unknown IssuanceRequest unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IssuanceRequestMutation) ResetEdge(name string) error {
+	switch name {
+	case issuancerequest.EdgeEvents:
+		m.ResetEvents()
+		return nil
+	case issuancerequest.EdgeCredential:
+		m.ResetCredential()
+		return nil
+	}
+	return fmt.Errorf("unknown IssuanceRequest edge %s", name)
+}
+
+// IssuanceRequestEventMutation represents an operation that mutates the IssuanceRequestEvent nodes in the graph.
+type IssuanceRequestEventMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	from_status    *string
+	to_status      *string
+	actor          *string
+	reason         *string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	request        *string
+	clearedrequest bool
+	done           bool
+	oldValue       func(context.Context) (*IssuanceRequestEvent, error)
+	predicates     []predicate.IssuanceRequestEvent
+}
+
+var _ ent.Mutation = (*IssuanceRequestEventMutation)(nil)
+
+// issuancerequesteventOption allows management of the mutation configuration using functional options.
+type issuancerequesteventOption func(*IssuanceRequestEventMutation)
+
+// newIssuanceRequestEventMutation creates new mutation for the IssuanceRequestEvent entity.
+func newIssuanceRequestEventMutation(c config, op Op, opts ...issuancerequesteventOption) *IssuanceRequestEventMutation {
+	m := &IssuanceRequestEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIssuanceRequestEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIssuanceRequestEventID sets the ID field of the mutation.
+func withIssuanceRequestEventID(id int) issuancerequesteventOption {
+	return func(m *IssuanceRequestEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IssuanceRequestEvent
+		)
+		m.oldValue = func(ctx context.Context) (*IssuanceRequestEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IssuanceRequestEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIssuanceRequestEvent sets the old IssuanceRequestEvent of the mutation.
+func withIssuanceRequestEvent(node *IssuanceRequestEvent) issuancerequesteventOption {
+	return func(m *IssuanceRequestEventMutation) {
+		m.oldValue = func(context.Context) (*IssuanceRequestEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IssuanceRequestEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IssuanceRequestEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IssuanceRequestEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IssuanceRequestEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IssuanceRequestEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFromStatus sets the "from_status" field.
+func (m *IssuanceRequestEventMutation) SetFromStatus(s string) {
+	m.from_status = &s
+}
+
+// FromStatus returns the value of the "from_status" field in the mutation.
+func (m *IssuanceRequestEventMutation) FromStatus() (r string, exists bool) {
+	v := m.from_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromStatus returns the old "from_status" field's value of the IssuanceRequestEvent entity.
+// If the IssuanceRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IssuanceRequestEventMutation) OldFromStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromStatus: %w", err)
+	}
+	return oldValue.FromStatus, nil
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (m *IssuanceRequestEventMutation) ClearFromStatus() {
+	m.from_status = nil
+	m.clearedFields[issuancerequestevent.FieldFromStatus] = struct{}{}
+}
+
+// FromStatusCleared returns if the "from_status" field was cleared in this mutation.
+func (m *IssuanceRequestEventMutation) FromStatusCleared() bool {
+	_, ok := m.clearedFields[issuancerequestevent.FieldFromStatus]
+	return ok
+}
+
+// ResetFromStatus resets all changes to the "from_status" field.
+func (m *IssuanceRequestEventMutation) ResetFromStatus() {
+	m.from_status = nil
+	delete(m.clearedFields, issuancerequestevent.FieldFromStatus)
+}
+
+// SetToStatus sets the "to_status" field.
+func (m *IssuanceRequestEventMutation) SetToStatus(s string) {
+	m.to_status = &s
+}
+
+// ToStatus returns the value of the "to_status" field in the mutation.
+func (m *IssuanceRequestEventMutation) ToStatus() (r string, exists bool) {
+	v := m.to_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToStatus returns the old "to_status" field's value of the IssuanceRequestEvent entity.
+// If the IssuanceRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IssuanceRequestEventMutation) OldToStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToStatus: %w", err)
+	}
+	return oldValue.ToStatus, nil
+}
+
+// ResetToStatus resets all changes to the "to_status" field.
+func (m *IssuanceRequestEventMutation) ResetToStatus() {
+	m.to_status = nil
+}
+
+// SetActor sets the "actor" field.
+func (m *IssuanceRequestEventMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *IssuanceRequestEventMutation) Actor() (r string, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActor returns the old "actor" field's value of the IssuanceRequestEvent entity.
+// If the IssuanceRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IssuanceRequestEventMutation) OldActor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
+	}
+	return oldValue.Actor, nil
+}
+
+// ResetActor resets all changes to the "actor" field.
+func (m *IssuanceRequestEventMutation) ResetActor() {
+	m.actor = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *IssuanceRequestEventMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *IssuanceRequestEventMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the IssuanceRequestEvent entity.
+// If the IssuanceRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IssuanceRequestEventMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *IssuanceRequestEventMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[issuancerequestevent.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *IssuanceRequestEventMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[issuancerequestevent.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *IssuanceRequestEventMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, issuancerequestevent.FieldReason)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IssuanceRequestEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IssuanceRequestEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the IssuanceRequestEvent entity.
+// If the IssuanceRequestEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IssuanceRequestEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IssuanceRequestEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetRequestID sets the "request" edge to the IssuanceRequest entity by id.
+func (m *IssuanceRequestEventMutation) SetRequestID(id string) {
+	m.request = &id
+}
+
+// ClearRequest clears the "request" edge to the IssuanceRequest entity.
+func (m *IssuanceRequestEventMutation) ClearRequest() {
+	m.clearedrequest = true
+}
+
+// RequestCleared reports if the "request" edge to the IssuanceRequest entity was cleared.
+func (m *IssuanceRequestEventMutation) RequestCleared() bool {
+	return m.clearedrequest
+}
+
+// RequestID returns the "request" edge ID in the mutation.
+func (m *IssuanceRequestEventMutation) RequestID() (id string, exists bool) {
+	if m.request != nil {
+		return *m.request, true
+	}
+	return
+}
+
+// RequestIDs returns the "request" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RequestID instead. It exists only for internal usage by the builders.
+func (m *IssuanceRequestEventMutation) RequestIDs() (ids []string) {
+	if id := m.request; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRequest resets all changes to the "request" edge.
+func (m *IssuanceRequestEventMutation) ResetRequest() {
+	m.request = nil
+	m.clearedrequest = false
+}
+
+// Where appends a list predicates to the IssuanceRequestEventMutation builder.
+func (m *IssuanceRequestEventMutation) Where(ps ...predicate.IssuanceRequestEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *IssuanceRequestEventMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (IssuanceRequestEvent).
+func (m *IssuanceRequestEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IssuanceRequestEventMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.from_status != nil {
+		fields = append(fields, issuancerequestevent.FieldFromStatus)
+	}
+	if m.to_status != nil {
+		fields = append(fields, issuancerequestevent.FieldToStatus)
+	}
+	if m.actor != nil {
+		fields = append(fields, issuancerequestevent.FieldActor)
+	}
+	if m.reason != nil {
+		fields = append(fields, issuancerequestevent.FieldReason)
+	}
+	if m.created_at != nil {
+		fields = append(fields, issuancerequestevent.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IssuanceRequestEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case issuancerequestevent.FieldFromStatus:
+		return m.FromStatus()
+	case issuancerequestevent.FieldToStatus:
+		return m.ToStatus()
+	case issuancerequestevent.FieldActor:
+		return m.Actor()
+	case issuancerequestevent.FieldReason:
+		return m.Reason()
+	case issuancerequestevent.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IssuanceRequestEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case issuancerequestevent.FieldFromStatus:
+		return m.OldFromStatus(ctx)
+	case issuancerequestevent.FieldToStatus:
+		return m.OldToStatus(ctx)
+	case issuancerequestevent.FieldActor:
+		return m.OldActor(ctx)
+	case issuancerequestevent.FieldReason:
+		return m.OldReason(ctx)
+	case issuancerequestevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown IssuanceRequestEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IssuanceRequestEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case issuancerequestevent.FieldFromStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromStatus(v)
+		return nil
+	case issuancerequestevent.FieldToStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToStatus(v)
+		return nil
+	case issuancerequestevent.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case issuancerequestevent.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case issuancerequestevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IssuanceRequestEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IssuanceRequestEventMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IssuanceRequestEventMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IssuanceRequestEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown IssuanceRequestEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IssuanceRequestEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(issuancerequestevent.FieldFromStatus) {
+		fields = append(fields, issuancerequestevent.FieldFromStatus)
+	}
+	if m.FieldCleared(issuancerequestevent.FieldReason) {
+		fields = append(fields, issuancerequestevent.FieldReason)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IssuanceRequestEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IssuanceRequestEventMutation) ClearField(name string) error {
+	switch name {
+	case issuancerequestevent.FieldFromStatus:
+		m.ClearFromStatus()
+		return nil
+	case issuancerequestevent.FieldReason:
+		m.ClearReason()
+		return nil
+	}
+	return fmt.Errorf("unknown IssuanceRequestEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IssuanceRequestEventMutation) ResetField(name string) error {
+	switch name {
+	case issuancerequestevent.FieldFromStatus:
+		m.ResetFromStatus()
+		return nil
+	case issuancerequestevent.FieldToStatus:
+		m.ResetToStatus()
+		return nil
+	case issuancerequestevent.FieldActor:
+		m.ResetActor()
+		return nil
+	case issuancerequestevent.FieldReason:
+		m.ResetReason()
+		return nil
+	case issuancerequestevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown IssuanceRequestEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IssuanceRequestEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.request != nil {
+		edges = append(edges, issuancerequestevent.EdgeRequest)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IssuanceRequestEventMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case issuancerequestevent.EdgeRequest:
+		if id := m.request; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IssuanceRequestEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IssuanceRequestEventMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IssuanceRequestEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedrequest {
+		edges = append(edges, issuancerequestevent.EdgeRequest)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IssuanceRequestEventMutation) EdgeCleared(name string) bool {
+	switch name {
+	case issuancerequestevent.EdgeRequest:
+		return m.clearedrequest
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IssuanceRequestEventMutation) ClearEdge(name string) error {
+	switch name {
+	case issuancerequestevent.EdgeRequest:
+		m.ClearRequest()
+		return nil
+	}
+	return fmt.Errorf("unknown IssuanceRequestEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IssuanceRequestEventMutation) ResetEdge(name string) error {
+	switch name {
+	case issuancerequestevent.EdgeRequest:
+		m.ResetRequest()
+		return nil
+	}
+	return fmt.Errorf("unknown IssuanceRequestEvent edge %s", name)
+}
+
+// LoginSessionMutation represents an operation that mutates the LoginSession nodes in the graph.
+type LoginSessionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	client_id     *string
+	return_url    *string
+	credential    *string
+	created_at    *time.Time
+	expires_at    *time.Time
+	completed_at  *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*LoginSession, error)
+	predicates    []predicate.LoginSession
+}
+
+var _ ent.Mutation = (*LoginSessionMutation)(nil)
+
+// loginsessionOption allows management of the mutation configuration using functional options.
+type loginsessionOption func(*LoginSessionMutation)
+
+// newLoginSessionMutation creates new mutation for the LoginSession entity.
+func newLoginSessionMutation(c config, op Op, opts ...loginsessionOption) *LoginSessionMutation {
+	m := &LoginSessionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLoginSession,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLoginSessionID sets the ID field of the mutation.
+func withLoginSessionID(id string) loginsessionOption {
+	return func(m *LoginSessionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LoginSession
+		)
+		m.oldValue = func(ctx context.Context) (*LoginSession, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LoginSession.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLoginSession sets the old LoginSession of the mutation.
+func withLoginSession(node *LoginSession) loginsessionOption {
+	return func(m *LoginSessionMutation) {
+		m.oldValue = func(context.Context) (*LoginSession, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LoginSessionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LoginSessionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LoginSession entities.
+func (m *LoginSessionMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LoginSessionMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LoginSessionMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LoginSession.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetClientID sets the "client_id" field.
+func (m *LoginSessionMutation) SetClientID(s string) {
+	m.client_id = &s
+}
+
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *LoginSessionMutation) ClientID() (r string, exists bool) {
+	v := m.client_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientID returns the old "client_id" field's value of the LoginSession entity.
+// If the LoginSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginSessionMutation) OldClientID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+	}
+	return oldValue.ClientID, nil
+}
+
+// ClearClientID clears the value of the "client_id" field.
+func (m *LoginSessionMutation) ClearClientID() {
+	m.client_id = nil
+	m.clearedFields[loginsession.FieldClientID] = struct{}{}
+}
+
+// ClientIDCleared returns if the "client_id" field was cleared in this mutation.
+func (m *LoginSessionMutation) ClientIDCleared() bool {
+	_, ok := m.clearedFields[loginsession.FieldClientID]
+	return ok
+}
+
+// ResetClientID resets all changes to the "client_id" field.
+func (m *LoginSessionMutation) ResetClientID() {
+	m.client_id = nil
+	delete(m.clearedFields, loginsession.FieldClientID)
+}
+
+// SetReturnURL sets the "return_url" field.
+func (m *LoginSessionMutation) SetReturnURL(s string) {
+	m.return_url = &s
+}
+
+// ReturnURL returns the value of the "return_url" field in the mutation.
+func (m *LoginSessionMutation) ReturnURL() (r string, exists bool) {
+	v := m.return_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReturnURL returns the old "return_url" field's value of the LoginSession entity.
+// If the LoginSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginSessionMutation) OldReturnURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReturnURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReturnURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReturnURL: %w", err)
+	}
+	return oldValue.ReturnURL, nil
+}
+
+// ClearReturnURL clears the value of the "return_url" field.
+func (m *LoginSessionMutation) ClearReturnURL() {
+	m.return_url = nil
+	m.clearedFields[loginsession.FieldReturnURL] = struct{}{}
+}
+
+// ReturnURLCleared returns if the "return_url" field was cleared in this mutation.
+func (m *LoginSessionMutation) ReturnURLCleared() bool {
+	_, ok := m.clearedFields[loginsession.FieldReturnURL]
+	return ok
+}
+
+// ResetReturnURL resets all changes to the "return_url" field.
+func (m *LoginSessionMutation) ResetReturnURL() {
+	m.return_url = nil
+	delete(m.clearedFields, loginsession.FieldReturnURL)
+}
+
+// SetCredential sets the "credential" field.
+func (m *LoginSessionMutation) SetCredential(s string) {
+	m.credential = &s
+}
+
+// Credential returns the value of the "credential" field in the mutation.
+func (m *LoginSessionMutation) Credential() (r string, exists bool) {
+	v := m.credential
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCredential returns the old "credential" field's value of the LoginSession entity.
+// If the LoginSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginSessionMutation) OldCredential(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCredential is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCredential requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCredential: %w", err)
+	}
+	return oldValue.Credential, nil
+}
+
+// ClearCredential clears the value of the "credential" field.
+func (m *LoginSessionMutation) ClearCredential() {
+	m.credential = nil
+	m.clearedFields[loginsession.FieldCredential] = struct{}{}
+}
+
+// CredentialCleared returns if the "credential" field was cleared in this mutation.
+func (m *LoginSessionMutation) CredentialCleared() bool {
+	_, ok := m.clearedFields[loginsession.FieldCredential]
+	return ok
+}
+
+// ResetCredential resets all changes to the "credential" field.
+func (m *LoginSessionMutation) ResetCredential() {
+	m.credential = nil
+	delete(m.clearedFields, loginsession.FieldCredential)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LoginSessionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LoginSessionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LoginSession entity.
+// If the LoginSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginSessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LoginSessionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *LoginSessionMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *LoginSessionMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the LoginSession entity.
+// If the LoginSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginSessionMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *LoginSessionMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *LoginSessionMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *LoginSessionMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the LoginSession entity.
+// If the LoginSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginSessionMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *LoginSessionMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[loginsession.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *LoginSessionMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[loginsession.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *LoginSessionMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, loginsession.FieldCompletedAt)
+}
+
+// Where appends a list predicates to the LoginSessionMutation builder.
+func (m *LoginSessionMutation) Where(ps ...predicate.LoginSession) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *LoginSessionMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (LoginSession).
+func (m *LoginSessionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LoginSessionMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.client_id != nil {
+		fields = append(fields, loginsession.FieldClientID)
+	}
+	if m.return_url != nil {
+		fields = append(fields, loginsession.FieldReturnURL)
+	}
+	if m.credential != nil {
+		fields = append(fields, loginsession.FieldCredential)
+	}
+	if m.created_at != nil {
+		fields = append(fields, loginsession.FieldCreatedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, loginsession.FieldExpiresAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, loginsession.FieldCompletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LoginSessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case loginsession.FieldClientID:
+		return m.ClientID()
+	case loginsession.FieldReturnURL:
+		return m.ReturnURL()
+	case loginsession.FieldCredential:
+		return m.Credential()
+	case loginsession.FieldCreatedAt:
+		return m.CreatedAt()
+	case loginsession.FieldExpiresAt:
+		return m.ExpiresAt()
+	case loginsession.FieldCompletedAt:
+		return m.CompletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LoginSessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case loginsession.FieldClientID:
+		return m.OldClientID(ctx)
+	case loginsession.FieldReturnURL:
+		return m.OldReturnURL(ctx)
+	case loginsession.FieldCredential:
+		return m.OldCredential(ctx)
+	case loginsession.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case loginsession.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case loginsession.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LoginSession field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoginSessionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case loginsession.FieldClientID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientID(v)
+		return nil
+	case loginsession.FieldReturnURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReturnURL(v)
+		return nil
+	case loginsession.FieldCredential:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCredential(v)
+		return nil
+	case loginsession.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case loginsession.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case loginsession.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LoginSession field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LoginSessionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LoginSessionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoginSessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown LoginSession numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LoginSessionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(loginsession.FieldClientID) {
+		fields = append(fields, loginsession.FieldClientID)
+	}
+	if m.FieldCleared(loginsession.FieldReturnURL) {
+		fields = append(fields, loginsession.FieldReturnURL)
+	}
+	if m.FieldCleared(loginsession.FieldCredential) {
+		fields = append(fields, loginsession.FieldCredential)
+	}
+	if m.FieldCleared(loginsession.FieldCompletedAt) {
+		fields = append(fields, loginsession.FieldCompletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LoginSessionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LoginSessionMutation) ClearField(name string) error {
+	switch name {
+	case loginsession.FieldClientID:
+		m.ClearClientID()
+		return nil
+	case loginsession.FieldReturnURL:
+		m.ClearReturnURL()
+		return nil
+	case loginsession.FieldCredential:
+		m.ClearCredential()
+		return nil
+	case loginsession.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LoginSession nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LoginSessionMutation) ResetField(name string) error {
+	switch name {
+	case loginsession.FieldClientID:
+		m.ResetClientID()
+		return nil
+	case loginsession.FieldReturnURL:
+		m.ResetReturnURL()
+		return nil
+	case loginsession.FieldCredential:
+		m.ResetCredential()
+		return nil
+	case loginsession.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case loginsession.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case loginsession.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LoginSession field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LoginSessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LoginSessionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LoginSessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LoginSessionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LoginSessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LoginSessionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LoginSessionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LoginSession unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LoginSessionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LoginSession edge %s", name)
+}
+
+// NaturalPersonMutation represents an operation that mutates the NaturalPerson nodes in the graph.
+type NaturalPersonMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *string
+	name               *string
+	displayname        *string
+	_type              *string
+	password           *[]byte
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	keys               map[string]struct{}
+	removedkeys        map[string]struct{}
+	clearedkeys        bool
+	credentials        map[string]struct{}
+	removedcredentials map[string]struct{}
+	clearedcredentials bool
+	done               bool
+	oldValue           func(context.Context) (*NaturalPerson, error)
+	predicates         []predicate.NaturalPerson
+}
+
+var _ ent.Mutation = (*NaturalPersonMutation)(nil)
+
+// naturalpersonOption allows management of the mutation configuration using functional options.
+type naturalpersonOption func(*NaturalPersonMutation)
+
+// newNaturalPersonMutation creates new mutation for the NaturalPerson entity.
+func newNaturalPersonMutation(c config, op Op, opts ...naturalpersonOption) *NaturalPersonMutation {
+	m := &NaturalPersonMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNaturalPerson,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNaturalPersonID sets the ID field of the mutation.
+func withNaturalPersonID(id string) naturalpersonOption {
+	return func(m *NaturalPersonMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NaturalPerson
+		)
+		m.oldValue = func(ctx context.Context) (*NaturalPerson, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NaturalPerson.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNaturalPerson sets the old NaturalPerson of the mutation.
+func withNaturalPerson(node *NaturalPerson) naturalpersonOption {
+	return func(m *NaturalPersonMutation) {
+		m.oldValue = func(context.Context) (*NaturalPerson, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NaturalPersonMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NaturalPersonMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of NaturalPerson entities.
+func (m *NaturalPersonMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NaturalPersonMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NaturalPersonMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NaturalPerson.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *NaturalPersonMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *NaturalPersonMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the NaturalPerson entity.
+// If the NaturalPerson object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NaturalPersonMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *NaturalPersonMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDisplayname sets the "displayname" field.
+func (m *NaturalPersonMutation) SetDisplayname(s string) {
+	m.displayname = &s
+}
+
+// Displayname returns the value of the "displayname" field in the mutation.
+func (m *NaturalPersonMutation) Displayname() (r string, exists bool) {
+	v := m.displayname
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayname returns the old "displayname" field's value of the NaturalPerson entity.
+// If the NaturalPerson object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NaturalPersonMutation) OldDisplayname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayname is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayname requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayname: %w", err)
+	}
+	return oldValue.Displayname, nil
+}
+
+// ClearDisplayname clears the value of the "displayname" field.
+func (m *NaturalPersonMutation) ClearDisplayname() {
+	m.displayname = nil
+	m.clearedFields[naturalperson.FieldDisplayname] = struct{}{}
+}
+
+// DisplaynameCleared returns if the "displayname" field was cleared in this mutation.
+func (m *NaturalPersonMutation) DisplaynameCleared() bool {
+	_, ok := m.clearedFields[naturalperson.FieldDisplayname]
+	return ok
+}
+
+// ResetDisplayname resets all changes to the "displayname" field.
+func (m *NaturalPersonMutation) ResetDisplayname() {
+	m.displayname = nil
+	delete(m.clearedFields, naturalperson.FieldDisplayname)
+}
+
+// SetType sets the "type" field.
+func (m *NaturalPersonMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *NaturalPersonMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the NaturalPerson entity.
+// If the NaturalPerson object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NaturalPersonMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *NaturalPersonMutation) ResetType() {
+	m._type = nil
+}
+
+// SetPassword sets the "password" field.
+func (m *NaturalPersonMutation) SetPassword(b []byte) {
+	m.password = &b
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *NaturalPersonMutation) Password() (r []byte, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the NaturalPerson entity.
+// If the NaturalPerson object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NaturalPersonMutation) OldPassword(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *NaturalPersonMutation) ResetPassword() {
+	m.password = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NaturalPersonMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NaturalPersonMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the NaturalPerson entity.
+// If the NaturalPerson object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NaturalPersonMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NaturalPersonMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *NaturalPersonMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *NaturalPersonMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the NaturalPerson entity.
+// If the NaturalPerson object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NaturalPersonMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *NaturalPersonMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddKeyIDs adds the "keys" edge to the PrivateKey entity by ids.
+func (m *NaturalPersonMutation) AddKeyIDs(ids ...string) {
+	if m.keys == nil {
+		m.keys = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.keys[ids[i]] = struct{}{}
+	}
+}
+
+// ClearKeys clears the "keys" edge to the PrivateKey entity.
+func (m *NaturalPersonMutation) ClearKeys() {
+	m.clearedkeys = true
+}
+
+// KeysCleared reports if the "keys" edge to the PrivateKey entity was cleared.
+func (m *NaturalPersonMutation) KeysCleared() bool {
+	return m.clearedkeys
+}
+
+// RemoveKeyIDs removes the "keys" edge to the PrivateKey entity by IDs.
+func (m *NaturalPersonMutation) RemoveKeyIDs(ids ...string) {
+	if m.removedkeys == nil {
+		m.removedkeys = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.keys, ids[i])
+		m.removedkeys[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedKeys returns the removed IDs of the "keys" edge to the PrivateKey entity.
+func (m *NaturalPersonMutation) RemovedKeysIDs() (ids []string) {
+	for id := range m.removedkeys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// KeysIDs returns the "keys" edge IDs in the mutation.
 func (m *NaturalPersonMutation) KeysIDs() (ids []string) {
 	for id := range m.keys {
 		ids = append(ids, id)
 	}
-	return
+	return
+}
+
+// ResetKeys resets all changes to the "keys" edge.
+func (m *NaturalPersonMutation) ResetKeys() {
+	m.keys = nil
+	m.clearedkeys = false
+	m.removedkeys = nil
+}
+
+// AddCredentialIDs adds the "credentials" edge to the Credential entity by ids.
+func (m *NaturalPersonMutation) AddCredentialIDs(ids ...string) {
+	if m.credentials == nil {
+		m.credentials = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.credentials[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCredentials clears the "credentials" edge to the Credential entity.
+func (m *NaturalPersonMutation) ClearCredentials() {
+	m.clearedcredentials = true
+}
+
+// CredentialsCleared reports if the "credentials" edge to the Credential entity was cleared.
+func (m *NaturalPersonMutation) CredentialsCleared() bool {
+	return m.clearedcredentials
+}
+
+// RemoveCredentialIDs removes the "credentials" edge to the Credential entity by IDs.
+func (m *NaturalPersonMutation) RemoveCredentialIDs(ids ...string) {
+	if m.removedcredentials == nil {
+		m.removedcredentials = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.credentials, ids[i])
+		m.removedcredentials[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCredentials returns the removed IDs of the "credentials" edge to the Credential entity.
+func (m *NaturalPersonMutation) RemovedCredentialsIDs() (ids []string) {
+	for id := range m.removedcredentials {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CredentialsIDs returns the "credentials" edge IDs in the mutation.
+func (m *NaturalPersonMutation) CredentialsIDs() (ids []string) {
+	for id := range m.credentials {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCredentials resets all changes to the "credentials" edge.
+func (m *NaturalPersonMutation) ResetCredentials() {
+	m.credentials = nil
+	m.clearedcredentials = false
+	m.removedcredentials = nil
+}
+
+// Where appends a list predicates to the NaturalPersonMutation builder.
+func (m *NaturalPersonMutation) Where(ps ...predicate.NaturalPerson) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *NaturalPersonMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (NaturalPerson).
+func (m *NaturalPersonMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NaturalPersonMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.name != nil {
+		fields = append(fields, naturalperson.FieldName)
+	}
+	if m.displayname != nil {
+		fields = append(fields, naturalperson.FieldDisplayname)
+	}
+	if m._type != nil {
+		fields = append(fields, naturalperson.FieldType)
+	}
+	if m.password != nil {
+		fields = append(fields, naturalperson.FieldPassword)
+	}
+	if m.created_at != nil {
+		fields = append(fields, naturalperson.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, naturalperson.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NaturalPersonMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case naturalperson.FieldName:
+		return m.Name()
+	case naturalperson.FieldDisplayname:
+		return m.Displayname()
+	case naturalperson.FieldType:
+		return m.GetType()
+	case naturalperson.FieldPassword:
+		return m.Password()
+	case naturalperson.FieldCreatedAt:
+		return m.CreatedAt()
+	case naturalperson.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NaturalPersonMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case naturalperson.FieldName:
+		return m.OldName(ctx)
+	case naturalperson.FieldDisplayname:
+		return m.OldDisplayname(ctx)
+	case naturalperson.FieldType:
+		return m.OldType(ctx)
+	case naturalperson.FieldPassword:
+		return m.OldPassword(ctx)
+	case naturalperson.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case naturalperson.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown NaturalPerson field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NaturalPersonMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case naturalperson.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case naturalperson.FieldDisplayname:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayname(v)
+		return nil
+	case naturalperson.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case naturalperson.FieldPassword:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	case naturalperson.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case naturalperson.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NaturalPerson field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NaturalPersonMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NaturalPersonMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NaturalPersonMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown NaturalPerson numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NaturalPersonMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(naturalperson.FieldDisplayname) {
+		fields = append(fields, naturalperson.FieldDisplayname)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NaturalPersonMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NaturalPersonMutation) ClearField(name string) error {
+	switch name {
+	case naturalperson.FieldDisplayname:
+		m.ClearDisplayname()
+		return nil
+	}
+	return fmt.Errorf("unknown NaturalPerson nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NaturalPersonMutation) ResetField(name string) error {
+	switch name {
+	case naturalperson.FieldName:
+		m.ResetName()
+		return nil
+	case naturalperson.FieldDisplayname:
+		m.ResetDisplayname()
+		return nil
+	case naturalperson.FieldType:
+		m.ResetType()
+		return nil
+	case naturalperson.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case naturalperson.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case naturalperson.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown NaturalPerson field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NaturalPersonMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.keys != nil {
+		edges = append(edges, naturalperson.EdgeKeys)
+	}
+	if m.credentials != nil {
+		edges = append(edges, naturalperson.EdgeCredentials)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NaturalPersonMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case naturalperson.EdgeKeys:
+		ids := make([]ent.Value, 0, len(m.keys))
+		for id := range m.keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case naturalperson.EdgeCredentials:
+		ids := make([]ent.Value, 0, len(m.credentials))
+		for id := range m.credentials {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NaturalPersonMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedkeys != nil {
+		edges = append(edges, naturalperson.EdgeKeys)
+	}
+	if m.removedcredentials != nil {
+		edges = append(edges, naturalperson.EdgeCredentials)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NaturalPersonMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case naturalperson.EdgeKeys:
+		ids := make([]ent.Value, 0, len(m.removedkeys))
+		for id := range m.removedkeys {
+			ids = append(ids, id)
+		}
+		return ids
+	case naturalperson.EdgeCredentials:
+		ids := make([]ent.Value, 0, len(m.removedcredentials))
+		for id := range m.removedcredentials {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NaturalPersonMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedkeys {
+		edges = append(edges, naturalperson.EdgeKeys)
+	}
+	if m.clearedcredentials {
+		edges = append(edges, naturalperson.EdgeCredentials)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NaturalPersonMutation) EdgeCleared(name string) bool {
+	switch name {
+	case naturalperson.EdgeKeys:
+		return m.clearedkeys
+	case naturalperson.EdgeCredentials:
+		return m.clearedcredentials
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NaturalPersonMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown NaturalPerson unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NaturalPersonMutation) ResetEdge(name string) error {
+	switch name {
+	case naturalperson.EdgeKeys:
+		m.ResetKeys()
+		return nil
+	case naturalperson.EdgeCredentials:
+		m.ResetCredentials()
+		return nil
+	}
+	return fmt.Errorf("unknown NaturalPerson edge %s", name)
+}
+
+// PresentationNonceMutation represents an operation that mutates the PresentationNonce nodes in the graph.
+type PresentationNonceMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	state         *string
+	client_id     *string
+	audience      *string
+	created_at    *time.Time
+	expires_at    *time.Time
+	consumed_at   *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*PresentationNonce, error)
+	predicates    []predicate.PresentationNonce
+}
+
+var _ ent.Mutation = (*PresentationNonceMutation)(nil)
+
+// presentationnonceOption allows management of the mutation configuration using functional options.
+type presentationnonceOption func(*PresentationNonceMutation)
+
+// newPresentationNonceMutation creates new mutation for the PresentationNonce entity.
+func newPresentationNonceMutation(c config, op Op, opts ...presentationnonceOption) *PresentationNonceMutation {
+	m := &PresentationNonceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePresentationNonce,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPresentationNonceID sets the ID field of the mutation.
+func withPresentationNonceID(id string) presentationnonceOption {
+	return func(m *PresentationNonceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PresentationNonce
+		)
+		m.oldValue = func(ctx context.Context) (*PresentationNonce, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PresentationNonce.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPresentationNonce sets the old PresentationNonce of the mutation.
+func withPresentationNonce(node *PresentationNonce) presentationnonceOption {
+	return func(m *PresentationNonceMutation) {
+		m.oldValue = func(context.Context) (*PresentationNonce, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PresentationNonceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PresentationNonceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PresentationNonce entities.
+func (m *PresentationNonceMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PresentationNonceMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PresentationNonceMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PresentationNonce.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetState sets the "state" field.
+func (m *PresentationNonceMutation) SetState(s string) {
+	m.state = &s
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *PresentationNonceMutation) State() (r string, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the PresentationNonce entity.
+// If the PresentationNonce object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PresentationNonceMutation) OldState(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *PresentationNonceMutation) ResetState() {
+	m.state = nil
+}
+
+// SetClientID sets the "client_id" field.
+func (m *PresentationNonceMutation) SetClientID(s string) {
+	m.client_id = &s
+}
+
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *PresentationNonceMutation) ClientID() (r string, exists bool) {
+	v := m.client_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientID returns the old "client_id" field's value of the PresentationNonce entity.
+// If the PresentationNonce object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PresentationNonceMutation) OldClientID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+	}
+	return oldValue.ClientID, nil
+}
+
+// ClearClientID clears the value of the "client_id" field.
+func (m *PresentationNonceMutation) ClearClientID() {
+	m.client_id = nil
+	m.clearedFields[presentationnonce.FieldClientID] = struct{}{}
+}
+
+// ClientIDCleared returns if the "client_id" field was cleared in this mutation.
+func (m *PresentationNonceMutation) ClientIDCleared() bool {
+	_, ok := m.clearedFields[presentationnonce.FieldClientID]
+	return ok
+}
+
+// ResetClientID resets all changes to the "client_id" field.
+func (m *PresentationNonceMutation) ResetClientID() {
+	m.client_id = nil
+	delete(m.clearedFields, presentationnonce.FieldClientID)
+}
+
+// SetAudience sets the "audience" field.
+func (m *PresentationNonceMutation) SetAudience(s string) {
+	m.audience = &s
+}
+
+// Audience returns the value of the "audience" field in the mutation.
+func (m *PresentationNonceMutation) Audience() (r string, exists bool) {
+	v := m.audience
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAudience returns the old "audience" field's value of the PresentationNonce entity.
+// If the PresentationNonce object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PresentationNonceMutation) OldAudience(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAudience is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAudience requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAudience: %w", err)
+	}
+	return oldValue.Audience, nil
+}
+
+// ResetAudience resets all changes to the "audience" field.
+func (m *PresentationNonceMutation) ResetAudience() {
+	m.audience = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PresentationNonceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PresentationNonceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PresentationNonce entity.
+// If the PresentationNonce object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PresentationNonceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PresentationNonceMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *PresentationNonceMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *PresentationNonceMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the PresentationNonce entity.
+// If the PresentationNonce object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PresentationNonceMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *PresentationNonceMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetConsumedAt sets the "consumed_at" field.
+func (m *PresentationNonceMutation) SetConsumedAt(t time.Time) {
+	m.consumed_at = &t
+}
+
+// ConsumedAt returns the value of the "consumed_at" field in the mutation.
+func (m *PresentationNonceMutation) ConsumedAt() (r time.Time, exists bool) {
+	v := m.consumed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConsumedAt returns the old "consumed_at" field's value of the PresentationNonce entity.
+// If the PresentationNonce object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PresentationNonceMutation) OldConsumedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConsumedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConsumedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConsumedAt: %w", err)
+	}
+	return oldValue.ConsumedAt, nil
+}
+
+// ClearConsumedAt clears the value of the "consumed_at" field.
+func (m *PresentationNonceMutation) ClearConsumedAt() {
+	m.consumed_at = nil
+	m.clearedFields[presentationnonce.FieldConsumedAt] = struct{}{}
+}
+
+// ConsumedAtCleared returns if the "consumed_at" field was cleared in this mutation.
+func (m *PresentationNonceMutation) ConsumedAtCleared() bool {
+	_, ok := m.clearedFields[presentationnonce.FieldConsumedAt]
+	return ok
+}
+
+// ResetConsumedAt resets all changes to the "consumed_at" field.
+func (m *PresentationNonceMutation) ResetConsumedAt() {
+	m.consumed_at = nil
+	delete(m.clearedFields, presentationnonce.FieldConsumedAt)
+}
+
+// Where appends a list predicates to the PresentationNonceMutation builder.
+func (m *PresentationNonceMutation) Where(ps ...predicate.PresentationNonce) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *PresentationNonceMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (PresentationNonce).
+func (m *PresentationNonceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PresentationNonceMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.state != nil {
+		fields = append(fields, presentationnonce.FieldState)
+	}
+	if m.client_id != nil {
+		fields = append(fields, presentationnonce.FieldClientID)
+	}
+	if m.audience != nil {
+		fields = append(fields, presentationnonce.FieldAudience)
+	}
+	if m.created_at != nil {
+		fields = append(fields, presentationnonce.FieldCreatedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, presentationnonce.FieldExpiresAt)
+	}
+	if m.consumed_at != nil {
+		fields = append(fields, presentationnonce.FieldConsumedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PresentationNonceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case presentationnonce.FieldState:
+		return m.State()
+	case presentationnonce.FieldClientID:
+		return m.ClientID()
+	case presentationnonce.FieldAudience:
+		return m.Audience()
+	case presentationnonce.FieldCreatedAt:
+		return m.CreatedAt()
+	case presentationnonce.FieldExpiresAt:
+		return m.ExpiresAt()
+	case presentationnonce.FieldConsumedAt:
+		return m.ConsumedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PresentationNonceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case presentationnonce.FieldState:
+		return m.OldState(ctx)
+	case presentationnonce.FieldClientID:
+		return m.OldClientID(ctx)
+	case presentationnonce.FieldAudience:
+		return m.OldAudience(ctx)
+	case presentationnonce.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case presentationnonce.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case presentationnonce.FieldConsumedAt:
+		return m.OldConsumedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PresentationNonce field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PresentationNonceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case presentationnonce.FieldState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case presentationnonce.FieldClientID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientID(v)
+		return nil
+	case presentationnonce.FieldAudience:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAudience(v)
+		return nil
+	case presentationnonce.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case presentationnonce.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case presentationnonce.FieldConsumedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConsumedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PresentationNonce field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PresentationNonceMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PresentationNonceMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PresentationNonceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PresentationNonce numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PresentationNonceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(presentationnonce.FieldClientID) {
+		fields = append(fields, presentationnonce.FieldClientID)
+	}
+	if m.FieldCleared(presentationnonce.FieldConsumedAt) {
+		fields = append(fields, presentationnonce.FieldConsumedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PresentationNonceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PresentationNonceMutation) ClearField(name string) error {
+	switch name {
+	case presentationnonce.FieldClientID:
+		m.ClearClientID()
+		return nil
+	case presentationnonce.FieldConsumedAt:
+		m.ClearConsumedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PresentationNonce nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PresentationNonceMutation) ResetField(name string) error {
+	switch name {
+	case presentationnonce.FieldState:
+		m.ResetState()
+		return nil
+	case presentationnonce.FieldClientID:
+		m.ResetClientID()
+		return nil
+	case presentationnonce.FieldAudience:
+		m.ResetAudience()
+		return nil
+	case presentationnonce.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case presentationnonce.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case presentationnonce.FieldConsumedAt:
+		m.ResetConsumedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PresentationNonce field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PresentationNonceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PresentationNonceMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PresentationNonceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PresentationNonceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PresentationNonceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PresentationNonceMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PresentationNonceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PresentationNonce unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PresentationNonceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PresentationNonce edge %s", name)
+}
+
+// PrivateKeyMutation represents an operation that mutates the PrivateKey nodes in the graph.
+type PrivateKeyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	kty           *string
+	alg           *string
+	jwk           *[]uint8
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *string
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*PrivateKey, error)
+	predicates    []predicate.PrivateKey
+}
+
+var _ ent.Mutation = (*PrivateKeyMutation)(nil)
+
+// privatekeyOption allows management of the mutation configuration using functional options.
+type privatekeyOption func(*PrivateKeyMutation)
+
+// newPrivateKeyMutation creates new mutation for the PrivateKey entity.
+func newPrivateKeyMutation(c config, op Op, opts ...privatekeyOption) *PrivateKeyMutation {
+	m := &PrivateKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePrivateKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPrivateKeyID sets the ID field of the mutation.
+func withPrivateKeyID(id string) privatekeyOption {
+	return func(m *PrivateKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PrivateKey
+		)
+		m.oldValue = func(ctx context.Context) (*PrivateKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PrivateKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPrivateKey sets the old PrivateKey of the mutation.
+func withPrivateKey(node *PrivateKey) privatekeyOption {
+	return func(m *PrivateKeyMutation) {
+		m.oldValue = func(context.Context) (*PrivateKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PrivateKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PrivateKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PrivateKey entities.
+func (m *PrivateKeyMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PrivateKeyMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PrivateKeyMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PrivateKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKty sets the "kty" field.
+func (m *PrivateKeyMutation) SetKty(s string) {
+	m.kty = &s
+}
+
+// Kty returns the value of the "kty" field in the mutation.
+func (m *PrivateKeyMutation) Kty() (r string, exists bool) {
+	v := m.kty
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKty returns the old "kty" field's value of the PrivateKey entity.
+// If the PrivateKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivateKeyMutation) OldKty(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKty is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKty requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKty: %w", err)
+	}
+	return oldValue.Kty, nil
+}
+
+// ResetKty resets all changes to the "kty" field.
+func (m *PrivateKeyMutation) ResetKty() {
+	m.kty = nil
+}
+
+// SetAlg sets the "alg" field.
+func (m *PrivateKeyMutation) SetAlg(s string) {
+	m.alg = &s
+}
+
+// Alg returns the value of the "alg" field in the mutation.
+func (m *PrivateKeyMutation) Alg() (r string, exists bool) {
+	v := m.alg
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlg returns the old "alg" field's value of the PrivateKey entity.
+// If the PrivateKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivateKeyMutation) OldAlg(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlg is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlg requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlg: %w", err)
+	}
+	return oldValue.Alg, nil
+}
+
+// ClearAlg clears the value of the "alg" field.
+func (m *PrivateKeyMutation) ClearAlg() {
+	m.alg = nil
+	m.clearedFields[privatekey.FieldAlg] = struct{}{}
+}
+
+// AlgCleared returns if the "alg" field was cleared in this mutation.
+func (m *PrivateKeyMutation) AlgCleared() bool {
+	_, ok := m.clearedFields[privatekey.FieldAlg]
+	return ok
+}
+
+// ResetAlg resets all changes to the "alg" field.
+func (m *PrivateKeyMutation) ResetAlg() {
+	m.alg = nil
+	delete(m.clearedFields, privatekey.FieldAlg)
+}
+
+// SetJwk sets the "jwk" field.
+func (m *PrivateKeyMutation) SetJwk(u []uint8) {
+	m.jwk = &u
+}
+
+// Jwk returns the value of the "jwk" field in the mutation.
+func (m *PrivateKeyMutation) Jwk() (r []uint8, exists bool) {
+	v := m.jwk
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJwk returns the old "jwk" field's value of the PrivateKey entity.
+// If the PrivateKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivateKeyMutation) OldJwk(ctx context.Context) (v []uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJwk is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJwk requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJwk: %w", err)
+	}
+	return oldValue.Jwk, nil
+}
+
+// ResetJwk resets all changes to the "jwk" field.
+func (m *PrivateKeyMutation) ResetJwk() {
+	m.jwk = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PrivateKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PrivateKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PrivateKey entity.
+// If the PrivateKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivateKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PrivateKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PrivateKeyMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PrivateKeyMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PrivateKey entity.
+// If the PrivateKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivateKeyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PrivateKeyMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *PrivateKeyMutation) SetUserID(id string) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PrivateKeyMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PrivateKeyMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *PrivateKeyMutation) UserID() (id string, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PrivateKeyMutation) UserIDs() (ids []string) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *PrivateKeyMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the PrivateKeyMutation builder.
+func (m *PrivateKeyMutation) Where(ps ...predicate.PrivateKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *PrivateKeyMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (PrivateKey).
+func (m *PrivateKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PrivateKeyMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.kty != nil {
+		fields = append(fields, privatekey.FieldKty)
+	}
+	if m.alg != nil {
+		fields = append(fields, privatekey.FieldAlg)
+	}
+	if m.jwk != nil {
+		fields = append(fields, privatekey.FieldJwk)
+	}
+	if m.created_at != nil {
+		fields = append(fields, privatekey.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, privatekey.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PrivateKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case privatekey.FieldKty:
+		return m.Kty()
+	case privatekey.FieldAlg:
+		return m.Alg()
+	case privatekey.FieldJwk:
+		return m.Jwk()
+	case privatekey.FieldCreatedAt:
+		return m.CreatedAt()
+	case privatekey.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PrivateKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case privatekey.FieldKty:
+		return m.OldKty(ctx)
+	case privatekey.FieldAlg:
+		return m.OldAlg(ctx)
+	case privatekey.FieldJwk:
+		return m.OldJwk(ctx)
+	case privatekey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case privatekey.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PrivateKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PrivateKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case privatekey.FieldKty:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKty(v)
+		return nil
+	case privatekey.FieldAlg:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlg(v)
+		return nil
+	case privatekey.FieldJwk:
+		v, ok := value.([]uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJwk(v)
+		return nil
+	case privatekey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case privatekey.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PrivateKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PrivateKeyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PrivateKeyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PrivateKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PrivateKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PrivateKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(privatekey.FieldAlg) {
+		fields = append(fields, privatekey.FieldAlg)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PrivateKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PrivateKeyMutation) ClearField(name string) error {
+	switch name {
+	case privatekey.FieldAlg:
+		m.ClearAlg()
+		return nil
+	}
+	return fmt.Errorf("unknown PrivateKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PrivateKeyMutation) ResetField(name string) error {
+	switch name {
+	case privatekey.FieldKty:
+		m.ResetKty()
+		return nil
+	case privatekey.FieldAlg:
+		m.ResetAlg()
+		return nil
+	case privatekey.FieldJwk:
+		m.ResetJwk()
+		return nil
+	case privatekey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case privatekey.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PrivateKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PrivateKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, privatekey.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PrivateKeyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case privatekey.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PrivateKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PrivateKeyMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PrivateKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, privatekey.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PrivateKeyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case privatekey.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PrivateKeyMutation) ClearEdge(name string) error {
+	switch name {
+	case privatekey.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown PrivateKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PrivateKeyMutation) ResetEdge(name string) error {
+	switch name {
+	case privatekey.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown PrivateKey edge %s", name)
+}
+
+// PublicKeyMutation represents an operation that mutates the PublicKey nodes in the graph.
+type PublicKeyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	kty           *string
+	alg           *string
+	jwk           *[]uint8
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*PublicKey, error)
+	predicates    []predicate.PublicKey
+}
+
+var _ ent.Mutation = (*PublicKeyMutation)(nil)
+
+// publickeyOption allows management of the mutation configuration using functional options.
+type publickeyOption func(*PublicKeyMutation)
+
+// newPublicKeyMutation creates new mutation for the PublicKey entity.
+func newPublicKeyMutation(c config, op Op, opts ...publickeyOption) *PublicKeyMutation {
+	m := &PublicKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePublicKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPublicKeyID sets the ID field of the mutation.
+func withPublicKeyID(id string) publickeyOption {
+	return func(m *PublicKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PublicKey
+		)
+		m.oldValue = func(ctx context.Context) (*PublicKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PublicKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPublicKey sets the old PublicKey of the mutation.
+func withPublicKey(node *PublicKey) publickeyOption {
+	return func(m *PublicKeyMutation) {
+		m.oldValue = func(context.Context) (*PublicKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PublicKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PublicKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PublicKey entities.
+func (m *PublicKeyMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PublicKeyMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PublicKeyMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PublicKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKty sets the "kty" field.
+func (m *PublicKeyMutation) SetKty(s string) {
+	m.kty = &s
+}
+
+// Kty returns the value of the "kty" field in the mutation.
+func (m *PublicKeyMutation) Kty() (r string, exists bool) {
+	v := m.kty
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKty returns the old "kty" field's value of the PublicKey entity.
+// If the PublicKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicKeyMutation) OldKty(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKty is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKty requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKty: %w", err)
+	}
+	return oldValue.Kty, nil
+}
+
+// ResetKty resets all changes to the "kty" field.
+func (m *PublicKeyMutation) ResetKty() {
+	m.kty = nil
+}
+
+// SetAlg sets the "alg" field.
+func (m *PublicKeyMutation) SetAlg(s string) {
+	m.alg = &s
+}
+
+// Alg returns the value of the "alg" field in the mutation.
+func (m *PublicKeyMutation) Alg() (r string, exists bool) {
+	v := m.alg
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlg returns the old "alg" field's value of the PublicKey entity.
+// If the PublicKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicKeyMutation) OldAlg(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlg is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlg requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlg: %w", err)
+	}
+	return oldValue.Alg, nil
+}
+
+// ClearAlg clears the value of the "alg" field.
+func (m *PublicKeyMutation) ClearAlg() {
+	m.alg = nil
+	m.clearedFields[publickey.FieldAlg] = struct{}{}
+}
+
+// AlgCleared returns if the "alg" field was cleared in this mutation.
+func (m *PublicKeyMutation) AlgCleared() bool {
+	_, ok := m.clearedFields[publickey.FieldAlg]
+	return ok
+}
+
+// ResetAlg resets all changes to the "alg" field.
+func (m *PublicKeyMutation) ResetAlg() {
+	m.alg = nil
+	delete(m.clearedFields, publickey.FieldAlg)
+}
+
+// SetJwk sets the "jwk" field.
+func (m *PublicKeyMutation) SetJwk(u []uint8) {
+	m.jwk = &u
+}
+
+// Jwk returns the value of the "jwk" field in the mutation.
+func (m *PublicKeyMutation) Jwk() (r []uint8, exists bool) {
+	v := m.jwk
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJwk returns the old "jwk" field's value of the PublicKey entity.
+// If the PublicKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicKeyMutation) OldJwk(ctx context.Context) (v []uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJwk is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJwk requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJwk: %w", err)
+	}
+	return oldValue.Jwk, nil
+}
+
+// ResetJwk resets all changes to the "jwk" field.
+func (m *PublicKeyMutation) ResetJwk() {
+	m.jwk = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PublicKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PublicKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PublicKey entity.
+// If the PublicKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PublicKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PublicKeyMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PublicKeyMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PublicKey entity.
+// If the PublicKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PublicKeyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PublicKeyMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the PublicKeyMutation builder.
+func (m *PublicKeyMutation) Where(ps ...predicate.PublicKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *PublicKeyMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (PublicKey).
+func (m *PublicKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PublicKeyMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.kty != nil {
+		fields = append(fields, publickey.FieldKty)
+	}
+	if m.alg != nil {
+		fields = append(fields, publickey.FieldAlg)
+	}
+	if m.jwk != nil {
+		fields = append(fields, publickey.FieldJwk)
+	}
+	if m.created_at != nil {
+		fields = append(fields, publickey.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, publickey.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PublicKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case publickey.FieldKty:
+		return m.Kty()
+	case publickey.FieldAlg:
+		return m.Alg()
+	case publickey.FieldJwk:
+		return m.Jwk()
+	case publickey.FieldCreatedAt:
+		return m.CreatedAt()
+	case publickey.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PublicKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case publickey.FieldKty:
+		return m.OldKty(ctx)
+	case publickey.FieldAlg:
+		return m.OldAlg(ctx)
+	case publickey.FieldJwk:
+		return m.OldJwk(ctx)
+	case publickey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case publickey.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PublicKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PublicKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case publickey.FieldKty:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKty(v)
+		return nil
+	case publickey.FieldAlg:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlg(v)
+		return nil
+	case publickey.FieldJwk:
+		v, ok := value.([]uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJwk(v)
+		return nil
+	case publickey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case publickey.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PublicKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PublicKeyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PublicKeyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PublicKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PublicKey numeric field %s", name)
 }
 
-// ResetKeys resets all changes to the "keys" edge.
-func (m *NaturalPersonMutation) ResetKeys() {
-	m.keys = nil
-	m.clearedkeys = false
-	m.removedkeys = nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PublicKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(publickey.FieldAlg) {
+		fields = append(fields, publickey.FieldAlg)
+	}
+	return fields
 }
 
-// AddCredentialIDs adds the "credentials" edge to the Credential entity by ids.
-func (m *NaturalPersonMutation) AddCredentialIDs(ids ...string) {
-	if m.credentials == nil {
-		m.credentials = make(map[string]struct{})
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PublicKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PublicKeyMutation) ClearField(name string) error {
+	switch name {
+	case publickey.FieldAlg:
+		m.ClearAlg()
+		return nil
 	}
-	for i := range ids {
-		m.credentials[ids[i]] = struct{}{}
+	return fmt.Errorf("unknown PublicKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PublicKeyMutation) ResetField(name string) error {
+	switch name {
+	case publickey.FieldKty:
+		m.ResetKty()
+		return nil
+	case publickey.FieldAlg:
+		m.ResetAlg()
+		return nil
+	case publickey.FieldJwk:
+		m.ResetJwk()
+		return nil
+	case publickey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case publickey.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
+	return fmt.Errorf("unknown PublicKey field %s", name)
 }
 
-// ClearCredentials clears the "credentials" edge to the Credential entity.
-func (m *NaturalPersonMutation) ClearCredentials() {
-	m.clearedcredentials = true
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PublicKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// CredentialsCleared reports if the "credentials" edge to the Credential entity was cleared.
-func (m *NaturalPersonMutation) CredentialsCleared() bool {
-	return m.clearedcredentials
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PublicKeyMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// RemoveCredentialIDs removes the "credentials" edge to the Credential entity by IDs.
-func (m *NaturalPersonMutation) RemoveCredentialIDs(ids ...string) {
-	if m.removedcredentials == nil {
-		m.removedcredentials = make(map[string]struct{})
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PublicKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PublicKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PublicKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PublicKeyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PublicKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PublicKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PublicKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PublicKey edge %s", name)
+}
+
+// RateCounterMutation represents an operation that mutates the RateCounter nodes in the graph.
+type RateCounterMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	count         *int
+	addcount      *int
+	expires_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*RateCounter, error)
+	predicates    []predicate.RateCounter
+}
+
+var _ ent.Mutation = (*RateCounterMutation)(nil)
+
+// ratecounterOption allows management of the mutation configuration using functional options.
+type ratecounterOption func(*RateCounterMutation)
+
+// newRateCounterMutation creates new mutation for the RateCounter entity.
+func newRateCounterMutation(c config, op Op, opts ...ratecounterOption) *RateCounterMutation {
+	m := &RateCounterMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRateCounter,
+		clearedFields: make(map[string]struct{}),
 	}
-	for i := range ids {
-		delete(m.credentials, ids[i])
-		m.removedcredentials[ids[i]] = struct{}{}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
-// RemovedCredentials returns the removed IDs of the "credentials" edge to the Credential entity.
-func (m *NaturalPersonMutation) RemovedCredentialsIDs() (ids []string) {
-	for id := range m.removedcredentials {
-		ids = append(ids, id)
+// withRateCounterID sets the ID field of the mutation.
+func withRateCounterID(id string) ratecounterOption {
+	return func(m *RateCounterMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RateCounter
+		)
+		m.oldValue = func(ctx context.Context) (*RateCounter, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RateCounter.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return
 }
 
-// CredentialsIDs returns the "credentials" edge IDs in the mutation.
-func (m *NaturalPersonMutation) CredentialsIDs() (ids []string) {
-	for id := range m.credentials {
-		ids = append(ids, id)
+// withRateCounter sets the old RateCounter of the mutation.
+func withRateCounter(node *RateCounter) ratecounterOption {
+	return func(m *RateCounterMutation) {
+		m.oldValue = func(context.Context) (*RateCounter, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RateCounterMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RateCounterMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RateCounter entities.
+func (m *RateCounterMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RateCounterMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RateCounterMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RateCounter.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCount sets the "count" field.
+func (m *RateCounterMutation) SetCount(i int) {
+	m.count = &i
+	m.addcount = nil
+}
+
+// Count returns the value of the "count" field in the mutation.
+func (m *RateCounterMutation) Count() (r int, exists bool) {
+	v := m.count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCount returns the old "count" field's value of the RateCounter entity.
+// If the RateCounter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RateCounterMutation) OldCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCount: %w", err)
+	}
+	return oldValue.Count, nil
+}
+
+// AddCount adds i to the "count" field.
+func (m *RateCounterMutation) AddCount(i int) {
+	if m.addcount != nil {
+		*m.addcount += i
+	} else {
+		m.addcount = &i
+	}
+}
+
+// AddedCount returns the value that was added to the "count" field in this mutation.
+func (m *RateCounterMutation) AddedCount() (r int, exists bool) {
+	v := m.addcount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCount resets all changes to the "count" field.
+func (m *RateCounterMutation) ResetCount() {
+	m.count = nil
+	m.addcount = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *RateCounterMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *RateCounterMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the RateCounter entity.
+// If the RateCounter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RateCounterMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetCredentials resets all changes to the "credentials" edge.
-func (m *NaturalPersonMutation) ResetCredentials() {
-	m.credentials = nil
-	m.clearedcredentials = false
-	m.removedcredentials = nil
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *RateCounterMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// Where appends a list predicates to the NaturalPersonMutation builder.
-func (m *NaturalPersonMutation) Where(ps ...predicate.NaturalPerson) {
+// Where appends a list predicates to the RateCounterMutation builder.
+func (m *RateCounterMutation) Where(ps ...predicate.RateCounter) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *NaturalPersonMutation) Op() Op {
+func (m *RateCounterMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (NaturalPerson).
-func (m *NaturalPersonMutation) Type() string {
+// Type returns the node type of this mutation (RateCounter).
+func (m *RateCounterMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *NaturalPersonMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.name != nil {
-		fields = append(fields, naturalperson.FieldName)
-	}
-	if m.displayname != nil {
-		fields = append(fields, naturalperson.FieldDisplayname)
-	}
-	if m._type != nil {
-		fields = append(fields, naturalperson.FieldType)
-	}
-	if m.password != nil {
-		fields = append(fields, naturalperson.FieldPassword)
-	}
-	if m.created_at != nil {
-		fields = append(fields, naturalperson.FieldCreatedAt)
+func (m *RateCounterMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.count != nil {
+		fields = append(fields, ratecounter.FieldCount)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, naturalperson.FieldUpdatedAt)
+	if m.expires_at != nil {
+		fields = append(fields, ratecounter.FieldExpiresAt)
 	}
 	return fields
 }
@@ -1610,20 +9906,12 @@ func (m *NaturalPersonMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *NaturalPersonMutation) Field(name string) (ent.Value, bool) {
+func (m *RateCounterMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case naturalperson.FieldName:
-		return m.Name()
-	case naturalperson.FieldDisplayname:
-		return m.Displayname()
-	case naturalperson.FieldType:
-		return m.GetType()
-	case naturalperson.FieldPassword:
-		return m.Password()
-	case naturalperson.FieldCreatedAt:
-		return m.CreatedAt()
-	case naturalperson.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case ratecounter.FieldCount:
+		return m.Count()
+	case ratecounter.FieldExpiresAt:
+		return m.ExpiresAt()
 	}
 	return nil, false
 }
@@ -1631,291 +9919,191 @@ func (m *NaturalPersonMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *NaturalPersonMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RateCounterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case naturalperson.FieldName:
-		return m.OldName(ctx)
-	case naturalperson.FieldDisplayname:
-		return m.OldDisplayname(ctx)
-	case naturalperson.FieldType:
-		return m.OldType(ctx)
-	case naturalperson.FieldPassword:
-		return m.OldPassword(ctx)
-	case naturalperson.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case naturalperson.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case ratecounter.FieldCount:
+		return m.OldCount(ctx)
+	case ratecounter.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown NaturalPerson field %s", name)
+	return nil, fmt.Errorf("unknown RateCounter field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NaturalPersonMutation) SetField(name string, value ent.Value) error {
+func (m *RateCounterMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case naturalperson.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case naturalperson.FieldDisplayname:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDisplayname(v)
-		return nil
-	case naturalperson.FieldType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case naturalperson.FieldPassword:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPassword(v)
-		return nil
-	case naturalperson.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case ratecounter.FieldCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetCount(v)
 		return nil
-	case naturalperson.FieldUpdatedAt:
+	case ratecounter.FieldExpiresAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetExpiresAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown NaturalPerson field %s", name)
+	return fmt.Errorf("unknown RateCounter field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *NaturalPersonMutation) AddedFields() []string {
-	return nil
+func (m *RateCounterMutation) AddedFields() []string {
+	var fields []string
+	if m.addcount != nil {
+		fields = append(fields, ratecounter.FieldCount)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *NaturalPersonMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RateCounterMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case ratecounter.FieldCount:
+		return m.AddedCount()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *NaturalPersonMutation) AddField(name string, value ent.Value) error {
+func (m *RateCounterMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case ratecounter.FieldCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCount(v)
+		return nil
 	}
-	return fmt.Errorf("unknown NaturalPerson numeric field %s", name)
+	return fmt.Errorf("unknown RateCounter numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *NaturalPersonMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(naturalperson.FieldDisplayname) {
-		fields = append(fields, naturalperson.FieldDisplayname)
-	}
-	return fields
+func (m *RateCounterMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *NaturalPersonMutation) FieldCleared(name string) bool {
+func (m *RateCounterMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *NaturalPersonMutation) ClearField(name string) error {
-	switch name {
-	case naturalperson.FieldDisplayname:
-		m.ClearDisplayname()
-		return nil
-	}
-	return fmt.Errorf("unknown NaturalPerson nullable field %s", name)
+func (m *RateCounterMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RateCounter nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *NaturalPersonMutation) ResetField(name string) error {
+func (m *RateCounterMutation) ResetField(name string) error {
 	switch name {
-	case naturalperson.FieldName:
-		m.ResetName()
-		return nil
-	case naturalperson.FieldDisplayname:
-		m.ResetDisplayname()
+	case ratecounter.FieldCount:
+		m.ResetCount()
 		return nil
-	case naturalperson.FieldType:
-		m.ResetType()
-		return nil
-	case naturalperson.FieldPassword:
-		m.ResetPassword()
-		return nil
-	case naturalperson.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case naturalperson.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case ratecounter.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
 	}
-	return fmt.Errorf("unknown NaturalPerson field %s", name)
+	return fmt.Errorf("unknown RateCounter field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NaturalPersonMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.keys != nil {
-		edges = append(edges, naturalperson.EdgeKeys)
-	}
-	if m.credentials != nil {
-		edges = append(edges, naturalperson.EdgeCredentials)
-	}
+func (m *RateCounterMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *NaturalPersonMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case naturalperson.EdgeKeys:
-		ids := make([]ent.Value, 0, len(m.keys))
-		for id := range m.keys {
-			ids = append(ids, id)
-		}
-		return ids
-	case naturalperson.EdgeCredentials:
-		ids := make([]ent.Value, 0, len(m.credentials))
-		for id := range m.credentials {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *RateCounterMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NaturalPersonMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedkeys != nil {
-		edges = append(edges, naturalperson.EdgeKeys)
-	}
-	if m.removedcredentials != nil {
-		edges = append(edges, naturalperson.EdgeCredentials)
-	}
-	return edges
-}
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *NaturalPersonMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case naturalperson.EdgeKeys:
-		ids := make([]ent.Value, 0, len(m.removedkeys))
-		for id := range m.removedkeys {
-			ids = append(ids, id)
-		}
-		return ids
-	case naturalperson.EdgeCredentials:
-		ids := make([]ent.Value, 0, len(m.removedcredentials))
-		for id := range m.removedcredentials {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
-}
-
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NaturalPersonMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedkeys {
-		edges = append(edges, naturalperson.EdgeKeys)
-	}
-	if m.clearedcredentials {
-		edges = append(edges, naturalperson.EdgeCredentials)
-	}
+func (m *RateCounterMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *NaturalPersonMutation) EdgeCleared(name string) bool {
-	switch name {
-	case naturalperson.EdgeKeys:
-		return m.clearedkeys
-	case naturalperson.EdgeCredentials:
-		return m.clearedcredentials
-	}
-	return false
-}
-
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *NaturalPersonMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown NaturalPerson unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *NaturalPersonMutation) ResetEdge(name string) error {
-	switch name {
-	case naturalperson.EdgeKeys:
-		m.ResetKeys()
-		return nil
-	case naturalperson.EdgeCredentials:
-		m.ResetCredentials()
-		return nil
-	}
-	return fmt.Errorf("unknown NaturalPerson edge %s", name)
-}
-
-// PrivateKeyMutation represents an operation that mutates the PrivateKey nodes in the graph.
-type PrivateKeyMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *string
-	kty           *string
-	alg           *string
-	jwk           *[]uint8
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	user          *string
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*PrivateKey, error)
-	predicates    []predicate.PrivateKey
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RateCounterMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-var _ ent.Mutation = (*PrivateKeyMutation)(nil)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RateCounterMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
 
-// privatekeyOption allows management of the mutation configuration using functional options.
-type privatekeyOption func(*PrivateKeyMutation)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RateCounterMutation) EdgeCleared(name string) bool {
+	return false
+}
 
-// newPrivateKeyMutation creates new mutation for the PrivateKey entity.
-func newPrivateKeyMutation(c config, op Op, opts ...privatekeyOption) *PrivateKeyMutation {
-	m := &PrivateKeyMutation{
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RateCounterMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RateCounter unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RateCounterMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RateCounter edge %s", name)
+}
+
+// RelyingPartyMutation represents an operation that mutates the RelyingParty nodes in the graph.
+type RelyingPartyMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *string
+	name                    *string
+	redirect_uris           *[]string
+	credential_types        *[]string
+	presentation_definition *map[string]interface{}
+	scope                   *string
+	audience                *string
+	token_lifetime          *string
+	signing_alg             *string
+	service                 *string
+	created_at              *time.Time
+	updated_at              *time.Time
+	clearedFields           map[string]struct{}
+	done                    bool
+	oldValue                func(context.Context) (*RelyingParty, error)
+	predicates              []predicate.RelyingParty
+}
+
+var _ ent.Mutation = (*RelyingPartyMutation)(nil)
+
+// relyingpartyOption allows management of the mutation configuration using functional options.
+type relyingpartyOption func(*RelyingPartyMutation)
+
+// newRelyingPartyMutation creates new mutation for the RelyingParty entity.
+func newRelyingPartyMutation(c config, op Op, opts ...relyingpartyOption) *RelyingPartyMutation {
+	m := &RelyingPartyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePrivateKey,
+		typ:           TypeRelyingParty,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1924,20 +10112,20 @@ func newPrivateKeyMutation(c config, op Op, opts ...privatekeyOption) *PrivateKe
 	return m
 }
 
-// withPrivateKeyID sets the ID field of the mutation.
-func withPrivateKeyID(id string) privatekeyOption {
-	return func(m *PrivateKeyMutation) {
+// withRelyingPartyID sets the ID field of the mutation.
+func withRelyingPartyID(id string) relyingpartyOption {
+	return func(m *RelyingPartyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PrivateKey
+			value *RelyingParty
 		)
-		m.oldValue = func(ctx context.Context) (*PrivateKey, error) {
+		m.oldValue = func(ctx context.Context) (*RelyingParty, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PrivateKey.Get(ctx, id)
+					value, err = m.Client().RelyingParty.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1946,10 +10134,10 @@ func withPrivateKeyID(id string) privatekeyOption {
 	}
 }
 
-// withPrivateKey sets the old PrivateKey of the mutation.
-func withPrivateKey(node *PrivateKey) privatekeyOption {
-	return func(m *PrivateKeyMutation) {
-		m.oldValue = func(context.Context) (*PrivateKey, error) {
+// withRelyingParty sets the old RelyingParty of the mutation.
+func withRelyingParty(node *RelyingParty) relyingpartyOption {
+	return func(m *RelyingPartyMutation) {
+		m.oldValue = func(context.Context) (*RelyingParty, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1958,7 +10146,7 @@ func withPrivateKey(node *PrivateKey) privatekeyOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PrivateKeyMutation) Client() *Client {
+func (m RelyingPartyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1966,7 +10154,7 @@ func (m PrivateKeyMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PrivateKeyMutation) Tx() (*Tx, error) {
+func (m RelyingPartyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1976,14 +10164,14 @@ func (m PrivateKeyMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of PrivateKey entities.
-func (m *PrivateKeyMutation) SetID(id string) {
+// operation is only accepted on creation of RelyingParty entities.
+func (m *RelyingPartyMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PrivateKeyMutation) ID() (id string, exists bool) {
+func (m *RelyingPartyMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1994,7 +10182,7 @@ func (m *PrivateKeyMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PrivateKeyMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *RelyingPartyMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2003,140 +10191,447 @@ func (m *PrivateKeyMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PrivateKey.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().RelyingParty.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetKty sets the "kty" field.
-func (m *PrivateKeyMutation) SetKty(s string) {
-	m.kty = &s
+// SetName sets the "name" field.
+func (m *RelyingPartyMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Kty returns the value of the "kty" field in the mutation.
-func (m *PrivateKeyMutation) Kty() (r string, exists bool) {
-	v := m.kty
+// Name returns the value of the "name" field in the mutation.
+func (m *RelyingPartyMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKty returns the old "kty" field's value of the PrivateKey entity.
-// If the PrivateKey object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the RelyingParty entity.
+// If the RelyingParty object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PrivateKeyMutation) OldKty(ctx context.Context) (v string, err error) {
+func (m *RelyingPartyMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKty is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKty requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKty: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Kty, nil
+	return oldValue.Name, nil
 }
 
-// ResetKty resets all changes to the "kty" field.
-func (m *PrivateKeyMutation) ResetKty() {
-	m.kty = nil
+// ClearName clears the value of the "name" field.
+func (m *RelyingPartyMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[relyingparty.FieldName] = struct{}{}
 }
 
-// SetAlg sets the "alg" field.
-func (m *PrivateKeyMutation) SetAlg(s string) {
-	m.alg = &s
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *RelyingPartyMutation) NameCleared() bool {
+	_, ok := m.clearedFields[relyingparty.FieldName]
+	return ok
 }
 
-// Alg returns the value of the "alg" field in the mutation.
-func (m *PrivateKeyMutation) Alg() (r string, exists bool) {
-	v := m.alg
+// ResetName resets all changes to the "name" field.
+func (m *RelyingPartyMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, relyingparty.FieldName)
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (m *RelyingPartyMutation) SetRedirectUris(s []string) {
+	m.redirect_uris = &s
+}
+
+// RedirectUris returns the value of the "redirect_uris" field in the mutation.
+func (m *RelyingPartyMutation) RedirectUris() (r []string, exists bool) {
+	v := m.redirect_uris
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAlg returns the old "alg" field's value of the PrivateKey entity.
-// If the PrivateKey object wasn't provided to the builder, the object is fetched from the database.
+// OldRedirectUris returns the old "redirect_uris" field's value of the RelyingParty entity.
+// If the RelyingParty object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PrivateKeyMutation) OldAlg(ctx context.Context) (v string, err error) {
+func (m *RelyingPartyMutation) OldRedirectUris(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAlg is only allowed on UpdateOne operations")
+		return v, errors.New("OldRedirectUris is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAlg requires an ID field in the mutation")
+		return v, errors.New("OldRedirectUris requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAlg: %w", err)
+		return v, fmt.Errorf("querying old value for OldRedirectUris: %w", err)
 	}
-	return oldValue.Alg, nil
+	return oldValue.RedirectUris, nil
 }
 
-// ClearAlg clears the value of the "alg" field.
-func (m *PrivateKeyMutation) ClearAlg() {
-	m.alg = nil
-	m.clearedFields[privatekey.FieldAlg] = struct{}{}
+// ResetRedirectUris resets all changes to the "redirect_uris" field.
+func (m *RelyingPartyMutation) ResetRedirectUris() {
+	m.redirect_uris = nil
 }
 
-// AlgCleared returns if the "alg" field was cleared in this mutation.
-func (m *PrivateKeyMutation) AlgCleared() bool {
-	_, ok := m.clearedFields[privatekey.FieldAlg]
+// SetCredentialTypes sets the "credential_types" field.
+func (m *RelyingPartyMutation) SetCredentialTypes(s []string) {
+	m.credential_types = &s
+}
+
+// CredentialTypes returns the value of the "credential_types" field in the mutation.
+func (m *RelyingPartyMutation) CredentialTypes() (r []string, exists bool) {
+	v := m.credential_types
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCredentialTypes returns the old "credential_types" field's value of the RelyingParty entity.
+// If the RelyingParty object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RelyingPartyMutation) OldCredentialTypes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCredentialTypes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCredentialTypes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCredentialTypes: %w", err)
+	}
+	return oldValue.CredentialTypes, nil
+}
+
+// ClearCredentialTypes clears the value of the "credential_types" field.
+func (m *RelyingPartyMutation) ClearCredentialTypes() {
+	m.credential_types = nil
+	m.clearedFields[relyingparty.FieldCredentialTypes] = struct{}{}
+}
+
+// CredentialTypesCleared returns if the "credential_types" field was cleared in this mutation.
+func (m *RelyingPartyMutation) CredentialTypesCleared() bool {
+	_, ok := m.clearedFields[relyingparty.FieldCredentialTypes]
 	return ok
 }
 
-// ResetAlg resets all changes to the "alg" field.
-func (m *PrivateKeyMutation) ResetAlg() {
-	m.alg = nil
-	delete(m.clearedFields, privatekey.FieldAlg)
+// ResetCredentialTypes resets all changes to the "credential_types" field.
+func (m *RelyingPartyMutation) ResetCredentialTypes() {
+	m.credential_types = nil
+	delete(m.clearedFields, relyingparty.FieldCredentialTypes)
 }
 
-// SetJwk sets the "jwk" field.
-func (m *PrivateKeyMutation) SetJwk(u []uint8) {
-	m.jwk = &u
+// SetPresentationDefinition sets the "presentation_definition" field.
+func (m *RelyingPartyMutation) SetPresentationDefinition(value map[string]interface{}) {
+	m.presentation_definition = &value
 }
 
-// Jwk returns the value of the "jwk" field in the mutation.
-func (m *PrivateKeyMutation) Jwk() (r []uint8, exists bool) {
-	v := m.jwk
+// PresentationDefinition returns the value of the "presentation_definition" field in the mutation.
+func (m *RelyingPartyMutation) PresentationDefinition() (r map[string]interface{}, exists bool) {
+	v := m.presentation_definition
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldJwk returns the old "jwk" field's value of the PrivateKey entity.
-// If the PrivateKey object wasn't provided to the builder, the object is fetched from the database.
+// OldPresentationDefinition returns the old "presentation_definition" field's value of the RelyingParty entity.
+// If the RelyingParty object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PrivateKeyMutation) OldJwk(ctx context.Context) (v []uint8, err error) {
+func (m *RelyingPartyMutation) OldPresentationDefinition(ctx context.Context) (v map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJwk is only allowed on UpdateOne operations")
+		return v, errors.New("OldPresentationDefinition is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJwk requires an ID field in the mutation")
+		return v, errors.New("OldPresentationDefinition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPresentationDefinition: %w", err)
+	}
+	return oldValue.PresentationDefinition, nil
+}
+
+// ClearPresentationDefinition clears the value of the "presentation_definition" field.
+func (m *RelyingPartyMutation) ClearPresentationDefinition() {
+	m.presentation_definition = nil
+	m.clearedFields[relyingparty.FieldPresentationDefinition] = struct{}{}
+}
+
+// PresentationDefinitionCleared returns if the "presentation_definition" field was cleared in this mutation.
+func (m *RelyingPartyMutation) PresentationDefinitionCleared() bool {
+	_, ok := m.clearedFields[relyingparty.FieldPresentationDefinition]
+	return ok
+}
+
+// ResetPresentationDefinition resets all changes to the "presentation_definition" field.
+func (m *RelyingPartyMutation) ResetPresentationDefinition() {
+	m.presentation_definition = nil
+	delete(m.clearedFields, relyingparty.FieldPresentationDefinition)
+}
+
+// SetScope sets the "scope" field.
+func (m *RelyingPartyMutation) SetScope(s string) {
+	m.scope = &s
+}
+
+// Scope returns the value of the "scope" field in the mutation.
+func (m *RelyingPartyMutation) Scope() (r string, exists bool) {
+	v := m.scope
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScope returns the old "scope" field's value of the RelyingParty entity.
+// If the RelyingParty object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RelyingPartyMutation) OldScope(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScope is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScope requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScope: %w", err)
+	}
+	return oldValue.Scope, nil
+}
+
+// ClearScope clears the value of the "scope" field.
+func (m *RelyingPartyMutation) ClearScope() {
+	m.scope = nil
+	m.clearedFields[relyingparty.FieldScope] = struct{}{}
+}
+
+// ScopeCleared returns if the "scope" field was cleared in this mutation.
+func (m *RelyingPartyMutation) ScopeCleared() bool {
+	_, ok := m.clearedFields[relyingparty.FieldScope]
+	return ok
+}
+
+// ResetScope resets all changes to the "scope" field.
+func (m *RelyingPartyMutation) ResetScope() {
+	m.scope = nil
+	delete(m.clearedFields, relyingparty.FieldScope)
+}
+
+// SetAudience sets the "audience" field.
+func (m *RelyingPartyMutation) SetAudience(s string) {
+	m.audience = &s
+}
+
+// Audience returns the value of the "audience" field in the mutation.
+func (m *RelyingPartyMutation) Audience() (r string, exists bool) {
+	v := m.audience
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAudience returns the old "audience" field's value of the RelyingParty entity.
+// If the RelyingParty object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RelyingPartyMutation) OldAudience(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAudience is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAudience requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAudience: %w", err)
+	}
+	return oldValue.Audience, nil
+}
+
+// ClearAudience clears the value of the "audience" field.
+func (m *RelyingPartyMutation) ClearAudience() {
+	m.audience = nil
+	m.clearedFields[relyingparty.FieldAudience] = struct{}{}
+}
+
+// AudienceCleared returns if the "audience" field was cleared in this mutation.
+func (m *RelyingPartyMutation) AudienceCleared() bool {
+	_, ok := m.clearedFields[relyingparty.FieldAudience]
+	return ok
+}
+
+// ResetAudience resets all changes to the "audience" field.
+func (m *RelyingPartyMutation) ResetAudience() {
+	m.audience = nil
+	delete(m.clearedFields, relyingparty.FieldAudience)
+}
+
+// SetTokenLifetime sets the "token_lifetime" field.
+func (m *RelyingPartyMutation) SetTokenLifetime(s string) {
+	m.token_lifetime = &s
+}
+
+// TokenLifetime returns the value of the "token_lifetime" field in the mutation.
+func (m *RelyingPartyMutation) TokenLifetime() (r string, exists bool) {
+	v := m.token_lifetime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenLifetime returns the old "token_lifetime" field's value of the RelyingParty entity.
+// If the RelyingParty object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RelyingPartyMutation) OldTokenLifetime(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenLifetime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenLifetime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenLifetime: %w", err)
+	}
+	return oldValue.TokenLifetime, nil
+}
+
+// ClearTokenLifetime clears the value of the "token_lifetime" field.
+func (m *RelyingPartyMutation) ClearTokenLifetime() {
+	m.token_lifetime = nil
+	m.clearedFields[relyingparty.FieldTokenLifetime] = struct{}{}
+}
+
+// TokenLifetimeCleared returns if the "token_lifetime" field was cleared in this mutation.
+func (m *RelyingPartyMutation) TokenLifetimeCleared() bool {
+	_, ok := m.clearedFields[relyingparty.FieldTokenLifetime]
+	return ok
+}
+
+// ResetTokenLifetime resets all changes to the "token_lifetime" field.
+func (m *RelyingPartyMutation) ResetTokenLifetime() {
+	m.token_lifetime = nil
+	delete(m.clearedFields, relyingparty.FieldTokenLifetime)
+}
+
+// SetSigningAlg sets the "signing_alg" field.
+func (m *RelyingPartyMutation) SetSigningAlg(s string) {
+	m.signing_alg = &s
+}
+
+// SigningAlg returns the value of the "signing_alg" field in the mutation.
+func (m *RelyingPartyMutation) SigningAlg() (r string, exists bool) {
+	v := m.signing_alg
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSigningAlg returns the old "signing_alg" field's value of the RelyingParty entity.
+// If the RelyingParty object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RelyingPartyMutation) OldSigningAlg(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSigningAlg is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSigningAlg requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSigningAlg: %w", err)
+	}
+	return oldValue.SigningAlg, nil
+}
+
+// ClearSigningAlg clears the value of the "signing_alg" field.
+func (m *RelyingPartyMutation) ClearSigningAlg() {
+	m.signing_alg = nil
+	m.clearedFields[relyingparty.FieldSigningAlg] = struct{}{}
+}
+
+// SigningAlgCleared returns if the "signing_alg" field was cleared in this mutation.
+func (m *RelyingPartyMutation) SigningAlgCleared() bool {
+	_, ok := m.clearedFields[relyingparty.FieldSigningAlg]
+	return ok
+}
+
+// ResetSigningAlg resets all changes to the "signing_alg" field.
+func (m *RelyingPartyMutation) ResetSigningAlg() {
+	m.signing_alg = nil
+	delete(m.clearedFields, relyingparty.FieldSigningAlg)
+}
+
+// SetService sets the "service" field.
+func (m *RelyingPartyMutation) SetService(s string) {
+	m.service = &s
+}
+
+// Service returns the value of the "service" field in the mutation.
+func (m *RelyingPartyMutation) Service() (r string, exists bool) {
+	v := m.service
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldService returns the old "service" field's value of the RelyingParty entity.
+// If the RelyingParty object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RelyingPartyMutation) OldService(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldService is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldService requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJwk: %w", err)
+		return v, fmt.Errorf("querying old value for OldService: %w", err)
 	}
-	return oldValue.Jwk, nil
+	return oldValue.Service, nil
 }
 
-// ResetJwk resets all changes to the "jwk" field.
-func (m *PrivateKeyMutation) ResetJwk() {
-	m.jwk = nil
+// ClearService clears the value of the "service" field.
+func (m *RelyingPartyMutation) ClearService() {
+	m.service = nil
+	m.clearedFields[relyingparty.FieldService] = struct{}{}
+}
+
+// ServiceCleared returns if the "service" field was cleared in this mutation.
+func (m *RelyingPartyMutation) ServiceCleared() bool {
+	_, ok := m.clearedFields[relyingparty.FieldService]
+	return ok
+}
+
+// ResetService resets all changes to the "service" field.
+func (m *RelyingPartyMutation) ResetService() {
+	m.service = nil
+	delete(m.clearedFields, relyingparty.FieldService)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *PrivateKeyMutation) SetCreatedAt(t time.Time) {
+func (m *RelyingPartyMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PrivateKeyMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *RelyingPartyMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2144,10 +10639,10 @@ func (m *PrivateKeyMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the PrivateKey entity.
-// If the PrivateKey object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the RelyingParty entity.
+// If the RelyingParty object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PrivateKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *RelyingPartyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2162,17 +10657,17 @@ func (m *PrivateKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PrivateKeyMutation) ResetCreatedAt() {
+func (m *RelyingPartyMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *PrivateKeyMutation) SetUpdatedAt(t time.Time) {
+func (m *RelyingPartyMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PrivateKeyMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *RelyingPartyMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -2180,10 +10675,10 @@ func (m *PrivateKeyMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the PrivateKey entity.
-// If the PrivateKey object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the RelyingParty entity.
+// If the RelyingParty object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PrivateKeyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *RelyingPartyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -2198,83 +10693,62 @@ func (m *PrivateKeyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PrivateKeyMutation) ResetUpdatedAt() {
+func (m *RelyingPartyMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *PrivateKeyMutation) SetUserID(id string) {
-	m.user = &id
-}
-
-// ClearUser clears the "user" edge to the User entity.
-func (m *PrivateKeyMutation) ClearUser() {
-	m.cleareduser = true
-}
-
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *PrivateKeyMutation) UserCleared() bool {
-	return m.cleareduser
-}
-
-// UserID returns the "user" edge ID in the mutation.
-func (m *PrivateKeyMutation) UserID() (id string, exists bool) {
-	if m.user != nil {
-		return *m.user, true
-	}
-	return
-}
-
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *PrivateKeyMutation) UserIDs() (ids []string) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetUser resets all changes to the "user" edge.
-func (m *PrivateKeyMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
-}
-
-// Where appends a list predicates to the PrivateKeyMutation builder.
-func (m *PrivateKeyMutation) Where(ps ...predicate.PrivateKey) {
+// Where appends a list predicates to the RelyingPartyMutation builder.
+func (m *RelyingPartyMutation) Where(ps ...predicate.RelyingParty) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *PrivateKeyMutation) Op() Op {
+func (m *RelyingPartyMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (PrivateKey).
-func (m *PrivateKeyMutation) Type() string {
+// Type returns the node type of this mutation (RelyingParty).
+func (m *RelyingPartyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PrivateKeyMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.kty != nil {
-		fields = append(fields, privatekey.FieldKty)
+func (m *RelyingPartyMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.name != nil {
+		fields = append(fields, relyingparty.FieldName)
 	}
-	if m.alg != nil {
-		fields = append(fields, privatekey.FieldAlg)
+	if m.redirect_uris != nil {
+		fields = append(fields, relyingparty.FieldRedirectUris)
 	}
-	if m.jwk != nil {
-		fields = append(fields, privatekey.FieldJwk)
+	if m.credential_types != nil {
+		fields = append(fields, relyingparty.FieldCredentialTypes)
+	}
+	if m.presentation_definition != nil {
+		fields = append(fields, relyingparty.FieldPresentationDefinition)
+	}
+	if m.scope != nil {
+		fields = append(fields, relyingparty.FieldScope)
+	}
+	if m.audience != nil {
+		fields = append(fields, relyingparty.FieldAudience)
+	}
+	if m.token_lifetime != nil {
+		fields = append(fields, relyingparty.FieldTokenLifetime)
+	}
+	if m.signing_alg != nil {
+		fields = append(fields, relyingparty.FieldSigningAlg)
+	}
+	if m.service != nil {
+		fields = append(fields, relyingparty.FieldService)
 	}
 	if m.created_at != nil {
-		fields = append(fields, privatekey.FieldCreatedAt)
+		fields = append(fields, relyingparty.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, privatekey.FieldUpdatedAt)
+		fields = append(fields, relyingparty.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2282,17 +10756,29 @@ func (m *PrivateKeyMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PrivateKeyMutation) Field(name string) (ent.Value, bool) {
+func (m *RelyingPartyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case privatekey.FieldKty:
-		return m.Kty()
-	case privatekey.FieldAlg:
-		return m.Alg()
-	case privatekey.FieldJwk:
-		return m.Jwk()
-	case privatekey.FieldCreatedAt:
+	case relyingparty.FieldName:
+		return m.Name()
+	case relyingparty.FieldRedirectUris:
+		return m.RedirectUris()
+	case relyingparty.FieldCredentialTypes:
+		return m.CredentialTypes()
+	case relyingparty.FieldPresentationDefinition:
+		return m.PresentationDefinition()
+	case relyingparty.FieldScope:
+		return m.Scope()
+	case relyingparty.FieldAudience:
+		return m.Audience()
+	case relyingparty.FieldTokenLifetime:
+		return m.TokenLifetime()
+	case relyingparty.FieldSigningAlg:
+		return m.SigningAlg()
+	case relyingparty.FieldService:
+		return m.Service()
+	case relyingparty.FieldCreatedAt:
 		return m.CreatedAt()
-	case privatekey.FieldUpdatedAt:
+	case relyingparty.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -2301,56 +10787,110 @@ func (m *PrivateKeyMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PrivateKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RelyingPartyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case privatekey.FieldKty:
-		return m.OldKty(ctx)
-	case privatekey.FieldAlg:
-		return m.OldAlg(ctx)
-	case privatekey.FieldJwk:
-		return m.OldJwk(ctx)
-	case privatekey.FieldCreatedAt:
+	case relyingparty.FieldName:
+		return m.OldName(ctx)
+	case relyingparty.FieldRedirectUris:
+		return m.OldRedirectUris(ctx)
+	case relyingparty.FieldCredentialTypes:
+		return m.OldCredentialTypes(ctx)
+	case relyingparty.FieldPresentationDefinition:
+		return m.OldPresentationDefinition(ctx)
+	case relyingparty.FieldScope:
+		return m.OldScope(ctx)
+	case relyingparty.FieldAudience:
+		return m.OldAudience(ctx)
+	case relyingparty.FieldTokenLifetime:
+		return m.OldTokenLifetime(ctx)
+	case relyingparty.FieldSigningAlg:
+		return m.OldSigningAlg(ctx)
+	case relyingparty.FieldService:
+		return m.OldService(ctx)
+	case relyingparty.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case privatekey.FieldUpdatedAt:
+	case relyingparty.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown PrivateKey field %s", name)
+	return nil, fmt.Errorf("unknown RelyingParty field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PrivateKeyMutation) SetField(name string, value ent.Value) error {
+func (m *RelyingPartyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case privatekey.FieldKty:
+	case relyingparty.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKty(v)
+		m.SetName(v)
 		return nil
-	case privatekey.FieldAlg:
+	case relyingparty.FieldRedirectUris:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRedirectUris(v)
+		return nil
+	case relyingparty.FieldCredentialTypes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCredentialTypes(v)
+		return nil
+	case relyingparty.FieldPresentationDefinition:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPresentationDefinition(v)
+		return nil
+	case relyingparty.FieldScope:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAlg(v)
+		m.SetScope(v)
 		return nil
-	case privatekey.FieldJwk:
-		v, ok := value.([]uint8)
+	case relyingparty.FieldAudience:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetJwk(v)
+		m.SetAudience(v)
 		return nil
-	case privatekey.FieldCreatedAt:
+	case relyingparty.FieldTokenLifetime:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenLifetime(v)
+		return nil
+	case relyingparty.FieldSigningAlg:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSigningAlg(v)
+		return nil
+	case relyingparty.FieldService:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetService(v)
+		return nil
+	case relyingparty.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case privatekey.FieldUpdatedAt:
+	case relyingparty.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2358,186 +10898,216 @@ func (m *PrivateKeyMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PrivateKey field %s", name)
+	return fmt.Errorf("unknown RelyingParty field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PrivateKeyMutation) AddedFields() []string {
+func (m *RelyingPartyMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PrivateKeyMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RelyingPartyMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PrivateKeyMutation) AddField(name string, value ent.Value) error {
+func (m *RelyingPartyMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown PrivateKey numeric field %s", name)
+	return fmt.Errorf("unknown RelyingParty numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PrivateKeyMutation) ClearedFields() []string {
+func (m *RelyingPartyMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(privatekey.FieldAlg) {
-		fields = append(fields, privatekey.FieldAlg)
+	if m.FieldCleared(relyingparty.FieldName) {
+		fields = append(fields, relyingparty.FieldName)
+	}
+	if m.FieldCleared(relyingparty.FieldCredentialTypes) {
+		fields = append(fields, relyingparty.FieldCredentialTypes)
+	}
+	if m.FieldCleared(relyingparty.FieldPresentationDefinition) {
+		fields = append(fields, relyingparty.FieldPresentationDefinition)
+	}
+	if m.FieldCleared(relyingparty.FieldScope) {
+		fields = append(fields, relyingparty.FieldScope)
+	}
+	if m.FieldCleared(relyingparty.FieldAudience) {
+		fields = append(fields, relyingparty.FieldAudience)
+	}
+	if m.FieldCleared(relyingparty.FieldTokenLifetime) {
+		fields = append(fields, relyingparty.FieldTokenLifetime)
+	}
+	if m.FieldCleared(relyingparty.FieldSigningAlg) {
+		fields = append(fields, relyingparty.FieldSigningAlg)
+	}
+	if m.FieldCleared(relyingparty.FieldService) {
+		fields = append(fields, relyingparty.FieldService)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PrivateKeyMutation) FieldCleared(name string) bool {
+func (m *RelyingPartyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PrivateKeyMutation) ClearField(name string) error {
+func (m *RelyingPartyMutation) ClearField(name string) error {
 	switch name {
-	case privatekey.FieldAlg:
-		m.ClearAlg()
+	case relyingparty.FieldName:
+		m.ClearName()
+		return nil
+	case relyingparty.FieldCredentialTypes:
+		m.ClearCredentialTypes()
+		return nil
+	case relyingparty.FieldPresentationDefinition:
+		m.ClearPresentationDefinition()
+		return nil
+	case relyingparty.FieldScope:
+		m.ClearScope()
+		return nil
+	case relyingparty.FieldAudience:
+		m.ClearAudience()
+		return nil
+	case relyingparty.FieldTokenLifetime:
+		m.ClearTokenLifetime()
+		return nil
+	case relyingparty.FieldSigningAlg:
+		m.ClearSigningAlg()
+		return nil
+	case relyingparty.FieldService:
+		m.ClearService()
 		return nil
 	}
-	return fmt.Errorf("unknown PrivateKey nullable field %s", name)
+	return fmt.Errorf("unknown RelyingParty nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PrivateKeyMutation) ResetField(name string) error {
+func (m *RelyingPartyMutation) ResetField(name string) error {
 	switch name {
-	case privatekey.FieldKty:
-		m.ResetKty()
+	case relyingparty.FieldName:
+		m.ResetName()
 		return nil
-	case privatekey.FieldAlg:
-		m.ResetAlg()
+	case relyingparty.FieldRedirectUris:
+		m.ResetRedirectUris()
 		return nil
-	case privatekey.FieldJwk:
-		m.ResetJwk()
+	case relyingparty.FieldCredentialTypes:
+		m.ResetCredentialTypes()
 		return nil
-	case privatekey.FieldCreatedAt:
+	case relyingparty.FieldPresentationDefinition:
+		m.ResetPresentationDefinition()
+		return nil
+	case relyingparty.FieldScope:
+		m.ResetScope()
+		return nil
+	case relyingparty.FieldAudience:
+		m.ResetAudience()
+		return nil
+	case relyingparty.FieldTokenLifetime:
+		m.ResetTokenLifetime()
+		return nil
+	case relyingparty.FieldSigningAlg:
+		m.ResetSigningAlg()
+		return nil
+	case relyingparty.FieldService:
+		m.ResetService()
+		return nil
+	case relyingparty.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case privatekey.FieldUpdatedAt:
+	case relyingparty.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown PrivateKey field %s", name)
+	return fmt.Errorf("unknown RelyingParty field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PrivateKeyMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, privatekey.EdgeUser)
-	}
+func (m *RelyingPartyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PrivateKeyMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case privatekey.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *RelyingPartyMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PrivateKeyMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *RelyingPartyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PrivateKeyMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	}
+func (m *RelyingPartyMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PrivateKeyMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, privatekey.EdgeUser)
-	}
+func (m *RelyingPartyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PrivateKeyMutation) EdgeCleared(name string) bool {
-	switch name {
-	case privatekey.EdgeUser:
-		return m.cleareduser
-	}
+func (m *RelyingPartyMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PrivateKeyMutation) ClearEdge(name string) error {
-	switch name {
-	case privatekey.EdgeUser:
-		m.ClearUser()
-		return nil
-	}
-	return fmt.Errorf("unknown PrivateKey unique edge %s", name)
+func (m *RelyingPartyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RelyingParty unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PrivateKeyMutation) ResetEdge(name string) error {
-	switch name {
-	case privatekey.EdgeUser:
-		m.ResetUser()
-		return nil
-	}
-	return fmt.Errorf("unknown PrivateKey edge %s", name)
+func (m *RelyingPartyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RelyingParty edge %s", name)
 }
 
-// PublicKeyMutation represents an operation that mutates the PublicKey nodes in the graph.
-type PublicKeyMutation struct {
+// TrustedIssuerMutation represents an operation that mutates the TrustedIssuer nodes in the graph.
+type TrustedIssuerMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *string
-	kty           *string
-	alg           *string
-	jwk           *[]uint8
+	credentials   *[]til.Credential
 	created_at    *time.Time
 	updated_at    *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*PublicKey, error)
-	predicates    []predicate.PublicKey
+	oldValue      func(context.Context) (*TrustedIssuer, error)
+	predicates    []predicate.TrustedIssuer
 }
 
-var _ ent.Mutation = (*PublicKeyMutation)(nil)
+var _ ent.Mutation = (*TrustedIssuerMutation)(nil)
 
-// publickeyOption allows management of the mutation configuration using functional options.
-type publickeyOption func(*PublicKeyMutation)
+// trustedissuerOption allows management of the mutation configuration using functional options.
+type trustedissuerOption func(*TrustedIssuerMutation)
 
-// newPublicKeyMutation creates new mutation for the PublicKey entity.
-func newPublicKeyMutation(c config, op Op, opts ...publickeyOption) *PublicKeyMutation {
-	m := &PublicKeyMutation{
+// newTrustedIssuerMutation creates new mutation for the TrustedIssuer entity.
+func newTrustedIssuerMutation(c config, op Op, opts ...trustedissuerOption) *TrustedIssuerMutation {
+	m := &TrustedIssuerMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePublicKey,
+		typ:           TypeTrustedIssuer,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2546,20 +11116,20 @@ func newPublicKeyMutation(c config, op Op, opts ...publickeyOption) *PublicKeyMu
 	return m
 }
 
-// withPublicKeyID sets the ID field of the mutation.
-func withPublicKeyID(id string) publickeyOption {
-	return func(m *PublicKeyMutation) {
+// withTrustedIssuerID sets the ID field of the mutation.
+func withTrustedIssuerID(id string) trustedissuerOption {
+	return func(m *TrustedIssuerMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PublicKey
+			value *TrustedIssuer
 		)
-		m.oldValue = func(ctx context.Context) (*PublicKey, error) {
+		m.oldValue = func(ctx context.Context) (*TrustedIssuer, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PublicKey.Get(ctx, id)
+					value, err = m.Client().TrustedIssuer.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2568,10 +11138,10 @@ func withPublicKeyID(id string) publickeyOption {
 	}
 }
 
-// withPublicKey sets the old PublicKey of the mutation.
-func withPublicKey(node *PublicKey) publickeyOption {
-	return func(m *PublicKeyMutation) {
-		m.oldValue = func(context.Context) (*PublicKey, error) {
+// withTrustedIssuer sets the old TrustedIssuer of the mutation.
+func withTrustedIssuer(node *TrustedIssuer) trustedissuerOption {
+	return func(m *TrustedIssuerMutation) {
+		m.oldValue = func(context.Context) (*TrustedIssuer, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2580,7 +11150,7 @@ func withPublicKey(node *PublicKey) publickeyOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PublicKeyMutation) Client() *Client {
+func (m TrustedIssuerMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2588,7 +11158,7 @@ func (m PublicKeyMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PublicKeyMutation) Tx() (*Tx, error) {
+func (m TrustedIssuerMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2598,14 +11168,14 @@ func (m PublicKeyMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of PublicKey entities.
-func (m *PublicKeyMutation) SetID(id string) {
+// operation is only accepted on creation of TrustedIssuer entities.
+func (m *TrustedIssuerMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PublicKeyMutation) ID() (id string, exists bool) {
+func (m *TrustedIssuerMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2616,7 +11186,7 @@ func (m *PublicKeyMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PublicKeyMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *TrustedIssuerMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2625,140 +11195,55 @@ func (m *PublicKeyMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PublicKey.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TrustedIssuer.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetKty sets the "kty" field.
-func (m *PublicKeyMutation) SetKty(s string) {
-	m.kty = &s
-}
-
-// Kty returns the value of the "kty" field in the mutation.
-func (m *PublicKeyMutation) Kty() (r string, exists bool) {
-	v := m.kty
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldKty returns the old "kty" field's value of the PublicKey entity.
-// If the PublicKey object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PublicKeyMutation) OldKty(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKty is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKty requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKty: %w", err)
-	}
-	return oldValue.Kty, nil
-}
-
-// ResetKty resets all changes to the "kty" field.
-func (m *PublicKeyMutation) ResetKty() {
-	m.kty = nil
-}
-
-// SetAlg sets the "alg" field.
-func (m *PublicKeyMutation) SetAlg(s string) {
-	m.alg = &s
-}
-
-// Alg returns the value of the "alg" field in the mutation.
-func (m *PublicKeyMutation) Alg() (r string, exists bool) {
-	v := m.alg
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldAlg returns the old "alg" field's value of the PublicKey entity.
-// If the PublicKey object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PublicKeyMutation) OldAlg(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAlg is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAlg requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAlg: %w", err)
-	}
-	return oldValue.Alg, nil
-}
-
-// ClearAlg clears the value of the "alg" field.
-func (m *PublicKeyMutation) ClearAlg() {
-	m.alg = nil
-	m.clearedFields[publickey.FieldAlg] = struct{}{}
-}
-
-// AlgCleared returns if the "alg" field was cleared in this mutation.
-func (m *PublicKeyMutation) AlgCleared() bool {
-	_, ok := m.clearedFields[publickey.FieldAlg]
-	return ok
-}
-
-// ResetAlg resets all changes to the "alg" field.
-func (m *PublicKeyMutation) ResetAlg() {
-	m.alg = nil
-	delete(m.clearedFields, publickey.FieldAlg)
-}
-
-// SetJwk sets the "jwk" field.
-func (m *PublicKeyMutation) SetJwk(u []uint8) {
-	m.jwk = &u
+// SetCredentials sets the "credentials" field.
+func (m *TrustedIssuerMutation) SetCredentials(t []til.Credential) {
+	m.credentials = &t
 }
 
-// Jwk returns the value of the "jwk" field in the mutation.
-func (m *PublicKeyMutation) Jwk() (r []uint8, exists bool) {
-	v := m.jwk
+// Credentials returns the value of the "credentials" field in the mutation.
+func (m *TrustedIssuerMutation) Credentials() (r []til.Credential, exists bool) {
+	v := m.credentials
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldJwk returns the old "jwk" field's value of the PublicKey entity.
-// If the PublicKey object wasn't provided to the builder, the object is fetched from the database.
+// OldCredentials returns the old "credentials" field's value of the TrustedIssuer entity.
+// If the TrustedIssuer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PublicKeyMutation) OldJwk(ctx context.Context) (v []uint8, err error) {
+func (m *TrustedIssuerMutation) OldCredentials(ctx context.Context) (v []til.Credential, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJwk is only allowed on UpdateOne operations")
+		return v, errors.New("OldCredentials is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJwk requires an ID field in the mutation")
+		return v, errors.New("OldCredentials requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJwk: %w", err)
+		return v, fmt.Errorf("querying old value for OldCredentials: %w", err)
 	}
-	return oldValue.Jwk, nil
+	return oldValue.Credentials, nil
 }
 
-// ResetJwk resets all changes to the "jwk" field.
-func (m *PublicKeyMutation) ResetJwk() {
-	m.jwk = nil
+// ResetCredentials resets all changes to the "credentials" field.
+func (m *TrustedIssuerMutation) ResetCredentials() {
+	m.credentials = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *PublicKeyMutation) SetCreatedAt(t time.Time) {
+func (m *TrustedIssuerMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PublicKeyMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *TrustedIssuerMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2766,10 +11251,10 @@ func (m *PublicKeyMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the PublicKey entity.
-// If the PublicKey object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the TrustedIssuer entity.
+// If the TrustedIssuer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PublicKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TrustedIssuerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2784,17 +11269,17 @@ func (m *PublicKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PublicKeyMutation) ResetCreatedAt() {
+func (m *TrustedIssuerMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *PublicKeyMutation) SetUpdatedAt(t time.Time) {
+func (m *TrustedIssuerMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PublicKeyMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *TrustedIssuerMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -2802,10 +11287,10 @@ func (m *PublicKeyMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the PublicKey entity.
-// If the PublicKey object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the TrustedIssuer entity.
+// If the TrustedIssuer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PublicKeyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TrustedIssuerMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -2820,44 +11305,38 @@ func (m *PublicKeyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PublicKeyMutation) ResetUpdatedAt() {
+func (m *TrustedIssuerMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// Where appends a list predicates to the PublicKeyMutation builder.
-func (m *PublicKeyMutation) Where(ps ...predicate.PublicKey) {
+// Where appends a list predicates to the TrustedIssuerMutation builder.
+func (m *TrustedIssuerMutation) Where(ps ...predicate.TrustedIssuer) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *PublicKeyMutation) Op() Op {
+func (m *TrustedIssuerMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (PublicKey).
-func (m *PublicKeyMutation) Type() string {
+// Type returns the node type of this mutation (TrustedIssuer).
+func (m *TrustedIssuerMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PublicKeyMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.kty != nil {
-		fields = append(fields, publickey.FieldKty)
-	}
-	if m.alg != nil {
-		fields = append(fields, publickey.FieldAlg)
-	}
-	if m.jwk != nil {
-		fields = append(fields, publickey.FieldJwk)
+func (m *TrustedIssuerMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.credentials != nil {
+		fields = append(fields, trustedissuer.FieldCredentials)
 	}
 	if m.created_at != nil {
-		fields = append(fields, publickey.FieldCreatedAt)
+		fields = append(fields, trustedissuer.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, publickey.FieldUpdatedAt)
+		fields = append(fields, trustedissuer.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2865,17 +11344,13 @@ func (m *PublicKeyMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PublicKeyMutation) Field(name string) (ent.Value, bool) {
+func (m *TrustedIssuerMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case publickey.FieldKty:
-		return m.Kty()
-	case publickey.FieldAlg:
-		return m.Alg()
-	case publickey.FieldJwk:
-		return m.Jwk()
-	case publickey.FieldCreatedAt:
+	case trustedissuer.FieldCredentials:
+		return m.Credentials()
+	case trustedissuer.FieldCreatedAt:
 		return m.CreatedAt()
-	case publickey.FieldUpdatedAt:
+	case trustedissuer.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -2884,56 +11359,38 @@ func (m *PublicKeyMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PublicKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TrustedIssuerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case publickey.FieldKty:
-		return m.OldKty(ctx)
-	case publickey.FieldAlg:
-		return m.OldAlg(ctx)
-	case publickey.FieldJwk:
-		return m.OldJwk(ctx)
-	case publickey.FieldCreatedAt:
+	case trustedissuer.FieldCredentials:
+		return m.OldCredentials(ctx)
+	case trustedissuer.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case publickey.FieldUpdatedAt:
+	case trustedissuer.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown PublicKey field %s", name)
+	return nil, fmt.Errorf("unknown TrustedIssuer field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PublicKeyMutation) SetField(name string, value ent.Value) error {
+func (m *TrustedIssuerMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case publickey.FieldKty:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetKty(v)
-		return nil
-	case publickey.FieldAlg:
-		v, ok := value.(string)
+	case trustedissuer.FieldCredentials:
+		v, ok := value.([]til.Credential)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAlg(v)
-		return nil
-	case publickey.FieldJwk:
-		v, ok := value.([]uint8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetJwk(v)
+		m.SetCredentials(v)
 		return nil
-	case publickey.FieldCreatedAt:
+	case trustedissuer.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case publickey.FieldUpdatedAt:
+	case trustedissuer.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2941,128 +11398,113 @@ func (m *PublicKeyMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PublicKey field %s", name)
+	return fmt.Errorf("unknown TrustedIssuer field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PublicKeyMutation) AddedFields() []string {
+func (m *TrustedIssuerMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PublicKeyMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TrustedIssuerMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PublicKeyMutation) AddField(name string, value ent.Value) error {
+func (m *TrustedIssuerMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown PublicKey numeric field %s", name)
+	return fmt.Errorf("unknown TrustedIssuer numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PublicKeyMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(publickey.FieldAlg) {
-		fields = append(fields, publickey.FieldAlg)
-	}
-	return fields
+func (m *TrustedIssuerMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PublicKeyMutation) FieldCleared(name string) bool {
+func (m *TrustedIssuerMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PublicKeyMutation) ClearField(name string) error {
-	switch name {
-	case publickey.FieldAlg:
-		m.ClearAlg()
-		return nil
-	}
-	return fmt.Errorf("unknown PublicKey nullable field %s", name)
+func (m *TrustedIssuerMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TrustedIssuer nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PublicKeyMutation) ResetField(name string) error {
+func (m *TrustedIssuerMutation) ResetField(name string) error {
 	switch name {
-	case publickey.FieldKty:
-		m.ResetKty()
-		return nil
-	case publickey.FieldAlg:
-		m.ResetAlg()
-		return nil
-	case publickey.FieldJwk:
-		m.ResetJwk()
+	case trustedissuer.FieldCredentials:
+		m.ResetCredentials()
 		return nil
-	case publickey.FieldCreatedAt:
+	case trustedissuer.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case publickey.FieldUpdatedAt:
+	case trustedissuer.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown PublicKey field %s", name)
+	return fmt.Errorf("unknown TrustedIssuer field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PublicKeyMutation) AddedEdges() []string {
+func (m *TrustedIssuerMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PublicKeyMutation) AddedIDs(name string) []ent.Value {
+func (m *TrustedIssuerMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PublicKeyMutation) RemovedEdges() []string {
+func (m *TrustedIssuerMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PublicKeyMutation) RemovedIDs(name string) []ent.Value {
+func (m *TrustedIssuerMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PublicKeyMutation) ClearedEdges() []string {
+func (m *TrustedIssuerMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PublicKeyMutation) EdgeCleared(name string) bool {
+func (m *TrustedIssuerMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PublicKeyMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown PublicKey unique edge %s", name)
+func (m *TrustedIssuerMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TrustedIssuer unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PublicKeyMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown PublicKey edge %s", name)
+func (m *TrustedIssuerMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TrustedIssuer edge %s", name)
 }
 
 // UserMutation represents an operation that mutates the User nodes in the graph.
@@ -3075,6 +11517,7 @@ type UserMutation struct {
 	displayname        *string
 	_type              *string
 	password           *[]byte
+	roles              *[]string
 	created_at         *time.Time
 	updated_at         *time.Time
 	clearedFields      map[string]struct{}
@@ -3353,6 +11796,55 @@ func (m *UserMutation) ResetPassword() {
 	m.password = nil
 }
 
+// SetRoles sets the "roles" field.
+func (m *UserMutation) SetRoles(s []string) {
+	m.roles = &s
+}
+
+// Roles returns the value of the "roles" field in the mutation.
+func (m *UserMutation) Roles() (r []string, exists bool) {
+	v := m.roles
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoles returns the old "roles" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRoles(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoles: %w", err)
+	}
+	return oldValue.Roles, nil
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (m *UserMutation) ClearRoles() {
+	m.roles = nil
+	m.clearedFields[user.FieldRoles] = struct{}{}
+}
+
+// RolesCleared returns if the "roles" field was cleared in this mutation.
+func (m *UserMutation) RolesCleared() bool {
+	_, ok := m.clearedFields[user.FieldRoles]
+	return ok
+}
+
+// ResetRoles resets all changes to the "roles" field.
+func (m *UserMutation) ResetRoles() {
+	m.roles = nil
+	delete(m.clearedFields, user.FieldRoles)
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *UserMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -3606,7 +12098,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 7)
 	if m.name != nil {
 		fields = append(fields, user.FieldName)
 	}
@@ -3619,6 +12111,9 @@ func (m *UserMutation) Fields() []string {
 	if m.password != nil {
 		fields = append(fields, user.FieldPassword)
 	}
+	if m.roles != nil {
+		fields = append(fields, user.FieldRoles)
+	}
 	if m.created_at != nil {
 		fields = append(fields, user.FieldCreatedAt)
 	}
@@ -3641,6 +12136,8 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.GetType()
 	case user.FieldPassword:
 		return m.Password()
+	case user.FieldRoles:
+		return m.Roles()
 	case user.FieldCreatedAt:
 		return m.CreatedAt()
 	case user.FieldUpdatedAt:
@@ -3662,6 +12159,8 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldType(ctx)
 	case user.FieldPassword:
 		return m.OldPassword(ctx)
+	case user.FieldRoles:
+		return m.OldRoles(ctx)
 	case user.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case user.FieldUpdatedAt:
@@ -3703,6 +12202,13 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetPassword(v)
 		return nil
+	case user.FieldRoles:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoles(v)
+		return nil
 	case user.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -3750,6 +12256,9 @@ func (m *UserMutation) ClearedFields() []string {
 	if m.FieldCleared(user.FieldDisplayname) {
 		fields = append(fields, user.FieldDisplayname)
 	}
+	if m.FieldCleared(user.FieldRoles) {
+		fields = append(fields, user.FieldRoles)
+	}
 	return fields
 }
 
@@ -3767,6 +12276,9 @@ func (m *UserMutation) ClearField(name string) error {
 	case user.FieldDisplayname:
 		m.ClearDisplayname()
 		return nil
+	case user.FieldRoles:
+		m.ClearRoles()
+		return nil
 	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
@@ -3787,6 +12299,9 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldPassword:
 		m.ResetPassword()
 		return nil
+	case user.FieldRoles:
+		m.ResetRoles()
+		return nil
 	case user.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
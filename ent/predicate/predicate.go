@@ -6,20 +6,50 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AuditEntry is the predicate function for auditentry builders.
+type AuditEntry func(*sql.Selector)
+
+// ClientCertificate is the predicate function for clientcertificate builders.
+type ClientCertificate func(*sql.Selector)
+
 // Credential is the predicate function for credential builders.
 type Credential func(*sql.Selector)
 
+// CredentialTemplate is the predicate function for credentialtemplate builders.
+type CredentialTemplate func(*sql.Selector)
+
 // DID is the predicate function for did builders.
 type DID func(*sql.Selector)
 
+// IssuanceRequest is the predicate function for issuancerequest builders.
+type IssuanceRequest func(*sql.Selector)
+
+// IssuanceRequestEvent is the predicate function for issuancerequestevent builders.
+type IssuanceRequestEvent func(*sql.Selector)
+
+// LoginSession is the predicate function for loginsession builders.
+type LoginSession func(*sql.Selector)
+
 // NaturalPerson is the predicate function for naturalperson builders.
 type NaturalPerson func(*sql.Selector)
 
+// PresentationNonce is the predicate function for presentationnonce builders.
+type PresentationNonce func(*sql.Selector)
+
 // PrivateKey is the predicate function for privatekey builders.
 type PrivateKey func(*sql.Selector)
 
 // PublicKey is the predicate function for publickey builders.
 type PublicKey func(*sql.Selector)
 
+// RateCounter is the predicate function for ratecounter builders.
+type RateCounter func(*sql.Selector)
+
+// RelyingParty is the predicate function for relyingparty builders.
+type RelyingParty func(*sql.Selector)
+
+// TrustedIssuer is the predicate function for trustedissuer builders.
+type TrustedIssuer func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/hesusruiz/vcbackend/ent/presentationnonce"
+)
+
+// PresentationNonce is the model entity for the PresentationNonce schema.
+type PresentationNonce struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// State holds the value of the "state" field.
+	State string `json:"state,omitempty"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID string `json:"client_id,omitempty"`
+	// Audience holds the value of the "audience" field.
+	Audience string `json:"audience,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// ConsumedAt holds the value of the "consumed_at" field.
+	ConsumedAt *time.Time `json:"consumed_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PresentationNonce) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case presentationnonce.FieldID, presentationnonce.FieldState, presentationnonce.FieldClientID, presentationnonce.FieldAudience:
+			values[i] = new(sql.NullString)
+		case presentationnonce.FieldCreatedAt, presentationnonce.FieldExpiresAt, presentationnonce.FieldConsumedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type PresentationNonce", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PresentationNonce fields.
+func (pn *PresentationNonce) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case presentationnonce.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				pn.ID = value.String
+			}
+		case presentationnonce.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				pn.State = value.String
+			}
+		case presentationnonce.FieldClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				pn.ClientID = value.String
+			}
+		case presentationnonce.FieldAudience:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field audience", values[i])
+			} else if value.Valid {
+				pn.Audience = value.String
+			}
+		case presentationnonce.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pn.CreatedAt = value.Time
+			}
+		case presentationnonce.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				pn.ExpiresAt = value.Time
+			}
+		case presentationnonce.FieldConsumedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field consumed_at", values[i])
+			} else if value.Valid {
+				pn.ConsumedAt = new(time.Time)
+				*pn.ConsumedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this PresentationNonce.
+// Note that you need to call PresentationNonce.Unwrap() before calling this method if this PresentationNonce
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pn *PresentationNonce) Update() *PresentationNonceUpdateOne {
+	return (&PresentationNonceClient{config: pn.config}).UpdateOne(pn)
+}
+
+// Unwrap unwraps the PresentationNonce entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pn *PresentationNonce) Unwrap() *PresentationNonce {
+	_tx, ok := pn.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PresentationNonce is not a transactional entity")
+	}
+	pn.config.driver = _tx.drv
+	return pn
+}
+
+// String implements the fmt.Stringer.
+func (pn *PresentationNonce) String() string {
+	var builder strings.Builder
+	builder.WriteString("PresentationNonce(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pn.ID))
+	builder.WriteString("state=")
+	builder.WriteString(pn.State)
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(pn.ClientID)
+	builder.WriteString(", ")
+	builder.WriteString("audience=")
+	builder.WriteString(pn.Audience)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pn.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(pn.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := pn.ConsumedAt; v != nil {
+		builder.WriteString("consumed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PresentationNonces is a parsable slice of PresentationNonce.
+type PresentationNonces []*PresentationNonce
+
+func (pn PresentationNonces) config(cfg config) {
+	for _i := range pn {
+		pn[_i].config = cfg
+	}
+}
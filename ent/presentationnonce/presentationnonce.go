@@ -0,0 +1,54 @@
+// Code generated by ent, DO NOT EDIT.
+
+package presentationnonce
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the presentationnonce type in the database.
+	Label = "presentation_nonce"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldClientID holds the string denoting the client_id field in the database.
+	FieldClientID = "client_id"
+	// FieldAudience holds the string denoting the audience field in the database.
+	FieldAudience = "audience"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldConsumedAt holds the string denoting the consumed_at field in the database.
+	FieldConsumedAt = "consumed_at"
+	// Table holds the table name of the presentationnonce in the database.
+	Table = "presentation_nonces"
+)
+
+// Columns holds all SQL columns for presentationnonce fields.
+var Columns = []string{
+	FieldID,
+	FieldState,
+	FieldClientID,
+	FieldAudience,
+	FieldCreatedAt,
+	FieldExpiresAt,
+	FieldConsumedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
@@ -0,0 +1,351 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/presentationnonce"
+)
+
+// PresentationNonceCreate is the builder for creating a PresentationNonce entity.
+type PresentationNonceCreate struct {
+	config
+	mutation *PresentationNonceMutation
+	hooks    []Hook
+}
+
+// SetState sets the "state" field.
+func (pnc *PresentationNonceCreate) SetState(s string) *PresentationNonceCreate {
+	pnc.mutation.SetState(s)
+	return pnc
+}
+
+// SetClientID sets the "client_id" field.
+func (pnc *PresentationNonceCreate) SetClientID(s string) *PresentationNonceCreate {
+	pnc.mutation.SetClientID(s)
+	return pnc
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (pnc *PresentationNonceCreate) SetNillableClientID(s *string) *PresentationNonceCreate {
+	if s != nil {
+		pnc.SetClientID(*s)
+	}
+	return pnc
+}
+
+// SetAudience sets the "audience" field.
+func (pnc *PresentationNonceCreate) SetAudience(s string) *PresentationNonceCreate {
+	pnc.mutation.SetAudience(s)
+	return pnc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pnc *PresentationNonceCreate) SetCreatedAt(t time.Time) *PresentationNonceCreate {
+	pnc.mutation.SetCreatedAt(t)
+	return pnc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pnc *PresentationNonceCreate) SetNillableCreatedAt(t *time.Time) *PresentationNonceCreate {
+	if t != nil {
+		pnc.SetCreatedAt(*t)
+	}
+	return pnc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (pnc *PresentationNonceCreate) SetExpiresAt(t time.Time) *PresentationNonceCreate {
+	pnc.mutation.SetExpiresAt(t)
+	return pnc
+}
+
+// SetConsumedAt sets the "consumed_at" field.
+func (pnc *PresentationNonceCreate) SetConsumedAt(t time.Time) *PresentationNonceCreate {
+	pnc.mutation.SetConsumedAt(t)
+	return pnc
+}
+
+// SetNillableConsumedAt sets the "consumed_at" field if the given value is not nil.
+func (pnc *PresentationNonceCreate) SetNillableConsumedAt(t *time.Time) *PresentationNonceCreate {
+	if t != nil {
+		pnc.SetConsumedAt(*t)
+	}
+	return pnc
+}
+
+// SetID sets the "id" field.
+func (pnc *PresentationNonceCreate) SetID(s string) *PresentationNonceCreate {
+	pnc.mutation.SetID(s)
+	return pnc
+}
+
+// Mutation returns the PresentationNonceMutation object of the builder.
+func (pnc *PresentationNonceCreate) Mutation() *PresentationNonceMutation {
+	return pnc.mutation
+}
+
+// Save creates the PresentationNonce in the database.
+func (pnc *PresentationNonceCreate) Save(ctx context.Context) (*PresentationNonce, error) {
+	var (
+		err  error
+		node *PresentationNonce
+	)
+	pnc.defaults()
+	if len(pnc.hooks) == 0 {
+		if err = pnc.check(); err != nil {
+			return nil, err
+		}
+		node, err = pnc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PresentationNonceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pnc.check(); err != nil {
+				return nil, err
+			}
+			pnc.mutation = mutation
+			if node, err = pnc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pnc.hooks) - 1; i >= 0; i-- {
+			if pnc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pnc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, pnc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*PresentationNonce)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from PresentationNonceMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pnc *PresentationNonceCreate) SaveX(ctx context.Context) *PresentationNonce {
+	v, err := pnc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pnc *PresentationNonceCreate) Exec(ctx context.Context) error {
+	_, err := pnc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pnc *PresentationNonceCreate) ExecX(ctx context.Context) {
+	if err := pnc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pnc *PresentationNonceCreate) defaults() {
+	if _, ok := pnc.mutation.CreatedAt(); !ok {
+		v := presentationnonce.DefaultCreatedAt()
+		pnc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pnc *PresentationNonceCreate) check() error {
+	if _, ok := pnc.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "PresentationNonce.state"`)}
+	}
+	if _, ok := pnc.mutation.Audience(); !ok {
+		return &ValidationError{Name: "audience", err: errors.New(`ent: missing required field "PresentationNonce.audience"`)}
+	}
+	if _, ok := pnc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PresentationNonce.created_at"`)}
+	}
+	if _, ok := pnc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "PresentationNonce.expires_at"`)}
+	}
+	return nil
+}
+
+func (pnc *PresentationNonceCreate) sqlSave(ctx context.Context) (*PresentationNonce, error) {
+	_node, _spec := pnc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pnc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PresentationNonce.ID type: %T", _spec.ID.Value)
+		}
+	}
+	return _node, nil
+}
+
+func (pnc *PresentationNonceCreate) createSpec() (*PresentationNonce, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PresentationNonce{config: pnc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: presentationnonce.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: presentationnonce.FieldID,
+			},
+		}
+	)
+	if id, ok := pnc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := pnc.mutation.State(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: presentationnonce.FieldState,
+		})
+		_node.State = value
+	}
+	if value, ok := pnc.mutation.ClientID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: presentationnonce.FieldClientID,
+		})
+		_node.ClientID = value
+	}
+	if value, ok := pnc.mutation.Audience(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: presentationnonce.FieldAudience,
+		})
+		_node.Audience = value
+	}
+	if value, ok := pnc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: presentationnonce.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := pnc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: presentationnonce.FieldExpiresAt,
+		})
+		_node.ExpiresAt = value
+	}
+	if value, ok := pnc.mutation.ConsumedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: presentationnonce.FieldConsumedAt,
+		})
+		_node.ConsumedAt = &value
+	}
+	return _node, _spec
+}
+
+// PresentationNonceCreateBulk is the builder for creating many PresentationNonce entities in bulk.
+type PresentationNonceCreateBulk struct {
+	config
+	builders []*PresentationNonceCreate
+}
+
+// Save creates the PresentationNonce entities in the database.
+func (pncb *PresentationNonceCreateBulk) Save(ctx context.Context) ([]*PresentationNonce, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(pncb.builders))
+	nodes := make([]*PresentationNonce, len(pncb.builders))
+	mutators := make([]Mutator, len(pncb.builders))
+	for i := range pncb.builders {
+		func(i int, root context.Context) {
+			builder := pncb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PresentationNonceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pncb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pncb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pncb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pncb *PresentationNonceCreateBulk) SaveX(ctx context.Context) []*PresentationNonce {
+	v, err := pncb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pncb *PresentationNonceCreateBulk) Exec(ctx context.Context) error {
+	_, err := pncb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pncb *PresentationNonceCreateBulk) ExecX(ctx context.Context) {
+	if err := pncb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+	"github.com/hesusruiz/vcbackend/ent/presentationnonce"
+)
+
+// PresentationNonceQuery is the builder for querying PresentationNonce entities.
+type PresentationNonceQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.PresentationNonce
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PresentationNonceQuery builder.
+func (pnq *PresentationNonceQuery) Where(ps ...predicate.PresentationNonce) *PresentationNonceQuery {
+	pnq.predicates = append(pnq.predicates, ps...)
+	return pnq
+}
+
+// Limit adds a limit step to the query.
+func (pnq *PresentationNonceQuery) Limit(limit int) *PresentationNonceQuery {
+	pnq.limit = &limit
+	return pnq
+}
+
+// Offset adds an offset step to the query.
+func (pnq *PresentationNonceQuery) Offset(offset int) *PresentationNonceQuery {
+	pnq.offset = &offset
+	return pnq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pnq *PresentationNonceQuery) Unique(unique bool) *PresentationNonceQuery {
+	pnq.unique = &unique
+	return pnq
+}
+
+// Order adds an order step to the query.
+func (pnq *PresentationNonceQuery) Order(o ...OrderFunc) *PresentationNonceQuery {
+	pnq.order = append(pnq.order, o...)
+	return pnq
+}
+
+// First returns the first PresentationNonce entity from the query.
+// Returns a *NotFoundError when no PresentationNonce was found.
+func (pnq *PresentationNonceQuery) First(ctx context.Context) (*PresentationNonce, error) {
+	nodes, err := pnq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{presentationnonce.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pnq *PresentationNonceQuery) FirstX(ctx context.Context) *PresentationNonce {
+	node, err := pnq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PresentationNonce ID from the query.
+// Returns a *NotFoundError when no PresentationNonce ID was found.
+func (pnq *PresentationNonceQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = pnq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{presentationnonce.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pnq *PresentationNonceQuery) FirstIDX(ctx context.Context) string {
+	id, err := pnq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PresentationNonce entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PresentationNonce entity is found.
+// Returns a *NotFoundError when no PresentationNonce entities are found.
+func (pnq *PresentationNonceQuery) Only(ctx context.Context) (*PresentationNonce, error) {
+	nodes, err := pnq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{presentationnonce.Label}
+	default:
+		return nil, &NotSingularError{presentationnonce.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pnq *PresentationNonceQuery) OnlyX(ctx context.Context) *PresentationNonce {
+	node, err := pnq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PresentationNonce ID in the query.
+// Returns a *NotSingularError when more than one PresentationNonce ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pnq *PresentationNonceQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = pnq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{presentationnonce.Label}
+	default:
+		err = &NotSingularError{presentationnonce.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pnq *PresentationNonceQuery) OnlyIDX(ctx context.Context) string {
+	id, err := pnq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PresentationNonces.
+func (pnq *PresentationNonceQuery) All(ctx context.Context) ([]*PresentationNonce, error) {
+	if err := pnq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return pnq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pnq *PresentationNonceQuery) AllX(ctx context.Context) []*PresentationNonce {
+	nodes, err := pnq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PresentationNonce IDs.
+func (pnq *PresentationNonceQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := pnq.Select(presentationnonce.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pnq *PresentationNonceQuery) IDsX(ctx context.Context) []string {
+	ids, err := pnq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pnq *PresentationNonceQuery) Count(ctx context.Context) (int, error) {
+	if err := pnq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return pnq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pnq *PresentationNonceQuery) CountX(ctx context.Context) int {
+	count, err := pnq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pnq *PresentationNonceQuery) Exist(ctx context.Context) (bool, error) {
+	if err := pnq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return pnq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pnq *PresentationNonceQuery) ExistX(ctx context.Context) bool {
+	exist, err := pnq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PresentationNonceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pnq *PresentationNonceQuery) Clone() *PresentationNonceQuery {
+	if pnq == nil {
+		return nil
+	}
+	return &PresentationNonceQuery{
+		config:     pnq.config,
+		limit:      pnq.limit,
+		offset:     pnq.offset,
+		order:      append([]OrderFunc{}, pnq.order...),
+		predicates: append([]predicate.PresentationNonce{}, pnq.predicates...),
+		// clone intermediate query.
+		sql:    pnq.sql.Clone(),
+		path:   pnq.path,
+		unique: pnq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		State string `json:"state,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PresentationNonce.Query().
+//		GroupBy(presentationnonce.FieldState).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pnq *PresentationNonceQuery) GroupBy(field string, fields ...string) *PresentationNonceGroupBy {
+	grbuild := &PresentationNonceGroupBy{config: pnq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := pnq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return pnq.sqlQuery(ctx), nil
+	}
+	grbuild.label = presentationnonce.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		State string `json:"state,omitempty"`
+//	}
+//
+//	client.PresentationNonce.Query().
+//		Select(presentationnonce.FieldState).
+//		Scan(ctx, &v)
+func (pnq *PresentationNonceQuery) Select(fields ...string) *PresentationNonceSelect {
+	pnq.fields = append(pnq.fields, fields...)
+	selbuild := &PresentationNonceSelect{PresentationNonceQuery: pnq}
+	selbuild.label = presentationnonce.Label
+	selbuild.flds, selbuild.scan = &pnq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (pnq *PresentationNonceQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range pnq.fields {
+		if !presentationnonce.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pnq.path != nil {
+		prev, err := pnq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pnq.sql = prev
+	}
+	return nil
+}
+
+func (pnq *PresentationNonceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PresentationNonce, error) {
+	var (
+		nodes = []*PresentationNonce{}
+		_spec = pnq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*PresentationNonce).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &PresentationNonce{config: pnq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pnq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (pnq *PresentationNonceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pnq.querySpec()
+	_spec.Node.Columns = pnq.fields
+	if len(pnq.fields) > 0 {
+		_spec.Unique = pnq.unique != nil && *pnq.unique
+	}
+	return sqlgraph.CountNodes(ctx, pnq.driver, _spec)
+}
+
+func (pnq *PresentationNonceQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := pnq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (pnq *PresentationNonceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   presentationnonce.Table,
+			Columns: presentationnonce.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: presentationnonce.FieldID,
+			},
+		},
+		From:   pnq.sql,
+		Unique: true,
+	}
+	if unique := pnq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := pnq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, presentationnonce.FieldID)
+		for i := range fields {
+			if fields[i] != presentationnonce.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pnq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pnq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pnq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pnq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pnq *PresentationNonceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pnq.driver.Dialect())
+	t1 := builder.Table(presentationnonce.Table)
+	columns := pnq.fields
+	if len(columns) == 0 {
+		columns = presentationnonce.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pnq.sql != nil {
+		selector = pnq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pnq.unique != nil && *pnq.unique {
+		selector.Distinct()
+	}
+	for _, p := range pnq.predicates {
+		p(selector)
+	}
+	for _, p := range pnq.order {
+		p(selector)
+	}
+	if offset := pnq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pnq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PresentationNonceGroupBy is the group-by builder for PresentationNonce entities.
+type PresentationNonceGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pngb *PresentationNonceGroupBy) Aggregate(fns ...AggregateFunc) *PresentationNonceGroupBy {
+	pngb.fns = append(pngb.fns, fns...)
+	return pngb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (pngb *PresentationNonceGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := pngb.path(ctx)
+	if err != nil {
+		return err
+	}
+	pngb.sql = query
+	return pngb.sqlScan(ctx, v)
+}
+
+func (pngb *PresentationNonceGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range pngb.fields {
+		if !presentationnonce.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := pngb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pngb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (pngb *PresentationNonceGroupBy) sqlQuery() *sql.Selector {
+	selector := pngb.sql.Select()
+	aggregation := make([]string, 0, len(pngb.fns))
+	for _, fn := range pngb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(pngb.fields)+len(pngb.fns))
+		for _, f := range pngb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(pngb.fields...)...)
+}
+
+// PresentationNonceSelect is the builder for selecting fields of PresentationNonce entities.
+type PresentationNonceSelect struct {
+	*PresentationNonceQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pns *PresentationNonceSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := pns.prepareQuery(ctx); err != nil {
+		return err
+	}
+	pns.sql = pns.PresentationNonceQuery.sqlQuery(ctx)
+	return pns.sqlScan(ctx, v)
+}
+
+func (pns *PresentationNonceSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := pns.sql.Query()
+	if err := pns.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,324 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+	"github.com/hesusruiz/vcbackend/ent/presentationnonce"
+)
+
+// PresentationNonceUpdate is the builder for updating PresentationNonce entities.
+type PresentationNonceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PresentationNonceMutation
+}
+
+// Where appends a list predicates to the PresentationNonceUpdate builder.
+func (pnu *PresentationNonceUpdate) Where(ps ...predicate.PresentationNonce) *PresentationNonceUpdate {
+	pnu.mutation.Where(ps...)
+	return pnu
+}
+
+// SetConsumedAt sets the "consumed_at" field.
+func (pnu *PresentationNonceUpdate) SetConsumedAt(t time.Time) *PresentationNonceUpdate {
+	pnu.mutation.SetConsumedAt(t)
+	return pnu
+}
+
+// SetNillableConsumedAt sets the "consumed_at" field if the given value is not nil.
+func (pnu *PresentationNonceUpdate) SetNillableConsumedAt(t *time.Time) *PresentationNonceUpdate {
+	if t != nil {
+		pnu.SetConsumedAt(*t)
+	}
+	return pnu
+}
+
+// ClearConsumedAt clears the value of the "consumed_at" field.
+func (pnu *PresentationNonceUpdate) ClearConsumedAt() *PresentationNonceUpdate {
+	pnu.mutation.ClearConsumedAt()
+	return pnu
+}
+
+// Mutation returns the PresentationNonceMutation object of the builder.
+func (pnu *PresentationNonceUpdate) Mutation() *PresentationNonceMutation {
+	return pnu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pnu *PresentationNonceUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(pnu.hooks) == 0 {
+		affected, err = pnu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PresentationNonceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			pnu.mutation = mutation
+			affected, err = pnu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(pnu.hooks) - 1; i >= 0; i-- {
+			if pnu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pnu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pnu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pnu *PresentationNonceUpdate) SaveX(ctx context.Context) int {
+	affected, err := pnu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pnu *PresentationNonceUpdate) Exec(ctx context.Context) error {
+	_, err := pnu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pnu *PresentationNonceUpdate) ExecX(ctx context.Context) {
+	if err := pnu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (pnu *PresentationNonceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   presentationnonce.Table,
+			Columns: presentationnonce.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: presentationnonce.FieldID,
+			},
+		},
+	}
+	if ps := pnu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if pnu.mutation.ClientIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: presentationnonce.FieldClientID,
+		})
+	}
+	if value, ok := pnu.mutation.ConsumedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: presentationnonce.FieldConsumedAt,
+		})
+	}
+	if pnu.mutation.ConsumedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: presentationnonce.FieldConsumedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pnu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{presentationnonce.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// PresentationNonceUpdateOne is the builder for updating a single PresentationNonce entity.
+type PresentationNonceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PresentationNonceMutation
+}
+
+// SetConsumedAt sets the "consumed_at" field.
+func (pnuo *PresentationNonceUpdateOne) SetConsumedAt(t time.Time) *PresentationNonceUpdateOne {
+	pnuo.mutation.SetConsumedAt(t)
+	return pnuo
+}
+
+// SetNillableConsumedAt sets the "consumed_at" field if the given value is not nil.
+func (pnuo *PresentationNonceUpdateOne) SetNillableConsumedAt(t *time.Time) *PresentationNonceUpdateOne {
+	if t != nil {
+		pnuo.SetConsumedAt(*t)
+	}
+	return pnuo
+}
+
+// ClearConsumedAt clears the value of the "consumed_at" field.
+func (pnuo *PresentationNonceUpdateOne) ClearConsumedAt() *PresentationNonceUpdateOne {
+	pnuo.mutation.ClearConsumedAt()
+	return pnuo
+}
+
+// Mutation returns the PresentationNonceMutation object of the builder.
+func (pnuo *PresentationNonceUpdateOne) Mutation() *PresentationNonceMutation {
+	return pnuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pnuo *PresentationNonceUpdateOne) Select(field string, fields ...string) *PresentationNonceUpdateOne {
+	pnuo.fields = append([]string{field}, fields...)
+	return pnuo
+}
+
+// Save executes the query and returns the updated PresentationNonce entity.
+func (pnuo *PresentationNonceUpdateOne) Save(ctx context.Context) (*PresentationNonce, error) {
+	var (
+		err  error
+		node *PresentationNonce
+	)
+	if len(pnuo.hooks) == 0 {
+		node, err = pnuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PresentationNonceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			pnuo.mutation = mutation
+			node, err = pnuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pnuo.hooks) - 1; i >= 0; i-- {
+			if pnuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pnuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, pnuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*PresentationNonce)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from PresentationNonceMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pnuo *PresentationNonceUpdateOne) SaveX(ctx context.Context) *PresentationNonce {
+	node, err := pnuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pnuo *PresentationNonceUpdateOne) Exec(ctx context.Context) error {
+	_, err := pnuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pnuo *PresentationNonceUpdateOne) ExecX(ctx context.Context) {
+	if err := pnuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (pnuo *PresentationNonceUpdateOne) sqlSave(ctx context.Context) (_node *PresentationNonce, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   presentationnonce.Table,
+			Columns: presentationnonce.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: presentationnonce.FieldID,
+			},
+		},
+	}
+	id, ok := pnuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PresentationNonce.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pnuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, presentationnonce.FieldID)
+		for _, f := range fields {
+			if !presentationnonce.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != presentationnonce.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pnuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if pnuo.mutation.ClientIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: presentationnonce.FieldClientID,
+		})
+	}
+	if value, ok := pnuo.mutation.ConsumedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: presentationnonce.FieldConsumedAt,
+		})
+	}
+	if pnuo.mutation.ConsumedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: presentationnonce.FieldConsumedAt,
+		})
+	}
+	_node = &PresentationNonce{config: pnuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pnuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{presentationnonce.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,113 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/hesusruiz/vcbackend/ent/ratecounter"
+)
+
+// RateCounter is the model entity for the RateCounter schema.
+type RateCounter struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Count holds the value of the "count" field.
+	Count int `json:"count,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RateCounter) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ratecounter.FieldCount:
+			values[i] = new(sql.NullInt64)
+		case ratecounter.FieldID:
+			values[i] = new(sql.NullString)
+		case ratecounter.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type RateCounter", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RateCounter fields.
+func (rc *RateCounter) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ratecounter.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				rc.ID = value.String
+			}
+		case ratecounter.FieldCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field count", values[i])
+			} else if value.Valid {
+				rc.Count = int(value.Int64)
+			}
+		case ratecounter.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				rc.ExpiresAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this RateCounter.
+// Note that you need to call RateCounter.Unwrap() before calling this method if this RateCounter
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rc *RateCounter) Update() *RateCounterUpdateOne {
+	return (&RateCounterClient{config: rc.config}).UpdateOne(rc)
+}
+
+// Unwrap unwraps the RateCounter entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rc *RateCounter) Unwrap() *RateCounter {
+	_tx, ok := rc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RateCounter is not a transactional entity")
+	}
+	rc.config.driver = _tx.drv
+	return rc
+}
+
+// String implements the fmt.Stringer.
+func (rc *RateCounter) String() string {
+	var builder strings.Builder
+	builder.WriteString("RateCounter(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rc.ID))
+	builder.WriteString("count=")
+	builder.WriteString(fmt.Sprintf("%v", rc.Count))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(rc.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RateCounters is a parsable slice of RateCounter.
+type RateCounters []*RateCounter
+
+func (rc RateCounters) config(cfg config) {
+	for _i := range rc {
+		rc[_i].config = cfg
+	}
+}
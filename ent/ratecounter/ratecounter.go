@@ -0,0 +1,38 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ratecounter
+
+const (
+	// Label holds the string label denoting the ratecounter type in the database.
+	Label = "rate_counter"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCount holds the string denoting the count field in the database.
+	FieldCount = "count"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// Table holds the table name of the ratecounter in the database.
+	Table = "rate_counters"
+)
+
+// Columns holds all SQL columns for ratecounter fields.
+var Columns = []string{
+	FieldID,
+	FieldCount,
+	FieldExpiresAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCount holds the default value on creation for the "count" field.
+	DefaultCount int
+)
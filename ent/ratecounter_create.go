@@ -0,0 +1,273 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/ratecounter"
+)
+
+// RateCounterCreate is the builder for creating a RateCounter entity.
+type RateCounterCreate struct {
+	config
+	mutation *RateCounterMutation
+	hooks    []Hook
+}
+
+// SetCount sets the "count" field.
+func (rcc *RateCounterCreate) SetCount(i int) *RateCounterCreate {
+	rcc.mutation.SetCount(i)
+	return rcc
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (rcc *RateCounterCreate) SetNillableCount(i *int) *RateCounterCreate {
+	if i != nil {
+		rcc.SetCount(*i)
+	}
+	return rcc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rcc *RateCounterCreate) SetExpiresAt(t time.Time) *RateCounterCreate {
+	rcc.mutation.SetExpiresAt(t)
+	return rcc
+}
+
+// SetID sets the "id" field.
+func (rcc *RateCounterCreate) SetID(s string) *RateCounterCreate {
+	rcc.mutation.SetID(s)
+	return rcc
+}
+
+// Mutation returns the RateCounterMutation object of the builder.
+func (rcc *RateCounterCreate) Mutation() *RateCounterMutation {
+	return rcc.mutation
+}
+
+// Save creates the RateCounter in the database.
+func (rcc *RateCounterCreate) Save(ctx context.Context) (*RateCounter, error) {
+	var (
+		err  error
+		node *RateCounter
+	)
+	rcc.defaults()
+	if len(rcc.hooks) == 0 {
+		if err = rcc.check(); err != nil {
+			return nil, err
+		}
+		node, err = rcc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RateCounterMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rcc.check(); err != nil {
+				return nil, err
+			}
+			rcc.mutation = mutation
+			if node, err = rcc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rcc.hooks) - 1; i >= 0; i-- {
+			if rcc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rcc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, rcc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*RateCounter)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from RateCounterMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rcc *RateCounterCreate) SaveX(ctx context.Context) *RateCounter {
+	v, err := rcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcc *RateCounterCreate) Exec(ctx context.Context) error {
+	_, err := rcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcc *RateCounterCreate) ExecX(ctx context.Context) {
+	if err := rcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rcc *RateCounterCreate) defaults() {
+	if _, ok := rcc.mutation.Count(); !ok {
+		v := ratecounter.DefaultCount
+		rcc.mutation.SetCount(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rcc *RateCounterCreate) check() error {
+	if _, ok := rcc.mutation.Count(); !ok {
+		return &ValidationError{Name: "count", err: errors.New(`ent: missing required field "RateCounter.count"`)}
+	}
+	if _, ok := rcc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "RateCounter.expires_at"`)}
+	}
+	return nil
+}
+
+func (rcc *RateCounterCreate) sqlSave(ctx context.Context) (*RateCounter, error) {
+	_node, _spec := rcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected RateCounter.ID type: %T", _spec.ID.Value)
+		}
+	}
+	return _node, nil
+}
+
+func (rcc *RateCounterCreate) createSpec() (*RateCounter, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RateCounter{config: rcc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: ratecounter.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: ratecounter.FieldID,
+			},
+		}
+	)
+	if id, ok := rcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rcc.mutation.Count(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ratecounter.FieldCount,
+		})
+		_node.Count = value
+	}
+	if value, ok := rcc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: ratecounter.FieldExpiresAt,
+		})
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// RateCounterCreateBulk is the builder for creating many RateCounter entities in bulk.
+type RateCounterCreateBulk struct {
+	config
+	builders []*RateCounterCreate
+}
+
+// Save creates the RateCounter entities in the database.
+func (rccb *RateCounterCreateBulk) Save(ctx context.Context) ([]*RateCounter, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(rccb.builders))
+	nodes := make([]*RateCounter, len(rccb.builders))
+	mutators := make([]Mutator, len(rccb.builders))
+	for i := range rccb.builders {
+		func(i int, root context.Context) {
+			builder := rccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RateCounterMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rccb *RateCounterCreateBulk) SaveX(ctx context.Context) []*RateCounter {
+	v, err := rccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rccb *RateCounterCreateBulk) Exec(ctx context.Context) error {
+	_, err := rccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rccb *RateCounterCreateBulk) ExecX(ctx context.Context) {
+	if err := rccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
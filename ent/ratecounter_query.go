@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+	"github.com/hesusruiz/vcbackend/ent/ratecounter"
+)
+
+// RateCounterQuery is the builder for querying RateCounter entities.
+type RateCounterQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.RateCounter
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RateCounterQuery builder.
+func (rcq *RateCounterQuery) Where(ps ...predicate.RateCounter) *RateCounterQuery {
+	rcq.predicates = append(rcq.predicates, ps...)
+	return rcq
+}
+
+// Limit adds a limit step to the query.
+func (rcq *RateCounterQuery) Limit(limit int) *RateCounterQuery {
+	rcq.limit = &limit
+	return rcq
+}
+
+// Offset adds an offset step to the query.
+func (rcq *RateCounterQuery) Offset(offset int) *RateCounterQuery {
+	rcq.offset = &offset
+	return rcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rcq *RateCounterQuery) Unique(unique bool) *RateCounterQuery {
+	rcq.unique = &unique
+	return rcq
+}
+
+// Order adds an order step to the query.
+func (rcq *RateCounterQuery) Order(o ...OrderFunc) *RateCounterQuery {
+	rcq.order = append(rcq.order, o...)
+	return rcq
+}
+
+// First returns the first RateCounter entity from the query.
+// Returns a *NotFoundError when no RateCounter was found.
+func (rcq *RateCounterQuery) First(ctx context.Context) (*RateCounter, error) {
+	nodes, err := rcq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{ratecounter.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rcq *RateCounterQuery) FirstX(ctx context.Context) *RateCounter {
+	node, err := rcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RateCounter ID from the query.
+// Returns a *NotFoundError when no RateCounter ID was found.
+func (rcq *RateCounterQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = rcq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{ratecounter.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rcq *RateCounterQuery) FirstIDX(ctx context.Context) string {
+	id, err := rcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RateCounter entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RateCounter entity is found.
+// Returns a *NotFoundError when no RateCounter entities are found.
+func (rcq *RateCounterQuery) Only(ctx context.Context) (*RateCounter, error) {
+	nodes, err := rcq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{ratecounter.Label}
+	default:
+		return nil, &NotSingularError{ratecounter.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rcq *RateCounterQuery) OnlyX(ctx context.Context) *RateCounter {
+	node, err := rcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RateCounter ID in the query.
+// Returns a *NotSingularError when more than one RateCounter ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rcq *RateCounterQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = rcq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{ratecounter.Label}
+	default:
+		err = &NotSingularError{ratecounter.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rcq *RateCounterQuery) OnlyIDX(ctx context.Context) string {
+	id, err := rcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RateCounters.
+func (rcq *RateCounterQuery) All(ctx context.Context) ([]*RateCounter, error) {
+	if err := rcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return rcq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rcq *RateCounterQuery) AllX(ctx context.Context) []*RateCounter {
+	nodes, err := rcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RateCounter IDs.
+func (rcq *RateCounterQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := rcq.Select(ratecounter.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rcq *RateCounterQuery) IDsX(ctx context.Context) []string {
+	ids, err := rcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rcq *RateCounterQuery) Count(ctx context.Context) (int, error) {
+	if err := rcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return rcq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rcq *RateCounterQuery) CountX(ctx context.Context) int {
+	count, err := rcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rcq *RateCounterQuery) Exist(ctx context.Context) (bool, error) {
+	if err := rcq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return rcq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rcq *RateCounterQuery) ExistX(ctx context.Context) bool {
+	exist, err := rcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RateCounterQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rcq *RateCounterQuery) Clone() *RateCounterQuery {
+	if rcq == nil {
+		return nil
+	}
+	return &RateCounterQuery{
+		config:     rcq.config,
+		limit:      rcq.limit,
+		offset:     rcq.offset,
+		order:      append([]OrderFunc{}, rcq.order...),
+		predicates: append([]predicate.RateCounter{}, rcq.predicates...),
+		// clone intermediate query.
+		sql:    rcq.sql.Clone(),
+		path:   rcq.path,
+		unique: rcq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Count int `json:"count,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RateCounter.Query().
+//		GroupBy(ratecounter.FieldCount).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rcq *RateCounterQuery) GroupBy(field string, fields ...string) *RateCounterGroupBy {
+	grbuild := &RateCounterGroupBy{config: rcq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := rcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return rcq.sqlQuery(ctx), nil
+	}
+	grbuild.label = ratecounter.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RateCounter.Query().
+//		Select(ratecounter.FieldCount).
+//		Scan(ctx, &v)
+func (rcq *RateCounterQuery) Select(fields ...string) *RateCounterSelect {
+	rcq.fields = append(rcq.fields, fields...)
+	selbuild := &RateCounterSelect{RateCounterQuery: rcq}
+	selbuild.label = ratecounter.Label
+	selbuild.flds, selbuild.scan = &rcq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (rcq *RateCounterQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range rcq.fields {
+		if !ratecounter.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rcq.path != nil {
+		prev, err := rcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rcq.sql = prev
+	}
+	return nil
+}
+
+func (rcq *RateCounterQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RateCounter, error) {
+	var (
+		nodes = []*RateCounter{}
+		_spec = rcq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*RateCounter).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &RateCounter{config: rcq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (rcq *RateCounterQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rcq.querySpec()
+	_spec.Node.Columns = rcq.fields
+	if len(rcq.fields) > 0 {
+		_spec.Unique = rcq.unique != nil && *rcq.unique
+	}
+	return sqlgraph.CountNodes(ctx, rcq.driver, _spec)
+}
+
+func (rcq *RateCounterQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := rcq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (rcq *RateCounterQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   ratecounter.Table,
+			Columns: ratecounter.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: ratecounter.FieldID,
+			},
+		},
+		From:   rcq.sql,
+		Unique: true,
+	}
+	if unique := rcq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := rcq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ratecounter.FieldID)
+		for i := range fields {
+			if fields[i] != ratecounter.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rcq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rcq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rcq *RateCounterQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rcq.driver.Dialect())
+	t1 := builder.Table(ratecounter.Table)
+	columns := rcq.fields
+	if len(columns) == 0 {
+		columns = ratecounter.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rcq.sql != nil {
+		selector = rcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rcq.unique != nil && *rcq.unique {
+		selector.Distinct()
+	}
+	for _, p := range rcq.predicates {
+		p(selector)
+	}
+	for _, p := range rcq.order {
+		p(selector)
+	}
+	if offset := rcq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rcq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RateCounterGroupBy is the group-by builder for RateCounter entities.
+type RateCounterGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rcgb *RateCounterGroupBy) Aggregate(fns ...AggregateFunc) *RateCounterGroupBy {
+	rcgb.fns = append(rcgb.fns, fns...)
+	return rcgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (rcgb *RateCounterGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := rcgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	rcgb.sql = query
+	return rcgb.sqlScan(ctx, v)
+}
+
+func (rcgb *RateCounterGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range rcgb.fields {
+		if !ratecounter.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := rcgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rcgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (rcgb *RateCounterGroupBy) sqlQuery() *sql.Selector {
+	selector := rcgb.sql.Select()
+	aggregation := make([]string, 0, len(rcgb.fns))
+	for _, fn := range rcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(rcgb.fields)+len(rcgb.fns))
+		for _, f := range rcgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(rcgb.fields...)...)
+}
+
+// RateCounterSelect is the builder for selecting fields of RateCounter entities.
+type RateCounterSelect struct {
+	*RateCounterQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rcs *RateCounterSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := rcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	rcs.sql = rcs.RateCounterQuery.sqlQuery(ctx)
+	return rcs.sqlScan(ctx, v)
+}
+
+func (rcs *RateCounterSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := rcs.sql.Query()
+	if err := rcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
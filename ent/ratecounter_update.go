@@ -0,0 +1,315 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+	"github.com/hesusruiz/vcbackend/ent/ratecounter"
+)
+
+// RateCounterUpdate is the builder for updating RateCounter entities.
+type RateCounterUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RateCounterMutation
+}
+
+// Where appends a list predicates to the RateCounterUpdate builder.
+func (rcu *RateCounterUpdate) Where(ps ...predicate.RateCounter) *RateCounterUpdate {
+	rcu.mutation.Where(ps...)
+	return rcu
+}
+
+// SetCount sets the "count" field.
+func (rcu *RateCounterUpdate) SetCount(i int) *RateCounterUpdate {
+	rcu.mutation.ResetCount()
+	rcu.mutation.SetCount(i)
+	return rcu
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (rcu *RateCounterUpdate) SetNillableCount(i *int) *RateCounterUpdate {
+	if i != nil {
+		rcu.SetCount(*i)
+	}
+	return rcu
+}
+
+// AddCount adds i to the "count" field.
+func (rcu *RateCounterUpdate) AddCount(i int) *RateCounterUpdate {
+	rcu.mutation.AddCount(i)
+	return rcu
+}
+
+// Mutation returns the RateCounterMutation object of the builder.
+func (rcu *RateCounterUpdate) Mutation() *RateCounterMutation {
+	return rcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rcu *RateCounterUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(rcu.hooks) == 0 {
+		affected, err = rcu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RateCounterMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			rcu.mutation = mutation
+			affected, err = rcu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(rcu.hooks) - 1; i >= 0; i-- {
+			if rcu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rcu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rcu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcu *RateCounterUpdate) SaveX(ctx context.Context) int {
+	affected, err := rcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rcu *RateCounterUpdate) Exec(ctx context.Context) error {
+	_, err := rcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcu *RateCounterUpdate) ExecX(ctx context.Context) {
+	if err := rcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (rcu *RateCounterUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   ratecounter.Table,
+			Columns: ratecounter.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: ratecounter.FieldID,
+			},
+		},
+	}
+	if ps := rcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rcu.mutation.Count(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ratecounter.FieldCount,
+		})
+	}
+	if value, ok := rcu.mutation.AddedCount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ratecounter.FieldCount,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ratecounter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// RateCounterUpdateOne is the builder for updating a single RateCounter entity.
+type RateCounterUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RateCounterMutation
+}
+
+// SetCount sets the "count" field.
+func (rcuo *RateCounterUpdateOne) SetCount(i int) *RateCounterUpdateOne {
+	rcuo.mutation.ResetCount()
+	rcuo.mutation.SetCount(i)
+	return rcuo
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (rcuo *RateCounterUpdateOne) SetNillableCount(i *int) *RateCounterUpdateOne {
+	if i != nil {
+		rcuo.SetCount(*i)
+	}
+	return rcuo
+}
+
+// AddCount adds i to the "count" field.
+func (rcuo *RateCounterUpdateOne) AddCount(i int) *RateCounterUpdateOne {
+	rcuo.mutation.AddCount(i)
+	return rcuo
+}
+
+// Mutation returns the RateCounterMutation object of the builder.
+func (rcuo *RateCounterUpdateOne) Mutation() *RateCounterMutation {
+	return rcuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rcuo *RateCounterUpdateOne) Select(field string, fields ...string) *RateCounterUpdateOne {
+	rcuo.fields = append([]string{field}, fields...)
+	return rcuo
+}
+
+// Save executes the query and returns the updated RateCounter entity.
+func (rcuo *RateCounterUpdateOne) Save(ctx context.Context) (*RateCounter, error) {
+	var (
+		err  error
+		node *RateCounter
+	)
+	if len(rcuo.hooks) == 0 {
+		node, err = rcuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RateCounterMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			rcuo.mutation = mutation
+			node, err = rcuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rcuo.hooks) - 1; i >= 0; i-- {
+			if rcuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rcuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, rcuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*RateCounter)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from RateCounterMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcuo *RateCounterUpdateOne) SaveX(ctx context.Context) *RateCounter {
+	node, err := rcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rcuo *RateCounterUpdateOne) Exec(ctx context.Context) error {
+	_, err := rcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcuo *RateCounterUpdateOne) ExecX(ctx context.Context) {
+	if err := rcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (rcuo *RateCounterUpdateOne) sqlSave(ctx context.Context) (_node *RateCounter, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   ratecounter.Table,
+			Columns: ratecounter.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: ratecounter.FieldID,
+			},
+		},
+	}
+	id, ok := rcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RateCounter.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ratecounter.FieldID)
+		for _, f := range fields {
+			if !ratecounter.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ratecounter.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rcuo.mutation.Count(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ratecounter.FieldCount,
+		})
+	}
+	if value, ok := rcuo.mutation.AddedCount(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ratecounter.FieldCount,
+		})
+	}
+	_node = &RateCounter{config: rcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ratecounter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
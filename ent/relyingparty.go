@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/hesusruiz/vcbackend/ent/relyingparty"
+)
+
+// RelyingParty is the model entity for the RelyingParty schema.
+type RelyingParty struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// RedirectUris holds the value of the "redirect_uris" field.
+	RedirectUris []string `json:"redirect_uris,omitempty"`
+	// CredentialTypes holds the value of the "credential_types" field.
+	CredentialTypes []string `json:"credential_types,omitempty"`
+	// PresentationDefinition holds the value of the "presentation_definition" field.
+	PresentationDefinition map[string]interface{} `json:"presentation_definition,omitempty"`
+	// Scope holds the value of the "scope" field.
+	Scope string `json:"scope,omitempty"`
+	// Audience holds the value of the "audience" field.
+	Audience string `json:"audience,omitempty"`
+	// TokenLifetime holds the value of the "token_lifetime" field.
+	TokenLifetime string `json:"token_lifetime,omitempty"`
+	// SigningAlg holds the value of the "signing_alg" field.
+	SigningAlg string `json:"signing_alg,omitempty"`
+	// Service holds the value of the "service" field.
+	Service string `json:"service,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RelyingParty) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case relyingparty.FieldRedirectUris, relyingparty.FieldCredentialTypes, relyingparty.FieldPresentationDefinition:
+			values[i] = new([]byte)
+		case relyingparty.FieldID, relyingparty.FieldName, relyingparty.FieldScope, relyingparty.FieldAudience, relyingparty.FieldTokenLifetime, relyingparty.FieldSigningAlg, relyingparty.FieldService:
+			values[i] = new(sql.NullString)
+		case relyingparty.FieldCreatedAt, relyingparty.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type RelyingParty", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RelyingParty fields.
+func (rp *RelyingParty) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case relyingparty.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				rp.ID = value.String
+			}
+		case relyingparty.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				rp.Name = value.String
+			}
+		case relyingparty.FieldRedirectUris:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field redirect_uris", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rp.RedirectUris); err != nil {
+					return fmt.Errorf("unmarshal field redirect_uris: %w", err)
+				}
+			}
+		case relyingparty.FieldCredentialTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field credential_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rp.CredentialTypes); err != nil {
+					return fmt.Errorf("unmarshal field credential_types: %w", err)
+				}
+			}
+		case relyingparty.FieldPresentationDefinition:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field presentation_definition", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rp.PresentationDefinition); err != nil {
+					return fmt.Errorf("unmarshal field presentation_definition: %w", err)
+				}
+			}
+		case relyingparty.FieldScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope", values[i])
+			} else if value.Valid {
+				rp.Scope = value.String
+			}
+		case relyingparty.FieldAudience:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field audience", values[i])
+			} else if value.Valid {
+				rp.Audience = value.String
+			}
+		case relyingparty.FieldTokenLifetime:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_lifetime", values[i])
+			} else if value.Valid {
+				rp.TokenLifetime = value.String
+			}
+		case relyingparty.FieldSigningAlg:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signing_alg", values[i])
+			} else if value.Valid {
+				rp.SigningAlg = value.String
+			}
+		case relyingparty.FieldService:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field service", values[i])
+			} else if value.Valid {
+				rp.Service = value.String
+			}
+		case relyingparty.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rp.CreatedAt = value.Time
+			}
+		case relyingparty.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rp.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this RelyingParty.
+// Note that you need to call RelyingParty.Unwrap() before calling this method if this RelyingParty
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rp *RelyingParty) Update() *RelyingPartyUpdateOne {
+	return (&RelyingPartyClient{config: rp.config}).UpdateOne(rp)
+}
+
+// Unwrap unwraps the RelyingParty entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rp *RelyingParty) Unwrap() *RelyingParty {
+	_tx, ok := rp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RelyingParty is not a transactional entity")
+	}
+	rp.config.driver = _tx.drv
+	return rp
+}
+
+// String implements the fmt.Stringer.
+func (rp *RelyingParty) String() string {
+	var builder strings.Builder
+	builder.WriteString("RelyingParty(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rp.ID))
+	builder.WriteString("name=")
+	builder.WriteString(rp.Name)
+	builder.WriteString(", ")
+	builder.WriteString("redirect_uris=")
+	builder.WriteString(fmt.Sprintf("%v", rp.RedirectUris))
+	builder.WriteString(", ")
+	builder.WriteString("credential_types=")
+	builder.WriteString(fmt.Sprintf("%v", rp.CredentialTypes))
+	builder.WriteString(", ")
+	builder.WriteString("presentation_definition=")
+	builder.WriteString(fmt.Sprintf("%v", rp.PresentationDefinition))
+	builder.WriteString(", ")
+	builder.WriteString("scope=")
+	builder.WriteString(rp.Scope)
+	builder.WriteString(", ")
+	builder.WriteString("audience=")
+	builder.WriteString(rp.Audience)
+	builder.WriteString(", ")
+	builder.WriteString("token_lifetime=")
+	builder.WriteString(rp.TokenLifetime)
+	builder.WriteString(", ")
+	builder.WriteString("signing_alg=")
+	builder.WriteString(rp.SigningAlg)
+	builder.WriteString(", ")
+	builder.WriteString("service=")
+	builder.WriteString(rp.Service)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(rp.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rp.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RelyingParties is a parsable slice of RelyingParty.
+type RelyingParties []*RelyingParty
+
+func (rp RelyingParties) config(cfg config) {
+	for _i := range rp {
+		rp[_i].config = cfg
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by ent, DO NOT EDIT.
+
+package relyingparty
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the relyingparty type in the database.
+	Label = "relying_party"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldRedirectUris holds the string denoting the redirect_uris field in the database.
+	FieldRedirectUris = "redirect_uris"
+	// FieldCredentialTypes holds the string denoting the credential_types field in the database.
+	FieldCredentialTypes = "credential_types"
+	// FieldPresentationDefinition holds the string denoting the presentation_definition field in the database.
+	FieldPresentationDefinition = "presentation_definition"
+	// FieldScope holds the string denoting the scope field in the database.
+	FieldScope = "scope"
+	// FieldAudience holds the string denoting the audience field in the database.
+	FieldAudience = "audience"
+	// FieldTokenLifetime holds the string denoting the token_lifetime field in the database.
+	FieldTokenLifetime = "token_lifetime"
+	// FieldSigningAlg holds the string denoting the signing_alg field in the database.
+	FieldSigningAlg = "signing_alg"
+	// FieldService holds the string denoting the service field in the database.
+	FieldService = "service"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the relyingparty in the database.
+	Table = "relying_parties"
+)
+
+// Columns holds all SQL columns for relyingparty fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldRedirectUris,
+	FieldCredentialTypes,
+	FieldPresentationDefinition,
+	FieldScope,
+	FieldAudience,
+	FieldTokenLifetime,
+	FieldSigningAlg,
+	FieldService,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+)
@@ -0,0 +1,1099 @@
+// Code generated by ent, DO NOT EDIT.
+
+package relyingparty
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// Scope applies equality check predicate on the "scope" field. It's identical to ScopeEQ.
+func Scope(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldScope), v))
+	})
+}
+
+// Audience applies equality check predicate on the "audience" field. It's identical to AudienceEQ.
+func Audience(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAudience), v))
+	})
+}
+
+// TokenLifetime applies equality check predicate on the "token_lifetime" field. It's identical to TokenLifetimeEQ.
+func TokenLifetime(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTokenLifetime), v))
+	})
+}
+
+// SigningAlg applies equality check predicate on the "signing_alg" field. It's identical to SigningAlgEQ.
+func SigningAlg(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSigningAlg), v))
+	})
+}
+
+// Service applies equality check predicate on the "service" field. It's identical to ServiceEQ.
+func Service(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldService), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.RelyingParty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.RelyingParty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldName)))
+	})
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldName)))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
+// CredentialTypesIsNil applies the IsNil predicate on the "credential_types" field.
+func CredentialTypesIsNil() predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCredentialTypes)))
+	})
+}
+
+// CredentialTypesNotNil applies the NotNil predicate on the "credential_types" field.
+func CredentialTypesNotNil() predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCredentialTypes)))
+	})
+}
+
+// PresentationDefinitionIsNil applies the IsNil predicate on the "presentation_definition" field.
+func PresentationDefinitionIsNil() predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPresentationDefinition)))
+	})
+}
+
+// PresentationDefinitionNotNil applies the NotNil predicate on the "presentation_definition" field.
+func PresentationDefinitionNotNil() predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPresentationDefinition)))
+	})
+}
+
+// ScopeEQ applies the EQ predicate on the "scope" field.
+func ScopeEQ(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldScope), v))
+	})
+}
+
+// ScopeNEQ applies the NEQ predicate on the "scope" field.
+func ScopeNEQ(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldScope), v))
+	})
+}
+
+// ScopeIn applies the In predicate on the "scope" field.
+func ScopeIn(vs ...string) predicate.RelyingParty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldScope), v...))
+	})
+}
+
+// ScopeNotIn applies the NotIn predicate on the "scope" field.
+func ScopeNotIn(vs ...string) predicate.RelyingParty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldScope), v...))
+	})
+}
+
+// ScopeGT applies the GT predicate on the "scope" field.
+func ScopeGT(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldScope), v))
+	})
+}
+
+// ScopeGTE applies the GTE predicate on the "scope" field.
+func ScopeGTE(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldScope), v))
+	})
+}
+
+// ScopeLT applies the LT predicate on the "scope" field.
+func ScopeLT(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldScope), v))
+	})
+}
+
+// ScopeLTE applies the LTE predicate on the "scope" field.
+func ScopeLTE(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldScope), v))
+	})
+}
+
+// ScopeContains applies the Contains predicate on the "scope" field.
+func ScopeContains(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldScope), v))
+	})
+}
+
+// ScopeHasPrefix applies the HasPrefix predicate on the "scope" field.
+func ScopeHasPrefix(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldScope), v))
+	})
+}
+
+// ScopeHasSuffix applies the HasSuffix predicate on the "scope" field.
+func ScopeHasSuffix(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldScope), v))
+	})
+}
+
+// ScopeIsNil applies the IsNil predicate on the "scope" field.
+func ScopeIsNil() predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldScope)))
+	})
+}
+
+// ScopeNotNil applies the NotNil predicate on the "scope" field.
+func ScopeNotNil() predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldScope)))
+	})
+}
+
+// ScopeEqualFold applies the EqualFold predicate on the "scope" field.
+func ScopeEqualFold(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldScope), v))
+	})
+}
+
+// ScopeContainsFold applies the ContainsFold predicate on the "scope" field.
+func ScopeContainsFold(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldScope), v))
+	})
+}
+
+// AudienceEQ applies the EQ predicate on the "audience" field.
+func AudienceEQ(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAudience), v))
+	})
+}
+
+// AudienceNEQ applies the NEQ predicate on the "audience" field.
+func AudienceNEQ(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAudience), v))
+	})
+}
+
+// AudienceIn applies the In predicate on the "audience" field.
+func AudienceIn(vs ...string) predicate.RelyingParty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAudience), v...))
+	})
+}
+
+// AudienceNotIn applies the NotIn predicate on the "audience" field.
+func AudienceNotIn(vs ...string) predicate.RelyingParty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAudience), v...))
+	})
+}
+
+// AudienceGT applies the GT predicate on the "audience" field.
+func AudienceGT(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAudience), v))
+	})
+}
+
+// AudienceGTE applies the GTE predicate on the "audience" field.
+func AudienceGTE(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAudience), v))
+	})
+}
+
+// AudienceLT applies the LT predicate on the "audience" field.
+func AudienceLT(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAudience), v))
+	})
+}
+
+// AudienceLTE applies the LTE predicate on the "audience" field.
+func AudienceLTE(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAudience), v))
+	})
+}
+
+// AudienceContains applies the Contains predicate on the "audience" field.
+func AudienceContains(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldAudience), v))
+	})
+}
+
+// AudienceHasPrefix applies the HasPrefix predicate on the "audience" field.
+func AudienceHasPrefix(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldAudience), v))
+	})
+}
+
+// AudienceHasSuffix applies the HasSuffix predicate on the "audience" field.
+func AudienceHasSuffix(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAudience), v))
+	})
+}
+
+// AudienceIsNil applies the IsNil predicate on the "audience" field.
+func AudienceIsNil() predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAudience)))
+	})
+}
+
+// AudienceNotNil applies the NotNil predicate on the "audience" field.
+func AudienceNotNil() predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAudience)))
+	})
+}
+
+// AudienceEqualFold applies the EqualFold predicate on the "audience" field.
+func AudienceEqualFold(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAudience), v))
+	})
+}
+
+// AudienceContainsFold applies the ContainsFold predicate on the "audience" field.
+func AudienceContainsFold(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAudience), v))
+	})
+}
+
+// TokenLifetimeEQ applies the EQ predicate on the "token_lifetime" field.
+func TokenLifetimeEQ(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTokenLifetime), v))
+	})
+}
+
+// TokenLifetimeNEQ applies the NEQ predicate on the "token_lifetime" field.
+func TokenLifetimeNEQ(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTokenLifetime), v))
+	})
+}
+
+// TokenLifetimeIn applies the In predicate on the "token_lifetime" field.
+func TokenLifetimeIn(vs ...string) predicate.RelyingParty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTokenLifetime), v...))
+	})
+}
+
+// TokenLifetimeNotIn applies the NotIn predicate on the "token_lifetime" field.
+func TokenLifetimeNotIn(vs ...string) predicate.RelyingParty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTokenLifetime), v...))
+	})
+}
+
+// TokenLifetimeGT applies the GT predicate on the "token_lifetime" field.
+func TokenLifetimeGT(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTokenLifetime), v))
+	})
+}
+
+// TokenLifetimeGTE applies the GTE predicate on the "token_lifetime" field.
+func TokenLifetimeGTE(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTokenLifetime), v))
+	})
+}
+
+// TokenLifetimeLT applies the LT predicate on the "token_lifetime" field.
+func TokenLifetimeLT(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTokenLifetime), v))
+	})
+}
+
+// TokenLifetimeLTE applies the LTE predicate on the "token_lifetime" field.
+func TokenLifetimeLTE(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTokenLifetime), v))
+	})
+}
+
+// TokenLifetimeContains applies the Contains predicate on the "token_lifetime" field.
+func TokenLifetimeContains(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTokenLifetime), v))
+	})
+}
+
+// TokenLifetimeHasPrefix applies the HasPrefix predicate on the "token_lifetime" field.
+func TokenLifetimeHasPrefix(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTokenLifetime), v))
+	})
+}
+
+// TokenLifetimeHasSuffix applies the HasSuffix predicate on the "token_lifetime" field.
+func TokenLifetimeHasSuffix(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTokenLifetime), v))
+	})
+}
+
+// TokenLifetimeIsNil applies the IsNil predicate on the "token_lifetime" field.
+func TokenLifetimeIsNil() predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTokenLifetime)))
+	})
+}
+
+// TokenLifetimeNotNil applies the NotNil predicate on the "token_lifetime" field.
+func TokenLifetimeNotNil() predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTokenLifetime)))
+	})
+}
+
+// TokenLifetimeEqualFold applies the EqualFold predicate on the "token_lifetime" field.
+func TokenLifetimeEqualFold(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTokenLifetime), v))
+	})
+}
+
+// TokenLifetimeContainsFold applies the ContainsFold predicate on the "token_lifetime" field.
+func TokenLifetimeContainsFold(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTokenLifetime), v))
+	})
+}
+
+// SigningAlgEQ applies the EQ predicate on the "signing_alg" field.
+func SigningAlgEQ(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSigningAlg), v))
+	})
+}
+
+// SigningAlgNEQ applies the NEQ predicate on the "signing_alg" field.
+func SigningAlgNEQ(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSigningAlg), v))
+	})
+}
+
+// SigningAlgIn applies the In predicate on the "signing_alg" field.
+func SigningAlgIn(vs ...string) predicate.RelyingParty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSigningAlg), v...))
+	})
+}
+
+// SigningAlgNotIn applies the NotIn predicate on the "signing_alg" field.
+func SigningAlgNotIn(vs ...string) predicate.RelyingParty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSigningAlg), v...))
+	})
+}
+
+// SigningAlgGT applies the GT predicate on the "signing_alg" field.
+func SigningAlgGT(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSigningAlg), v))
+	})
+}
+
+// SigningAlgGTE applies the GTE predicate on the "signing_alg" field.
+func SigningAlgGTE(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSigningAlg), v))
+	})
+}
+
+// SigningAlgLT applies the LT predicate on the "signing_alg" field.
+func SigningAlgLT(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSigningAlg), v))
+	})
+}
+
+// SigningAlgLTE applies the LTE predicate on the "signing_alg" field.
+func SigningAlgLTE(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSigningAlg), v))
+	})
+}
+
+// SigningAlgContains applies the Contains predicate on the "signing_alg" field.
+func SigningAlgContains(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSigningAlg), v))
+	})
+}
+
+// SigningAlgHasPrefix applies the HasPrefix predicate on the "signing_alg" field.
+func SigningAlgHasPrefix(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSigningAlg), v))
+	})
+}
+
+// SigningAlgHasSuffix applies the HasSuffix predicate on the "signing_alg" field.
+func SigningAlgHasSuffix(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSigningAlg), v))
+	})
+}
+
+// SigningAlgIsNil applies the IsNil predicate on the "signing_alg" field.
+func SigningAlgIsNil() predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSigningAlg)))
+	})
+}
+
+// SigningAlgNotNil applies the NotNil predicate on the "signing_alg" field.
+func SigningAlgNotNil() predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSigningAlg)))
+	})
+}
+
+// SigningAlgEqualFold applies the EqualFold predicate on the "signing_alg" field.
+func SigningAlgEqualFold(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSigningAlg), v))
+	})
+}
+
+// SigningAlgContainsFold applies the ContainsFold predicate on the "signing_alg" field.
+func SigningAlgContainsFold(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSigningAlg), v))
+	})
+}
+
+// ServiceEQ applies the EQ predicate on the "service" field.
+func ServiceEQ(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldService), v))
+	})
+}
+
+// ServiceNEQ applies the NEQ predicate on the "service" field.
+func ServiceNEQ(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldService), v))
+	})
+}
+
+// ServiceIn applies the In predicate on the "service" field.
+func ServiceIn(vs ...string) predicate.RelyingParty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldService), v...))
+	})
+}
+
+// ServiceNotIn applies the NotIn predicate on the "service" field.
+func ServiceNotIn(vs ...string) predicate.RelyingParty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldService), v...))
+	})
+}
+
+// ServiceGT applies the GT predicate on the "service" field.
+func ServiceGT(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldService), v))
+	})
+}
+
+// ServiceGTE applies the GTE predicate on the "service" field.
+func ServiceGTE(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldService), v))
+	})
+}
+
+// ServiceLT applies the LT predicate on the "service" field.
+func ServiceLT(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldService), v))
+	})
+}
+
+// ServiceLTE applies the LTE predicate on the "service" field.
+func ServiceLTE(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldService), v))
+	})
+}
+
+// ServiceContains applies the Contains predicate on the "service" field.
+func ServiceContains(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldService), v))
+	})
+}
+
+// ServiceHasPrefix applies the HasPrefix predicate on the "service" field.
+func ServiceHasPrefix(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldService), v))
+	})
+}
+
+// ServiceHasSuffix applies the HasSuffix predicate on the "service" field.
+func ServiceHasSuffix(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldService), v))
+	})
+}
+
+// ServiceIsNil applies the IsNil predicate on the "service" field.
+func ServiceIsNil() predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldService)))
+	})
+}
+
+// ServiceNotNil applies the NotNil predicate on the "service" field.
+func ServiceNotNil() predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldService)))
+	})
+}
+
+// ServiceEqualFold applies the EqualFold predicate on the "service" field.
+func ServiceEqualFold(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldService), v))
+	})
+}
+
+// ServiceContainsFold applies the ContainsFold predicate on the "service" field.
+func ServiceContainsFold(v string) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldService), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RelyingParty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RelyingParty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RelyingParty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RelyingParty {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUpdatedAt), v...))
+	})
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUpdatedAt), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RelyingParty) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RelyingParty) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RelyingParty) predicate.RelyingParty {
+	return predicate.RelyingParty(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,462 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/relyingparty"
+)
+
+// RelyingPartyCreate is the builder for creating a RelyingParty entity.
+type RelyingPartyCreate struct {
+	config
+	mutation *RelyingPartyMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (rpc *RelyingPartyCreate) SetName(s string) *RelyingPartyCreate {
+	rpc.mutation.SetName(s)
+	return rpc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (rpc *RelyingPartyCreate) SetNillableName(s *string) *RelyingPartyCreate {
+	if s != nil {
+		rpc.SetName(*s)
+	}
+	return rpc
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (rpc *RelyingPartyCreate) SetRedirectUris(s []string) *RelyingPartyCreate {
+	rpc.mutation.SetRedirectUris(s)
+	return rpc
+}
+
+// SetCredentialTypes sets the "credential_types" field.
+func (rpc *RelyingPartyCreate) SetCredentialTypes(s []string) *RelyingPartyCreate {
+	rpc.mutation.SetCredentialTypes(s)
+	return rpc
+}
+
+// SetPresentationDefinition sets the "presentation_definition" field.
+func (rpc *RelyingPartyCreate) SetPresentationDefinition(m map[string]interface{}) *RelyingPartyCreate {
+	rpc.mutation.SetPresentationDefinition(m)
+	return rpc
+}
+
+// SetScope sets the "scope" field.
+func (rpc *RelyingPartyCreate) SetScope(s string) *RelyingPartyCreate {
+	rpc.mutation.SetScope(s)
+	return rpc
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (rpc *RelyingPartyCreate) SetNillableScope(s *string) *RelyingPartyCreate {
+	if s != nil {
+		rpc.SetScope(*s)
+	}
+	return rpc
+}
+
+// SetAudience sets the "audience" field.
+func (rpc *RelyingPartyCreate) SetAudience(s string) *RelyingPartyCreate {
+	rpc.mutation.SetAudience(s)
+	return rpc
+}
+
+// SetNillableAudience sets the "audience" field if the given value is not nil.
+func (rpc *RelyingPartyCreate) SetNillableAudience(s *string) *RelyingPartyCreate {
+	if s != nil {
+		rpc.SetAudience(*s)
+	}
+	return rpc
+}
+
+// SetTokenLifetime sets the "token_lifetime" field.
+func (rpc *RelyingPartyCreate) SetTokenLifetime(s string) *RelyingPartyCreate {
+	rpc.mutation.SetTokenLifetime(s)
+	return rpc
+}
+
+// SetNillableTokenLifetime sets the "token_lifetime" field if the given value is not nil.
+func (rpc *RelyingPartyCreate) SetNillableTokenLifetime(s *string) *RelyingPartyCreate {
+	if s != nil {
+		rpc.SetTokenLifetime(*s)
+	}
+	return rpc
+}
+
+// SetSigningAlg sets the "signing_alg" field.
+func (rpc *RelyingPartyCreate) SetSigningAlg(s string) *RelyingPartyCreate {
+	rpc.mutation.SetSigningAlg(s)
+	return rpc
+}
+
+// SetNillableSigningAlg sets the "signing_alg" field if the given value is not nil.
+func (rpc *RelyingPartyCreate) SetNillableSigningAlg(s *string) *RelyingPartyCreate {
+	if s != nil {
+		rpc.SetSigningAlg(*s)
+	}
+	return rpc
+}
+
+// SetService sets the "service" field.
+func (rpc *RelyingPartyCreate) SetService(s string) *RelyingPartyCreate {
+	rpc.mutation.SetService(s)
+	return rpc
+}
+
+// SetNillableService sets the "service" field if the given value is not nil.
+func (rpc *RelyingPartyCreate) SetNillableService(s *string) *RelyingPartyCreate {
+	if s != nil {
+		rpc.SetService(*s)
+	}
+	return rpc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rpc *RelyingPartyCreate) SetCreatedAt(t time.Time) *RelyingPartyCreate {
+	rpc.mutation.SetCreatedAt(t)
+	return rpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rpc *RelyingPartyCreate) SetNillableCreatedAt(t *time.Time) *RelyingPartyCreate {
+	if t != nil {
+		rpc.SetCreatedAt(*t)
+	}
+	return rpc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rpc *RelyingPartyCreate) SetUpdatedAt(t time.Time) *RelyingPartyCreate {
+	rpc.mutation.SetUpdatedAt(t)
+	return rpc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rpc *RelyingPartyCreate) SetNillableUpdatedAt(t *time.Time) *RelyingPartyCreate {
+	if t != nil {
+		rpc.SetUpdatedAt(*t)
+	}
+	return rpc
+}
+
+// SetID sets the "id" field.
+func (rpc *RelyingPartyCreate) SetID(s string) *RelyingPartyCreate {
+	rpc.mutation.SetID(s)
+	return rpc
+}
+
+// Mutation returns the RelyingPartyMutation object of the builder.
+func (rpc *RelyingPartyCreate) Mutation() *RelyingPartyMutation {
+	return rpc.mutation
+}
+
+// Save creates the RelyingParty in the database.
+func (rpc *RelyingPartyCreate) Save(ctx context.Context) (*RelyingParty, error) {
+	var (
+		err  error
+		node *RelyingParty
+	)
+	rpc.defaults()
+	if len(rpc.hooks) == 0 {
+		if err = rpc.check(); err != nil {
+			return nil, err
+		}
+		node, err = rpc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RelyingPartyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rpc.check(); err != nil {
+				return nil, err
+			}
+			rpc.mutation = mutation
+			if node, err = rpc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rpc.hooks) - 1; i >= 0; i-- {
+			if rpc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rpc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, rpc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*RelyingParty)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from RelyingPartyMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rpc *RelyingPartyCreate) SaveX(ctx context.Context) *RelyingParty {
+	v, err := rpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rpc *RelyingPartyCreate) Exec(ctx context.Context) error {
+	_, err := rpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpc *RelyingPartyCreate) ExecX(ctx context.Context) {
+	if err := rpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rpc *RelyingPartyCreate) defaults() {
+	if _, ok := rpc.mutation.CreatedAt(); !ok {
+		v := relyingparty.DefaultCreatedAt()
+		rpc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rpc.mutation.UpdatedAt(); !ok {
+		v := relyingparty.DefaultUpdatedAt()
+		rpc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rpc *RelyingPartyCreate) check() error {
+	if _, ok := rpc.mutation.RedirectUris(); !ok {
+		return &ValidationError{Name: "redirect_uris", err: errors.New(`ent: missing required field "RelyingParty.redirect_uris"`)}
+	}
+	if _, ok := rpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RelyingParty.created_at"`)}
+	}
+	if _, ok := rpc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RelyingParty.updated_at"`)}
+	}
+	return nil
+}
+
+func (rpc *RelyingPartyCreate) sqlSave(ctx context.Context) (*RelyingParty, error) {
+	_node, _spec := rpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected RelyingParty.ID type: %T", _spec.ID.Value)
+		}
+	}
+	return _node, nil
+}
+
+func (rpc *RelyingPartyCreate) createSpec() (*RelyingParty, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RelyingParty{config: rpc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: relyingparty.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: relyingparty.FieldID,
+			},
+		}
+	)
+	if id, ok := rpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rpc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: relyingparty.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := rpc.mutation.RedirectUris(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: relyingparty.FieldRedirectUris,
+		})
+		_node.RedirectUris = value
+	}
+	if value, ok := rpc.mutation.CredentialTypes(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: relyingparty.FieldCredentialTypes,
+		})
+		_node.CredentialTypes = value
+	}
+	if value, ok := rpc.mutation.PresentationDefinition(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: relyingparty.FieldPresentationDefinition,
+		})
+		_node.PresentationDefinition = value
+	}
+	if value, ok := rpc.mutation.Scope(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: relyingparty.FieldScope,
+		})
+		_node.Scope = value
+	}
+	if value, ok := rpc.mutation.Audience(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: relyingparty.FieldAudience,
+		})
+		_node.Audience = value
+	}
+	if value, ok := rpc.mutation.TokenLifetime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: relyingparty.FieldTokenLifetime,
+		})
+		_node.TokenLifetime = value
+	}
+	if value, ok := rpc.mutation.SigningAlg(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: relyingparty.FieldSigningAlg,
+		})
+		_node.SigningAlg = value
+	}
+	if value, ok := rpc.mutation.Service(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: relyingparty.FieldService,
+		})
+		_node.Service = value
+	}
+	if value, ok := rpc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: relyingparty.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := rpc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: relyingparty.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// RelyingPartyCreateBulk is the builder for creating many RelyingParty entities in bulk.
+type RelyingPartyCreateBulk struct {
+	config
+	builders []*RelyingPartyCreate
+}
+
+// Save creates the RelyingParty entities in the database.
+func (rpcb *RelyingPartyCreateBulk) Save(ctx context.Context) ([]*RelyingParty, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(rpcb.builders))
+	nodes := make([]*RelyingParty, len(rpcb.builders))
+	mutators := make([]Mutator, len(rpcb.builders))
+	for i := range rpcb.builders {
+		func(i int, root context.Context) {
+			builder := rpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RelyingPartyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpcb *RelyingPartyCreateBulk) SaveX(ctx context.Context) []*RelyingParty {
+	v, err := rpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rpcb *RelyingPartyCreateBulk) Exec(ctx context.Context) error {
+	_, err := rpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpcb *RelyingPartyCreateBulk) ExecX(ctx context.Context) {
+	if err := rpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+	"github.com/hesusruiz/vcbackend/ent/relyingparty"
+)
+
+// RelyingPartyDelete is the builder for deleting a RelyingParty entity.
+type RelyingPartyDelete struct {
+	config
+	hooks    []Hook
+	mutation *RelyingPartyMutation
+}
+
+// Where appends a list predicates to the RelyingPartyDelete builder.
+func (rpd *RelyingPartyDelete) Where(ps ...predicate.RelyingParty) *RelyingPartyDelete {
+	rpd.mutation.Where(ps...)
+	return rpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rpd *RelyingPartyDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(rpd.hooks) == 0 {
+		affected, err = rpd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RelyingPartyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			rpd.mutation = mutation
+			affected, err = rpd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(rpd.hooks) - 1; i >= 0; i-- {
+			if rpd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rpd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rpd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpd *RelyingPartyDelete) ExecX(ctx context.Context) int {
+	n, err := rpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rpd *RelyingPartyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: relyingparty.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: relyingparty.FieldID,
+			},
+		},
+	}
+	if ps := rpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// RelyingPartyDeleteOne is the builder for deleting a single RelyingParty entity.
+type RelyingPartyDeleteOne struct {
+	rpd *RelyingPartyDelete
+}
+
+// Exec executes the deletion query.
+func (rpdo *RelyingPartyDeleteOne) Exec(ctx context.Context) error {
+	n, err := rpdo.rpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{relyingparty.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpdo *RelyingPartyDeleteOne) ExecX(ctx context.Context) {
+	rpdo.rpd.ExecX(ctx)
+}
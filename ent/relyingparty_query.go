@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+	"github.com/hesusruiz/vcbackend/ent/relyingparty"
+)
+
+// RelyingPartyQuery is the builder for querying RelyingParty entities.
+type RelyingPartyQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.RelyingParty
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RelyingPartyQuery builder.
+func (rpq *RelyingPartyQuery) Where(ps ...predicate.RelyingParty) *RelyingPartyQuery {
+	rpq.predicates = append(rpq.predicates, ps...)
+	return rpq
+}
+
+// Limit adds a limit step to the query.
+func (rpq *RelyingPartyQuery) Limit(limit int) *RelyingPartyQuery {
+	rpq.limit = &limit
+	return rpq
+}
+
+// Offset adds an offset step to the query.
+func (rpq *RelyingPartyQuery) Offset(offset int) *RelyingPartyQuery {
+	rpq.offset = &offset
+	return rpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rpq *RelyingPartyQuery) Unique(unique bool) *RelyingPartyQuery {
+	rpq.unique = &unique
+	return rpq
+}
+
+// Order adds an order step to the query.
+func (rpq *RelyingPartyQuery) Order(o ...OrderFunc) *RelyingPartyQuery {
+	rpq.order = append(rpq.order, o...)
+	return rpq
+}
+
+// First returns the first RelyingParty entity from the query.
+// Returns a *NotFoundError when no RelyingParty was found.
+func (rpq *RelyingPartyQuery) First(ctx context.Context) (*RelyingParty, error) {
+	nodes, err := rpq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{relyingparty.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rpq *RelyingPartyQuery) FirstX(ctx context.Context) *RelyingParty {
+	node, err := rpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RelyingParty ID from the query.
+// Returns a *NotFoundError when no RelyingParty ID was found.
+func (rpq *RelyingPartyQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = rpq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{relyingparty.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rpq *RelyingPartyQuery) FirstIDX(ctx context.Context) string {
+	id, err := rpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RelyingParty entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RelyingParty entity is found.
+// Returns a *NotFoundError when no RelyingParty entities are found.
+func (rpq *RelyingPartyQuery) Only(ctx context.Context) (*RelyingParty, error) {
+	nodes, err := rpq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{relyingparty.Label}
+	default:
+		return nil, &NotSingularError{relyingparty.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rpq *RelyingPartyQuery) OnlyX(ctx context.Context) *RelyingParty {
+	node, err := rpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RelyingParty ID in the query.
+// Returns a *NotSingularError when more than one RelyingParty ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rpq *RelyingPartyQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = rpq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{relyingparty.Label}
+	default:
+		err = &NotSingularError{relyingparty.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rpq *RelyingPartyQuery) OnlyIDX(ctx context.Context) string {
+	id, err := rpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RelyingParties.
+func (rpq *RelyingPartyQuery) All(ctx context.Context) ([]*RelyingParty, error) {
+	if err := rpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return rpq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rpq *RelyingPartyQuery) AllX(ctx context.Context) []*RelyingParty {
+	nodes, err := rpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RelyingParty IDs.
+func (rpq *RelyingPartyQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := rpq.Select(relyingparty.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rpq *RelyingPartyQuery) IDsX(ctx context.Context) []string {
+	ids, err := rpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rpq *RelyingPartyQuery) Count(ctx context.Context) (int, error) {
+	if err := rpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return rpq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rpq *RelyingPartyQuery) CountX(ctx context.Context) int {
+	count, err := rpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rpq *RelyingPartyQuery) Exist(ctx context.Context) (bool, error) {
+	if err := rpq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return rpq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rpq *RelyingPartyQuery) ExistX(ctx context.Context) bool {
+	exist, err := rpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RelyingPartyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rpq *RelyingPartyQuery) Clone() *RelyingPartyQuery {
+	if rpq == nil {
+		return nil
+	}
+	return &RelyingPartyQuery{
+		config:     rpq.config,
+		limit:      rpq.limit,
+		offset:     rpq.offset,
+		order:      append([]OrderFunc{}, rpq.order...),
+		predicates: append([]predicate.RelyingParty{}, rpq.predicates...),
+		// clone intermediate query.
+		sql:    rpq.sql.Clone(),
+		path:   rpq.path,
+		unique: rpq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RelyingParty.Query().
+//		GroupBy(relyingparty.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rpq *RelyingPartyQuery) GroupBy(field string, fields ...string) *RelyingPartyGroupBy {
+	grbuild := &RelyingPartyGroupBy{config: rpq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := rpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return rpq.sqlQuery(ctx), nil
+	}
+	grbuild.label = relyingparty.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.RelyingParty.Query().
+//		Select(relyingparty.FieldName).
+//		Scan(ctx, &v)
+func (rpq *RelyingPartyQuery) Select(fields ...string) *RelyingPartySelect {
+	rpq.fields = append(rpq.fields, fields...)
+	selbuild := &RelyingPartySelect{RelyingPartyQuery: rpq}
+	selbuild.label = relyingparty.Label
+	selbuild.flds, selbuild.scan = &rpq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (rpq *RelyingPartyQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range rpq.fields {
+		if !relyingparty.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rpq.path != nil {
+		prev, err := rpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rpq.sql = prev
+	}
+	return nil
+}
+
+func (rpq *RelyingPartyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RelyingParty, error) {
+	var (
+		nodes = []*RelyingParty{}
+		_spec = rpq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*RelyingParty).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &RelyingParty{config: rpq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (rpq *RelyingPartyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rpq.querySpec()
+	_spec.Node.Columns = rpq.fields
+	if len(rpq.fields) > 0 {
+		_spec.Unique = rpq.unique != nil && *rpq.unique
+	}
+	return sqlgraph.CountNodes(ctx, rpq.driver, _spec)
+}
+
+func (rpq *RelyingPartyQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := rpq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (rpq *RelyingPartyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   relyingparty.Table,
+			Columns: relyingparty.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: relyingparty.FieldID,
+			},
+		},
+		From:   rpq.sql,
+		Unique: true,
+	}
+	if unique := rpq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := rpq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, relyingparty.FieldID)
+		for i := range fields {
+			if fields[i] != relyingparty.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rpq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rpq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rpq *RelyingPartyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rpq.driver.Dialect())
+	t1 := builder.Table(relyingparty.Table)
+	columns := rpq.fields
+	if len(columns) == 0 {
+		columns = relyingparty.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rpq.sql != nil {
+		selector = rpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rpq.unique != nil && *rpq.unique {
+		selector.Distinct()
+	}
+	for _, p := range rpq.predicates {
+		p(selector)
+	}
+	for _, p := range rpq.order {
+		p(selector)
+	}
+	if offset := rpq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rpq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RelyingPartyGroupBy is the group-by builder for RelyingParty entities.
+type RelyingPartyGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rpgb *RelyingPartyGroupBy) Aggregate(fns ...AggregateFunc) *RelyingPartyGroupBy {
+	rpgb.fns = append(rpgb.fns, fns...)
+	return rpgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (rpgb *RelyingPartyGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := rpgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	rpgb.sql = query
+	return rpgb.sqlScan(ctx, v)
+}
+
+func (rpgb *RelyingPartyGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range rpgb.fields {
+		if !relyingparty.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := rpgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rpgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (rpgb *RelyingPartyGroupBy) sqlQuery() *sql.Selector {
+	selector := rpgb.sql.Select()
+	aggregation := make([]string, 0, len(rpgb.fns))
+	for _, fn := range rpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(rpgb.fields)+len(rpgb.fns))
+		for _, f := range rpgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(rpgb.fields...)...)
+}
+
+// RelyingPartySelect is the builder for selecting fields of RelyingParty entities.
+type RelyingPartySelect struct {
+	*RelyingPartyQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rps *RelyingPartySelect) Scan(ctx context.Context, v interface{}) error {
+	if err := rps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	rps.sql = rps.RelyingPartyQuery.sqlQuery(ctx)
+	return rps.sqlScan(ctx, v)
+}
+
+func (rps *RelyingPartySelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := rps.sql.Query()
+	if err := rps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,810 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+	"github.com/hesusruiz/vcbackend/ent/relyingparty"
+)
+
+// RelyingPartyUpdate is the builder for updating RelyingParty entities.
+type RelyingPartyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RelyingPartyMutation
+}
+
+// Where appends a list predicates to the RelyingPartyUpdate builder.
+func (rpu *RelyingPartyUpdate) Where(ps ...predicate.RelyingParty) *RelyingPartyUpdate {
+	rpu.mutation.Where(ps...)
+	return rpu
+}
+
+// SetName sets the "name" field.
+func (rpu *RelyingPartyUpdate) SetName(s string) *RelyingPartyUpdate {
+	rpu.mutation.SetName(s)
+	return rpu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (rpu *RelyingPartyUpdate) SetNillableName(s *string) *RelyingPartyUpdate {
+	if s != nil {
+		rpu.SetName(*s)
+	}
+	return rpu
+}
+
+// ClearName clears the value of the "name" field.
+func (rpu *RelyingPartyUpdate) ClearName() *RelyingPartyUpdate {
+	rpu.mutation.ClearName()
+	return rpu
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (rpu *RelyingPartyUpdate) SetRedirectUris(s []string) *RelyingPartyUpdate {
+	rpu.mutation.SetRedirectUris(s)
+	return rpu
+}
+
+// SetCredentialTypes sets the "credential_types" field.
+func (rpu *RelyingPartyUpdate) SetCredentialTypes(s []string) *RelyingPartyUpdate {
+	rpu.mutation.SetCredentialTypes(s)
+	return rpu
+}
+
+// ClearCredentialTypes clears the value of the "credential_types" field.
+func (rpu *RelyingPartyUpdate) ClearCredentialTypes() *RelyingPartyUpdate {
+	rpu.mutation.ClearCredentialTypes()
+	return rpu
+}
+
+// SetPresentationDefinition sets the "presentation_definition" field.
+func (rpu *RelyingPartyUpdate) SetPresentationDefinition(m map[string]interface{}) *RelyingPartyUpdate {
+	rpu.mutation.SetPresentationDefinition(m)
+	return rpu
+}
+
+// ClearPresentationDefinition clears the value of the "presentation_definition" field.
+func (rpu *RelyingPartyUpdate) ClearPresentationDefinition() *RelyingPartyUpdate {
+	rpu.mutation.ClearPresentationDefinition()
+	return rpu
+}
+
+// SetScope sets the "scope" field.
+func (rpu *RelyingPartyUpdate) SetScope(s string) *RelyingPartyUpdate {
+	rpu.mutation.SetScope(s)
+	return rpu
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (rpu *RelyingPartyUpdate) SetNillableScope(s *string) *RelyingPartyUpdate {
+	if s != nil {
+		rpu.SetScope(*s)
+	}
+	return rpu
+}
+
+// ClearScope clears the value of the "scope" field.
+func (rpu *RelyingPartyUpdate) ClearScope() *RelyingPartyUpdate {
+	rpu.mutation.ClearScope()
+	return rpu
+}
+
+// SetAudience sets the "audience" field.
+func (rpu *RelyingPartyUpdate) SetAudience(s string) *RelyingPartyUpdate {
+	rpu.mutation.SetAudience(s)
+	return rpu
+}
+
+// SetNillableAudience sets the "audience" field if the given value is not nil.
+func (rpu *RelyingPartyUpdate) SetNillableAudience(s *string) *RelyingPartyUpdate {
+	if s != nil {
+		rpu.SetAudience(*s)
+	}
+	return rpu
+}
+
+// ClearAudience clears the value of the "audience" field.
+func (rpu *RelyingPartyUpdate) ClearAudience() *RelyingPartyUpdate {
+	rpu.mutation.ClearAudience()
+	return rpu
+}
+
+// SetTokenLifetime sets the "token_lifetime" field.
+func (rpu *RelyingPartyUpdate) SetTokenLifetime(s string) *RelyingPartyUpdate {
+	rpu.mutation.SetTokenLifetime(s)
+	return rpu
+}
+
+// SetNillableTokenLifetime sets the "token_lifetime" field if the given value is not nil.
+func (rpu *RelyingPartyUpdate) SetNillableTokenLifetime(s *string) *RelyingPartyUpdate {
+	if s != nil {
+		rpu.SetTokenLifetime(*s)
+	}
+	return rpu
+}
+
+// ClearTokenLifetime clears the value of the "token_lifetime" field.
+func (rpu *RelyingPartyUpdate) ClearTokenLifetime() *RelyingPartyUpdate {
+	rpu.mutation.ClearTokenLifetime()
+	return rpu
+}
+
+// SetSigningAlg sets the "signing_alg" field.
+func (rpu *RelyingPartyUpdate) SetSigningAlg(s string) *RelyingPartyUpdate {
+	rpu.mutation.SetSigningAlg(s)
+	return rpu
+}
+
+// SetNillableSigningAlg sets the "signing_alg" field if the given value is not nil.
+func (rpu *RelyingPartyUpdate) SetNillableSigningAlg(s *string) *RelyingPartyUpdate {
+	if s != nil {
+		rpu.SetSigningAlg(*s)
+	}
+	return rpu
+}
+
+// ClearSigningAlg clears the value of the "signing_alg" field.
+func (rpu *RelyingPartyUpdate) ClearSigningAlg() *RelyingPartyUpdate {
+	rpu.mutation.ClearSigningAlg()
+	return rpu
+}
+
+// SetService sets the "service" field.
+func (rpu *RelyingPartyUpdate) SetService(s string) *RelyingPartyUpdate {
+	rpu.mutation.SetService(s)
+	return rpu
+}
+
+// SetNillableService sets the "service" field if the given value is not nil.
+func (rpu *RelyingPartyUpdate) SetNillableService(s *string) *RelyingPartyUpdate {
+	if s != nil {
+		rpu.SetService(*s)
+	}
+	return rpu
+}
+
+// ClearService clears the value of the "service" field.
+func (rpu *RelyingPartyUpdate) ClearService() *RelyingPartyUpdate {
+	rpu.mutation.ClearService()
+	return rpu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rpu *RelyingPartyUpdate) SetUpdatedAt(t time.Time) *RelyingPartyUpdate {
+	rpu.mutation.SetUpdatedAt(t)
+	return rpu
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rpu *RelyingPartyUpdate) SetNillableUpdatedAt(t *time.Time) *RelyingPartyUpdate {
+	if t != nil {
+		rpu.SetUpdatedAt(*t)
+	}
+	return rpu
+}
+
+// Mutation returns the RelyingPartyMutation object of the builder.
+func (rpu *RelyingPartyUpdate) Mutation() *RelyingPartyMutation {
+	return rpu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rpu *RelyingPartyUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(rpu.hooks) == 0 {
+		affected, err = rpu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RelyingPartyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			rpu.mutation = mutation
+			affected, err = rpu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(rpu.hooks) - 1; i >= 0; i-- {
+			if rpu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rpu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rpu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpu *RelyingPartyUpdate) SaveX(ctx context.Context) int {
+	affected, err := rpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rpu *RelyingPartyUpdate) Exec(ctx context.Context) error {
+	_, err := rpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpu *RelyingPartyUpdate) ExecX(ctx context.Context) {
+	if err := rpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (rpu *RelyingPartyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   relyingparty.Table,
+			Columns: relyingparty.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: relyingparty.FieldID,
+			},
+		},
+	}
+	if ps := rpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rpu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: relyingparty.FieldName,
+		})
+	}
+	if rpu.mutation.NameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: relyingparty.FieldName,
+		})
+	}
+	if value, ok := rpu.mutation.RedirectUris(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: relyingparty.FieldRedirectUris,
+		})
+	}
+	if value, ok := rpu.mutation.CredentialTypes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: relyingparty.FieldCredentialTypes,
+		})
+	}
+	if rpu.mutation.CredentialTypesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: relyingparty.FieldCredentialTypes,
+		})
+	}
+	if value, ok := rpu.mutation.PresentationDefinition(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: relyingparty.FieldPresentationDefinition,
+		})
+	}
+	if rpu.mutation.PresentationDefinitionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: relyingparty.FieldPresentationDefinition,
+		})
+	}
+	if value, ok := rpu.mutation.Scope(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: relyingparty.FieldScope,
+		})
+	}
+	if rpu.mutation.ScopeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: relyingparty.FieldScope,
+		})
+	}
+	if value, ok := rpu.mutation.Audience(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: relyingparty.FieldAudience,
+		})
+	}
+	if rpu.mutation.AudienceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: relyingparty.FieldAudience,
+		})
+	}
+	if value, ok := rpu.mutation.TokenLifetime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: relyingparty.FieldTokenLifetime,
+		})
+	}
+	if rpu.mutation.TokenLifetimeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: relyingparty.FieldTokenLifetime,
+		})
+	}
+	if value, ok := rpu.mutation.SigningAlg(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: relyingparty.FieldSigningAlg,
+		})
+	}
+	if rpu.mutation.SigningAlgCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: relyingparty.FieldSigningAlg,
+		})
+	}
+	if value, ok := rpu.mutation.Service(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: relyingparty.FieldService,
+		})
+	}
+	if rpu.mutation.ServiceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: relyingparty.FieldService,
+		})
+	}
+	if value, ok := rpu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: relyingparty.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{relyingparty.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// RelyingPartyUpdateOne is the builder for updating a single RelyingParty entity.
+type RelyingPartyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RelyingPartyMutation
+}
+
+// SetName sets the "name" field.
+func (rpuo *RelyingPartyUpdateOne) SetName(s string) *RelyingPartyUpdateOne {
+	rpuo.mutation.SetName(s)
+	return rpuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (rpuo *RelyingPartyUpdateOne) SetNillableName(s *string) *RelyingPartyUpdateOne {
+	if s != nil {
+		rpuo.SetName(*s)
+	}
+	return rpuo
+}
+
+// ClearName clears the value of the "name" field.
+func (rpuo *RelyingPartyUpdateOne) ClearName() *RelyingPartyUpdateOne {
+	rpuo.mutation.ClearName()
+	return rpuo
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (rpuo *RelyingPartyUpdateOne) SetRedirectUris(s []string) *RelyingPartyUpdateOne {
+	rpuo.mutation.SetRedirectUris(s)
+	return rpuo
+}
+
+// SetCredentialTypes sets the "credential_types" field.
+func (rpuo *RelyingPartyUpdateOne) SetCredentialTypes(s []string) *RelyingPartyUpdateOne {
+	rpuo.mutation.SetCredentialTypes(s)
+	return rpuo
+}
+
+// ClearCredentialTypes clears the value of the "credential_types" field.
+func (rpuo *RelyingPartyUpdateOne) ClearCredentialTypes() *RelyingPartyUpdateOne {
+	rpuo.mutation.ClearCredentialTypes()
+	return rpuo
+}
+
+// SetPresentationDefinition sets the "presentation_definition" field.
+func (rpuo *RelyingPartyUpdateOne) SetPresentationDefinition(m map[string]interface{}) *RelyingPartyUpdateOne {
+	rpuo.mutation.SetPresentationDefinition(m)
+	return rpuo
+}
+
+// ClearPresentationDefinition clears the value of the "presentation_definition" field.
+func (rpuo *RelyingPartyUpdateOne) ClearPresentationDefinition() *RelyingPartyUpdateOne {
+	rpuo.mutation.ClearPresentationDefinition()
+	return rpuo
+}
+
+// SetScope sets the "scope" field.
+func (rpuo *RelyingPartyUpdateOne) SetScope(s string) *RelyingPartyUpdateOne {
+	rpuo.mutation.SetScope(s)
+	return rpuo
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (rpuo *RelyingPartyUpdateOne) SetNillableScope(s *string) *RelyingPartyUpdateOne {
+	if s != nil {
+		rpuo.SetScope(*s)
+	}
+	return rpuo
+}
+
+// ClearScope clears the value of the "scope" field.
+func (rpuo *RelyingPartyUpdateOne) ClearScope() *RelyingPartyUpdateOne {
+	rpuo.mutation.ClearScope()
+	return rpuo
+}
+
+// SetAudience sets the "audience" field.
+func (rpuo *RelyingPartyUpdateOne) SetAudience(s string) *RelyingPartyUpdateOne {
+	rpuo.mutation.SetAudience(s)
+	return rpuo
+}
+
+// SetNillableAudience sets the "audience" field if the given value is not nil.
+func (rpuo *RelyingPartyUpdateOne) SetNillableAudience(s *string) *RelyingPartyUpdateOne {
+	if s != nil {
+		rpuo.SetAudience(*s)
+	}
+	return rpuo
+}
+
+// ClearAudience clears the value of the "audience" field.
+func (rpuo *RelyingPartyUpdateOne) ClearAudience() *RelyingPartyUpdateOne {
+	rpuo.mutation.ClearAudience()
+	return rpuo
+}
+
+// SetTokenLifetime sets the "token_lifetime" field.
+func (rpuo *RelyingPartyUpdateOne) SetTokenLifetime(s string) *RelyingPartyUpdateOne {
+	rpuo.mutation.SetTokenLifetime(s)
+	return rpuo
+}
+
+// SetNillableTokenLifetime sets the "token_lifetime" field if the given value is not nil.
+func (rpuo *RelyingPartyUpdateOne) SetNillableTokenLifetime(s *string) *RelyingPartyUpdateOne {
+	if s != nil {
+		rpuo.SetTokenLifetime(*s)
+	}
+	return rpuo
+}
+
+// ClearTokenLifetime clears the value of the "token_lifetime" field.
+func (rpuo *RelyingPartyUpdateOne) ClearTokenLifetime() *RelyingPartyUpdateOne {
+	rpuo.mutation.ClearTokenLifetime()
+	return rpuo
+}
+
+// SetSigningAlg sets the "signing_alg" field.
+func (rpuo *RelyingPartyUpdateOne) SetSigningAlg(s string) *RelyingPartyUpdateOne {
+	rpuo.mutation.SetSigningAlg(s)
+	return rpuo
+}
+
+// SetNillableSigningAlg sets the "signing_alg" field if the given value is not nil.
+func (rpuo *RelyingPartyUpdateOne) SetNillableSigningAlg(s *string) *RelyingPartyUpdateOne {
+	if s != nil {
+		rpuo.SetSigningAlg(*s)
+	}
+	return rpuo
+}
+
+// ClearSigningAlg clears the value of the "signing_alg" field.
+func (rpuo *RelyingPartyUpdateOne) ClearSigningAlg() *RelyingPartyUpdateOne {
+	rpuo.mutation.ClearSigningAlg()
+	return rpuo
+}
+
+// SetService sets the "service" field.
+func (rpuo *RelyingPartyUpdateOne) SetService(s string) *RelyingPartyUpdateOne {
+	rpuo.mutation.SetService(s)
+	return rpuo
+}
+
+// SetNillableService sets the "service" field if the given value is not nil.
+func (rpuo *RelyingPartyUpdateOne) SetNillableService(s *string) *RelyingPartyUpdateOne {
+	if s != nil {
+		rpuo.SetService(*s)
+	}
+	return rpuo
+}
+
+// ClearService clears the value of the "service" field.
+func (rpuo *RelyingPartyUpdateOne) ClearService() *RelyingPartyUpdateOne {
+	rpuo.mutation.ClearService()
+	return rpuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rpuo *RelyingPartyUpdateOne) SetUpdatedAt(t time.Time) *RelyingPartyUpdateOne {
+	rpuo.mutation.SetUpdatedAt(t)
+	return rpuo
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rpuo *RelyingPartyUpdateOne) SetNillableUpdatedAt(t *time.Time) *RelyingPartyUpdateOne {
+	if t != nil {
+		rpuo.SetUpdatedAt(*t)
+	}
+	return rpuo
+}
+
+// Mutation returns the RelyingPartyMutation object of the builder.
+func (rpuo *RelyingPartyUpdateOne) Mutation() *RelyingPartyMutation {
+	return rpuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rpuo *RelyingPartyUpdateOne) Select(field string, fields ...string) *RelyingPartyUpdateOne {
+	rpuo.fields = append([]string{field}, fields...)
+	return rpuo
+}
+
+// Save executes the query and returns the updated RelyingParty entity.
+func (rpuo *RelyingPartyUpdateOne) Save(ctx context.Context) (*RelyingParty, error) {
+	var (
+		err  error
+		node *RelyingParty
+	)
+	if len(rpuo.hooks) == 0 {
+		node, err = rpuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RelyingPartyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			rpuo.mutation = mutation
+			node, err = rpuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rpuo.hooks) - 1; i >= 0; i-- {
+			if rpuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rpuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, rpuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*RelyingParty)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from RelyingPartyMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rpuo *RelyingPartyUpdateOne) SaveX(ctx context.Context) *RelyingParty {
+	node, err := rpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rpuo *RelyingPartyUpdateOne) Exec(ctx context.Context) error {
+	_, err := rpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rpuo *RelyingPartyUpdateOne) ExecX(ctx context.Context) {
+	if err := rpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (rpuo *RelyingPartyUpdateOne) sqlSave(ctx context.Context) (_node *RelyingParty, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   relyingparty.Table,
+			Columns: relyingparty.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: relyingparty.FieldID,
+			},
+		},
+	}
+	id, ok := rpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RelyingParty.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, relyingparty.FieldID)
+		for _, f := range fields {
+			if !relyingparty.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != relyingparty.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rpuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: relyingparty.FieldName,
+		})
+	}
+	if rpuo.mutation.NameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: relyingparty.FieldName,
+		})
+	}
+	if value, ok := rpuo.mutation.RedirectUris(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: relyingparty.FieldRedirectUris,
+		})
+	}
+	if value, ok := rpuo.mutation.CredentialTypes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: relyingparty.FieldCredentialTypes,
+		})
+	}
+	if rpuo.mutation.CredentialTypesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: relyingparty.FieldCredentialTypes,
+		})
+	}
+	if value, ok := rpuo.mutation.PresentationDefinition(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: relyingparty.FieldPresentationDefinition,
+		})
+	}
+	if rpuo.mutation.PresentationDefinitionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: relyingparty.FieldPresentationDefinition,
+		})
+	}
+	if value, ok := rpuo.mutation.Scope(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: relyingparty.FieldScope,
+		})
+	}
+	if rpuo.mutation.ScopeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: relyingparty.FieldScope,
+		})
+	}
+	if value, ok := rpuo.mutation.Audience(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: relyingparty.FieldAudience,
+		})
+	}
+	if rpuo.mutation.AudienceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: relyingparty.FieldAudience,
+		})
+	}
+	if value, ok := rpuo.mutation.TokenLifetime(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: relyingparty.FieldTokenLifetime,
+		})
+	}
+	if rpuo.mutation.TokenLifetimeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: relyingparty.FieldTokenLifetime,
+		})
+	}
+	if value, ok := rpuo.mutation.SigningAlg(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: relyingparty.FieldSigningAlg,
+		})
+	}
+	if rpuo.mutation.SigningAlgCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: relyingparty.FieldSigningAlg,
+		})
+	}
+	if value, ok := rpuo.mutation.Service(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: relyingparty.FieldService,
+		})
+	}
+	if rpuo.mutation.ServiceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: relyingparty.FieldService,
+		})
+	}
+	if value, ok := rpuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: relyingparty.FieldUpdatedAt,
+		})
+	}
+	_node = &RelyingParty{config: rpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{relyingparty.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
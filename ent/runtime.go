@@ -5,12 +5,22 @@ package ent
 import (
 	"time"
 
+	"github.com/hesusruiz/vcbackend/ent/auditentry"
+	"github.com/hesusruiz/vcbackend/ent/clientcertificate"
 	"github.com/hesusruiz/vcbackend/ent/credential"
+	"github.com/hesusruiz/vcbackend/ent/credentialtemplate"
 	"github.com/hesusruiz/vcbackend/ent/did"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequest"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequestevent"
+	"github.com/hesusruiz/vcbackend/ent/loginsession"
 	"github.com/hesusruiz/vcbackend/ent/naturalperson"
+	"github.com/hesusruiz/vcbackend/ent/presentationnonce"
 	"github.com/hesusruiz/vcbackend/ent/privatekey"
 	"github.com/hesusruiz/vcbackend/ent/publickey"
+	"github.com/hesusruiz/vcbackend/ent/ratecounter"
+	"github.com/hesusruiz/vcbackend/ent/relyingparty"
 	"github.com/hesusruiz/vcbackend/ent/schema"
+	"github.com/hesusruiz/vcbackend/ent/trustedissuer"
 	"github.com/hesusruiz/vcbackend/ent/user"
 )
 
@@ -18,6 +28,22 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	auditentryFields := schema.AuditEntry{}.Fields()
+	_ = auditentryFields
+	// auditentryDescAction is the schema descriptor for action field.
+	auditentryDescAction := auditentryFields[3].Descriptor()
+	// auditentry.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditentry.ActionValidator = auditentryDescAction.Validators[0].(func(string) error)
+	// auditentryDescID is the schema descriptor for id field.
+	auditentryDescID := auditentryFields[0].Descriptor()
+	// auditentry.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	auditentry.IDValidator = auditentryDescID.Validators[0].(func(int) error)
+	clientcertificateFields := schema.ClientCertificate{}.Fields()
+	_ = clientcertificateFields
+	// clientcertificateDescCreatedAt is the schema descriptor for created_at field.
+	clientcertificateDescCreatedAt := clientcertificateFields[4].Descriptor()
+	// clientcertificate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	clientcertificate.DefaultCreatedAt = clientcertificateDescCreatedAt.Default.(func() time.Time)
 	credentialFields := schema.Credential{}.Fields()
 	_ = credentialFields
 	// credentialDescType is the schema descriptor for type field.
@@ -25,13 +51,39 @@ func init() {
 	// credential.DefaultType holds the default value on creation for the type field.
 	credential.DefaultType = credentialDescType.Default.(string)
 	// credentialDescCreatedAt is the schema descriptor for created_at field.
-	credentialDescCreatedAt := credentialFields[3].Descriptor()
+	credentialDescCreatedAt := credentialFields[17].Descriptor()
 	// credential.DefaultCreatedAt holds the default value on creation for the created_at field.
 	credential.DefaultCreatedAt = credentialDescCreatedAt.Default.(func() time.Time)
 	// credentialDescUpdatedAt is the schema descriptor for updated_at field.
-	credentialDescUpdatedAt := credentialFields[4].Descriptor()
+	credentialDescUpdatedAt := credentialFields[18].Descriptor()
 	// credential.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	credential.DefaultUpdatedAt = credentialDescUpdatedAt.Default.(func() time.Time)
+	credentialtemplateFields := schema.CredentialTemplate{}.Fields()
+	_ = credentialtemplateFields
+	// credentialtemplateDescName is the schema descriptor for name field.
+	credentialtemplateDescName := credentialtemplateFields[1].Descriptor()
+	// credentialtemplate.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	credentialtemplate.NameValidator = credentialtemplateDescName.Validators[0].(func(string) error)
+	// credentialtemplateDescVersion is the schema descriptor for version field.
+	credentialtemplateDescVersion := credentialtemplateFields[2].Descriptor()
+	// credentialtemplate.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	credentialtemplate.VersionValidator = credentialtemplateDescVersion.Validators[0].(func(int) error)
+	// credentialtemplateDescContent is the schema descriptor for content field.
+	credentialtemplateDescContent := credentialtemplateFields[5].Descriptor()
+	// credentialtemplate.ContentValidator is a validator for the "content" field. It is called by the builders before save.
+	credentialtemplate.ContentValidator = credentialtemplateDescContent.Validators[0].(func(string) error)
+	// credentialtemplateDescBuiltin is the schema descriptor for builtin field.
+	credentialtemplateDescBuiltin := credentialtemplateFields[7].Descriptor()
+	// credentialtemplate.DefaultBuiltin holds the default value on creation for the builtin field.
+	credentialtemplate.DefaultBuiltin = credentialtemplateDescBuiltin.Default.(bool)
+	// credentialtemplateDescCreatedAt is the schema descriptor for created_at field.
+	credentialtemplateDescCreatedAt := credentialtemplateFields[9].Descriptor()
+	// credentialtemplate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	credentialtemplate.DefaultCreatedAt = credentialtemplateDescCreatedAt.Default.(func() time.Time)
+	// credentialtemplateDescUpdatedAt is the schema descriptor for updated_at field.
+	credentialtemplateDescUpdatedAt := credentialtemplateFields[10].Descriptor()
+	// credentialtemplate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	credentialtemplate.DefaultUpdatedAt = credentialtemplateDescUpdatedAt.Default.(func() time.Time)
 	didFields := schema.DID{}.Fields()
 	_ = didFields
 	// didDescCreatedAt is the schema descriptor for created_at field.
@@ -42,6 +94,40 @@ func init() {
 	didDescUpdatedAt := didFields[3].Descriptor()
 	// did.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	did.DefaultUpdatedAt = didDescUpdatedAt.Default.(func() time.Time)
+	issuancerequestFields := schema.IssuanceRequest{}.Fields()
+	_ = issuancerequestFields
+	// issuancerequestDescCredentialType is the schema descriptor for credential_type field.
+	issuancerequestDescCredentialType := issuancerequestFields[1].Descriptor()
+	// issuancerequest.CredentialTypeValidator is a validator for the "credential_type" field. It is called by the builders before save.
+	issuancerequest.CredentialTypeValidator = issuancerequestDescCredentialType.Validators[0].(func(string) error)
+	// issuancerequestDescRevokePredecessor is the schema descriptor for revoke_predecessor field.
+	issuancerequestDescRevokePredecessor := issuancerequestFields[5].Descriptor()
+	// issuancerequest.DefaultRevokePredecessor holds the default value on creation for the revoke_predecessor field.
+	issuancerequest.DefaultRevokePredecessor = issuancerequestDescRevokePredecessor.Default.(bool)
+	// issuancerequestDescRequestedBy is the schema descriptor for requested_by field.
+	issuancerequestDescRequestedBy := issuancerequestFields[7].Descriptor()
+	// issuancerequest.RequestedByValidator is a validator for the "requested_by" field. It is called by the builders before save.
+	issuancerequest.RequestedByValidator = issuancerequestDescRequestedBy.Validators[0].(func(string) error)
+	// issuancerequestDescCreatedAt is the schema descriptor for created_at field.
+	issuancerequestDescCreatedAt := issuancerequestFields[10].Descriptor()
+	// issuancerequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	issuancerequest.DefaultCreatedAt = issuancerequestDescCreatedAt.Default.(func() time.Time)
+	// issuancerequestDescUpdatedAt is the schema descriptor for updated_at field.
+	issuancerequestDescUpdatedAt := issuancerequestFields[11].Descriptor()
+	// issuancerequest.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	issuancerequest.DefaultUpdatedAt = issuancerequestDescUpdatedAt.Default.(func() time.Time)
+	issuancerequesteventFields := schema.IssuanceRequestEvent{}.Fields()
+	_ = issuancerequesteventFields
+	// issuancerequesteventDescCreatedAt is the schema descriptor for created_at field.
+	issuancerequesteventDescCreatedAt := issuancerequesteventFields[4].Descriptor()
+	// issuancerequestevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	issuancerequestevent.DefaultCreatedAt = issuancerequesteventDescCreatedAt.Default.(func() time.Time)
+	loginsessionFields := schema.LoginSession{}.Fields()
+	_ = loginsessionFields
+	// loginsessionDescCreatedAt is the schema descriptor for created_at field.
+	loginsessionDescCreatedAt := loginsessionFields[4].Descriptor()
+	// loginsession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loginsession.DefaultCreatedAt = loginsessionDescCreatedAt.Default.(func() time.Time)
 	naturalpersonFields := schema.NaturalPerson{}.Fields()
 	_ = naturalpersonFields
 	// naturalpersonDescName is the schema descriptor for name field.
@@ -68,6 +154,12 @@ func init() {
 	naturalpersonDescID := naturalpersonFields[0].Descriptor()
 	// naturalperson.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	naturalperson.IDValidator = naturalpersonDescID.Validators[0].(func(string) error)
+	presentationnonceFields := schema.PresentationNonce{}.Fields()
+	_ = presentationnonceFields
+	// presentationnonceDescCreatedAt is the schema descriptor for created_at field.
+	presentationnonceDescCreatedAt := presentationnonceFields[4].Descriptor()
+	// presentationnonce.DefaultCreatedAt holds the default value on creation for the created_at field.
+	presentationnonce.DefaultCreatedAt = presentationnonceDescCreatedAt.Default.(func() time.Time)
 	privatekeyFields := schema.PrivateKey{}.Fields()
 	_ = privatekeyFields
 	// privatekeyDescCreatedAt is the schema descriptor for created_at field.
@@ -88,6 +180,32 @@ func init() {
 	publickeyDescUpdatedAt := publickeyFields[5].Descriptor()
 	// publickey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	publickey.DefaultUpdatedAt = publickeyDescUpdatedAt.Default.(func() time.Time)
+	ratecounterFields := schema.RateCounter{}.Fields()
+	_ = ratecounterFields
+	// ratecounterDescCount is the schema descriptor for count field.
+	ratecounterDescCount := ratecounterFields[1].Descriptor()
+	// ratecounter.DefaultCount holds the default value on creation for the count field.
+	ratecounter.DefaultCount = ratecounterDescCount.Default.(int)
+	relyingpartyFields := schema.RelyingParty{}.Fields()
+	_ = relyingpartyFields
+	// relyingpartyDescCreatedAt is the schema descriptor for created_at field.
+	relyingpartyDescCreatedAt := relyingpartyFields[10].Descriptor()
+	// relyingparty.DefaultCreatedAt holds the default value on creation for the created_at field.
+	relyingparty.DefaultCreatedAt = relyingpartyDescCreatedAt.Default.(func() time.Time)
+	// relyingpartyDescUpdatedAt is the schema descriptor for updated_at field.
+	relyingpartyDescUpdatedAt := relyingpartyFields[11].Descriptor()
+	// relyingparty.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	relyingparty.DefaultUpdatedAt = relyingpartyDescUpdatedAt.Default.(func() time.Time)
+	trustedissuerFields := schema.TrustedIssuer{}.Fields()
+	_ = trustedissuerFields
+	// trustedissuerDescCreatedAt is the schema descriptor for created_at field.
+	trustedissuerDescCreatedAt := trustedissuerFields[2].Descriptor()
+	// trustedissuer.DefaultCreatedAt holds the default value on creation for the created_at field.
+	trustedissuer.DefaultCreatedAt = trustedissuerDescCreatedAt.Default.(func() time.Time)
+	// trustedissuerDescUpdatedAt is the schema descriptor for updated_at field.
+	trustedissuerDescUpdatedAt := trustedissuerFields[3].Descriptor()
+	// trustedissuer.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	trustedissuer.DefaultUpdatedAt = trustedissuerDescUpdatedAt.Default.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescName is the schema descriptor for name field.
@@ -103,11 +221,11 @@ func init() {
 	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
 	user.PasswordValidator = userDescPassword.Validators[0].(func([]byte) error)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[5].Descriptor()
+	userDescCreatedAt := userFields[6].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[6].Descriptor()
+	userDescUpdatedAt := userFields[7].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// userDescID is the schema descriptor for id field.
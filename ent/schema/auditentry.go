@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditEntry holds the schema definition for the AuditEntry entity.
+// The entries are append-only and each one includes the hash of the previous one,
+// so any modification of the log can be detected.
+type AuditEntry struct {
+	ent.Schema
+}
+
+// Fields of the AuditEntry.
+func (AuditEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Immutable(),
+		field.Time("created_at").
+			Immutable(),
+		field.String("actor").
+			Immutable(),
+		field.String("action").
+			NotEmpty().
+			Immutable(),
+		field.String("target_type").
+			Optional().
+			Immutable(),
+		field.String("target").
+			Optional().
+			Immutable(),
+		field.Enum("outcome").
+			Values("success", "failure").
+			Immutable(),
+		field.String("request_id").
+			Optional().
+			Immutable(),
+		field.JSON("details", map[string]any{}).
+			Optional().
+			Immutable(),
+		field.String("prev_hash").
+			Immutable(),
+		field.String("hash").
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the AuditEntry.
+func (AuditEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("action"),
+		index.Fields("actor"),
+		index.Fields("target"),
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ClientCertificate holds the schema definition for the ClientCertificate entity.
+// A certificate of a client authenticated with mutual TLS, identified by its SHA-256 fingerprint,
+// and the user of the vault which the client authenticates as.
+type ClientCertificate struct {
+	ent.Schema
+}
+
+// Fields of the ClientCertificate.
+func (ClientCertificate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable(),
+		field.String("user"),
+		field.String("subject").Optional(),
+		field.Time("not_after"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the ClientCertificate.
+func (ClientCertificate) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ClientCertificate.
+func (ClientCertificate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user"),
+	}
+}
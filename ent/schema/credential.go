@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Credential holds the schema definition for the Credential entity.
@@ -19,6 +20,41 @@ func (Credential) Fields() []ent.Field {
 		field.String("id").Unique().Immutable(),
 		field.String("type").Default("jwt_vc"),
 		field.JSON("raw", []byte{}),
+		field.Enum("status").
+			Values("active", "revoked").
+			Default("active"),
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+		field.Time("renewal_due_at").
+			Optional().
+			Nillable(),
+		field.Time("renewal_started_at").
+			Optional().
+			Nillable(),
+		field.JSON("issuance_data", map[string]any{}).
+			Optional(),
+		field.Strings("types").
+			Optional(),
+		field.String("credential_type").
+			Optional(),
+		field.String("issuer").
+			Optional(),
+		field.String("subject").
+			Optional(),
+		field.String("holder_email").
+			Optional(),
+		field.String("template_name").
+			Optional(),
+		field.Time("issued_at").
+			Optional().
+			Nillable(),
+		field.Time("indexed_at").
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -33,5 +69,24 @@ func (Credential) Edges() []ent.Edge {
 		edge.From("account", User.Type).
 			Ref("credentials").
 			Unique(),
+		edge.From("template", CredentialTemplate.Type).
+			Ref("credentials").
+			Unique(),
+		edge.To("successor", Credential.Type).
+			Unique().
+			From("predecessor").
+			Unique(),
+	}
+}
+
+// Indexes of the Credential.
+func (Credential) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "expires_at"),
+		index.Fields("credential_type"),
+		index.Fields("subject"),
+		index.Fields("holder_email"),
+		index.Fields("issued_at"),
+		index.Fields("created_at"),
 	}
 }
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CredentialTemplate holds the schema definition for the CredentialTemplate entity.
+// Each row is one version of a named template. Only one version of a given
+// name can be in the published state at a time.
+type CredentialTemplate struct {
+	ent.Schema
+}
+
+// Fields of the CredentialTemplate.
+func (CredentialTemplate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable(),
+		field.String("name").
+			NotEmpty().
+			Immutable(),
+		field.Int("version").
+			Positive().
+			Immutable(),
+		field.Enum("status").
+			Values("draft", "published", "archived").
+			Default("draft"),
+		field.String("description").
+			Optional(),
+		field.Text("content").
+			NotEmpty(),
+		field.JSON("sample_data", map[string]any{}).
+			Optional(),
+		field.Bool("builtin").
+			Default(false),
+		field.Time("published_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the CredentialTemplate.
+func (CredentialTemplate) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("credentials", Credential.Type),
+	}
+}
+
+// Indexes of the CredentialTemplate.
+func (CredentialTemplate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name", "version").
+			Unique(),
+	}
+}
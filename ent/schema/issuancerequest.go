@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// IssuanceRequest holds the schema definition for the IssuanceRequest entity.
+// A request is created when an operator asks for a credential which requires the
+// approval of a second operator before being signed.
+type IssuanceRequest struct {
+	ent.Schema
+}
+
+// Fields of the IssuanceRequest.
+func (IssuanceRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable(),
+		field.String("credential_type").
+			NotEmpty().
+			Immutable(),
+		field.JSON("claims", map[string]any{}).
+			Immutable(),
+		field.String("subject_did").
+			Optional().
+			Immutable(),
+		field.String("predecessor").
+			Optional().
+			Immutable(),
+		field.Bool("revoke_predecessor").
+			Default(false).
+			Immutable(),
+		field.Enum("status").
+			Values("pending", "approved", "rejected", "issued", "failed").
+			Default("pending"),
+		field.String("requested_by").
+			NotEmpty().
+			Immutable(),
+		field.String("decided_by").
+			Optional(),
+		field.String("reason").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the IssuanceRequest.
+func (IssuanceRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("events", IssuanceRequestEvent.Type),
+		edge.To("credential", Credential.Type).
+			Unique(),
+	}
+}
+
+// Indexes of the IssuanceRequest.
+func (IssuanceRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// IssuanceRequestEvent holds the schema definition for the IssuanceRequestEvent entity.
+// There is one event for each state transition of an IssuanceRequest.
+type IssuanceRequestEvent struct {
+	ent.Schema
+}
+
+// Fields of the IssuanceRequestEvent.
+func (IssuanceRequestEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("from_status").
+			Optional().
+			Immutable(),
+		field.String("to_status").
+			Immutable(),
+		field.String("actor").
+			Immutable(),
+		field.String("reason").
+			Optional().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the IssuanceRequestEvent.
+func (IssuanceRequestEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("request", IssuanceRequest.Type).
+			Ref("events").
+			Unique(),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LoginSession holds the schema definition for the LoginSession entity.
+// A SIOP login of the verifier, identified by its state, with the relying party of the login, the URL
+// to return to after it and, when it is completed, the credential presented.
+type LoginSession struct {
+	ent.Schema
+}
+
+// Fields of the LoginSession.
+func (LoginSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable(),
+		field.String("client_id").Optional(),
+		field.String("return_url").Optional(),
+		field.Text("credential").Optional().Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("expires_at"),
+		field.Time("completed_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the LoginSession.
+func (LoginSession) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the LoginSession.
+func (LoginSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PresentationNonce holds the schema definition for the PresentationNonce entity.
+// A nonce sent in a SIOP request, which the presentation must carry and which can only be used once.
+type PresentationNonce struct {
+	ent.Schema
+}
+
+// Fields of the PresentationNonce.
+func (PresentationNonce) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable(),
+		field.String("state").Immutable(),
+		field.String("client_id").Optional().Immutable(),
+		field.String("audience").Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("expires_at").Immutable(),
+		field.Time("consumed_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the PresentationNonce.
+func (PresentationNonce) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the PresentationNonce.
+func (PresentationNonce) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("state"),
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RateCounter holds the schema definition for the RateCounter entity.
+// The number of requests of a client in a window of time, shared by the instances using the vault.
+type RateCounter struct {
+	ent.Schema
+}
+
+// Fields of the RateCounter.
+func (RateCounter) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable(),
+		field.Int("count").Default(0),
+		field.Time("expires_at").Immutable(),
+	}
+}
+
+// Edges of the RateCounter.
+func (RateCounter) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the RateCounter.
+func (RateCounter) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// RelyingParty holds the schema definition for the RelyingParty entity.
+// A client application registered in the verifier for the login of its users.
+type RelyingParty struct {
+	ent.Schema
+}
+
+// Fields of the RelyingParty.
+func (RelyingParty) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable(),
+		field.String("name").Optional(),
+		field.JSON("redirect_uris", []string{}),
+		field.JSON("credential_types", []string{}).Optional(),
+		field.JSON("presentation_definition", map[string]any{}).Optional(),
+		field.String("scope").Optional(),
+		field.String("audience").Optional(),
+		field.String("token_lifetime").Optional(),
+		field.String("signing_alg").Optional(),
+		field.String("service").Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the RelyingParty.
+func (RelyingParty) Edges() []ent.Edge {
+	return nil
+}
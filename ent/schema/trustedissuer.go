@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/internal/til"
+)
+
+// TrustedIssuer holds the schema definition for the TrustedIssuer entity.
+// An entry of the Trusted Issuers List, with the credentials that the issuer may issue.
+type TrustedIssuer struct {
+	ent.Schema
+}
+
+// Fields of the TrustedIssuer.
+func (TrustedIssuer) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Unique().Immutable(),
+		field.JSON("credentials", []til.Credential{}),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the TrustedIssuer.
+func (TrustedIssuer) Edges() []ent.Edge {
+	return nil
+}
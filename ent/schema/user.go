@@ -27,6 +27,8 @@ func (User) Fields() []ent.Field {
 			NotEmpty(),
 		field.Bytes("password").
 			NotEmpty().Sensitive(),
+		field.Strings("roles").
+			Optional(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
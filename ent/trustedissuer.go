@@ -0,0 +1,128 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/hesusruiz/vcbackend/ent/trustedissuer"
+	"github.com/hesusruiz/vcbackend/internal/til"
+)
+
+// TrustedIssuer is the model entity for the TrustedIssuer schema.
+type TrustedIssuer struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Credentials holds the value of the "credentials" field.
+	Credentials []til.Credential `json:"credentials,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TrustedIssuer) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case trustedissuer.FieldCredentials:
+			values[i] = new([]byte)
+		case trustedissuer.FieldID:
+			values[i] = new(sql.NullString)
+		case trustedissuer.FieldCreatedAt, trustedissuer.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type TrustedIssuer", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TrustedIssuer fields.
+func (ti *TrustedIssuer) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case trustedissuer.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ti.ID = value.String
+			}
+		case trustedissuer.FieldCredentials:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field credentials", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ti.Credentials); err != nil {
+					return fmt.Errorf("unmarshal field credentials: %w", err)
+				}
+			}
+		case trustedissuer.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ti.CreatedAt = value.Time
+			}
+		case trustedissuer.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ti.UpdatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this TrustedIssuer.
+// Note that you need to call TrustedIssuer.Unwrap() before calling this method if this TrustedIssuer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ti *TrustedIssuer) Update() *TrustedIssuerUpdateOne {
+	return (&TrustedIssuerClient{config: ti.config}).UpdateOne(ti)
+}
+
+// Unwrap unwraps the TrustedIssuer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ti *TrustedIssuer) Unwrap() *TrustedIssuer {
+	_tx, ok := ti.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TrustedIssuer is not a transactional entity")
+	}
+	ti.config.driver = _tx.drv
+	return ti
+}
+
+// String implements the fmt.Stringer.
+func (ti *TrustedIssuer) String() string {
+	var builder strings.Builder
+	builder.WriteString("TrustedIssuer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ti.ID))
+	builder.WriteString("credentials=")
+	builder.WriteString(fmt.Sprintf("%v", ti.Credentials))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ti.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ti.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TrustedIssuers is a parsable slice of TrustedIssuer.
+type TrustedIssuers []*TrustedIssuer
+
+func (ti TrustedIssuers) config(cfg config) {
+	for _i := range ti {
+		ti[_i].config = cfg
+	}
+}
@@ -0,0 +1,47 @@
+// Code generated by ent, DO NOT EDIT.
+
+package trustedissuer
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the trustedissuer type in the database.
+	Label = "trusted_issuer"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCredentials holds the string denoting the credentials field in the database.
+	FieldCredentials = "credentials"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the trustedissuer in the database.
+	Table = "trusted_issuers"
+)
+
+// Columns holds all SQL columns for trustedissuer fields.
+var Columns = []string{
+	FieldID,
+	FieldCredentials,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+)
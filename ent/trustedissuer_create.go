@@ -0,0 +1,303 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/trustedissuer"
+	"github.com/hesusruiz/vcbackend/internal/til"
+)
+
+// TrustedIssuerCreate is the builder for creating a TrustedIssuer entity.
+type TrustedIssuerCreate struct {
+	config
+	mutation *TrustedIssuerMutation
+	hooks    []Hook
+}
+
+// SetCredentials sets the "credentials" field.
+func (tic *TrustedIssuerCreate) SetCredentials(t []til.Credential) *TrustedIssuerCreate {
+	tic.mutation.SetCredentials(t)
+	return tic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tic *TrustedIssuerCreate) SetCreatedAt(t time.Time) *TrustedIssuerCreate {
+	tic.mutation.SetCreatedAt(t)
+	return tic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tic *TrustedIssuerCreate) SetNillableCreatedAt(t *time.Time) *TrustedIssuerCreate {
+	if t != nil {
+		tic.SetCreatedAt(*t)
+	}
+	return tic
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tic *TrustedIssuerCreate) SetUpdatedAt(t time.Time) *TrustedIssuerCreate {
+	tic.mutation.SetUpdatedAt(t)
+	return tic
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tic *TrustedIssuerCreate) SetNillableUpdatedAt(t *time.Time) *TrustedIssuerCreate {
+	if t != nil {
+		tic.SetUpdatedAt(*t)
+	}
+	return tic
+}
+
+// SetID sets the "id" field.
+func (tic *TrustedIssuerCreate) SetID(s string) *TrustedIssuerCreate {
+	tic.mutation.SetID(s)
+	return tic
+}
+
+// Mutation returns the TrustedIssuerMutation object of the builder.
+func (tic *TrustedIssuerCreate) Mutation() *TrustedIssuerMutation {
+	return tic.mutation
+}
+
+// Save creates the TrustedIssuer in the database.
+func (tic *TrustedIssuerCreate) Save(ctx context.Context) (*TrustedIssuer, error) {
+	var (
+		err  error
+		node *TrustedIssuer
+	)
+	tic.defaults()
+	if len(tic.hooks) == 0 {
+		if err = tic.check(); err != nil {
+			return nil, err
+		}
+		node, err = tic.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TrustedIssuerMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = tic.check(); err != nil {
+				return nil, err
+			}
+			tic.mutation = mutation
+			if node, err = tic.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(tic.hooks) - 1; i >= 0; i-- {
+			if tic.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tic.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, tic.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*TrustedIssuer)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from TrustedIssuerMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tic *TrustedIssuerCreate) SaveX(ctx context.Context) *TrustedIssuer {
+	v, err := tic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tic *TrustedIssuerCreate) Exec(ctx context.Context) error {
+	_, err := tic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tic *TrustedIssuerCreate) ExecX(ctx context.Context) {
+	if err := tic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tic *TrustedIssuerCreate) defaults() {
+	if _, ok := tic.mutation.CreatedAt(); !ok {
+		v := trustedissuer.DefaultCreatedAt()
+		tic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tic.mutation.UpdatedAt(); !ok {
+		v := trustedissuer.DefaultUpdatedAt()
+		tic.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tic *TrustedIssuerCreate) check() error {
+	if _, ok := tic.mutation.Credentials(); !ok {
+		return &ValidationError{Name: "credentials", err: errors.New(`ent: missing required field "TrustedIssuer.credentials"`)}
+	}
+	if _, ok := tic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TrustedIssuer.created_at"`)}
+	}
+	if _, ok := tic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TrustedIssuer.updated_at"`)}
+	}
+	return nil
+}
+
+func (tic *TrustedIssuerCreate) sqlSave(ctx context.Context) (*TrustedIssuer, error) {
+	_node, _spec := tic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected TrustedIssuer.ID type: %T", _spec.ID.Value)
+		}
+	}
+	return _node, nil
+}
+
+func (tic *TrustedIssuerCreate) createSpec() (*TrustedIssuer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TrustedIssuer{config: tic.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: trustedissuer.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: trustedissuer.FieldID,
+			},
+		}
+	)
+	if id, ok := tic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := tic.mutation.Credentials(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: trustedissuer.FieldCredentials,
+		})
+		_node.Credentials = value
+	}
+	if value, ok := tic.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: trustedissuer.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := tic.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: trustedissuer.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// TrustedIssuerCreateBulk is the builder for creating many TrustedIssuer entities in bulk.
+type TrustedIssuerCreateBulk struct {
+	config
+	builders []*TrustedIssuerCreate
+}
+
+// Save creates the TrustedIssuer entities in the database.
+func (ticb *TrustedIssuerCreateBulk) Save(ctx context.Context) ([]*TrustedIssuer, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(ticb.builders))
+	nodes := make([]*TrustedIssuer, len(ticb.builders))
+	mutators := make([]Mutator, len(ticb.builders))
+	for i := range ticb.builders {
+		func(i int, root context.Context) {
+			builder := ticb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TrustedIssuerMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ticb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ticb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ticb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ticb *TrustedIssuerCreateBulk) SaveX(ctx context.Context) []*TrustedIssuer {
+	v, err := ticb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ticb *TrustedIssuerCreateBulk) Exec(ctx context.Context) error {
+	_, err := ticb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ticb *TrustedIssuerCreateBulk) ExecX(ctx context.Context) {
+	if err := ticb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
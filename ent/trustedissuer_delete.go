@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+	"github.com/hesusruiz/vcbackend/ent/trustedissuer"
+)
+
+// TrustedIssuerDelete is the builder for deleting a TrustedIssuer entity.
+type TrustedIssuerDelete struct {
+	config
+	hooks    []Hook
+	mutation *TrustedIssuerMutation
+}
+
+// Where appends a list predicates to the TrustedIssuerDelete builder.
+func (tid *TrustedIssuerDelete) Where(ps ...predicate.TrustedIssuer) *TrustedIssuerDelete {
+	tid.mutation.Where(ps...)
+	return tid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tid *TrustedIssuerDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(tid.hooks) == 0 {
+		affected, err = tid.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TrustedIssuerMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			tid.mutation = mutation
+			affected, err = tid.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(tid.hooks) - 1; i >= 0; i-- {
+			if tid.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tid.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tid.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tid *TrustedIssuerDelete) ExecX(ctx context.Context) int {
+	n, err := tid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tid *TrustedIssuerDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: trustedissuer.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: trustedissuer.FieldID,
+			},
+		},
+	}
+	if ps := tid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// TrustedIssuerDeleteOne is the builder for deleting a single TrustedIssuer entity.
+type TrustedIssuerDeleteOne struct {
+	tid *TrustedIssuerDelete
+}
+
+// Exec executes the deletion query.
+func (tido *TrustedIssuerDeleteOne) Exec(ctx context.Context) error {
+	n, err := tido.tid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{trustedissuer.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tido *TrustedIssuerDeleteOne) ExecX(ctx context.Context) {
+	tido.tid.ExecX(ctx)
+}
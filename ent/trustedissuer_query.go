@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+	"github.com/hesusruiz/vcbackend/ent/trustedissuer"
+)
+
+// TrustedIssuerQuery is the builder for querying TrustedIssuer entities.
+type TrustedIssuerQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.TrustedIssuer
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TrustedIssuerQuery builder.
+func (tiq *TrustedIssuerQuery) Where(ps ...predicate.TrustedIssuer) *TrustedIssuerQuery {
+	tiq.predicates = append(tiq.predicates, ps...)
+	return tiq
+}
+
+// Limit adds a limit step to the query.
+func (tiq *TrustedIssuerQuery) Limit(limit int) *TrustedIssuerQuery {
+	tiq.limit = &limit
+	return tiq
+}
+
+// Offset adds an offset step to the query.
+func (tiq *TrustedIssuerQuery) Offset(offset int) *TrustedIssuerQuery {
+	tiq.offset = &offset
+	return tiq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tiq *TrustedIssuerQuery) Unique(unique bool) *TrustedIssuerQuery {
+	tiq.unique = &unique
+	return tiq
+}
+
+// Order adds an order step to the query.
+func (tiq *TrustedIssuerQuery) Order(o ...OrderFunc) *TrustedIssuerQuery {
+	tiq.order = append(tiq.order, o...)
+	return tiq
+}
+
+// First returns the first TrustedIssuer entity from the query.
+// Returns a *NotFoundError when no TrustedIssuer was found.
+func (tiq *TrustedIssuerQuery) First(ctx context.Context) (*TrustedIssuer, error) {
+	nodes, err := tiq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{trustedissuer.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tiq *TrustedIssuerQuery) FirstX(ctx context.Context) *TrustedIssuer {
+	node, err := tiq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TrustedIssuer ID from the query.
+// Returns a *NotFoundError when no TrustedIssuer ID was found.
+func (tiq *TrustedIssuerQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = tiq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{trustedissuer.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tiq *TrustedIssuerQuery) FirstIDX(ctx context.Context) string {
+	id, err := tiq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TrustedIssuer entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TrustedIssuer entity is found.
+// Returns a *NotFoundError when no TrustedIssuer entities are found.
+func (tiq *TrustedIssuerQuery) Only(ctx context.Context) (*TrustedIssuer, error) {
+	nodes, err := tiq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{trustedissuer.Label}
+	default:
+		return nil, &NotSingularError{trustedissuer.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tiq *TrustedIssuerQuery) OnlyX(ctx context.Context) *TrustedIssuer {
+	node, err := tiq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TrustedIssuer ID in the query.
+// Returns a *NotSingularError when more than one TrustedIssuer ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tiq *TrustedIssuerQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = tiq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{trustedissuer.Label}
+	default:
+		err = &NotSingularError{trustedissuer.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tiq *TrustedIssuerQuery) OnlyIDX(ctx context.Context) string {
+	id, err := tiq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TrustedIssuers.
+func (tiq *TrustedIssuerQuery) All(ctx context.Context) ([]*TrustedIssuer, error) {
+	if err := tiq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return tiq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tiq *TrustedIssuerQuery) AllX(ctx context.Context) []*TrustedIssuer {
+	nodes, err := tiq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TrustedIssuer IDs.
+func (tiq *TrustedIssuerQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := tiq.Select(trustedissuer.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tiq *TrustedIssuerQuery) IDsX(ctx context.Context) []string {
+	ids, err := tiq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tiq *TrustedIssuerQuery) Count(ctx context.Context) (int, error) {
+	if err := tiq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return tiq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tiq *TrustedIssuerQuery) CountX(ctx context.Context) int {
+	count, err := tiq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tiq *TrustedIssuerQuery) Exist(ctx context.Context) (bool, error) {
+	if err := tiq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return tiq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tiq *TrustedIssuerQuery) ExistX(ctx context.Context) bool {
+	exist, err := tiq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TrustedIssuerQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tiq *TrustedIssuerQuery) Clone() *TrustedIssuerQuery {
+	if tiq == nil {
+		return nil
+	}
+	return &TrustedIssuerQuery{
+		config:     tiq.config,
+		limit:      tiq.limit,
+		offset:     tiq.offset,
+		order:      append([]OrderFunc{}, tiq.order...),
+		predicates: append([]predicate.TrustedIssuer{}, tiq.predicates...),
+		// clone intermediate query.
+		sql:    tiq.sql.Clone(),
+		path:   tiq.path,
+		unique: tiq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Credentials []til.Credential `json:"credentials,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TrustedIssuer.Query().
+//		GroupBy(trustedissuer.FieldCredentials).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tiq *TrustedIssuerQuery) GroupBy(field string, fields ...string) *TrustedIssuerGroupBy {
+	grbuild := &TrustedIssuerGroupBy{config: tiq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := tiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return tiq.sqlQuery(ctx), nil
+	}
+	grbuild.label = trustedissuer.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Credentials []til.Credential `json:"credentials,omitempty"`
+//	}
+//
+//	client.TrustedIssuer.Query().
+//		Select(trustedissuer.FieldCredentials).
+//		Scan(ctx, &v)
+func (tiq *TrustedIssuerQuery) Select(fields ...string) *TrustedIssuerSelect {
+	tiq.fields = append(tiq.fields, fields...)
+	selbuild := &TrustedIssuerSelect{TrustedIssuerQuery: tiq}
+	selbuild.label = trustedissuer.Label
+	selbuild.flds, selbuild.scan = &tiq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (tiq *TrustedIssuerQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range tiq.fields {
+		if !trustedissuer.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tiq.path != nil {
+		prev, err := tiq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tiq.sql = prev
+	}
+	return nil
+}
+
+func (tiq *TrustedIssuerQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TrustedIssuer, error) {
+	var (
+		nodes = []*TrustedIssuer{}
+		_spec = tiq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*TrustedIssuer).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &TrustedIssuer{config: tiq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tiq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (tiq *TrustedIssuerQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tiq.querySpec()
+	_spec.Node.Columns = tiq.fields
+	if len(tiq.fields) > 0 {
+		_spec.Unique = tiq.unique != nil && *tiq.unique
+	}
+	return sqlgraph.CountNodes(ctx, tiq.driver, _spec)
+}
+
+func (tiq *TrustedIssuerQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := tiq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (tiq *TrustedIssuerQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   trustedissuer.Table,
+			Columns: trustedissuer.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: trustedissuer.FieldID,
+			},
+		},
+		From:   tiq.sql,
+		Unique: true,
+	}
+	if unique := tiq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := tiq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, trustedissuer.FieldID)
+		for i := range fields {
+			if fields[i] != trustedissuer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := tiq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tiq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tiq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tiq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tiq *TrustedIssuerQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tiq.driver.Dialect())
+	t1 := builder.Table(trustedissuer.Table)
+	columns := tiq.fields
+	if len(columns) == 0 {
+		columns = trustedissuer.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tiq.sql != nil {
+		selector = tiq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tiq.unique != nil && *tiq.unique {
+		selector.Distinct()
+	}
+	for _, p := range tiq.predicates {
+		p(selector)
+	}
+	for _, p := range tiq.order {
+		p(selector)
+	}
+	if offset := tiq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tiq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TrustedIssuerGroupBy is the group-by builder for TrustedIssuer entities.
+type TrustedIssuerGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tigb *TrustedIssuerGroupBy) Aggregate(fns ...AggregateFunc) *TrustedIssuerGroupBy {
+	tigb.fns = append(tigb.fns, fns...)
+	return tigb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (tigb *TrustedIssuerGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := tigb.path(ctx)
+	if err != nil {
+		return err
+	}
+	tigb.sql = query
+	return tigb.sqlScan(ctx, v)
+}
+
+func (tigb *TrustedIssuerGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range tigb.fields {
+		if !trustedissuer.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := tigb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tigb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (tigb *TrustedIssuerGroupBy) sqlQuery() *sql.Selector {
+	selector := tigb.sql.Select()
+	aggregation := make([]string, 0, len(tigb.fns))
+	for _, fn := range tigb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(tigb.fields)+len(tigb.fns))
+		for _, f := range tigb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(tigb.fields...)...)
+}
+
+// TrustedIssuerSelect is the builder for selecting fields of TrustedIssuer entities.
+type TrustedIssuerSelect struct {
+	*TrustedIssuerQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tis *TrustedIssuerSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := tis.prepareQuery(ctx); err != nil {
+		return err
+	}
+	tis.sql = tis.TrustedIssuerQuery.sqlQuery(ctx)
+	return tis.sqlScan(ctx, v)
+}
+
+func (tis *TrustedIssuerSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := tis.sql.Query()
+	if err := tis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,315 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+	"github.com/hesusruiz/vcbackend/ent/trustedissuer"
+	"github.com/hesusruiz/vcbackend/internal/til"
+)
+
+// TrustedIssuerUpdate is the builder for updating TrustedIssuer entities.
+type TrustedIssuerUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TrustedIssuerMutation
+}
+
+// Where appends a list predicates to the TrustedIssuerUpdate builder.
+func (tiu *TrustedIssuerUpdate) Where(ps ...predicate.TrustedIssuer) *TrustedIssuerUpdate {
+	tiu.mutation.Where(ps...)
+	return tiu
+}
+
+// SetCredentials sets the "credentials" field.
+func (tiu *TrustedIssuerUpdate) SetCredentials(t []til.Credential) *TrustedIssuerUpdate {
+	tiu.mutation.SetCredentials(t)
+	return tiu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tiu *TrustedIssuerUpdate) SetUpdatedAt(t time.Time) *TrustedIssuerUpdate {
+	tiu.mutation.SetUpdatedAt(t)
+	return tiu
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tiu *TrustedIssuerUpdate) SetNillableUpdatedAt(t *time.Time) *TrustedIssuerUpdate {
+	if t != nil {
+		tiu.SetUpdatedAt(*t)
+	}
+	return tiu
+}
+
+// Mutation returns the TrustedIssuerMutation object of the builder.
+func (tiu *TrustedIssuerUpdate) Mutation() *TrustedIssuerMutation {
+	return tiu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tiu *TrustedIssuerUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(tiu.hooks) == 0 {
+		affected, err = tiu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TrustedIssuerMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			tiu.mutation = mutation
+			affected, err = tiu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(tiu.hooks) - 1; i >= 0; i-- {
+			if tiu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tiu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tiu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tiu *TrustedIssuerUpdate) SaveX(ctx context.Context) int {
+	affected, err := tiu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tiu *TrustedIssuerUpdate) Exec(ctx context.Context) error {
+	_, err := tiu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tiu *TrustedIssuerUpdate) ExecX(ctx context.Context) {
+	if err := tiu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (tiu *TrustedIssuerUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   trustedissuer.Table,
+			Columns: trustedissuer.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: trustedissuer.FieldID,
+			},
+		},
+	}
+	if ps := tiu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tiu.mutation.Credentials(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: trustedissuer.FieldCredentials,
+		})
+	}
+	if value, ok := tiu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: trustedissuer.FieldUpdatedAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tiu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{trustedissuer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// TrustedIssuerUpdateOne is the builder for updating a single TrustedIssuer entity.
+type TrustedIssuerUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TrustedIssuerMutation
+}
+
+// SetCredentials sets the "credentials" field.
+func (tiuo *TrustedIssuerUpdateOne) SetCredentials(t []til.Credential) *TrustedIssuerUpdateOne {
+	tiuo.mutation.SetCredentials(t)
+	return tiuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tiuo *TrustedIssuerUpdateOne) SetUpdatedAt(t time.Time) *TrustedIssuerUpdateOne {
+	tiuo.mutation.SetUpdatedAt(t)
+	return tiuo
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tiuo *TrustedIssuerUpdateOne) SetNillableUpdatedAt(t *time.Time) *TrustedIssuerUpdateOne {
+	if t != nil {
+		tiuo.SetUpdatedAt(*t)
+	}
+	return tiuo
+}
+
+// Mutation returns the TrustedIssuerMutation object of the builder.
+func (tiuo *TrustedIssuerUpdateOne) Mutation() *TrustedIssuerMutation {
+	return tiuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tiuo *TrustedIssuerUpdateOne) Select(field string, fields ...string) *TrustedIssuerUpdateOne {
+	tiuo.fields = append([]string{field}, fields...)
+	return tiuo
+}
+
+// Save executes the query and returns the updated TrustedIssuer entity.
+func (tiuo *TrustedIssuerUpdateOne) Save(ctx context.Context) (*TrustedIssuer, error) {
+	var (
+		err  error
+		node *TrustedIssuer
+	)
+	if len(tiuo.hooks) == 0 {
+		node, err = tiuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TrustedIssuerMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			tiuo.mutation = mutation
+			node, err = tiuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(tiuo.hooks) - 1; i >= 0; i-- {
+			if tiuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tiuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, tiuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*TrustedIssuer)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from TrustedIssuerMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tiuo *TrustedIssuerUpdateOne) SaveX(ctx context.Context) *TrustedIssuer {
+	node, err := tiuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tiuo *TrustedIssuerUpdateOne) Exec(ctx context.Context) error {
+	_, err := tiuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tiuo *TrustedIssuerUpdateOne) ExecX(ctx context.Context) {
+	if err := tiuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (tiuo *TrustedIssuerUpdateOne) sqlSave(ctx context.Context) (_node *TrustedIssuer, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   trustedissuer.Table,
+			Columns: trustedissuer.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: trustedissuer.FieldID,
+			},
+		},
+	}
+	id, ok := tiuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TrustedIssuer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tiuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, trustedissuer.FieldID)
+		for _, f := range fields {
+			if !trustedissuer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != trustedissuer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tiuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tiuo.mutation.Credentials(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: trustedissuer.FieldCredentials,
+		})
+	}
+	if value, ok := tiuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: trustedissuer.FieldUpdatedAt,
+		})
+	}
+	_node = &TrustedIssuer{config: tiuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tiuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{trustedissuer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
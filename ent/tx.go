@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -12,16 +14,36 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AuditEntry is the client for interacting with the AuditEntry builders.
+	AuditEntry *AuditEntryClient
+	// ClientCertificate is the client for interacting with the ClientCertificate builders.
+	ClientCertificate *ClientCertificateClient
 	// Credential is the client for interacting with the Credential builders.
 	Credential *CredentialClient
+	// CredentialTemplate is the client for interacting with the CredentialTemplate builders.
+	CredentialTemplate *CredentialTemplateClient
 	// DID is the client for interacting with the DID builders.
 	DID *DIDClient
+	// IssuanceRequest is the client for interacting with the IssuanceRequest builders.
+	IssuanceRequest *IssuanceRequestClient
+	// IssuanceRequestEvent is the client for interacting with the IssuanceRequestEvent builders.
+	IssuanceRequestEvent *IssuanceRequestEventClient
+	// LoginSession is the client for interacting with the LoginSession builders.
+	LoginSession *LoginSessionClient
 	// NaturalPerson is the client for interacting with the NaturalPerson builders.
 	NaturalPerson *NaturalPersonClient
+	// PresentationNonce is the client for interacting with the PresentationNonce builders.
+	PresentationNonce *PresentationNonceClient
 	// PrivateKey is the client for interacting with the PrivateKey builders.
 	PrivateKey *PrivateKeyClient
 	// PublicKey is the client for interacting with the PublicKey builders.
 	PublicKey *PublicKeyClient
+	// RateCounter is the client for interacting with the RateCounter builders.
+	RateCounter *RateCounterClient
+	// RelyingParty is the client for interacting with the RelyingParty builders.
+	RelyingParty *RelyingPartyClient
+	// TrustedIssuer is the client for interacting with the TrustedIssuer builders.
+	TrustedIssuer *TrustedIssuerClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -159,11 +181,21 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AuditEntry = NewAuditEntryClient(tx.config)
+	tx.ClientCertificate = NewClientCertificateClient(tx.config)
 	tx.Credential = NewCredentialClient(tx.config)
+	tx.CredentialTemplate = NewCredentialTemplateClient(tx.config)
 	tx.DID = NewDIDClient(tx.config)
+	tx.IssuanceRequest = NewIssuanceRequestClient(tx.config)
+	tx.IssuanceRequestEvent = NewIssuanceRequestEventClient(tx.config)
+	tx.LoginSession = NewLoginSessionClient(tx.config)
 	tx.NaturalPerson = NewNaturalPersonClient(tx.config)
+	tx.PresentationNonce = NewPresentationNonceClient(tx.config)
 	tx.PrivateKey = NewPrivateKeyClient(tx.config)
 	tx.PublicKey = NewPublicKeyClient(tx.config)
+	tx.RateCounter = NewRateCounterClient(tx.config)
+	tx.RelyingParty = NewRelyingPartyClient(tx.config)
+	tx.TrustedIssuer = NewTrustedIssuerClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -174,7 +206,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Credential.QueryXXX(), the query will be executed
+// applies a query, for example: AuditEntry.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -223,3 +255,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v interface{}
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...interface{}) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...interface{}) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...interface{}) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...interface{}) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -19,6 +19,8 @@ const (
 	FieldType = "type"
 	// FieldPassword holds the string denoting the password field in the database.
 	FieldPassword = "password"
+	// FieldRoles holds the string denoting the roles field in the database.
+	FieldRoles = "roles"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -61,6 +63,7 @@ var Columns = []string{
 	FieldDisplayname,
 	FieldType,
 	FieldPassword,
+	FieldRoles,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/hesusruiz/vcbackend/internal/issuance"
+	"github.com/hesusruiz/vcbackend/vault"
+	"go.uber.org/zap"
+)
+
+const defaultHealthTimeout = 2 * time.Second
+const defaultDrainTimeout = 30 * time.Second
+
+// addHealthRoutes adds the endpoints for the liveness and readiness probes, like the ones of Kubernetes
+func (s *Server) addHealthRoutes() {
+	s.Get("/healthz", s.HandleLiveness)
+	s.Get("/readyz", s.HandleReadiness)
+}
+
+// HandleLiveness reports that the process is running. It does not check the dependencies,
+// so a failure of the database does not make the orchestrator restart the server.
+func (s *Server) HandleLiveness(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{"status": "ok"})
+}
+
+// HandleReadiness reports if the server can receive requests: it is not draining the requests in
+// progress before shutting down, the database of each vault can be queried and the SSI Kit signatory
+// is reachable. It answers 503 when any of them fails.
+func (s *Server) HandleReadiness(c *fiber.Ctx) error {
+
+	if s.draining.Load() {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"status": "draining"})
+	}
+
+	checks := s.readinessChecks(c.UserContext())
+	status := "ready"
+	for _, result := range checks {
+		if result != "ok" {
+			status = "not ready"
+			c.Status(fiber.StatusServiceUnavailable)
+		}
+	}
+
+	return c.JSON(fiber.Map{"status": status, "checks": checks})
+}
+
+// readinessChecks runs the checks of the dependencies concurrently, returning "ok" or "failed" for each one.
+// The details of the failures are logged, not returned to the probe.
+func (s *Server) readinessChecks(ctx context.Context) map[string]string {
+
+	timeout, err := issuance.ParseDuration(s.conf.Server.Health.Timeout)
+	if err != nil || timeout <= 0 {
+		timeout = defaultHealthTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	checks := map[string]func(context.Context) error{
+		"issuerVault":   s.issuerVault.Ping,
+		"verifierVault": s.verifierVault.Ping,
+		"walletVault":   s.walletvault.Ping,
+		"registryVault": s.registryVault.Ping,
+		"signer":        s.pingSigner,
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	results := map[string]string{}
+	for name, check := range checks {
+		wg.Add(1)
+		go func(name string, check func(context.Context) error) {
+			defer wg.Done()
+			result := "ok"
+			if err := check(ctx); err != nil {
+				s.logger.Errorw("readiness check failed", "check", name, zap.Error(err))
+				result = "failed"
+			}
+			mu.Lock()
+			results[name] = result
+			mu.Unlock()
+		}(name, check)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// pingSigner checks that the SSI Kit signatory, which signs the credentials in JSON-LD, answers requests
+func (s *Server) pingSigner(ctx context.Context) error {
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.ssiKit.signatoryUrl+"/v1/templates", nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode >= 500 {
+		return fmt.Errorf("the signatory answered with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// listenAndServe starts the server and stops it gracefully when the context is done, like when the
+// process receives SIGTERM or SIGINT
+func (s *Server) listenAndServe(ctx context.Context, address string) error {
+
+	listenErr := make(chan error, 1)
+	go func() {
+		listenErr <- s.serve(ctx, address)
+	}()
+
+	select {
+	case err := <-listenErr:
+		return err
+	case <-ctx.Done():
+	}
+
+	s.shutdown()
+	return nil
+}
+
+// shutdown reports that the server is not ready, so the load balancer stops sending new requests,
+// waits for the requests in progress up to the drain timeout and for the background tasks, which stop with
+// the context of the server, and closes the databases of the vaults. If the requests are not drained in time
+// the databases are not closed, as the requests in progress may still use them, and the process just exits.
+func (s *Server) shutdown() {
+
+	s.draining.Store(true)
+
+	delay, err := issuance.ParseDuration(s.conf.Server.Shutdown.Delay)
+	if err != nil || delay < 0 {
+		delay = 0
+	}
+	drainTimeout, err := issuance.ParseDuration(s.conf.Server.Shutdown.DrainTimeout)
+	if err != nil || drainTimeout <= 0 {
+		drainTimeout = defaultDrainTimeout
+	}
+
+	// Give the orchestrator time to see that the server is not ready before closing the listener
+	s.logger.Infow("shutting down", "delay", delay.String(), "drainTimeout", drainTimeout.String())
+	time.Sleep(delay)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- s.Shutdown()
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			s.logger.Errorw("error shutting down the server", zap.Error(err))
+		} else {
+			s.logger.Infow("requests drained")
+		}
+	case <-time.After(drainTimeout):
+		s.logger.Warnw("drain timeout expired, exiting with requests in progress", "drainTimeout", drainTimeout.String())
+		return
+	}
+
+	s.background.Wait()
+
+	for _, v := range []*vault.Vault{s.issuerVault, s.verifierVault, s.walletvault, s.registryVault} {
+		if err := v.Client.Close(); err != nil {
+			s.logger.Errorw("error closing the database of a vault", zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// TestBackgroundTasksStop checks that the background tasks stop when the context of the server is done,
+// so the databases can be closed at shutdown
+func TestBackgroundTasksStop(t *testing.T) {
+	s := newTestServer(t, t.TempDir())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.loadPolicies(ctx)
+	s.startRenewalSweep(ctx)
+	cancel()
+
+	stopped := make(chan struct{})
+	go func() {
+		s.background.Wait()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the background tasks did not stop")
+	}
+}
@@ -0,0 +1,98 @@
+// Package audit implements the hash chain of the audit log. Each entry includes the hash of the
+// previous one, so modifying, removing or reordering entries breaks the chain.
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+// Outcomes of an audited action
+const (
+	Success = "success"
+	Failure = "failure"
+)
+
+// GenesisHash is the previous hash of the first entry of a log
+const GenesisHash = "0000000000000000000000000000000000000000000000000000000000000000"
+
+// Entry is an entry of the audit log
+type Entry struct {
+	Seq        int            `json:"seq"`
+	Time       time.Time      `json:"time"`
+	Actor      string         `json:"actor"`
+	Action     string         `json:"action"`
+	TargetType string         `json:"targetType,omitempty"`
+	Target     string         `json:"target,omitempty"`
+	Outcome    string         `json:"outcome"`
+	RequestID  string         `json:"requestId,omitempty"`
+	Details    map[string]any `json:"details,omitempty"`
+	PrevHash   string         `json:"prevHash"`
+	Hash       string         `json:"hash"`
+}
+
+// Now returns the current time with the precision used in the entries, so it survives a round trip to the database
+func Now() time.Time {
+	return time.Now().UTC().Truncate(time.Microsecond)
+}
+
+// ComputeHash returns the hash of the entry, which covers all its fields and the hash of the previous entry
+func (e *Entry) ComputeHash() string {
+
+	canonical := struct {
+		Seq        int            `json:"seq"`
+		Time       string         `json:"time"`
+		Actor      string         `json:"actor"`
+		Action     string         `json:"action"`
+		TargetType string         `json:"targetType"`
+		Target     string         `json:"target"`
+		Outcome    string         `json:"outcome"`
+		RequestID  string         `json:"requestId"`
+		Details    map[string]any `json:"details"`
+		PrevHash   string         `json:"prevHash"`
+	}{
+		Seq:        e.Seq,
+		Time:       e.Time.UTC().Format(time.RFC3339Nano),
+		Actor:      e.Actor,
+		Action:     e.Action,
+		TargetType: e.TargetType,
+		Target:     e.Target,
+		Outcome:    e.Outcome,
+		RequestID:  e.RequestID,
+		Details:    e.Details,
+		PrevHash:   e.PrevHash,
+	}
+
+	// The keys of the maps are sorted when serialized, so the result is deterministic
+	b, _ := json.Marshal(canonical)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// Seal links the entry to the previous one and computes its hash
+func (e *Entry) Seal(prev *Entry) {
+	if prev == nil {
+		e.PrevHash = GenesisHash
+	} else {
+		e.PrevHash = prev.Hash
+	}
+	e.Hash = e.ComputeHash()
+}
+
+// Verify checks the chain of consecutive entries, starting after prev (nil for the start of the log).
+// It returns the sequence number of the first entry which does not match the chain, or 0 if all are valid.
+func Verify(prev *Entry, entries []*Entry) int {
+	for _, e := range entries {
+		expectedPrev := GenesisHash
+		if prev != nil {
+			expectedPrev = prev.Hash
+		}
+		if e.PrevHash != expectedPrev || e.Hash != e.ComputeHash() {
+			return e.Seq
+		}
+		prev = e
+	}
+	return 0
+}
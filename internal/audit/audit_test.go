@@ -0,0 +1,75 @@
+package audit
+
+import (
+	"testing"
+)
+
+func chain(n int) []*Entry {
+	var entries []*Entry
+	var prev *Entry
+	for i := 1; i <= n; i++ {
+		e := &Entry{
+			Seq:     i,
+			Time:    Now(),
+			Actor:   "alice",
+			Action:  "credential.issued",
+			Target:  "cred",
+			Outcome: Success,
+			Details: map[string]any{"status": 200.0, "path": "/issuer"},
+		}
+		e.Seal(prev)
+		entries = append(entries, e)
+		prev = e
+	}
+	return entries
+}
+
+func TestVerify(t *testing.T) {
+	entries := chain(5)
+
+	if entries[0].PrevHash != GenesisHash {
+		t.Errorf("PrevHash of the first entry = %s", entries[0].PrevHash)
+	}
+	if bad := Verify(nil, entries); bad != 0 {
+		t.Errorf("Verify() = %d for a valid chain", bad)
+	}
+
+	// Verify a segment of the chain
+	if bad := Verify(entries[1], entries[2:]); bad != 0 {
+		t.Errorf("Verify() = %d for a valid segment", bad)
+	}
+}
+
+func TestVerifyTampering(t *testing.T) {
+	tests := map[string]func(entries []*Entry) []*Entry{
+		"modified field": func(entries []*Entry) []*Entry {
+			entries[2].Actor = "mallory"
+			return entries
+		},
+		"modified details": func(entries []*Entry) []*Entry {
+			entries[2].Details["status"] = 500.0
+			return entries
+		},
+		"removed entry": func(entries []*Entry) []*Entry {
+			return append(entries[:2], entries[3:]...)
+		},
+		"reordered": func(entries []*Entry) []*Entry {
+			entries[2], entries[3] = entries[3], entries[2]
+			return entries
+		},
+		"rehashed entry": func(entries []*Entry) []*Entry {
+			entries[2].Outcome = Failure
+			entries[2].Hash = entries[2].ComputeHash()
+			return entries
+		},
+	}
+
+	for name, tamper := range tests {
+		t.Run(name, func(t *testing.T) {
+			entries := tamper(chain(5))
+			if bad := Verify(nil, entries); bad == 0 {
+				t.Error("Verify() did not detect the tampering")
+			}
+		})
+	}
+}
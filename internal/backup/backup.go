@@ -0,0 +1,291 @@
+// Package backup implements the archives of the backups of the vaults and of the exports of their users.
+//
+// An archive has the rows of some tables of a vault, with the names and the types of their columns,
+// so it can be restored into a database of any driver. The archive is serialized in JSON, compressed
+// with gzip and encrypted with AES-256-GCM, with a key derived from a passphrase with scrypt. The
+// encrypted file starts with a header with the version of the format, which is authenticated too.
+package backup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// FormatVersion is the version of the format of the archives written
+const FormatVersion = 1
+
+// magic identifies the files of the archives
+const magic = "VCBK"
+
+const (
+	saltSize  = 16
+	nonceSize = 12
+	keySize   = 32
+)
+
+var (
+	// ErrFormat is returned when the file is not an archive, or has a version of the format which is not supported
+	ErrFormat = errors.New("not a backup archive or unsupported version")
+	// ErrPassphrase is returned when the archive can not be decrypted, because the passphrase is wrong
+	// or the archive was modified
+	ErrPassphrase = errors.New("wrong passphrase or corrupted archive")
+)
+
+// Kinds of archives
+const (
+	// KindVault is the backup of all the tables of a vault
+	KindVault = "vault"
+	// KindUser is the export of a user of a vault, with its keys and DIDs
+	KindUser = "user"
+)
+
+// Types of the columns
+const (
+	TypeString = "string"
+	TypeInt    = "int"
+	TypeFloat  = "float"
+	TypeBool   = "bool"
+	TypeTime   = "time"
+	TypeBytes  = "bytes"
+	TypeJSON   = "json"
+)
+
+// Archive is the content of a backup or an export
+type Archive struct {
+	Format        int       `json:"format"`
+	Kind          string    `json:"kind"`
+	CreatedAt     time.Time `json:"createdAt"`
+	Driver        string    `json:"driver"`
+	SchemaVersion string    `json:"schemaVersion"`
+	Tables        []*Table  `json:"tables"`
+}
+
+// Table has the rows of a table, in the order of its columns
+type Table struct {
+	Name    string    `json:"name"`
+	Columns []*Column `json:"columns"`
+	Rows    [][]any   `json:"rows"`
+}
+
+// Column is a column of a table. References is the table referenced by a foreign key, and Increment
+// is set for the columns whose values are generated by the database.
+type Column struct {
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	References string `json:"references,omitempty"`
+	PrimaryKey bool   `json:"primaryKey,omitempty"`
+	Increment  bool   `json:"increment,omitempty"`
+}
+
+// Table returns the table of the archive with the name, or nil
+func (a *Archive) Table(name string) *Table {
+	for _, t := range a.Tables {
+		if t.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+// Counts returns the number of rows of each table
+func (a *Archive) Counts() map[string]int {
+	counts := map[string]int{}
+	for _, t := range a.Tables {
+		counts[t.Name] = len(t.Rows)
+	}
+	return counts
+}
+
+// EncodeValue converts a value read from the database to its representation in JSON: the times in
+// RFC 3339 with nanoseconds, the bytes in base64 and the JSON documents as strings
+func EncodeValue(typ string, value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	switch typ {
+	case TypeTime:
+		t, ok := value.(time.Time)
+		if !ok {
+			return nil, fmt.Errorf("expected a time, got %T", value)
+		}
+		return t.UTC().Format(time.RFC3339Nano), nil
+	case TypeBytes:
+		b, ok := value.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("expected bytes, got %T", value)
+		}
+		return base64.StdEncoding.EncodeToString(b), nil
+	case TypeString, TypeJSON:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case []byte:
+			return string(v), nil
+		}
+		return nil, fmt.Errorf("expected a string, got %T", value)
+	case TypeInt, TypeFloat, TypeBool:
+		return value, nil
+	}
+	return nil, fmt.Errorf("unknown column type %q", typ)
+}
+
+// DecodeValue converts a value of an archive to the value written to the database
+func DecodeValue(typ string, value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	switch typ {
+	case TypeTime:
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a time, got %T", value)
+		}
+		return time.Parse(time.RFC3339Nano, s)
+	case TypeBytes:
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected base64, got %T", value)
+		}
+		return base64.StdEncoding.DecodeString(s)
+	case TypeString, TypeJSON:
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string, got %T", value)
+		}
+		// The JSON documents are written as bytes, like ent writes them
+		if typ == TypeJSON {
+			return []byte(s), nil
+		}
+		return s, nil
+	case TypeInt:
+		n, ok := value.(json.Number)
+		if !ok {
+			return nil, fmt.Errorf("expected an integer, got %T", value)
+		}
+		return n.Int64()
+	case TypeFloat:
+		n, ok := value.(json.Number)
+		if !ok {
+			return nil, fmt.Errorf("expected a number, got %T", value)
+		}
+		return n.Float64()
+	case TypeBool:
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected a boolean, got %T", value)
+		}
+		return b, nil
+	}
+	return nil, fmt.Errorf("unknown column type %q", typ)
+}
+
+// deriveKey derives the key of the encryption from the passphrase
+func deriveKey(passphrase string, salt []byte) ([]byte, error) {
+	return scrypt.Key([]byte(passphrase), salt, 1<<15, 8, 1, keySize)
+}
+
+// Write compresses, encrypts and writes the archive
+func Write(w io.Writer, a *Archive, passphrase string) error {
+
+	if len(passphrase) == 0 {
+		return errors.New("the passphrase of the archive is required")
+	}
+
+	var plain bytes.Buffer
+	zw := gzip.NewWriter(&plain)
+	if err := json.NewEncoder(zw).Encode(a); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	header := make([]byte, len(magic)+1+saltSize+nonceSize)
+	copy(header, magic)
+	header[len(magic)] = FormatVersion
+	salt := header[len(magic)+1 : len(magic)+1+saltSize]
+	nonce := header[len(magic)+1+saltSize:]
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	aead, err := newAEAD(passphrase, salt)
+	if err != nil {
+		return err
+	}
+
+	// The header is authenticated with the content
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	_, err = w.Write(aead.Seal(nil, nonce, plain.Bytes(), header))
+	return err
+}
+
+// Read reads, decrypts and decompresses an archive
+func Read(r io.Reader, passphrase string) (*Archive, error) {
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	headerSize := len(magic) + 1 + saltSize + nonceSize
+	if len(data) < headerSize || string(data[:len(magic)]) != magic || data[len(magic)] != FormatVersion {
+		return nil, ErrFormat
+	}
+	header := data[:headerSize]
+	salt := header[len(magic)+1 : len(magic)+1+saltSize]
+	nonce := header[len(magic)+1+saltSize:]
+
+	aead, err := newAEAD(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	plain, err := aead.Open(nil, nonce, data[headerSize:], header)
+	if err != nil {
+		return nil, ErrPassphrase
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(plain))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrFormat, err)
+	}
+	dec := json.NewDecoder(zr)
+	dec.UseNumber()
+	a := &Archive{}
+	if err := dec.Decode(a); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrFormat, err)
+	}
+	if a.Format != FormatVersion {
+		return nil, ErrFormat
+	}
+
+	return a, nil
+}
+
+func newAEAD(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := deriveKey(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,116 @@
+package backup
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestValues(t *testing.T) {
+	now := time.Date(2023, 3, 1, 10, 20, 30, 123456789, time.UTC)
+
+	tests := []struct {
+		typ   string
+		value any
+		want  any
+	}{
+		{TypeString, "abc", "abc"},
+		{TypeString, []byte("abc"), "abc"},
+		{TypeJSON, []byte(`{"b":1,"a":[2]}`), []byte(`{"b":1,"a":[2]}`)},
+		{TypeTime, now, now},
+		{TypeBytes, []byte{0, 1, 2, 255}, []byte{0, 1, 2, 255}},
+		{TypeInt, int64(1) << 60, int64(1) << 60},
+		{TypeFloat, 1.5, 1.5},
+		{TypeBool, true, true},
+		{TypeTime, nil, nil},
+	}
+	for _, tt := range tests {
+		encoded, err := EncodeValue(tt.typ, tt.value)
+		if err != nil {
+			t.Fatalf("EncodeValue(%s, %v) error = %v", tt.typ, tt.value, err)
+		}
+
+		// The values are decoded from JSON, with the numbers as json.Number
+		b, _ := json.Marshal(encoded)
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.UseNumber()
+		var fromJSON any
+		if err := dec.Decode(&fromJSON); err != nil {
+			t.Fatal(err)
+		}
+
+		decoded, err := DecodeValue(tt.typ, fromJSON)
+		if err != nil {
+			t.Fatalf("DecodeValue(%s, %v) error = %v", tt.typ, fromJSON, err)
+		}
+		if !reflect.DeepEqual(decoded, tt.want) {
+			t.Errorf("%s: got %#v, want %#v", tt.typ, decoded, tt.want)
+		}
+	}
+
+	if _, err := EncodeValue(TypeTime, "2023-01-01"); err == nil {
+		t.Error("EncodeValue() of a string as a time should fail")
+	}
+	if _, err := DecodeValue("uuid", "x"); err == nil {
+		t.Error("DecodeValue() of an unknown type should fail")
+	}
+}
+
+func TestArchive(t *testing.T) {
+
+	a := &Archive{
+		Format:        FormatVersion,
+		Kind:          KindVault,
+		CreatedAt:     time.Now().UTC().Truncate(time.Second),
+		Driver:        "sqlite3",
+		SchemaVersion: "20230101120000",
+		Tables: []*Table{{
+			Name:    "users",
+			Columns: []*Column{{Name: "id", Type: TypeString}, {Name: "created_at", Type: TypeTime}},
+			Rows:    [][]any{{"did:elsi:a", "2023-03-01T10:20:30Z"}, {"did:elsi:b", nil}},
+		}},
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, a, "secret"); err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(buf.Bytes(), []byte("did:elsi")) {
+		t.Error("the archive is not encrypted")
+	}
+
+	got, err := Read(bytes.NewReader(buf.Bytes()), "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Kind != a.Kind || !got.CreatedAt.Equal(a.CreatedAt) || got.SchemaVersion != a.SchemaVersion {
+		t.Errorf("Read() = %+v, want %+v", got, a)
+	}
+	if !reflect.DeepEqual(got.Counts(), map[string]int{"users": 2}) || got.Table("users").Rows[0][0] != "did:elsi:a" {
+		t.Errorf("Read() tables = %+v", got.Tables[0])
+	}
+	if got.Table("keys") != nil {
+		t.Error("Table() of a missing table should be nil")
+	}
+
+	if _, err := Read(bytes.NewReader(buf.Bytes()), "wrong"); !errors.Is(err, ErrPassphrase) {
+		t.Errorf("Read() with a wrong passphrase error = %v", err)
+	}
+
+	// The header is authenticated
+	tampered := append([]byte{}, buf.Bytes()...)
+	tampered[len(magic)+1] ^= 1
+	if _, err := Read(bytes.NewReader(tampered), "secret"); !errors.Is(err, ErrPassphrase) {
+		t.Errorf("Read() of a modified archive error = %v", err)
+	}
+
+	if _, err := Read(bytes.NewReader([]byte("PK\x03\x04 a zip file")), "secret"); !errors.Is(err, ErrFormat) {
+		t.Errorf("Read() of another file error = %v", err)
+	}
+	if err := Write(&buf, a, ""); err == nil {
+		t.Error("Write() without a passphrase should fail")
+	}
+}
@@ -0,0 +1,408 @@
+// Package config implements the configuration of the server: its structure, the defaults, the overrides
+// with environment variables, the secrets read from files and the validation at startup.
+//
+// The configuration file is merged over the defaults. Then, the secrets like passwords can be read
+// from files, with a key like "passwordFile" or an environment variable like VCB_ISSUER_PASSWORD_FILE.
+// Any key can be overridden with an environment variable, with the prefix VCB_ and the dotted path of
+// the key in uppercase with underscores, like VCB_SERVER_LISTENADDRESS for server.listenAddress.
+package config
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hesusruiz/vcutils/yaml"
+)
+
+// EnvPrefix is the prefix of the environment variables which override the configuration
+const EnvPrefix = "VCB_"
+
+// fileSuffix is the suffix of the keys and environment variables with the file of a secret
+const fileSuffix = "File"
+
+//go:embed defaults.yaml
+var defaults []byte
+
+// Config is the configuration of the server
+type Config struct {
+	Server             Server             `json:"server"`
+	Store              Store              `json:"store"`
+	Issuer             Issuer             `json:"issuer"`
+	Verifier           Verifier           `json:"verifier"`
+	VerifiableRegistry VerifiableRegistry `json:"verifiableregistry"`
+	Wallet             Wallet             `json:"wallet"`
+	SSIKit             SSIKit             `json:"ssikit"`
+	WebAuthn           WebAuthn           `json:"webauthn"`
+}
+
+type Server struct {
+	ListenAddress       string    `json:"listenAddress"`
+	StaticDir           string    `json:"staticDir"`
+	TemplateDir         string    `json:"templateDir"`
+	Environment         string    `json:"environment"`
+	LogLevel            string    `json:"loglevel"`
+	LogSensitiveData    bool      `json:"logSensitiveData"`
+	Health              Health    `json:"health"`
+	Shutdown            Shutdown  `json:"shutdown"`
+	Metrics             Metrics   `json:"metrics"`
+	Tracing             Tracing   `json:"tracing"`
+	API                 API       `json:"api"`
+	RateLimit           RateLimit `json:"rateLimit"`
+	BodyLimit           int       `json:"bodyLimit"`
+	CredentialBodyLimit int       `json:"credentialBodyLimit"`
+	ExternalURL         string    `json:"externalURL"`
+	TLS                 TLS       `json:"tls"`
+	Proxy               Proxy     `json:"proxy"`
+}
+
+// TLS is the configuration of the certificate of the server, reloaded when its files change, and of the
+// clients authenticated with mutual TLS. ClientAuth is none, optional to accept the certificates of the
+// clients in the administration and M2M APIs besides the passwords, or required to accept only them.
+type TLS struct {
+	Enabled        bool   `json:"enabled"`
+	CertFile       string `json:"certFile"`
+	KeyFile        string `json:"keyFile"`
+	ReloadInterval string `json:"reloadInterval"`
+	ClientCAFile   string `json:"clientCAFile"`
+	ClientAuth     string `json:"clientAuth"`
+}
+
+// Proxy is the configuration of the reverse proxies in front of the server. Only the requests from the
+// trusted proxies, by address or CIDR range, can set the X-Forwarded-* headers, and Header is the one
+// with the address of the client, like X-Forwarded-For.
+type Proxy struct {
+	TrustedProxies []string `json:"trustedProxies"`
+	Header         string   `json:"header"`
+}
+
+// API is the configuration of the validation of the JSON API against its OpenAPI document
+type API struct {
+	ValidateResponses bool `json:"validateResponses"`
+}
+
+// RateLimit is the configuration of the limits of the requests of the clients, by route group.
+// Store is where the requests are counted: memory, or vault to share the counts among the
+// instances using the vault of the verifier.
+type RateLimit struct {
+	Enabled    bool           `json:"enabled"`
+	Store      string         `json:"store"`
+	Global     RateLimitGroup `json:"global"`
+	SIOP       RateLimitGroup `json:"siop"`
+	Credential RateLimitGroup `json:"credential"`
+	DID        RateLimitGroup `json:"did"`
+	Login      LoginLockout   `json:"login"`
+}
+
+// RateLimitGroup limits the requests to the routes of a group in a window, by IP address and by client.
+// A limit of zero does not limit the requests.
+type RateLimitGroup struct {
+	PerIP     int    `json:"perIP"`
+	PerClient int    `json:"perClient"`
+	Window    string `json:"window"`
+}
+
+// LoginLockout rejects the logins of a user from an address after some failed ones, during the lockout
+type LoginLockout struct {
+	MaxFailures int    `json:"maxFailures"`
+	Lockout     string `json:"lockout"`
+}
+
+// Tracing is the configuration of the export of the OpenTelemetry spans
+type Tracing struct {
+	Exporter    string  `json:"exporter"`
+	Endpoint    string  `json:"endpoint"`
+	Insecure    bool    `json:"insecure"`
+	ServiceName string  `json:"serviceName"`
+	SampleRatio float64 `json:"sampleRatio"`
+}
+
+// Metrics is the configuration of the endpoint for Prometheus
+type Metrics struct {
+	Enabled bool `json:"enabled"`
+}
+
+// Health is the configuration of the checks of the readiness endpoint
+type Health struct {
+	Timeout string `json:"timeout"`
+}
+
+// Shutdown is the configuration of the graceful shutdown of the server
+type Shutdown struct {
+	Delay        string `json:"delay"`
+	DrainTimeout string `json:"drainTimeout"`
+}
+
+// Store is the database of a vault. With AutoMigrate, the pending migrations are applied when the
+// vault is opened, instead of with the migrate command.
+type Store struct {
+	DriverName     string `json:"driverName"`
+	DataSourceName string `json:"dataSourceName"`
+	AutoMigrate    bool   `json:"autoMigrate"`
+}
+
+type Issuer struct {
+	ID                    string                    `json:"id"`
+	Name                  string                    `json:"name"`
+	Password              string                    `json:"password" secret:"true"`
+	Store                 Store                     `json:"store"`
+	IssuancePolicies      map[string]IssuancePolicy `json:"issuancePolicies"`
+	Renewal               Renewal                   `json:"renewal"`
+	CredentialTypesDir    string                    `json:"credentialTypesDir"`
+	DefaultCredentialType string                    `json:"defaultCredentialType"`
+	HolderBinding         HolderBinding             `json:"holderBinding"`
+	Approval              Approval                  `json:"approval"`
+	Operators             []Operator                `json:"operators"`
+}
+
+// IssuancePolicy is the validity of the credentials of a type
+type IssuancePolicy struct {
+	Validity          string `json:"validity,omitempty"`
+	MaxValidity       string `json:"maxValidity,omitempty"`
+	NotBeforeDelay    string `json:"notBeforeDelay,omitempty"`
+	NotAfter          string `json:"notAfter,omitempty"`
+	MaxValidFromDelay string `json:"maxValidFromDelay,omitempty"`
+}
+
+type Renewal struct {
+	DaysBefore        int    `json:"daysBefore"`
+	SweepInterval     string `json:"sweepInterval"`
+	AutoRenew         bool   `json:"autoRenew"`
+	RevokePredecessor bool   `json:"revokePredecessor"`
+}
+
+type HolderBinding struct {
+	ResolverURL string `json:"resolverURL"`
+}
+
+type Approval struct {
+	CredentialTypes []string `json:"credentialTypes"`
+}
+
+// Operator is a user of the issuer
+type Operator struct {
+	ID       string   `json:"id"`
+	Name     string   `json:"name"`
+	Password string   `json:"password" secret:"true"`
+	Roles    []string `json:"roles"`
+}
+
+type Verifier struct {
+	ID                string            `json:"id"`
+	Name              string            `json:"name"`
+	Password          string            `json:"password" secret:"true"`
+	Store             Store             `json:"store"`
+	ProtectedResource ProtectedResource `json:"protectedResource"`
+	Policies          Policies          `json:"policies"`
+	Nonce             Nonce             `json:"nonce"`
+	AccessToken       AccessToken       `json:"accessToken"`
+	PEP               PEP               `json:"pep"`
+	ForwardAuth       ForwardAuth       `json:"forwardAuth"`
+	TrustedIssuers    TrustedIssuers    `json:"trustedIssuers"`
+}
+
+type ProtectedResource struct {
+	URL     string `json:"url"`
+	Service string `json:"service"`
+}
+
+type Policies struct {
+	Dir            string `json:"dir"`
+	ReloadInterval string `json:"reloadInterval"`
+}
+
+type Nonce struct {
+	Lifetime string `json:"lifetime"`
+}
+
+type AccessToken struct {
+	Audience     string `json:"audience"`
+	Lifetime     string `json:"lifetime"`
+	CookieDomain string `json:"cookieDomain"`
+}
+
+// Route is a route of the policy enforcement point or of the forward authentication
+type Route struct {
+	Host        string   `json:"host,omitempty"`
+	Path        string   `json:"path"`
+	Upstream    string   `json:"upstream,omitempty"`
+	StripPrefix bool     `json:"stripPrefix,omitempty"`
+	Methods     []string `json:"methods,omitempty"`
+	Roles       []string `json:"roles,omitempty"`
+	Target      string   `json:"target,omitempty"`
+}
+
+// RouteList returns the routes as a list of maps, like in the configuration document, which is what the
+// policy enforcement point and the forward authentication read
+func RouteList(routes []Route) []any {
+	b, _ := json.Marshal(routes)
+	var list []any
+	json.Unmarshal(b, &list)
+	return list
+}
+
+type PEP struct {
+	Enabled bool    `json:"enabled"`
+	Prefix  string  `json:"prefix"`
+	Timeout string  `json:"timeout"`
+	Routes  []Route `json:"routes"`
+}
+
+type ForwardAuth struct {
+	Enabled      bool     `json:"enabled"`
+	Redirect     bool     `json:"redirect"`
+	LoginURL     string   `json:"loginURL"`
+	AllowedHosts []string `json:"allowedHosts"`
+	Routes       []Route  `json:"routes"`
+}
+
+type TrustedIssuers struct {
+	Enabled     bool   `json:"enabled"`
+	RegistryURL string `json:"registryURL"`
+}
+
+type VerifiableRegistry struct {
+	Password         string `json:"password" secret:"true"`
+	TrustLocalIssuer bool   `json:"trustLocalIssuer"`
+	Store            Store  `json:"store"`
+}
+
+type Wallet struct {
+	Store Store `json:"store"`
+}
+
+// SSIKit has the URLs of the services of the SSI Kit
+type SSIKit struct {
+	CoreURL      string `json:"coreURL"`
+	SignatoryURL string `json:"signatoryURL"`
+	AuditorURL   string `json:"auditorURL"`
+	CustodianURL string `json:"custodianURL"`
+	EssifURL     string `json:"essifURL"`
+}
+
+type WebAuthn struct {
+	RPDisplayName           string `json:"RPDisplayName"`
+	RPID                    string `json:"RPID"`
+	RPOrigin                string `json:"RPOrigin"`
+	AuthenticatorAttachment string `json:"AuthenticatorAttachment"`
+	UserVerification        string `json:"UserVerification"`
+}
+
+// Load reads the configuration file, applies the defaults, the secrets in files and the overrides in the
+// environment variables, and validates the result. It returns the typed configuration and the same
+// configuration as a document, for the components which look up the keys by their path. The keys
+// which are not set are not in the document, so the lookups get their own defaults.
+func Load(configFile string, environ []string) (*Config, *yaml.YAML, error) {
+
+	src, err := os.ReadFile(configFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading the configuration: %w", err)
+	}
+	return Parse(src, environ)
+}
+
+// Parse is like Load, for the contents of a configuration file
+func Parse(src []byte, environ []string) (*Config, *yaml.YAML, error) {
+
+	doc, err := document(defaults)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing the default configuration: %w", err)
+	}
+	file, err := document(src)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing the configuration: %w", err)
+	}
+	merge(doc, file)
+
+	if err := readSecrets(doc, configType, ""); err != nil {
+		return nil, nil, &ValidationError{Problems: []string{err.Error()}}
+	}
+
+	// The problems with the keys are reported together with the problems with the values
+	problems := applyEnvironment(doc, environ)
+	problems = append(problems, unknownKeys(doc, configType, "")...)
+
+	// Convert to the structure using its JSON representation
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return nil, nil, err
+	}
+	cfg := &Config{}
+	if err := json.Unmarshal(b, cfg); err != nil {
+		return nil, nil, &ValidationError{Problems: append(problems, typeProblem(err))}
+	}
+
+	var verr *ValidationError
+	if err := cfg.Validate(); errors.As(err, &verr) {
+		problems = append(problems, verr.Problems...)
+	}
+	if len(problems) > 0 {
+		return nil, nil, &ValidationError{Problems: problems}
+	}
+
+	return cfg, yaml.New(doc), nil
+}
+
+// document parses a YAML document whose root is a map
+func document(src []byte) (map[string]any, error) {
+	y, err := yaml.ParseYamlBytes(src)
+	if err != nil {
+		return nil, err
+	}
+	if y.Data() == nil {
+		return map[string]any{}, nil
+	}
+	doc, ok := y.Data().(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("the root of the configuration must be a map")
+	}
+	return doc, nil
+}
+
+// merge sets the values of src in dst, merging the maps recursively and replacing the other values
+func merge(dst map[string]any, src map[string]any) {
+	for k, v := range src {
+		if sv, ok := v.(map[string]any); ok {
+			if dv, ok := dst[k].(map[string]any); ok {
+				merge(dv, sv)
+				continue
+			}
+		}
+		dst[k] = v
+	}
+}
+
+// typeProblem describes an error converting the configuration to its structure
+func typeProblem(err error) string {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return fmt.Sprintf("%s must be of type %s, not %s", typeErr.Field, typeErr.Type, typeErr.Value)
+	}
+	return err.Error()
+}
+
+// Redacted returns a copy of the configuration without the values of the secrets
+func (c *Config) Redacted() *Config {
+	b, _ := json.Marshal(c)
+	var data map[string]any
+	json.Unmarshal(b, &data)
+	redact(data, configType)
+	r := &Config{}
+	b, _ = json.Marshal(data)
+	json.Unmarshal(b, r)
+	return r
+}
+
+// String returns the configuration as indented JSON, without the values of the secrets
+func (c *Config) String() string {
+	b, _ := json.MarshalIndent(c.Redacted(), "", "  ")
+	return string(b)
+}
+
+// envKey returns the name of the environment variable which overrides a key, like VCB_SERVER_LISTENADDRESS
+func envKey(path string) string {
+	return EnvPrefix + strings.ToUpper(strings.ReplaceAll(path, ".", "_"))
+}
@@ -0,0 +1,254 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const minimal = `
+issuer:
+  id: HappyPets
+  password: ThePassword
+  issuancePolicies:
+    PacketDeliveryCredential:
+      validity: 90d
+  operators:
+    - id: alice
+      password: ThePassword
+verifier:
+  id: PacketDelivery
+  password: ThePassword
+verifiableregistry:
+  password: ThePassword
+ssikit:
+  coreURL: localhost:7000
+  signatoryURL: http://localhost:7001
+  auditorURL: localhost:7002
+  custodianURL: localhost:7003
+  essifURL: localhost:7010
+`
+
+func TestRepositoryConfig(t *testing.T) {
+	if _, _, err := Load("../../configs/server.yaml", nil); err != nil {
+		t.Errorf("Load() of the configuration of the repository error = %v", err)
+	}
+}
+
+func TestDefaults(t *testing.T) {
+	cfg, doc, err := Parse([]byte(minimal), nil)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if cfg.Server.ListenAddress != "0.0.0.0:3000" || cfg.Verifier.AccessToken.Lifetime != "1h" || cfg.Issuer.Renewal.DaysBefore != 30 {
+		t.Errorf("Parse() defaults = %+v", cfg)
+	}
+	if doc.String("verifier.store.driverName") != "sqlite3" || doc.String("verifier.id") != "PacketDelivery" {
+		t.Errorf("Parse() document = %v", doc.Data())
+	}
+
+	// The keys without a default are not in the document
+	if audience := doc.String("verifier.accessToken.audience", "fallback"); audience != "fallback" {
+		t.Errorf("Parse() document has the audience %q", audience)
+	}
+}
+
+func TestEnvironment(t *testing.T) {
+	environ := []string{
+		"VCB_SERVER_LISTENADDRESS=127.0.0.1:8080",
+		"VCB_VERIFIER_PEP_ENABLED=true",
+		`VCB_VERIFIER_PEP_ROUTES=[{"path": "/orders", "upstream": "http://orders:8080"}]`,
+		"VCB_VERIFIER_FORWARDAUTH_ALLOWEDHOSTS=a.example.com, b.example.com",
+		"VCB_ISSUER_RENEWAL_DAYSBEFORE=10",
+		"VCB_ISSUER_ISSUANCEPOLICIES_PACKETDELIVERYCREDENTIAL_VALIDITY=30d",
+		"VCB_ISSUER_OPERATORS_0_NAME=Alice",
+		"VCB_SERVER_TRACING_SAMPLERATIO=0.25",
+		"PATH=/bin",
+	}
+	cfg, doc, err := Parse([]byte(minimal), environ)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if cfg.Server.ListenAddress != "127.0.0.1:8080" || !cfg.Verifier.PEP.Enabled || cfg.Verifier.PEP.Routes[0].Path != "/orders" ||
+		len(cfg.Verifier.ForwardAuth.AllowedHosts) != 2 || cfg.Verifier.ForwardAuth.AllowedHosts[1] != "b.example.com" ||
+		cfg.Issuer.Renewal.DaysBefore != 10 || cfg.Issuer.IssuancePolicies["PacketDeliveryCredential"].Validity != "30d" ||
+		cfg.Issuer.Operators[0].Name != "Alice" || cfg.Server.Tracing.SampleRatio != 0.25 {
+		t.Errorf("Parse() with the environment = %+v", cfg)
+	}
+	if !doc.Bool("verifier.pep.enabled") || doc.Int("issuer.renewal.daysBefore") != 10 {
+		t.Errorf("Parse() document with the environment = %v", doc.Data())
+	}
+
+	invalid := []string{
+		"VCB_SERVER_LISTENADRESS=x",
+		"VCB_VERIFIER_PEP_ENABLED=yes",
+		"VCB_ISSUER_ISSUANCEPOLICIES_OTHER_VALIDITY=1d",
+		"VCB_ISSUER_OPERATORS_5_NAME=x",
+		"VCB_SERVER_LISTENADDRESS_FILE=/tmp/x",
+		"VCB_SERVER_TRACING_SAMPLERATIO=half",
+		"VCB_SERVER_TRACING_SAMPLERATIO=2",
+	}
+	for _, env := range invalid {
+		if _, _, err := Parse([]byte(minimal), []string{env}); err == nil {
+			t.Errorf("Parse() with %s error = nil", env)
+		}
+	}
+}
+
+func TestSecrets(t *testing.T) {
+	dir := t.TempDir()
+	for name, secret := range map[string]string{"issuer": "IssuerSecret\n", "alice": "AliceSecret", "verifier": "VerifierSecret\n"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(secret), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	src := strings.Replace(minimal, "  password: ThePassword\n  issuancePolicies", "  passwordFile: "+filepath.Join(dir, "issuer")+"\n  issuancePolicies", 1)
+	src = strings.Replace(src, "      password: ThePassword", "      passwordFile: "+filepath.Join(dir, "alice"), 1)
+	environ := []string{"VCB_VERIFIER_PASSWORD_FILE=" + filepath.Join(dir, "verifier")}
+
+	cfg, doc, err := Parse([]byte(src), environ)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if cfg.Issuer.Password != "IssuerSecret" || cfg.Issuer.Operators[0].Password != "AliceSecret" || cfg.Verifier.Password != "VerifierSecret" {
+		t.Errorf("Parse() secrets = %q, %q, %q", cfg.Issuer.Password, cfg.Issuer.Operators[0].Password, cfg.Verifier.Password)
+	}
+	if doc.String("issuer.password") != "IssuerSecret" {
+		t.Errorf("Parse() document secret = %q", doc.String("issuer.password"))
+	}
+
+	if s := cfg.String(); strings.Contains(s, "Secret") || !strings.Contains(s, "********") {
+		t.Errorf("String() shows the secrets: %s", s)
+	}
+	if cfg.Issuer.Password != "IssuerSecret" {
+		t.Errorf("String() changed the configuration")
+	}
+
+	missing := strings.Replace(minimal, "  password: ThePassword\n  issuancePolicies", "  passwordFile: "+filepath.Join(dir, "none")+"\n  issuancePolicies", 1)
+	if _, _, err := Parse([]byte(missing), nil); err == nil {
+		t.Errorf("Parse() with a missing secret file error = nil")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	src := minimal + `
+server:
+  environment: staging
+  listenAdress: ":3000"
+`
+	_, _, err := Parse([]byte(src), nil)
+	var verr *ValidationError
+	if !errors.As(err, &verr) || len(verr.Problems) != 2 || verr.Problems[0] != "unknown key server.listenAdress" {
+		t.Fatalf("Parse() with an unknown key error = %v", err)
+	}
+
+	src = strings.Replace(minimal, "  coreURL: localhost:7000\n", "", 1) + `
+server:
+  environment: staging
+verifier:
+  nonce:
+    lifetime: soon
+  pep:
+    enabled: true
+    routes:
+      - path: /orders
+        upstream: orders
+`
+	_, _, err = Parse([]byte(src), nil)
+	if !errors.As(err, &verr) {
+		t.Fatalf("Parse() of an invalid configuration error = %v", err)
+	}
+	want := []string{"server.environment", "ssikit.coreURL is required", "verifier.nonce.lifetime", "verifier.pep.routes", "verifier.id is required", "verifier.password is required"}
+	for _, w := range want {
+		if !strings.Contains(err.Error(), w) {
+			t.Errorf("Parse() error does not report %s:\n%v", w, err)
+		}
+	}
+
+	src = minimal + `
+server:
+  credentialBodyLimit: 8388608
+  rateLimit:
+    store: redis
+    did:
+      perIP: -1
+    siop:
+      window: often
+`
+	_, _, err = Parse([]byte(src), nil)
+	if !errors.As(err, &verr) {
+		t.Fatalf("Parse() of invalid rate limits error = %v", err)
+	}
+	want = []string{"server.rateLimit.store", "server.rateLimit.did.perIP", "server.rateLimit.siop.window", "server.credentialBodyLimit"}
+	for _, w := range want {
+		if !strings.Contains(err.Error(), w) {
+			t.Errorf("Parse() error does not report %s:\n%v", w, err)
+		}
+	}
+
+	src = minimal + `
+server:
+  externalURL: vc.example.com
+  tls:
+    clientAuth: required
+  proxy:
+    trustedProxies: ["10.0.0.0/8", "proxy"]
+`
+	_, _, err = Parse([]byte(src), nil)
+	if !errors.As(err, &verr) {
+		t.Fatalf("Parse() of an invalid TLS configuration error = %v", err)
+	}
+	want = []string{"server.externalURL", "needs server.tls.enabled", "server.tls.clientCAFile", "server.proxy.trustedProxies[1]"}
+	for _, w := range want {
+		if !strings.Contains(err.Error(), w) {
+			t.Errorf("Parse() error does not report %s:\n%v", w, err)
+		}
+	}
+	if strings.Contains(err.Error(), "trustedProxies[0]") {
+		t.Errorf("Parse() error reports a valid CIDR range:\n%v", err)
+	}
+
+	// Without the password of the registry, the admin user would be accepted with an empty password
+	src = strings.Replace(minimal, "verifiableregistry:\n  password: ThePassword\n", "", 1) + `
+verifier:
+  id: PacketDelivery
+  password: ThePassword
+  pep:
+    enabled: true
+    timeout: ""
+  accessToken:
+    lifetime: ""
+webauthn:
+  RPID: example.com
+  RPOrigin: https://login.example.org
+  AuthenticatorAttachment: usb
+  UserVerification: always
+`
+	_, _, err = Parse([]byte(src), nil)
+	if !errors.As(err, &verr) {
+		t.Fatalf("Parse() of an invalid registry and WebAuthn configuration error = %v", err)
+	}
+	want = []string{"verifiableregistry.password is required", "verifier.pep.timeout is required", "verifier.accessToken.lifetime is required",
+		"webauthn.RPDisplayName is required", "webauthn.RPID must be the host", "webauthn.AuthenticatorAttachment", "webauthn.UserVerification"}
+	for _, w := range want {
+		if !strings.Contains(err.Error(), w) {
+			t.Errorf("Parse() error does not report %s:\n%v", w, err)
+		}
+	}
+
+	src = minimal + `
+webauthn:
+  RPDisplayName: Packet Delivery
+  RPID: example.com
+  RPOrigin: https://login.example.com
+  UserVerification: required
+`
+	if _, _, err := Parse([]byte(src), nil); err != nil {
+		t.Errorf("Parse() of a valid WebAuthn configuration error = %v", err)
+	}
+}
@@ -0,0 +1,320 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var configType = reflect.TypeOf(Config{})
+
+// key returns the name of the key of a field in the configuration
+func key(f reflect.StructField) string {
+	return strings.Split(f.Tag.Get("json"), ",")[0]
+}
+
+// field returns the field of the structure for a key. Environment variables are matched ignoring the case.
+func field(t reflect.Type, name string, ignoreCase bool) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if k := key(f); k == name || (ignoreCase && strings.EqualFold(k, name)) {
+			return f, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+func isSecret(f reflect.StructField) bool {
+	return f.Tag.Get("secret") == "true"
+}
+
+func join(path string, name string) string {
+	if len(path) == 0 {
+		return name
+	}
+	return path + "." + name
+}
+
+// children calls fn for each value in the document which is a structure of the configuration,
+// with its path and its type
+func children(value any, t reflect.Type, path string, fn func(doc map[string]any, t reflect.Type, path string)) {
+	switch t.Kind() {
+	case reflect.Struct:
+		if m, ok := value.(map[string]any); ok {
+			fn(m, t, path)
+		}
+	case reflect.Slice:
+		if list, ok := value.([]any); ok {
+			for i, item := range list {
+				children(item, t.Elem(), join(path, strconv.Itoa(i)), fn)
+			}
+		}
+	case reflect.Map:
+		if m, ok := value.(map[string]any); ok {
+			for k, item := range m {
+				children(item, t.Elem(), join(path, k), fn)
+			}
+		}
+	}
+}
+
+// readSecrets replaces the keys with the file of a secret, like "passwordFile", by the contents of the file
+func readSecrets(doc map[string]any, t reflect.Type, path string) error {
+
+	var err error
+	var walk func(doc map[string]any, t reflect.Type, path string)
+	walk = func(doc map[string]any, t reflect.Type, path string) {
+		for i := 0; i < t.NumField() && err == nil; i++ {
+			f := t.Field(i)
+			name := key(f)
+
+			if fileName, ok := doc[name+fileSuffix]; ok && isSecret(f) {
+				var secret string
+				if secret, err = readSecret(join(path, name+fileSuffix), fileName); err != nil {
+					return
+				}
+				doc[name] = secret
+				delete(doc, name+fileSuffix)
+			}
+
+			children(doc[name], f.Type, join(path, name), walk)
+		}
+	}
+	walk(doc, t, path)
+
+	return err
+}
+
+// readSecret returns the contents of the file of a secret, without the final line break
+func readSecret(source string, fileName any) (string, error) {
+	name, ok := fileName.(string)
+	if !ok || len(name) == 0 {
+		return "", fmt.Errorf("%s must be the name of a file", source)
+	}
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return "", fmt.Errorf("%s: %v", source, err)
+	}
+	return strings.TrimRight(string(b), "\r\n"), nil
+}
+
+// unknownKeys returns the keys in the document which are not part of the configuration
+func unknownKeys(doc map[string]any, t reflect.Type, path string) []string {
+
+	var unknown []string
+	var walk func(doc map[string]any, t reflect.Type, path string)
+	walk = func(doc map[string]any, t reflect.Type, path string) {
+		for name, value := range doc {
+			f, ok := field(t, name, false)
+			if !ok {
+				unknown = append(unknown, "unknown key "+join(path, name))
+				continue
+			}
+			children(value, f.Type, join(path, name), walk)
+		}
+	}
+	walk(doc, t, path)
+
+	sort.Strings(unknown)
+	return unknown
+}
+
+// applyEnvironment sets the values of the environment variables with the prefix in the document,
+// returning the problems with the variables which can not be applied
+func applyEnvironment(doc map[string]any, environ []string) []string {
+
+	sort.Strings(environ)
+
+	var problems []string
+	for _, env := range environ {
+		name, value, _ := strings.Cut(env, "=")
+		if !strings.HasPrefix(name, EnvPrefix) || len(name) == len(EnvPrefix) {
+			continue
+		}
+		segments := strings.Split(strings.TrimPrefix(name, EnvPrefix), "_")
+
+		// The variables like VCB_ISSUER_PASSWORD_FILE have the file of a secret
+		n := len(segments)
+		if n > 1 && strings.EqualFold(segments[n-1], fileSuffix) {
+			if err := set(doc, segments[:n-1], name, value, true); err == nil {
+				continue
+			} else if _, ok := err.(*notSecretError); !ok {
+				problems = append(problems, err.Error())
+				continue
+			}
+		}
+
+		if err := set(doc, segments, name, value, false); err != nil {
+			problems = append(problems, err.Error())
+		}
+	}
+
+	return problems
+}
+
+// notSecretError is returned when the file of a key which is not a secret is set in the environment
+type notSecretError struct {
+	env string
+}
+
+func (e *notSecretError) Error() string {
+	return e.env + ": the key is not a secret which can be read from a file"
+}
+
+// set assigns the value of the environment variable to the key with the path in the segments,
+// following the structure of the configuration to find the names of the keys and the type of the value
+func set(doc map[string]any, segments []string, env string, value string, secretFile bool) error {
+
+	var container any = doc
+	t := configType
+	path := ""
+
+	for i, segment := range segments {
+
+		var get func() any
+		var put func(any)
+		var ft reflect.Type
+		secret := false
+
+		switch t.Kind() {
+		case reflect.Struct:
+			f, ok := field(t, segment, true)
+			if !ok {
+				return fmt.Errorf("%s: unknown key %s", env, join(path, strings.ToLower(segment)))
+			}
+			m := container.(map[string]any)
+			name := key(f)
+			get = func() any { return m[name] }
+			put = func(v any) { m[name] = v }
+			ft, secret, path = f.Type, isSecret(f), join(path, name)
+
+		case reflect.Map:
+			m := container.(map[string]any)
+			name := ""
+			for k := range m {
+				if strings.EqualFold(k, segment) {
+					name = k
+				}
+			}
+			if len(name) == 0 {
+				return fmt.Errorf("%s: there is no %s in %s", env, strings.ToLower(segment), path)
+			}
+			get = func() any { return m[name] }
+			put = func(v any) { m[name] = v }
+			ft, path = t.Elem(), join(path, name)
+
+		case reflect.Slice:
+			list := container.([]any)
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(list) {
+				return fmt.Errorf("%s: there is no element %s in %s", env, segment, path)
+			}
+			get = func() any { return list[index] }
+			put = func(v any) { list[index] = v }
+			ft, path = t.Elem(), join(path, segment)
+
+		default:
+			return fmt.Errorf("%s: unknown key %s", env, join(path, strings.ToLower(segment)))
+		}
+
+		if i == len(segments)-1 {
+			if secretFile {
+				if !secret {
+					return &notSecretError{env: env}
+				}
+				s, err := readSecret(env, value)
+				if err != nil {
+					return err
+				}
+				put(s)
+				return nil
+			}
+			v, err := convert(value, ft)
+			if err != nil {
+				return fmt.Errorf("%s: %s %v", env, path, err)
+			}
+			put(v)
+			return nil
+		}
+
+		next := get()
+		switch ft.Kind() {
+		case reflect.Struct, reflect.Map:
+			if _, ok := next.(map[string]any); !ok {
+				next = map[string]any{}
+				put(next)
+			}
+		case reflect.Slice:
+			if _, ok := next.([]any); !ok {
+				next = []any{}
+				put(next)
+			}
+		}
+		container, t = next, ft
+	}
+
+	return nil
+}
+
+// convert parses the value of an environment variable as the type of the key. The lists of strings
+// are separated by commas, and the other lists and the maps are in JSON.
+func convert(value string, t reflect.Type) (any, error) {
+	switch t.Kind() {
+	case reflect.String:
+		return value, nil
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("must be true or false")
+		}
+		return b, nil
+	case reflect.Int:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("must be an integer")
+		}
+		return n, nil
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("must be a number")
+		}
+		return f, nil
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.String && !strings.HasPrefix(strings.TrimSpace(value), "[") {
+			list := []any{}
+			for _, item := range strings.Split(value, ",") {
+				if item = strings.TrimSpace(item); len(item) > 0 {
+					list = append(list, item)
+				}
+			}
+			return list, nil
+		}
+	}
+
+	var v any
+	if err := json.Unmarshal([]byte(value), &v); err != nil {
+		return nil, fmt.Errorf("must be in JSON: %v", err)
+	}
+	return v, nil
+}
+
+// redact replaces the values of the secrets in the document
+func redact(doc map[string]any, t reflect.Type) {
+	var walk func(doc map[string]any, t reflect.Type, path string)
+	walk = func(doc map[string]any, t reflect.Type, path string) {
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			name := key(f)
+			if s, ok := doc[name].(string); ok && isSecret(f) && len(s) > 0 {
+				doc[name] = "********"
+			}
+			children(doc[name], f.Type, join(path, name), walk)
+		}
+	}
+	walk(doc, t, "")
+}
@@ -0,0 +1,249 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/hesusruiz/vcbackend/internal/issuance"
+	"github.com/hesusruiz/vcbackend/internal/pep"
+)
+
+// Drivers are the database drivers supported by the vaults
+var Drivers = []string{"sqlite3", "postgres", "mysql"}
+
+// ValidationError has all the problems found in the configuration
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid configuration:\n  - " + strings.Join(e.Problems, "\n  - ")
+}
+
+// problems collects the problems found in the configuration
+type problems []string
+
+func (p *problems) add(format string, args ...any) {
+	*p = append(*p, fmt.Sprintf(format, args...))
+}
+
+func (p *problems) required(key string, value string) {
+	if len(strings.TrimSpace(value)) == 0 {
+		p.add("%s is required", key)
+	}
+}
+
+func (p *problems) oneOf(key string, value string, allowed ...string) {
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+	p.add("%s must be one of %s, not %q", key, strings.Join(allowed, ", "), value)
+}
+
+// duration checks a duration like 10s, 1h or 30d, which must be positive when it is set
+func (p *problems) duration(key string, value string) {
+	if len(value) == 0 {
+		return
+	}
+	if d, err := issuance.ParseDuration(value); err != nil || d <= 0 {
+		p.add("%s must be a positive duration like 30s, 1h or 7d, not %q", key, value)
+	}
+}
+
+// url checks a URL, which must be absolute when it is set
+func (p *problems) url(key string, value string) {
+	if len(value) == 0 {
+		return
+	}
+	if u, err := url.Parse(value); err != nil || len(u.Scheme) == 0 || len(u.Host) == 0 {
+		p.add("%s must be an absolute URL, not %q", key, value)
+	}
+}
+
+func (p *problems) store(key string, s Store) {
+	p.oneOf(key+".driverName", s.DriverName, Drivers...)
+	p.required(key+".dataSourceName", s.DataSourceName)
+}
+
+// routes checks the routes of the policy enforcement point or of the forward authentication
+func (p *problems) routes(key string, routes []Route, upstream bool) {
+
+	// The routes are checked by the component which uses them
+	var err error
+	if upstream {
+		_, err = pep.NewRouter(RouteList(routes))
+	} else {
+		_, err = pep.NewRules(RouteList(routes))
+	}
+	if err != nil {
+		p.add("%s: %v", key, err)
+	}
+}
+
+// webAuthn checks the relying party of WebAuthn, whose id must be the host of its origin or a parent domain
+func (p *problems) webAuthn(w WebAuthn) {
+
+	p.required("webauthn.RPDisplayName", w.RPDisplayName)
+	p.required("webauthn.RPID", w.RPID)
+	p.required("webauthn.RPOrigin", w.RPOrigin)
+	p.url("webauthn.RPOrigin", w.RPOrigin)
+	if u, err := url.Parse(w.RPOrigin); err == nil && len(u.Host) > 0 && len(w.RPID) > 0 {
+		if host := u.Hostname(); host != w.RPID && !strings.HasSuffix(host, "."+w.RPID) {
+			p.add("webauthn.RPID must be the host of webauthn.RPOrigin or a parent domain, not %q", w.RPID)
+		}
+	}
+	if len(w.AuthenticatorAttachment) > 0 {
+		p.oneOf("webauthn.AuthenticatorAttachment", w.AuthenticatorAttachment, "platform", "cross-platform")
+	}
+	if len(w.UserVerification) > 0 {
+		p.oneOf("webauthn.UserVerification", w.UserVerification, "required", "preferred", "discouraged")
+	}
+}
+
+// Validate checks the configuration, returning a ValidationError with all the problems found
+func (c *Config) Validate() error {
+
+	var p problems
+
+	p.required("server.listenAddress", c.Server.ListenAddress)
+	p.oneOf("server.environment", c.Server.Environment, "development", "production")
+	p.oneOf("server.loglevel", strings.ToUpper(c.Server.LogLevel), "DEBUG", "INFO", "WARN", "ERROR")
+	p.duration("server.health.timeout", c.Server.Health.Timeout)
+	p.duration("server.shutdown.drainTimeout", c.Server.Shutdown.DrainTimeout)
+	if d, err := issuance.ParseDuration(c.Server.Shutdown.Delay); err != nil || d < 0 {
+		p.add("server.shutdown.delay must be a duration like 5s, not %q", c.Server.Shutdown.Delay)
+	}
+	p.oneOf("server.tracing.exporter", c.Server.Tracing.Exporter, "none", "stdout", "otlp")
+	if r := c.Server.Tracing.SampleRatio; r < 0 || r > 1 {
+		p.add("server.tracing.sampleRatio must be between 0 and 1, not %v", r)
+	}
+	p.oneOf("server.rateLimit.store", c.Server.RateLimit.Store, "memory", "vault")
+	for _, g := range []struct {
+		name string
+		RateLimitGroup
+	}{
+		{"global", c.Server.RateLimit.Global},
+		{"siop", c.Server.RateLimit.SIOP},
+		{"credential", c.Server.RateLimit.Credential},
+		{"did", c.Server.RateLimit.DID},
+	} {
+		key := "server.rateLimit." + g.name
+		if g.PerIP < 0 || g.PerClient < 0 {
+			p.add("%s.perIP and %s.perClient can not be negative", key, key)
+		}
+		if g.PerIP > 0 || g.PerClient > 0 {
+			p.required(key+".window", g.Window)
+		}
+		p.duration(key+".window", g.Window)
+	}
+	if c.Server.RateLimit.Login.MaxFailures < 0 {
+		p.add("server.rateLimit.login.maxFailures can not be negative, not %d", c.Server.RateLimit.Login.MaxFailures)
+	}
+	if c.Server.RateLimit.Login.MaxFailures > 0 {
+		p.required("server.rateLimit.login.lockout", c.Server.RateLimit.Login.Lockout)
+	}
+	p.duration("server.rateLimit.login.lockout", c.Server.RateLimit.Login.Lockout)
+	if c.Server.BodyLimit <= 0 {
+		p.add("server.bodyLimit must be a positive number of bytes, not %d", c.Server.BodyLimit)
+	}
+	if c.Server.CredentialBodyLimit <= 0 || c.Server.CredentialBodyLimit > c.Server.BodyLimit {
+		p.add("server.credentialBodyLimit must be a positive number of bytes up to server.bodyLimit, not %d", c.Server.CredentialBodyLimit)
+	}
+	p.url("server.externalURL", c.Server.ExternalURL)
+	if c.Server.TLS.Enabled {
+		p.required("server.tls.certFile", c.Server.TLS.CertFile)
+		p.required("server.tls.keyFile", c.Server.TLS.KeyFile)
+	}
+	p.duration("server.tls.reloadInterval", c.Server.TLS.ReloadInterval)
+	p.oneOf("server.tls.clientAuth", c.Server.TLS.ClientAuth, "none", "optional", "required")
+	if c.Server.TLS.ClientAuth == "optional" || c.Server.TLS.ClientAuth == "required" {
+		if !c.Server.TLS.Enabled {
+			p.add("server.tls.clientAuth %s needs server.tls.enabled", c.Server.TLS.ClientAuth)
+		}
+		p.required("server.tls.clientCAFile", c.Server.TLS.ClientCAFile)
+	}
+	for i, proxy := range c.Server.Proxy.TrustedProxies {
+		_, _, err := net.ParseCIDR(proxy)
+		if err != nil && net.ParseIP(proxy) == nil {
+			p.add("server.proxy.trustedProxies[%d] must be an IP address or a CIDR range, not %q", i, proxy)
+		}
+	}
+	p.store("store", c.Store)
+
+	// Issuer
+	p.required("issuer.id", c.Issuer.ID)
+	p.store("issuer.store", c.Issuer.Store)
+	policies := map[string]any{}
+	for name, policy := range c.Issuer.IssuancePolicies {
+		policies[name] = map[string]any{
+			"validity":          policy.Validity,
+			"maxValidity":       policy.MaxValidity,
+			"notBeforeDelay":    policy.NotBeforeDelay,
+			"notAfter":          policy.NotAfter,
+			"maxValidFromDelay": policy.MaxValidFromDelay,
+		}
+	}
+	if _, err := issuance.FromConfig(policies); err != nil {
+		p.add("issuer.issuancePolicies: %v", err)
+	}
+	if c.Issuer.Renewal.DaysBefore < 0 {
+		p.add("issuer.renewal.daysBefore can not be negative")
+	}
+	p.required("issuer.renewal.sweepInterval", c.Issuer.Renewal.SweepInterval)
+	p.duration("issuer.renewal.sweepInterval", c.Issuer.Renewal.SweepInterval)
+	p.url("issuer.holderBinding.resolverURL", c.Issuer.HolderBinding.ResolverURL)
+	for i, op := range c.Issuer.Operators {
+		p.required(fmt.Sprintf("issuer.operators.%d.id", i), op.ID)
+		p.required(fmt.Sprintf("issuer.operators.%d.password", i), op.Password)
+	}
+
+	// Verifier
+	p.required("verifier.id", c.Verifier.ID)
+	p.required("verifier.password", c.Verifier.Password)
+	p.store("verifier.store", c.Verifier.Store)
+	p.url("verifier.protectedResource.url", c.Verifier.ProtectedResource.URL)
+	p.required("verifier.protectedResource.service", c.Verifier.ProtectedResource.Service)
+	if len(c.Verifier.Policies.Dir) > 0 {
+		p.required("verifier.policies.reloadInterval", c.Verifier.Policies.ReloadInterval)
+	}
+	p.duration("verifier.policies.reloadInterval", c.Verifier.Policies.ReloadInterval)
+	p.required("verifier.nonce.lifetime", c.Verifier.Nonce.Lifetime)
+	p.duration("verifier.nonce.lifetime", c.Verifier.Nonce.Lifetime)
+	p.required("verifier.accessToken.lifetime", c.Verifier.AccessToken.Lifetime)
+	p.duration("verifier.accessToken.lifetime", c.Verifier.AccessToken.Lifetime)
+	if c.Verifier.PEP.Enabled {
+		p.required("verifier.pep.prefix", strings.Trim(c.Verifier.PEP.Prefix, "/"))
+		p.required("verifier.pep.timeout", c.Verifier.PEP.Timeout)
+		p.duration("verifier.pep.timeout", c.Verifier.PEP.Timeout)
+		p.routes("verifier.pep.routes", c.Verifier.PEP.Routes, true)
+	}
+	if c.Verifier.ForwardAuth.Enabled {
+		p.required("verifier.forwardAuth.loginURL", c.Verifier.ForwardAuth.LoginURL)
+		p.routes("verifier.forwardAuth.routes", c.Verifier.ForwardAuth.Routes, false)
+	}
+	p.url("verifier.trustedIssuers.registryURL", c.Verifier.TrustedIssuers.RegistryURL)
+
+	// Other components
+	p.required("verifiableregistry.password", c.VerifiableRegistry.Password)
+	p.store("verifiableregistry.store", c.VerifiableRegistry.Store)
+	p.store("wallet.store", c.Wallet.Store)
+
+	p.required("ssikit.coreURL", c.SSIKit.CoreURL)
+	p.required("ssikit.signatoryURL", c.SSIKit.SignatoryURL)
+	p.required("ssikit.auditorURL", c.SSIKit.AuditorURL)
+	p.required("ssikit.custodianURL", c.SSIKit.CustodianURL)
+	p.required("ssikit.essifURL", c.SSIKit.EssifURL)
+
+	if c.WebAuthn != (WebAuthn{}) {
+		p.webAuthn(c.WebAuthn)
+	}
+
+	if len(p) > 0 {
+		return &ValidationError{Problems: p}
+	}
+	return nil
+}
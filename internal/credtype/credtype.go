@@ -0,0 +1,501 @@
+// Package credtype implements the declarations of the credential types that the issuer can issue.
+// A declaration specifies the input fields of the credential, which are used to generate the
+// issuance forms and to validate the data received before issuing a credential.
+package credtype
+
+import (
+	"fmt"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hesusruiz/vcutils/yaml"
+)
+
+// Field types
+const (
+	TypeString  = "string"
+	TypeEmail   = "email"
+	TypeNumber  = "number"
+	TypeBoolean = "boolean"
+	TypeDate    = "date"
+	TypeDID     = "did"
+	TypeList    = "list"
+	TypeGroup   = "group"
+)
+
+// Issuers which can sign a credential type
+const (
+	IssuerSSIKit = "ssikit"
+	IssuerVault  = "vault"
+)
+
+// DefaultRepeatableRows is the number of rows displayed in a form for a repeatable group
+const DefaultRepeatableRows = 3
+
+// Field is the declaration of an input field of a credential
+type Field struct {
+	Name        string   `json:"name"`
+	Label       string   `json:"label,omitempty"`
+	Type        string   `json:"type"`
+	Required    bool     `json:"required,omitempty"`
+	Enum        []string `json:"enum,omitempty"`
+	Pattern     string   `json:"pattern,omitempty"`
+	Placeholder string   `json:"placeholder,omitempty"`
+	Repeatable  bool     `json:"repeatable,omitempty"`
+	Rows        int      `json:"rows,omitempty"`
+	Fields      []*Field `json:"fields,omitempty"`
+
+	pattern *regexp.Regexp
+}
+
+// Definition is the declaration of a credential type
+type Definition struct {
+	Name        string   `json:"name"`
+	Title       string   `json:"title,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Issuer      string   `json:"issuer"`
+	Template    string   `json:"template"`
+	Fields      []*Field `json:"fields"`
+}
+
+// FieldError describes a validation error in a field. The path identifies the field with
+// the same dotted notation used in the forms, like "roles.0.target".
+type FieldError struct {
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+func (e FieldError) Error() string {
+	return e.Path + ": " + e.Message
+}
+
+// ValidationError is returned when the data for a credential is not valid
+type ValidationError struct {
+	Errors []FieldError `json:"errors"`
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, fe := range e.Errors {
+		msgs[i] = fe.Error()
+	}
+	return "invalid credential data: " + strings.Join(msgs, "; ")
+}
+
+// Registry holds the definitions of the credential types, by name
+type Registry struct {
+	defs map[string]*Definition
+}
+
+// LoadDir reads all the definitions in the YAML files of a directory
+func LoadDir(dir string) (*Registry, error) {
+
+	r := &Registry{defs: map[string]*Definition{}}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		src, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		def, err := Parse(src)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		if _, exists := r.defs[def.Name]; exists {
+			return nil, fmt.Errorf("%s: credential type %s defined twice", file, def.Name)
+		}
+		r.defs[def.Name] = def
+	}
+
+	return r, nil
+}
+
+// Parse reads a definition in YAML format and checks that it is well formed
+func Parse(src []byte) (*Definition, error) {
+
+	doc, err := yaml.ParseYamlBytes(src)
+	if err != nil {
+		return nil, err
+	}
+
+	def := &Definition{
+		Name:        doc.String("name"),
+		Title:       doc.String("title"),
+		Description: doc.String("description"),
+		Issuer:      doc.String("issuer", IssuerSSIKit),
+		Template:    doc.String("template"),
+	}
+	if len(def.Name) == 0 {
+		return nil, fmt.Errorf("the credential type has no name")
+	}
+	if len(def.Title) == 0 {
+		def.Title = def.Name
+	}
+	if len(def.Template) == 0 {
+		def.Template = def.Name
+	}
+	if def.Issuer != IssuerSSIKit && def.Issuer != IssuerVault {
+		return nil, fmt.Errorf("invalid issuer %q", def.Issuer)
+	}
+
+	def.Fields, err = parseFields(doc.List("fields"))
+	if err != nil {
+		return nil, err
+	}
+	if len(def.Fields) == 0 {
+		return nil, fmt.Errorf("the credential type has no fields")
+	}
+
+	return def, nil
+}
+
+func parseFields(list []any) ([]*Field, error) {
+
+	fields := make([]*Field, 0, len(list))
+	names := map[string]bool{}
+
+	for _, item := range list {
+		y := yaml.New(item)
+
+		f := &Field{
+			Name:        y.String("name"),
+			Label:       y.String("label"),
+			Type:        y.String("type", TypeString),
+			Required:    y.Bool("required"),
+			Enum:        y.ListString("enum"),
+			Pattern:     y.String("pattern"),
+			Placeholder: y.String("placeholder"),
+			Repeatable:  y.Bool("repeatable"),
+			Rows:        y.Int("rows"),
+		}
+
+		if len(f.Name) == 0 || strings.Contains(f.Name, ".") {
+			return nil, fmt.Errorf("invalid field name %q", f.Name)
+		}
+		if names[f.Name] {
+			return nil, fmt.Errorf("field %s defined twice", f.Name)
+		}
+		names[f.Name] = true
+
+		if len(f.Label) == 0 {
+			f.Label = f.Name
+		}
+
+		switch f.Type {
+		case TypeString, TypeEmail, TypeNumber, TypeBoolean, TypeDate, TypeDID, TypeList:
+			if f.Repeatable {
+				return nil, fmt.Errorf("field %s: only groups can be repeatable", f.Name)
+			}
+		case TypeGroup:
+			sub, err := parseFields(y.List("fields"))
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", f.Name, err)
+			}
+			if len(sub) == 0 {
+				return nil, fmt.Errorf("field %s: the group has no fields", f.Name)
+			}
+			for _, s := range sub {
+				if s.Type == TypeGroup {
+					return nil, fmt.Errorf("field %s: groups can not be nested", f.Name)
+				}
+			}
+			f.Fields = sub
+			if f.Repeatable && f.Rows <= 0 {
+				f.Rows = DefaultRepeatableRows
+			}
+		default:
+			return nil, fmt.Errorf("field %s: invalid type %q", f.Name, f.Type)
+		}
+
+		if len(f.Pattern) > 0 {
+			re, err := regexp.Compile("^(?:" + f.Pattern + ")$")
+			if err != nil {
+				return nil, fmt.Errorf("field %s: invalid pattern: %w", f.Name, err)
+			}
+			f.pattern = re
+		}
+
+		fields = append(fields, f)
+	}
+
+	return fields, nil
+}
+
+// Get returns the definition of a credential type
+func (r *Registry) Get(name string) (*Definition, bool) {
+	def, ok := r.defs[name]
+	return def, ok
+}
+
+// All returns the definitions sorted by name
+func (r *Registry) All() []*Definition {
+	defs := make([]*Definition, 0, len(r.defs))
+	for _, def := range r.defs {
+		defs = append(defs, def)
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].Name < defs[j].Name })
+	return defs
+}
+
+// Indexes returns the row numbers of a repeatable group, for generating the form
+func (f *Field) Indexes() []int {
+	idx := make([]int, f.Rows)
+	for i := range idx {
+		idx[i] = i
+	}
+	return idx
+}
+
+// FromForm converts the values submitted in an HTML form into the hierarchical data of the credential.
+// The inputs of a group are named "group.field", and the inputs of the rows of a repeatable group
+// are named "group.N.field". The rows where all inputs are empty are ignored.
+func (def *Definition) FromForm(value func(key string) string) map[string]any {
+
+	data := map[string]any{}
+
+	for _, f := range def.Fields {
+		switch {
+		case f.Type == TypeGroup && f.Repeatable:
+			var rows []any
+			for i := 0; i < f.Rows; i++ {
+				row, empty := groupFromForm(f, fmt.Sprintf("%s.%d.", f.Name, i), value)
+				if !empty {
+					rows = append(rows, row)
+				}
+			}
+			if len(rows) > 0 {
+				data[f.Name] = rows
+			}
+		case f.Type == TypeGroup:
+			row, empty := groupFromForm(f, f.Name+".", value)
+			if !empty {
+				data[f.Name] = row
+			}
+		default:
+			if v := strings.TrimSpace(value(f.Name)); len(v) > 0 {
+				data[f.Name] = v
+			}
+		}
+	}
+
+	return data
+}
+
+func groupFromForm(group *Field, prefix string, value func(key string) string) (map[string]any, bool) {
+	row := map[string]any{}
+	for _, sub := range group.Fields {
+		if v := strings.TrimSpace(value(prefix + sub.Name)); len(v) > 0 {
+			row[sub.Name] = v
+		}
+	}
+	return row, len(row) == 0
+}
+
+// Validate checks the data received for a credential against the definition, and returns the claims
+// for the credential with the values converted to their declared types. Fields not declared are rejected.
+func (def *Definition) Validate(data map[string]any) (map[string]any, error) {
+
+	errs := &ValidationError{}
+	claims := validateFields(def.Fields, data, "", errs)
+
+	if len(errs.Errors) > 0 {
+		return nil, errs
+	}
+	return claims, nil
+}
+
+func validateFields(fields []*Field, data map[string]any, prefix string, errs *ValidationError) map[string]any {
+
+	out := map[string]any{}
+	declared := map[string]bool{}
+
+	for _, f := range fields {
+		declared[f.Name] = true
+		path := prefix + f.Name
+
+		raw, present := data[f.Name]
+		if !present || isEmpty(raw) {
+			if f.Required {
+				errs.Errors = append(errs.Errors, FieldError{path, "is required"})
+			}
+			continue
+		}
+
+		switch {
+		case f.Type == TypeGroup && f.Repeatable:
+			rows, ok := raw.([]any)
+			if !ok {
+				errs.Errors = append(errs.Errors, FieldError{path, "must be a list"})
+				continue
+			}
+			var result []any
+			for i, row := range rows {
+				m, ok := row.(map[string]any)
+				if !ok {
+					errs.Errors = append(errs.Errors, FieldError{fmt.Sprintf("%s.%d", path, i), "must be an object"})
+					continue
+				}
+				result = append(result, validateFields(f.Fields, m, fmt.Sprintf("%s.%d.", path, i), errs))
+			}
+			out[f.Name] = result
+		case f.Type == TypeGroup:
+			m, ok := raw.(map[string]any)
+			if !ok {
+				errs.Errors = append(errs.Errors, FieldError{path, "must be an object"})
+				continue
+			}
+			out[f.Name] = validateFields(f.Fields, m, path+".", errs)
+		default:
+			v, err := f.convert(raw)
+			if err != nil {
+				errs.Errors = append(errs.Errors, FieldError{path, err.Error()})
+				continue
+			}
+			out[f.Name] = v
+		}
+	}
+
+	// Reject the fields which were not declared
+	var unknown []string
+	for name := range data {
+		if !declared[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	for _, name := range unknown {
+		errs.Errors = append(errs.Errors, FieldError{prefix + name, "is not a field of the credential"})
+	}
+
+	return out
+}
+
+func isEmpty(v any) bool {
+	switch t := v.(type) {
+	case nil:
+		return true
+	case string:
+		return len(strings.TrimSpace(t)) == 0
+	case []any:
+		return len(t) == 0
+	}
+	return false
+}
+
+// convert checks a scalar or list value and converts it to the declared type
+func (f *Field) convert(raw any) (any, error) {
+
+	if f.Type == TypeList {
+		var items []string
+		switch t := raw.(type) {
+		case string:
+			for _, item := range strings.Split(t, ",") {
+				if item = strings.TrimSpace(item); len(item) > 0 {
+					items = append(items, item)
+				}
+			}
+		case []any:
+			for _, item := range t {
+				s, ok := item.(string)
+				if !ok {
+					return nil, fmt.Errorf("must be a list of strings")
+				}
+				items = append(items, s)
+			}
+		default:
+			return nil, fmt.Errorf("must be a list of strings")
+		}
+		if len(items) == 0 && f.Required {
+			return nil, fmt.Errorf("is required")
+		}
+		for _, item := range items {
+			if err := f.checkString(item); err != nil {
+				return nil, err
+			}
+		}
+		return items, nil
+	}
+
+	switch f.Type {
+	case TypeNumber:
+		switch t := raw.(type) {
+		case float64, int, int64, uint64:
+			return t, nil
+		case string:
+			n, err := strconv.ParseFloat(strings.TrimSpace(t), 64)
+			if err != nil {
+				return nil, fmt.Errorf("must be a number")
+			}
+			return n, nil
+		}
+		return nil, fmt.Errorf("must be a number")
+	case TypeBoolean:
+		switch t := raw.(type) {
+		case bool:
+			return t, nil
+		case string:
+			b, err := strconv.ParseBool(strings.TrimSpace(t))
+			if err != nil {
+				return nil, fmt.Errorf("must be true or false")
+			}
+			return b, nil
+		}
+		return nil, fmt.Errorf("must be true or false")
+	}
+
+	s, ok := raw.(string)
+	if !ok {
+		return nil, fmt.Errorf("must be a string")
+	}
+	s = strings.TrimSpace(s)
+
+	switch f.Type {
+	case TypeEmail:
+		if _, err := mail.ParseAddress(s); err != nil {
+			return nil, fmt.Errorf("must be a valid email address")
+		}
+	case TypeDate:
+		if _, err := time.Parse("2006-01-02", s); err != nil {
+			return nil, fmt.Errorf("must be a date in the format YYYY-MM-DD")
+		}
+	case TypeDID:
+		if !strings.HasPrefix(s, "did:") || len(strings.Split(s, ":")) < 3 {
+			return nil, fmt.Errorf("must be a DID")
+		}
+	}
+
+	if err := f.checkString(s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (f *Field) checkString(s string) error {
+	if len(f.Enum) > 0 {
+		found := false
+		for _, e := range f.Enum {
+			if s == e {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%q must be one of %s", s, strings.Join(f.Enum, ", "))
+		}
+	}
+	if f.pattern != nil && !f.pattern.MatchString(s) {
+		return fmt.Errorf("%q does not match the pattern %s", s, f.Pattern)
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+package credtype
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const packetDelivery = `
+name: PacketDeliveryService
+title: Packet Delivery Service
+issuer: ssikit
+fields:
+  - name: email
+    type: email
+    required: true
+  - name: firstName
+    required: true
+  - name: level
+    type: string
+    enum: ["gold", "silver"]
+  - name: roles
+    type: group
+    repeatable: true
+    required: true
+    fields:
+      - name: target
+        type: did
+        required: true
+      - name: names
+        type: list
+        required: true
+`
+
+func mustParse(t *testing.T, src string) *Definition {
+	t.Helper()
+	def, err := Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	return def
+}
+
+func TestParse(t *testing.T) {
+	def := mustParse(t, packetDelivery)
+
+	if def.Template != "PacketDeliveryService" {
+		t.Errorf("Template = %q, want the name of the type", def.Template)
+	}
+	if len(def.Fields) != 4 {
+		t.Fatalf("len(Fields) = %d, want 4", len(def.Fields))
+	}
+	roles := def.Fields[3]
+	if roles.Rows != DefaultRepeatableRows || len(roles.Fields) != 2 {
+		t.Errorf("roles = %+v, want a repeatable group with two fields", roles)
+	}
+	if def.Fields[1].Label != "firstName" {
+		t.Errorf("Label = %q, want the name of the field", def.Fields[1].Label)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := map[string]string{
+		"no name":       "fields: [{name: a}]",
+		"no fields":     "name: A",
+		"bad issuer":    "name: A\nissuer: other\nfields: [{name: a}]",
+		"bad type":      "name: A\nfields: [{name: a, type: color}]",
+		"duplicated":    "name: A\nfields: [{name: a}, {name: a}]",
+		"dotted name":   "name: A\nfields: [{name: a.b}]",
+		"repeatable":    "name: A\nfields: [{name: a, repeatable: true}]",
+		"empty group":   "name: A\nfields: [{name: a, type: group}]",
+		"nested groups": "name: A\nfields: [{name: a, type: group, fields: [{name: b, type: group, fields: [{name: c}]}]}]",
+		"bad pattern":   "name: A\nfields: [{name: a, pattern: '('}]",
+	}
+	for name, src := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Parse([]byte(src)); err == nil {
+				t.Error("Parse() expected an error")
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	def := mustParse(t, packetDelivery)
+
+	claims, err := def.Validate(map[string]any{
+		"email":     "john@example.com",
+		"firstName": " John ",
+		"roles": []any{
+			map[string]any{"target": "did:elsi:packetdelivery", "names": "P.Create, P.Info"},
+			map[string]any{"target": "did:elsi:other", "names": []any{"P.Info"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	want := map[string]any{
+		"email":     "john@example.com",
+		"firstName": "John",
+		"roles": []any{
+			map[string]any{"target": "did:elsi:packetdelivery", "names": []string{"P.Create", "P.Info"}},
+			map[string]any{"target": "did:elsi:other", "names": []string{"P.Info"}},
+		},
+	}
+	if !reflect.DeepEqual(claims, want) {
+		t.Errorf("Validate() = %v, want %v", claims, want)
+	}
+}
+
+func TestValidateErrors(t *testing.T) {
+	def := mustParse(t, packetDelivery)
+
+	_, err := def.Validate(map[string]any{
+		"email": "not an email",
+		"level": "bronze",
+		"roles": []any{
+			map[string]any{"target": "elsi", "names": ""},
+		},
+		"other": "x",
+	})
+
+	verr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("Validate() error = %v, want a ValidationError", err)
+	}
+
+	got := map[string]bool{}
+	for _, fe := range verr.Errors {
+		got[fe.Path] = true
+	}
+	for _, path := range []string{"email", "firstName", "level", "roles.0.target", "roles.0.names", "other"} {
+		if !got[path] {
+			t.Errorf("no error for %s in %v", path, verr)
+		}
+	}
+}
+
+func TestValidateTypes(t *testing.T) {
+	def := mustParse(t, `
+name: A
+fields:
+  - name: age
+    type: number
+  - name: active
+    type: boolean
+  - name: birth
+    type: date
+  - name: code
+    pattern: "[A-Z]{3}"
+`)
+
+	claims, err := def.Validate(map[string]any{"age": "42", "active": "true", "birth": "2000-01-31", "code": "ABC"})
+	if err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if claims["age"] != 42.0 || claims["active"] != true {
+		t.Errorf("Validate() = %v, values not converted", claims)
+	}
+
+	for field, value := range map[string]any{"age": "old", "active": "maybe", "birth": "31/01/2000", "code": "ABCD"} {
+		if _, err := def.Validate(map[string]any{field: value}); err == nil {
+			t.Errorf("Validate(%s=%v) expected an error", field, value)
+		}
+	}
+}
+
+func TestFromForm(t *testing.T) {
+	def := mustParse(t, packetDelivery)
+
+	form := map[string]string{
+		"email":          "john@example.com",
+		"firstName":      "John",
+		"roles.0.target": "did:elsi:packetdelivery",
+		"roles.0.names":  "P.Create,P.Info",
+		"roles.2.target": "did:elsi:other",
+		"roles.2.names":  "P.Info",
+	}
+
+	data := def.FromForm(func(key string) string { return form[key] })
+
+	roles, ok := data["roles"].([]any)
+	if !ok || len(roles) != 2 {
+		t.Fatalf("roles = %v, want two rows without the empty one", data["roles"])
+	}
+	if _, present := data["level"]; present {
+		t.Error("empty fields must not be included")
+	}
+	if _, err := def.Validate(data); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
+
+func TestLoadDir(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "b.yaml"), []byte(packetDelivery), 0644)
+	os.WriteFile(filepath.Join(dir, "a.yaml"), []byte("name: CustomerCredential\nissuer: vault\nfields: [{name: email, type: email}]"), 0644)
+
+	r, err := LoadDir(dir)
+	if err != nil {
+		t.Fatalf("LoadDir() error = %v", err)
+	}
+	all := r.All()
+	if len(all) != 2 || all[0].Name != "CustomerCredential" {
+		t.Errorf("All() = %v, want two definitions sorted by name", all)
+	}
+	if _, ok := r.Get("PacketDeliveryService"); !ok {
+		t.Error("Get() did not find PacketDeliveryService")
+	}
+
+	os.WriteFile(filepath.Join(dir, "c.yaml"), []byte(packetDelivery), 0644)
+	if _, err := LoadDir(dir); err == nil || !strings.Contains(err.Error(), "twice") {
+		t.Errorf("LoadDir() error = %v, want duplicated definition", err)
+	}
+}
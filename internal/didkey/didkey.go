@@ -4,7 +4,9 @@
 package didkey
 
 import (
+	"crypto/ecdsa"
 	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rsa"
 	"crypto/x509"
 	"fmt"
@@ -22,6 +24,8 @@ const (
 	MulticodecKindRSAPubKey = 0x1205
 	// MulticodecKindEd25519PubKey ed25519-pub
 	MulticodecKindEd25519PubKey = 0xed
+	// MulticodecKindP256PubKey p256-pub, in compressed form
+	MulticodecKindP256PubKey = 0x1200
 )
 
 // ID is a DID:key identifier
@@ -34,11 +38,42 @@ func NewID(pub crypto.PubKey) (ID, error) {
 	switch pub.Type() {
 	case crypto.Ed25519, crypto.RSA:
 		return ID{PubKey: pub}, nil
+	case crypto.ECDSA:
+		if _, err := compressedP256(pub); err != nil {
+			return ID{}, err
+		}
+		return ID{PubKey: pub}, nil
 	default:
 		return ID{}, fmt.Errorf("unsupported key type: %s", pub.Type())
 	}
 }
 
+// FromPublicKey constructs an Identifier from a public key of the standard library
+func FromPublicKey(pub interface{}) (ID, error) {
+
+	var key crypto.PubKey
+	var err error
+
+	switch k := pub.(type) {
+	case *ecdsa.PublicKey:
+		key, err = crypto.ECDSAPublicKeyFromPubKey(*k)
+	case ed25519.PublicKey:
+		key, err = crypto.UnmarshalEd25519PublicKey(k)
+	case *rsa.PublicKey:
+		var der []byte
+		if der, err = x509.MarshalPKIXPublicKey(k); err == nil {
+			key, err = crypto.UnmarshalRsaPublicKey(der)
+		}
+	default:
+		return ID{}, fmt.Errorf("unsupported key type: %T", pub)
+	}
+	if err != nil {
+		return ID{}, err
+	}
+
+	return NewID(key)
+}
+
 // MulticodecType indicates the type for this multicodec
 func (id ID) MulticodecType() uint64 {
 	switch id.Type() {
@@ -46,6 +81,8 @@ func (id ID) MulticodecType() uint64 {
 		return MulticodecKindRSAPubKey
 	case crypto.Ed25519:
 		return MulticodecKindEd25519PubKey
+	case crypto.ECDSA:
+		return MulticodecKindP256PubKey
 	default:
 		panic("unexpected crypto type")
 	}
@@ -57,6 +94,12 @@ func (id ID) String() string {
 	if err != nil {
 		return ""
 	}
+	if id.Type() == crypto.ECDSA {
+		raw, err = compressedP256(id.PubKey)
+		if err != nil {
+			return ""
+		}
+	}
 
 	t := id.MulticodecType()
 	size := varint.UvarintSize(t)
@@ -73,7 +116,7 @@ func (id ID) String() string {
 }
 
 // VerifyKey returns the backing implementation for a public key, one of:
-// *rsa.PublicKey, ed25519.PublicKey
+// *rsa.PublicKey, ed25519.PublicKey, *ecdsa.PublicKey
 func (id ID) VerifyKey() (interface{}, error) {
 	rawPubBytes, err := id.PubKey.Raw()
 	if err != nil {
@@ -92,6 +135,16 @@ func (id ID) VerifyKey() (interface{}, error) {
 		return verifyKey, nil
 	case crypto.Ed25519:
 		return ed25519.PublicKey(rawPubBytes), nil
+	case crypto.ECDSA:
+		verifyKeyiface, err := x509.ParsePKIXPublicKey(rawPubBytes)
+		if err != nil {
+			return nil, err
+		}
+		verifyKey, ok := verifyKeyiface.(*ecdsa.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("public key is not an ECDSA key. got type: %T", verifyKeyiface)
+		}
+		return verifyKey, nil
 	default:
 		return nil, fmt.Errorf("unrecognized Public Key type: %s", id.PubKey.Type())
 	}
@@ -133,7 +186,34 @@ func Parse(keystr string) (ID, error) {
 			return id, err
 		}
 		return ID{pub}, nil
+	case MulticodecKindP256PubKey:
+		x, y := elliptic.UnmarshalCompressed(elliptic.P256(), data[n:])
+		if x == nil {
+			return id, fmt.Errorf("invalid P-256 public key")
+		}
+		pub, err := crypto.ECDSAPublicKeyFromPubKey(ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y})
+		if err != nil {
+			return id, err
+		}
+		return ID{pub}, nil
 	}
 
 	return id, fmt.Errorf("unrecognized key type multicodec prefix: %x", data[0])
 }
+
+// compressedP256 returns the compressed form of a P-256 public key, as used in did:key
+func compressedP256(pub crypto.PubKey) ([]byte, error) {
+	raw, err := pub.Raw()
+	if err != nil {
+		return nil, err
+	}
+	key, err := x509.ParsePKIXPublicKey(raw)
+	if err != nil {
+		return nil, err
+	}
+	ecKey, ok := key.(*ecdsa.PublicKey)
+	if !ok || ecKey.Curve != elliptic.P256() {
+		return nil, fmt.Errorf("only P-256 ECDSA keys are supported")
+	}
+	return elliptic.MarshalCompressed(ecKey.Curve, ecKey.X, ecKey.Y), nil
+}
@@ -0,0 +1,287 @@
+// Package holder implements the binding of credentials to the DID of their holder.
+// The DID of the holder is obtained from a proof signed by the holder, or from the DID registered
+// for the holder, and it must be resolvable before it is embedded in the credential.
+// The presentations of the credentials are verified with the key of the same DID.
+package holder
+
+import (
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hesusruiz/vcbackend/internal/didkey"
+	"github.com/hesusruiz/vcbackend/internal/jwk"
+	"github.com/hesusruiz/vcbackend/internal/jwt"
+	"github.com/hesusruiz/vcbackend/internal/vc"
+)
+
+var (
+	ErrNoHolder      = errors.New("the DID of the holder is required")
+	ErrUnresolvable  = errors.New("the DID of the holder can not be resolved")
+	ErrInvalidProof  = errors.New("invalid holder proof")
+	ErrProofReplayed = errors.New("the nonce of the holder proof is unknown or was already used")
+
+	ErrInvalidPresentation = errors.New("invalid presentation")
+)
+
+// MaxProofAge is the maximum time since a proof was issued for accepting it
+const MaxProofAge = 5 * time.Minute
+
+// Resolver resolves DIDs. The did:key method is resolved locally, and other methods
+// are resolved with a Universal Resolver, if configured.
+type Resolver struct {
+	URL    string
+	Client *http.Client
+}
+
+// NewResolver creates a resolver using the Universal Resolver at the given URL.
+// If the URL is empty, only did:key can be resolved.
+func NewResolver(url string) *Resolver {
+	return &Resolver{
+		URL:    strings.TrimSuffix(url, "/"),
+		Client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// VerificationKey resolves a DID and returns the public key for verifying its signatures
+func (r *Resolver) VerificationKey(did string) (crypto.PublicKey, error) {
+
+	// Ignore the fragment of a DID URL
+	did, _, _ = strings.Cut(did, "#")
+
+	if strings.HasPrefix(did, didkey.KeyPrefix+":") {
+		id, err := didkey.Parse(did)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrUnresolvable, err)
+		}
+		key, err := id.VerifyKey()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrUnresolvable, err)
+		}
+		return key, nil
+	}
+
+	doc, err := r.resolveRemote(did)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, vm := range doc.DIDDocument.VerificationMethod {
+		if len(vm.PublicKeyJwk) == 0 {
+			continue
+		}
+		key, err := jwk.NewFromBytes(vm.PublicKeyJwk)
+		if err != nil {
+			continue
+		}
+		if pub, err := key.GetPublicKey(); err == nil {
+			return pub, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s has no supported verification method", ErrUnresolvable, did)
+}
+
+// Resolve checks that a DID can be resolved
+func (r *Resolver) Resolve(did string) error {
+	if !strings.HasPrefix(did, "did:") {
+		return fmt.Errorf("%w: %q is not a DID", ErrUnresolvable, did)
+	}
+	if strings.HasPrefix(did, didkey.KeyPrefix+":") {
+		_, err := r.VerificationKey(did)
+		return err
+	}
+	_, err := r.resolveRemote(did)
+	return err
+}
+
+type resolution struct {
+	DIDDocument struct {
+		ID                 string `json:"id"`
+		VerificationMethod []struct {
+			ID           string          `json:"id"`
+			PublicKeyJwk json.RawMessage `json:"publicKeyJwk"`
+		} `json:"verificationMethod"`
+	} `json:"didDocument"`
+}
+
+// resolveRemote resolves a DID with the Universal Resolver
+func (r *Resolver) resolveRemote(did string) (*resolution, error) {
+
+	if len(r.URL) == 0 {
+		return nil, fmt.Errorf("%w: no resolver configured for %s", ErrUnresolvable, did)
+	}
+
+	resp, err := r.Client.Get(r.URL + "/1.0/identifiers/" + did)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnresolvable, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: resolver replied %d for %s", ErrUnresolvable, resp.StatusCode, did)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnresolvable, err)
+	}
+
+	doc := &resolution{}
+	if err := json.Unmarshal(body, doc); err != nil || doc.DIDDocument.ID != did {
+		return nil, fmt.Errorf("%w: invalid DID document for %s", ErrUnresolvable, did)
+	}
+
+	return doc, nil
+}
+
+// VerifyProof verifies a proof JWT signed by the holder and returns the DID of the holder.
+// The proof must be signed with a key of the DID in the iss claim (or in the kid header),
+// be addressed to the issuer in the aud claim, and include a nonce issued by the issuer.
+// The consume function must return true only the first time it is called with a valid nonce.
+func (r *Resolver) VerifyProof(proof string, audience string, consume func(nonce string) bool) (string, error) {
+
+	claims := jwt.MapClaims{}
+	token, err := jwt.ParseWithClaims(proof, claims, func(t *jwt.Token) (interface{}, error) {
+		did, _ := claims["iss"].(string)
+		if kid, ok := t.Header["kid"].(string); ok && strings.HasPrefix(kid, "did:") {
+			kidDID, _, _ := strings.Cut(kid, "#")
+			if len(did) > 0 && did != kidDID {
+				return nil, fmt.Errorf("the kid does not belong to the issuer of the proof")
+			}
+			did = kidDID
+		}
+		if len(did) == 0 {
+			return nil, fmt.Errorf("the proof does not identify the holder")
+		}
+		claims["iss"] = did
+		return r.VerificationKey(did)
+	}, jwt.WithValidMethods([]string{"ES256", "ES384", "EdDSA", "RS256"}))
+	if err != nil {
+		if errors.Is(err, ErrUnresolvable) {
+			return "", err
+		}
+		return "", fmt.Errorf("%w: %v", ErrInvalidProof, err)
+	}
+	if !token.Valid {
+		return "", ErrInvalidProof
+	}
+
+	if !claims.VerifyAudience(audience, true) {
+		return "", fmt.Errorf("%w: the proof is not addressed to %s", ErrInvalidProof, audience)
+	}
+
+	iat, ok := claims["iat"].(float64)
+	if !ok || time.Since(time.Unix(int64(iat), 0)) > MaxProofAge {
+		return "", fmt.Errorf("%w: the proof is too old or has no iat", ErrInvalidProof)
+	}
+
+	nonce, _ := claims["nonce"].(string)
+	if len(nonce) == 0 || !consume(nonce) {
+		return "", ErrProofReplayed
+	}
+
+	return claims["iss"].(string), nil
+}
+
+// Presentation is a verifiable presentation of a credential, signed by the holder of the credential
+type Presentation struct {
+	// Holder is the DID of the subject of the credential, which signed the presentation
+	Holder string
+	// Nonce is the nonce of the request answered by the presentation
+	Nonce string
+	// Credential is the credential presented, a JWT or a JSON credential
+	Credential []byte
+}
+
+// VerifyPresentation verifies a presentation JWT and returns the credential it carries. The presentation
+// must carry one credential in vp.verifiableCredential, be signed with a key of the DID of the subject of
+// the credential and be addressed to the audience. The nonce is returned to be checked by the caller.
+func (r *Resolver) VerifyPresentation(vpToken string, audience string) (*Presentation, error) {
+
+	p := &Presentation{}
+	claims := jwt.MapClaims{}
+	token, err := jwt.ParseWithClaims(vpToken, claims, func(t *jwt.Token) (interface{}, error) {
+		raw, err := presentedCredential(claims)
+		if err != nil {
+			return nil, err
+		}
+		cred, err := vc.Decode(raw)
+		if err != nil {
+			return nil, err
+		}
+		did := cred.SubjectID()
+		if !strings.HasPrefix(did, "did:") {
+			return nil, fmt.Errorf("the credential is not bound to the DID of its holder")
+		}
+		if iss, _ := claims["iss"].(string); len(iss) > 0 && iss != did {
+			return nil, fmt.Errorf("the presentation was not issued by the holder of the credential")
+		}
+		p.Holder, p.Credential = did, raw
+		return r.VerificationKey(did)
+	}, jwt.WithValidMethods([]string{"ES256", "ES384", "EdDSA", "RS256"}))
+	if err != nil {
+		if errors.Is(err, ErrUnresolvable) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPresentation, err)
+	}
+	if !token.Valid {
+		return nil, ErrInvalidPresentation
+	}
+
+	if !claims.VerifyAudience(audience, true) {
+		return nil, fmt.Errorf("%w: the presentation is not addressed to %s", ErrInvalidPresentation, audience)
+	}
+
+	iat, ok := claims["iat"].(float64)
+	if !ok || time.Since(time.Unix(int64(iat), 0)) > MaxProofAge {
+		return nil, fmt.Errorf("%w: the presentation is too old or has no iat", ErrInvalidPresentation)
+	}
+
+	p.Nonce, _ = claims["nonce"].(string)
+	return p, nil
+}
+
+// presentedCredential returns the credential in the vp claim of a presentation, serialized
+func presentedCredential(claims jwt.MapClaims) ([]byte, error) {
+
+	vp, _ := claims["vp"].(map[string]any)
+	creds, ok := vp["verifiableCredential"].([]any)
+	if !ok || len(creds) != 1 {
+		return nil, fmt.Errorf("the presentation must carry one credential")
+	}
+
+	switch cred := creds[0].(type) {
+	case string:
+		return []byte(cred), nil
+	case map[string]any:
+		return json.Marshal(cred)
+	default:
+		return nil, fmt.Errorf("the credential of the presentation is neither a JWT nor a JSON object")
+	}
+}
+
+// Apply embeds the DID of the holder in a credential, as the subject of the JWT and as the id
+// of the credentialSubject, either inside the "vc" claim or at the top level.
+func Apply(cred map[string]any, did string) {
+
+	cred["sub"] = did
+
+	target := cred
+	if vc, ok := cred["vc"].(map[string]any); ok {
+		target = vc
+	}
+
+	subject, ok := target["credentialSubject"].(map[string]any)
+	if !ok {
+		subject = map[string]any{}
+		target["credentialSubject"] = subject
+	}
+	subject["id"] = did
+}
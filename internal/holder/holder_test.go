@@ -0,0 +1,257 @@
+package holder
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hesusruiz/vcbackend/internal/didkey"
+	"github.com/hesusruiz/vcbackend/internal/jwt"
+	p2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+)
+
+const audience = "did:key:issuer"
+
+func newP256DID(t *testing.T) (*ecdsa.PrivateKey, string) {
+	t.Helper()
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, err := p2pcrypto.ECDSAPublicKeyFromPubKey(priv.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := didkey.NewID(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return priv, id.String()
+}
+
+func signProof(t *testing.T, method jwt.SigningMethod, key any, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if len(kid) > 0 {
+		token.Header["kid"] = kid
+	}
+	proof, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return proof
+}
+
+func onceNonces(nonces ...string) func(string) bool {
+	valid := map[string]bool{}
+	for _, n := range nonces {
+		valid[n] = true
+	}
+	return func(n string) bool {
+		ok := valid[n]
+		delete(valid, n)
+		return ok
+	}
+}
+
+func TestDIDKeyP256RoundTrip(t *testing.T) {
+	priv, did := newP256DID(t)
+
+	id, err := didkey.Parse(did)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	key, err := id.VerifyKey()
+	if err != nil {
+		t.Fatalf("VerifyKey() error = %v", err)
+	}
+	if !priv.PublicKey.Equal(key) {
+		t.Error("the resolved key is not the original one")
+	}
+	if id.String() != did {
+		t.Errorf("String() = %s, want %s", id.String(), did)
+	}
+}
+
+func TestVerifyProof(t *testing.T) {
+	priv, did := newP256DID(t)
+	r := NewResolver("")
+
+	claims := jwt.MapClaims{"iss": did, "aud": audience, "nonce": "n1", "iat": time.Now().Unix()}
+	proof := signProof(t, jwt.SigningMethodES256, priv, did+"#key-1", claims)
+
+	consume := onceNonces("n1")
+	got, err := r.VerifyProof(proof, audience, consume)
+	if err != nil {
+		t.Fatalf("VerifyProof() error = %v", err)
+	}
+	if got != did {
+		t.Errorf("VerifyProof() = %s, want %s", got, did)
+	}
+
+	// The same proof can not be used twice
+	if _, err := r.VerifyProof(proof, audience, consume); !errors.Is(err, ErrProofReplayed) {
+		t.Errorf("VerifyProof() replay error = %v, want ErrProofReplayed", err)
+	}
+}
+
+func TestVerifyProofEd25519(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(rand.Reader)
+	p2pPub, err := p2pcrypto.UnmarshalEd25519PublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, _ := didkey.NewID(p2pPub)
+
+	claims := jwt.MapClaims{"iss": id.String(), "aud": audience, "nonce": "n1", "iat": time.Now().Unix()}
+	proof := signProof(t, jwt.SigningMethodEdDSA, priv, "", claims)
+
+	if _, err := NewResolver("").VerifyProof(proof, audience, onceNonces("n1")); err != nil {
+		t.Errorf("VerifyProof() error = %v", err)
+	}
+}
+
+func TestVerifyProofErrors(t *testing.T) {
+	priv, did := newP256DID(t)
+	other, _ := newP256DID(t)
+	_, otherDID := newP256DID(t)
+	r := NewResolver("")
+	now := time.Now().Unix()
+
+	tests := []struct {
+		name   string
+		key    *ecdsa.PrivateKey
+		kid    string
+		claims jwt.MapClaims
+		want   error
+	}{
+		{"wrong key", other, "", jwt.MapClaims{"iss": did, "aud": audience, "nonce": "n", "iat": now}, ErrInvalidProof},
+		{"kid of other DID", priv, otherDID, jwt.MapClaims{"iss": did, "aud": audience, "nonce": "n", "iat": now}, ErrInvalidProof},
+		{"wrong audience", priv, "", jwt.MapClaims{"iss": did, "aud": "other", "nonce": "n", "iat": now}, ErrInvalidProof},
+		{"too old", priv, "", jwt.MapClaims{"iss": did, "aud": audience, "nonce": "n", "iat": now - 3600}, ErrInvalidProof},
+		{"unknown nonce", priv, "", jwt.MapClaims{"iss": did, "aud": audience, "nonce": "x", "iat": now}, ErrProofReplayed},
+		{"unresolvable", priv, "", jwt.MapClaims{"iss": "did:web:example.com", "aud": audience, "nonce": "n", "iat": now}, ErrUnresolvable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proof := signProof(t, jwt.SigningMethodES256, tt.key, tt.kid, tt.claims)
+			if _, err := r.VerifyProof(proof, audience, onceNonces("n")); !errors.Is(err, tt.want) {
+				t.Errorf("VerifyProof() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+// presentationClaims returns the claims of a presentation of a credential of the subject
+func presentationClaims(subject string, iss string, aud string, iat int64) jwt.MapClaims {
+	cred := map[string]any{
+		"type":              []any{"VerifiableCredential", "PacketDeliveryService"},
+		"credentialSubject": map[string]any{"id": subject},
+	}
+	return jwt.MapClaims{
+		"iss":   iss,
+		"aud":   aud,
+		"nonce": "n1",
+		"iat":   iat,
+		"vp":    map[string]any{"type": []any{"VerifiablePresentation"}, "verifiableCredential": []any{cred}},
+	}
+}
+
+func TestVerifyPresentation(t *testing.T) {
+	priv, did := newP256DID(t)
+	other, _ := newP256DID(t)
+	_, otherDID := newP256DID(t)
+	r := NewResolver("")
+	now := time.Now().Unix()
+
+	vp := signProof(t, jwt.SigningMethodES256, priv, "", presentationClaims(did, did, audience, now))
+	p, err := r.VerifyPresentation(vp, audience)
+	if err != nil {
+		t.Fatalf("VerifyPresentation() error = %v", err)
+	}
+	if p.Holder != did || p.Nonce != "n1" || !strings.Contains(string(p.Credential), did) {
+		t.Errorf("VerifyPresentation() = %+v", p)
+	}
+
+	tests := []struct {
+		name   string
+		key    *ecdsa.PrivateKey
+		claims jwt.MapClaims
+		want   error
+	}{
+		{"not signed by the holder", other, presentationClaims(did, did, audience, now), ErrInvalidPresentation},
+		{"issued by another DID", priv, presentationClaims(did, otherDID, audience, now), ErrInvalidPresentation},
+		{"wrong audience", priv, presentationClaims(did, did, "other", now), ErrInvalidPresentation},
+		{"too old", priv, presentationClaims(did, did, audience, now-3600), ErrInvalidPresentation},
+		{"subject without DID", priv, presentationClaims("holder", "", audience, now), ErrInvalidPresentation},
+		{"without credential", priv, jwt.MapClaims{"iss": did, "aud": audience, "iat": now}, ErrInvalidPresentation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vp := signProof(t, jwt.SigningMethodES256, tt.key, "", tt.claims)
+			if _, err := r.VerifyPresentation(vp, audience); !errors.Is(err, tt.want) {
+				t.Errorf("VerifyPresentation() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveRemote(t *testing.T) {
+	priv, _ := newP256DID(t)
+	x := base64.RawURLEncoding.EncodeToString(priv.PublicKey.X.FillBytes(make([]byte, 32)))
+	y := base64.RawURLEncoding.EncodeToString(priv.PublicKey.Y.FillBytes(make([]byte, 32)))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/1.0/identifiers/did:web:example.com" {
+			http.NotFound(w, req)
+			return
+		}
+		fmt.Fprintf(w, `{"didDocument": {"id": "did:web:example.com", "verificationMethod": [
+			{"id": "did:web:example.com#key-1", "publicKeyJwk": {"kty": "EC", "crv": "P-256", "x": %q, "y": %q}}]}}`, x, y)
+	}))
+	defer srv.Close()
+
+	r := NewResolver(srv.URL)
+	if err := r.Resolve("did:web:example.com"); err != nil {
+		t.Errorf("Resolve() error = %v", err)
+	}
+	if err := r.Resolve("did:web:unknown.com"); !errors.Is(err, ErrUnresolvable) {
+		t.Errorf("Resolve() error = %v, want ErrUnresolvable", err)
+	}
+	if err := r.Resolve("not a did"); !errors.Is(err, ErrUnresolvable) {
+		t.Errorf("Resolve() error = %v, want ErrUnresolvable", err)
+	}
+
+	key, err := r.VerificationKey("did:web:example.com#key-1")
+	if err != nil {
+		t.Fatalf("VerificationKey() error = %v", err)
+	}
+	if !priv.PublicKey.Equal(key) {
+		t.Error("the resolved key is not the original one")
+	}
+}
+
+func TestApply(t *testing.T) {
+	cred := map[string]any{"vc": map[string]any{"credentialSubject": map[string]any{"id": "did:key:fake", "email": "a@b.c"}}}
+	Apply(cred, "did:key:holder")
+
+	subject := cred["vc"].(map[string]any)["credentialSubject"].(map[string]any)
+	if cred["sub"] != "did:key:holder" || subject["id"] != "did:key:holder" || subject["email"] != "a@b.c" {
+		t.Errorf("Apply() = %v", cred)
+	}
+
+	ldp := map[string]any{}
+	Apply(ldp, "did:key:holder")
+	if ldp["credentialSubject"].(map[string]any)["id"] != "did:key:holder" {
+		t.Errorf("Apply() = %v", ldp)
+	}
+}
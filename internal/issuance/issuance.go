@@ -0,0 +1,278 @@
+// Package issuance implements the issuance policies of the issuer, which determine
+// the issuance, validity and expiration dates of the credentials of each type.
+package issuance
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hesusruiz/vcutils/yaml"
+)
+
+// DefaultPolicyName is the name of the policy applied to credential types without a specific one
+const DefaultPolicyName = "default"
+
+// DefaultValidity is used when no policy at all is configured
+const DefaultValidity = 365 * 24 * time.Hour
+
+// DefaultMaxValidFromDelay is the maximum time from issuance to a requested validFrom, for the policies
+// which do not set one
+const DefaultMaxValidFromDelay = 30 * 24 * time.Hour
+
+// ErrPolicyViolation is returned when the dates requested for a credential are not allowed by the policy
+var ErrPolicyViolation = errors.New("issuance policy violation")
+
+// Policy specifies how the dates of a credential are computed
+type Policy struct {
+	// Validity is the duration of the credential, counted from ValidFrom
+	Validity time.Duration
+	// NotBeforeDelay is the time from issuance until the credential is valid
+	NotBeforeDelay time.Duration
+	// NotAfter is a fixed date after which no credential can be valid
+	NotAfter *time.Time
+	// MaxValidity is the maximum duration which can be requested for a credential
+	MaxValidity time.Duration
+	// MaxValidFromDelay is the maximum time from issuance to the validFrom requested for a credential.
+	// If it is zero, DefaultMaxValidFromDelay is used.
+	MaxValidFromDelay time.Duration
+}
+
+// Dates are the dates of a credential, computed according to a Policy
+type Dates struct {
+	IssuanceDate   time.Time
+	ValidFrom      time.Time
+	ExpirationDate time.Time
+}
+
+// Request holds the optional dates requested by the caller for a credential.
+// Zero values mean that the dates are computed from the policy.
+type Request struct {
+	ValidFrom      time.Time
+	ExpirationDate time.Time
+}
+
+// Policies holds the policies per credential type
+type Policies struct {
+	byType map[string]Policy
+}
+
+// New returns a set of policies with only the builtin default
+func New() *Policies {
+	return &Policies{byType: map[string]Policy{
+		DefaultPolicyName: {Validity: DefaultValidity},
+	}}
+}
+
+// FromConfig creates the policies from a configuration map where the keys are the credential
+// types and the values are the policies for the type, like:
+//
+//	default:
+//	  validity: 365d
+//	PacketDeliveryCredential:
+//	  validity: 90d
+//	  notBeforeDelay: 1h
+//	  notAfter: "2024-12-31T23:59:59Z"
+//	  maxValidity: 180d
+//	  maxValidFromDelay: 7d
+func FromConfig(cfg map[string]any) (*Policies, error) {
+
+	p := New()
+
+	for credType, raw := range cfg {
+		entry, ok := raw.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("issuance policy %s: expected a map", credType)
+		}
+		policy, err := parsePolicy(yaml.New(entry))
+		if err != nil {
+			return nil, fmt.Errorf("issuance policy %s: %w", credType, err)
+		}
+		p.byType[credType] = policy
+	}
+
+	return p, nil
+}
+
+func parsePolicy(cfg *yaml.YAML) (policy Policy, err error) {
+
+	if policy.Validity, err = ParseDuration(cfg.String("validity")); err != nil {
+		return policy, err
+	}
+	if policy.NotBeforeDelay, err = ParseDuration(cfg.String("notBeforeDelay")); err != nil {
+		return policy, err
+	}
+	if policy.MaxValidity, err = ParseDuration(cfg.String("maxValidity")); err != nil {
+		return policy, err
+	}
+	if policy.MaxValidFromDelay, err = ParseDuration(cfg.String("maxValidFromDelay")); err != nil {
+		return policy, err
+	}
+	if notAfter := cfg.String("notAfter"); len(notAfter) > 0 {
+		t, err := time.Parse(time.RFC3339, notAfter)
+		if err != nil {
+			return policy, fmt.Errorf("invalid notAfter: %w", err)
+		}
+		policy.NotAfter = &t
+	}
+
+	if policy.Validity == 0 && policy.NotAfter == nil {
+		return policy, fmt.Errorf("either validity or notAfter must be specified")
+	}
+	if policy.MaxValidity > 0 && policy.Validity > policy.MaxValidity {
+		return policy, fmt.Errorf("validity is greater than maxValidity")
+	}
+	if policy.NotBeforeDelay > policy.maxValidFromDelay() {
+		return policy, fmt.Errorf("notBeforeDelay is greater than maxValidFromDelay")
+	}
+
+	return policy, nil
+}
+
+// ParseDuration parses a duration like time.ParseDuration, but accepting also
+// a number of days with the "d" suffix. The empty string is a zero duration.
+func ParseDuration(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if len(s) == 0 {
+		return 0, nil
+	}
+	if strings.HasSuffix(s, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(s, "d"))
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	return d, nil
+}
+
+// maxValidFromDelay is the maximum time from issuance to a requested validFrom
+func (policy Policy) maxValidFromDelay() time.Duration {
+	if policy.MaxValidFromDelay > 0 {
+		return policy.MaxValidFromDelay
+	}
+	return DefaultMaxValidFromDelay
+}
+
+// For returns the policy for the credential type, or the default policy
+func (p *Policies) For(credType string) Policy {
+	if policy, ok := p.byType[credType]; ok {
+		return policy
+	}
+	return p.byType[DefaultPolicyName]
+}
+
+// Compute calculates the dates for a credential of the given type issued at now
+func (p *Policies) Compute(credType string, now time.Time, req Request) (Dates, error) {
+	return p.For(credType).Compute(now, req)
+}
+
+// Compute calculates the dates for a credential issued at now, honouring the
+// dates requested by the caller if they are allowed by the policy
+func (policy Policy) Compute(now time.Time, req Request) (Dates, error) {
+
+	now = now.UTC().Truncate(time.Second)
+
+	d := Dates{
+		IssuanceDate: now,
+		ValidFrom:    now.Add(policy.NotBeforeDelay),
+	}
+
+	// The validFrom requested by the caller must be soon after the issuance, and before the end date
+	if !req.ValidFrom.IsZero() {
+		from := req.ValidFrom.UTC()
+		if from.Before(now) {
+			return d, fmt.Errorf("%w: validFrom is before the issuance date", ErrPolicyViolation)
+		}
+		if from.Sub(now) > policy.maxValidFromDelay() {
+			return d, fmt.Errorf("%w: validFrom is more than %s after the issuance date", ErrPolicyViolation, policy.maxValidFromDelay())
+		}
+		if policy.NotAfter != nil && !from.Before(*policy.NotAfter) {
+			return d, fmt.Errorf("%w: validFrom is not before %s", ErrPolicyViolation, policy.NotAfter.Format(time.RFC3339))
+		}
+		d.ValidFrom = from
+	}
+
+	// The expiration computed from the policy
+	switch {
+	case policy.Validity > 0:
+		d.ExpirationDate = d.ValidFrom.Add(policy.Validity)
+	case policy.NotAfter != nil:
+		d.ExpirationDate = policy.NotAfter.UTC()
+	}
+	if policy.NotAfter != nil && d.ExpirationDate.After(*policy.NotAfter) {
+		d.ExpirationDate = policy.NotAfter.UTC()
+	}
+
+	// The expiration requested by the caller can not go beyond the policy limits
+	if !req.ExpirationDate.IsZero() {
+		exp := req.ExpirationDate.UTC()
+		if policy.NotAfter != nil && exp.After(*policy.NotAfter) {
+			return d, fmt.Errorf("%w: expirationDate is after %s", ErrPolicyViolation, policy.NotAfter.Format(time.RFC3339))
+		}
+		if policy.MaxValidity > 0 && exp.Sub(d.ValidFrom) > policy.MaxValidity {
+			return d, fmt.Errorf("%w: validity is longer than %s", ErrPolicyViolation, policy.MaxValidity)
+		}
+		d.ExpirationDate = exp
+	}
+
+	if !d.ExpirationDate.After(d.ValidFrom) {
+		return d, fmt.Errorf("%w: expirationDate is not after validFrom", ErrPolicyViolation)
+	}
+
+	return d, nil
+}
+
+// RequestFromMap extracts the requested dates from the credential data, if they exist
+func RequestFromMap(data map[string]any) (req Request, err error) {
+	d := yaml.New(data)
+	if s := d.String("validFrom"); len(s) > 0 {
+		if req.ValidFrom, err = time.Parse(time.RFC3339, s); err != nil {
+			return req, fmt.Errorf("invalid validFrom: %w", err)
+		}
+	}
+	if s := d.String("expirationDate"); len(s) > 0 {
+		if req.ExpirationDate, err = time.Parse(time.RFC3339, s); err != nil {
+			return req, fmt.Errorf("invalid expirationDate: %w", err)
+		}
+	}
+	return req, nil
+}
+
+// Fields returns the dates in the form expected by the credential templates:
+// RFC3339 strings for the W3C VC data model, and NumericDate for the JWT claims.
+func (d Dates) Fields() map[string]any {
+	return map[string]any{
+		"issuanceDate":   d.IssuanceDate.Format(time.RFC3339),
+		"validFrom":      d.ValidFrom.Format(time.RFC3339),
+		"expirationDate": d.ExpirationDate.Format(time.RFC3339),
+		"iat":            d.IssuanceDate.Unix(),
+		"nbf":            d.ValidFrom.Unix(),
+		"exp":            d.ExpirationDate.Unix(),
+	}
+}
+
+// Apply sets the dates in a credential, overriding whatever the template generated.
+// The JWT claims are set at the top level, and the VC dates are set in the "vc" claim
+// for a JWT credential, or at the top level for a plain credential.
+func (d Dates) Apply(cred map[string]any) {
+
+	cred["iat"] = d.IssuanceDate.Unix()
+	cred["nbf"] = d.ValidFrom.Unix()
+	cred["exp"] = d.ExpirationDate.Unix()
+
+	vc, ok := cred["vc"].(map[string]any)
+	if !ok {
+		vc = cred
+	}
+	vc["issuanceDate"] = d.IssuanceDate.Format(time.RFC3339)
+	vc["validFrom"] = d.ValidFrom.Format(time.RFC3339)
+	vc["expirationDate"] = d.ExpirationDate.Format(time.RFC3339)
+
+}
@@ -0,0 +1,103 @@
+package issuance
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+var now = time.Date(2022, 10, 6, 18, 9, 14, 0, time.UTC)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Duration
+		err  bool
+	}{
+		{"", 0, false},
+		{"90d", 90 * 24 * time.Hour, false},
+		{"36h", 36 * time.Hour, false},
+		{"xd", 0, true},
+		{"ten", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseDuration(tt.in)
+		if (err != nil) != tt.err {
+			t.Errorf("ParseDuration(%q): unexpected error %v", tt.in, err)
+		}
+		if got != tt.want {
+			t.Errorf("ParseDuration(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestCompute(t *testing.T) {
+	notAfter := now.Add(30 * 24 * time.Hour)
+
+	p, err := FromConfig(map[string]any{
+		"default": map[string]any{"validity": "365d"},
+		"Short":   map[string]any{"validity": "90d", "notBeforeDelay": "1h", "notAfter": notAfter.Format(time.RFC3339)},
+		"Capped":  map[string]any{"validity": "30d", "maxValidity": "60d"},
+		"Delayed": map[string]any{"validity": "30d", "maxValidFromDelay": "7d"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		credType string
+		req      Request
+		wantFrom time.Time
+		wantExp  time.Time
+		err      error
+	}{
+		{"default", "Unknown", Request{}, now, now.Add(365 * 24 * time.Hour), nil},
+		{"fixed end date wins", "Short", Request{}, now.Add(time.Hour), notAfter, nil},
+		{"requested within max", "Capped", Request{ExpirationDate: now.Add(45 * 24 * time.Hour)}, now, now.Add(45 * 24 * time.Hour), nil},
+		{"requested beyond max", "Capped", Request{ExpirationDate: now.Add(90 * 24 * time.Hour)}, time.Time{}, time.Time{}, ErrPolicyViolation},
+		{"requested beyond end date", "Short", Request{ExpirationDate: notAfter.Add(time.Hour)}, time.Time{}, time.Time{}, ErrPolicyViolation},
+		{"backdated", "Capped", Request{ValidFrom: now.Add(-time.Hour)}, time.Time{}, time.Time{}, ErrPolicyViolation},
+		{"delayed within max", "Delayed", Request{ValidFrom: now.Add(7 * 24 * time.Hour)}, now.Add(7 * 24 * time.Hour), now.Add(37 * 24 * time.Hour), nil},
+		{"delayed beyond max", "Delayed", Request{ValidFrom: now.Add(8 * 24 * time.Hour)}, time.Time{}, time.Time{}, ErrPolicyViolation},
+		{"delayed within default max", "Capped", Request{ValidFrom: now.Add(20 * 24 * time.Hour)}, now.Add(20 * 24 * time.Hour), now.Add(50 * 24 * time.Hour), nil},
+		{"far future", "Unknown", Request{ValidFrom: now.Add(10 * 365 * 24 * time.Hour)}, time.Time{}, time.Time{}, ErrPolicyViolation},
+		{"far future with expiration", "Capped", Request{ValidFrom: now.AddDate(50, 0, 0), ExpirationDate: now.AddDate(50, 1, 0)}, time.Time{}, time.Time{}, ErrPolicyViolation},
+		{"at end date", "Short", Request{ValidFrom: notAfter}, time.Time{}, time.Time{}, ErrPolicyViolation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := p.Compute(tt.credType, now, tt.req)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("expected error %v, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !d.IssuanceDate.Equal(now) || !d.ValidFrom.Equal(tt.wantFrom) || !d.ExpirationDate.Equal(tt.wantExp) {
+				t.Errorf("got %+v, want validFrom %v and expiration %v", d, tt.wantFrom, tt.wantExp)
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	d, err := New().Compute("Any", now, Request{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cred := map[string]any{"vc": map[string]any{"issuanceDate": "2022-03-22T14:00:00Z"}}
+	d.Apply(cred)
+
+	if cred["exp"] != d.ExpirationDate.Unix() || cred["nbf"] != now.Unix() {
+		t.Errorf("JWT claims not set: %v", cred)
+	}
+	vc := cred["vc"].(map[string]any)
+	if vc["issuanceDate"] != now.Format(time.RFC3339) || vc["expirationDate"] != d.ExpirationDate.Format(time.RFC3339) {
+		t.Errorf("VC dates not set: %v", vc)
+	}
+}
@@ -0,0 +1,85 @@
+// Package logging creates the structured logger used by all the components of the server.
+//
+// The logger redacts the sensitive data: the fields with names like password, token, credential or email,
+// and the JWTs, credentials and email addresses in any message or value. The redaction can be disabled
+// only explicitly, for debugging.
+package logging
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Options configure the logger
+type Options struct {
+	// Level is the minimum level of the entries: DEBUG, INFO, WARN or ERROR
+	Level string
+	// JSON writes the entries in JSON, one per line, instead of for the console
+	JSON bool
+	// SensitiveData disables the redaction
+	SensitiveData bool
+	// DisableStacktrace omits the stack traces of the warnings and the errors, for the command line
+	DisableStacktrace bool
+}
+
+// New creates a logger with the options
+func New(opts Options) (*zap.Logger, error) {
+
+	level := zapcore.InfoLevel
+	if len(opts.Level) > 0 {
+		if err := level.UnmarshalText([]byte(strings.ToLower(opts.Level))); err != nil {
+			return nil, err
+		}
+	}
+
+	cfg := zap.NewDevelopmentConfig()
+	if opts.JSON {
+		cfg = zap.NewProductionConfig()
+	}
+	cfg.Level = zap.NewAtomicLevelAt(level)
+	cfg.DisableStacktrace = opts.DisableStacktrace
+
+	// The sampling would be bypassed by the redaction
+	cfg.Sampling = nil
+
+	logger, err := cfg.Build()
+	if err != nil {
+		return nil, err
+	}
+	if !opts.SensitiveData {
+		logger = logger.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+			return &redactingCore{Core: core}
+		}))
+	}
+	return logger, nil
+}
+
+// Setup creates a logger and sets it as the logger of the packages which use the global logger of zap
+func Setup(opts Options) (*zap.Logger, error) {
+	logger, err := New(opts)
+	if err != nil {
+		return nil, err
+	}
+	zap.ReplaceGlobals(logger)
+	return logger, nil
+}
+
+type contextKey struct{}
+
+// WithContext returns a copy of the context with the logger, like a logger with the id of a request
+func WithContext(ctx context.Context, logger *zap.SugaredLogger) context.Context {
+	return context.WithValue(ctx, contextKey{}, logger)
+}
+
+// FromContext returns the logger of the context, or the global logger if the context does not have one
+func FromContext(ctx context.Context) *zap.SugaredLogger {
+	if ctx != nil {
+		if logger, ok := ctx.Value(contextKey{}).(*zap.SugaredLogger); ok {
+			return logger
+		}
+	}
+	return zap.S()
+}
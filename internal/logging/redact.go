@@ -0,0 +1,142 @@
+package logging
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Redacted replaces the sensitive values
+const Redacted = "[REDACTED]"
+
+// sensitiveKeys are the names of the fields whose values are always redacted, in lowercase and
+// without separators, so "access_token" and "accessToken" are the same
+var sensitiveKeys = map[string]bool{
+	"password": true, "passwd": true, "pass": true, "secret": true, "clientsecret": true,
+	"token": true, "accesstoken": true, "refreshtoken": true, "idtoken": true, "vptoken": true,
+	"authorization": true, "cookie": true, "setcookie": true,
+	"jwt": true, "credential": true, "credentials": true, "vc": true, "vp": true, "claims": true, "body": true,
+	"email": true, "mail": true, "username": true, "givenname": true, "familyname": true, "firstname": true,
+	"lastname": true, "fullname": true, "displayname": true, "phone": true, "mobile": true, "telephone": true,
+	"address": true, "birthdate": true, "dateofbirth": true,
+}
+
+var (
+	jwtPattern   = regexp.MustCompile(`eyJ[A-Za-z0-9_-]*\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+)
+
+// IsSensitiveKey reports if the values of the fields with the name are redacted
+func IsSensitiveKey(key string) bool {
+	key = strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+	return sensitiveKeys[key]
+}
+
+// RedactString removes the credentials, the JWTs and the email addresses in a string
+func RedactString(s string) string {
+	if strings.Contains(s, `"credentialSubject"`) || strings.Contains(s, `"verifiableCredential"`) {
+		return Redacted + " credential"
+	}
+	s = jwtPattern.ReplaceAllString(s, Redacted+" JWT")
+	s = emailPattern.ReplaceAllString(s, Redacted+" email")
+	return s
+}
+
+// redactValue redacts a value decoded from JSON, with the sensitive keys of its maps
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for key, value := range v {
+			if IsSensitiveKey(key) {
+				m[key] = Redacted
+			} else {
+				m[key] = redactValue(value)
+			}
+		}
+		return m
+	case []any:
+		list := make([]any, len(v))
+		for i, value := range v {
+			list[i] = redactValue(value)
+		}
+		return list
+	case string:
+		return RedactString(v)
+	default:
+		return v
+	}
+}
+
+// redactField returns the field without sensitive data
+func redactField(f zapcore.Field) zapcore.Field {
+
+	if IsSensitiveKey(f.Key) {
+		return zap.String(f.Key, Redacted)
+	}
+
+	switch f.Type {
+	case zapcore.StringType:
+		return zap.String(f.Key, RedactString(f.String))
+	case zapcore.ByteStringType, zapcore.BinaryType:
+		if b, ok := f.Interface.([]byte); ok {
+			return zap.String(f.Key, RedactString(string(b)))
+		}
+	case zapcore.StringerType:
+		if s, ok := f.Interface.(fmt.Stringer); ok {
+			return zap.String(f.Key, RedactString(s.String()))
+		}
+	case zapcore.ErrorType:
+		if err, ok := f.Interface.(error); ok {
+			if msg := RedactString(err.Error()); msg != err.Error() {
+				return zap.NamedError(f.Key, errors.New(msg))
+			}
+		}
+	case zapcore.ReflectType:
+		// The structures and maps are redacted with their JSON representation
+		b, err := json.Marshal(f.Interface)
+		if err != nil {
+			return zap.String(f.Key, Redacted)
+		}
+		var v any
+		if err := json.Unmarshal(b, &v); err != nil {
+			return zap.String(f.Key, Redacted)
+		}
+		return zap.Any(f.Key, redactValue(v))
+	}
+	return f
+}
+
+func redactFields(fields []zapcore.Field) []zapcore.Field {
+	redacted := make([]zapcore.Field, len(fields))
+	for i, f := range fields {
+		redacted[i] = redactField(f)
+	}
+	return redacted
+}
+
+// redactingCore removes the sensitive data of the entries before writing them
+type redactingCore struct {
+	zapcore.Core
+}
+
+func (c *redactingCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactingCore{Core: c.Core.With(redactFields(fields))}
+}
+
+func (c *redactingCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *redactingCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	entry.Message = RedactString(entry.Message)
+	return c.Core.Write(entry, redactFields(fields))
+}
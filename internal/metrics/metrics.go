@@ -0,0 +1,133 @@
+// Package metrics defines the Prometheus metrics of the server: the issuance of credentials, the verification
+// of presentations, the calls to the SSI Kit, the signatures of the vaults, the HTTP requests
+// and the requests rejected by the rate limits.
+package metrics
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "vcbackend"
+
+// Outcomes of the issuance of credentials and of the verification of presentations
+const (
+	Issued   = "issued"
+	Pending  = "pending"
+	Failed   = "failed"
+	Accepted = "accepted"
+	Rejected = "rejected"
+)
+
+// Registry has the metrics of the server, and the metrics of the Go runtime and of the process
+var Registry = prometheus.NewRegistry()
+
+var (
+	CredentialsIssued = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "credentials_issued_total",
+		Help:      "Credentials requested to the issuer, by template and outcome (issued, pending approval or failed).",
+	}, []string{"template", "outcome"})
+
+	PresentationsVerified = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "presentations_verified_total",
+		Help:      "Presentations received by the verifier, by outcome and reason of the rejection.",
+	}, []string{"outcome", "reason"})
+
+	SSIKitRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "ssikit_request_duration_seconds",
+		Help:      "Duration of the calls to the SSI Kit, by operation.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation"})
+
+	SSIKitErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "ssikit_errors_total",
+		Help:      "Calls to the SSI Kit which failed or were answered with an error status, by operation.",
+	}, []string{"operation"})
+
+	VaultSigningDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "vault_signing_duration_seconds",
+		Help:      "Duration of the signatures made with the keys of the vaults, by algorithm.",
+		Buckets:   []float64{.0001, .00025, .0005, .001, .0025, .005, .01, .025, .05, .1},
+	}, []string{"alg"})
+
+	HTTPRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests, by route group, method and status.",
+	}, []string{"group", "method", "status"})
+
+	HTTPRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Duration of the HTTP requests, by route group and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"group", "method"})
+
+	RateLimited = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rate_limited_total",
+		Help:      "Requests rejected by the rate limits, by group of routes, or \"login\" for the locked out logins.",
+	}, []string{"group"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		CredentialsIssued,
+		PresentationsVerified,
+		SSIKitRequestDuration,
+		SSIKitErrors,
+		VaultSigningDuration,
+		HTTPRequests,
+		HTTPRequestDuration,
+		RateLimited,
+	)
+}
+
+// Handler returns the handler of the endpoint scraped by Prometheus
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+}
+
+// RegisterActiveSessions registers the gauge with the number of SIOP sessions in progress,
+// which is computed by the function each time the metrics are scraped
+func RegisterActiveSessions(count func() float64) error {
+	return Registry.Register(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "siop_sessions_active",
+		Help:      "SIOP sessions started by the verifier which have not received a presentation and have not expired.",
+	}, count))
+}
+
+// ObserveSSIKit records the duration of a call to the SSI Kit started at start, and whether it failed
+func ObserveSSIKit(operation string, start time.Time, failed bool) {
+	SSIKitRequestDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+	if failed {
+		SSIKitErrors.WithLabelValues(operation).Inc()
+	}
+}
+
+// routeGroups are the prefixes of the paths which are reported as a group
+var routeGroups = []string{"/issuer", "/verifier", "/wallet", "/core", "/registry"}
+
+// RouteGroup returns the group of a path, like "/issuer" for "/issuer/api/v1/credential/1234",
+// so the number of label values does not grow with the identifiers in the paths
+func RouteGroup(path string) string {
+	for _, group := range routeGroups {
+		if path == group || strings.HasPrefix(path, group+"/") {
+			return group
+		}
+	}
+	return "other"
+}
@@ -0,0 +1,68 @@
+package metrics
+
+import (
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestRouteGroup(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/issuer", "/issuer"},
+		{"/issuer/api/v1/credential/1234", "/issuer"},
+		{"/verifier/api/v1/poll/abc", "/verifier"},
+		{"/wallet/api/v1/selectcredential", "/wallet"},
+		{"/core/api/v1/createdid", "/core"},
+		{"/registry/api/v1/issuers", "/registry"},
+		{"/issuers", "other"},
+		{"/static/app.js", "other"},
+		{"/", "other"},
+	}
+	for _, tt := range tests {
+		if got := RouteGroup(tt.path); got != tt.want {
+			t.Errorf("RouteGroup(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestObserveSSIKit(t *testing.T) {
+	before := testutil.ToFloat64(SSIKitErrors.WithLabelValues("test"))
+
+	ObserveSSIKit("test", time.Now(), false)
+	ObserveSSIKit("test", time.Now(), true)
+
+	if got := testutil.ToFloat64(SSIKitErrors.WithLabelValues("test")) - before; got != 1 {
+		t.Errorf("errors = %v, want 1", got)
+	}
+	if got := testutil.CollectAndCount(SSIKitRequestDuration); got == 0 {
+		t.Error("the duration of the calls was not recorded")
+	}
+}
+
+func TestHandler(t *testing.T) {
+	CredentialsIssued.WithLabelValues("EmployeeCredential", Issued).Inc()
+	if err := RegisterActiveSessions(func() float64 { return 3 }); err != nil {
+		t.Fatal(err)
+	}
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body, _ := io.ReadAll(rec.Body)
+
+	for _, want := range []string{
+		`vcbackend_credentials_issued_total{outcome="issued",template="EmployeeCredential"} 1`,
+		`vcbackend_siop_sessions_active 3`,
+		`go_goroutines`,
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("the metrics do not have %s", want)
+		}
+	}
+}
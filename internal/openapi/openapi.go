@@ -0,0 +1,110 @@
+// Package openapi publishes the OpenAPI document of the API and validates the requests and the
+// responses against it.
+package openapi
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+)
+
+// Spec is the OpenAPI document of the API, in YAML
+//
+//go:embed openapi.yaml
+var Spec []byte
+
+// ErrUnknownOperation is returned when the request is not for an operation of the document
+var ErrUnknownOperation = errors.New("operation not in the OpenAPI document")
+
+// Validator validates the requests and the responses of the operations of the document
+type Validator struct {
+	doc    *openapi3.T
+	router routers.Router
+}
+
+// New loads and checks the OpenAPI document of the API
+func New() (*Validator, error) {
+
+	doc, err := openapi3.NewLoader().LoadFromData(Spec)
+	if err != nil {
+		return nil, err
+	}
+	if err := doc.Validate(context.Background()); err != nil {
+		return nil, err
+	}
+
+	router, err := legacy.NewRouter(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Validator{doc: doc, router: router}, nil
+}
+
+// Document returns the OpenAPI document
+func (v *Validator) Document() *openapi3.T {
+	return v.doc
+}
+
+// Operation is a request to an operation of the document, used to validate its response
+type Operation struct {
+	input *openapi3filter.RequestValidationInput
+}
+
+// ValidateRequest checks the parameters and the body of the request. It returns ErrUnknownOperation
+// if the request is not for an operation of the document.
+func (v *Validator) ValidateRequest(ctx context.Context, req *http.Request) (*Operation, error) {
+
+	// The servers of the document are relative, so the routes are matched only with the path
+	match := req.Clone(ctx)
+	match.URL = &url.URL{Path: req.URL.Path, RawPath: req.URL.RawPath, RawQuery: req.URL.RawQuery}
+
+	route, params, err := v.router.FindRoute(match)
+	if err != nil {
+		// The path or the method are not in the document
+		var re *routers.RouteError
+		if errors.As(err, &re) {
+			return nil, ErrUnknownOperation
+		}
+		return nil, err
+	}
+
+	input := &openapi3filter.RequestValidationInput{
+		Request:    req,
+		PathParams: params,
+		Route:      route,
+		Options: &openapi3filter.Options{
+			// The credentials are checked by the handlers
+			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+			MultiError:         true,
+		},
+	}
+	if err := openapi3filter.ValidateRequest(ctx, input); err != nil {
+		return nil, err
+	}
+
+	return &Operation{input: input}, nil
+}
+
+// ValidateResponse checks that the response of the operation is one of those in the document
+func (op *Operation) ValidateResponse(ctx context.Context, status int, header http.Header, body []byte) error {
+	return openapi3filter.ValidateResponse(ctx, &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: op.input,
+		Status:                 status,
+		Header:                 header,
+		Body:                   io.NopCloser(bytes.NewReader(body)),
+		Options: &openapi3filter.Options{
+			IncludeResponseStatus: true,
+			MultiError:            true,
+		},
+	})
+}
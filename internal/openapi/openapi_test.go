@@ -0,0 +1,107 @@
+package openapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func mustValidator(t *testing.T) *Validator {
+	t.Helper()
+	v, err := New()
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return v
+}
+
+func TestValidateRequest(t *testing.T) {
+
+	v := mustValidator(t)
+
+	tests := []struct {
+		name    string
+		method  string
+		target  string
+		body    string
+		wantErr bool
+	}{
+		{"valid query", http.MethodGet, "/api/v1/issuer/credentials?status=active&limit=10", "", false},
+		{"invalid enum", http.MethodGet, "/api/v1/issuer/credentials?status=unknown", "", true},
+		{"invalid integer", http.MethodGet, "/api/v1/issuer/credentials?limit=many", "", true},
+		{"path parameter", http.MethodGet, "/api/v1/issuer/credentials/urn:uuid:1234", "", false},
+		{"valid body", http.MethodPost, "/api/v1/issuer/holderdids", `{"holderId":"h1","proof":"eyJ"}`, false},
+		{"missing property", http.MethodPost, "/api/v1/issuer/holderdids", `{"holderId":"h1"}`, true},
+		{"wrong type", http.MethodPost, "/api/v1/issuer/credentialtypes/Employee/credentials", `{"claims":"x"}`, true},
+		{"missing body", http.MethodPost, "/api/v1/verifier/policies/dryrun", "", true},
+		{"absolute url", http.MethodGet, "http://localhost:3000/api/v1/verifier/sessions/abc", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if len(tt.body) > 0 {
+				req.Header.Set("Content-Type", "application/json")
+			}
+			_, err := v.ValidateRequest(context.Background(), req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateRequestUnknownOperation(t *testing.T) {
+
+	v := mustValidator(t)
+
+	for _, target := range []string{"/api/v1/unknown", "/x/api/v1/issuer/credentials"} {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		if _, err := v.ValidateRequest(context.Background(), req); !errors.Is(err, ErrUnknownOperation) {
+			t.Errorf("ValidateRequest(%s) error = %v, want ErrUnknownOperation", target, err)
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/v1/issuer/credentials", nil)
+	if _, err := v.ValidateRequest(context.Background(), req); !errors.Is(err, ErrUnknownOperation) {
+		t.Errorf("ValidateRequest(PATCH) error = %v, want ErrUnknownOperation", err)
+	}
+}
+
+func TestValidateResponse(t *testing.T) {
+
+	v := mustValidator(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/issuer/holderchallenge", nil)
+	op, err := v.ValidateRequest(context.Background(), req)
+	if err != nil {
+		t.Fatalf("ValidateRequest() error = %v", err)
+	}
+
+	json := http.Header{"Content-Type": {"application/json"}}
+	problem := http.Header{"Content-Type": {"application/problem+json"}}
+
+	tests := []struct {
+		name    string
+		status  int
+		header  http.Header
+		body    string
+		wantErr bool
+	}{
+		{"valid", 200, json, `{"nonce":"n","aud":"did:key:z"}`, false},
+		{"missing property", 200, json, `{"nonce":"n"}`, true},
+		{"problem", 500, problem, `{"type":"urn:vcbackend:problem:internal_server_error","title":"Internal Server Error","status":500,"code":"internal_server_error"}`, false},
+		{"invalid problem", 404, problem, `{"title":"Not Found"}`, true},
+		{"wrong content type", 200, http.Header{"Content-Type": {"text/plain"}}, "n", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := op.ValidateResponse(context.Background(), tt.status, tt.header, []byte(tt.body))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateResponse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
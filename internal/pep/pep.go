@@ -0,0 +1,275 @@
+// Package pep implements the decisions of the Policy Enforcement Point of the verifier: which upstream
+// service receives a request, whether the credential in the access token has the roles required by the
+// route, and the identity headers forwarded to the upstream service.
+package pep
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hesusruiz/vcutils/yaml"
+)
+
+// Headers with the identity of the holder of the credential, forwarded to the upstream services.
+// They are removed from the incoming requests, so clients can not forge them.
+const (
+	HeaderSubject        = "X-Subject"
+	HeaderEmail          = "X-Email"
+	HeaderRoles          = "X-Roles"
+	HeaderCredentialType = "X-Credential-Type"
+	HeaderIssuer         = "X-Credential-Issuer"
+)
+
+var IdentityHeaders = []string{HeaderSubject, HeaderEmail, HeaderRoles, HeaderCredentialType, HeaderIssuer}
+
+var (
+	ErrInvalidRoute = errors.New("invalid proxy route")
+	ErrForbidden    = errors.New("the credential does not have the required roles")
+)
+
+// Route sends the requests with a path prefix to an upstream service. When Roles is not empty,
+// the credential must have at least one of them, for the Target if it is set.
+// When Host is not empty, the route only applies to the requests for that host.
+// Service is the protected service whose access policy is evaluated for the requests.
+type Route struct {
+	Host        string
+	Path        string
+	Upstream    *url.URL
+	StripPrefix bool
+	Methods     []string
+	Roles       []string
+	Target      string
+	Service     string
+}
+
+// Router selects the route of a request
+type Router struct {
+	routes []*Route
+}
+
+// NewRouter reads the routes from their configuration
+func NewRouter(cfg []any) (*Router, error) {
+	return parseRoutes(cfg, true)
+}
+
+// NewRules reads the routes from their configuration when they only state the requirements of
+// the requests, because they are forwarded by an external proxy. The upstream is ignored.
+func NewRules(cfg []any) (*Router, error) {
+	return parseRoutes(cfg, false)
+}
+
+func parseRoutes(cfg []any, upstream bool) (*Router, error) {
+
+	r := &Router{}
+	for i, item := range cfg {
+		m, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%w: routes[%d] is not a map", ErrInvalidRoute, i)
+		}
+		rc := yaml.New(m)
+
+		route := &Route{
+			Host:        strings.ToLower(rc.String("host")),
+			Path:        "/" + strings.Trim(rc.String("path"), "/"),
+			StripPrefix: rc.Bool("stripPrefix"),
+			Target:      rc.String("target"),
+			Service:     rc.String("service"),
+		}
+		if upstream {
+			u, err := url.Parse(rc.String("upstream"))
+			if err != nil || len(u.Scheme) == 0 || len(u.Host) == 0 {
+				return nil, fmt.Errorf("%w: routes[%d]: the upstream must be an absolute URL", ErrInvalidRoute, i)
+			}
+			route.Upstream = u
+		}
+
+		for _, list := range []struct {
+			key    string
+			target *[]string
+		}{{"methods", &route.Methods}, {"roles", &route.Roles}} {
+			for _, v := range rc.List(list.key) {
+				s, ok := v.(string)
+				if !ok {
+					return nil, fmt.Errorf("%w: routes[%d]: %s must be strings", ErrInvalidRoute, i, list.key)
+				}
+				*list.target = append(*list.target, s)
+			}
+		}
+		for j := range route.Methods {
+			route.Methods[j] = strings.ToUpper(route.Methods[j])
+		}
+
+		r.routes = append(r.routes, route)
+	}
+
+	// The routes for a host are matched before the routes for any host, and the longest paths first
+	sort.SliceStable(r.routes, func(i, j int) bool {
+		if (len(r.routes[i].Host) > 0) != (len(r.routes[j].Host) > 0) {
+			return len(r.routes[i].Host) > 0
+		}
+		return len(r.routes[i].Path) > len(r.routes[j].Path)
+	})
+
+	return r, nil
+}
+
+// Match returns the route for the method, host and path, or nil
+func (r *Router) Match(method string, host string, path string) *Route {
+	host = strings.ToLower(host)
+	for _, route := range r.routes {
+		if len(route.Host) > 0 && route.Host != host {
+			continue
+		}
+		if route.Path != "/" && path != route.Path && !strings.HasPrefix(path, route.Path+"/") {
+			continue
+		}
+		if len(route.Methods) > 0 && !contains(route.Methods, method) {
+			continue
+		}
+		return route
+	}
+	return nil
+}
+
+// UpstreamURL returns the URL of the request in the upstream service
+func (route *Route) UpstreamURL(path string, rawQuery string) string {
+
+	if route.StripPrefix && route.Path != "/" {
+		path = strings.TrimPrefix(path, route.Path)
+	}
+
+	u := *route.Upstream
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + strings.TrimPrefix(path, "/")
+	u.RawPath = ""
+	u.RawQuery = rawQuery
+	return u.String()
+}
+
+// Authorize checks that the credential in the claims of the access token has the roles required by the route
+func (route *Route) Authorize(claims map[string]any) error {
+
+	if len(route.Roles) == 0 {
+		return nil
+	}
+
+	for _, role := range Roles(claims, route.Target) {
+		if contains(route.Roles, role) {
+			return nil
+		}
+	}
+
+	if len(route.Target) > 0 {
+		return fmt.Errorf("%w: one of %s for %s", ErrForbidden, strings.Join(route.Roles, ", "), route.Target)
+	}
+	return fmt.Errorf("%w: one of %s", ErrForbidden, strings.Join(route.Roles, ", "))
+}
+
+// Requirements returns the requirements stated in the headers of a request, as a comma separated
+// list of roles and the target of the roles, or nil when there are no roles
+func Requirements(roles string, target string) *Route {
+	route := &Route{Path: "/", Target: strings.TrimSpace(target)}
+	for _, role := range strings.Split(roles, ",") {
+		if role = strings.TrimSpace(role); len(role) > 0 {
+			route.Roles = append(route.Roles, role)
+		}
+	}
+	if len(route.Roles) == 0 {
+		return nil
+	}
+	return route
+}
+
+// Credential returns the credential in the claims of the access token, serialized as JSON
+func Credential(claims map[string]any) ([]byte, error) {
+	cred, ok := claims["verifiableCredential"].(map[string]any)
+	if !ok {
+		return nil, errors.New("the access token has no credential")
+	}
+	return json.Marshal(cred)
+}
+
+// subject returns the credential subject in the claims of the access token
+func subject(claims map[string]any) map[string]any {
+	cred, _ := claims["verifiableCredential"].(map[string]any)
+	subject, _ := cred["credentialSubject"].(map[string]any)
+	return subject
+}
+
+// Roles returns the names of the roles in the credential, for the target if it is not empty
+func Roles(claims map[string]any, target string) []string {
+
+	roles, _ := subject(claims)["roles"].([]any)
+
+	var names []string
+	for _, item := range roles {
+		role, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		if t, _ := role["target"].(string); len(target) > 0 && t != target {
+			continue
+		}
+		switch n := role["names"].(type) {
+		case string:
+			names = append(names, n)
+		case []any:
+			for _, name := range n {
+				if s, ok := name.(string); ok {
+					names = append(names, s)
+				}
+			}
+		}
+	}
+	return names
+}
+
+// Identity returns the identity headers for the upstream service
+func Identity(claims map[string]any, target string) map[string]string {
+
+	headers := map[string]string{}
+	sub := subject(claims)
+	cred, _ := claims["verifiableCredential"].(map[string]any)
+
+	if id, _ := claims["sub"].(string); len(id) > 0 {
+		headers[HeaderSubject] = id
+	} else if id, _ := sub["id"].(string); len(id) > 0 {
+		headers[HeaderSubject] = id
+	}
+	if email, _ := sub["email"].(string); len(email) > 0 {
+		headers[HeaderEmail] = email
+	}
+	if roles := Roles(claims, target); len(roles) > 0 {
+		headers[HeaderRoles] = strings.Join(roles, ",")
+	}
+
+	if types, ok := cred["type"].([]any); ok {
+		for _, t := range types {
+			if s, ok := t.(string); ok && s != "VerifiableCredential" {
+				headers[HeaderCredentialType] = s
+			}
+		}
+	}
+	switch issuer := cred["issuer"].(type) {
+	case string:
+		headers[HeaderIssuer] = issuer
+	case map[string]any:
+		if id, ok := issuer["id"].(string); ok {
+			headers[HeaderIssuer] = id
+		}
+	}
+
+	return headers
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
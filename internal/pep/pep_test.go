@@ -0,0 +1,142 @@
+package pep
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hesusruiz/vcutils/yaml"
+)
+
+const routesConfig = `
+routes:
+  - path: /orders
+    upstream: http://orders:8080/api
+    stripPrefix: true
+    roles: [P.Info, P.Create]
+    target: did:key:packetdelivery
+    service: orders
+  - path: /orders/admin
+    upstream: http://admin:8080
+    methods: [post]
+    roles: [P.Admin]
+  - path: /
+    upstream: http://public:8080
+`
+
+func newRouter(t *testing.T) *Router {
+	t.Helper()
+	cfg, err := yaml.ParseYamlBytes([]byte(routesConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewRouter(cfg.List("routes"))
+	if err != nil {
+		t.Fatalf("NewRouter() error = %v", err)
+	}
+	return r
+}
+
+func TestMatch(t *testing.T) {
+	r := newRouter(t)
+
+	tests := []struct {
+		method string
+		path   string
+		want   string
+	}{
+		{"GET", "/orders", "http://orders:8080/api/?a=1"},
+		{"GET", "/orders/123", "http://orders:8080/api/123?a=1"},
+		{"POST", "/orders/admin/x", "http://admin:8080/orders/admin/x?a=1"},
+		{"GET", "/orders/admin/x", "http://orders:8080/api/admin/x?a=1"},
+		{"GET", "/ordersx", "http://public:8080/ordersx?a=1"},
+	}
+	for _, tt := range tests {
+		route := r.Match(tt.method, "", tt.path)
+		if route == nil {
+			t.Errorf("Match(%s %s) = nil", tt.method, tt.path)
+			continue
+		}
+		if got := route.UpstreamURL(tt.path, "a=1"); got != tt.want {
+			t.Errorf("Match(%s %s) upstream = %s, want %s", tt.method, tt.path, got, tt.want)
+		}
+	}
+
+	if route := r.Match("GET", "", "/orders/123"); route.Service != "orders" {
+		t.Errorf("Match() service = %s", route.Service)
+	}
+
+	if _, err := NewRouter([]any{map[string]any{"path": "/a", "upstream": "orders"}}); !errors.Is(err, ErrInvalidRoute) {
+		t.Errorf("NewRouter() with a relative upstream error = %v", err)
+	}
+}
+
+func TestRules(t *testing.T) {
+	cfg, err := yaml.ParseYamlBytes([]byte(`
+routes:
+  - path: /
+    roles: [P.Info]
+  - host: Orders.example.com
+    path: /
+    roles: [P.Create]
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewRules(cfg.List("routes"))
+	if err != nil {
+		t.Fatalf("NewRules() error = %v", err)
+	}
+
+	if route := r.Match("GET", "orders.example.com", "/x"); route == nil || route.Roles[0] != "P.Create" {
+		t.Errorf("Match() for the host = %v", route)
+	}
+	if route := r.Match("GET", "other.example.com", "/x"); route == nil || route.Roles[0] != "P.Info" {
+		t.Errorf("Match() for another host = %v", route)
+	}
+
+	if route := Requirements(" P.Info, ,P.Admin", "did:key:packetdelivery"); route == nil ||
+		len(route.Roles) != 2 || route.Roles[1] != "P.Admin" || route.Target != "did:key:packetdelivery" {
+		t.Errorf("Requirements() = %v", route)
+	}
+	if route := Requirements(" ", "did:key:packetdelivery"); route != nil {
+		t.Errorf("Requirements() without roles = %v", route)
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	r := newRouter(t)
+	route := r.Match("GET", "", "/orders")
+
+	claims := func(roles ...any) map[string]any {
+		return map[string]any{
+			"sub": "did:key:holder",
+			"verifiableCredential": map[string]any{
+				"type":              []any{"VerifiableCredential", "PacketDeliveryService"},
+				"issuer":            map[string]any{"id": "did:key:issuer"},
+				"credentialSubject": map[string]any{"email": "a@b.c", "roles": roles},
+			},
+		}
+	}
+
+	allowed := claims(map[string]any{"target": "did:key:packetdelivery", "names": []any{"P.Info"}})
+	if err := route.Authorize(allowed); err != nil {
+		t.Errorf("Authorize() error = %v", err)
+	}
+
+	otherTarget := claims(map[string]any{"target": "did:key:other", "names": []any{"P.Info"}})
+	if err := route.Authorize(otherTarget); !errors.Is(err, ErrForbidden) {
+		t.Errorf("Authorize() with a role for another target error = %v", err)
+	}
+	if err := route.Authorize(claims()); !errors.Is(err, ErrForbidden) {
+		t.Errorf("Authorize() without roles error = %v", err)
+	}
+	if err := r.Match("GET", "", "/public").Authorize(claims()); err != nil {
+		t.Errorf("Authorize() of a route without roles error = %v", err)
+	}
+
+	headers := Identity(allowed, route.Target)
+	if headers[HeaderSubject] != "did:key:holder" || headers[HeaderEmail] != "a@b.c" || headers[HeaderRoles] != "P.Info" ||
+		headers[HeaderCredentialType] != "PacketDeliveryService" || headers[HeaderIssuer] != "did:key:issuer" {
+		t.Errorf("Identity() = %v", headers)
+	}
+}
@@ -0,0 +1,357 @@
+// Package policy implements the access policies of the services protected by the verifier.
+// A policy is a list of rules with conditions over the claims of the credential presented;
+// the first rule whose conditions are all true decides whether access is allowed or denied.
+package policy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hesusruiz/vcbackend/internal/vc"
+	"github.com/hesusruiz/vcutils/yaml"
+)
+
+// Effects of a rule
+const (
+	Allow = "allow"
+	Deny  = "deny"
+)
+
+var ErrInvalidPolicy = errors.New("invalid policy")
+
+// Policy is the access policy of a protected service
+type Policy struct {
+	Service     string `json:"service"`
+	Description string `json:"description,omitempty"`
+	Default     string `json:"default,omitempty"`
+	Rules       []Rule `json:"rules"`
+}
+
+// Rule applies its effect when all its conditions are true
+type Rule struct {
+	Name   string      `json:"name"`
+	Effect string      `json:"effect"`
+	When   []Condition `json:"when,omitempty"`
+}
+
+// Condition is a test over the values of a claim of the credential. The claim is a dotted path,
+// like "credentialSubject.roles[].names". A path not found at the top level of the credential is
+// looked up in the credential subject, so "roles" is the same as "credentialSubject.roles".
+// The values which are lists are expanded, so "[]" in the path is optional.
+type Condition struct {
+	Claim string `json:"claim,omitempty"`
+
+	// Exactly one of the following tests
+	Exists   *bool       `json:"exists,omitempty"`
+	Equals   any         `json:"equals,omitempty"`
+	In       []any       `json:"in,omitempty"`
+	Contains any         `json:"contains,omitempty"`
+	Matches  string      `json:"matches,omitempty"`
+	Any      []Condition `json:"any,omitempty"`
+	Valid    *bool       `json:"valid,omitempty"`
+
+	matches *regexp.Regexp
+}
+
+// Decision is the result of evaluating a policy
+type Decision struct {
+	Service      string   `json:"service"`
+	Allow        bool     `json:"allow"`
+	Rule         string   `json:"rule,omitempty"`
+	Explanations []string `json:"explanations"`
+}
+
+// Parse reads a policy in YAML or JSON format and checks that it is well formed
+func Parse(src []byte) (*Policy, error) {
+
+	doc, err := yaml.ParseYamlBytes(src)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPolicy, err)
+	}
+
+	// Convert to the structure using its JSON representation
+	b, err := json.Marshal(doc.Data())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPolicy, err)
+	}
+	p := &Policy{}
+	if err := json.Unmarshal(b, p); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPolicy, err)
+	}
+
+	if err := p.compile(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// compile checks the policy and prepares its conditions for evaluation
+func (p *Policy) compile() error {
+
+	if len(p.Service) == 0 {
+		return fmt.Errorf("%w: the service is required", ErrInvalidPolicy)
+	}
+	if len(p.Default) == 0 {
+		p.Default = Deny
+	}
+	if p.Default != Allow && p.Default != Deny {
+		return fmt.Errorf("%w: default must be %s or %s", ErrInvalidPolicy, Allow, Deny)
+	}
+
+	for i := range p.Rules {
+		rule := &p.Rules[i]
+		if len(rule.Name) == 0 {
+			rule.Name = fmt.Sprintf("rule %d", i+1)
+		}
+		if rule.Effect != Allow && rule.Effect != Deny {
+			return fmt.Errorf("%w: %s: effect must be %s or %s", ErrInvalidPolicy, rule.Name, Allow, Deny)
+		}
+		for j := range rule.When {
+			if err := rule.When[j].compile(); err != nil {
+				return fmt.Errorf("%w: %s: %v", ErrInvalidPolicy, rule.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *Condition) compile() error {
+
+	tests := 0
+	for _, set := range []bool{c.Exists != nil, c.Equals != nil, c.In != nil, c.Contains != nil,
+		len(c.Matches) > 0, c.Any != nil, c.Valid != nil} {
+		if set {
+			tests++
+		}
+	}
+	if tests != 1 {
+		return fmt.Errorf("condition on %q must have exactly one test", c.Claim)
+	}
+	if c.Valid == nil && len(c.Claim) == 0 {
+		return fmt.Errorf("the claim of a condition is required")
+	}
+
+	if len(c.Matches) > 0 {
+		re, err := regexp.Compile(c.Matches)
+		if err != nil {
+			return fmt.Errorf("condition on %s: %v", c.Claim, err)
+		}
+		c.matches = re
+	}
+
+	c.Equals = normalize(c.Equals)
+	c.Contains = normalize(c.Contains)
+	for i := range c.In {
+		c.In[i] = normalize(c.In[i])
+	}
+	for i := range c.Any {
+		if err := c.Any[i].compile(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Evaluate decides whether the credential gives access to the service at the given time
+func (p *Policy) Evaluate(cred *vc.Credential, at time.Time) *Decision {
+
+	d := &Decision{Service: p.Service}
+	input := document(cred)
+
+	for _, rule := range p.Rules {
+		reason := ""
+		for _, cond := range rule.When {
+			if ok, why := cond.evaluate(input, cred, at, true); !ok {
+				reason = why
+				break
+			}
+		}
+		if len(reason) > 0 {
+			d.Explanations = append(d.Explanations, rule.Name+": "+reason)
+			continue
+		}
+
+		d.Allow = rule.Effect == Allow
+		d.Rule = rule.Name
+		d.Explanations = append(d.Explanations, rule.Name+": all conditions are true, "+rule.Effect)
+		return d
+	}
+
+	d.Allow = p.Default == Allow
+	d.Explanations = append(d.Explanations, "no rule applies, default "+p.Default)
+	return d
+}
+
+// document returns the credential as evaluated by the policies, with the issuer as a string
+func document(cred *vc.Credential) map[string]any {
+	doc := make(map[string]any, len(cred.VC))
+	for k, v := range cred.VC {
+		doc[k] = v
+	}
+	doc["issuer"] = cred.Issuer()
+	return doc
+}
+
+// evaluate returns true if the condition is true for the object, or false and the reason why not
+func (c *Condition) evaluate(object map[string]any, cred *vc.Credential, at time.Time, top bool) (bool, string) {
+
+	if c.Valid != nil {
+		valid, why := validAt(cred, at)
+		if valid == *c.Valid {
+			return true, ""
+		}
+		if valid {
+			return false, "the credential is inside its validity period"
+		}
+		return false, why
+	}
+
+	values := resolve(object, c.Claim)
+	if len(values) == 0 && top {
+		if subject, ok := object["credentialSubject"].(map[string]any); ok {
+			values = resolve(subject, c.Claim)
+		}
+	}
+
+	switch {
+	case c.Exists != nil:
+		if (len(values) > 0) == *c.Exists {
+			return true, ""
+		}
+		if *c.Exists {
+			return false, c.Claim + " does not exist"
+		}
+		return false, c.Claim + " exists"
+
+	case c.Equals != nil:
+		for _, v := range values {
+			if !reflect.DeepEqual(v, c.Equals) {
+				return false, fmt.Sprintf("%s is %v, not %v", c.Claim, v, c.Equals)
+			}
+		}
+		if len(values) == 0 {
+			return false, c.Claim + " does not exist"
+		}
+		return true, ""
+
+	case c.In != nil:
+		for _, v := range values {
+			if !member(v, c.In) {
+				return false, fmt.Sprintf("%s has the value %v, not in %v", c.Claim, v, c.In)
+			}
+		}
+		if len(values) == 0 {
+			return false, c.Claim + " does not exist"
+		}
+		return true, ""
+
+	case c.Contains != nil:
+		if member(c.Contains, values) {
+			return true, ""
+		}
+		return false, fmt.Sprintf("%s does not contain %v", c.Claim, c.Contains)
+
+	case c.matches != nil:
+		for _, v := range values {
+			if s, ok := v.(string); ok && c.matches.MatchString(s) {
+				return true, ""
+			}
+		}
+		return false, fmt.Sprintf("%s does not match %s", c.Claim, c.Matches)
+
+	default:
+		// Some element must satisfy all the conditions
+		for _, v := range values {
+			element, ok := v.(map[string]any)
+			if !ok {
+				continue
+			}
+			all := true
+			for _, sub := range c.Any {
+				if ok, _ := sub.evaluate(element, cred, at, false); !ok {
+					all = false
+					break
+				}
+			}
+			if all {
+				return true, ""
+			}
+		}
+		return false, fmt.Sprintf("no element of %s satisfies all the conditions", c.Claim)
+	}
+}
+
+// resolve returns the values of a dotted path in the object, expanding the lists
+func resolve(object map[string]any, path string) []any {
+
+	values := []any{object}
+	for _, segment := range strings.Split(path, ".") {
+		name := strings.TrimSuffix(segment, "[]")
+
+		var next []any
+		for _, v := range expand(values) {
+			if m, ok := v.(map[string]any); ok {
+				if value, ok := m[name]; ok {
+					next = append(next, value)
+				}
+			}
+		}
+		values = next
+	}
+
+	return expand(values)
+}
+
+// expand replaces the lists by their elements
+func expand(values []any) []any {
+	var result []any
+	for _, v := range values {
+		if list, ok := v.([]any); ok {
+			result = append(result, list...)
+		} else {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// validAt returns true if the credential is inside its validity period, or false and the reason why not
+func validAt(cred *vc.Credential, at time.Time) (bool, string) {
+	if from := cred.ValidFrom(); from != nil && at.Before(*from) {
+		return false, "the credential is not valid until " + from.Format(time.RFC3339)
+	}
+	if until := cred.ExpiresAt(); until != nil && !at.Before(*until) {
+		return false, "the credential expired at " + until.Format(time.RFC3339)
+	}
+	return true, ""
+}
+
+func member(value any, list []any) bool {
+	for _, item := range list {
+		if reflect.DeepEqual(value, item) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalize converts a value to its JSON representation, so numbers compare equal whatever their type
+func normalize(value any) any {
+	if value == nil {
+		return nil
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+	var result any
+	json.Unmarshal(b, &result)
+	return result
+}
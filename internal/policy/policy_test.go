@@ -0,0 +1,135 @@
+package policy
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hesusruiz/vcbackend/internal/vc"
+)
+
+const packetDelivery = `
+service: packetdelivery
+rules:
+  - name: revoked issuer
+    effect: deny
+    when:
+      - claim: issuer
+        equals: did:key:revoked
+  - name: standard customers
+    effect: allow
+    when:
+      - valid: true
+      - claim: type
+        contains: PacketDeliveryService
+      - claim: issuer
+        in: [did:key:happypets, did:key:noxious]
+      - claim: roles
+        any:
+          - claim: target
+            equals: did:key:packetdelivery
+          - claim: names
+            contains: P.Info
+`
+
+func credential(t *testing.T, issuer string, roles string, expiration string) *vc.Credential {
+	t.Helper()
+	cred, err := vc.Decode([]byte(`{
+		"type": ["VerifiableCredential", "PacketDeliveryService"],
+		"issuer": {"id": "` + issuer + `"},
+		"issuanceDate": "2023-01-01T00:00:00Z",
+		"expirationDate": "` + expiration + `",
+		"credentialSubject": {"id": "did:key:holder", "roles": ` + roles + `}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cred
+}
+
+func TestEvaluate(t *testing.T) {
+	p, err := Parse([]byte(packetDelivery))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	at := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	infoRole := `[{"target": "did:key:other", "names": ["P.Create"]}, {"target": "did:key:packetdelivery", "names": ["P.Info"]}]`
+
+	tests := []struct {
+		name      string
+		cred      *vc.Credential
+		at        time.Time
+		wantAllow bool
+		wantRule  string
+	}{
+		{"allowed", credential(t, "did:key:happypets", infoRole, "2024-01-01T00:00:00Z"), at, true, "standard customers"},
+		{"denied issuer", credential(t, "did:key:revoked", infoRole, "2024-01-01T00:00:00Z"), at, false, "revoked issuer"},
+		{"unknown issuer", credential(t, "did:key:other", infoRole, "2024-01-01T00:00:00Z"), at, false, ""},
+		{"role in another target", credential(t, "did:key:happypets", `[{"target": "did:key:other", "names": ["P.Info"]}]`, "2024-01-01T00:00:00Z"), at, false, ""},
+		{"expired", credential(t, "did:key:happypets", infoRole, "2023-05-01T00:00:00Z"), at, false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := p.Evaluate(tt.cred, tt.at)
+			if d.Allow != tt.wantAllow || d.Rule != tt.wantRule {
+				t.Errorf("Evaluate() = %v by %q, want %v by %q; %v", d.Allow, d.Rule, tt.wantAllow, tt.wantRule, d.Explanations)
+			}
+			if len(d.Explanations) == 0 {
+				t.Error("Evaluate() returned no explanations")
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	invalid := []string{
+		`rules: []`,
+		`{service: a, default: maybe}`,
+		`{service: a, rules: [{effect: permit}]}`,
+		`{service: a, rules: [{effect: allow, when: [{claim: type}]}]}`,
+		`{service: a, rules: [{effect: allow, when: [{claim: type, exists: true, equals: a}]}]}`,
+		`{service: a, rules: [{effect: allow, when: [{claim: type, matches: "("}]}]}`,
+	}
+	for _, src := range invalid {
+		if _, err := Parse([]byte(src)); !errors.Is(err, ErrInvalidPolicy) {
+			t.Errorf("Parse(%s) error = %v", src, err)
+		}
+	}
+}
+
+func TestReload(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "packetdelivery.yaml")
+	os.WriteFile(file, []byte(packetDelivery), 0644)
+
+	s, err := LoadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reloaded, _ := s.Reload(); reloaded {
+		t.Error("Reload() without changes reloaded the policies")
+	}
+
+	// A new policy
+	os.WriteFile(filepath.Join(dir, "other.yaml"), []byte("service: other\ndefault: allow\n"), 0644)
+	if reloaded, err := s.Reload(); !reloaded || err != nil {
+		t.Errorf("Reload() = %v, %v", reloaded, err)
+	}
+	if p, ok := s.Get("other"); !ok || p.Default != Allow || len(s.All()) != 2 {
+		t.Errorf("policies after reload = %v", s.All())
+	}
+
+	// An invalid policy keeps the previous ones
+	os.WriteFile(filepath.Join(dir, "other.yaml"), []byte("service: other\ndefault: maybe\n"), 0644)
+	os.Chtimes(filepath.Join(dir, "other.yaml"), time.Now(), time.Now().Add(time.Second))
+	if _, err := s.Reload(); err == nil {
+		t.Error("Reload() of an invalid policy expected an error")
+	}
+	if _, ok := s.Get("packetdelivery"); !ok {
+		t.Error("the previous policies were not kept")
+	}
+}
@@ -0,0 +1,103 @@
+package policy
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Set holds the policies read from the YAML files of a directory, by service.
+// The policies can be reloaded while the server is running.
+type Set struct {
+	dir string
+
+	mu       sync.RWMutex
+	policies map[string]*Policy
+	modTimes map[string]time.Time
+}
+
+// LoadDir reads all the policies in the YAML files of a directory
+func LoadDir(dir string) (*Set, error) {
+	s := &Set{dir: dir}
+	if _, err := s.Reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Reload reads again the policies if any file of the directory was added, removed or modified.
+// It returns true if the policies were reloaded. When a policy is not valid, the previous ones are kept.
+func (s *Set) Reload() (bool, error) {
+
+	files, err := filepath.Glob(filepath.Join(s.dir, "*.yaml"))
+	if err != nil {
+		return false, err
+	}
+
+	modTimes := map[string]time.Time{}
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return false, err
+		}
+		modTimes[file] = info.ModTime()
+	}
+
+	s.mu.RLock()
+	changed := s.policies == nil || len(modTimes) != len(s.modTimes)
+	for file, t := range modTimes {
+		if !s.modTimes[file].Equal(t) {
+			changed = true
+		}
+	}
+	s.mu.RUnlock()
+	if !changed {
+		return false, nil
+	}
+
+	policies := map[string]*Policy{}
+	for _, file := range files {
+		src, err := os.ReadFile(file)
+		if err != nil {
+			return false, err
+		}
+		p, err := Parse(src)
+		if err != nil {
+			return false, fmt.Errorf("%s: %w", file, err)
+		}
+		if _, exists := policies[p.Service]; exists {
+			return false, fmt.Errorf("%s: %w: policy for service %s defined twice", file, ErrInvalidPolicy, p.Service)
+		}
+		policies[p.Service] = p
+	}
+
+	s.mu.Lock()
+	s.policies = policies
+	s.modTimes = modTimes
+	s.mu.Unlock()
+
+	return true, nil
+}
+
+// Get returns the policy of a service
+func (s *Set) Get(service string) (*Policy, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	p, ok := s.policies[service]
+	return p, ok
+}
+
+// All returns all the policies, sorted by service
+func (s *Set) All() []*Policy {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	all := make([]*Policy, 0, len(s.policies))
+	for _, p := range s.policies {
+		all = append(all, p)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Service < all[j].Service })
+	return all
+}
@@ -0,0 +1,167 @@
+// Package problem sends the errors of the API as problem details (RFC 7807).
+//
+// Each problem has a stable code, which identifies the kind of error independently of the
+// message, so the clients can handle the errors without parsing the messages.
+package problem
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+)
+
+// ContentType is the media type of the problem details
+const ContentType = "application/problem+json"
+
+// typePrefix is the prefix of the type of the problems, followed by the code
+const typePrefix = "urn:vcbackend:problem:"
+
+// The codes of the problems which are not identified only by the status of the response.
+// The other problems have as code the status text in snake case, like "not_found".
+const (
+	InternalError   = "internal_server_error"
+	InvalidRequest  = "invalid_request"
+	InvalidResponse = "invalid_response"
+	InvalidClaims   = "invalid_claims"
+	SSIKitError     = "ssikit_error"
+
+	TemplateNotFound      = "template_not_found"
+	TemplateNotPublished  = "template_not_published"
+	TemplateNotDraft      = "template_not_draft"
+	TemplateInvalid       = "template_invalid"
+	TemplateNotArchivable = "template_not_archivable"
+
+	CredentialNotFound   = "credential_not_found"
+	CredentialRevoked    = "credential_revoked"
+	CredentialRenewed    = "credential_renewed"
+	RenewalNotSupported  = "renewal_not_supported"
+	RenewalDataNotStored = "renewal_data_not_stored"
+	RenewalInProgress    = "renewal_in_progress"
+	PolicyViolation      = "issuance_policy_violation"
+
+	RequestNotFound   = "issuance_request_not_found"
+	RequestNotPending = "issuance_request_not_pending"
+	RequestNotAllowed = "issuance_request_not_allowed"
+
+	HolderUnknown      = "holder_unknown"
+	HolderUnresolvable = "holder_did_unresolvable"
+	HolderProofInvalid = "holder_proof_invalid"
+	HolderProofReplay  = "holder_proof_replayed"
+	HolderDIDConflict  = "holder_did_conflict"
+
+	ClientNotFound        = "client_not_found"
+	ClientExists          = "client_exists"
+	ClientInvalid         = "client_invalid"
+	RedirectURIInvalid    = "redirect_uri_invalid"
+	CredentialTypeRefused = "credential_type_not_allowed"
+	AccessPolicyDenied    = "access_policy_denied"
+
+	NonceRequired = "nonce_required"
+	NonceUnknown  = "nonce_unknown"
+	NonceExpired  = "nonce_expired"
+	NonceMismatch = "nonce_mismatch"
+	NonceReplayed = "nonce_replayed"
+
+	SessionNotFound     = "session_not_found"
+	PresentationInvalid = "presentation_invalid"
+
+	IssuerNotFound      = "issuer_not_found"
+	IssuerExists        = "issuer_exists"
+	IssuerInvalid       = "issuer_invalid"
+	IssuerNotTrusted    = "issuer_not_trusted"
+	CredentialInvalid   = "credential_invalid"
+	CredentialSignature = "credential_signature_invalid"
+)
+
+// Error is an error with the status of the response and the code of the problem
+type Error struct {
+	Status int
+	Code   string
+	Detail string
+	// Errors are the details of the error, like the invalid fields of a request
+	Errors any
+}
+
+// New creates an error with the status and the code of the problem
+func New(status int, code string, detail string) *Error {
+	return &Error{Status: status, Code: code, Detail: detail}
+}
+
+func (e *Error) Error() string {
+	return e.Detail
+}
+
+// Unwrap returns the error as a Fiber error with the status, so the middlewares which look for
+// the status of the response in the errors of the handlers find it
+func (e *Error) Unwrap() error {
+	return fiber.NewError(e.Status, e.Detail)
+}
+
+// WithErrors sets the details of the error
+func (e *Error) WithErrors(errs any) *Error {
+	e.Errors = errs
+	return e
+}
+
+// Problem is the document with the details of a problem
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	Code     string `json:"code"`
+	Errors   any    `json:"errors,omitempty"`
+}
+
+// StatusCode returns the code of the problems which are identified only by the status
+func StatusCode(status int) string {
+	return strings.ReplaceAll(strings.ToLower(utils.StatusMessage(status)), " ", "_")
+}
+
+// From returns the problem of an error. The errors without a status are internal errors,
+// whose messages are not sent to the clients.
+func From(err error) *Problem {
+
+	var pe *Error
+	if errors.As(err, &pe) {
+		return newProblem(pe.Status, pe.Code, pe.Detail, pe.Errors)
+	}
+
+	var fe *fiber.Error
+	if errors.As(err, &fe) {
+		return newProblem(fe.Code, StatusCode(fe.Code), fe.Message, nil)
+	}
+
+	return newProblem(fiber.StatusInternalServerError, InternalError, "", nil)
+}
+
+func newProblem(status int, code string, detail string, errs any) *Problem {
+	if len(code) == 0 {
+		code = StatusCode(status)
+	}
+	return &Problem{
+		Type:   typePrefix + code,
+		Title:  utils.StatusMessage(status),
+		Status: status,
+		Detail: detail,
+		Code:   code,
+		Errors: errs,
+	}
+}
+
+// Send writes the problem as the response
+func Send(c *fiber.Ctx, p *Problem) error {
+
+	body, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	c.Status(p.Status)
+	c.Set(fiber.HeaderContentType, ContentType)
+	return c.Send(body)
+}
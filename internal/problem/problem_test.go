@@ -0,0 +1,110 @@
+package problem
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestStatusCode(t *testing.T) {
+	tests := map[int]string{
+		fiber.StatusNotFound:            "not_found",
+		fiber.StatusBadRequest:          "bad_request",
+		fiber.StatusTooManyRequests:     "too_many_requests",
+		fiber.StatusInternalServerError: "internal_server_error",
+	}
+	for status, want := range tests {
+		if got := StatusCode(status); got != want {
+			t.Errorf("StatusCode(%d) = %q, want %q", status, got, want)
+		}
+	}
+}
+
+func TestFrom(t *testing.T) {
+
+	fieldErrors := []string{"email: is required"}
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+		wantDetail string
+		wantErrors bool
+	}{
+		{"problem", New(fiber.StatusConflict, TemplateNotDraft, "not a draft"), fiber.StatusConflict, TemplateNotDraft, "not a draft", false},
+		{"wrapped problem", fmt.Errorf("publishing: %w", New(fiber.StatusNotFound, TemplateNotFound, "no template")), fiber.StatusNotFound, TemplateNotFound, "no template", false},
+		{"problem with errors", New(fiber.StatusBadRequest, InvalidClaims, "invalid").WithErrors(fieldErrors), fiber.StatusBadRequest, InvalidClaims, "invalid", true},
+		{"problem without code", New(fiber.StatusNotFound, "", "missing"), fiber.StatusNotFound, "not_found", "missing", false},
+		{"fiber error", fiber.NewError(fiber.StatusForbidden, "denied"), fiber.StatusForbidden, "forbidden", "denied", false},
+		{"internal error", errors.New("database is locked"), fiber.StatusInternalServerError, InternalError, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := From(tt.err)
+			if p.Status != tt.wantStatus || p.Code != tt.wantCode || p.Detail != tt.wantDetail {
+				t.Errorf("From() = %d %q %q, want %d %q %q", p.Status, p.Code, p.Detail, tt.wantStatus, tt.wantCode, tt.wantDetail)
+			}
+			if p.Type != typePrefix+tt.wantCode {
+				t.Errorf("From().Type = %q", p.Type)
+			}
+			if (p.Errors != nil) != tt.wantErrors {
+				t.Errorf("From().Errors = %v", p.Errors)
+			}
+		})
+	}
+}
+
+func TestErrorStatus(t *testing.T) {
+
+	// The middlewares find the status of the problems as Fiber errors
+	var fe *fiber.Error
+	if err := error(New(fiber.StatusConflict, ClientExists, "exists")); !errors.As(err, &fe) || fe.Code != fiber.StatusConflict {
+		t.Errorf("errors.As(*fiber.Error) = %v", fe)
+	}
+}
+
+func TestSend(t *testing.T) {
+
+	app := fiber.New()
+	app.Get("/", func(c *fiber.Ctx) error {
+		p := From(New(fiber.StatusGone, NonceExpired, "the nonce has expired"))
+		p.Instance = c.Path()
+		return Send(c, p)
+	})
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != fiber.StatusGone {
+		t.Errorf("status = %d, want %d", resp.StatusCode, fiber.StatusGone)
+	}
+	if ct := resp.Header.Get(fiber.HeaderContentType); ct != ContentType {
+		t.Errorf("content type = %q, want %q", ct, ContentType)
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	got := map[string]any{}
+	if err := json.Unmarshal(body, &got); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"type":     "urn:vcbackend:problem:nonce_expired",
+		"title":    "Gone",
+		"status":   float64(fiber.StatusGone),
+		"detail":   "the nonce has expired",
+		"instance": "/",
+		"code":     "nonce_expired",
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("%s = %v, want %v", k, got[k], v)
+		}
+	}
+}
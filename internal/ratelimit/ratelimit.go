@@ -0,0 +1,175 @@
+// Package ratelimit limits the rate of the requests of each client, counting them in fixed windows of time.
+//
+// The counts are kept by a Counter, in memory for a single instance or in a store shared by all the
+// instances of the server, like the vault of the verifier.
+package ratelimit
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Counter counts the events of each key in fixed windows of time
+type Counter interface {
+	// Add adds n events to the key, in the window of the given duration which contains the time, and
+	// returns the events of the key in the window. With n equal to zero it only reads them.
+	Add(ctx context.Context, key string, window time.Duration, now time.Time, n int) (int, error)
+	// Reset removes the events of the key in the window which contains the time
+	Reset(ctx context.Context, key string, window time.Duration, now time.Time) error
+}
+
+// Window returns the start and the end of the window of the given duration which contains the time.
+// The windows are aligned to the Unix epoch, so all the instances of the server agree on them.
+func Window(window time.Duration, now time.Time) (time.Time, time.Time) {
+	start := now.Truncate(window)
+	return start, start.Add(window)
+}
+
+// WindowKey returns the key of the events of a key in the window which contains the time
+func WindowKey(key string, window time.Duration, now time.Time) string {
+	start, _ := Window(window, now)
+	return key + "@" + strconv.FormatInt(start.Unix(), 10)
+}
+
+// Rule is a limit of events in a window of time. A rule without a limit allows all the events.
+type Rule struct {
+	Limit  int
+	Window time.Duration
+}
+
+// Decision is the result of checking an event with a rule
+type Decision struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// RetryAfter returns the time until the window of a decision ends
+func (d *Decision) RetryAfter(now time.Time) time.Duration {
+	if wait := d.Reset.Sub(now); wait > 0 {
+		return wait
+	}
+	return 0
+}
+
+// Limiter checks the events of the clients with the rules
+type Limiter struct {
+	counter Counter
+	now     func() time.Time
+}
+
+// New creates a limiter counting the events with the counter
+func New(counter Counter) *Limiter {
+	return &Limiter{counter: counter, now: time.Now}
+}
+
+// Allow counts an event of the key and returns if it is within the limit of the rule
+func (l *Limiter) Allow(ctx context.Context, key string, rule Rule) (*Decision, error) {
+	return l.check(ctx, key, rule, 1)
+}
+
+// Peek returns if the events of the key are within the limit of the rule, without counting a new one.
+// It is used with Fail for the events which only count when they fail, like the logins.
+func (l *Limiter) Peek(ctx context.Context, key string, rule Rule) (*Decision, error) {
+	return l.check(ctx, key, rule, 0)
+}
+
+// Fail counts a failed event of the key, which Peek compares with the limit
+func (l *Limiter) Fail(ctx context.Context, key string, rule Rule) error {
+	if rule.Limit <= 0 {
+		return nil
+	}
+	_, err := l.counter.Add(ctx, key, rule.Window, l.now(), 1)
+	return err
+}
+
+// Clear forgets the events of the key in the current window, like the failed logins after a successful one
+func (l *Limiter) Clear(ctx context.Context, key string, rule Rule) error {
+	if rule.Limit <= 0 {
+		return nil
+	}
+	return l.counter.Reset(ctx, key, rule.Window, l.now())
+}
+
+func (l *Limiter) check(ctx context.Context, key string, rule Rule, n int) (*Decision, error) {
+
+	if rule.Limit <= 0 {
+		return &Decision{Allowed: true}, nil
+	}
+
+	now := l.now()
+	count, err := l.counter.Add(ctx, key, rule.Window, now, n)
+	if err != nil {
+		return nil, err
+	}
+	_, end := Window(rule.Window, now)
+
+	d := &Decision{Limit: rule.Limit, Reset: end}
+	if n > 0 {
+		d.Allowed = count <= rule.Limit
+	} else {
+		d.Allowed = count < rule.Limit
+	}
+	if remaining := rule.Limit - count; remaining > 0 {
+		d.Remaining = remaining
+	}
+	return d, nil
+}
+
+// Memory is a Counter for a single instance of the server
+type Memory struct {
+	mu      sync.Mutex
+	counts  map[string]*memoryCount
+	cleaned time.Time
+}
+
+type memoryCount struct {
+	count   int
+	expires time.Time
+}
+
+// NewMemory creates a counter in memory
+func NewMemory() *Memory {
+	return &Memory{counts: map[string]*memoryCount{}}
+}
+
+// Add implements Counter
+func (m *Memory) Add(ctx context.Context, key string, window time.Duration, now time.Time, n int) (int, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// The counts of the past windows are removed from time to time
+	if now.Sub(m.cleaned) > time.Minute {
+		for k, c := range m.counts {
+			if !now.Before(c.expires) {
+				delete(m.counts, k)
+			}
+		}
+		m.cleaned = now
+	}
+
+	k := WindowKey(key, window, now)
+	c := m.counts[k]
+	if c == nil {
+		if n == 0 {
+			return 0, nil
+		}
+		_, end := Window(window, now)
+		c = &memoryCount{expires: end}
+		m.counts[k] = c
+	}
+	c.count += n
+	return c.count, nil
+}
+
+// Reset implements Counter
+func (m *Memory) Reset(ctx context.Context, key string, window time.Duration, now time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.counts, WindowKey(key, window, now))
+	return nil
+}
@@ -0,0 +1,105 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestWindow(t *testing.T) {
+	now := time.Unix(1000, 0)
+
+	start, end := Window(time.Minute, now)
+	if start.Unix() != 960 || end.Unix() != 1020 {
+		t.Errorf("Window() = %d, %d, want 960, 1020", start.Unix(), end.Unix())
+	}
+	if WindowKey("ip:10.0.0.1", time.Minute, now) != "ip:10.0.0.1@960" {
+		t.Errorf("WindowKey() = %s", WindowKey("ip:10.0.0.1", time.Minute, now))
+	}
+	if WindowKey("k", time.Minute, now) != WindowKey("k", time.Minute, now.Add(19*time.Second)) {
+		t.Error("the times of the same window have different keys")
+	}
+	if WindowKey("k", time.Minute, now) == WindowKey("k", time.Minute, now.Add(20*time.Second)) {
+		t.Error("the times of different windows have the same key")
+	}
+}
+
+func TestAllow(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1000, 0)
+	l := New(NewMemory())
+	l.now = func() time.Time { return now }
+
+	rule := Rule{Limit: 3, Window: time.Minute}
+	for i := 1; i <= 3; i++ {
+		d, err := l.Allow(ctx, "a", rule)
+		if err != nil || !d.Allowed || d.Remaining != 3-i {
+			t.Fatalf("Allow() %d = %+v, %v", i, d, err)
+		}
+	}
+
+	d, _ := l.Allow(ctx, "a", rule)
+	if d.Allowed || d.Remaining != 0 || d.Reset.Unix() != 1020 || d.RetryAfter(now) != 20*time.Second {
+		t.Errorf("Allow() over the limit = %+v", d)
+	}
+
+	// The keys are counted separately
+	if d, _ := l.Allow(ctx, "b", rule); !d.Allowed {
+		t.Error("Allow() of another key should be allowed")
+	}
+
+	// The next window starts again
+	now = now.Add(20 * time.Second)
+	if d, _ := l.Allow(ctx, "a", rule); !d.Allowed || d.Remaining != 2 {
+		t.Errorf("Allow() in the next window = %+v", d)
+	}
+
+	// Without a limit everything is allowed
+	for i := 0; i < 10; i++ {
+		if d, _ := l.Allow(ctx, "a", Rule{}); !d.Allowed {
+			t.Fatal("Allow() without a limit should be allowed")
+		}
+	}
+}
+
+func TestLockout(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1000, 0)
+	l := New(NewMemory())
+	l.now = func() time.Time { return now }
+
+	rule := Rule{Limit: 2, Window: 15 * time.Minute}
+	for i := 0; i < 2; i++ {
+		if d, _ := l.Peek(ctx, "login:alice", rule); !d.Allowed {
+			t.Fatalf("Peek() after %d failures should be allowed", i)
+		}
+		if err := l.Fail(ctx, "login:alice", rule); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if d, _ := l.Peek(ctx, "login:alice", rule); d.Allowed {
+		t.Error("Peek() after the limit of failures should not be allowed")
+	}
+
+	// A successful login forgets the failures
+	l.Clear(ctx, "login:alice", rule)
+	if d, _ := l.Peek(ctx, "login:alice", rule); !d.Allowed || d.Remaining != 2 {
+		t.Errorf("Peek() after Clear = %+v", d)
+	}
+}
+
+func TestMemoryCleanup(t *testing.T) {
+	ctx := context.Background()
+	m := NewMemory()
+	now := time.Unix(1000, 0)
+
+	m.Add(ctx, "a", time.Minute, now, 1)
+	if n, _ := m.Add(ctx, "a", time.Minute, now, 0); n != 1 {
+		t.Errorf("Add() with zero events = %d, want 1", n)
+	}
+
+	m.Add(ctx, "b", time.Minute, now.Add(5*time.Minute), 1)
+	if len(m.counts) != 1 {
+		t.Errorf("%d counts after the window, want 1", len(m.counts))
+	}
+}
@@ -0,0 +1,117 @@
+// Package rp implements the relying-party clients registered in the verifier. Each client is an
+// application using the verifier for the login of its users, with the redirect URIs where the users
+// return after the login, the credentials it requires and the properties of its access tokens.
+package rp
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hesusruiz/vcbackend/internal/issuance"
+)
+
+var (
+	ErrNotFound        = errors.New("relying party not found")
+	ErrInvalidClient   = errors.New("invalid relying party")
+	ErrInvalidRedirect = errors.New("the redirect URI is not registered for the relying party")
+	ErrCredentialType  = errors.New("the credential is not of a type required by the relying party")
+)
+
+// Client is a relying party registered in the verifier
+type Client struct {
+	ID                     string         `json:"clientId"`
+	Name                   string         `json:"name,omitempty"`
+	RedirectURIs           []string       `json:"redirectUris"`
+	CredentialTypes        []string       `json:"credentialTypes,omitempty"`
+	PresentationDefinition map[string]any `json:"presentationDefinition,omitempty"`
+	Scope                  string         `json:"scope,omitempty"`
+	Audience               string         `json:"audience,omitempty"`
+	TokenLifetime          string         `json:"tokenLifetime,omitempty"`
+	SigningAlg             string         `json:"signingAlg,omitempty"`
+	Service                string         `json:"service,omitempty"`
+}
+
+// Validate checks that the client is well formed
+func (c *Client) Validate() error {
+
+	if len(c.ID) == 0 {
+		return fmt.Errorf("%w: the client id is required", ErrInvalidClient)
+	}
+	if len(c.RedirectURIs) == 0 {
+		return fmt.Errorf("%w: at least one redirect URI is required", ErrInvalidClient)
+	}
+	for _, uri := range c.RedirectURIs {
+		u, err := url.Parse(uri)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+			return fmt.Errorf("%w: the redirect URI %q must be an absolute http(s) URL", ErrInvalidClient, uri)
+		}
+		if len(u.Fragment) > 0 {
+			return fmt.Errorf("%w: the redirect URI %q can not have a fragment", ErrInvalidClient, uri)
+		}
+	}
+	if len(c.TokenLifetime) > 0 {
+		if d, err := issuance.ParseDuration(c.TokenLifetime); err != nil || d <= 0 {
+			return fmt.Errorf("%w: invalid token lifetime %q", ErrInvalidClient, c.TokenLifetime)
+		}
+	}
+
+	return nil
+}
+
+// RedirectURI returns the redirect URI requested, which must be registered exactly,
+// or the first one registered when none is requested
+func (c *Client) RedirectURI(requested string) (string, error) {
+	if len(requested) == 0 {
+		return c.RedirectURIs[0], nil
+	}
+	for _, uri := range c.RedirectURIs {
+		if uri == requested {
+			return uri, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s", ErrInvalidRedirect, requested)
+}
+
+// Accepts checks that the credential, with the given types, is of one of the types required by the client.
+// Any credential is accepted when the client does not require a type.
+func (c *Client) Accepts(types []string) error {
+	if len(c.CredentialTypes) == 0 {
+		return nil
+	}
+	for _, required := range c.CredentialTypes {
+		for _, t := range types {
+			if t == required {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("%w: one of %s", ErrCredentialType, strings.Join(c.CredentialTypes, ", "))
+}
+
+// Lifetime returns the lifetime of the access tokens of the client, or the default one if it is not set
+func (c *Client) Lifetime(defaultLifetime time.Duration) time.Duration {
+	if d, err := issuance.ParseDuration(c.TokenLifetime); err == nil && d > 0 {
+		return d
+	}
+	return defaultLifetime
+}
+
+// TokenAudience returns the audience of the access tokens of the client, which is the client id by default
+func (c *Client) TokenAudience() string {
+	if len(c.Audience) > 0 {
+		return c.Audience
+	}
+	return c.ID
+}
+
+// PolicyService returns the protected service whose access policy is evaluated in the logins of the client,
+// which is the client id by default
+func (c *Client) PolicyService() string {
+	if len(c.Service) > 0 {
+		return c.Service
+	}
+	return c.ID
+}
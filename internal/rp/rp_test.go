@@ -0,0 +1,75 @@
+package rp
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+var orders = &Client{
+	ID:              "orders",
+	RedirectURIs:    []string{"https://orders.example.com/callback", "http://localhost:8080/callback"},
+	CredentialTypes: []string{"PacketDeliveryService"},
+	TokenLifetime:   "15m",
+}
+
+func TestValidate(t *testing.T) {
+	if err := orders.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	invalid := []*Client{
+		{RedirectURIs: []string{"https://orders.example.com"}},
+		{ID: "orders"},
+		{ID: "orders", RedirectURIs: []string{"/callback"}},
+		{ID: "orders", RedirectURIs: []string{"https://orders.example.com/#x"}},
+		{ID: "orders", RedirectURIs: []string{"https://orders.example.com"}, TokenLifetime: "soon"},
+	}
+	for _, c := range invalid {
+		if err := c.Validate(); !errors.Is(err, ErrInvalidClient) {
+			t.Errorf("Validate(%+v) error = %v", c, err)
+		}
+	}
+}
+
+func TestRedirectURI(t *testing.T) {
+	if uri, err := orders.RedirectURI(""); err != nil || uri != "https://orders.example.com/callback" {
+		t.Errorf("RedirectURI() = %s, %v", uri, err)
+	}
+	if uri, err := orders.RedirectURI("http://localhost:8080/callback"); err != nil || uri != "http://localhost:8080/callback" {
+		t.Errorf("RedirectURI() = %s, %v", uri, err)
+	}
+	if _, err := orders.RedirectURI("https://orders.example.com/callback/x"); !errors.Is(err, ErrInvalidRedirect) {
+		t.Errorf("RedirectURI() of a URI not registered error = %v", err)
+	}
+}
+
+func TestAccepts(t *testing.T) {
+	if err := orders.Accepts([]string{"VerifiableCredential", "PacketDeliveryService"}); err != nil {
+		t.Errorf("Accepts() error = %v", err)
+	}
+	if err := orders.Accepts([]string{"VerifiableCredential", "EmployeeCredential"}); !errors.Is(err, ErrCredentialType) {
+		t.Errorf("Accepts() of another type error = %v", err)
+	}
+	if err := (&Client{ID: "any"}).Accepts([]string{"VerifiableCredential"}); err != nil {
+		t.Errorf("Accepts() without required types error = %v", err)
+	}
+}
+
+func TestTokens(t *testing.T) {
+	if d := orders.Lifetime(time.Hour); d != 15*time.Minute {
+		t.Errorf("Lifetime() = %v", d)
+	}
+	if d := (&Client{}).Lifetime(time.Hour); d != time.Hour {
+		t.Errorf("Lifetime() by default = %v", d)
+	}
+	if aud := orders.TokenAudience(); aud != "orders" {
+		t.Errorf("TokenAudience() = %s", aud)
+	}
+	if service := orders.PolicyService(); service != "orders" {
+		t.Errorf("PolicyService() = %s", service)
+	}
+	if service := (&Client{ID: "orders", Service: "packetdelivery"}).PolicyService(); service != "packetdelivery" {
+		t.Errorf("PolicyService() = %s", service)
+	}
+}
@@ -0,0 +1,51 @@
+package til
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Client reads the trusted issuers from a remote Trusted Issuers Registry API
+type Client struct {
+	URL    string
+	Client *http.Client
+}
+
+// NewClient returns a client of the Trusted Issuers Registry at the given URL
+func NewClient(registryURL string) *Client {
+	return &Client{
+		URL:    strings.TrimSuffix(registryURL, "/"),
+		Client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// TrustedIssuer retrieves an issuer from the registry, with GET /v4/issuers/{did}
+func (c *Client) TrustedIssuer(did string) (*TrustedIssuer, error) {
+
+	resp, err := c.Client.Get(c.URL + "/v4/issuers/" + url.PathEscape(did))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("trusted issuers registry returned status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		DID        string      `json:"did"`
+		Attributes []Attribute `json:"attributes"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	return FromAttributes(did, body.Attributes)
+}
@@ -0,0 +1,277 @@
+// Package til implements the Trusted Issuers List: which issuers may issue which types of credentials,
+// with which values of their claims and during which period.
+// The format of the entries follows the i4Trust Trusted Issuers List.
+package til
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hesusruiz/vcbackend/internal/vc"
+)
+
+var (
+	ErrNotFound       = errors.New("issuer not found in the trusted issuers list")
+	ErrInvalidIssuer  = errors.New("invalid trusted issuer")
+	ErrNotTrusted     = errors.New("the issuer is not trusted")
+	ErrInvalidPayload = errors.New("invalid credential")
+)
+
+// TrustedIssuer is an issuer with the credentials it is trusted to issue
+type TrustedIssuer struct {
+	DID         string       `json:"did"`
+	Credentials []Credential `json:"credentials"`
+}
+
+// Credential is a type of credential that an issuer may issue
+type Credential struct {
+	ValidFor        *TimeRange `json:"validFor,omitempty"`
+	CredentialsType string     `json:"credentialsType"`
+	Claims          []Claim    `json:"claims,omitempty"`
+}
+
+// TimeRange is the period when the issuer is trusted. Any of the limits may be missing.
+type TimeRange struct {
+	From *time.Time `json:"from,omitempty"`
+	To   *time.Time `json:"to,omitempty"`
+}
+
+// Claim restricts the values of a claim of the credential subject. The claims which are not listed
+// may have any value.
+type Claim struct {
+	Name          string `json:"name"`
+	AllowedValues []any  `json:"allowedValues,omitempty"`
+}
+
+// Registry is the source of the trusted issuers, either local or remote
+type Registry interface {
+	TrustedIssuer(did string) (*TrustedIssuer, error)
+}
+
+// Validate checks that the trusted issuer is well formed
+func (ti *TrustedIssuer) Validate() error {
+	if !strings.HasPrefix(ti.DID, "did:") {
+		return fmt.Errorf("%w: the did is not a DID", ErrInvalidIssuer)
+	}
+	for i, cred := range ti.Credentials {
+		if len(cred.CredentialsType) == 0 {
+			return fmt.Errorf("%w: credentials[%d]: the credentialsType is required", ErrInvalidIssuer, i)
+		}
+		if r := cred.ValidFor; r != nil && r.From != nil && r.To != nil && !r.From.Before(*r.To) {
+			return fmt.Errorf("%w: credentials[%d]: validFor.from must be before validFor.to", ErrInvalidIssuer, i)
+		}
+		for j, claim := range cred.Claims {
+			if len(claim.Name) == 0 {
+				return fmt.Errorf("%w: credentials[%d].claims[%d]: the name is required", ErrInvalidIssuer, i, j)
+			}
+		}
+	}
+	return nil
+}
+
+// Contains returns true if the time is inside the range
+func (r *TimeRange) Contains(t time.Time) bool {
+	if r == nil {
+		return true
+	}
+	if r.From != nil && t.Before(*r.From) {
+		return false
+	}
+	if r.To != nil && !t.Before(*r.To) {
+		return false
+	}
+	return true
+}
+
+// Presented is the data of a credential needed to check its issuer
+type Presented struct {
+	Issuer  string
+	Types   []string
+	Subject map[string]any
+}
+
+// ParseCredential extracts the issuer, types and subject of a credential, either a JWT or a JSON-LD credential
+func ParseCredential(raw []byte) (*Presented, error) {
+
+	cred, err := vc.Decode(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+	}
+
+	p := &Presented{
+		Issuer:  cred.Issuer(),
+		Subject: cred.Subject(),
+	}
+	for _, t := range cred.Types() {
+		if t != "VerifiableCredential" {
+			p.Types = append(p.Types, t)
+		}
+	}
+
+	if len(p.Issuer) == 0 || len(p.Types) == 0 {
+		return nil, fmt.Errorf("%w: the issuer and the type are required", ErrInvalidPayload)
+	}
+
+	return p, nil
+}
+
+// Check returns nil if the issuer is trusted to issue the credential at the given time
+func (ti *TrustedIssuer) Check(p *Presented, at time.Time) error {
+
+	var reasons []string
+	for _, credType := range p.Types {
+		for _, cred := range ti.Credentials {
+			if cred.CredentialsType != credType {
+				continue
+			}
+			if !cred.ValidFor.Contains(at) {
+				reasons = append(reasons, credType+" is outside the validity period")
+				continue
+			}
+			reason := checkClaims(cred.Claims, p.Subject)
+			if len(reason) == 0 {
+				return nil
+			}
+			reasons = append(reasons, reason)
+		}
+	}
+
+	if len(reasons) == 0 {
+		return fmt.Errorf("%w: %s may not issue %s", ErrNotTrusted, ti.DID, strings.Join(p.Types, ", "))
+	}
+	return fmt.Errorf("%w: %s", ErrNotTrusted, strings.Join(reasons, "; "))
+}
+
+// Verify checks in the registry that the issuer of the credential is trusted to issue it
+func Verify(r Registry, raw []byte, at time.Time) (*Presented, error) {
+
+	p, err := ParseCredential(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	ti, err := r.TrustedIssuer(p.Issuer)
+	if errors.Is(err, ErrNotFound) {
+		return p, fmt.Errorf("%w: %s is not in the trusted issuers list", ErrNotTrusted, p.Issuer)
+	}
+	if err != nil {
+		return p, err
+	}
+
+	return p, ti.Check(p, at)
+}
+
+// checkClaims returns the reason why the subject does not comply with the restrictions, or an empty string
+func checkClaims(claims []Claim, subject map[string]any) string {
+	for _, claim := range claims {
+		value, ok := subject[claim.Name]
+		if !ok || len(claim.AllowedValues) == 0 {
+			continue
+		}
+		if !contained(normalize(value), normalize(claim.AllowedValues)) {
+			return fmt.Sprintf("the value of %s is not allowed", claim.Name)
+		}
+	}
+	return ""
+}
+
+// contained returns true if the value is allowed by the allowed value: a list allows its elements,
+// every element of a list must be allowed, and an object allows objects with a subset of its fields
+// where each field is allowed.
+func contained(value any, allowed any) bool {
+
+	if values, ok := value.([]any); ok {
+		for _, v := range values {
+			if !contained(v, allowed) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if alternatives, ok := allowed.([]any); ok {
+		for _, a := range alternatives {
+			if contained(value, a) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if object, ok := value.(map[string]any); ok {
+		allowedObject, ok := allowed.(map[string]any)
+		if !ok {
+			return false
+		}
+		for k, v := range object {
+			a, ok := allowedObject[k]
+			if !ok || !contained(v, a) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return reflect.DeepEqual(value, allowed)
+}
+
+// normalize converts a value to its JSON representation, so numbers and nested structures compare equal
+func normalize(value any) any {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+	var result any
+	json.Unmarshal(b, &result)
+	return result
+}
+
+// Attribute is an entry of an issuer in the Trusted Issuers Registry API, with the body encoded in base64
+type Attribute struct {
+	Hash       string `json:"hash"`
+	Body       string `json:"body"`
+	IssuerType string `json:"issuerType"`
+}
+
+// Attributes encodes the credentials of the issuer as the attributes of the Trusted Issuers Registry API
+func (ti *TrustedIssuer) Attributes() []Attribute {
+	attributes := make([]Attribute, 0, len(ti.Credentials))
+	for _, cred := range ti.Credentials {
+		body, _ := json.Marshal(cred)
+		attributes = append(attributes, Attribute{
+			Hash:       hash(body),
+			Body:       base64.StdEncoding.EncodeToString(body),
+			IssuerType: "Undefined",
+		})
+	}
+	return attributes
+}
+
+// FromAttributes decodes the attributes of an issuer in the Trusted Issuers Registry API
+func FromAttributes(did string, attributes []Attribute) (*TrustedIssuer, error) {
+	ti := &TrustedIssuer{DID: did}
+	for _, attr := range attributes {
+		body, err := base64.StdEncoding.DecodeString(attr.Body)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidIssuer, err)
+		}
+		var cred Credential
+		if err := json.Unmarshal(body, &cred); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidIssuer, err)
+		}
+		ti.Credentials = append(ti.Credentials, cred)
+	}
+	return ti, nil
+}
+
+func hash(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
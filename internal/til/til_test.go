@@ -0,0 +1,123 @@
+package til
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+)
+
+type mapRegistry map[string]*TrustedIssuer
+
+func (r mapRegistry) TrustedIssuer(did string) (*TrustedIssuer, error) {
+	ti, ok := r[did]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return ti, nil
+}
+
+func date(s string) *time.Time {
+	t, _ := time.Parse(time.RFC3339, s)
+	return &t
+}
+
+var packetDelivery = &TrustedIssuer{
+	DID: "did:key:happypets",
+	Credentials: []Credential{
+		{
+			ValidFor:        &TimeRange{From: date("2023-01-01T00:00:00Z"), To: date("2024-01-01T00:00:00Z")},
+			CredentialsType: "PacketDeliveryService",
+			Claims: []Claim{
+				{
+					Name: "roles",
+					AllowedValues: []any{
+						map[string]any{"target": "did:key:packetdelivery", "names": []any{"P.Info", "P.Create"}},
+					},
+				},
+				{Name: "level", AllowedValues: []any{1, 2}},
+			},
+		},
+	},
+}
+
+func credential(subject string) []byte {
+	return []byte(`{
+		"type": ["VerifiableCredential", "PacketDeliveryService"],
+		"issuer": {"id": "did:key:happypets"},
+		"credentialSubject": ` + subject + `
+	}`)
+}
+
+func TestVerify(t *testing.T) {
+	registry := mapRegistry{packetDelivery.DID: packetDelivery}
+	inside := *date("2023-06-01T00:00:00Z")
+
+	tests := []struct {
+		name    string
+		raw     []byte
+		at      time.Time
+		wantErr error
+	}{
+		{"allowed", credential(`{"roles": [{"target": "did:key:packetdelivery", "names": ["P.Info"]}], "level": 2}`), inside, nil},
+		{"unrestricted claims", credential(`{"email": "a@b.c"}`), inside, nil},
+		{"role not allowed", credential(`{"roles": [{"target": "did:key:packetdelivery", "names": ["P.Delete"]}]}`), inside, ErrNotTrusted},
+		{"target not allowed", credential(`{"roles": [{"target": "did:key:other", "names": ["P.Info"]}]}`), inside, ErrNotTrusted},
+		{"value not allowed", credential(`{"level": 3}`), inside, ErrNotTrusted},
+		{"expired", credential(`{}`), *date("2024-02-01T00:00:00Z"), ErrNotTrusted},
+		{"unknown issuer", []byte(`{"type": ["PacketDeliveryService"], "issuer": "did:key:other", "credentialSubject": {}}`), inside, ErrNotTrusted},
+		{"other type", []byte(`{"type": ["EmployeeCredential"], "issuer": "did:key:happypets", "credentialSubject": {}}`), inside, ErrNotTrusted},
+		{"not a credential", []byte(`a.b`), inside, ErrInvalidPayload},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Verify(registry, tt.raw, tt.at)
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("Verify() error = %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVerifyJWT(t *testing.T) {
+	registry := mapRegistry{packetDelivery.DID: packetDelivery}
+
+	payload := `{"iss": "did:key:happypets", "vc": {"type": ["VerifiableCredential", "PacketDeliveryService"], "credentialSubject": {"level": 1}}}`
+	jwt := "eyJhbGciOiJFUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".sig"
+
+	if _, err := Verify(registry, []byte(jwt), *date("2023-06-01T00:00:00Z")); err != nil {
+		t.Errorf("Verify() error = %v", err)
+	}
+}
+
+func TestAttributes(t *testing.T) {
+	ti, err := FromAttributes(packetDelivery.DID, packetDelivery.Attributes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &Presented{Issuer: ti.DID, Types: []string{"PacketDeliveryService"}, Subject: map[string]any{"level": 2.0}}
+	if err := ti.Check(p, *date("2023-06-01T00:00:00Z")); err != nil {
+		t.Errorf("Check() after the round trip error = %v", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	invalid := []*TrustedIssuer{
+		{DID: "happypets"},
+		{DID: "did:key:a", Credentials: []Credential{{}}},
+		{DID: "did:key:a", Credentials: []Credential{{CredentialsType: "A", ValidFor: &TimeRange{From: date("2024-01-01T00:00:00Z"), To: date("2023-01-01T00:00:00Z")}}}},
+		{DID: "did:key:a", Credentials: []Credential{{CredentialsType: "A", Claims: []Claim{{}}}}},
+	}
+	for i, ti := range invalid {
+		if err := ti.Validate(); !errors.Is(err, ErrInvalidIssuer) {
+			t.Errorf("Validate(%d) error = %v", i, err)
+		}
+	}
+	if err := packetDelivery.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
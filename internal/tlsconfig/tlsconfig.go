@@ -0,0 +1,155 @@
+// Package tlsconfig configures the TLS of the server: its certificate, which is reloaded when its files
+// change, and the certificate authorities of the clients which authenticate with mutual TLS.
+package tlsconfig
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// ErrNoCertificate is returned when a PEM file does not have a certificate
+var ErrNoCertificate = errors.New("no certificate found")
+
+// Files are the files of the certificate of the server and of the certificate authorities of the clients.
+// Without the authorities the clients are not asked for a certificate.
+type Files struct {
+	CertFile     string
+	KeyFile      string
+	ClientCAFile string
+}
+
+// Reloader keeps the certificate of the server and the authorities of the clients, reading them again
+// when their files change, so the certificates can be renewed without restarting the server
+type Reloader struct {
+	files Files
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	modTimes  map[string]time.Time
+}
+
+// Load reads the certificate of the server and the authorities of the clients
+func Load(files Files) (*Reloader, error) {
+	r := &Reloader{files: files}
+	if _, err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload reads again the files if any of them was modified. It returns true if they were reloaded.
+// When a file is not valid, the previous certificates are kept.
+func (r *Reloader) Reload() (bool, error) {
+
+	names := []string{r.files.CertFile, r.files.KeyFile}
+	if len(r.files.ClientCAFile) > 0 {
+		names = append(names, r.files.ClientCAFile)
+	}
+
+	modTimes := map[string]time.Time{}
+	for _, name := range names {
+		info, err := os.Stat(name)
+		if err != nil {
+			return false, err
+		}
+		modTimes[name] = info.ModTime()
+	}
+
+	r.mu.RLock()
+	changed := r.cert == nil
+	for name, t := range modTimes {
+		if !r.modTimes[name].Equal(t) {
+			changed = true
+		}
+	}
+	r.mu.RUnlock()
+	if !changed {
+		return false, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.files.CertFile, r.files.KeyFile)
+	if err != nil {
+		return false, err
+	}
+
+	var clientCAs *x509.CertPool
+	if len(r.files.ClientCAFile) > 0 {
+		src, err := os.ReadFile(r.files.ClientCAFile)
+		if err != nil {
+			return false, err
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(src) {
+			return false, fmt.Errorf("%s: %w", r.files.ClientCAFile, ErrNoCertificate)
+		}
+	}
+
+	r.mu.Lock()
+	r.cert = &cert
+	r.clientCAs = clientCAs
+	r.modTimes = modTimes
+	r.mu.Unlock()
+
+	return true, nil
+}
+
+// Config returns the TLS configuration of the server, which uses the certificates loaded when each
+// connection starts. If there are authorities of the clients, the clients can present a certificate,
+// which must be issued by one of them, and the connections without a certificate are also accepted.
+func (r *Reloader) Config() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+
+			config := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.cert},
+				NextProtos:   []string{"http/1.1"},
+			}
+			if r.clientCAs != nil {
+				config.ClientCAs = r.clientCAs
+				config.ClientAuth = tls.VerifyClientCertIfGiven
+			}
+			return config, nil
+		},
+	}
+}
+
+// Certificate returns the certificate of the server currently used
+func (r *Reloader) Certificate() *tls.Certificate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert
+}
+
+// Fingerprint returns the SHA-256 fingerprint of a certificate in hexadecimal, which identifies the
+// certificate of a client
+func Fingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// ParseCertificate parses the first certificate of a PEM file
+func ParseCertificate(src []byte) (*x509.Certificate, error) {
+	for {
+		var block *pem.Block
+		block, src = pem.Decode(src)
+		if block == nil {
+			return nil, ErrNoCertificate
+		}
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+}
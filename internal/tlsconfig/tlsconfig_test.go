@@ -0,0 +1,191 @@
+package tlsconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newCert creates a certificate signed by the parent, or self-signed without a parent
+func newCert(t *testing.T, name string, parent *tls.Certificate) *tls.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		DNSNames:              []string{name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+	}
+
+	signer, signerKey := template, any(key)
+	if parent == nil {
+		template.IsCA = true
+		template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature
+	} else {
+		signer, signerKey = parent.Leaf, parent.PrivateKey
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
+
+// writeCert writes the certificate and its key in PEM files
+func writeCert(t *testing.T, cert *tls.Certificate, certFile string, keyFile string) {
+	t.Helper()
+
+	key, err := x509.MarshalECPrivateKey(cert.PrivateKey.(*ecdsa.PrivateKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if len(keyFile) > 0 {
+		if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: key}), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// handshake connects to the server with the client certificate, if any, and returns the certificates
+// of the client verified by the server
+func handshake(t *testing.T, server *tls.Config, root *x509.CertPool, client *tls.Certificate) ([]*x509.Certificate, error) {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	config := &tls.Config{RootCAs: root, ServerName: "localhost"}
+	if client != nil {
+		// Sent even if it is not issued by the authorities requested by the server
+		config.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return client, nil
+		}
+	}
+	go func() {
+		conn, err := tls.Dial("tcp", ln.Addr().String(), config)
+		if err == nil {
+			// The client reads until the server closes the connection
+			io.Copy(io.Discard, conn)
+			conn.Close()
+		}
+	}()
+
+	serverConn, err := ln.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn := tls.Server(serverConn, server)
+	defer conn.Close()
+	if err := conn.Handshake(); err != nil {
+		return nil, err
+	}
+	return conn.ConnectionState().PeerCertificates, nil
+}
+
+func TestReloader(t *testing.T) {
+	dir := t.TempDir()
+	files := Files{
+		CertFile:     filepath.Join(dir, "server.crt"),
+		KeyFile:      filepath.Join(dir, "server.key"),
+		ClientCAFile: filepath.Join(dir, "clients.crt"),
+	}
+
+	ca := newCert(t, "ca", nil)
+	clientCA := newCert(t, "clients", nil)
+	writeCert(t, newCert(t, "localhost", ca), files.CertFile, files.KeyFile)
+	writeCert(t, clientCA, files.ClientCAFile, "")
+
+	r, err := Load(files)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	root := x509.NewCertPool()
+	root.AddCert(ca.Leaf)
+
+	// The clients can connect without a certificate, or with one of the authorities
+	if peers, err := handshake(t, r.Config(), root, nil); err != nil || len(peers) != 0 {
+		t.Errorf("handshake without a client certificate = %d, %v", len(peers), err)
+	}
+	client := newCert(t, "client", clientCA)
+	peers, err := handshake(t, r.Config(), root, client)
+	if err != nil || len(peers) != 1 || Fingerprint(peers[0]) != Fingerprint(client.Leaf) {
+		t.Errorf("handshake with a client certificate = %d, %v", len(peers), err)
+	}
+	if _, err := handshake(t, r.Config(), root, newCert(t, "client", ca)); err == nil {
+		t.Error("handshake with a certificate of another authority should fail")
+	}
+
+	if reloaded, err := r.Reload(); reloaded || err != nil {
+		t.Errorf("Reload() without changes = %v, %v", reloaded, err)
+	}
+
+	// A renewed certificate is used by the new connections
+	renewed := newCert(t, "localhost", ca)
+	writeCert(t, renewed, files.CertFile, files.KeyFile)
+	later := time.Now().Add(time.Minute)
+	os.Chtimes(files.CertFile, later, later)
+	if reloaded, err := r.Reload(); !reloaded || err != nil {
+		t.Fatalf("Reload() after renewing the certificate = %v, %v", reloaded, err)
+	}
+	if string(r.Certificate().Certificate[0]) != string(renewed.Certificate[0]) {
+		t.Error("Reload() did not load the renewed certificate")
+	}
+
+	// An invalid certificate is not loaded
+	os.WriteFile(files.CertFile, []byte("invalid"), 0600)
+	os.Chtimes(files.CertFile, later.Add(time.Minute), later.Add(time.Minute))
+	if _, err := r.Reload(); err == nil {
+		t.Error("Reload() of an invalid certificate should fail")
+	}
+	if string(r.Certificate().Certificate[0]) != string(renewed.Certificate[0]) {
+		t.Error("the previous certificate was not kept")
+	}
+}
+
+func TestParseCertificate(t *testing.T) {
+	cert := newCert(t, "client", nil)
+	file := filepath.Join(t.TempDir(), "client.crt")
+	writeCert(t, cert, file, "")
+	src, _ := os.ReadFile(file)
+
+	parsed, err := ParseCertificate(append([]byte("# client\n"), src...))
+	if err != nil || parsed.Subject.CommonName != "client" {
+		t.Fatalf("ParseCertificate() = %v, %v", parsed, err)
+	}
+	if len(Fingerprint(parsed)) != 64 || Fingerprint(parsed) != Fingerprint(cert.Leaf) {
+		t.Errorf("Fingerprint() = %s", Fingerprint(parsed))
+	}
+	if _, err := ParseCertificate([]byte("no PEM")); !errors.Is(err, ErrNoCertificate) {
+		t.Errorf("ParseCertificate() without a certificate error = %v", err)
+	}
+}
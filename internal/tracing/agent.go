@@ -0,0 +1,97 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/valyala/fasthttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Do sends the request of the agent in a span named name, with the trace context in the headers
+// of the request so the service which receives it can continue the trace
+func Do(ctx context.Context, name string, agent *fiber.Agent) (code int, body []byte, errs []error) {
+
+	req := agent.Request()
+	ctx, span := Tracer().Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPMethod(string(req.Header.Method())),
+			semconv.HTTPURL(req.URI().String()),
+		),
+	)
+	defer span.End()
+
+	otel.GetTextMapPropagator().Inject(ctx, RequestHeaderCarrier{&req.Header})
+
+	code, body, errs = agent.Bytes()
+	if len(errs) > 0 {
+		for _, err := range errs {
+			span.RecordError(err)
+		}
+		span.SetStatus(codes.Error, errs[0].Error())
+		return code, body, errs
+	}
+
+	span.SetAttributes(semconv.HTTPStatusCode(code))
+	if code >= 400 {
+		span.SetStatus(codes.Error, fmt.Sprintf("status %d", code))
+	}
+	return code, body, errs
+}
+
+// DoHTTP sends the request with the client in a span named name, like Do. The span ends when the
+// headers of the response are received, so the body can be streamed afterwards.
+func DoHTTP(ctx context.Context, name string, client *http.Client, req *http.Request) (*http.Response, error) {
+
+	ctx, span := Tracer().Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPMethod(req.Method),
+			semconv.HTTPURL(req.URL.String()),
+		),
+	)
+	defer span.End()
+
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetAttributes(semconv.HTTPStatusCode(resp.StatusCode))
+	if resp.StatusCode >= 400 {
+		span.SetStatus(codes.Error, fmt.Sprintf("status %d", resp.StatusCode))
+	}
+	return resp, nil
+}
+
+// RequestHeaderCarrier reads and writes the trace context in the headers of a request
+type RequestHeaderCarrier struct {
+	Header *fasthttp.RequestHeader
+}
+
+func (c RequestHeaderCarrier) Get(key string) string {
+	return string(c.Header.Peek(key))
+}
+
+func (c RequestHeaderCarrier) Set(key string, value string) {
+	c.Header.Set(key, value)
+}
+
+func (c RequestHeaderCarrier) Keys() []string {
+	var keys []string
+	c.Header.VisitAll(func(key, _ []byte) {
+		keys = append(keys, string(key))
+	})
+	return keys
+}
@@ -0,0 +1,103 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+
+	"entgo.io/ent/dialect"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Driver wraps the driver of a database, with a span for each statement executed as part of a request.
+// The statements have placeholders for their arguments, so the values are not in the spans.
+func Driver(drv dialect.Driver) dialect.Driver {
+	return &driver{Driver: drv}
+}
+
+type driver struct {
+	dialect.Driver
+}
+
+func (d *driver) Exec(ctx context.Context, query string, args, v any) error {
+	ctx, span := startStatement(ctx, d.Dialect(), query)
+	return end(span, d.Driver.Exec(ctx, query, args, v))
+}
+
+func (d *driver) Query(ctx context.Context, query string, args, v any) error {
+	ctx, span := startStatement(ctx, d.Dialect(), query)
+	return end(span, d.Driver.Query(ctx, query, args, v))
+}
+
+// ExecContext executes a statement which is not built by ent, like the lock of a table
+func (d *driver) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	var res sql.Result
+	err := d.Exec(ctx, query, args, &res)
+	return res, err
+}
+
+func (d *driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	t, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &tx{Tx: t, dialect: d.Dialect()}, nil
+}
+
+type tx struct {
+	dialect.Tx
+	dialect string
+}
+
+func (t *tx) Exec(ctx context.Context, query string, args, v any) error {
+	ctx, span := startStatement(ctx, t.dialect, query)
+	return end(span, t.Tx.Exec(ctx, query, args, v))
+}
+
+func (t *tx) Query(ctx context.Context, query string, args, v any) error {
+	ctx, span := startStatement(ctx, t.dialect, query)
+	return end(span, t.Tx.Query(ctx, query, args, v))
+}
+
+// ExecContext executes in the transaction a statement which is not built by ent
+func (t *tx) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	var res sql.Result
+	err := t.Exec(ctx, query, args, &res)
+	return res, err
+}
+
+func startStatement(ctx context.Context, system string, query string) (context.Context, trace.Span) {
+	return StartChild(ctx, "db.query",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			dbSystem(system),
+			semconv.DBStatement(query),
+		),
+	)
+}
+
+// end records the error of the operation in the span, and ends it
+func end(span trace.Span, err error) error {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+	return err
+}
+
+// dbSystem returns the name of the database in the semantic conventions for the dialect of ent
+func dbSystem(d string) attribute.KeyValue {
+	switch d {
+	case dialect.SQLite:
+		return semconv.DBSystemSqlite
+	case dialect.Postgres:
+		return semconv.DBSystemPostgreSQL
+	case dialect.MySQL:
+		return semconv.DBSystemMySQL
+	default:
+		return semconv.DBSystemKey.String(d)
+	}
+}
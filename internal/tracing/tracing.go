@@ -0,0 +1,103 @@
+// Package tracing configures OpenTelemetry for the server and provides the spans of the operations which are
+// not HTTP handlers: the queries to the databases of the vaults, the signatures and the outbound requests.
+//
+// The operations of the vaults are traced only as part of a request, so the background tasks and the
+// initialization do not create traces of their own.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName is the name of the tracer of the server
+const instrumentationName = "github.com/hesusruiz/vcbackend"
+
+// Exporters of the spans
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterOTLP   = "otlp"
+)
+
+// Options configure the export of the spans
+type Options struct {
+	// Exporter is none, stdout or otlp
+	Exporter string
+	// Endpoint is the host and port of the OTLP/HTTP collector. When it is empty, the standard
+	// variable OTEL_EXPORTER_OTLP_ENDPOINT is used, or localhost:4318.
+	Endpoint string
+	// Insecure sends the spans to the collector without TLS
+	Insecure bool
+	// ServiceName is the name of the service in the spans
+	ServiceName string
+	// SampleRatio is the fraction of the traces started by the server which are recorded
+	SampleRatio float64
+}
+
+// Setup configures the propagation of the trace context in the requests and, unless the exporter is none,
+// the export of the spans. It returns the function which sends the pending spans when the server stops.
+func Setup(ctx context.Context, opts Options) (shutdown func(context.Context) error, err error) {
+
+	// The trace context is propagated with the W3C headers even if the spans are not exported
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	switch opts.Exporter {
+	case "", ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case ExporterOTLP:
+		var options []otlptracehttp.Option
+		if len(opts.Endpoint) > 0 {
+			options = append(options, otlptracehttp.WithEndpoint(opts.Endpoint))
+		}
+		if opts.Insecure {
+			options = append(options, otlptracehttp.WithInsecure())
+		}
+		exporter, err = otlptracehttp.New(ctx, options...)
+	default:
+		return nil, fmt.Errorf("unknown exporter %q", opts.Exporter)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(opts.ServiceName)))
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer of the server
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// StartChild starts a span only if the context already has one, like the span of a request.
+// Otherwise it returns the context and a span which does nothing.
+func StartChild(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx, trace.SpanFromContext(ctx)
+	}
+	return Tracer().Start(ctx, name, opts...)
+}
@@ -0,0 +1,141 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/gofiber/fiber/v2"
+	_ "github.com/mattn/go-sqlite3"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// record sets a tracer provider which keeps the spans in memory
+func record(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	return recorder
+}
+
+func TestSetup(t *testing.T) {
+	for _, exporter := range []string{"", ExporterNone, ExporterStdout, ExporterOTLP} {
+		shutdown, err := Setup(context.Background(), Options{Exporter: exporter, ServiceName: "test", SampleRatio: 1})
+		if err != nil {
+			t.Fatalf("Setup(%q) error = %v", exporter, err)
+		}
+		if err := shutdown(context.Background()); err != nil {
+			t.Errorf("shutdown of %q error = %v", exporter, err)
+		}
+	}
+	if _, err := Setup(context.Background(), Options{Exporter: "jaeger"}); err == nil {
+		t.Error("Setup() with an unknown exporter should fail")
+	}
+}
+
+func TestStartChild(t *testing.T) {
+	recorder := record(t)
+
+	// Without a parent there is no span
+	_, span := StartChild(context.Background(), "orphan")
+	span.End()
+	if n := len(recorder.Ended()); n != 0 {
+		t.Fatalf("spans without a parent = %d, want 0", n)
+	}
+
+	ctx, parent := Tracer().Start(context.Background(), "request")
+	_, span = StartChild(ctx, "child")
+	span.End()
+	parent.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 2 || spans[0].Name() != "child" || spans[0].Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Errorf("spans = %v, want the child of the request", spans)
+	}
+}
+
+func TestDriver(t *testing.T) {
+	recorder := record(t)
+
+	drv, err := entsql.Open("sqlite3", "file:tracing?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+	traced := Driver(drv)
+	defer traced.Close()
+
+	ctx, parent := Tracer().Start(context.Background(), "request")
+	if err := traced.Exec(ctx, "CREATE TABLE t (id INTEGER)", []any{}, nil); err != nil {
+		t.Fatal(err)
+	}
+	tx, err := traced.Tx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Exec(ctx, "INSERT INTO t (id) VALUES (?)", []any{1}, nil); err != nil {
+		t.Fatal(err)
+	}
+	tx.Commit()
+	if err := traced.Exec(ctx, "INSERT INTO missing VALUES (1)", []any{}, nil); err == nil {
+		t.Fatal("the insert in a missing table should fail")
+	}
+	parent.End()
+
+	// Without a request there are no spans
+	traced.Exec(context.Background(), "SELECT 1", []any{}, nil)
+
+	spans := recorder.Ended()
+	if len(spans) != 4 {
+		t.Fatalf("spans = %d, want 3 statements and the request", len(spans))
+	}
+	for _, span := range spans[:3] {
+		if span.Name() != "db.query" {
+			t.Errorf("span name = %s", span.Name())
+		}
+	}
+	if spans[2].Status().Code != codes.Error {
+		t.Errorf("the failed statement has status %v", spans[2].Status())
+	}
+}
+
+func TestDo(t *testing.T) {
+	recorder := record(t)
+
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	ctx, parent := Tracer().Start(context.Background(), "request")
+	code, _, errs := Do(ctx, "ssikit.test", fiber.Get(server.URL+"/v1/templates"))
+	parent.End()
+
+	if len(errs) > 0 || code != http.StatusBadGateway {
+		t.Fatalf("Do() = %d, %v", code, errs)
+	}
+	if len(traceparent) == 0 {
+		t.Error("the request does not have the trace context")
+	}
+
+	span := recorder.Ended()[0]
+	if span.Name() != "ssikit.test" || span.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Errorf("span = %s, parent %v", span.Name(), span.Parent())
+	}
+	if span.Status().Code != codes.Error {
+		t.Errorf("the request answered with an error has status %v", span.Status())
+	}
+}
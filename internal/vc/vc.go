@@ -0,0 +1,151 @@
+// Package vc decodes Verifiable Credentials serialized either as JSON-LD or as a JWT.
+// The signature is not verified.
+package vc
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var ErrInvalidCredential = errors.New("the credential is neither JSON nor a JWT")
+
+// Credential is a decoded credential
+type Credential struct {
+	// Claims are the claims of the JWT, or the JSON-LD credential
+	Claims map[string]any
+	// VC is the credential: the "vc" claim of a JWT, or the JSON-LD credential
+	VC map[string]any
+}
+
+// Decode parses a credential, either a JWT or a JSON-LD credential
+func Decode(raw []byte) (*Credential, error) {
+
+	var claims map[string]any
+
+	serialized := strings.TrimSpace(string(raw))
+	if strings.HasPrefix(serialized, "{") {
+		if err := json.Unmarshal([]byte(serialized), &claims); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidCredential, err)
+		}
+	} else {
+		// A JWT is composed of 3 parts concatenated by dots (".")
+		parts := strings.Split(serialized, ".")
+		if len(parts) != 3 {
+			return nil, ErrInvalidCredential
+		}
+		payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidCredential, err)
+		}
+		if err := json.Unmarshal(payload, &claims); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidCredential, err)
+		}
+	}
+
+	c := &Credential{Claims: claims, VC: claims}
+	if inner, ok := claims["vc"].(map[string]any); ok {
+		c.VC = inner
+	}
+
+	return c, nil
+}
+
+// Types returns the types of the credential, including VerifiableCredential
+func (c *Credential) Types() []string {
+	switch t := c.VC["type"].(type) {
+	case string:
+		return []string{t}
+	case []any:
+		var types []string
+		for _, item := range t {
+			if s, ok := item.(string); ok {
+				types = append(types, s)
+			}
+		}
+		return types
+	}
+	return nil
+}
+
+// Type returns the most specific type of the credential, the last one which is not VerifiableCredential
+func (c *Credential) Type() string {
+	result := ""
+	for _, t := range c.Types() {
+		if t != "VerifiableCredential" {
+			result = t
+		}
+	}
+	return result
+}
+
+// ID returns the identifier of the credential: the jti claim of a JWT, or the id of the credential
+func (c *Credential) ID() string {
+	return FirstString(c.Claims["jti"], c.VC["id"])
+}
+
+// Issuer returns the DID of the issuer
+func (c *Credential) Issuer() string {
+	if iss := FirstString(c.Claims["iss"], c.VC["issuer"]); len(iss) > 0 {
+		return iss
+	}
+	if issuer, ok := c.VC["issuer"].(map[string]any); ok {
+		return FirstString(issuer["id"])
+	}
+	return ""
+}
+
+// Subject returns the credential subject, or nil
+func (c *Credential) Subject() map[string]any {
+	subject, _ := c.VC["credentialSubject"].(map[string]any)
+	return subject
+}
+
+// SubjectID returns the id of the credential subject
+func (c *Credential) SubjectID() string {
+	return FirstString(c.Claims["sub"], c.Subject()["id"])
+}
+
+// IssuedAt returns the issuance date, or nil
+func (c *Credential) IssuedAt() *time.Time {
+	return FirstTime(c.Claims["iat"], c.VC["issuanceDate"], c.VC["validFrom"])
+}
+
+// ValidFrom returns the start of the validity, or nil
+func (c *Credential) ValidFrom() *time.Time {
+	return FirstTime(c.Claims["nbf"], c.VC["validFrom"], c.VC["issuanceDate"], c.Claims["iat"])
+}
+
+// ExpiresAt returns the end of the validity, or nil
+func (c *Credential) ExpiresAt() *time.Time {
+	return FirstTime(c.Claims["exp"], c.VC["expirationDate"], c.VC["validUntil"])
+}
+
+// FirstString returns the first value which is a non-empty string
+func FirstString(values ...any) string {
+	for _, v := range values {
+		if s, ok := v.(string); ok && len(s) > 0 {
+			return s
+		}
+	}
+	return ""
+}
+
+// FirstTime returns the first value which is a NumericDate or a date in RFC3339 format
+func FirstTime(values ...any) *time.Time {
+	for _, v := range values {
+		switch t := v.(type) {
+		case float64:
+			tm := time.Unix(int64(t), 0).UTC()
+			return &tm
+		case string:
+			if tm, err := time.Parse(time.RFC3339, t); err == nil {
+				return &tm
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package vc
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+
+	payload := `{"iss": "did:key:issuer", "sub": "did:key:holder", "nbf": 1672531200, "exp": 1704067200,
+		"vc": {"type": ["VerifiableCredential", "PacketDeliveryService"], "credentialSubject": {"email": "a@b.c"}}}`
+	jwt := "eyJhbGciOiJFUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".sig"
+
+	ldp := `{"type": "PacketDeliveryService", "issuer": {"id": "did:key:issuer"},
+		"validFrom": "2023-01-01T00:00:00Z", "validUntil": "2024-01-01T00:00:00Z",
+		"credentialSubject": {"id": "did:key:holder", "email": "a@b.c"}}`
+
+	for name, raw := range map[string]string{"jwt": jwt, "ldp": ldp} {
+		t.Run(name, func(t *testing.T) {
+			c, err := Decode([]byte(raw))
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			if c.Type() != "PacketDeliveryService" || c.Issuer() != "did:key:issuer" || c.SubjectID() != "did:key:holder" ||
+				c.Subject()["email"] != "a@b.c" {
+				t.Errorf("Decode() = %v", c.VC)
+			}
+			if c.ValidFrom().Unix() != 1672531200 || c.ExpiresAt().Unix() != 1704067200 {
+				t.Errorf("validity = %v - %v", c.ValidFrom(), c.ExpiresAt())
+			}
+		})
+	}
+
+	if _, err := Decode([]byte("a.b")); !errors.Is(err, ErrInvalidCredential) {
+		t.Errorf("Decode() error = %v", err)
+	}
+}
@@ -0,0 +1,277 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/basicauth"
+	"github.com/hesusruiz/vcbackend/ent"
+	"github.com/hesusruiz/vcbackend/internal/problem"
+	"github.com/hesusruiz/vcbackend/vault"
+	"go.uber.org/zap"
+)
+
+// ##########################################
+// ##########################################
+// Four-eyes approval of credentials
+
+// DecisionRequest is the body for approving or rejecting an issuance request
+type DecisionRequest struct {
+	Reason string `json:"reason,omitempty" form:"reason"`
+}
+
+func (s *Server) addApprovalRoutes(issuerRoutes fiber.Router, csrfHandler fiber.Handler) {
+
+	auth := s.operatorAuth()
+
+	// Pages for the approvers
+	issuerRoutes.Get("/approvals", auth, csrfHandler, s.IssuerPageApprovals)
+	issuerRoutes.Post("/approvals/:id/approve", auth, csrfHandler, s.IssuerPageApproveRequest)
+	issuerRoutes.Post("/approvals/:id/reject", auth, csrfHandler, s.IssuerPageRejectRequest)
+
+	// APIs
+	issuerRoutes.Get("/issuancerequests", auth, s.IssuerAPIListIssuanceRequests)
+	issuerRoutes.Get("/issuancerequests/:id", auth, s.IssuerAPIGetIssuanceRequest)
+	issuerRoutes.Post("/issuancerequests/:id/approve", auth, s.IssuerAPIApproveIssuanceRequest)
+	issuerRoutes.Post("/issuancerequests/:id/reject", auth, s.IssuerAPIRejectIssuanceRequest)
+
+}
+
+// createOperators creates or updates the operators of the issuer specified in the configuration,
+// with their roles. Operators authenticate with their id and password.
+func (s *Server) createOperators() {
+
+	for _, op := range s.conf.Issuer.Operators {
+
+		name := op.Name
+		if len(name) == 0 {
+			name = op.ID
+		}
+
+		usr, _ := s.issuerVault.UserByID(op.ID)
+		if usr == nil {
+			_, err := s.issuerVault.CreateUser(op.ID, name, operatorType, op.Password)
+			if err != nil {
+				s.logger.Errorw("error creating operator", "id", op.ID, zap.Error(err))
+				continue
+			}
+		}
+
+		if err := s.issuerVault.SetUserRoles(op.ID, op.Roles); err != nil {
+			s.logger.Errorw("error setting operator roles", "id", op.ID, zap.Error(err))
+		}
+
+	}
+
+}
+
+// operatorType is the type of the users of the issuer vault who operate the issuer
+const operatorType = "operator"
+
+// operatorAuth authenticates the operators of the issuer with their credentials in the issuer vault
+func (s *Server) operatorAuth() fiber.Handler {
+	return s.authOperators(s.operatorAuthConfig())
+}
+
+// authOperators authenticates the operators of the issuer with the configuration, with their password
+// or with a client certificate. The other users of the issuer vault, like the holders, are rejected.
+func (s *Server) authOperators(cfg basicauth.Config) fiber.Handler {
+	return s.clientCertAuth(s.issuerVault, cfg, isOperator, s.passwordAuth(cfg))
+}
+
+// operatorAuthConfig authenticates the operators with their passwords in the vault of the issuer
+func (s *Server) operatorAuthConfig() basicauth.Config {
+	return basicauth.Config{
+		Realm: "Issuer",
+		Authorizer: func(user string, pass string) bool {
+			usr, err := s.issuerVault.CheckPassword(user, pass)
+			return err == nil && isOperator(usr)
+		},
+	}
+}
+
+// isOperator returns true if the user operates the issuer
+func isOperator(usr *ent.User) bool {
+	return usr.Type == operatorType
+}
+
+// operator returns the id of the operator authenticated in the request
+func operator(c *fiber.Ctx) string {
+	user, _ := c.Locals("username").(string)
+	return user
+}
+
+// approvalRequired returns true if credentials of the given type need the approval of a second operator
+func (s *Server) approvalRequired(credType string) bool {
+	for _, t := range s.conf.Issuer.Approval.CredentialTypes {
+		if t == credType {
+			return true
+		}
+	}
+	return false
+}
+
+// approveAndIssue approves a pending request and signs the credential. The credential of a request for
+// renewing another one is linked to it.
+func (s *Server) approveAndIssue(ctx context.Context, id string, approver string, reason string) (*ent.IssuanceRequest, error) {
+
+	issuerVault := s.issuerVault.WithContext(ctx)
+
+	req, err := issuerVault.ApproveIssuanceRequest(id, approver, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	def, err := s.credentialType(req.CredentialType)
+	if err != nil {
+		issuerVault.MarkIssuanceRequestFailed(id, approver, err)
+		return nil, err
+	}
+
+	if len(req.Predecessor) > 0 {
+		if err := issuerVault.StartRenewal(req.Predecessor); err != nil {
+			issuerVault.MarkIssuanceRequestFailed(id, approver, err)
+			return nil, err
+		}
+	}
+
+	credentialID, _, err := s.issueCredential(ctx, def, req.SubjectDid, req.Claims)
+	if err != nil {
+		if len(req.Predecessor) > 0 {
+			issuerVault.AbortRenewal(req.Predecessor)
+		}
+		issuerVault.MarkIssuanceRequestFailed(id, approver, err)
+		return nil, err
+	}
+
+	if len(req.Predecessor) > 0 {
+		if _, err := issuerVault.CompleteRenewal(req.Predecessor, credentialID, req.RevokePredecessor); err != nil {
+			issuerVault.MarkIssuanceRequestFailed(id, approver, err)
+			return nil, err
+		}
+	}
+
+	return issuerVault.MarkIssuanceRequestIssued(id, credentialID, approver)
+}
+
+// IssuerPageApprovals displays the requests pending of approval
+func (s *Server) IssuerPageApprovals(c *fiber.Ctx) error {
+
+	requests, err := s.issuerVault.ListIssuanceRequests("pending")
+	if err != nil {
+		return err
+	}
+
+	m := fiber.Map{
+		"issuerPrefix":   issuerPrefix,
+		"verifierPrefix": verifierPrefix,
+		"walletPrefix":   walletPrefix,
+		"csrftoken":      c.Locals("csrftoken"),
+		"prefix":         issuerPrefix,
+		"operator":       operator(c),
+		"requests":       requests,
+	}
+	return c.Render("issuer_approvals", m)
+}
+
+// IssuerPageApproveRequest approves a request and displays the credential signed
+func (s *Server) IssuerPageApproveRequest(c *fiber.Ctx) error {
+
+	decision := &DecisionRequest{}
+	if err := c.BodyParser(decision); err != nil {
+		return err
+	}
+
+	req, err := s.approveAndIssue(c.UserContext(), c.Params("id"), operator(c), decision.Reason)
+	if err != nil {
+		return approvalError(err)
+	}
+
+	return c.Redirect(issuerPrefix + "/creddetails/" + req.Edges.Credential.ID)
+}
+
+// IssuerPageRejectRequest rejects a request and displays again the pending requests
+func (s *Server) IssuerPageRejectRequest(c *fiber.Ctx) error {
+
+	decision := &DecisionRequest{}
+	if err := c.BodyParser(decision); err != nil {
+		return err
+	}
+
+	if _, err := s.issuerVault.RejectIssuanceRequest(c.Params("id"), operator(c), decision.Reason); err != nil {
+		return approvalError(err)
+	}
+
+	return c.Redirect(issuerPrefix + "/approvals")
+}
+
+// IssuerAPIListIssuanceRequests returns the issuance requests, optionally filtered by status
+func (s *Server) IssuerAPIListIssuanceRequests(c *fiber.Ctx) error {
+
+	requests, err := s.issuerVault.ListIssuanceRequests(c.Query("status"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return c.JSON(requests)
+}
+
+// IssuerAPIGetIssuanceRequest returns an issuance request with the history of its state transitions
+func (s *Server) IssuerAPIGetIssuanceRequest(c *fiber.Ctx) error {
+
+	req, err := s.issuerVault.IssuanceRequestByID(c.Params("id"))
+	if err != nil {
+		return approvalError(err)
+	}
+
+	return c.JSON(req)
+}
+
+// IssuerAPIApproveIssuanceRequest approves a request and signs the credential
+func (s *Server) IssuerAPIApproveIssuanceRequest(c *fiber.Ctx) error {
+
+	decision := &DecisionRequest{}
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(decision); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	}
+
+	req, err := s.approveAndIssue(c.UserContext(), c.Params("id"), operator(c), decision.Reason)
+	if err != nil {
+		return approvalError(err)
+	}
+
+	return c.JSON(req)
+}
+
+// IssuerAPIRejectIssuanceRequest rejects a request
+func (s *Server) IssuerAPIRejectIssuanceRequest(c *fiber.Ctx) error {
+
+	decision := &DecisionRequest{}
+	if err := c.BodyParser(decision); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	req, err := s.issuerVault.RejectIssuanceRequest(c.Params("id"), operator(c), decision.Reason)
+	if err != nil {
+		return approvalError(err)
+	}
+
+	return c.JSON(req)
+}
+
+// approvalError converts the errors from the approval workflow into HTTP errors
+func approvalError(err error) error {
+	switch {
+	case errors.Is(err, vault.ErrRequestNotFound):
+		return problem.New(fiber.StatusNotFound, problem.RequestNotFound, err.Error())
+	case errors.Is(err, vault.ErrRequestNotPending):
+		return problem.New(fiber.StatusConflict, problem.RequestNotPending, err.Error())
+	case errors.Is(err, vault.ErrRequestNotAllowed):
+		return problem.New(fiber.StatusForbidden, problem.RequestNotAllowed, err.Error())
+	default:
+		return err
+	}
+}
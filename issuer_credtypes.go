@@ -0,0 +1,336 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/hesusruiz/vcbackend/ent"
+	"github.com/hesusruiz/vcbackend/internal/credtype"
+	"github.com/hesusruiz/vcbackend/internal/problem"
+)
+
+// ##########################################
+// ##########################################
+// Credential types and issuance forms
+
+const defaultCredentialTypesDir = "configs/credentialtypes"
+
+// IssueCredentialRequest is the body for issuing a credential with the API. The holder is identified
+// by a proof signed by the holder or by the id of a holder with a registered DID.
+type IssueCredentialRequest struct {
+	Claims      map[string]any `json:"claims"`
+	HolderID    string         `json:"holderId,omitempty"`
+	HolderProof string         `json:"holderProof,omitempty"`
+}
+
+// formInput is an input of the issuance form, generated from the declaration of a field.
+// Inputs with a Heading start a group of inputs.
+type formInput struct {
+	Heading     string
+	Name        string
+	Label       string
+	InputType   string
+	Placeholder string
+	Value       string
+	Options     []string
+	Required    bool
+	Error       string
+}
+
+func (s *Server) addCredentialTypeRoutes(issuerRoutes fiber.Router, csrfHandler fiber.Handler) {
+
+	auth := s.operatorAuth()
+
+	// Pages for the operator of the issuer
+	issuerRoutes.Get("/newcredential", auth, csrfHandler, s.IssuerPageNewCredentialFormDisplay)
+	issuerRoutes.Post("/newcredential", auth, csrfHandler, s.IssuerPageNewCredentialFormPost)
+
+	// APIs
+	issuerRoutes.Get("/credentialtypes", s.IssuerAPIListCredentialTypes)
+	issuerRoutes.Get("/credentialtypes/:name", s.IssuerAPIGetCredentialType)
+	issuerRoutes.Post("/credentialtypes/:name/credentials", auth, s.IssuerAPIIssueCredential)
+
+}
+
+// credentialType returns the definition of a credential type. If name is empty, it returns the
+// default type of the configuration or the first one.
+func (s *Server) credentialType(name string) (*credtype.Definition, error) {
+	if len(name) == 0 {
+		name = s.conf.Issuer.DefaultCredentialType
+	}
+	if len(name) == 0 {
+		all := s.credTypes.All()
+		if len(all) == 0 {
+			return nil, fiber.NewError(fiber.StatusNotFound, "no credential types defined")
+		}
+		return all[0], nil
+	}
+	def, ok := s.credTypes.Get(name)
+	if !ok {
+		return nil, fiber.NewError(fiber.StatusNotFound, fmt.Sprintf("credential type %s not found", name))
+	}
+	return def, nil
+}
+
+// credentialTypeOfTemplate returns the definition of the credential type issued with a template
+func (s *Server) credentialTypeOfTemplate(template string) (*credtype.Definition, bool) {
+	for _, def := range s.credTypes.All() {
+		if def.Template == template {
+			return def, true
+		}
+	}
+	return nil, false
+}
+
+// issueCredential signs a credential of the given type for the holder identified by subjectDID,
+// with the issuer declared for the type. It returns the id of the credential and the credential.
+func (s *Server) issueCredential(ctx context.Context, def *credtype.Definition, subjectDID string, claims map[string]any) (id string, raw []byte, err error) {
+
+	defer func() { recordIssuance(def.Template, err) }()
+
+	if def.Issuer == credtype.IssuerVault {
+		credmap := map[string]any{
+			"credName":   def.Template,
+			"issuerDID":  s.conf.Issuer.ID,
+			"subjectDID": subjectDID,
+			"claims":     claims,
+		}
+		return s.issuerVault.WithContext(ctx).CreateCredentialJWTFromMap(credmap)
+	}
+
+	return s.issueWithSSIKit(ctx, def.Template, subjectDID, claims)
+}
+
+// requestCredential issues a credential, or stores a pending request if the type requires approval
+func (s *Server) requestCredential(c *fiber.Ctx, def *credtype.Definition, subjectDID string, claims map[string]any) (*ent.IssuanceRequest, string, []byte, error) {
+
+	if s.approvalRequired(def.Name) {
+		req, err := s.issuerVault.WithContext(c.UserContext()).CreateIssuanceRequest(def.Name, subjectDID, claims, operator(c))
+		if err == nil {
+			recordPendingIssuance(def.Template)
+		}
+		return req, "", nil, err
+	}
+
+	credentialID, raw, err := s.issueCredential(c.UserContext(), def, subjectDID, claims)
+	return nil, credentialID, raw, err
+}
+
+// buildForm generates the inputs of the issuance form for a credential type, with the values
+// previously entered and the validation errors
+func buildForm(def *credtype.Definition, value func(key string) string, errs map[string]string) []formInput {
+
+	var inputs []formInput
+
+	add := func(f *credtype.Field, name string) {
+		in := formInput{
+			Name:        name,
+			Label:       f.Label,
+			Placeholder: f.Placeholder,
+			Value:       value(name),
+			Required:    f.Required,
+			Error:       errs[name],
+		}
+		switch f.Type {
+		case credtype.TypeEmail:
+			in.InputType = "email"
+		case credtype.TypeNumber:
+			in.InputType = "number"
+		case credtype.TypeDate:
+			in.InputType = "date"
+		case credtype.TypeBoolean:
+			in.Options = []string{"true", "false"}
+		case credtype.TypeList:
+			in.InputType = "text"
+			if len(f.Enum) > 0 && len(in.Placeholder) == 0 {
+				in.Placeholder = "separated by comma: " + strings.Join(f.Enum, ", ")
+			}
+		default:
+			in.InputType = "text"
+			in.Options = f.Enum
+		}
+		inputs = append(inputs, in)
+	}
+
+	for _, f := range def.Fields {
+		switch {
+		case f.Type == credtype.TypeGroup && f.Repeatable:
+			for _, i := range f.Indexes() {
+				inputs = append(inputs, formInput{Heading: fmt.Sprintf("%s %d", f.Label, i+1), Error: errs[fmt.Sprintf("%s.%d", f.Name, i)]})
+				for _, sub := range f.Fields {
+					add(sub, fmt.Sprintf("%s.%d.%s", f.Name, i, sub.Name))
+				}
+			}
+		case f.Type == credtype.TypeGroup:
+			inputs = append(inputs, formInput{Heading: f.Label})
+			for _, sub := range f.Fields {
+				add(sub, f.Name+"."+sub.Name)
+			}
+		default:
+			add(f, f.Name)
+		}
+	}
+
+	return inputs
+}
+
+// renderNewCredentialForm displays the form for entering the data of a credential of the given type
+func (s *Server) renderNewCredentialForm(c *fiber.Ctx, def *credtype.Definition, verr *credtype.ValidationError) error {
+
+	errs := map[string]string{}
+	message := ""
+	if verr != nil {
+		for _, fe := range verr.Errors {
+			errs[fe.Path] = fe.Message
+		}
+		message = "Correct the errors in the form"
+	}
+
+	m := fiber.Map{
+		"issuerPrefix":   issuerPrefix,
+		"verifierPrefix": verifierPrefix,
+		"walletPrefix":   walletPrefix,
+		"csrftoken":      c.Locals("csrftoken"),
+		"prefix":         issuerPrefix,
+		"types":          s.credTypes.All(),
+		"credType":       def,
+		"inputs":         buildForm(def, func(key string) string { return c.FormValue(key) }, errs),
+		"Errormessage":   message,
+		"holderId":       c.FormValue("holderId"),
+		"holderError":    errs["holder"],
+	}
+
+	return c.Render("issuer_newcredential", m)
+}
+
+// IssuerPageNewCredentialFormDisplay displays the form to enter the data of a credential
+func (s *Server) IssuerPageNewCredentialFormDisplay(c *fiber.Ctx) error {
+
+	def, err := s.credentialType(c.Query("type"))
+	if err != nil {
+		return err
+	}
+
+	return s.renderNewCredentialForm(c, def, nil)
+}
+
+// IssuerPageNewCredentialFormPost validates the data entered in the form and issues the credential
+func (s *Server) IssuerPageNewCredentialFormPost(c *fiber.Ctx) error {
+
+	def, err := s.credentialType(c.FormValue("credentialType"))
+	if err != nil {
+		return err
+	}
+
+	// Display again the form if there are errors on input
+	claims, err := def.Validate(def.FromForm(func(key string) string { return c.FormValue(key) }))
+	if err != nil {
+		var verr *credtype.ValidationError
+		if errors.As(err, &verr) {
+			return s.renderNewCredentialForm(c, def, verr)
+		}
+		return err
+	}
+
+	// The holder is identified by a proof signed by the holder or by its registered DID
+	subjectDID, err := s.bindHolder(c.FormValue("holderId"), c.FormValue("holderProof"))
+	if err != nil {
+		return s.renderNewCredentialForm(c, def, &credtype.ValidationError{
+			Errors: []credtype.FieldError{{Path: "holder", Message: err.Error()}},
+		})
+	}
+
+	req, _, raw, err := s.requestCredential(c, def, subjectDID, claims)
+	if err != nil {
+		return err
+	}
+
+	m := fiber.Map{
+		"issuerPrefix":   issuerPrefix,
+		"verifierPrefix": verifierPrefix,
+		"walletPrefix":   walletPrefix,
+		"prefix":         issuerPrefix,
+	}
+
+	// Credentials which need approval are stored until a second operator approves them
+	if req != nil {
+		m["request"] = req
+		return c.Render("issuer_pending", m)
+	}
+
+	m["claims"] = credentialDisplay(raw)
+	return c.Render("creddetails", m)
+}
+
+// IssuerAPIListCredentialTypes returns the declarations of the credential types
+func (s *Server) IssuerAPIListCredentialTypes(c *fiber.Ctx) error {
+	return c.JSON(s.credTypes.All())
+}
+
+// IssuerAPIGetCredentialType returns the declaration of a credential type
+func (s *Server) IssuerAPIGetCredentialType(c *fiber.Ctx) error {
+
+	def, err := s.credentialType(c.Params("name"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(def)
+}
+
+// IssuerAPIIssueCredential validates the claims received against the declaration of the type and
+// issues the credential. If the type requires approval, the pending request is returned instead.
+func (s *Server) IssuerAPIIssueCredential(c *fiber.Ctx) error {
+
+	def, err := s.credentialType(c.Params("name"))
+	if err != nil {
+		return err
+	}
+
+	body := &IssueCredentialRequest{}
+	if err := json.Unmarshal(c.Body(), body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	claims, err := def.Validate(body.Claims)
+	if err != nil {
+		var verr *credtype.ValidationError
+		if errors.As(err, &verr) {
+			return problem.New(fiber.StatusBadRequest, problem.InvalidClaims, err.Error()).WithErrors(verr.Errors)
+		}
+		return err
+	}
+
+	subjectDID, err := s.bindHolder(body.HolderID, body.HolderProof)
+	if err != nil {
+		return holderError(err)
+	}
+
+	req, credentialID, _, err := s.requestCredential(c, def, subjectDID, claims)
+	if err != nil {
+		return renewalError(err)
+	}
+
+	if req != nil {
+		return c.Status(fiber.StatusAccepted).JSON(req)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id":      credentialID,
+		"type":    def.Name,
+		"subject": subjectDID,
+		"offer":   s.baseURL(c) + issuerPrefix + "/displayqrurl/" + credentialID,
+	})
+}
+
+// credentialDisplay formats a credential for displaying it. Credentials in JWT format are displayed as they are.
+func credentialDisplay(raw []byte) string {
+	if json.Valid(raw) {
+		return prettyFormatJSON(raw)
+	}
+	return string(raw)
+}
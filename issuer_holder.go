@@ -0,0 +1,162 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/basicauth"
+	"github.com/gofiber/fiber/v2/utils"
+	"github.com/hesusruiz/vcbackend/internal/holder"
+	"github.com/hesusruiz/vcbackend/internal/problem"
+	"github.com/hesusruiz/vcbackend/vault"
+)
+
+// ##########################################
+// ##########################################
+// Binding of credentials to the holder DID
+
+const holderNoncePrefix = "holdernonce:"
+
+// HolderDIDRequest is the body for registering the DID of a holder. The holder is the user
+// authenticated in the request, unless it is an operator registering the DID for a holder.
+type HolderDIDRequest struct {
+	HolderID string `json:"holderId"`
+	Proof    string `json:"proof"`
+}
+
+func (s *Server) addHolderRoutes(issuerRoutes fiber.Router) {
+
+	// Routes of the wallets, limited like the retrieval of the credentials. The registrations are limited
+	// after the authentication, so the holders are also limited as clients.
+	limit := s.rateLimit("credential", s.conf.Server.RateLimit.Credential)
+	auth := s.basicAuth(s.issuerVault, s.holderAuthConfig())
+	issuerRoutes.Get("/holderchallenge", limit, s.IssuerAPIHolderChallenge)
+	issuerRoutes.Post("/holderdid", auth, limit, bodyLimit(s.conf.Server.CredentialBodyLimit), s.IssuerAPIRegisterHolderDID)
+
+}
+
+// IssuerAPIHolderChallenge returns a nonce that the holder must include in the proof of control of its DID
+func (s *Server) IssuerAPIHolderChallenge(c *fiber.Ctx) error {
+
+	nonce := utils.UUID()
+	s.storage.Set(holderNoncePrefix+nonce, []byte("pending"), holder.MaxProofAge)
+
+	return c.JSON(fiber.Map{
+		"nonce": nonce,
+		"aud":   s.issuerDID,
+	})
+}
+
+// IssuerAPIRegisterHolderDID registers the DID of a holder, after verifying that the holder controls it.
+// The credentials issued later for the holder are bound to this DID.
+func (s *Server) IssuerAPIRegisterHolderDID(c *fiber.Ctx) error {
+
+	req := &HolderDIDRequest{}
+	if err := c.BodyParser(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	if len(req.Proof) == 0 {
+		return fiber.NewError(fiber.StatusBadRequest, "proof is required")
+	}
+
+	// The holders register their own DID, and the operators the DID of any holder
+	issuerVault := s.issuerVault.WithContext(c.UserContext())
+	user, _ := c.Locals("username").(string)
+	usr, err := issuerVault.UserByID(user)
+	if err != nil {
+		return err
+	}
+	if len(req.HolderID) == 0 {
+		req.HolderID = user
+	}
+	if req.HolderID != user && (usr == nil || !isOperator(usr)) {
+		return fiber.NewError(fiber.StatusForbidden, "only operators can register the DID of other holders")
+	}
+
+	did, err := s.holders.VerifyProof(req.Proof, s.issuerDID, s.consumeHolderNonce)
+	if err != nil {
+		return holderError(err)
+	}
+
+	holderUsr, err := issuerVault.UserByID(req.HolderID)
+	if err != nil {
+		return err
+	}
+	if holderUsr == nil {
+		// The holder authenticates later only if an administrator sets its password
+		if _, err := issuerVault.CreateUser(req.HolderID, req.HolderID, "naturalperson", generateNonce()); err != nil {
+			return err
+		}
+	}
+	if err := issuerVault.SetDIDForUser(req.HolderID, did); err != nil {
+		return holderError(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"holderId": req.HolderID,
+		"did":      did,
+	})
+}
+
+// holderAuthConfig authenticates the holders and the operators with their passwords in the vault of the issuer
+func (s *Server) holderAuthConfig() basicauth.Config {
+	return basicauth.Config{
+		Realm: "Issuer",
+		Authorizer: func(user string, pass string) bool {
+			_, err := s.issuerVault.CheckPassword(user, pass)
+			return err == nil
+		},
+	}
+}
+
+// consumeHolderNonce returns true if the nonce was issued by the issuer and not used yet
+func (s *Server) consumeHolderNonce(nonce string) bool {
+	status, _ := s.storage.Get(holderNoncePrefix + nonce)
+	if status == nil {
+		return false
+	}
+	s.storage.Delete(holderNoncePrefix + nonce)
+	return true
+}
+
+// bindHolder returns the DID of the holder of a new credential, taken from the proof signed by the
+// holder or, if there is no proof, from the DID registered for the holder in the issuer vault
+func (s *Server) bindHolder(holderID string, proof string) (string, error) {
+
+	if len(proof) > 0 {
+		return s.holders.VerifyProof(proof, s.issuerDID, s.consumeHolderNonce)
+	}
+
+	if len(holderID) == 0 {
+		return "", holder.ErrNoHolder
+	}
+
+	did, err := s.issuerVault.GetDIDForUser(holderID)
+	if err != nil || len(did) == 0 {
+		return "", fmt.Errorf("%w: no DID registered for %s", holder.ErrNoHolder, holderID)
+	}
+	if err := s.holders.Resolve(did); err != nil {
+		return "", err
+	}
+
+	return did, nil
+}
+
+// holderError converts the errors from the holder binding into HTTP errors
+func holderError(err error) error {
+	switch {
+	case errors.Is(err, holder.ErrNoHolder):
+		return problem.New(fiber.StatusUnprocessableEntity, problem.HolderUnknown, err.Error())
+	case errors.Is(err, holder.ErrUnresolvable):
+		return problem.New(fiber.StatusUnprocessableEntity, problem.HolderUnresolvable, err.Error())
+	case errors.Is(err, holder.ErrInvalidProof):
+		return problem.New(fiber.StatusUnauthorized, problem.HolderProofInvalid, err.Error())
+	case errors.Is(err, holder.ErrProofReplayed):
+		return problem.New(fiber.StatusUnauthorized, problem.HolderProofReplay, err.Error())
+	case errors.Is(err, vault.ErrDIDOfAnotherUser):
+		return problem.New(fiber.StatusConflict, problem.HolderDIDConflict, err.Error())
+	default:
+		return err
+	}
+}
@@ -0,0 +1,301 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/hesusruiz/vcbackend/ent"
+	"github.com/hesusruiz/vcbackend/internal/credtype"
+	"github.com/hesusruiz/vcbackend/internal/issuance"
+	"github.com/hesusruiz/vcbackend/internal/problem"
+	"github.com/hesusruiz/vcbackend/internal/vc"
+	"github.com/hesusruiz/vcbackend/vault"
+	"go.uber.org/zap"
+)
+
+// ##########################################
+// ##########################################
+// Credential renewal
+
+// RenewRequest is the body for renewing a credential
+type RenewRequest struct {
+	Claims            map[string]any `json:"claims,omitempty"`
+	RevokePredecessor bool           `json:"revokePredecessor,omitempty" form:"revokePredecessor"`
+}
+
+// errRenewalNeedsApproval is the error of the automatic renewals of the credential types which require approval
+var errRenewalNeedsApproval = errors.New("the renewal of the credential requires the approval of an operator")
+
+func (s *Server) addRenewalRoutes(issuerRoutes fiber.Router, csrfHandler fiber.Handler) {
+
+	auth := s.operatorAuth()
+
+	// Pages for the operator of the issuer
+	issuerRoutes.Get("/renewals", auth, csrfHandler, s.IssuerPageRenewals)
+	issuerRoutes.Post("/renewcredential/:id", auth, csrfHandler, s.IssuerPageRenewCredential)
+
+	// APIs
+	issuerRoutes.Get("/renewalcandidates", auth, s.IssuerAPIRenewalCandidates)
+	issuerRoutes.Post("/credential/:id/renew", auth, s.IssuerAPIRenewCredential)
+	issuerRoutes.Post("/credential/:id/revoke", auth, s.IssuerAPIRevokeCredential)
+
+}
+
+// startRenewalSweep periodically flags the credentials which expire in the configured number of days,
+// so they appear as renewal candidates. If autoRenew is set, the credentials are also renewed, except
+// the ones of the types which require approval. The sweeps stop when the context is done.
+func (s *Server) startRenewalSweep(ctx context.Context) {
+
+	renewal := s.conf.Issuer.Renewal
+	interval, err := issuance.ParseDuration(renewal.SweepInterval)
+	if err != nil || interval <= 0 {
+		s.logger.Errorw("invalid renewal sweep interval, renewal sweep disabled", zap.Error(err))
+		return
+	}
+	autoRenew := renewal.AutoRenew
+	revokePredecessor := renewal.RevokePredecessor
+
+	before := time.Duration(renewal.DaysBefore) * 24 * time.Hour
+
+	sweep := func() {
+		candidates, err := s.issuerVault.WithContext(ctx).MarkRenewalCandidates(before)
+		if err != nil {
+			s.logger.Errorw("error in renewal sweep", zap.Error(err))
+			return
+		}
+		s.logger.Infow("renewal sweep", "candidates", len(candidates))
+
+		if !autoRenew {
+			return
+		}
+		for _, cred := range candidates {
+			if ctx.Err() != nil {
+				return
+			}
+			renewed, _, err := s.renewCredential(ctx, cred.ID, nil, revokePredecessor, "")
+			if errors.Is(err, errRenewalNeedsApproval) {
+				s.logger.Infow("credential not renewed automatically, it requires approval", "id", cred.ID)
+				continue
+			}
+			if err != nil {
+				s.logger.Errorw("error renewing credential", "id", cred.ID, zap.Error(err))
+				continue
+			}
+			s.logger.Infow("credential renewed", "id", cred.ID, "new", renewed.ID)
+		}
+	}
+
+	s.background.Add(1)
+	go func() {
+		defer s.background.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			sweep()
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+}
+
+// renewCredential issues a new credential with the claims of an existing one, with the same mechanism
+// which was used to issue the original credential. The claims updated are validated against the declaration
+// of the credential type. The credentials of the types which require approval are not renewed, but a request
+// of the operator which asks for the renewal is stored and returned, and the renewal completes when a second
+// operator approves it.
+func (s *Server) renewCredential(ctx context.Context, id string, updated map[string]any, revokePredecessor bool, requester string) (*ent.Credential, *ent.IssuanceRequest, error) {
+
+	issuerVault := s.issuerVault.WithContext(ctx)
+
+	data, err := issuerVault.RenewalData(id, updated)
+	if err != nil {
+		return nil, nil, err
+	}
+	templateId := vc.FirstString(data["templateId"], data["credName"])
+	subjectDID, _ := data["subjectDID"].(string)
+	claims, _ := data["claims"].(map[string]any)
+
+	def, declared := s.credentialTypeOfTemplate(templateId)
+	if len(updated) > 0 {
+		if !declared {
+			return nil, nil, fmt.Errorf("%w: the credential type of the template %s is not declared, so the claims can not be updated", vault.ErrRenewalNotSupported, templateId)
+		}
+		if claims, err = def.Validate(claims); err != nil {
+			return nil, nil, err
+		}
+		updated = claims
+	}
+
+	if declared && s.approvalRequired(def.Name) {
+		if len(requester) == 0 {
+			return nil, nil, errRenewalNeedsApproval
+		}
+		req, err := issuerVault.CreateRenewalRequest(id, revokePredecessor, def.Name, subjectDID, claims, requester)
+		if err == nil {
+			recordPendingIssuance(def.Template)
+		}
+		return nil, req, err
+	}
+
+	cred, err := issuerVault.CredentialByID(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Credentials generated by the vault can be renewed directly
+	if cred.Type != "ldp_vc" {
+		renewed, err := issuerVault.RenewCredential(id, updated, revokePredecessor)
+		return renewed, nil, err
+	}
+
+	// Credentials signed by the SSI Kit are sent again to the SSI Kit
+	if len(templateId) == 0 || len(subjectDID) == 0 || claims == nil {
+		return nil, nil, vault.ErrRenewalDataNotStored
+	}
+
+	if err := issuerVault.StartRenewal(id); err != nil {
+		return nil, nil, err
+	}
+	newID, _, err := s.issueWithSSIKit(ctx, templateId, subjectDID, claims)
+	if err != nil {
+		issuerVault.AbortRenewal(id)
+		return nil, nil, err
+	}
+
+	renewed, err := issuerVault.CompleteRenewal(id, newID, revokePredecessor)
+	return renewed, nil, err
+}
+
+// IssuerPageRenewals displays the credentials due for renewal
+func (s *Server) IssuerPageRenewals(c *fiber.Ctx) error {
+
+	candidates, err := s.issuerVault.RenewalCandidates()
+	if err != nil {
+		return err
+	}
+
+	m := fiber.Map{
+		"issuerPrefix":   issuerPrefix,
+		"verifierPrefix": verifierPrefix,
+		"walletPrefix":   walletPrefix,
+		"csrftoken":      c.Locals("csrftoken"),
+		"prefix":         issuerPrefix,
+		"candidates":     candidates,
+	}
+	return c.Render("issuer_renewals", m)
+}
+
+// IssuerPageRenewCredential renews a credential and offers the new one to the holder with a QR code
+func (s *Server) IssuerPageRenewCredential(c *fiber.Ctx) error {
+
+	req := &RenewRequest{}
+	if err := c.BodyParser(req); err != nil {
+		return err
+	}
+
+	renewed, pending, err := s.renewCredential(c.UserContext(), c.Params("id"), nil, req.RevokePredecessor, operator(c))
+	if err != nil {
+		return renewalError(err)
+	}
+
+	// Credentials which need approval are renewed when a second operator approves them
+	if pending != nil {
+		return c.Render("issuer_pending", fiber.Map{
+			"issuerPrefix":   issuerPrefix,
+			"verifierPrefix": verifierPrefix,
+			"walletPrefix":   walletPrefix,
+			"prefix":         issuerPrefix,
+			"request":        pending,
+		})
+	}
+
+	return c.Redirect(issuerPrefix + "/displayqrurl/" + renewed.ID)
+}
+
+// IssuerAPIRenewalCandidates returns the credentials due for renewal
+func (s *Server) IssuerAPIRenewalCandidates(c *fiber.Ctx) error {
+
+	candidates, err := s.issuerVault.RenewalCandidates()
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(candidates)
+}
+
+// IssuerAPIRenewCredential renews a credential, optionally updating some claims, and returns
+// the id of the new credential and the URL where the holder can retrieve it. If the type requires
+// approval, the pending request is returned instead.
+func (s *Server) IssuerAPIRenewCredential(c *fiber.Ctx) error {
+
+	req := &RenewRequest{}
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(req); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	}
+
+	id := c.Params("id")
+	renewed, pending, err := s.renewCredential(c.UserContext(), id, req.Claims, req.RevokePredecessor, operator(c))
+	if err != nil {
+		return renewalError(err)
+	}
+
+	if pending != nil {
+		return c.Status(fiber.StatusAccepted).JSON(pending)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"id":          renewed.ID,
+		"predecessor": id,
+		"expiresAt":   renewed.ExpiresAt,
+		"offer":       s.baseURL(c) + issuerPrefix + "/displayqrurl/" + renewed.ID,
+	})
+}
+
+// IssuerAPIRevokeCredential revokes a credential
+func (s *Server) IssuerAPIRevokeCredential(c *fiber.Ctx) error {
+
+	cred, err := s.issuerVault.RevokeCredential(c.Params("id"))
+	if err != nil {
+		return renewalError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"id":        cred.ID,
+		"status":    cred.Status,
+		"revokedAt": cred.RevokedAt,
+	})
+}
+
+// renewalError converts the errors from the renewal process into HTTP errors
+func renewalError(err error) error {
+	var verr *credtype.ValidationError
+	switch {
+	case errors.As(err, &verr):
+		return problem.New(fiber.StatusBadRequest, problem.InvalidClaims, err.Error()).WithErrors(verr.Errors)
+	case errors.Is(err, vault.ErrCredentialNotFound):
+		return problem.New(fiber.StatusNotFound, problem.CredentialNotFound, err.Error())
+	case errors.Is(err, vault.ErrCredentialRevoked):
+		return problem.New(fiber.StatusConflict, problem.CredentialRevoked, err.Error())
+	case errors.Is(err, vault.ErrCredentialRenewed):
+		return problem.New(fiber.StatusConflict, problem.CredentialRenewed, err.Error())
+	case errors.Is(err, vault.ErrRenewalInProgress):
+		return problem.New(fiber.StatusConflict, problem.RenewalInProgress, err.Error())
+	case errors.Is(err, vault.ErrRenewalNotSupported):
+		return problem.New(fiber.StatusUnprocessableEntity, problem.RenewalNotSupported, err.Error())
+	case errors.Is(err, vault.ErrRenewalDataNotStored):
+		return problem.New(fiber.StatusUnprocessableEntity, problem.RenewalDataNotStored, err.Error())
+	case errors.Is(err, issuance.ErrPolicyViolation):
+		return problem.New(fiber.StatusUnprocessableEntity, problem.PolicyViolation, err.Error())
+	default:
+		return templateError(err)
+	}
+}
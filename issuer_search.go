@@ -0,0 +1,72 @@
+package main
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/hesusruiz/vcbackend/vault"
+)
+
+// ##########################################
+// ##########################################
+// Search of credentials
+
+func (s *Server) addSearchRoutes(issuerRoutes fiber.Router) {
+
+	issuerRoutes.Get("/credentials", s.IssuerAPISearchCredentials)
+
+}
+
+// credentialQuery builds a credential search from the query parameters of the request
+func credentialQuery(c *fiber.Ctx) (vault.CredentialQuery, error) {
+
+	q := vault.CredentialQuery{
+		Type:    c.Query("type"),
+		Subject: c.Query("subject"),
+		Status:  c.Query("status"),
+		Text:    c.Query("q"),
+		Sort:    c.Query("sort"),
+		Desc:    c.Query("order") == "desc",
+		Cursor:  c.Query("cursor"),
+	}
+
+	if limit := c.Query("limit"); len(limit) > 0 {
+		n, err := strconv.Atoi(limit)
+		if err != nil {
+			return q, fiber.NewError(fiber.StatusBadRequest, "limit must be a number")
+		}
+		q.Limit = n
+	}
+
+	for param, target := range map[string]**time.Time{
+		"expiresAfter":  &q.ExpiresAfter,
+		"expiresBefore": &q.ExpiresBefore,
+	} {
+		if value := c.Query(param); len(value) > 0 {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return q, fiber.NewError(fiber.StatusBadRequest, param+" must be a date in RFC3339 format")
+			}
+			*target = &t
+		}
+	}
+
+	return q, nil
+}
+
+// IssuerAPISearchCredentials returns a page of the credentials matching the filters in the query parameters
+func (s *Server) IssuerAPISearchCredentials(c *fiber.Ctx) error {
+
+	q, err := credentialQuery(c)
+	if err != nil {
+		return err
+	}
+
+	page, err := s.issuerVault.SearchCredentials(q)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return c.JSON(page)
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/hesusruiz/vcbackend/internal/problem"
+	"github.com/hesusruiz/vcbackend/vault"
+)
+
+// ##########################################
+// ##########################################
+// Credential templates
+
+// TemplateRequest is the body for creating or updating a version of a credential template
+type TemplateRequest struct {
+	Name        string         `json:"name,omitempty"`
+	Description string         `json:"description,omitempty"`
+	Content     string         `json:"content,omitempty"`
+	SampleData  map[string]any `json:"sampleData,omitempty"`
+}
+
+func (s *Server) addTemplateRoutes(issuerRoutes fiber.Router) {
+
+	// The changes of the templates are made by the operators of the issuer
+	auth := s.operatorAuth()
+
+	issuerRoutes.Get("/templates", s.IssuerAPIListTemplates)
+	issuerRoutes.Post("/templates", auth, s.IssuerAPICreateTemplate)
+	issuerRoutes.Get("/templates/:id", s.IssuerAPIGetTemplate)
+	issuerRoutes.Put("/templates/:id", auth, s.IssuerAPIUpdateTemplate)
+	issuerRoutes.Delete("/templates/:id", auth, s.IssuerAPIDeleteTemplate)
+	issuerRoutes.Post("/templates/:id/publish", auth, s.IssuerAPIPublishTemplate)
+	issuerRoutes.Post("/templates/:id/archive", auth, s.IssuerAPIArchiveTemplate)
+	issuerRoutes.Post("/templates/:id/preview", auth, s.IssuerAPIPreviewTemplate)
+
+}
+
+// IssuerAPIListTemplates returns all versions of the templates, optionally filtered by name
+func (s *Server) IssuerAPIListTemplates(c *fiber.Ctx) error {
+
+	tpls, err := s.issuerVault.ListTemplates(c.Query("name"))
+	if err != nil {
+		return templateError(err)
+	}
+
+	return c.JSON(tpls)
+}
+
+// IssuerAPICreateTemplate creates a new draft version of a template
+func (s *Server) IssuerAPICreateTemplate(c *fiber.Ctx) error {
+
+	req := &TemplateRequest{}
+	if err := c.BodyParser(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	tpl, err := s.issuerVault.CreateTemplate(req.Name, req.Description, req.Content, req.SampleData)
+	if err != nil {
+		return templateError(err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(tpl)
+}
+
+// IssuerAPIGetTemplate returns a version of a template given its id
+func (s *Server) IssuerAPIGetTemplate(c *fiber.Ctx) error {
+
+	tpl, err := s.issuerVault.TemplateByID(c.Params("id"))
+	if err != nil {
+		return templateError(err)
+	}
+
+	return c.JSON(tpl)
+}
+
+// IssuerAPIUpdateTemplate modifies a draft version of a template
+func (s *Server) IssuerAPIUpdateTemplate(c *fiber.Ctx) error {
+
+	req := &TemplateRequest{}
+	if err := c.BodyParser(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	tpl, err := s.issuerVault.UpdateTemplate(c.Params("id"), req.Description, req.Content, req.SampleData)
+	if err != nil {
+		return templateError(err)
+	}
+
+	return c.JSON(tpl)
+}
+
+// IssuerAPIDeleteTemplate deletes a draft version of a template
+func (s *Server) IssuerAPIDeleteTemplate(c *fiber.Ctx) error {
+
+	if err := s.issuerVault.DeleteTemplate(c.Params("id")); err != nil {
+		return templateError(err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// IssuerAPIPublishTemplate makes a version of a template the one used for issuance
+func (s *Server) IssuerAPIPublishTemplate(c *fiber.Ctx) error {
+
+	tpl, err := s.issuerVault.PublishTemplate(c.Params("id"))
+	if err != nil {
+		return templateError(err)
+	}
+
+	return c.JSON(tpl)
+}
+
+// IssuerAPIArchiveTemplate withdraws a version of a template
+func (s *Server) IssuerAPIArchiveTemplate(c *fiber.Ctx) error {
+
+	tpl, err := s.issuerVault.ArchiveTemplate(c.Params("id"))
+	if err != nil {
+		return templateError(err)
+	}
+
+	return c.JSON(tpl)
+}
+
+// IssuerAPIPreviewTemplate renders a version of a template with the data in the body,
+// or with the sample data of the template if the body is empty
+func (s *Server) IssuerAPIPreviewTemplate(c *fiber.Ctx) error {
+
+	var data map[string]any
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&data); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	}
+
+	rendered, parsed, err := s.issuerVault.PreviewTemplate(c.Params("id"), data)
+	if err != nil {
+		return templateError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"rendered": string(rendered),
+		"document": parsed,
+	})
+}
+
+// templateError converts the errors from the template store into HTTP errors
+func templateError(err error) error {
+	switch {
+	case errors.Is(err, vault.ErrTemplateNotFound):
+		return problem.New(fiber.StatusNotFound, problem.TemplateNotFound, err.Error())
+	case errors.Is(err, vault.ErrTemplateNotPublished):
+		return problem.New(fiber.StatusNotFound, problem.TemplateNotPublished, err.Error())
+	case errors.Is(err, vault.ErrTemplateNotDraft):
+		return problem.New(fiber.StatusConflict, problem.TemplateNotDraft, err.Error())
+	case errors.Is(err, vault.ErrTemplateNotArchivable):
+		return problem.New(fiber.StatusConflict, problem.TemplateNotArchivable, err.Error())
+	case errors.Is(err, vault.ErrTemplateInvalid):
+		return problem.New(fiber.StatusBadRequest, problem.TemplateInvalid, err.Error())
+	default:
+		return err
+	}
+}
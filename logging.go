@@ -0,0 +1,80 @@
+package main
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/hesusruiz/vcbackend/internal/logging"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// setupLogging creates the logger of the server, which is also the global logger used by the other packages
+func (s *Server) setupLogging() {
+
+	conf := s.conf.Server
+	logger, err := logging.Setup(logging.Options{
+		Level:         conf.LogLevel,
+		JSON:          conf.Environment == "production",
+		SensitiveData: conf.LogSensitiveData,
+	})
+	if err != nil {
+		panic(err)
+	}
+	s.logger = logger.Sugar()
+
+	if conf.LogSensitiveData {
+		s.logger.Warn("the logs are not redacted and may have credentials, tokens and personal data")
+	}
+}
+
+// requestLogger creates a logger with the id of the request and of its trace, available to the handlers
+// with s.log(c), and writes an entry when the request has been handled
+func (s *Server) requestLogger(c *fiber.Ctx) error {
+
+	start := time.Now()
+
+	logger := s.logger
+	if id, ok := c.Locals("requestid").(string); ok {
+		logger = logger.With("requestId", id)
+	}
+	if sc := trace.SpanContextFromContext(c.UserContext()); sc.HasTraceID() {
+		logger = logger.With("traceId", sc.TraceID().String())
+	}
+	c.SetUserContext(logging.WithContext(c.UserContext(), logger))
+
+	err := c.Next()
+
+	// The status of the response is set later by the error handler, if the handler failed
+	status := c.Response().StatusCode()
+	if err != nil {
+		status = fiber.StatusInternalServerError
+		var e *fiber.Error
+		if errors.As(err, &e) {
+			status = e.Code
+		}
+	}
+
+	fields := []any{
+		"method", c.Method(),
+		"path", c.Path(),
+		"status", status,
+		"latency", time.Since(start),
+	}
+	switch {
+	case status >= 500:
+		logger.Errorw("request", append(fields, zap.Error(err))...)
+	case status >= 400:
+		logger.Warnw("request", fields...)
+	default:
+		logger.Infow("request", fields...)
+	}
+
+	return err
+}
+
+// log returns the logger of the request
+func (s *Server) log(c *fiber.Ctx) *zap.SugaredLogger {
+	return logging.FromContext(c.UserContext())
+}
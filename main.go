@@ -8,17 +8,37 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/url"
 	"os"
+	"os/signal"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
 	"time"
 
 	"github.com/hesusruiz/vcbackend/back/handlers"
 	"github.com/hesusruiz/vcbackend/back/operations"
+	"github.com/hesusruiz/vcbackend/internal/config"
+	"github.com/hesusruiz/vcbackend/internal/credtype"
+	"github.com/hesusruiz/vcbackend/internal/holder"
+	"github.com/hesusruiz/vcbackend/internal/logging"
+	"github.com/hesusruiz/vcbackend/internal/metrics"
+	"github.com/hesusruiz/vcbackend/internal/openapi"
+	"github.com/hesusruiz/vcbackend/internal/pep"
+	"github.com/hesusruiz/vcbackend/internal/policy"
+	"github.com/hesusruiz/vcbackend/internal/problem"
+	"github.com/hesusruiz/vcbackend/internal/ratelimit"
+	"github.com/hesusruiz/vcbackend/internal/rp"
+	"github.com/hesusruiz/vcbackend/internal/til"
+	"github.com/hesusruiz/vcbackend/internal/tracing"
+	"github.com/hesusruiz/vcbackend/internal/vc"
 	"github.com/hesusruiz/vcbackend/vault"
 	"github.com/hesusruiz/vcutils/yaml"
 
 	"flag"
-	"log"
+	"net/http"
 
 	qrcode "github.com/skip2/go-qrcode"
 
@@ -27,30 +47,28 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/fiber/v2/middleware/csrf"
-	"github.com/gofiber/fiber/v2/middleware/logger"
 	"github.com/gofiber/fiber/v2/middleware/recover"
+	"github.com/gofiber/fiber/v2/middleware/requestid"
 	"github.com/gofiber/fiber/v2/utils"
 	"github.com/gofiber/storage/memory"
 	"github.com/gofiber/template/html"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
 const defaultConfigFile = "configs/server.yaml"
-const defaultTemplateDir = "back/views"
-const defaultStaticDir = "back/www"
 const defaultStoreDriverName = "sqlite3"
 const defaultStoreDataSourceName = "file:issuer.sqlite?mode=rwc&cache=shared&_fk=1"
-const defaultPassword = "ThePassword"
 
 const corePrefix = "/core/api/v1"
 const issuerPrefix = "/issuer/api/v1"
 const verifierPrefix = "/verifier/api/v1"
 const walletPrefix = "/wallet/api/v1"
+const registryPrefix = "/registry/api/v1"
 
 var (
 	prod       = flag.Bool("prod", false, "Enable prefork in Production")
 	configFile = flag.String("config", LookupEnvOrString("CONFIG_FILE", defaultConfigFile), "path to configuration file")
-	password   = flag.String("pass", LookupEnvOrString("PASSWORD", defaultPassword), "admin password for the server")
 )
 
 type SSIKitConfig struct {
@@ -64,17 +82,30 @@ type SSIKitConfig struct {
 // Server is the struct holding the state of the server
 type Server struct {
 	*fiber.App
-	cfg           *yaml.YAML
-	WebAuthn      *handlers.WebAuthnHandler
-	Operations    *operations.Manager
-	issuerVault   *vault.Vault
-	verifierVault *vault.Vault
-	walletvault   *vault.Vault
-	issuerDID     string
-	verifierDID   string
-	logger        *zap.SugaredLogger
-	storage       *memory.Storage
-	ssiKit        *SSIKitConfig
+	cfg            *yaml.YAML
+	conf           *config.Config
+	WebAuthn       *handlers.WebAuthnHandler
+	Operations     *operations.Manager
+	issuerVault    *vault.Vault
+	verifierVault  *vault.Vault
+	walletvault    *vault.Vault
+	registryVault  *vault.Vault
+	issuerDID      string
+	verifierDID    string
+	logger         *zap.SugaredLogger
+	storage        *memory.Storage
+	ssiKit         *SSIKitConfig
+	credTypes      *credtype.Registry
+	holders        *holder.Resolver
+	trustedIssuers til.Registry
+	policies       *policy.Set
+	pep            *pep.Router
+	pepClient      *http.Client
+	forwardAuth    *pep.Router
+	draining       atomic.Bool
+	background     sync.WaitGroup
+	apiSpec        *openapi.Validator
+	limiter        *ratelimit.Limiter
 }
 
 func LookupEnvOrString(key string, defaultVal string) string {
@@ -85,6 +116,14 @@ func LookupEnvOrString(key string, defaultVal string) string {
 }
 
 func main() {
+
+	// The first argument is the command, and without one the server is started
+	if len(os.Args) > 1 && !strings.HasPrefix(os.Args[1], "-") {
+		os.Exit(runCommand(os.Args[1], os.Args[2:]))
+	}
+
+	flag.Usage = usage
+	flag.Parse()
 	run()
 }
 
@@ -95,62 +134,105 @@ func run() {
 	s := Server{}
 
 	// Read configuration file
-	cfg := readConfiguration(*configFile)
+	conf, cfg := readConfiguration(*configFile)
+	s.cfg = cfg
+	s.conf = conf
 
 	// Create the logger and store in Server so all handlers can use it
-	if cfg.String("server.environment") == "production" {
-		s.logger = zap.Must(zap.NewProduction()).Sugar()
-	} else {
-		s.logger = zap.Must(zap.NewDevelopment()).Sugar()
-	}
-	zap.WithCaller(true)
+	s.setupLogging()
 	defer s.logger.Sync()
 
-	// Parse command-line flags
-	flag.Parse()
-
 	// Create the template engine using the templates in the configured directory
-	templateDir := cfg.String("server.templateDir", defaultTemplateDir)
-	templateEngine := html.New(templateDir, ".html")
+	templateEngine := html.New(conf.Server.TemplateDir, ".html")
 
-	if cfg.String("server.environment") == "development" {
+	if conf.Server.Environment == "development" {
 		// Just for development time. Disable when in production
 		templateEngine.Reload(true)
 	}
 
 	// Define the configuration for Fiber
 	fiberCfg := fiber.Config{
-		Views:       templateEngine,
-		ViewsLayout: "layouts/main",
-		Prefork:     *prod,
+		Views:        templateEngine,
+		ViewsLayout:  "layouts/main",
+		Prefork:      *prod,
+		ErrorHandler: s.errorHandler,
+		BodyLimit:    conf.Server.BodyLimit,
+
+		// The X-Forwarded-* headers and the address of the client in the proxy header are only
+		// accepted from the trusted proxies
+		EnableTrustedProxyCheck: true,
+		TrustedProxies:          conf.Server.Proxy.TrustedProxies,
+		ProxyHeader:             conf.Server.Proxy.Header,
 	}
 
 	// Create a Fiber instance and set it in our Server struct
 	s.App = fiber.New(fiberCfg)
-	s.cfg = cfg
+
+	// The background tasks run until the process is asked to terminate
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Export the traces of the requests, if configured
+	flushTracing := s.setupTracing()
+	defer flushTracing()
 
 	// Connect to the different store engines
 	s.issuerVault = vault.Must(vault.New(yaml.New(cfg.Map("issuer"))))
 	s.verifierVault = vault.Must(vault.New(yaml.New(cfg.Map("verifier"))))
 	s.walletvault = vault.Must(vault.New(yaml.New(cfg.Map("wallet"))))
+	s.registryVault = vault.Must(vault.New(yaml.New(cfg.Map("verifiableregistry"))))
 
 	// Create the issuer and verifier users
 	// TODO: the password is only for testing
-	s.issuerVault.CreateUserWithKey(cfg.String("issuer.id"), cfg.String("issuer.name"), "legalperson", cfg.String("issuer.password"))
-	s.verifierVault.CreateUserWithKey(cfg.String("verifier.id"), cfg.String("verifier.name"), "legalperson", cfg.String("verifier.password"))
+	s.issuerVault.CreateUserWithKey(conf.Issuer.ID, conf.Issuer.Name, "legalperson", conf.Issuer.Password)
+	s.verifierVault.CreateUserWithKey(conf.Verifier.ID, conf.Verifier.Name, "legalperson", conf.Verifier.Password)
+
+	// Create the operators of the issuer
+	s.createOperators()
 
-	s.ssiKit = fromMap(cfg.Map("ssikit"))
+	// Read the declarations of the credential types which can be issued
+	s.credTypes, err = credtype.LoadDir(conf.Issuer.CredentialTypesDir)
+	if err != nil {
+		panic(err)
+	}
+
+	// Resolver for the DIDs of the holders
+	s.holders = holder.NewResolver(conf.Issuer.HolderBinding.ResolverURL)
+
+	s.ssiKit = &SSIKitConfig{
+		coreUrl:      conf.SSIKit.CoreURL,
+		signatoryUrl: conf.SSIKit.SignatoryURL,
+		auditorUrl:   conf.SSIKit.AuditorURL,
+		custodianUrl: conf.SSIKit.CustodianURL,
+		essifUrl:     conf.SSIKit.EssifURL,
+	}
 
 	s.logger.Infof("SSIKit is configured at: %v", s.ssiKit)
 
 	// Create the DIDs for the issuer and verifier
-	s.issuerDID, err = operations.SSIKitCreateDID(s.ssiKit.custodianUrl, s.issuerVault, cfg.String("issuer.id"))
+	s.issuerDID, err = operations.SSIKitCreateDID(s.ssiKit.custodianUrl, s.issuerVault, conf.Issuer.ID)
 	if err != nil {
 		panic(err)
 	}
 	s.logger.Infow("IssuerDID created", "did", s.issuerDID)
 
-	s.verifierDID, err = operations.SSIKitCreateDID(s.ssiKit.custodianUrl, s.verifierVault, cfg.String("verifier.id"))
+	// The verifier accepts credentials only from the issuers in the Trusted Issuers List,
+	// either the local one or the one in a remote registry
+	if conf.Verifier.TrustedIssuers.Enabled {
+		if registryURL := conf.Verifier.TrustedIssuers.RegistryURL; len(registryURL) > 0 {
+			s.trustedIssuers = til.NewClient(registryURL)
+		} else {
+			s.trustedIssuers = s.registryVault
+		}
+	}
+	if conf.VerifiableRegistry.TrustLocalIssuer {
+		s.trustLocalIssuer()
+	}
+
+	// Access policies of the protected services
+	s.loadPolicies(ctx)
+
+	s.verifierDID, err = operations.SSIKitCreateDID(s.ssiKit.custodianUrl, s.verifierVault, conf.Verifier.ID)
 	if err != nil {
 		panic(err)
 	}
@@ -162,14 +244,23 @@ func run() {
 	// Recover panics from the HTTP handlers so the server continues running
 	s.Use(recover.New(recover.Config{EnableStackTrace: true}))
 
-	s.Use(logger.New(logger.Config{
-		// TimeFormat: "02-Jan-1985",
-		TimeZone: "Europe/Brussels",
-	}))
+	// Trace the requests, continuing the traces of the callers
+	s.Use(s.tracingMiddleware)
+
+	// Identify each request, log it with its id, and record the audited ones
+	s.Use(requestid.New())
+	s.Use(s.requestLogger)
+	s.Use(s.auditMiddleware)
 
 	// CORS
 	s.Use(cors.New())
 
+	// Metrics of the requests, and the endpoint for Prometheus
+	s.addMetricsRoutes()
+
+	// Limits of the rate of the requests and of the failed logins
+	s.setupRateLimits()
+
 	// CSRF
 	csrfHandler := csrf.New(csrf.Config{
 		KeyLookup:      "form:_csrf",
@@ -192,16 +283,14 @@ func run() {
 	s.Get("/", s.HandleHome)
 	s.Get("/issuer", s.HandleIssuerHome)
 	s.Get("/verifier", s.HandleVerifierHome)
-	s.Get("/stop", s.HandleStop)
+
+	// Liveness and readiness probes
+	s.addHealthRoutes()
 
 	// ##########################
 	// Issuer routes
 	issuerRoutes := s.Group(issuerPrefix)
 
-	// Handle new credential
-	issuerRoutes.Get("/newcredential", csrfHandler, s.IssuerPageNewCredentialFormDisplay)
-	issuerRoutes.Post("/newcredential", csrfHandler, s.IssuerPageNewCredentialFormPost)
-
 	// Display details of a credential
 	issuerRoutes.Get("/creddetails/:id", s.IssuerPageCredentialDetails)
 
@@ -209,10 +298,31 @@ func run() {
 	issuerRoutes.Get("/displayqrurl/:id", s.IssuerPageDisplayQRURL)
 
 	// Get a list of all credentials
-	issuerRoutes.Get("/allcredentials", s.IssuerAPIAllCredentials)
+	issuerRoutes.Get("/allcredentials", s.APIListWalletCredentials)
 
 	// Get a credential given its ID
-	issuerRoutes.Get("/credential/:id", s.IssuerAPICredential)
+	issuerRoutes.Get("/credential/:id", s.rateLimit("credential", conf.Server.RateLimit.Credential), s.IssuerAPICredential)
+
+	// Search the credentials
+	s.addSearchRoutes(issuerRoutes)
+
+	// Manage the credential templates
+	s.addTemplateRoutes(issuerRoutes)
+
+	// Issuance of credentials with the forms declared for each credential type
+	s.addCredentialTypeRoutes(issuerRoutes, csrfHandler)
+
+	// Binding of credentials to the DID of the holder
+	s.addHolderRoutes(issuerRoutes)
+
+	// Renewal and revocation of credentials
+	s.addRenewalRoutes(issuerRoutes, csrfHandler)
+
+	// Approval of credentials by a second operator
+	s.addApprovalRoutes(issuerRoutes, csrfHandler)
+
+	// Audit log of the issuer
+	s.addAuditRoutes(issuerRoutes, s.issuerVault)
 
 	// ###########################
 	// Verifier routes
@@ -224,60 +334,70 @@ func run() {
 	verifierRoutes.Get("/receivecredential/:state", s.VerifierPageReceiveCredential)
 	verifierRoutes.Get("/accessprotectedservice", s.VerifierPageAccessProtectedService)
 
-	verifierRoutes.Get("/poll/:state", s.VerifierAPIPoll)
-	verifierRoutes.Get("/startsiop", s.VerifierAPIStartSIOP)
-	verifierRoutes.Post("/authenticationresponse", s.VerifierAPIAuthenticationResponse)
+	siopLimit := s.rateLimit("siop", conf.Server.RateLimit.SIOP)
+	verifierRoutes.Get("/poll/:state", siopLimit, s.VerifierAPIPoll)
+	verifierRoutes.Get("/startsiop", siopLimit, s.VerifierAPIStartSIOP)
+	verifierRoutes.Post("/authenticationresponse", siopLimit, bodyLimit(conf.Server.CredentialBodyLimit), s.VerifierAPIAuthenticationResponse)
+
+	// Access policies of the protected services
+	s.addPolicyRoutes(verifierRoutes)
+	s.addForwardAuthRoutes(verifierRoutes)
+	s.addClientRoutes(verifierRoutes)
+
+	// Audit log of the verifier
+	s.addAuditRoutes(verifierRoutes, s.verifierVault)
+
+	// ########################################
+	// Verifiable registry routes
+	registryRoutes := s.Group(registryPrefix)
+
+	// Trusted Issuers List
+	s.addRegistryRoutes(registryRoutes)
+
+	// Audit log of the registry
+	s.addAuditRoutes(registryRoutes, s.registryVault)
+
+	// ########################################
+	// Reverse proxy to the services protected by the verifier
+	s.addPEPRoutes()
 
 	// ########################################
 	// Wallet routes
 	walletRoutes := s.Group(walletPrefix)
 
-	walletRoutes.Get("/selectcredential", s.WalletPageSelectCredential)
-	walletRoutes.Get("/sendcredential", s.WalletPageSendCredential)
+	// The holders present their credentials after authenticating with their user of the wallet
+	walletAuth := s.basicAuth(s.walletvault, s.walletAuthConfig())
+	walletRoutes.Get("/selectcredential", walletAuth, s.WalletPageSelectCredential)
+	walletRoutes.Get("/sendcredential", walletAuth, s.WalletPageSendCredential)
 
 	// ########################################
 	// Core routes
 	coreRoutes := s.Group(corePrefix)
 
 	// Create DID
-	coreRoutes.Get("/createdid", s.CoreAPICreateDID)
+	coreRoutes.Get("/createdid", s.rateLimit("did", conf.Server.RateLimit.DID), s.CoreAPICreateDID)
 	// List Templates
 	coreRoutes.Get("/listcredentialtemplates", s.CoreAPIListCredentialTemplates)
 	// Get one template
 	coreRoutes.Get("/getcredentialtemplate/:id", s.CoreAPIGetCredentialTemplate)
 
 	// ########################################
+	// Versioned JSON API
+	s.addAPIRoutes()
 
-	// Setup static files
-	s.Static("/static", cfg.String("server.staticDir", defaultStaticDir))
+	// ########################################
 
-	// Start the server
-	log.Fatal(s.Listen(cfg.String("server.listenAddress")))
+	// Setup static files
+	s.Static("/static", conf.Server.StaticDir)
 
-}
+	// Look periodically for credentials which have to be renewed
+	s.startRenewalSweep(ctx)
 
-func fromMap(configMap map[string]any) (skc *SSIKitConfig) {
-	coreUrl, ok := configMap["coreURL"]
-	if !ok {
-		panic(errors.New("no_core_url"))
+	// Start the server, which runs until the process is asked to terminate
+	if err := s.listenAndServe(ctx, conf.Server.ListenAddress); err != nil {
+		s.logger.Fatalw("error starting the server", zap.Error(err))
 	}
-	custodianUrl, ok := configMap["custodianURL"]
-	if !ok {
-		panic(errors.New("no_custodian_url"))
-	}
-	signatoryUrl, ok := configMap["signatoryURL"]
-	if !ok {
-		panic(errors.New("no_signatory_url"))
-	}
-	essifUrl, ok := configMap["essifURL"]
-	if !ok {
-		panic(errors.New("no_essif_url"))
-	}
-	auditorUrl, ok := configMap["auditorURL"]
-	if !ok {
-		panic(errors.New("no_auditor_url"))
-	}
-	return &SSIKitConfig{coreUrl: coreUrl.(string), signatoryUrl: signatoryUrl.(string), auditorUrl: auditorUrl.(string), essifUrl: essifUrl.(string), custodianUrl: custodianUrl.(string)}
+
 }
 
 func (s *Server) HandleHome(c *fiber.Ctx) error {
@@ -286,18 +406,32 @@ func (s *Server) HandleHome(c *fiber.Ctx) error {
 	return c.Render("index", "")
 }
 
-func (s *Server) HandleStop(c *fiber.Ctx) error {
-	os.Exit(0)
-	return nil
-}
-
 func (s *Server) HandleIssuerHome(c *fiber.Ctx) error {
 
-	// Get the list of credentials
-	credsSummary, err := s.Operations.GetAllCredentials()
+	// Get a page of the credentials, with the filters entered by the operator
+	q, err := credentialQuery(c)
 	if err != nil {
 		return err
 	}
+	if len(c.Query("order")) == 0 {
+		// The most recent credentials first
+		q.Desc = true
+	}
+	page, err := s.issuerVault.SearchCredentials(q)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	// The link to the next page keeps the filters
+	nextURL := ""
+	if len(page.NextCursor) > 0 {
+		params := url.Values{}
+		c.Context().QueryArgs().VisitAll(func(key, value []byte) {
+			params.Set(string(key), string(value))
+		})
+		params.Set("cursor", page.NextCursor)
+		nextURL = "/issuer?" + params.Encode()
+	}
 
 	// Render template
 	m := fiber.Map{
@@ -305,7 +439,9 @@ func (s *Server) HandleIssuerHome(c *fiber.Ctx) error {
 		"verifierPrefix": verifierPrefix,
 		"walletPrefix":   walletPrefix,
 		"prefix":         issuerPrefix,
-		"credlist":       credsSummary,
+		"credlist":       page.Items,
+		"nextURL":        nextURL,
+		"query":          q,
 	}
 	return c.Render("issuer_home", m)
 }
@@ -343,14 +479,13 @@ func (s *Server) IssuerPageDisplayQRURL(c *fiber.Ctx) error {
 	s.storage.Set(state, []byte("pending"), 40*time.Second)
 
 	// QR code for cross-device SIOP
-	template := "{{protocol}}://{{hostname}}{{prefix}}/credential/{{id}}?state={{state}}"
+	template := "{{base}}{{prefix}}/credential/{{id}}?state={{state}}"
 	t := fasttemplate.New(template, "{{", "}}")
 	str := t.ExecuteString(map[string]interface{}{
-		"protocol": c.Protocol(),
-		"hostname": c.Hostname(),
-		"prefix":   issuerPrefix,
-		"id":       id,
-		"state":    state,
+		"base":   s.baseURL(c),
+		"prefix": issuerPrefix,
+		"id":     id,
+		"state":  state,
 	})
 
 	// Create the QR
@@ -392,14 +527,14 @@ func (s *Server) VerifierPageDisplayQR(c *fiber.Ctx) error {
 	// Generate the state that will be used for checking expiration
 	state := generateNonce()
 
-	// Create an entry in storage that will expire in 2 minutes
-	// The entry is identified by the nonce
-	// s.storage.Set(state, []byte("pending"), 2*time.Minute)
-	s.storage.Set(state, []byte("pending"), 40*time.Second)
+	// Start the session of the login, which expires if no credential is presented
+	if err := s.startSession(c.UserContext(), state, 40*time.Second); err != nil {
+		return err
+	}
 
 	// QR code for cross-device SIOP
-	template := "{{protocol}}://{{hostname}}{{prefix}}/startsiop?state={{state}}"
-	qrCode1, err := qrCode(template, c.Protocol(), c.Hostname(), verifierPrefix, state)
+	template := "{{base}}{{prefix}}/startsiop?state={{state}}"
+	qrCode1, err := qrCode(template, s.baseURL(c), verifierPrefix, state)
 	if err != nil {
 		return err
 	}
@@ -416,15 +551,14 @@ func (s *Server) VerifierPageDisplayQR(c *fiber.Ctx) error {
 	return c.Render("verifier_present_qr", m)
 }
 
-func qrCode(template, protocol, hostname, prefix, state string) (string, error) {
+func qrCode(template, base, prefix, state string) (string, error) {
 
 	// Construct the URL to be included in the QR
 	t := fasttemplate.New(template, "{{", "}}")
 	str := t.ExecuteString(map[string]interface{}{
-		"protocol": protocol,
-		"hostname": hostname,
-		"prefix":   prefix,
-		"state":    state,
+		"base":   base,
+		"prefix": prefix,
+		"state":  state,
 	})
 
 	// Create the QR
@@ -446,35 +580,27 @@ func (s *Server) VerifierPageDisplayQRSIOP(c *fiber.Ctx) error {
 	// Generate the state that will be used for checking expiration
 	state := generateNonce()
 
-	// Create an entry in storage that will expire in 2 minutes
-	// The entry is identified by the nonce
-	// s.storage.Set(state, []byte("pending"), 2*time.Minute)
-	s.storage.Set(state, []byte("pending"), 200*time.Second)
-
-	// QR code for cross-device SIOP
+	// Start the session of the login, which expires if no credential is presented
+	if err := s.startSession(c.UserContext(), state, 200*time.Second); err != nil {
+		return err
+	}
 
-	const scope = "dsba.credentials.presentation.PacketDeliveryService"
-	const response_type = "vp_token"
-	redirect_uri := c.Protocol() + "://" + c.Hostname() + verifierPrefix + "/authenticationresponse"
+	// Return to the service protected by forward authentication after the login
+	if err := s.saveReturnURL(c.UserContext(), state, c.Query("return")); err != nil {
+		return err
+	}
 
-	template := "openid://?scope={{scope}}" +
-		"&response_type={{response_type}}" +
-		"&response_mode=post" +
-		"&client_id={{client_id}}" +
-		"&redirect_uri={{redirect_uri}}" +
-		"&state={{state}}" +
-		"&nonce={{nonce}}"
+	// The login may be for a relying party registered in the verifier
+	client, err := s.startClientLogin(c, state)
+	if err != nil {
+		return err
+	}
 
-	t := fasttemplate.New(template, "{{", "}}")
-	str := t.ExecuteString(map[string]interface{}{
-		"scope":         scope,
-		"response_type": response_type,
-		"client_id":     s.verifierDID,
-		"redirect_uri":  redirect_uri,
-		"state":         state,
-		"nonce":         generateNonce(),
-	})
-	fmt.Println(str)
+	// QR code for cross-device SIOP
+	str, err := s.siopRequest(c, "openid://", state, client)
+	if err != nil {
+		return err
+	}
 
 	// Create the QR
 	png, err := qrcode.Encode(str, qrcode.Medium, 256)
@@ -500,16 +626,15 @@ func (s *Server) VerifierPageDisplayQRSIOP(c *fiber.Ctx) error {
 
 func (s *Server) VerifierAPIPoll(c *fiber.Ctx) error {
 
-	// get the state
-	state := c.Params("state")
-
-	// Check if session still pending
-	status, _ := s.storage.Get(state)
-	if len(status) == 0 {
-		return c.SendString("expired")
-	} else {
-		return c.SendString(string(status))
+	// The status of the session, or the credential when it is completed
+	status, credential, err := s.sessionStatus(c, c.Params("state"))
+	if err != nil {
+		return err
 	}
+	if len(credential) > 0 {
+		return c.SendString(credential)
+	}
+	return c.SendString(status)
 
 }
 
@@ -524,37 +649,16 @@ func (s *Server) VerifierPageStartSIOPSameDevice(c *fiber.Ctx) error {
 
 	state := c.Query("state")
 
-	const scope = "dsba.credentials.presentation.PacketDeliveryService"
-	const response_type = "vp_token"
-	redirect_uri := c.Protocol() + "://" + c.Hostname() + verifierPrefix + "/authenticationresponse"
-
-	// template := "https://hesusruiz.github.io/faster/?scope={{scope}}" +
-	// 	"&response_type={{response_type}}" +
-	// 	"&response_mode=post" +
-	// 	"&client_id={{client_id}}" +
-	// 	"&redirect_uri={{redirect_uri}}" +
-	// 	"&state={{state}}" +
-	// 	"&nonce={{nonce}}"
-
-	walletUri := c.Protocol() + "://" + c.Hostname() + walletPrefix + "/selectcredential"
-	template := walletUri + "/?scope={{scope}}" +
-		"&response_type={{response_type}}" +
-		"&response_mode=post" +
-		"&client_id={{client_id}}" +
-		"&redirect_uri={{redirect_uri}}" +
-		"&state={{state}}" +
-		"&nonce={{nonce}}"
+	client, err := s.clientForState(c.UserContext(), state)
+	if err != nil {
+		return err
+	}
 
-	t := fasttemplate.New(template, "{{", "}}")
-	str := t.ExecuteString(map[string]interface{}{
-		"scope":         scope,
-		"response_type": response_type,
-		"client_id":     s.verifierDID,
-		"redirect_uri":  redirect_uri,
-		"state":         state,
-		"nonce":         generateNonce(),
-	})
-	fmt.Println(str)
+	walletUri := s.baseURL(c) + walletPrefix + "/selectcredential"
+	str, err := s.siopRequest(c, walletUri+"/", state, client)
+	if err != nil {
+		return err
+	}
 
 	return c.Redirect(str)
 }
@@ -564,11 +668,34 @@ func (s *Server) VerifierAPIStartSIOP(c *fiber.Ctx) error {
 	// Get the state
 	state := c.Query("state")
 
-	const scope = "dsba.credentials.presentation.PacketDeliveryService"
+	client, err := s.clientForState(c.UserContext(), state)
+	if err != nil {
+		return err
+	}
+
+	str, err := s.siopRequest(c, "openid://", state, client)
+	if err != nil {
+		return err
+	}
+
+	return c.SendString(str)
+}
+
+// siopRequest creates the SIOP authentication request of the login with the state, for the relying
+// party of the login if there is one. The request starts with the base, which is the scheme of the
+// wallets or the URL of the web wallet.
+func (s *Server) siopRequest(c *fiber.Ctx, base string, state string, client *rp.Client) (string, error) {
+
+	nonce, err := s.issueNonce(c.UserContext(), state)
+	if err != nil {
+		return "", err
+	}
+
+	scope, presentationDefinition := siopRequestParams(client)
 	const response_type = "vp_token"
-	redirect_uri := c.Protocol() + "://" + c.Hostname() + verifierPrefix + "/authenticationresponse"
+	redirect_uri := s.authenticationResponseURL(c)
 
-	template := "openid://?scope={{scope}}" +
+	template := base + "?scope={{scope}}" +
 		"&response_type={{response_type}}" +
 		"&response_mode=post" +
 		"&client_id={{client_id}}" +
@@ -583,11 +710,14 @@ func (s *Server) VerifierAPIStartSIOP(c *fiber.Ctx) error {
 		"client_id":     s.verifierDID,
 		"redirect_uri":  redirect_uri,
 		"state":         state,
-		"nonce":         generateNonce(),
+		"nonce":         nonce,
 	})
-	fmt.Println(str)
+	if len(presentationDefinition) > 0 {
+		str += "&presentation_definition=" + presentationDefinition
+	}
+	s.log(c).Debugw("SIOP authentication request", "state", state, "request", str)
 
-	return c.SendString(str)
+	return str, nil
 }
 
 func (s *Server) VerifierAPIAuthenticationResponse(c *fiber.Ctx) error {
@@ -595,22 +725,51 @@ func (s *Server) VerifierAPIAuthenticationResponse(c *fiber.Ctx) error {
 	// Get the state
 	state := c.Query("state")
 
-	// We should receive the credential in the body as JSON
+	// We should receive the presentation in the body as JSON
 	body := c.Body()
-	fmt.Println(string(body))
+	s.log(c).Debugw("presentation received", "state", state, "body", body)
 
 	// Decode into a map
-	cred, err := yaml.ParseJson(string(body))
+	resp, err := yaml.ParseJson(string(body))
 	if err != nil {
-		s.logger.Errorw("invalid credential received", zap.Error(err))
+		s.log(c).Errorw("invalid presentation received", zap.Error(err))
+		recordVerification("invalid_request")
 		return err
 	}
 
-	credential := cred.String("credential")
-	// Validate the credential
+	// The presentation must be signed by the holder of the credential, for the verifier
+	presentation, err := s.holders.VerifyPresentation(resp.String("vp_token"), s.verifierDID)
+	if err != nil {
+		s.log(c).Infow("presentation rejected", "state", state, zap.Error(err))
+		recordVerification("presentation")
+		return problem.New(fiber.StatusBadRequest, problem.PresentationInvalid, err.Error())
+	}
+	credential := string(presentation.Credential)
 
-	// Set the credential in storage, and wait for the polling from client
-	s.storage.Set(state, []byte(credential), 10*time.Second)
+	// The presentation must carry the nonce of the request, which can be used only once
+	if err := s.consumeNonce(c.UserContext(), state, presentation.Nonce, s.verifierDID); err != nil {
+		recordVerification("nonce")
+		return err
+	}
+
+	// Validate the issuer of the credential
+	if err := s.verifyIssuer(c.UserContext(), []byte(credential)); err != nil {
+		recordVerification("untrusted_issuer")
+		return err
+	}
+
+	// Check the credential types required by the relying party of the login
+	if err := s.checkClientCredential(c.UserContext(), state, []byte(credential)); err != nil {
+		recordVerification("credential_type")
+		return err
+	}
+
+	// Set the credential in the session, and wait for the polling from client
+	if err := s.verifierVault.WithContext(c.UserContext()).CompleteSession(state, credential, completedSessionLifetime); err != nil {
+		recordVerification("session")
+		return sessionError(err)
+	}
+	recordVerification("")
 
 	return c.SendString("ok")
 }
@@ -631,29 +790,15 @@ func (s *Server) HandleAuthenticationRequest(c *fiber.Ctx) error {
 	return c.Render("wallet_selectcredential", m)
 }
 
-func (s *Server) IssuerAPIAllCredentials(c *fiber.Ctx) error {
-
-	// Get the list of credentials
-	credsSummary, err := s.Operations.GetAllCredentials()
-	if err != nil {
-		return err
-	}
-
-	return c.JSON(credsSummary)
-}
-
 func (s *Server) IssuerAPICredential(c *fiber.Ctx) error {
 
-	// Get the ID of the credential
-	credID := c.Params("id")
-
 	// Get the raw credential from the Vault
-	rawCred, err := s.issuerVault.Client.Credential.Get(context.Background(), credID)
+	cred, err := s.issuedCredential(c)
 	if err != nil {
 		return err
 	}
 
-	return c.SendString(string(rawCred.Raw))
+	return c.SendString(string(cred.Raw))
 }
 
 func (s *Server) WalletPageSelectCredential(c *fiber.Ctx) error {
@@ -695,30 +840,71 @@ func (s *Server) WalletPageSendCredential(c *fiber.Ctx) error {
 
 	// Get the ID of the credential
 	credID := c.Query("id")
-	s.logger.Info("credID", credID)
 
 	// Get the url where we have to send the credential
 	redirect_uri := c.Query("redirect_uri")
-	s.logger.Info("redirect_uri", redirect_uri)
 
 	// Get the state nonce
 	state := c.Query("state")
-	s.logger.Info("state", state)
+	s.log(c).Infow("sending credential", "id", credID, "redirect_uri", redirect_uri, "state", state)
+
+	// Get the nonce and the client of the request, which the verifier checks in the presentation
+	nonce := c.Query("nonce")
+	clientID := c.Query("client_id")
+
+	code, err := s.sendCredential(c, credID, redirect_uri, state, nonce, clientID)
+	if err != nil {
+		return err
+	}
+
+	s.log(c).Infow("credential sent", "state", state, "status", code)
+
+	// Tell the user that it was OK
+	m := fiber.Map{
+		"issuerPrefix":   issuerPrefix,
+		"verifierPrefix": verifierPrefix,
+		"walletPrefix":   walletPrefix,
+		"prefix":         verifierPrefix,
+		"error":          "",
+	}
+	if code < 200 || code > 299 {
+		m["error"] = fmt.Sprintf("Error calling server: %v", code)
+	}
+	return c.Render("wallet_credentialsent", m)
+}
+
+// sendCredential sends a credential of the holder authenticated in the wallet to the verifier, answering
+// its authentication request. It returns the status of the answer of the verifier.
+func (s *Server) sendCredential(c *fiber.Ctx, credID string, redirect_uri string, state string, nonce string, clientID string) (int, error) {
+
+	// The presentations are sent only to the verifiers known by the wallet
+	if err := s.checkPresentationRedirect(c, clientID, redirect_uri); err != nil {
+		return 0, err
+	}
 
 	// Get the raw credential from the Vault
 	// TODO: change to the vault of the wallet without relying on the issuer
-	rawCred, err := s.issuerVault.Client.Credential.Get(context.Background(), credID)
+	rawCred, err := s.issuerVault.Client.Credential.Get(c.UserContext(), credID)
 	if err != nil {
-		return err
+		return 0, err
+	}
+
+	// Present the credential, signed by its holder for the verifier
+	vpToken, err := s.signPresentation(operator(c), rawCred.Raw, nonce, clientID)
+	if errors.Is(err, vault.ErrNoKeyForDID) {
+		return 0, fiber.NewError(fiber.StatusForbidden, "the credential is not of the holder authenticated: "+err.Error())
+	}
+	if err != nil {
+		return 0, err
 	}
 
-	// Prepare to POST the credential to the url, passing the state
+	// Prepare to POST the presentation to the url, passing the state
 	agent := fiber.Post(redirect_uri)
 	agent.QueryString("state=" + state)
 
-	// Set the credential in the body of the request
+	// Set the presentation in the body of the request
 	bodyRequest := fiber.Map{
-		"credential": string(rawCred.Raw),
+		"vp_token": vpToken,
 	}
 	agent.JSON(bodyRequest)
 
@@ -728,26 +914,53 @@ func (s *Server) WalletPageSendCredential(c *fiber.Ctx) error {
 
 	// Send the request.
 	// We are interested only in the success of the request.
-	code, _, errors := agent.Bytes()
+	code, _, errors := tracing.Do(c.UserContext(), "wallet.sendCredential", agent)
 	if len(errors) > 0 {
-		s.logger.Errorw("error sending credential", zap.Errors("errors", errors))
-		return fmt.Errorf("error sending credential: %v", errors[0])
+		s.log(c).Errorw("error sending credential", zap.Errors("errors", errors))
+		return 0, fmt.Errorf("error sending credential: %v", errors[0])
 	}
 
-	fmt.Println("code:", code)
+	return code, nil
+}
 
-	// Tell the user that it was OK
-	m := fiber.Map{
-		"issuerPrefix":   issuerPrefix,
-		"verifierPrefix": verifierPrefix,
-		"walletPrefix":   walletPrefix,
-		"prefix":         verifierPrefix,
-		"error":          "",
+// signPresentation creates a presentation of the credential with the nonce of the request, for the
+// verifier in the audience. It is signed with the key of the holder of the credential, which must be
+// a DID of the user of the wallet vault.
+func (s *Server) signPresentation(userid string, raw []byte, nonce string, audience string) (string, error) {
+
+	cred, err := vc.Decode(raw)
+	if err != nil {
+		return "", err
 	}
-	if code < 200 || code > 299 {
-		m["error"] = fmt.Sprintf("Error calling server: %v", code)
+	holderDID := cred.SubjectID()
+	key, err := s.walletvault.KeyForDID(userid, holderDID)
+	if err != nil {
+		return "", err
 	}
-	return c.Render("wallet_credentialsent", m)
+
+	// A JSON credential is embedded as an object, and a JWT as a string
+	var presented any = string(raw)
+	if json.Valid(raw) {
+		presented = json.RawMessage(raw)
+	}
+
+	now := time.Now()
+	claims := map[string]any{
+		"iss":   holderDID,
+		"sub":   holderDID,
+		"aud":   audience,
+		"nonce": nonce,
+		"iat":   now.Unix(),
+		"exp":   now.Add(holder.MaxProofAge).Unix(),
+		"jti":   uuid.NewString(),
+		"vp": map[string]any{
+			"@context":             []string{"https://www.w3.org/2018/credentials/v1"},
+			"type":                 []string{"VerifiablePresentation"},
+			"holder":               holderDID,
+			"verifiableCredential": []any{presented},
+		},
+	}
+	return s.walletvault.SignWithJWK(key, claims)
 }
 
 func (s *Server) VerifierPageReceiveCredential(c *fiber.Ctx) error {
@@ -755,9 +968,12 @@ func (s *Server) VerifierPageReceiveCredential(c *fiber.Ctx) error {
 	// Get the state as a path parameter
 	state := c.Params("state")
 
-	// get the credential from the storage
-	rawCred, _ := s.storage.Get(state)
-	if len(rawCred) == 0 {
+	// get the credential from the session of the login
+	session, err := s.session(c.UserContext(), state)
+	if err != nil {
+		return err
+	}
+	if session == nil || !session.Completed() {
 		// Render an error
 		m := fiber.Map{
 			"error": "No credential found",
@@ -765,19 +981,48 @@ func (s *Server) VerifierPageReceiveCredential(c *fiber.Ctx) error {
 		return c.Render("displayerror", m)
 	}
 
-	claims := string(rawCred)
+	claims := session.Credential
+	rawCred := []byte(claims)
 
-	// Create an access token from the credential
-	accessToken, err := s.issuerVault.CreateAccessToken(claims, s.cfg.String("issuer.id"))
+	// The relying party of the login, if there is one
+	client, err := s.clientForSession(session)
+	if err != nil {
+		return err
+	}
+
+	// Check the access policy of the service of the relying party, or of the protected resource
+	decision, err := s.authorize(s.clientService(client), rawCred)
+	if err != nil {
+		return err
+	}
+	if !decision.Allow {
+		m := fiber.Map{
+			"error":        "Access denied to " + decision.Service,
+			"explanations": decision.Explanations,
+		}
+		return c.Status(fiber.StatusForbidden).Render("displayerror", m)
+	}
+
+	// Create an access token from the credential, for the relying party of the login if there is one
+	lifetime, audience, alg := s.accessTokenLifetime(), s.accessTokenAudience(), ""
+	if client != nil {
+		lifetime, audience, alg = client.Lifetime(lifetime), client.TokenAudience(), client.SigningAlg
+	}
+	accessToken, err := s.verifierVault.WithContext(c.UserContext()).CreateAccessTokenWithAlg(claims, s.conf.Verifier.ID, audience, lifetime, alg)
 	if err != nil {
 		return err
 	}
 
 	// Set it in a cookie
 	cookie := new(fiber.Cookie)
-	cookie.Name = "dbsamvf"
+	cookie.Name = accessTokenCookie
 	cookie.Value = string(accessToken)
-	cookie.Expires = time.Now().Add(1 * time.Hour)
+	cookie.Expires = time.Now().Add(lifetime)
+	cookie.Path = "/"
+	cookie.HTTPOnly = true
+	cookie.SameSite = fiber.CookieSameSiteLaxMode
+	// The domain allows the services behind forward authentication to receive the cookie
+	cookie.Domain = s.conf.Verifier.AccessToken.CookieDomain
 
 	// Set cookie
 	c.Cookie(cookie)
@@ -786,6 +1031,21 @@ func (s *Server) VerifierPageReceiveCredential(c *fiber.Ctx) error {
 	bearer := "Bearer " + string(accessToken)
 	c.Set("Authorization", bearer)
 
+	// Return to the relying party with the access token in the fragment of its redirect URI
+	if client != nil {
+		fragment := url.Values{
+			"access_token": {string(accessToken)},
+			"token_type":   {"Bearer"},
+			"expires_in":   {strconv.Itoa(int(lifetime.Seconds()))},
+		}
+		return c.Redirect(session.ReturnURL+"#"+fragment.Encode(), fiber.StatusFound)
+	}
+
+	// Return to the protected service if the login started from the forward authentication
+	if len(session.ReturnURL) > 0 {
+		return c.Redirect(session.ReturnURL, fiber.StatusFound)
+	}
+
 	// Render
 	m := fiber.Map{
 		"issuerPrefix":   issuerPrefix,
@@ -804,10 +1064,10 @@ func (s *Server) VerifierPageAccessProtectedService(c *fiber.Ctx) error {
 	var errors []error
 
 	// Get the access token from the cookie
-	accessToken := c.Cookies("dbsamvf")
+	accessToken := c.Cookies(accessTokenCookie)
 
 	// Check if the user has configured a protected service to access
-	protected := s.cfg.String("verifier.protectedResource.url")
+	protected := s.conf.Verifier.ProtectedResource.URL
 	if len(protected) > 0 {
 
 		// Prepare to GET to the url
@@ -817,9 +1077,9 @@ func (s *Server) VerifierPageAccessProtectedService(c *fiber.Ctx) error {
 		agent.Set("Authorization", "Bearer "+accessToken)
 
 		agent.Set("accept", "application/json")
-		code, returnBody, errors = agent.Bytes()
+		code, returnBody, errors = tracing.Do(c.UserContext(), "verifier.protectedResource", agent)
 		if len(errors) > 0 {
-			s.logger.Errorw("error calling SSI Kit", zap.Errors("errors", errors))
+			s.log(c).Errorw("error calling SSI Kit", zap.Errors("errors", errors))
 			return fmt.Errorf("error calling SSI Kit: %v", errors[0])
 		}
 
@@ -842,74 +1102,26 @@ func (s *Server) VerifierPageAccessProtectedService(c *fiber.Ctx) error {
 // ##########################################
 // New Credential begin
 
-func (s *Server) IssuerPageNewCredentialFormDisplay(c *fiber.Ctx) error {
-
-	// Display the form to enter credential data
-	m := fiber.Map{
-		"issuerPrefix":   issuerPrefix,
-		"verifierPrefix": verifierPrefix,
-		"walletPrefix":   walletPrefix,
-		"csrftoken":      c.Locals("csrftoken"),
-		"prefix":         issuerPrefix,
-	}
-
-	return c.Render("issuer_newcredential", m)
-}
-
-type NewCredentialForm struct {
-	FirstName  string `form:"firstName,omitempty"`
-	FamilyName string `form:"familyName,omitempty"`
-	Email      string `form:"email,omitempty"`
-	Target     string `form:"target,omitempty"`
-	Roles      string `form:"roles,omitempty"`
-}
-
-func (s *Server) IssuerPageNewCredentialFormPost(c *fiber.Ctx) error {
-
-	// The user submitted the form. Get all the data
-	newCred := &NewCredentialForm{}
-	if err := c.BodyParser(newCred); err != nil {
-		return err
-	}
-
-	m := fiber.Map{}
-
-	// Display again the form if there are errors on input
-	if newCred.Email == "" || newCred.FirstName == "" || newCred.FamilyName == "" ||
-		newCred.Roles == "" || newCred.Target == "" {
-		m["Errormessage"] = "Enter all fields"
-		return c.Render("issuer_newcredential", m)
-	}
-
-	// Convert to the hierarchical map required for the template
-	claims := fiber.Map{}
-
-	claims["firstName"] = newCred.FirstName
-	claims["familyName"] = newCred.FamilyName
-	claims["email"] = newCred.Email
-
-	names := strings.Split(newCred.Roles, ",")
-	var roles []map[string]any
-	role := map[string]any{
-		"target": newCred.Target,
-		"names":  names,
-	}
-
-	roles = append(roles, role)
-	claims["roles"] = roles
+// issueWithSSIKit asks the signatory service of the SSI Kit to issue a credential with the given template
+// and claims for the holder identified by subjectDID, and stores it in the issuer vault.
+// It returns the id of the credential and the credential.
+func (s *Server) issueWithSSIKit(ctx context.Context, templateId string, subjectDID string, claims map[string]any) (string, []byte, error) {
 
 	credentialData := fiber.Map{}
 	credentialData["credentialSubject"] = claims
 
-	// credID, _, err := srv.Operations.CreateServiceCredential(claims)
-	// if err != nil {
-	// 	return err
-	// }
+	issuerVault := s.issuerVault.WithContext(ctx)
 
 	// Get the issuer DID
-	issuerDID, err := s.issuerVault.GetDIDForUser(s.cfg.String("issuer.id"))
+	issuerDID, err := issuerVault.GetDIDForUser(s.conf.Issuer.ID)
 	if err != nil {
-		return err
+		return "", nil, err
+	}
+
+	// Compute the dates of the credential according to the issuance policy
+	dates, err := issuerVault.IssuanceDates(templateId, nil)
+	if err != nil {
+		return "", nil, err
 	}
 
 	// Call the issuer of SSI Kit
@@ -917,7 +1129,7 @@ func (s *Server) IssuerPageNewCredentialFormPost(c *fiber.Ctx) error {
 
 	config := fiber.Map{
 		"issuerDid":  issuerDID,
-		"subjectDid": "did:key:z6Mkfdio1n9SKoZUtKdr9GTCZsRPbwHN8f7rbJghJRGdCt88",
+		"subjectDid": subjectDID,
 		// "verifierDid": "theVerifier",
 		// "issuerVerificationMethod": "string",
 		"proofType": "LD_PROOF",
@@ -925,63 +1137,60 @@ func (s *Server) IssuerPageNewCredentialFormPost(c *fiber.Ctx) error {
 		// "nonce":                    "string",
 		// "proofPurpose":             "string",
 		// "credentialId":             "string",
-		// "issueDate":                "2022-10-06T18:09:14.570Z",
-		// "validDate":                "2022-10-06T18:09:14.570Z",
-		// "expirationDate":           "2022-10-06T18:09:14.570Z",
+		"issueDate":      dates.IssuanceDate.Format(time.RFC3339),
+		"validDate":      dates.ValidFrom.Format(time.RFC3339),
+		"expirationDate": dates.ExpirationDate.Format(time.RFC3339),
 		// "dataProviderIdentifier":   "string",
 	}
 
 	bodyRequest := fiber.Map{
-		"templateId":     "PacketDeliveryService",
+		"templateId":     templateId,
 		"config":         config,
 		"credentialData": credentialData,
 	}
 
-	out, _ := json.MarshalIndent(bodyRequest, "", "  ")
-	fmt.Println(string(out))
+	logging.FromContext(ctx).Debugw("issuing credential with the SSI Kit", "templateId", templateId, "subjectDID", subjectDID, "claims", claims)
 
 	agent.JSON(bodyRequest)
 	agent.ContentType("application/json")
 	agent.Set("accept", "application/json")
-	_, returnBody, errors := agent.Bytes()
+	start := time.Now()
+	code, returnBody, errors := tracing.Do(ctx, "ssikit.issue", agent)
+	metrics.ObserveSSIKit("issue", start, len(errors) > 0 || code >= 400)
 	if len(errors) > 0 {
-		s.logger.Errorw("error calling SSI Kit", zap.Errors("errors", errors))
-		return fmt.Errorf("error calling SSI Kit: %v", errors[0])
+		logging.FromContext(ctx).Errorw("error calling SSI Kit", zap.Errors("errors", errors))
+		return "", nil, fmt.Errorf("error calling SSI Kit: %v", errors[0])
 	}
 
 	parsed, err := yaml.ParseJson(string(returnBody))
 	if err != nil {
-		return err
+		return "", nil, err
 	}
 
 	credentialID := parsed.String("id")
 	if len(credentialID) == 0 {
-		s.logger.Errorw("id field not found in credential")
-		return fmt.Errorf("id field not found in credential")
+		logging.FromContext(ctx).Errorw("id field not found in credential")
+		return "", nil, fmt.Errorf("id field not found in credential")
 	}
 
-	// Store credential
-	_, err = s.issuerVault.Client.Credential.Create().
+	// Store credential, with the data needed to renew it
+	_, err = issuerVault.Client.Credential.Create().
 		SetID(credentialID).
+		SetType("ldp_vc").
 		SetRaw([]uint8(returnBody)).
-		Save(context.Background())
+		SetExpiresAt(dates.ExpirationDate).
+		SetIssuanceData(map[string]any{
+			"templateId": templateId,
+			"subjectDID": subjectDID,
+			"claims":     claims,
+		}).
+		Save(ctx)
 	if err != nil {
-		s.logger.Errorw("error storing the credential", zap.Error(err))
-		return err
+		logging.FromContext(ctx).Errorw("error storing the credential", zap.Error(err))
+		return "", nil, err
 	}
 
-	str := prettyFormatJSON(returnBody)
-	fmt.Println(str)
-
-	// Render
-	m = fiber.Map{
-		"issuerPrefix":   issuerPrefix,
-		"verifierPrefix": verifierPrefix,
-		"walletPrefix":   walletPrefix,
-		"claims":         str,
-		"prefix":         issuerPrefix,
-	}
-	return c.Render("creddetails", m)
+	return credentialID, returnBody, nil
 }
 
 // New Credential end
@@ -1009,19 +1218,6 @@ func (s *Server) IssuerPageCredentialDetails(c *fiber.Ctx) error {
 	return c.Render("creddetails", m)
 }
 
-// readConfiguration reads a YAML file and creates an easy-to navigate structure
-func readConfiguration(configFile string) *yaml.YAML {
-	var cfg *yaml.YAML
-	var err error
-
-	cfg, err = yaml.ParseYamlFile(configFile)
-	if err != nil {
-		fmt.Printf("Config file not found, exiting\n")
-		panic(err)
-	}
-	return cfg
-}
-
 // ##########################################
 // ##########################################
 //              APIS
@@ -1031,20 +1227,10 @@ func readConfiguration(configFile string) *yaml.YAML {
 // DID handling
 func (srv *Server) CoreAPICreateDID(c *fiber.Ctx) error {
 
-	// body := c.Body()
-
 	// Call the SSI Kit
-	agent := fiber.Post(srv.ssiKit.custodianUrl + "/did/create")
-	bodyRequest := fiber.Map{
-		"method": "key",
-	}
-	agent.JSON(bodyRequest)
-	agent.ContentType("application/json")
-	agent.Set("accept", "application/json")
-	_, returnBody, errors := agent.Bytes()
-	if len(errors) > 0 {
-		srv.logger.Errorw("error calling SSI Kit", zap.Errors("errors", errors))
-		return fmt.Errorf("error calling SSI Kit: %v", errors[0])
+	returnBody, err := srv.createDID(c)
+	if err != nil {
+		return err
 	}
 
 	c.Set("Content-Type", "application/json")
@@ -1057,10 +1243,9 @@ func (srv *Server) CoreAPIListCredentialTemplates(c *fiber.Ctx) error {
 	// Call the SSI Kit
 	agent := fiber.Get(srv.ssiKit.signatoryUrl + "/v1/templates")
 	agent.Set("accept", "application/json")
-	_, returnBody, errors := agent.Bytes()
-	if len(errors) > 0 {
-		srv.logger.Errorw("error calling SSI Kit", zap.Errors("errors", errors))
-		return fmt.Errorf("error calling SSI Kit: %v", errors[0])
+	returnBody, err := srv.callSSIKit(c, "listTemplates", agent)
+	if err != nil {
+		return err
 	}
 
 	c.Set("Content-Type", "application/json")
@@ -1078,10 +1263,9 @@ func (srv *Server) CoreAPIGetCredentialTemplate(c *fiber.Ctx) error {
 	// Call the SSI Kit
 	agent := fiber.Get(srv.ssiKit.signatoryUrl + "/v1/templates/" + id)
 	agent.Set("accept", "application/json")
-	_, returnBody, errors := agent.Bytes()
-	if len(errors) > 0 {
-		srv.logger.Errorw("error calling SSI Kit", zap.Errors("errors", errors))
-		return fmt.Errorf("error calling SSI Kit: %v", errors[0])
+	returnBody, err := srv.callSSIKit(c, "getTemplate", agent)
+	if err != nil {
+		return err
 	}
 
 	c.Set("Content-Type", "application/json")
@@ -1089,6 +1273,25 @@ func (srv *Server) CoreAPIGetCredentialTemplate(c *fiber.Ctx) error {
 
 }
 
+// callSSIKit sends the request to the SSI Kit and returns the body of its response. The errors of the
+// SSI Kit are returned as a Bad Gateway problem.
+func (srv *Server) callSSIKit(c *fiber.Ctx, operation string, agent *fiber.Agent) ([]byte, error) {
+
+	start := time.Now()
+	code, returnBody, errors := tracing.Do(c.UserContext(), "ssikit."+operation, agent)
+	metrics.ObserveSSIKit(operation, start, len(errors) > 0 || code >= 400)
+	if len(errors) > 0 {
+		srv.log(c).Errorw("error calling SSI Kit", zap.Errors("errors", errors))
+		return nil, problem.New(fiber.StatusBadGateway, problem.SSIKitError, fmt.Sprintf("error calling SSI Kit: %v", errors[0]))
+	}
+	if code >= 400 {
+		srv.log(c).Errorw("error returned by SSI Kit", "status", code, "body", string(returnBody))
+		return nil, problem.New(fiber.StatusBadGateway, problem.SSIKitError, fmt.Sprintf("SSI Kit returned status %d", code))
+	}
+
+	return returnBody, nil
+}
+
 func prettyFormatJSON(in []byte) string {
 	decoded := &fiber.Map{}
 	json.Unmarshal(in, decoded)
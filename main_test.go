@@ -0,0 +1,63 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/hesusruiz/vcbackend/internal/credtype"
+	"github.com/hesusruiz/vcbackend/internal/holder"
+	"github.com/hesusruiz/vcbackend/vault"
+	"github.com/hesusruiz/vcutils/yaml"
+)
+
+// newTestServer creates a server with the configuration of the repository and its vaults in the
+// directory, without starting it. Several servers created in the same directory share the databases,
+// like the instances of a deployment.
+func newTestServer(t *testing.T, dir string) *Server {
+	t.Helper()
+
+	s := &Server{}
+	s.conf, s.cfg = readConfiguration(defaultConfigFile)
+	s.setupLogging()
+	s.App = fiber.New(fiber.Config{ErrorHandler: s.errorHandler})
+
+	s.issuerVault = newTestVault(t, filepath.Join(dir, "issuer.sqlite"))
+	s.verifierVault = newTestVault(t, filepath.Join(dir, "verifier.sqlite"))
+	s.walletvault = newTestVault(t, filepath.Join(dir, "wallet.sqlite"))
+	s.registryVault = newTestVault(t, filepath.Join(dir, "registry.sqlite"))
+
+	if usr, _ := s.issuerVault.UserByID(s.conf.Issuer.ID); usr == nil {
+		if _, err := s.issuerVault.CreateUserWithKey(s.conf.Issuer.ID, s.conf.Issuer.Name, "legalperson", "pass"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var err error
+	s.credTypes, err = credtype.LoadDir(s.conf.Issuer.CredentialTypesDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.holders = holder.NewResolver("")
+
+	return s
+}
+
+// newTestVault opens a vault in a database file, waiting for the locks of the other vaults using it
+func newTestVault(t *testing.T, file string) *vault.Vault {
+	t.Helper()
+
+	v, err := vault.New(yaml.New(map[string]any{
+		"store": map[string]any{
+			"driverName":     "sqlite3",
+			"autoMigrate":    true,
+			"dataSourceName": "file:" + file + "?mode=rwc&_fk=1&_busy_timeout=5000",
+		},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { v.Client.Close() })
+
+	return v
+}
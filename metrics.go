@@ -0,0 +1,87 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/hesusruiz/vcbackend/internal/metrics"
+	"github.com/valyala/fasthttp/fasthttpadaptor"
+	"go.uber.org/zap"
+)
+
+// addMetricsRoutes adds the endpoint scraped by Prometheus and the metrics of the HTTP requests
+func (s *Server) addMetricsRoutes() {
+
+	if !s.conf.Server.Metrics.Enabled {
+		return
+	}
+
+	// The SIOP sessions are counted with the nonces in the verifier vault, which is shared by the instances
+	err := metrics.RegisterActiveSessions(func() float64 {
+		active, err := s.verifierVault.ActiveNonces()
+		if err != nil {
+			s.logger.Errorw("error counting the active SIOP sessions", zap.Error(err))
+		}
+		return float64(active)
+	})
+	if err != nil {
+		s.logger.Errorw("error registering the active SIOP sessions", zap.Error(err))
+	}
+
+	s.Use(s.metricsMiddleware)
+
+	handler := fasthttpadaptor.NewFastHTTPHandler(metrics.Handler())
+	s.Get("/metrics", func(c *fiber.Ctx) error {
+		handler(c.Context())
+		return nil
+	})
+}
+
+// metricsMiddleware records the number and the duration of the HTTP requests by route group
+func (s *Server) metricsMiddleware(c *fiber.Ctx) error {
+
+	start := time.Now()
+	err := c.Next()
+
+	// The status of the response is set later by the error handler, if the handler failed
+	status := c.Response().StatusCode()
+	if err != nil {
+		status = fiber.StatusInternalServerError
+		var e *fiber.Error
+		if errors.As(err, &e) {
+			status = e.Code
+		}
+	}
+
+	group := metrics.RouteGroup(c.Path())
+	metrics.HTTPRequests.WithLabelValues(group, c.Method(), strconv.Itoa(status)).Inc()
+	metrics.HTTPRequestDuration.WithLabelValues(group, c.Method()).Observe(time.Since(start).Seconds())
+
+	return err
+}
+
+// recordIssuance records the outcome of the issuance of a credential with the template
+func recordIssuance(template string, err error) {
+	outcome := metrics.Issued
+	if err != nil {
+		outcome = metrics.Failed
+	}
+	metrics.CredentialsIssued.WithLabelValues(template, outcome).Inc()
+}
+
+// recordPendingIssuance records a credential with the template which is waiting for the approval of an operator
+func recordPendingIssuance(template string) {
+	metrics.CredentialsIssued.WithLabelValues(template, metrics.Pending).Inc()
+}
+
+// recordVerification records the outcome of the verification of a presentation. The reason is empty
+// for the accepted presentations.
+func recordVerification(reason string) {
+	outcome := metrics.Accepted
+	if len(reason) > 0 {
+		outcome = metrics.Rejected
+	}
+	metrics.PresentationsVerified.WithLabelValues(outcome, reason).Inc()
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hesusruiz/vcbackend/internal/config"
+	"github.com/hesusruiz/vcbackend/vault/migrations"
+)
+
+// store is a database of the vaults, with the keys of the configuration which use it
+type store struct {
+	keys []string
+	config.Store
+}
+
+// stores returns the distinct databases of the vaults
+func stores(conf *config.Config) []*store {
+
+	all := []struct {
+		key string
+		s   config.Store
+	}{
+		{"store", conf.Store},
+		{"issuer.store", conf.Issuer.Store},
+		{"verifier.store", conf.Verifier.Store},
+		{"verifiableregistry.store", conf.VerifiableRegistry.Store},
+		{"wallet.store", conf.Wallet.Store},
+	}
+
+	var list []*store
+	for _, a := range all {
+		found := false
+		for _, s := range list {
+			if s.DriverName == a.s.DriverName && s.DataSourceName == a.s.DataSourceName {
+				s.keys = append(s.keys, a.key)
+				found = true
+			}
+		}
+		if !found {
+			list = append(list, &store{keys: []string{a.key}, Store: a.s})
+		}
+	}
+	return list
+}
+
+// migrateCommand implements the migrate command, which applies the migrations of the schema to the
+// databases of the vaults, reverts them or prints their status
+func migrateCommand(args []string) int {
+
+	const usage = "usage: vcbackend migrate up|down|status|baseline [-config file] [-steps n] [-version version]"
+
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, usage)
+		return 2
+	}
+	command := args[0]
+	switch command {
+	case "up", "down", "status", "baseline":
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+		return 2
+	}
+
+	flags := flag.NewFlagSet("migrate "+command, flag.ContinueOnError)
+	file := flags.String("config", LookupEnvOrString("CONFIG_FILE", defaultConfigFile), "path to configuration file")
+	steps := flags.Int("steps", 1, "number of migrations reverted by down")
+	version := flags.String("version", "", "last version recorded by baseline, by default the first one")
+	if err := flags.Parse(args[1:]); err != nil {
+		return 2
+	}
+
+	conf, _, err := config.Load(*file, os.Environ())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", *file, err)
+		return 1
+	}
+
+	ctx := context.Background()
+	status := 0
+	for _, s := range stores(conf) {
+		name := fmt.Sprintf("%s (%s)", strings.Join(s.keys, ", "), s.DriverName)
+		if err := migrateStore(ctx, s, command, *steps, *version); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", name, err)
+			status = 1
+		}
+	}
+	return status
+}
+
+// migrateStore runs a command of migrate on a database
+func migrateStore(ctx context.Context, s *store, command string, steps int, version string) error {
+
+	db, err := sql.Open(s.DriverName, s.DataSourceName)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	m, err := migrations.New(db, s.DriverName)
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s (%s)", strings.Join(s.keys, ", "), s.DriverName)
+	var done []*migrations.Migration
+	switch command {
+	case "up":
+		done, err = m.Up(ctx)
+	case "down":
+		done, err = m.Down(ctx, steps)
+	case "baseline":
+		if len(version) == 0 {
+			version = m.Migrations()[0].Version
+		}
+		done, err = m.Baseline(ctx, version)
+	case "status":
+		list, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Println(name)
+		for _, st := range list {
+			applied := "pending"
+			if len(st.AppliedAt) > 0 {
+				applied = "applied at " + st.AppliedAt
+			}
+			fmt.Printf("  %s_%s\t%s\n", st.Version, st.Name, applied)
+		}
+		return m.Check(ctx)
+	}
+
+	for _, mig := range done {
+		fmt.Printf("%s: %s %s_%s\n", name, command, mig.Version, mig.Name)
+	}
+	if err == nil && len(done) == 0 {
+		fmt.Printf("%s: no changes\n", name)
+	}
+	return err
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"encoding/base64"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/basicauth"
+	"github.com/hesusruiz/vcbackend/internal/config"
+	"github.com/hesusruiz/vcbackend/internal/issuance"
+	"github.com/hesusruiz/vcbackend/internal/metrics"
+	"github.com/hesusruiz/vcbackend/internal/ratelimit"
+	"github.com/hesusruiz/vcbackend/vault"
+	"go.uber.org/zap"
+)
+
+// loginFailedKey is the local of the requests whose basic authentication failed
+const loginFailedKey = "loginFailed"
+
+// setupRateLimits creates the limiter of the requests, counting them in memory or in the verifier vault
+// so the instances of the server sharing it apply the limits together
+func (s *Server) setupRateLimits() {
+
+	if !s.conf.Server.RateLimit.Enabled {
+		return
+	}
+
+	if s.conf.Server.RateLimit.Store == "vault" {
+		s.limiter = ratelimit.New(s.verifierVault.RateCounter())
+	} else {
+		s.limiter = ratelimit.New(ratelimit.NewMemory())
+	}
+
+	// The global limits apply to all the routes
+	s.Use(s.rateLimit("global", s.conf.Server.RateLimit.Global))
+}
+
+// rateLimit limits the requests to the routes of a group, by IP address and by client. The client is the
+// user authenticated before the limit, or the relying party registered with the client_id parameter of the
+// request. When the store of the counts fails, the requests are allowed.
+func (s *Server) rateLimit(group string, limits config.RateLimitGroup) fiber.Handler {
+
+	if s.limiter == nil || (limits.PerIP <= 0 && limits.PerClient <= 0) {
+		return func(c *fiber.Ctx) error {
+			return c.Next()
+		}
+	}
+
+	window, _ := issuance.ParseDuration(limits.Window)
+	perIP := ratelimit.Rule{Limit: limits.PerIP, Window: window}
+	perClient := ratelimit.Rule{Limit: limits.PerClient, Window: window}
+
+	return func(c *fiber.Ctx) error {
+
+		ctx := c.UserContext()
+
+		decision, err := s.limiter.Allow(ctx, "ip:"+group+":"+c.IP(), perIP)
+		if err != nil {
+			s.log(c).Errorw("error counting the requests", "group", group, zap.Error(err))
+			return c.Next()
+		}
+
+		if client := s.clientID(c, perClient); len(client) > 0 {
+			byClient, err := s.limiter.Allow(ctx, "client:"+group+":"+client, perClient)
+			if err != nil {
+				s.log(c).Errorw("error counting the requests", "group", group, zap.Error(err))
+				return c.Next()
+			}
+			// The headers describe the limit which is closest to be exceeded
+			if !byClient.Allowed || decision.Limit == 0 || (decision.Allowed && byClient.Remaining < decision.Remaining) {
+				decision = byClient
+			}
+		}
+
+		if decision.Limit == 0 {
+			return c.Next()
+		}
+		c.Set("X-RateLimit-Limit", strconv.Itoa(decision.Limit))
+		c.Set("X-RateLimit-Remaining", strconv.Itoa(decision.Remaining))
+		c.Set("X-RateLimit-Reset", strconv.FormatInt(decision.Reset.Unix(), 10))
+
+		if !decision.Allowed {
+			return s.rateLimited(c, group, decision)
+		}
+		return c.Next()
+	}
+}
+
+// rateLimited rejects a request over the limits, telling the client when it can try again
+func (s *Server) rateLimited(c *fiber.Ctx, group string, decision *ratelimit.Decision) error {
+	metrics.RateLimited.WithLabelValues(group).Inc()
+	s.log(c).Infow("request rate limited", "group", group, "ip", c.IP())
+
+	retry := decision.RetryAfter(time.Now())
+	c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(retry.Seconds()))))
+	if group == "login" {
+		return fiber.NewError(fiber.StatusTooManyRequests, "too many failed logins, try again later")
+	}
+	return fiber.NewError(fiber.StatusTooManyRequests, "too many requests, try again later")
+}
+
+// clientID returns the client of a request to count its requests with the rule: the user authenticated in
+// the request, or the relying party registered with the client_id parameter. The clients stated by the other
+// requests are not verified, so they are limited only by their IP address and can not use the limits of others.
+func (s *Server) clientID(c *fiber.Ctx, rule ratelimit.Rule) string {
+
+	if rule.Limit <= 0 {
+		return ""
+	}
+	if user, _ := c.Locals("username").(string); len(user) > 0 {
+		return "user:" + user
+	}
+
+	id := c.Query("client_id", c.FormValue("client_id"))
+	if len(id) == 0 {
+		return ""
+	}
+	if _, err := s.verifierVault.WithContext(c.UserContext()).RelyingParty(id); err != nil {
+		return ""
+	}
+	return "rp:" + id
+}
+
+// basicUser returns the user in the basic authentication header of a request, if there is one
+func basicUser(c *fiber.Ctx) (string, bool) {
+	auth := c.Get(fiber.HeaderAuthorization)
+	if len(auth) <= 6 || !strings.EqualFold(auth[:6], "basic ") {
+		return "", false
+	}
+	raw, err := base64.StdEncoding.DecodeString(auth[6:])
+	if err != nil {
+		return "", false
+	}
+	user, _, ok := strings.Cut(string(raw), ":")
+	return user, ok
+}
+
+// basicAuth authenticates with basic authentication, or with a client certificate registered in the vault
+// if the clients can authenticate with mutual TLS
+func (s *Server) basicAuth(v *vault.Vault, cfg basicauth.Config) fiber.Handler {
+	return s.clientCertAuth(v, cfg, nil, s.passwordAuth(cfg))
+}
+
+// passwordAuth authenticates with basic authentication, locking out a user from an address after
+// repeated failed logins
+func (s *Server) passwordAuth(cfg basicauth.Config) fiber.Handler {
+
+	unauthorized := cfg.Unauthorized
+	cfg.Unauthorized = func(c *fiber.Ctx) error {
+		c.Locals(loginFailedKey, true)
+		if unauthorized != nil {
+			return unauthorized(c)
+		}
+		realm := cfg.Realm
+		if len(realm) == 0 {
+			realm = "Restricted"
+		}
+		c.Set(fiber.HeaderWWWAuthenticate, "basic realm="+realm)
+		return c.SendStatus(fiber.StatusUnauthorized)
+	}
+	auth := basicauth.New(cfg)
+
+	login := s.conf.Server.RateLimit.Login
+	if s.limiter == nil || login.MaxFailures <= 0 {
+		return auth
+	}
+	lockout, _ := issuance.ParseDuration(login.Lockout)
+	rule := ratelimit.Rule{Limit: login.MaxFailures, Window: lockout}
+
+	return func(c *fiber.Ctx) error {
+
+		user, ok := basicUser(c)
+		if !ok {
+			return auth(c)
+		}
+
+		ctx := c.UserContext()
+		key := "login:" + user + ":" + c.IP()
+
+		decision, err := s.limiter.Peek(ctx, key, rule)
+		if err != nil {
+			s.log(c).Errorw("error reading the failed logins", zap.Error(err))
+			return auth(c)
+		}
+		if !decision.Allowed {
+			return s.rateLimited(c, "login", decision)
+		}
+
+		err = auth(c)
+
+		if failed, _ := c.Locals(loginFailedKey).(bool); failed {
+			if err := s.limiter.Fail(ctx, key, rule); err != nil {
+				s.log(c).Errorw("error counting a failed login", zap.Error(err))
+			}
+			s.log(c).Infow("failed login", "user", user, "ip", c.IP())
+		} else if decision.Remaining < decision.Limit {
+			// A successful login forgets the previous failures
+			if err := s.limiter.Clear(ctx, key, rule); err != nil {
+				s.log(c).Errorw("error clearing the failed logins", zap.Error(err))
+			}
+		}
+		return err
+	}
+}
+
+// bodyLimit rejects the requests with a body larger than the limit, which is lower for some
+// routes than the limit of the server
+func bodyLimit(limit int) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if c.Request().Header.ContentLength() > limit || len(c.Body()) > limit {
+			return fiber.NewError(fiber.StatusRequestEntityTooLarge, "the body of the request is larger than "+strconv.Itoa(limit)+" bytes")
+		}
+		return c.Next()
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/hesusruiz/vcbackend/internal/config"
+	"github.com/hesusruiz/vcbackend/internal/ratelimit"
+	"github.com/hesusruiz/vcbackend/internal/rp"
+)
+
+// TestRateLimitClients checks that only the authenticated users and the registered relying parties are
+// limited as clients, so a request can not use the limit of another client
+func TestRateLimitClients(t *testing.T) {
+	s := newTestServer(t, t.TempDir())
+	s.limiter = ratelimit.New(ratelimit.NewMemory())
+
+	if err := s.verifierVault.CreateRelyingParty(&rp.Client{ID: "orders", RedirectURIs: []string{"https://orders.example.com/callback"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	limit := s.rateLimit("test", config.RateLimitGroup{PerIP: 100, PerClient: 1, Window: "1m"})
+	authenticated := func(c *fiber.Ctx) error {
+		if user := c.Query("user"); len(user) > 0 {
+			c.Locals("username", user)
+		}
+		return c.Next()
+	}
+	s.Get("/limited", authenticated, limit, func(c *fiber.Ctx) error {
+		return c.SendStatus(fiber.StatusOK)
+	})
+
+	get := func(query string, basicUser string) int {
+		req := httptest.NewRequest("GET", "/limited?"+query, nil)
+		if len(basicUser) > 0 {
+			req.Header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(basicUser+":wrong")))
+		}
+		resp, err := s.App.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.StatusCode
+	}
+
+	tests := []struct {
+		name      string
+		query     string
+		basicUser string
+		second    int
+	}{
+		{"unregistered client", "client_id=unknown", "", http.StatusOK},
+		{"unverified user", "", "admin", http.StatusOK},
+		{"registered client", "client_id=orders", "", http.StatusTooManyRequests},
+		{"authenticated user", "user=operator1", "", http.StatusTooManyRequests},
+	}
+	for _, tt := range tests {
+		if status := get(tt.query, tt.basicUser); status != http.StatusOK {
+			t.Errorf("%s: first request status = %d, want %d", tt.name, status, http.StatusOK)
+		}
+		if status := get(tt.query, tt.basicUser); status != tt.second {
+			t.Errorf("%s: second request status = %d, want %d", tt.name, status, tt.second)
+		}
+	}
+}
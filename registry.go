@@ -0,0 +1,347 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/basicauth"
+	"github.com/hesusruiz/vcbackend/ent/credential"
+	"github.com/hesusruiz/vcbackend/internal/jwt"
+	"github.com/hesusruiz/vcbackend/internal/logging"
+	"github.com/hesusruiz/vcbackend/internal/metrics"
+	"github.com/hesusruiz/vcbackend/internal/problem"
+	"github.com/hesusruiz/vcbackend/internal/til"
+	"github.com/hesusruiz/vcbackend/internal/tracing"
+	"github.com/hesusruiz/vcbackend/internal/vc"
+	"github.com/hesusruiz/vcbackend/vault"
+	"github.com/hesusruiz/vcutils/yaml"
+	"go.uber.org/zap"
+)
+
+// ##########################################
+// ##########################################
+// Trusted Issuers List, stored in the verifiable registry
+
+func (s *Server) addRegistryRoutes(registryRoutes fiber.Router) {
+
+	// Management of the list, compatible with the i4Trust Trusted Issuers List API
+	auth := s.basicAuth(s.registryVault, basicauth.Config{
+		Realm: "Registry",
+		Users: map[string]string{"admin": s.conf.VerifiableRegistry.Password},
+	})
+	registryRoutes.Post("/issuer", auth, s.RegistryAPICreateIssuer)
+	registryRoutes.Get("/issuer/:did", auth, s.RegistryAPIGetIssuer)
+	registryRoutes.Put("/issuer/:did", auth, s.RegistryAPIUpdateIssuer)
+	registryRoutes.Delete("/issuer/:did", auth, s.RegistryAPIDeleteIssuer)
+
+	// Public read access, compatible with the EBSI Trusted Issuers Registry API
+	registryRoutes.Get("/v4/issuers", s.RegistryAPIListIssuers)
+	registryRoutes.Get("/v4/issuers/:did", s.RegistryAPIGetIssuerAttributes)
+
+}
+
+// trustLocalIssuer registers the issuer of this server in the Trusted Issuers List, for all the declared
+// credential types, unless it is already registered. The issuer is registered with the DIDs which appear
+// as issuer of its credentials: the DID of its signing key in the credentials signed by the vault, and
+// the DID created by the SSI Kit in the credentials signed by the SSI Kit.
+func (s *Server) trustLocalIssuer() {
+
+	signingDID, err := s.issuerVault.SigningDID(s.conf.Issuer.ID)
+	if err != nil {
+		s.logger.Errorw("error getting the DID of the signing key of the issuer", zap.Error(err))
+	}
+
+	for _, did := range []string{signingDID, s.issuerDID} {
+
+		if len(did) == 0 {
+			continue
+		}
+		if _, err := s.registryVault.TrustedIssuer(did); !errors.Is(err, til.ErrNotFound) {
+			continue
+		}
+
+		ti := &til.TrustedIssuer{DID: did}
+		for _, def := range s.credTypes.All() {
+			ti.Credentials = append(ti.Credentials, til.Credential{CredentialsType: def.Name})
+		}
+
+		if err := s.registryVault.CreateTrustedIssuer(ti); err != nil {
+			s.logger.Errorw("error registering the issuer as trusted", "did", did, zap.Error(err))
+		}
+	}
+}
+
+// verifyIssuer checks the signature of a presented credential with the key of its issuer, that it was not
+// revoked if it was issued by this issuer, and then in the Trusted Issuers List that the issuer is trusted
+// to issue it. The signature and the revocation are always checked, and the list only when the verifier
+// is configured to use it.
+func (s *Server) verifyIssuer(ctx context.Context, raw []byte) error {
+
+	// The issuer in the credential can be trusted only if the credential was signed by it
+	if err := s.verifyCredentialSignature(ctx, raw); err != nil {
+		logging.FromContext(ctx).Infow("credential rejected with an invalid signature", zap.Error(err))
+		return problem.New(fiber.StatusBadRequest, problem.CredentialSignature, err.Error())
+	}
+
+	if err := s.checkRevocation(ctx, raw); err != nil {
+		logging.FromContext(ctx).Infow("credential rejected", zap.Error(err))
+		return err
+	}
+
+	if s.trustedIssuers == nil {
+		return nil
+	}
+
+	p, err := til.Verify(s.trustedIssuers, raw, time.Now())
+	if err != nil {
+		logging.FromContext(ctx).Infow("credential rejected by the trusted issuers list", zap.Error(err))
+		return registryError(err)
+	}
+
+	logging.FromContext(ctx).Infow("issuer found in the trusted issuers list", "did", p.Issuer, "types", p.Types)
+	return nil
+}
+
+// checkRevocation rejects a credential revoked by this issuer. The credentials are found in the vault of
+// the issuer by their id, and the ones issued by others are not checked.
+func (s *Server) checkRevocation(ctx context.Context, raw []byte) error {
+
+	cred, err := vc.Decode(raw)
+	if err != nil {
+		return problem.New(fiber.StatusBadRequest, problem.InvalidRequest, err.Error())
+	}
+	if len(cred.ID()) == 0 {
+		return nil
+	}
+
+	issued, err := s.issuerVault.WithContext(ctx).CredentialByID(cred.ID())
+	if errors.Is(err, vault.ErrCredentialNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if issued.Status == credential.StatusRevoked {
+		return problem.New(fiber.StatusBadRequest, problem.CredentialRevoked, vault.ErrCredentialRevoked.Error())
+	}
+	return nil
+}
+
+// verifyCredentialSignature checks the signature of a credential with the key of its issuer. A JWT must
+// be signed with the key of the DID in its iss claim, and a JSON-LD credential is verified by the auditor
+// of the SSI Kit.
+func (s *Server) verifyCredentialSignature(ctx context.Context, raw []byte) error {
+
+	serialized := strings.TrimSpace(string(raw))
+	if strings.HasPrefix(serialized, "{") {
+		return s.verifyWithSSIKit(ctx, serialized)
+	}
+
+	claims := jwt.MapClaims{}
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{"ES256", "ES384", "EdDSA", "RS256"}), jwt.WithoutClaimsValidation())
+	_, err := parser.ParseWithClaims(serialized, claims, func(t *jwt.Token) (interface{}, error) {
+		iss, _ := claims["iss"].(string)
+		if !strings.HasPrefix(iss, "did:") {
+			return nil, fmt.Errorf("the issuer %q is not a DID", iss)
+		}
+		return s.holders.VerificationKey(iss)
+	})
+	return err
+}
+
+// verifyWithSSIKit checks the signature of a JSON-LD credential with the auditor of the SSI Kit
+func (s *Server) verifyWithSSIKit(ctx context.Context, credential string) error {
+
+	agent := fiber.Post(s.ssiKit.auditorUrl + "/v1/verify")
+	agent.JSON(fiber.Map{
+		"policies":    []fiber.Map{{"policy": "SignaturePolicy"}},
+		"credentials": []json.RawMessage{json.RawMessage(credential)},
+	})
+	agent.ContentType("application/json")
+	agent.Set("accept", "application/json")
+
+	start := time.Now()
+	code, returnBody, errs := tracing.Do(ctx, "ssikit.verify", agent)
+	metrics.ObserveSSIKit("verify", start, len(errs) > 0 || code >= 400)
+	if len(errs) > 0 {
+		return fmt.Errorf("error calling SSI Kit: %v", errs[0])
+	}
+	if code >= 400 {
+		return fmt.Errorf("SSI Kit returned status %d", code)
+	}
+
+	result, err := yaml.ParseJson(string(returnBody))
+	if err != nil {
+		return err
+	}
+	if !result.Bool("valid") {
+		return errors.New("the signature was not verified by the SSI Kit")
+	}
+	return nil
+}
+
+// registryError converts the errors of the Trusted Issuers List to HTTP errors
+func registryError(err error) error {
+	switch {
+	case errors.Is(err, til.ErrNotFound):
+		return problem.New(fiber.StatusNotFound, problem.IssuerNotFound, err.Error())
+	case errors.Is(err, vault.ErrTrustedIssuerExists):
+		return problem.New(fiber.StatusConflict, problem.IssuerExists, err.Error())
+	case errors.Is(err, til.ErrInvalidIssuer):
+		return problem.New(fiber.StatusBadRequest, problem.IssuerInvalid, err.Error())
+	case errors.Is(err, til.ErrInvalidPayload):
+		return problem.New(fiber.StatusBadRequest, problem.CredentialInvalid, err.Error())
+	case errors.Is(err, til.ErrNotTrusted):
+		return problem.New(fiber.StatusForbidden, problem.IssuerNotTrusted, err.Error())
+	default:
+		return err
+	}
+}
+
+// trustedIssuerBody parses the trusted issuer in the body of the request
+func trustedIssuerBody(c *fiber.Ctx) (*til.TrustedIssuer, error) {
+	ti := &til.TrustedIssuer{}
+	if err := c.BodyParser(ti); err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	return ti, nil
+}
+
+// didParam returns the DID in the path of the request, which may be URL-encoded
+func didParam(c *fiber.Ctx) string {
+	did, err := url.PathUnescape(c.Params("did"))
+	if err != nil {
+		return c.Params("did")
+	}
+	return did
+}
+
+// RegistryAPICreateIssuer adds an issuer to the Trusted Issuers List
+func (s *Server) RegistryAPICreateIssuer(c *fiber.Ctx) error {
+
+	ti, err := trustedIssuerBody(c)
+	if err != nil {
+		return err
+	}
+
+	if err := s.registryVault.CreateTrustedIssuer(ti); err != nil {
+		return registryError(err)
+	}
+
+	c.Location(s.baseURL(c) + registryPrefix + "/issuer/" + url.PathEscape(ti.DID))
+	return c.SendStatus(fiber.StatusCreated)
+}
+
+// RegistryAPIGetIssuer returns an issuer of the Trusted Issuers List with its credentials
+func (s *Server) RegistryAPIGetIssuer(c *fiber.Ctx) error {
+
+	ti, err := s.registryVault.TrustedIssuer(didParam(c))
+	if err != nil {
+		return registryError(err)
+	}
+
+	return c.JSON(ti)
+}
+
+// RegistryAPIUpdateIssuer replaces the credentials of an issuer in the Trusted Issuers List
+func (s *Server) RegistryAPIUpdateIssuer(c *fiber.Ctx) error {
+
+	ti, err := trustedIssuerBody(c)
+	if err != nil {
+		return err
+	}
+
+	did := didParam(c)
+	if len(ti.DID) == 0 {
+		ti.DID = did
+	}
+	if ti.DID != did {
+		return fiber.NewError(fiber.StatusBadRequest, "the did in the body does not match the path")
+	}
+
+	if err := s.registryVault.UpdateTrustedIssuer(ti); err != nil {
+		return registryError(err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// RegistryAPIDeleteIssuer removes an issuer from the Trusted Issuers List
+func (s *Server) RegistryAPIDeleteIssuer(c *fiber.Ctx) error {
+
+	if err := s.registryVault.DeleteTrustedIssuer(didParam(c)); err != nil {
+		return registryError(err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// RegistryAPIListIssuers returns a page of the DIDs of the trusted issuers. The next page starts
+// after the last DID of the previous one, in the pageAfter parameter.
+func (s *Server) RegistryAPIListIssuers(c *fiber.Ctx) error {
+
+	pageSize := vault.DefaultPageSize
+	if value := c.Query("pageSize"); len(value) > 0 {
+		n, err := strconv.Atoi(value)
+		if err != nil || n <= 0 {
+			return fiber.NewError(fiber.StatusBadRequest, "pageSize must be a positive number")
+		}
+		pageSize = n
+	}
+	if pageSize > vault.MaxPageSize {
+		pageSize = vault.MaxPageSize
+	}
+
+	dids, total, err := s.registryVault.ListTrustedIssuers(c.Query("pageAfter"), pageSize)
+	if err != nil {
+		return err
+	}
+
+	base := s.baseURL(c) + registryPrefix + "/v4/issuers"
+	items := make([]fiber.Map, 0, len(dids))
+	for _, did := range dids {
+		items = append(items, fiber.Map{
+			"did":  did,
+			"href": base + "/" + url.PathEscape(did),
+		})
+	}
+
+	links := fiber.Map{
+		"first": base + "?pageSize=" + strconv.Itoa(pageSize),
+	}
+	if len(dids) == pageSize {
+		links["next"] = base + "?" + url.Values{
+			"pageSize":  {strconv.Itoa(pageSize)},
+			"pageAfter": {dids[len(dids)-1]},
+		}.Encode()
+	}
+
+	return c.JSON(fiber.Map{
+		"self":     s.baseURL(c) + c.OriginalURL(),
+		"items":    items,
+		"total":    total,
+		"pageSize": pageSize,
+		"links":    links,
+	})
+}
+
+// RegistryAPIGetIssuerAttributes returns an issuer with its credentials encoded as attributes
+func (s *Server) RegistryAPIGetIssuerAttributes(c *fiber.Ctx) error {
+
+	ti, err := s.registryVault.TrustedIssuer(didParam(c))
+	if err != nil {
+		return registryError(err)
+	}
+
+	return c.JSON(fiber.Map{
+		"did":        ti.DID,
+		"attributes": ti.Attributes(),
+	})
+}
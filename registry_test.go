@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hesusruiz/vcbackend/internal/jwt"
+	"github.com/hesusruiz/vcbackend/internal/problem"
+	"github.com/hesusruiz/vcbackend/internal/vc"
+)
+
+// issueTestCredential issues with the vault of the issuer a credential for a holder
+func issueTestCredential(t *testing.T, s *Server) string {
+	t.Helper()
+
+	_, raw, err := s.issuerVault.CreateCredentialJWTFromMap(map[string]any{
+		"credName":   "PacketDeliveryCredential",
+		"issuerDID":  s.conf.Issuer.ID,
+		"subjectDID": "did:key:holder",
+		"claims": map[string]any{
+			"given_name":  "John",
+			"family_name": "Doe",
+			"email":       "john@example.com",
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateCredentialJWTFromMap() error = %v", err)
+	}
+	return string(raw)
+}
+
+func TestVerifyIssuerLocal(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t, t.TempDir())
+	s.trustedIssuers = s.registryVault
+	s.trustLocalIssuer()
+
+	// The credentials of the local issuer are signed by the DID registered as trusted
+	raw := issueTestCredential(t, s)
+	decoded, err := vc.Decode([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	signingDID, _ := s.issuerVault.SigningDID(s.conf.Issuer.ID)
+	if decoded.Issuer() != signingDID {
+		t.Errorf("issuer of the credential = %s, want %s", decoded.Issuer(), signingDID)
+	}
+	if err := s.verifyIssuer(ctx, []byte(raw)); err != nil {
+		t.Fatalf("verifyIssuer() of a local credential error = %v", err)
+	}
+
+	// A credential claiming to be issued by the local issuer, signed with another key
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged, err := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims(decoded.Claims)).SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var p *problem.Error
+	if err := s.verifyIssuer(ctx, []byte(forged)); !errors.As(err, &p) || p.Code != problem.CredentialSignature {
+		t.Errorf("verifyIssuer() of a forged credential error = %v", err)
+	}
+
+	// The issuer must be a DID, to verify the signature with its key
+	decoded.Claims["iss"] = s.conf.Issuer.ID
+	unverifiable, _ := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims(decoded.Claims)).SignedString(key)
+	if err := s.verifyIssuer(ctx, []byte(unverifiable)); !errors.As(err, &p) || p.Code != problem.CredentialSignature {
+		t.Errorf("verifyIssuer() of a credential without an issuer DID error = %v", err)
+	}
+}
+
+// TestVerifyIssuerWithoutList checks that the signature of the credentials is verified when the
+// verifier does not use the Trusted Issuers List
+func TestVerifyIssuerWithoutList(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t, t.TempDir())
+
+	raw := issueTestCredential(t, s)
+	if err := s.verifyIssuer(ctx, []byte(raw)); err != nil {
+		t.Fatalf("verifyIssuer() of a local credential error = %v", err)
+	}
+
+	decoded, err := vc.Decode([]byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged, err := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims(decoded.Claims)).SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := strings.Split(raw, ".")
+	unsigned := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`)) + "." + parts[1] + "."
+
+	for name, cred := range map[string]string{"forged": forged, "unsigned": unsigned} {
+		var p *problem.Error
+		if err := s.verifyIssuer(ctx, []byte(cred)); !errors.As(err, &p) || p.Code != problem.CredentialSignature {
+			t.Errorf("verifyIssuer() of the %s credential error = %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/basicauth"
+	"github.com/hesusruiz/vcbackend/ent"
+	"github.com/hesusruiz/vcbackend/internal/issuance"
+	"github.com/hesusruiz/vcbackend/internal/tlsconfig"
+	"github.com/hesusruiz/vcbackend/vault"
+	"go.uber.org/zap"
+)
+
+// serve serves the requests in the address, with TLS if configured. The certificates are reloaded until the context is done.
+func (s *Server) serve(ctx context.Context, address string) error {
+
+	if !s.conf.Server.TLS.Enabled {
+		return s.Listen(address)
+	}
+
+	certs, err := tlsconfig.Load(tlsconfig.Files{
+		CertFile:     s.conf.Server.TLS.CertFile,
+		KeyFile:      s.conf.Server.TLS.KeyFile,
+		ClientCAFile: s.conf.Server.TLS.ClientCAFile,
+	})
+	if err != nil {
+		return err
+	}
+	s.logger.Infow("TLS certificate loaded", "certFile", s.conf.Server.TLS.CertFile, "clientAuth", s.conf.Server.TLS.ClientAuth)
+
+	// The certificates are read again when their files change, like when they are renewed, until the server stops
+	interval, err := issuance.ParseDuration(s.conf.Server.TLS.ReloadInterval)
+	if err == nil && interval > 0 {
+		s.background.Add(1)
+		go func() {
+			defer s.background.Done()
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+				reloaded, err := certs.Reload()
+				if err != nil {
+					s.logger.Errorw("invalid TLS certificate, keeping the previous one", zap.Error(err))
+				} else if reloaded {
+					s.logger.Infow("TLS certificate reloaded", "certFile", s.conf.Server.TLS.CertFile)
+				}
+			}
+		}()
+	}
+
+	ln, err := tls.Listen(s.Config().Network, address, certs.Config())
+	if err != nil {
+		return err
+	}
+	return s.Listener(ln)
+}
+
+// baseURL returns the external URL of the server, used in the QR codes, the redirect_uri of the SIOP
+// requests and the links of the API. It is the configured one, or the one of the request, which uses
+// the X-Forwarded-* headers only if they are sent by a trusted proxy.
+func (s *Server) baseURL(c *fiber.Ctx) string {
+	if len(s.conf.Server.ExternalURL) > 0 {
+		return strings.TrimSuffix(s.conf.Server.ExternalURL, "/")
+	}
+	return c.BaseURL()
+}
+
+// clientCertificate returns the certificate of the client of the request, verified with the
+// certificate authorities of the clients, if the client presented one
+func clientCertificate(c *fiber.Ctx) *x509.Certificate {
+	state := c.Context().TLSConnectionState()
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.PeerCertificates) == 0 {
+		return nil
+	}
+	return state.PeerCertificates[0]
+}
+
+// clientCertAuth authenticates the clients with a certificate registered in the vault for one of the
+// users of the configuration, or for a user of the vault if the configuration has no users. If allowed
+// is not nil, only the users of the vault accepted by it are authenticated. The other requests are
+// authenticated by the next handler with their password, unless the certificates are required.
+func (s *Server) clientCertAuth(v *vault.Vault, cfg basicauth.Config, allowed func(*ent.User) bool, password fiber.Handler) fiber.Handler {
+
+	mode := s.conf.Server.TLS.ClientAuth
+	if !s.conf.Server.TLS.Enabled || (mode != "optional" && mode != "required") {
+		return password
+	}
+
+	unauthorized := cfg.Unauthorized
+	if unauthorized == nil {
+		unauthorized = func(c *fiber.Ctx) error {
+			return fiber.ErrUnauthorized
+		}
+	}
+	contextUsername := cfg.ContextUsername
+	if len(contextUsername) == 0 {
+		contextUsername = basicauth.ConfigDefault.ContextUsername
+	}
+
+	return func(c *fiber.Ctx) error {
+
+		cert := clientCertificate(c)
+		if cert == nil {
+			if mode == "required" {
+				return unauthorized(c)
+			}
+			return password(c)
+		}
+
+		fingerprint := tlsconfig.Fingerprint(cert)
+		vc := v.WithContext(c.UserContext())
+		user, err := vc.ClientCertificateUser(fingerprint)
+		if err == nil {
+			if cfg.Users != nil {
+				if _, ok := cfg.Users[user]; !ok {
+					err = errors.New("the user of the certificate can not use the route")
+				}
+			} else if usr, _ := vc.UserByID(user); usr == nil {
+				err = errors.New("the user of the certificate is not in the vault")
+			} else if allowed != nil && !allowed(usr) {
+				err = errors.New("the user of the certificate can not use the route")
+			}
+		}
+		if err != nil {
+			s.log(c).Infow("client certificate rejected", "fingerprint", fingerprint, "subject", cert.Subject.String(), zap.Error(err))
+			if mode == "required" {
+				return unauthorized(c)
+			}
+			return password(c)
+		}
+
+		c.Locals(contextUsername, user)
+		return c.Next()
+	}
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"github.com/hesusruiz/vcbackend/internal/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// stateAttribute is the attribute of the spans with the state of a SIOP login, which identifies
+// the requests of the same login in the verifier, the wallet and the relying party
+const stateAttribute = attribute.Key("siop.state")
+
+// setupTracing configures the export of the spans, returning the function which flushes them when the server stops
+func (s *Server) setupTracing() func() {
+
+	conf := s.conf.Server.Tracing
+	shutdown, err := tracing.Setup(context.Background(), tracing.Options{
+		Exporter:    conf.Exporter,
+		Endpoint:    conf.Endpoint,
+		Insecure:    conf.Insecure,
+		ServiceName: conf.ServiceName,
+		SampleRatio: conf.SampleRatio,
+	})
+	if err != nil {
+		panic(err)
+	}
+	s.logger.Infow("tracing configured", "exporter", conf.Exporter)
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdown(ctx); err != nil {
+			s.logger.Errorw("error flushing the spans", zap.Error(err))
+		}
+	}
+}
+
+// tracingMiddleware creates a span for each request, continuing the trace of the caller if the request has
+// a trace context. The handlers get the context of the span with c.UserContext().
+func (s *Server) tracingMiddleware(c *fiber.Ctx) error {
+
+	ctx := otel.GetTextMapPropagator().Extract(c.UserContext(), tracing.RequestHeaderCarrier{Header: &c.Request().Header})
+	ctx, span := tracing.Tracer().Start(ctx, c.Method()+" "+c.Path(),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			semconv.HTTPMethod(c.Method()),
+			semconv.HTTPTarget(c.OriginalURL()),
+		),
+	)
+	defer span.End()
+
+	c.SetUserContext(ctx)
+	err := c.Next()
+
+	// The route is known only after the request has been handled
+	route := c.Route().Path
+	span.SetName(c.Method() + " " + route)
+	span.SetAttributes(semconv.HTTPRoute(route))
+
+	// The state of the login is in the path or in the query, depending on the step of the flow
+	state := c.Params("state")
+	if len(state) == 0 {
+		state = c.Query("state")
+	}
+	if len(state) > 0 {
+		span.SetAttributes(stateAttribute.String(state))
+	}
+
+	// The status of the response is set later by the error handler, if the handler failed
+	status := c.Response().StatusCode()
+	if err != nil {
+		status = fiber.StatusInternalServerError
+		var e *fiber.Error
+		if errors.As(err, &e) {
+			status = e.Code
+		}
+		span.RecordError(err)
+	}
+	span.SetAttributes(semconv.HTTPStatusCode(status))
+	if status >= 500 {
+		span.SetStatus(codes.Error, utils.StatusMessage(status))
+	}
+
+	return err
+}
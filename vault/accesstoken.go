@@ -2,15 +2,33 @@ package vault
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
-	"github.com/hesusruiz/vcutils/yaml"
-	zlog "github.com/rs/zerolog/log"
+	"github.com/google/uuid"
+	"github.com/hesusruiz/vcbackend/internal/jwt"
+	"github.com/hesusruiz/vcbackend/internal/vc"
+	"go.uber.org/zap"
 )
 
-// CreateAccessToken creates a JWT access token from the credential in serialized form,
-// signed with the first private key associated to the issuer DID
-func (v *Vault) CreateAccessToken(credData string, issuerDID string) (json.RawMessage, error) {
+var (
+	ErrAccessTokenInvalid  = errors.New("invalid access token")
+	ErrAccessTokenExpired  = errors.New("the access token has expired")
+	ErrAccessTokenAudience = errors.New("the access token is not for this audience")
+	ErrSigningAlg          = errors.New("the issuer has no key for the signing algorithm")
+)
+
+// CreateAccessToken creates a JWT access token from the credential in serialized form, JSON or a JWT,
+// signed with the first private key associated to the issuer DID.
+// The token is valid for the audience during the given lifetime.
+func (v *Vault) CreateAccessToken(credData string, issuerDID string, audience string, lifetime time.Duration) (json.RawMessage, error) {
+	return v.CreateAccessTokenWithAlg(credData, issuerDID, audience, lifetime, "")
+}
+
+// CreateAccessTokenWithAlg creates the access token like CreateAccessToken, signed with the first
+// private key of the issuer for the algorithm, or with the first key when the algorithm is empty.
+func (v *Vault) CreateAccessTokenWithAlg(credData string, issuerDID string, audience string, lifetime time.Duration, alg string) (json.RawMessage, error) {
 
 	// Return error if the issuerDID does not exist
 	iss, err := v.UserByID(issuerDID)
@@ -27,17 +45,40 @@ func (v *Vault) CreateAccessToken(credData string, issuerDID string) (json.RawMe
 		return nil, err
 	}
 
-	// At this point, jwks has at least one key, get the first one
+	// At this point, jwks has at least one key, get the first one for the algorithm
 	privateJWK := jwks[0]
+	if len(alg) > 0 {
+		privateJWK = nil
+		for _, k := range jwks {
+			if k.GetAlg() == alg {
+				privateJWK = k
+				break
+			}
+		}
+		if privateJWK == nil {
+			return nil, fmt.Errorf("%w: %s", ErrSigningAlg, alg)
+		}
+	}
 
-	// Parse the serialized credential into a struct
-	data, err := yaml.ParseJson(credData)
+	// Decode the credential, either JSON or a JWT like the ones issued by the vault
+	cred, err := vc.Decode([]byte(credData))
 	if err != nil {
-		zlog.Logger.Error().Err(err).Send()
+		v.logger().Errorw("invalid credential data", zap.Error(err))
 		return nil, err
 	}
 
-	jwt := map[string]any{"verifiableCredential": data.Data()}
+	now := time.Now()
+	jwt := map[string]any{
+		"jti":                  uuid.NewString(),
+		"iss":                  issuerDID,
+		"aud":                  audience,
+		"iat":                  now.Unix(),
+		"exp":                  now.Add(lifetime).Unix(),
+		"verifiableCredential": cred.VC,
+	}
+	if sub := cred.SubjectID(); len(sub) > 0 {
+		jwt["sub"] = sub
+	}
 
 	// Sign the credential data with the private key
 	signedString, err := v.SignWithJWK(privateJWK, jwt)
@@ -47,10 +88,64 @@ func (v *Vault) CreateAccessToken(credData string, issuerDID string) (json.RawMe
 
 	_, err = v.CredentialFromJWT(signedString)
 	if err != nil {
-		zlog.Logger.Error().Err(err).Send()
+		v.logger().Errorw("invalid signed access token", zap.Error(err))
 		return nil, err
 	}
 
 	return []byte(signedString), nil
 
 }
+
+// VerifyAccessToken checks that the access token was signed by a key of the issuer, that it has not
+// expired and that it is for the audience. It returns the claims of the token.
+func (v *Vault) VerifyAccessToken(accessToken string, issuerDID string, audience string) (map[string]any, error) {
+
+	claims := jwt.MapClaims{}
+	token, err := jwt.NewParser().ParseUnverified2(accessToken, &claims)
+	if errors.Is(err, jwt.ErrTokenExpired) {
+		return nil, ErrAccessTokenExpired
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrAccessTokenInvalid, err)
+	}
+
+	// The key must belong to the issuer
+	jwks, err := v.PrivateKeysForUser(issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	ownKey := false
+	for _, k := range jwks {
+		if k.GetKid() == token.Kid() {
+			ownKey = true
+		}
+	}
+	if !ownKey {
+		return nil, fmt.Errorf("%w: unknown signing key", ErrAccessTokenInvalid)
+	}
+
+	if err := v.VerifySignature(token.ToBeSignedString, token.Signature, token.Alg(), token.Kid()); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrAccessTokenInvalid, err)
+	}
+
+	result := map[string]any{}
+	if err := json.Unmarshal(token.ClaimBytes, &result); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrAccessTokenInvalid, err)
+	}
+
+	if iss, _ := result["iss"].(string); iss != issuerDID {
+		return nil, fmt.Errorf("%w: unexpected issuer", ErrAccessTokenInvalid)
+	}
+	exp, ok := result["exp"].(float64)
+	if !ok {
+		return nil, fmt.Errorf("%w: no expiration", ErrAccessTokenInvalid)
+	}
+	if time.Now().Unix() >= int64(exp) {
+		return nil, ErrAccessTokenExpired
+	}
+	if aud, _ := result["aud"].(string); aud != audience {
+		return nil, ErrAccessTokenAudience
+	}
+
+	return result, nil
+}
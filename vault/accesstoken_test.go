@@ -0,0 +1,68 @@
+package vault
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAccessToken(t *testing.T) {
+	v := newTestVault(t)
+
+	for _, id := range []string{"verifier", "other"} {
+		if _, err := v.CreateUserWithKey(id, id, "legalperson", "pass"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cred := `{"type": ["VerifiableCredential"], "credentialSubject": {"id": "did:key:holder", "roles": [{"names": ["P.Info"]}]}}`
+
+	token, err := v.CreateAccessToken(cred, "verifier", "pep", time.Hour)
+	if err != nil {
+		t.Fatalf("CreateAccessToken() error = %v", err)
+	}
+	claims, err := v.VerifyAccessToken(string(token), "verifier", "pep")
+	if err != nil {
+		t.Fatalf("VerifyAccessToken() error = %v", err)
+	}
+	if claims["sub"] != "did:key:holder" || claims["verifiableCredential"] == nil {
+		t.Errorf("VerifyAccessToken() claims = %v", claims)
+	}
+
+	if _, err := v.CreateAccessTokenWithAlg(cred, "verifier", "pep", time.Hour, "ES256"); err != nil {
+		t.Errorf("CreateAccessTokenWithAlg() error = %v", err)
+	}
+	if _, err := v.CreateAccessTokenWithAlg(cred, "verifier", "pep", time.Hour, "RS256"); !errors.Is(err, ErrSigningAlg) {
+		t.Errorf("CreateAccessTokenWithAlg() without a key for the algorithm error = %v", err)
+	}
+
+	keys, _ := v.PrivateKeysForUser("verifier")
+	expired, _ := v.SignWithJWK(keys[0], map[string]any{"iss": "verifier", "aud": "pep", "exp": time.Now().Add(-time.Minute).Unix()})
+	otherIssuer, _ := v.CreateAccessToken(cred, "other", "pep", time.Hour)
+	parts := strings.Split(string(token), ".")
+	tampered := parts[0] + "." + parts[1] + "x." + parts[2]
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{"expired", string(expired), ErrAccessTokenExpired},
+		{"audience", string(token), ErrAccessTokenAudience},
+		{"other issuer", string(otherIssuer), ErrAccessTokenInvalid},
+		{"tampered", tampered, ErrAccessTokenInvalid},
+		{"malformed", "abc", ErrAccessTokenInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			audience := "pep"
+			if tt.name == "audience" {
+				audience = "another"
+			}
+			if _, err := v.VerifyAccessToken(tt.token, "verifier", audience); !errors.Is(err, tt.wantErr) {
+				t.Errorf("VerifyAccessToken() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
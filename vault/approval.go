@@ -0,0 +1,230 @@
+package vault
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hesusruiz/vcbackend/ent"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequest"
+	"github.com/hesusruiz/vcbackend/ent/issuancerequestevent"
+)
+
+// ApproverRole is the role that a user needs to approve or reject issuance requests
+const ApproverRole = "approver"
+
+var (
+	ErrRequestNotFound   = errors.New("issuance request not found")
+	ErrRequestNotPending = errors.New("issuance request is not pending")
+	ErrRequestNotAllowed = errors.New("operation on issuance request not allowed")
+)
+
+// CreateIssuanceRequest stores a pending request for a credential of the given type and claims,
+// for the holder identified by subjectDID
+func (v *Vault) CreateIssuanceRequest(credType string, subjectDID string, claims map[string]any, requester string) (*ent.IssuanceRequest, error) {
+	return v.createIssuanceRequest(credType, subjectDID, claims, requester, "", false)
+}
+
+// CreateRenewalRequest stores a pending request for renewing the credential predecessor with the given
+// claims. When the request is approved, the new credential is linked to the predecessor, which is revoked
+// if revokePredecessor is true.
+func (v *Vault) CreateRenewalRequest(predecessor string, revokePredecessor bool, credType string, subjectDID string, claims map[string]any, requester string) (*ent.IssuanceRequest, error) {
+	return v.createIssuanceRequest(credType, subjectDID, claims, requester, predecessor, revokePredecessor)
+}
+
+func (v *Vault) createIssuanceRequest(credType string, subjectDID string, claims map[string]any, requester string, predecessor string, revokePredecessor bool) (*ent.IssuanceRequest, error) {
+
+	tx, err := v.Client.Tx(v.dbContext())
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := tx.IssuanceRequest.Create().
+		SetID(uuid.NewString()).
+		SetCredentialType(credType).
+		SetSubjectDid(subjectDID).
+		SetClaims(claims).
+		SetRequestedBy(requester).
+		SetPredecessor(predecessor).
+		SetRevokePredecessor(revokePredecessor).
+		Save(v.dbContext())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = tx.IssuanceRequestEvent.Create().
+		SetRequest(req).
+		SetToStatus(string(issuancerequest.StatusPending)).
+		SetActor(requester).
+		Exec(v.dbContext())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	v.logger().Infow("issuance request created", "id", req.ID, "type", credType, "requester", requester, "predecessor", predecessor)
+
+	return req, nil
+}
+
+// IssuanceRequestByID returns the request with its events and the credential issued, if any
+func (v *Vault) IssuanceRequestByID(id string) (*ent.IssuanceRequest, error) {
+	req, err := v.Client.IssuanceRequest.Query().
+		Where(issuancerequest.ID(id)).
+		WithEvents(func(q *ent.IssuanceRequestEventQuery) {
+			q.Order(ent.Asc(issuancerequestevent.FieldCreatedAt))
+		}).
+		WithCredential().
+		Only(v.dbContext())
+	if ent.IsNotFound(err) {
+		return nil, ErrRequestNotFound
+	}
+	return req, err
+}
+
+// ListIssuanceRequests returns the requests in the given status, or all of them if status is empty,
+// the most recent first
+func (v *Vault) ListIssuanceRequests(status string) ([]*ent.IssuanceRequest, error) {
+	query := v.Client.IssuanceRequest.Query()
+	if len(status) > 0 {
+		if err := issuancerequest.StatusValidator(issuancerequest.Status(status)); err != nil {
+			return nil, err
+		}
+		query = query.Where(issuancerequest.StatusEQ(issuancerequest.Status(status)))
+	}
+	return query.
+		Order(ent.Desc(issuancerequest.FieldCreatedAt)).
+		All(v.dbContext())
+}
+
+// ApproveIssuanceRequest approves a pending request. The approver must have the approver role
+// and be a different user than the one who created the request.
+func (v *Vault) ApproveIssuanceRequest(id string, approver string, reason string) (*ent.IssuanceRequest, error) {
+
+	req, err := v.IssuanceRequestByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := v.checkApprover(req, approver); err != nil {
+		return nil, err
+	}
+
+	return v.transitionRequest(req, issuancerequest.StatusPending, issuancerequest.StatusApproved, approver, reason)
+}
+
+// RejectIssuanceRequest rejects a pending request. The same rules as for approval apply,
+// and the reason is compulsory.
+func (v *Vault) RejectIssuanceRequest(id string, approver string, reason string) (*ent.IssuanceRequest, error) {
+
+	if len(reason) == 0 {
+		return nil, fmt.Errorf("%w: a reason is required for rejecting", ErrRequestNotAllowed)
+	}
+
+	req, err := v.IssuanceRequestByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := v.checkApprover(req, approver); err != nil {
+		return nil, err
+	}
+
+	return v.transitionRequest(req, issuancerequest.StatusPending, issuancerequest.StatusRejected, approver, reason)
+}
+
+// MarkIssuanceRequestIssued records that the credential for an approved request was signed
+func (v *Vault) MarkIssuanceRequestIssued(id string, credentialID string, actor string) (*ent.IssuanceRequest, error) {
+
+	req, err := v.IssuanceRequestByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err = v.transitionRequest(req, issuancerequest.StatusApproved, issuancerequest.StatusIssued, actor, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return req.Update().
+		SetCredentialID(credentialID).
+		Save(v.dbContext())
+}
+
+// MarkIssuanceRequestFailed records that the credential for an approved request could not be signed
+func (v *Vault) MarkIssuanceRequestFailed(id string, actor string, cause error) (*ent.IssuanceRequest, error) {
+
+	req, err := v.IssuanceRequestByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.transitionRequest(req, issuancerequest.StatusApproved, issuancerequest.StatusFailed, actor, cause.Error())
+}
+
+func (v *Vault) checkApprover(req *ent.IssuanceRequest, approver string) error {
+	if req.Status != issuancerequest.StatusPending {
+		return ErrRequestNotPending
+	}
+	if approver == req.RequestedBy {
+		return fmt.Errorf("%w: the requester can not decide on its own request", ErrRequestNotAllowed)
+	}
+	if !v.UserHasRole(approver, ApproverRole) {
+		return fmt.Errorf("%w: %s does not have the %s role", ErrRequestNotAllowed, approver, ApproverRole)
+	}
+	return nil
+}
+
+// transitionRequest changes the status of the request, recording who did it and why.
+// The update only succeeds if the request is still in the expected status, so two
+// concurrent decisions on the same request can not both succeed.
+func (v *Vault) transitionRequest(req *ent.IssuanceRequest, from issuancerequest.Status, to issuancerequest.Status, actor string, reason string) (*ent.IssuanceRequest, error) {
+
+	tx, err := v.Client.Tx(v.dbContext())
+	if err != nil {
+		return nil, err
+	}
+
+	update := tx.IssuanceRequest.Update().
+		Where(
+			issuancerequest.ID(req.ID),
+			issuancerequest.StatusEQ(from),
+		).
+		SetStatus(to).
+		SetUpdatedAt(time.Now())
+	if to == issuancerequest.StatusApproved || to == issuancerequest.StatusRejected {
+		update = update.SetDecidedBy(actor).SetReason(reason)
+	}
+
+	n, err := update.Save(v.dbContext())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if n == 0 {
+		tx.Rollback()
+		return nil, fmt.Errorf("%w: expected %s but it is %s", ErrRequestNotPending, from, req.Status)
+	}
+
+	err = tx.IssuanceRequestEvent.Create().
+		SetRequestID(req.ID).
+		SetFromStatus(string(from)).
+		SetToStatus(string(to)).
+		SetActor(actor).
+		SetReason(reason).
+		Exec(v.dbContext())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	v.logger().Infow("issuance request updated", "id", req.ID, "from", string(from), "to", string(to), "actor", actor)
+
+	return v.IssuanceRequestByID(req.ID)
+}
@@ -0,0 +1,95 @@
+package vault
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hesusruiz/vcbackend/ent/issuancerequest"
+)
+
+func TestIssuanceRequestApproval(t *testing.T) {
+	v := newTestVault(t)
+
+	for _, id := range []string{"alice", "bob", "carol"} {
+		if _, err := v.CreateUser(id, id, "operator", "ThePassword"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	v.SetUserRoles("bob", []string{ApproverRole})
+	v.SetUserRoles("alice", []string{ApproverRole})
+
+	req, err := v.CreateIssuanceRequest("PacketDeliveryService", "did:key:holder", map[string]any{"email": "a@b.c"}, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.SubjectDid != "did:key:holder" {
+		t.Errorf("SubjectDid = %q, want the DID of the holder", req.SubjectDid)
+	}
+
+	// The requester can not approve its own request, even being an approver
+	if _, err := v.ApproveIssuanceRequest(req.ID, "alice", ""); !errors.Is(err, ErrRequestNotAllowed) {
+		t.Errorf("expected ErrRequestNotAllowed for the requester, got %v", err)
+	}
+
+	// Only approvers can approve
+	if _, err := v.ApproveIssuanceRequest(req.ID, "carol", ""); !errors.Is(err, ErrRequestNotAllowed) {
+		t.Errorf("expected ErrRequestNotAllowed for a non approver, got %v", err)
+	}
+
+	req, err = v.ApproveIssuanceRequest(req.ID, "bob", "ok")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.Status != issuancerequest.StatusApproved || req.DecidedBy != "bob" {
+		t.Errorf("unexpected request after approval: %+v", req)
+	}
+
+	// A decided request can not be decided again
+	if _, err := v.RejectIssuanceRequest(req.ID, "bob", "changed my mind"); !errors.Is(err, ErrRequestNotPending) {
+		t.Errorf("expected ErrRequestNotPending, got %v", err)
+	}
+
+	req, err = v.MarkIssuanceRequestFailed(req.ID, "bob", errors.New("SSI Kit not available"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Every transition is recorded with its actor
+	want := []struct{ from, to, actor string }{
+		{"", "pending", "alice"},
+		{"pending", "approved", "bob"},
+		{"approved", "failed", "bob"},
+	}
+	if len(req.Edges.Events) != len(want) {
+		t.Fatalf("expected %d events, got %d", len(want), len(req.Edges.Events))
+	}
+	for i, ev := range req.Edges.Events {
+		if ev.FromStatus != want[i].from || ev.ToStatus != want[i].to || ev.Actor != want[i].actor {
+			t.Errorf("event %d: got %s->%s by %s", i, ev.FromStatus, ev.ToStatus, ev.Actor)
+		}
+	}
+}
+
+func TestIssuanceRequestRejectNeedsReason(t *testing.T) {
+	v := newTestVault(t)
+
+	v.CreateUser("alice", "alice", "operator", "ThePassword")
+	v.CreateUser("bob", "bob", "operator", "ThePassword")
+	v.SetUserRoles("bob", []string{ApproverRole})
+
+	req, err := v.CreateIssuanceRequest("PacketDeliveryService", "did:key:holder", map[string]any{}, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := v.RejectIssuanceRequest(req.ID, "bob", ""); !errors.Is(err, ErrRequestNotAllowed) {
+		t.Errorf("expected ErrRequestNotAllowed without reason, got %v", err)
+	}
+	req, err = v.RejectIssuanceRequest(req.ID, "bob", "wrong roles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.Status != issuancerequest.StatusRejected || req.Reason != "wrong roles" {
+		t.Errorf("unexpected request after rejection: %+v", req)
+	}
+}
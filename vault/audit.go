@@ -0,0 +1,394 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/hesusruiz/vcbackend/ent"
+	"github.com/hesusruiz/vcbackend/ent/auditentry"
+	"github.com/hesusruiz/vcbackend/ent/credential"
+	"github.com/hesusruiz/vcbackend/ent/hook"
+	entmigrate "github.com/hesusruiz/vcbackend/ent/migrate"
+	"github.com/hesusruiz/vcbackend/internal/audit"
+	"go.uber.org/zap"
+)
+
+// SystemActor is the actor of the entries recorded by the vault itself, for changes in the stored data
+const SystemActor = "system"
+
+// auditBatchSize is the number of entries read at a time when verifying or exporting the log
+const auditBatchSize = 1000
+
+// auditAttempts is the number of times an entry is appended when it conflicts with an entry appended
+// at the same time by another instance, waiting auditRetryDelay more after each attempt
+const (
+	auditAttempts   = 5
+	auditRetryDelay = 10 * time.Millisecond
+)
+
+var ErrAuditAppendOnly = errors.New("the audit log is append-only")
+
+// AuditQuery are the filters of a search in the audit log. The entries are returned in order,
+// starting after the sequence number AfterSeq.
+type AuditQuery struct {
+	Actor    string
+	Action   string
+	Target   string
+	From     *time.Time
+	To       *time.Time
+	AfterSeq int
+	Limit    int
+}
+
+// useAudit registers the hooks which record in the audit log the changes of credentials, keys and DIDs,
+// and which prevent modifying or deleting the entries of the log
+func (v *Vault) useAudit() {
+
+	v.Client.AuditEntry.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.AuditEntryFunc(func(ctx context.Context, m *ent.AuditEntryMutation) (ent.Value, error) {
+			if !m.Op().Is(ent.OpCreate) {
+				return nil, ErrAuditAppendOnly
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+
+	v.Client.Credential.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.CredentialFunc(func(ctx context.Context, m *ent.CredentialMutation) (ent.Value, error) {
+
+			action := "credential.updated"
+			switch {
+			case m.Op().Is(ent.OpCreate):
+				action = "credential.stored"
+			case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+				action = "credential.deleted"
+			}
+			if status, ok := m.Status(); ok && status == credential.StatusRevoked {
+				action = "credential.revoked"
+			}
+
+			ids, _ := mutationIDs(ctx, m)
+			value, err := next.Mutate(ctx, m)
+			v.recordMutation(ctx, m, action, "credential", ids, m.Fields(), err)
+			return value, err
+		})
+	})
+
+	v.Client.PrivateKey.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.PrivateKeyFunc(func(ctx context.Context, m *ent.PrivateKeyMutation) (ent.Value, error) {
+
+			action := "key.updated"
+			switch {
+			case m.Op().Is(ent.OpCreate):
+				action = "key.created"
+			case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+				action = "key.deleted"
+			}
+
+			var ids []string
+			if id, ok := m.ID(); ok {
+				ids = []string{id}
+			} else if !m.Op().Is(ent.OpCreate) {
+				ids, _ = m.IDs(ctx)
+			}
+
+			// Never record the key material
+			value, err := next.Mutate(ctx, m)
+			v.recordMutation(ctx, m, action, "key", ids, nil, err)
+			return value, err
+		})
+	})
+
+	v.Client.DID.Use(hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.DIDFunc(func(ctx context.Context, m *ent.DIDMutation) (ent.Value, error) {
+			id, _ := m.ID()
+			value, err := next.Mutate(ctx, m)
+			v.recordMutation(ctx, m, "did.registered", "did", []string{id}, nil, err)
+			return value, err
+		})
+	}, ent.OpCreate))
+
+}
+
+// mutationIDs returns the ids of the credentials affected by a mutation
+func mutationIDs(ctx context.Context, m *ent.CredentialMutation) ([]string, error) {
+	if id, ok := m.ID(); ok {
+		return []string{id}, nil
+	}
+	if m.Op().Is(ent.OpCreate) {
+		return nil, nil
+	}
+	return m.IDs(ctx)
+}
+
+// txMutation is a mutation which may run in a transaction
+type txMutation interface {
+	Tx() (*ent.Tx, error)
+}
+
+// recordMutation records a change in the stored data. If the mutation runs in a transaction, the entry
+// is written in it, so it is committed or rolled back with the change.
+func (v *Vault) recordMutation(ctx context.Context, m txMutation, action string, targetType string, ids []string, fields []string, err error) {
+
+	e := &audit.Entry{
+		Actor:      SystemActor,
+		Action:     action,
+		TargetType: targetType,
+		Outcome:    audit.Success,
+		Details:    map[string]any{},
+	}
+	if len(ids) == 1 {
+		e.Target = ids[0]
+	} else if len(ids) > 1 {
+		e.Details["ids"] = ids
+	}
+	if len(fields) > 0 {
+		e.Details["fields"] = fields
+	}
+	if err != nil {
+		e.Outcome = audit.Failure
+		e.Details["error"] = err.Error()
+	}
+
+	var auditErr error
+	if tx, txErr := m.Tx(); txErr == nil {
+		_, auditErr = v.appendAuditTx(ctx, tx.Client(), e)
+	} else {
+		_, auditErr = v.appendAudit(ctx, e)
+	}
+	if auditErr != nil {
+		v.logger().Errorw("failed writing the audit log", "action", action, zap.Error(auditErr))
+	}
+}
+
+// Audit appends an entry to the audit log, chained to the last entry
+func (v *Vault) Audit(e *audit.Entry) (*audit.Entry, error) {
+	return v.appendAudit(v.dbContext(), e)
+}
+
+// appendAudit appends the entry in its own transaction. When several instances share the database,
+// the transaction is retried if another instance appended an entry at the same time.
+func (v *Vault) appendAudit(ctx context.Context, e *audit.Entry) (*audit.Entry, error) {
+
+	for attempt := 1; ; attempt++ {
+
+		// The entry is sealed again in each attempt, chained to the last entry at that time
+		entry := *e
+		sealed, err := v.appendAuditOnce(ctx, &entry)
+		if err == nil {
+			*e = *sealed
+			return e, nil
+		}
+		if attempt == auditAttempts || !isConflict(err) {
+			return nil, err
+		}
+
+		v.logger().Debugw("retrying the append to the audit log", "attempt", attempt, zap.Error(err))
+		time.Sleep(time.Duration(attempt) * auditRetryDelay)
+	}
+}
+
+func (v *Vault) appendAuditOnce(ctx context.Context, e *audit.Entry) (*audit.Entry, error) {
+
+	tx, err := v.Client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := v.appendAuditTx(ctx, tx.Client(), e)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return sealed, nil
+}
+
+// appendAuditTx appends the entry with the client of a transaction. The table is locked until the end of
+// the transaction, so the entries appended at the same time by other instances are chained after this one.
+func (v *Vault) appendAuditTx(ctx context.Context, client *ent.Client, e *audit.Entry) (*audit.Entry, error) {
+
+	query := client.AuditEntry.Query().Order(ent.Desc(auditentry.FieldID))
+	switch v.driver {
+	case dialect.Postgres:
+		// Other transactions can read the log, but not lock it until this one ends
+		if _, err := client.ExecContext(ctx, "LOCK TABLE "+entmigrate.AuditEntriesTable.Name+" IN SHARE ROW EXCLUSIVE MODE"); err != nil {
+			return nil, err
+		}
+	case dialect.MySQL:
+		// Locks the last entry and the gap after it, also when the log is empty
+		query.Where(func(s *entsql.Selector) { s.ForUpdate() })
+	default:
+		// SQLite allows only one transaction writing to the database
+	}
+
+	var prev *audit.Entry
+	last, err := query.First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if last != nil {
+		prev = auditFromEnt(last)
+	}
+
+	e.Seq = 1
+	if prev != nil {
+		e.Seq = prev.Seq + 1
+	}
+	if e.Time.IsZero() {
+		e.Time = audit.Now()
+	}
+	if len(e.Actor) == 0 {
+		e.Actor = SystemActor
+	}
+	if len(e.Details) == 0 {
+		e.Details = nil
+	}
+	e.Seal(prev)
+
+	err = client.AuditEntry.Create().
+		SetID(e.Seq).
+		SetCreatedAt(e.Time).
+		SetActor(e.Actor).
+		SetAction(e.Action).
+		SetTargetType(e.TargetType).
+		SetTarget(e.Target).
+		SetOutcome(auditentry.Outcome(e.Outcome)).
+		SetRequestID(e.RequestID).
+		SetDetails(e.Details).
+		SetPrevHash(e.PrevHash).
+		SetHash(e.Hash).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+func auditFromEnt(a *ent.AuditEntry) *audit.Entry {
+	return &audit.Entry{
+		Seq:        a.ID,
+		Time:       a.CreatedAt,
+		Actor:      a.Actor,
+		Action:     a.Action,
+		TargetType: a.TargetType,
+		Target:     a.Target,
+		Outcome:    string(a.Outcome),
+		RequestID:  a.RequestID,
+		Details:    a.Details,
+		PrevHash:   a.PrevHash,
+		Hash:       a.Hash,
+	}
+}
+
+// QueryAudit returns the entries of the audit log matching the query, in order
+func (v *Vault) QueryAudit(q AuditQuery) ([]*audit.Entry, error) {
+
+	query := v.Client.AuditEntry.Query().
+		Where(auditentry.IDGT(q.AfterSeq))
+
+	if len(q.Actor) > 0 {
+		query = query.Where(auditentry.Actor(q.Actor))
+	}
+	if len(q.Action) > 0 {
+		query = query.Where(auditentry.Action(q.Action))
+	}
+	if len(q.Target) > 0 {
+		query = query.Where(auditentry.Target(q.Target))
+	}
+	if q.From != nil {
+		query = query.Where(auditentry.CreatedAtGTE(*q.From))
+	}
+	if q.To != nil {
+		query = query.Where(auditentry.CreatedAtLT(*q.To))
+	}
+	if q.Limit > 0 {
+		query = query.Limit(q.Limit)
+	}
+
+	entries, err := query.
+		Order(ent.Asc(auditentry.FieldID)).
+		All(v.dbContext())
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*audit.Entry, len(entries))
+	for i, a := range entries {
+		result[i] = auditFromEnt(a)
+	}
+	return result, nil
+}
+
+// ExportAudit calls fn with all the entries matching the query, in order, reading them in batches
+func (v *Vault) ExportAudit(q AuditQuery, fn func(e *audit.Entry) error) error {
+
+	remaining := q.Limit
+	for {
+		batch := q
+		batch.Limit = auditBatchSize
+		if remaining > 0 && remaining < auditBatchSize {
+			batch.Limit = remaining
+		}
+
+		entries, err := v.QueryAudit(batch)
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			if err := fn(e); err != nil {
+				return err
+			}
+		}
+
+		if q.Limit > 0 {
+			remaining -= len(entries)
+			if remaining <= 0 {
+				return nil
+			}
+		}
+		if len(entries) < batch.Limit {
+			return nil
+		}
+		q.AfterSeq = entries[len(entries)-1].Seq
+	}
+}
+
+// VerifyAudit checks the hash chain of the whole audit log. It returns the number of entries
+// verified and the sequence number of the first entry which was tampered with, or 0 if there is none.
+func (v *Vault) VerifyAudit() (int, int, error) {
+
+	var prev *audit.Entry
+	verified := 0
+	expectedSeq := 1
+
+	for {
+		entries, err := v.QueryAudit(AuditQuery{AfterSeq: expectedSeq - 1, Limit: auditBatchSize})
+		if err != nil {
+			return verified, 0, err
+		}
+
+		// A gap in the sequence means that entries were removed
+		for _, e := range entries {
+			if e.Seq != expectedSeq {
+				return verified, expectedSeq, nil
+			}
+			expectedSeq++
+		}
+
+		if bad := audit.Verify(prev, entries); bad != 0 {
+			return verified, bad, nil
+		}
+		verified += len(entries)
+
+		if len(entries) < auditBatchSize {
+			return verified, 0, nil
+		}
+		prev = entries[len(entries)-1]
+	}
+}
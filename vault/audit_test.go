@@ -0,0 +1,145 @@
+package vault
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/hesusruiz/vcbackend/ent/credential"
+	"github.com/hesusruiz/vcbackend/internal/audit"
+	"github.com/hesusruiz/vcutils/yaml"
+)
+
+func TestAuditHooks(t *testing.T) {
+	v := newTestVault(t)
+	ctx := context.Background()
+
+	raw := ldpCredential("cred", "PacketDeliveryService", "did:key:holder", "a@b.c", "")
+	v.Client.Credential.Create().SetID("cred").SetType("ldp_vc").SetRaw(raw).ExecX(ctx)
+	v.Client.Credential.UpdateOneID("cred").SetStatus(credential.StatusRevoked).ExecX(ctx)
+
+	entries, err := v.QueryAudit(AuditQuery{Target: "cred"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var actions []string
+	for _, e := range entries {
+		actions = append(actions, e.Action)
+	}
+	if len(actions) != 2 || actions[0] != "credential.stored" || actions[1] != "credential.revoked" {
+		t.Errorf("recorded actions = %v", actions)
+	}
+
+	// The entries can not be modified or deleted
+	if err := v.Client.AuditEntry.UpdateOneID(entries[0].Seq).Exec(ctx); !errors.Is(err, ErrAuditAppendOnly) {
+		t.Errorf("update of an entry: error = %v", err)
+	}
+	if _, err := v.Client.AuditEntry.Delete().Exec(ctx); !errors.Is(err, ErrAuditAppendOnly) {
+		t.Errorf("delete of entries: error = %v", err)
+	}
+}
+
+func TestAuditChain(t *testing.T) {
+
+	// Open the database also without the vault, to tamper with it
+	dsn := "file:audittamper?mode=memory&cache=shared&_fk=1"
+	v, err := New(yaml.New(map[string]any{
+		"store": map[string]any{
+			"driverName":     "sqlite3",
+			"autoMigrate":    true,
+			"dataSourceName": dsn,
+		},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer v.Client.Close()
+
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	for _, action := range []string{"credential.issue", "credential.download", "verification.login"} {
+		if _, err := v.Audit(&audit.Entry{Actor: "admin", Action: action, Target: "cred", Outcome: audit.Success}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	total, _ := v.Client.AuditEntry.Query().Count(context.Background())
+
+	verified, bad, err := v.VerifyAudit()
+	if err != nil || bad != 0 || verified != total {
+		t.Fatalf("VerifyAudit() = %d, %d, %v, want %d valid entries", verified, bad, err, total)
+	}
+
+	// Exported in order
+	var seqs []int
+	v.ExportAudit(AuditQuery{Action: "credential.download"}, func(e *audit.Entry) error {
+		seqs = append(seqs, e.Seq)
+		return nil
+	})
+	if len(seqs) != 1 {
+		t.Errorf("ExportAudit() exported %v", seqs)
+	}
+
+	// Modifying an entry breaks the chain
+	if _, err := db.Exec("UPDATE audit_entries SET actor = 'mallory' WHERE id = ?", seqs[0]); err != nil {
+		t.Fatal(err)
+	}
+	if _, bad, _ := v.VerifyAudit(); bad != seqs[0] {
+		t.Errorf("VerifyAudit() first bad entry = %d, want %d", bad, seqs[0])
+	}
+
+	// Removing an entry leaves a gap in the sequence
+	if _, err := db.Exec("DELETE FROM audit_entries WHERE id = ?", seqs[0]); err != nil {
+		t.Fatal(err)
+	}
+	if _, bad, _ := v.VerifyAudit(); bad != seqs[0] {
+		t.Errorf("VerifyAudit() first bad entry = %d, want %d", bad, seqs[0])
+	}
+}
+
+// TestAuditInstances appends entries concurrently from several vaults sharing the database, like the
+// instances of a server. No entry is lost and the chain is kept.
+func TestAuditInstances(t *testing.T) {
+	instances := newTestInstances(t, 3)
+	ctx := context.Background()
+	before, _ := instances[0].Client.AuditEntry.Query().Count(ctx)
+
+	const perInstance = 20
+	errs := make(chan error, len(instances)*perInstance)
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for _, v := range instances {
+		wg.Add(1)
+		go func(v *Vault) {
+			defer wg.Done()
+			<-start
+			for i := 0; i < perInstance; i++ {
+				_, err := v.Audit(&audit.Entry{Actor: "admin", Action: "credential.download", Target: "cred", Outcome: audit.Success})
+				errs <- err
+			}
+		}(v)
+	}
+	close(start)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("Audit() error = %v", err)
+		}
+	}
+
+	total, _ := instances[0].Client.AuditEntry.Query().Count(ctx)
+	if total != before+len(instances)*perInstance {
+		t.Errorf("%d entries, want %d", total, before+len(instances)*perInstance)
+	}
+	verified, bad, err := instances[1].VerifyAudit()
+	if err != nil || bad != 0 || verified != total {
+		t.Errorf("VerifyAudit() = %d, %d, %v, want %d valid entries", verified, bad, err, total)
+	}
+}
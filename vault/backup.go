@@ -0,0 +1,510 @@
+package vault
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+	entmigrate "github.com/hesusruiz/vcbackend/ent/migrate"
+	"github.com/hesusruiz/vcbackend/ent/user"
+	"github.com/hesusruiz/vcbackend/internal/audit"
+	"github.com/hesusruiz/vcbackend/internal/backup"
+	"github.com/hesusruiz/vcbackend/vault/migrations"
+)
+
+// ErrNotEmpty is returned when restoring a backup into a database which already has data
+var ErrNotEmpty = errors.New("the database is not empty")
+
+// identifier is the syntax of the names of the tables and columns of an archive
+var identifier = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// backupTables returns the tables of the vault, each one after the tables it references
+func backupTables() []*schema.Table {
+
+	var sorted []*schema.Table
+	done := map[string]bool{}
+
+	var visit func(t *schema.Table)
+	visit = func(t *schema.Table) {
+		if done[t.Name] {
+			return
+		}
+		done[t.Name] = true
+		for _, fk := range t.ForeignKeys {
+			visit(fk.RefTable)
+		}
+		sorted = append(sorted, t)
+	}
+	for _, t := range entmigrate.Tables {
+		visit(t)
+	}
+
+	return sorted
+}
+
+// backupColumns returns the columns of a table, with their types in the archives
+func backupColumns(t *schema.Table) ([]*backup.Column, error) {
+
+	references := map[string]string{}
+	for _, fk := range t.ForeignKeys {
+		references[fk.Columns[0].Name] = fk.RefTable.Name
+	}
+	primary := map[string]bool{}
+	for _, c := range t.PrimaryKey {
+		primary[c.Name] = true
+	}
+
+	columns := make([]*backup.Column, len(t.Columns))
+	for i, c := range t.Columns {
+		col := &backup.Column{
+			Name:       c.Name,
+			References: references[c.Name],
+			PrimaryKey: primary[c.Name],
+			Increment:  c.Increment,
+		}
+		switch {
+		case c.Type == field.TypeBool:
+			col.Type = backup.TypeBool
+		case c.Type.Integer():
+			col.Type = backup.TypeInt
+		case c.Type.Float():
+			col.Type = backup.TypeFloat
+		case c.Type == field.TypeString || c.Type == field.TypeEnum:
+			col.Type = backup.TypeString
+		case c.Type == field.TypeTime:
+			col.Type = backup.TypeTime
+		case c.Type == field.TypeBytes:
+			col.Type = backup.TypeBytes
+		case c.Type == field.TypeJSON:
+			col.Type = backup.TypeJSON
+		default:
+			return nil, fmt.Errorf("column %s.%s: type %s not supported in backups", t.Name, c.Name, c.Type)
+		}
+		columns[i] = col
+	}
+	return columns, nil
+}
+
+// scanDest returns the destination of Scan for a column of the type
+func scanDest(typ string) any {
+	switch typ {
+	case backup.TypeInt:
+		return &sql.NullInt64{}
+	case backup.TypeFloat:
+		return &sql.NullFloat64{}
+	case backup.TypeBool:
+		return &sql.NullBool{}
+	case backup.TypeTime:
+		return &sql.NullTime{}
+	case backup.TypeBytes, backup.TypeJSON:
+		return &[]byte{}
+	default:
+		return &sql.NullString{}
+	}
+}
+
+// scanned returns the value scanned into a destination of scanDest, or nil for NULL
+func scanned(dest any) any {
+	switch d := dest.(type) {
+	case *sql.NullInt64:
+		if d.Valid {
+			return d.Int64
+		}
+	case *sql.NullFloat64:
+		if d.Valid {
+			return d.Float64
+		}
+	case *sql.NullBool:
+		if d.Valid {
+			return d.Bool
+		}
+	case *sql.NullTime:
+		if d.Valid {
+			return d.Time
+		}
+	case *sql.NullString:
+		if d.Valid {
+			return d.String
+		}
+	case *[]byte:
+		if *d != nil {
+			return *d
+		}
+	}
+	return nil
+}
+
+// readTable reads the rows of a table which satisfy the predicate, or all of them if it is nil
+func readTable(ctx context.Context, tx *sql.Tx, driver string, t *schema.Table, where *entsql.Predicate) (*backup.Table, error) {
+
+	columns, err := backupColumns(t)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = c.Name
+	}
+
+	selector := entsql.Dialect(driver).Select(names...).From(entsql.Table(t.Name))
+	if where != nil {
+		selector.Where(where)
+	}
+	for _, c := range t.PrimaryKey {
+		selector.OrderBy(c.Name)
+	}
+	query, args := selector.Query()
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", t.Name, err)
+	}
+	defer rows.Close()
+
+	table := &backup.Table{Name: t.Name, Columns: columns, Rows: [][]any{}}
+	for rows.Next() {
+		dest := make([]any, len(columns))
+		for i, c := range columns {
+			dest[i] = scanDest(c.Type)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("reading %s: %w", t.Name, err)
+		}
+		row := make([]any, len(columns))
+		for i, c := range columns {
+			if row[i], err = backup.EncodeValue(c.Type, scanned(dest[i])); err != nil {
+				return nil, fmt.Errorf("reading %s.%s: %w", t.Name, c.Name, err)
+			}
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", t.Name, err)
+	}
+
+	return table, nil
+}
+
+// newArchive creates an archive of the vault in the database, which must have all the migrations applied
+func newArchive(ctx context.Context, db *sql.DB, driver string, kind string) (*backup.Archive, error) {
+
+	migrator, err := migrations.New(db, driver)
+	if err != nil {
+		return nil, err
+	}
+	if err := migrator.Check(ctx); err != nil {
+		return nil, err
+	}
+	version, err := migrator.Version(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &backup.Archive{
+		Format:        backup.FormatVersion,
+		Kind:          kind,
+		CreatedAt:     time.Now().UTC(),
+		Driver:        driver,
+		SchemaVersion: version,
+	}, nil
+}
+
+// readOnly starts a transaction where all the reads see the same snapshot of the database
+func readOnly(ctx context.Context, db *sql.DB) (*sql.Tx, error) {
+	return db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+}
+
+// Backup reads all the tables of the vault in the database, in a transaction so the backup is
+// consistent while the vault is in use
+func Backup(ctx context.Context, db *sql.DB, driver string) (*backup.Archive, error) {
+
+	a, err := newArchive(ctx, db, driver, backup.KindVault)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := readOnly(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	for _, t := range backupTables() {
+		table, err := readTable(ctx, tx, driver, t, nil)
+		if err != nil {
+			return nil, err
+		}
+		a.Tables = append(a.Tables, table)
+	}
+
+	return a, nil
+}
+
+// Restore writes the tables of a backup into a database without data, which can be of another driver.
+// The database is migrated to the version of the schema of the backup before writing it, and to the
+// last version after.
+func Restore(ctx context.Context, db *sql.DB, driver string, a *backup.Archive) error {
+
+	if a.Kind != backup.KindVault {
+		return fmt.Errorf("the archive is not a backup of a vault but a %s export", a.Kind)
+	}
+
+	migrator, err := migrations.New(db, driver)
+	if err != nil {
+		return err
+	}
+	version, err := migrator.Version(ctx)
+	if err != nil {
+		return err
+	}
+	if version > a.SchemaVersion {
+		return fmt.Errorf("%w: the database has the version %s of the schema, newer than the %s of the backup",
+			ErrNotEmpty, version, a.SchemaVersion)
+	}
+	if _, err := migrator.UpTo(ctx, a.SchemaVersion); err != nil {
+		return fmt.Errorf("migrating to the version of the backup: %w", err)
+	}
+
+	for _, t := range a.Tables {
+		if !identifier.MatchString(t.Name) {
+			return fmt.Errorf("invalid table name %q", t.Name)
+		}
+		var count int
+		query, args := entsql.Dialect(driver).Select(entsql.Count("*")).From(entsql.Table(t.Name)).Query()
+		if err := db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+			return fmt.Errorf("counting the rows of %s: %w", t.Name, err)
+		}
+		if count > 0 {
+			return fmt.Errorf("%w: the table %s has %d rows", ErrNotEmpty, t.Name, count)
+		}
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, t := range a.Tables {
+		if err := writeTable(ctx, tx, driver, t); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	// The backups of older releases are migrated like the databases
+	if _, err := migrator.Up(ctx); err != nil {
+		return fmt.Errorf("migrating the restored backup: %w", err)
+	}
+	return nil
+}
+
+// writeTable inserts the rows of a table of an archive. The references of a table to itself are
+// updated after inserting all its rows, which may be in any order.
+func writeTable(ctx context.Context, tx *sql.Tx, driver string, t *backup.Table) error {
+
+	names := make([]string, len(t.Columns))
+	key := -1
+	var selfRefs []int
+	for i, c := range t.Columns {
+		if !identifier.MatchString(c.Name) {
+			return fmt.Errorf("%s: invalid column name %q", t.Name, c.Name)
+		}
+		names[i] = c.Name
+		if c.PrimaryKey {
+			key = i
+		}
+		if c.References == t.Name {
+			selfRefs = append(selfRefs, i)
+		}
+	}
+	if len(selfRefs) > 0 && key < 0 {
+		return fmt.Errorf("%s: references to the same table without a primary key", t.Name)
+	}
+
+	type update struct {
+		column int
+		key    any
+		value  any
+	}
+	var updates []update
+
+	for _, row := range t.Rows {
+		if len(row) != len(t.Columns) {
+			return fmt.Errorf("%s: a row has %d values for %d columns", t.Name, len(row), len(t.Columns))
+		}
+		values := make([]any, len(row))
+		for i, c := range t.Columns {
+			v, err := backup.DecodeValue(c.Type, row[i])
+			if err != nil {
+				return fmt.Errorf("%s.%s: %w", t.Name, c.Name, err)
+			}
+			values[i] = v
+		}
+		for _, i := range selfRefs {
+			if values[i] != nil {
+				updates = append(updates, update{column: i, key: values[key], value: values[i]})
+				values[i] = nil
+			}
+		}
+
+		query, args := entsql.Dialect(driver).Insert(t.Name).Columns(names...).Values(values...).Query()
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("writing %s: %w", t.Name, err)
+		}
+	}
+
+	for _, u := range updates {
+		query, args := entsql.Dialect(driver).Update(t.Name).
+			Set(names[u.column], u.value).
+			Where(entsql.EQ(names[key], u.key)).
+			Query()
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("writing %s: %w", t.Name, err)
+		}
+	}
+
+	// The sequences of PostgreSQL do not advance with the values inserted
+	if driver == "postgres" {
+		for _, c := range t.Columns {
+			if !c.Increment {
+				continue
+			}
+			query := fmt.Sprintf(`SELECT setval(pg_get_serial_sequence('%s', '%s'), COALESCE(MAX("%s"), 0) + 1, false) FROM "%s"`,
+				t.Name, c.Name, c.Name, t.Name)
+			if _, err := tx.ExecContext(ctx, query); err != nil {
+				return fmt.Errorf("resetting the sequence of %s.%s: %w", t.Name, c.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// userTables are the tables of the exports of a user
+var userTables = []*schema.Table{
+	entmigrate.UsersTable,
+	entmigrate.PrivateKeysTable,
+	entmigrate.PublicKeysTable,
+	entmigrate.DiDsTable,
+}
+
+// ExportUser reads a user of the vault with its keys and DIDs, to be imported into another instance
+func (v *Vault) ExportUser(userid string) (*backup.Archive, error) {
+
+	if v.db == nil {
+		return nil, errors.New("the vault was not opened from its configuration")
+	}
+	ctx := v.dbContext()
+
+	a, err := newArchive(ctx, v.db, v.driver, backup.KindUser)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := readOnly(ctx, v.db)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	users, err := readTable(ctx, tx, v.driver, entmigrate.UsersTable, entsql.EQ(user.FieldID, userid))
+	if err != nil {
+		return nil, err
+	}
+	if len(users.Rows) == 0 {
+		return nil, fmt.Errorf("user %s not found", userid)
+	}
+
+	privateKeys, err := readTable(ctx, tx, v.driver, entmigrate.PrivateKeysTable, entsql.EQ(user.KeysColumn, userid))
+	if err != nil {
+		return nil, err
+	}
+
+	// The public keys have the identifiers of the private keys
+	kids := make([]any, len(privateKeys.Rows))
+	for i, row := range privateKeys.Rows {
+		kids[i] = row[0]
+	}
+	publicKeys := &backup.Table{Name: entmigrate.PublicKeysTable.Name, Rows: [][]any{}}
+	if len(kids) > 0 {
+		if publicKeys, err = readTable(ctx, tx, v.driver, entmigrate.PublicKeysTable, entsql.In("id", kids...)); err != nil {
+			return nil, err
+		}
+	} else if publicKeys.Columns, err = backupColumns(entmigrate.PublicKeysTable); err != nil {
+		return nil, err
+	}
+
+	dids, err := readTable(ctx, tx, v.driver, entmigrate.DiDsTable, entsql.EQ(user.DidsColumn, userid))
+	if err != nil {
+		return nil, err
+	}
+
+	a.Tables = []*backup.Table{users, privateKeys, publicKeys, dids}
+	return a, nil
+}
+
+// ImportUser writes a user exported from another instance, with its keys and DIDs, and returns its
+// identifier. The vault must not have the user nor its keys and DIDs.
+func (v *Vault) ImportUser(a *backup.Archive) (string, error) {
+
+	if v.db == nil {
+		return "", errors.New("the vault was not opened from its configuration")
+	}
+	if a.Kind != backup.KindUser {
+		return "", fmt.Errorf("the archive is not the export of a user but a %s backup", a.Kind)
+	}
+	ctx := v.dbContext()
+
+	users := a.Table(entmigrate.UsersTable.Name)
+	if users == nil || len(users.Rows) != 1 || len(users.Columns) == 0 || users.Columns[0].Name != user.FieldID {
+		return "", errors.New("the archive does not have a user")
+	}
+	userid, ok := users.Rows[0][0].(string)
+	if !ok {
+		return "", errors.New("the archive does not have a user")
+	}
+	if exists, err := v.Client.User.Query().Where(user.ID(userid)).Exist(ctx); err != nil {
+		return "", err
+	} else if exists {
+		return "", fmt.Errorf("the user %s already exists", userid)
+	}
+
+	tx, err := v.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	counts := map[string]any{}
+	for _, t := range userTables {
+		table := a.Table(t.Name)
+		if table == nil {
+			continue
+		}
+		if err := writeTable(ctx, tx, v.driver, table); err != nil {
+			tx.Rollback()
+			return "", err
+		}
+		counts[t.Name] = len(table.Rows)
+	}
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
+
+	// The rows are written without the client, so the audit hooks do not record them
+	v.Audit(&audit.Entry{
+		Action:     "user.imported",
+		TargetType: "user",
+		Target:     userid,
+		Outcome:    audit.Success,
+		Details:    map[string]any{"rows": counts, "exportedAt": a.CreatedAt.Format(time.RFC3339)},
+	})
+
+	return userid, nil
+}
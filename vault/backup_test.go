@@ -0,0 +1,182 @@
+package vault
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hesusruiz/vcbackend/ent/auditentry"
+	"github.com/hesusruiz/vcbackend/internal/backup"
+	"github.com/hesusruiz/vcutils/yaml"
+)
+
+// openTestDB opens a new in-memory database, without the migrations, and its data source name
+func openTestDB(t *testing.T) (*sql.DB, string) {
+	t.Helper()
+
+	dsn := fmt.Sprintf("file:vault%d?mode=memory&cache=shared&_fk=1", atomic.AddInt64(&testDBCounter, 1))
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	return db, dsn
+}
+
+func TestBackupRestore(t *testing.T) {
+	ctx := context.Background()
+	v := newTestVault(t)
+
+	if _, err := v.CreateUserWithKey("issuer", "Issuer", "legalperson", "pass"); err != nil {
+		t.Fatal(err)
+	}
+	if err := v.SetDIDForUser("issuer", "did:key:issuer"); err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, email := range []string{"john@example.com", "jane@example.com"} {
+		id, _, err := v.CreateCredentialJWTFromMap(map[string]any{
+			"credName":   "PacketDeliveryCredential",
+			"issuerDID":  "issuer",
+			"subjectDID": "did:key:holder",
+			"claims":     map[string]any{"email": email},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, id)
+	}
+	// A credential renewed by the other one, which references it in the same table
+	if err := v.Client.Credential.UpdateOneID(ids[0]).SetSuccessorID(ids[1]).Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := Backup(ctx, v.db, v.driver)
+	if err != nil {
+		t.Fatalf("Backup() error = %v", err)
+	}
+	if a.Table("users") == nil || len(a.Table("credentials").Rows) != 2 || a.Kind != backup.KindVault {
+		t.Fatalf("Backup() = %v", a.Counts())
+	}
+
+	var buf bytes.Buffer
+	if err := backup.Write(&buf, a, "secret"); err != nil {
+		t.Fatal(err)
+	}
+	a, err = backup.Read(&buf, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Restored into a new database, which is migrated by the restore
+	db, dsn := openTestDB(t)
+	if err := Restore(ctx, db, "sqlite3", a); err != nil {
+		t.Fatalf("Restore() error = %v", err)
+	}
+	restored, err := New(yaml.New(map[string]any{
+		"store": map[string]any{"driverName": "sqlite3", "dataSourceName": dsn},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer restored.Client.Close()
+
+	copied, err := Backup(ctx, db, "sqlite3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(copied.Counts(), a.Counts()) {
+		t.Errorf("restored %v, want %v", copied.Counts(), a.Counts())
+	}
+
+	if _, err := restored.CheckPassword("issuer", "pass"); err != nil {
+		t.Errorf("CheckPassword() after Restore = %v", err)
+	}
+	if did, _ := restored.GetDIDForUser("issuer"); did != "did:key:issuer" {
+		t.Errorf("GetDIDForUser() after Restore = %q", did)
+	}
+	cred, err := restored.CredentialByID(ids[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if successor, err := cred.QuerySuccessor().OnlyID(ctx); err != nil || successor != ids[1] {
+		t.Errorf("successor after Restore = %q, %v, want %q", successor, err, ids[1])
+	}
+
+	// The chain of the audit log is the same
+	if _, bad, err := restored.VerifyAudit(); err != nil || bad != 0 {
+		t.Errorf("VerifyAudit() after Restore = %d bad entries, %v", bad, err)
+	}
+
+	if err := Restore(ctx, db, "sqlite3", a); !errors.Is(err, ErrNotEmpty) {
+		t.Errorf("second Restore() = %v, want ErrNotEmpty", err)
+	}
+
+	a.Kind = backup.KindUser
+	if err := Restore(ctx, db, "sqlite3", a); err == nil {
+		t.Error("Restore() of a user export should fail")
+	}
+}
+
+func TestExportImportUser(t *testing.T) {
+	ctx := context.Background()
+	v := newTestVault(t)
+
+	if _, err := v.CreateUserWithKey("issuer", "Issuer", "legalperson", "pass"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.NewKeyForUser("issuer"); err != nil {
+		t.Fatal(err)
+	}
+	if err := v.SetDIDForUser("issuer", "did:key:issuer"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.CreateUser("other", "Other", "legalperson", "pass"); err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := v.ExportUser("issuer")
+	if err != nil {
+		t.Fatalf("ExportUser() error = %v", err)
+	}
+	want := map[string]int{"users": 1, "private_keys": 2, "public_keys": 2, "di_ds": 1}
+	if !reflect.DeepEqual(a.Counts(), want) {
+		t.Errorf("ExportUser() = %v, want %v", a.Counts(), want)
+	}
+	if _, err := v.ExportUser("unknown"); err == nil {
+		t.Error("ExportUser() of an unknown user should fail")
+	}
+
+	target := newTestVault(t)
+	userid, err := target.ImportUser(a)
+	if err != nil || userid != "issuer" {
+		t.Fatalf("ImportUser() = %q, %v", userid, err)
+	}
+
+	keys, _ := v.PrivateKeysForUser("issuer")
+	imported, err := target.PrivateKeysForUser("issuer")
+	if err != nil || len(imported) != len(keys) || imported[0].GetKid() != keys[0].GetKid() {
+		t.Errorf("PrivateKeysForUser() after ImportUser = %d keys, %v", len(imported), err)
+	}
+	if did, _ := target.GetDIDForUser("issuer"); did != "did:key:issuer" {
+		t.Errorf("GetDIDForUser() after ImportUser = %q", did)
+	}
+	if usr, _ := target.UserByID("other"); usr != nil {
+		t.Error("ImportUser() imported another user")
+	}
+
+	// The import is recorded in the audit log of the target
+	if n, _ := target.Client.AuditEntry.Query().Where(auditentry.Action("user.imported"), auditentry.Target("issuer")).Count(ctx); n != 1 {
+		t.Errorf("%d audit entries of the import, want 1", n)
+	}
+
+	if _, err := target.ImportUser(a); err == nil {
+		t.Error("ImportUser() of an existing user should fail")
+	}
+}
@@ -0,0 +1,103 @@
+package vault
+
+import (
+	"crypto/x509"
+	"errors"
+	"time"
+
+	"github.com/hesusruiz/vcbackend/ent"
+	"github.com/hesusruiz/vcbackend/ent/clientcertificate"
+	"github.com/hesusruiz/vcbackend/internal/audit"
+	"github.com/hesusruiz/vcbackend/internal/tlsconfig"
+)
+
+var (
+	ErrClientCertificateNotFound = errors.New("client certificate not registered")
+	ErrClientCertificateExpired  = errors.New("client certificate expired")
+	ErrClientCertificateExists   = errors.New("client certificate already registered")
+)
+
+// AddClientCertificate registers a certificate of a client authenticated with mutual TLS, which
+// authenticates as the user. The user can be one of the vault, like an operator of the issuer, or
+// one of the configuration, like the administrator.
+func (v *Vault) AddClientCertificate(user string, cert *x509.Certificate) (*ent.ClientCertificate, error) {
+
+	if len(user) == 0 {
+		return nil, errors.New("the user of the certificate is required")
+	}
+	if time.Now().After(cert.NotAfter) {
+		return nil, ErrClientCertificateExpired
+	}
+
+	fingerprint := tlsconfig.Fingerprint(cert)
+	entry, err := v.Client.ClientCertificate.Create().
+		SetID(fingerprint).
+		SetUser(user).
+		SetSubject(cert.Subject.String()).
+		SetNotAfter(cert.NotAfter).
+		Save(v.dbContext())
+	if ent.IsConstraintError(err) {
+		return nil, ErrClientCertificateExists
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	v.Audit(&audit.Entry{
+		Action:     "clientcert.added",
+		TargetType: "user",
+		Target:     user,
+		Outcome:    audit.Success,
+		Details:    map[string]any{"fingerprint": fingerprint, "subject": entry.Subject},
+	})
+	return entry, nil
+}
+
+// ClientCertificateUser returns the user of a certificate, given its fingerprint
+func (v *Vault) ClientCertificateUser(fingerprint string) (string, error) {
+
+	entry, err := v.Client.ClientCertificate.Get(v.dbContext(), fingerprint)
+	if ent.IsNotFound(err) {
+		return "", ErrClientCertificateNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+	if time.Now().After(entry.NotAfter) {
+		return "", ErrClientCertificateExpired
+	}
+	return entry.User, nil
+}
+
+// ClientCertificates returns the certificates registered for a user, or for all the users if it is empty
+func (v *Vault) ClientCertificates(user string) ([]*ent.ClientCertificate, error) {
+	query := v.Client.ClientCertificate.Query()
+	if len(user) > 0 {
+		query = query.Where(clientcertificate.User(user))
+	}
+	return query.Order(ent.Asc(clientcertificate.FieldUser), ent.Asc(clientcertificate.FieldCreatedAt)).All(v.dbContext())
+}
+
+// RemoveClientCertificate removes the registration of a certificate, given its fingerprint, and returns it
+func (v *Vault) RemoveClientCertificate(fingerprint string) (*ent.ClientCertificate, error) {
+
+	entry, err := v.Client.ClientCertificate.Get(v.dbContext(), fingerprint)
+	if ent.IsNotFound(err) {
+		return nil, ErrClientCertificateNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := v.Client.ClientCertificate.DeleteOneID(fingerprint).Exec(v.dbContext()); err != nil {
+		return nil, err
+	}
+
+	v.Audit(&audit.Entry{
+		Action:     "clientcert.removed",
+		TargetType: "user",
+		Target:     entry.User,
+		Outcome:    audit.Success,
+		Details:    map[string]any{"fingerprint": fingerprint},
+	})
+	return entry, nil
+}
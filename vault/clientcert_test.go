@@ -0,0 +1,94 @@
+package vault
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hesusruiz/vcbackend/ent/auditentry"
+	"github.com/hesusruiz/vcbackend/internal/tlsconfig"
+)
+
+// newClientCert creates a self-signed certificate of a client, valid until the time
+func newClientCert(t *testing.T, name string, notAfter time.Time) *x509.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name, Organization: []string{"Example"}},
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+func TestClientCertificates(t *testing.T) {
+	ctx := context.Background()
+	v := newTestVault(t)
+
+	cert := newClientCert(t, "orders", time.Now().Add(time.Hour))
+	fingerprint := tlsconfig.Fingerprint(cert)
+
+	if _, err := v.ClientCertificateUser(fingerprint); !errors.Is(err, ErrClientCertificateNotFound) {
+		t.Errorf("ClientCertificateUser() of an unknown certificate error = %v", err)
+	}
+
+	entry, err := v.AddClientCertificate("operator", cert)
+	if err != nil || entry.ID != fingerprint || entry.Subject != "CN=orders,O=Example" {
+		t.Fatalf("AddClientCertificate() = %v, %v", entry, err)
+	}
+	if user, err := v.ClientCertificateUser(fingerprint); err != nil || user != "operator" {
+		t.Errorf("ClientCertificateUser() = %q, %v", user, err)
+	}
+	if _, err := v.AddClientCertificate("admin", cert); !errors.Is(err, ErrClientCertificateExists) {
+		t.Errorf("AddClientCertificate() of a registered certificate error = %v", err)
+	}
+	if _, err := v.AddClientCertificate("admin", newClientCert(t, "old", time.Now().Add(-time.Hour))); !errors.Is(err, ErrClientCertificateExpired) {
+		t.Errorf("AddClientCertificate() of an expired certificate error = %v", err)
+	}
+
+	if _, err := v.AddClientCertificate("admin", newClientCert(t, "admin", time.Now().Add(time.Hour))); err != nil {
+		t.Fatal(err)
+	}
+	if list, _ := v.ClientCertificates("operator"); len(list) != 1 || list[0].ID != fingerprint {
+		t.Errorf("ClientCertificates() of the operator = %v", list)
+	}
+	if list, _ := v.ClientCertificates(""); len(list) != 2 {
+		t.Errorf("ClientCertificates() = %d certificates, want 2", len(list))
+	}
+
+	if removed, err := v.RemoveClientCertificate(fingerprint); err != nil || removed.User != "operator" {
+		t.Fatalf("RemoveClientCertificate() = %v, %v", removed, err)
+	}
+	if _, err := v.ClientCertificateUser(fingerprint); !errors.Is(err, ErrClientCertificateNotFound) {
+		t.Errorf("ClientCertificateUser() after RemoveClientCertificate = %v", err)
+	}
+	if _, err := v.RemoveClientCertificate(fingerprint); !errors.Is(err, ErrClientCertificateNotFound) {
+		t.Errorf("second RemoveClientCertificate() error = %v", err)
+	}
+
+	// The changes of the certificates are recorded in the audit log
+	if n, _ := v.Client.AuditEntry.Query().Where(auditentry.ActionHasPrefix("clientcert.")).Count(ctx); n != 3 {
+		t.Errorf("%d audit entries of the certificates, want 3", n)
+	}
+}
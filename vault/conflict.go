@@ -0,0 +1,39 @@
+package vault
+
+import (
+	"errors"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/hesusruiz/vcbackend/ent"
+	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
+)
+
+// isConflict returns true if the error is caused by a concurrent transaction, like the insertion of the
+// same key, a lock of the database or a deadlock, so the transaction can be retried
+func isConflict(err error) bool {
+
+	if ent.IsConstraintError(err) {
+		return true
+	}
+
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.Code == sqlite3.ErrBusy || sqliteErr.Code == sqlite3.ErrLocked ||
+			sqliteErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey || sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		// serialization_failure, deadlock_detected and unique_violation
+		return pqErr.Code == "40001" || pqErr.Code == "40P01" || pqErr.Code == "23505"
+	}
+
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		// ER_LOCK_WAIT_TIMEOUT, ER_LOCK_DEADLOCK and ER_DUP_ENTRY
+		return mysqlErr.Number == 1205 || mysqlErr.Number == 1213 || mysqlErr.Number == 1062
+	}
+
+	return false
+}
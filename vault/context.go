@@ -0,0 +1,29 @@
+package vault
+
+import (
+	"context"
+
+	"github.com/hesusruiz/vcbackend/internal/logging"
+	"go.uber.org/zap"
+)
+
+// WithContext returns a copy of the vault which uses the context for the operations with the database
+// and the signatures, so they are traced as part of the request with the context
+func (v *Vault) WithContext(ctx context.Context) *Vault {
+	c := *v
+	c.ctx = ctx
+	return &c
+}
+
+// dbContext returns the context for the operations with the database
+func (v *Vault) dbContext() context.Context {
+	if v.ctx != nil {
+		return v.ctx
+	}
+	return context.Background()
+}
+
+// logger returns the logger of the context of the vault, which has the id of the request if there is one
+func (v *Vault) logger() *zap.SugaredLogger {
+	return logging.FromContext(v.dbContext()).Named("vault")
+}
@@ -1,20 +1,23 @@
 package vault
 
 import (
-	"bytes"
-	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"text/template"
+	"strings"
+	"time"
 
-	"github.com/Masterminds/sprig/v3"
 	"github.com/google/uuid"
 	"github.com/hesusruiz/vcbackend/ent"
+	"github.com/hesusruiz/vcbackend/ent/did"
+	"github.com/hesusruiz/vcbackend/internal/didkey"
+	"github.com/hesusruiz/vcbackend/internal/holder"
+	"github.com/hesusruiz/vcbackend/internal/issuance"
 	"github.com/hesusruiz/vcbackend/internal/jwk"
 	"github.com/hesusruiz/vcbackend/internal/jwt"
 	"github.com/hesusruiz/vcutils/yaml"
-	zlog "github.com/rs/zerolog/log"
 	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
 )
 
 type CredentialData struct {
@@ -27,14 +30,34 @@ type CredentialData struct {
 	Family_name        string `json:"family_name"`
 	Preferred_username string `json:"preferred_username"`
 	Email              string `json:"email"`
+	IssuanceDate       string `json:"issuanceDate"`
+	ValidFrom          string `json:"validFrom"`
+	ExpirationDate     string `json:"expirationDate"`
+	Iat                int64  `json:"iat"`
+	Nbf                int64  `json:"nbf"`
+	Exp                int64  `json:"exp"`
 }
 
-var t *template.Template
+// setDates sets the dates computed by the issuance policy in the credential data
+func (credData *CredentialData) setDates(d issuance.Dates) {
+	credData.IssuanceDate = d.IssuanceDate.Format(time.RFC3339)
+	credData.ValidFrom = d.ValidFrom.Format(time.RFC3339)
+	credData.ExpirationDate = d.ExpirationDate.Format(time.RFC3339)
+	credData.Iat = d.IssuanceDate.Unix()
+	credData.Nbf = d.ValidFrom.Unix()
+	credData.Exp = d.ExpirationDate.Unix()
+}
 
-func init() {
+// IssuanceDates computes the dates of a new credential of the given type, according to the
+// issuance policy for the type and the dates requested in the credential data, if any
+func (v *Vault) IssuanceDates(credType string, credmap map[string]any) (issuance.Dates, error) {
 
-	t = template.Must(template.New("base").Funcs(sprig.TxtFuncMap()).ParseGlob("vault/templates/*.tpl"))
+	req, err := issuance.RequestFromMap(credmap)
+	if err != nil {
+		return issuance.Dates{}, fmt.Errorf("%w: %v", issuance.ErrPolicyViolation, err)
+	}
 
+	return v.Policies.Compute(credType, time.Now(), req)
 }
 
 func (v *Vault) TestCred(credData *CredentialData) (rawJsonCred json.RawMessage, err error) {
@@ -48,22 +71,25 @@ func (v *Vault) TestCred(credData *CredentialData) (rawJsonCred json.RawMessage,
 	// Set the unique id in the credential
 	credData.Jti = jti.String()
 
-	// Generate the credential from the template
-	var b bytes.Buffer
-	err = t.ExecuteTemplate(&b, credData.CredName, credData)
+	// Set the dates according to the issuance policy
+	dates, err := v.Policies.Compute(credData.CredName, time.Now(), issuance.Request{})
 	if err != nil {
 		return nil, err
 	}
+	credData.setDates(dates)
 
-	// The serialized credential
-	rawJsonCred = b.Bytes()
+	// Generate the credential from the template
+	rawJsonCred, _, err = v.renderPublished(credData.CredName, credData)
+	if err != nil {
+		return nil, err
+	}
 
 	// Validate the generated JSON, just in case the template is malformed
-	if !gjson.ValidBytes(b.Bytes()) {
-		zlog.Error().Msg("Error validating JSON")
+	if !gjson.ValidBytes(rawJsonCred) {
+		v.logger().Errorw("invalid JSON generated by the template", "credName", credData.CredName)
 		return nil, nil
 	}
-	m, ok := gjson.ParseBytes(b.Bytes()).Value().(map[string]interface{})
+	m, ok := gjson.ParseBytes(rawJsonCred).Value().(map[string]interface{})
 	if !ok {
 		return nil, nil
 	}
@@ -73,7 +99,7 @@ func (v *Vault) TestCred(credData *CredentialData) (rawJsonCred json.RawMessage,
 		return nil, err
 	}
 
-	zlog.Info().Msgf("Value: %T\n\n%v", rj, string(rj))
+	v.logger().Debugw("credential generated", "credential", rj)
 
 	// cc := make(map[string]any)
 
@@ -87,6 +113,12 @@ func (v *Vault) CreateCredentialJWTFromMap(credmap map[string]any) (credID strin
 
 	credData := yaml.New(credmap)
 
+	// Keep the data received, so the credential can be re-issued when it expires
+	issuanceData := make(map[string]any, len(credmap))
+	for k, val := range credmap {
+		issuanceData[k] = val
+	}
+
 	// Return error if the issuer does not exist
 	issuer := credData.String("issuerDID")
 	iss, err := v.UserByID(issuer)
@@ -135,46 +167,75 @@ func (v *Vault) CreateCredentialJWTFromMap(credmap map[string]any) (credID strin
 
 	credentialID := credmap["jti"].(string)
 
-	// Generate the credential from the template
-	var b bytes.Buffer
-	err = t.ExecuteTemplate(&b, credData.String("credName"), credmap)
+	// Compute the dates according to the issuance policy for the type of credential,
+	// and make them available to the template
+	dates, err := v.IssuanceDates(credData.String("credName"), credmap)
+	if err != nil {
+		v.logger().Errorw("failed computing the issuance dates", zap.Error(err))
+		return "", nil, err
+	}
+	for k, val := range dates.Fields() {
+		credmap[k] = val
+	}
+
+	// Generate the credential from the published version of the template
+	rawJSONCred, tpl, err := v.renderPublished(credData.String("credName"), credmap)
 	if err != nil {
-		zlog.Logger.Error().Err(err).Send()
+		v.logger().Errorw("failed rendering the template", zap.Error(err))
 		return "", nil, err
 	}
 
-	// The serialized credential
-	fmt.Println("**** Serialized Credential ****")
-	rawJSONCred = b.Bytes()
-	fmt.Printf("%v\n\n", string(rawJSONCred))
-	fmt.Println("**** End Serialized Credential ****")
+	v.logger().Debugw("credential generated", "credential", rawJSONCred)
 
 	// Parse the resulting byte string
 	data, err := yaml.ParseYamlBytes(rawJSONCred)
 	if err != nil {
-		zlog.Logger.Error().Err(err).Send()
+		v.logger().Errorw("invalid credential generated by the template", zap.Error(err))
 		return "", nil, err
 	}
 
+	// Enforce the dates, independently of what the template generated
+	claims, ok := data.Data().(map[string]any)
+	if !ok {
+		return "", nil, fmt.Errorf("the credential generated by the template is not an object")
+	}
+	dates.Apply(claims)
+
+	// Bind the credential to the holder, independently of what the template generated
+	if subjectDID := credData.String("subjectDID"); len(subjectDID) > 0 {
+		holder.Apply(claims, subjectDID)
+	}
+
+	// The issuer is identified by the DID of the signing key, so the verifiers can check the signature
+	// with the key resolved from the issuer of the credential
+	issuerDID, err := KeyDID(privateJWK)
+	if err != nil {
+		return "", nil, err
+	}
+	applyIssuer(claims, issuerDID)
+
 	// Sign the credential data with the private key
-	signedString, err := v.SignWithJWK(privateJWK, data.Data())
+	signedString, err := v.SignWithJWK(privateJWK, claims)
 	if err != nil {
 		return "", nil, err
 	}
 
 	_, err = v.CredentialFromJWT(signedString)
 	if err != nil {
-		zlog.Logger.Error().Err(err).Send()
+		v.logger().Errorw("invalid signed credential", zap.Error(err))
 		return "", nil, err
 	}
 
-	// Store credential
+	// Store credential, linked to the template version which produced it
 	_, err = v.Client.Credential.Create().
 		SetID(credentialID).
 		SetRaw([]uint8(signedString)).
-		Save(context.Background())
+		SetTemplate(tpl).
+		SetExpiresAt(dates.ExpirationDate).
+		SetIssuanceData(issuanceData).
+		Save(v.dbContext())
 	if err != nil {
-		zlog.Logger.Error().Err(err).Send()
+		v.logger().Errorw("failed storing credential", zap.Error(err))
 		return "", nil, err
 	}
 
@@ -182,6 +243,79 @@ func (v *Vault) CreateCredentialJWTFromMap(credmap map[string]any) (credID strin
 
 }
 
+// KeyDID returns the did:key of the public key of a signing key
+func KeyDID(key *jwk.JWK) (string, error) {
+	pub, err := key.GetPublicKey()
+	if err != nil {
+		return "", err
+	}
+	id, err := didkey.FromPublicKey(pub)
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
+
+// SigningDID returns the DID of the key used by default to sign the credentials of the user,
+// which is the issuer of those credentials
+func (v *Vault) SigningDID(userid string) (string, error) {
+	keys, err := v.PrivateKeysForUser(userid)
+	if err != nil {
+		return "", err
+	}
+	return KeyDID(keys[0])
+}
+
+// ErrNoKeyForDID is returned when the vault does not have the private key of a DID
+var ErrNoKeyForDID = errors.New("the vault has no key of the DID")
+
+// KeyForDID returns the private key of a DID of the user: the key of a did:key, or the signing key
+// of the user for the other methods. The DIDs of other users are not found.
+func (v *Vault) KeyForDID(userid string, didID string) (*jwk.JWK, error) {
+
+	entry, err := v.Client.DID.Query().Where(did.ID(didID)).WithUser().Only(v.dbContext())
+	if ent.IsNotFound(err) || (err == nil && (entry.Edges.User == nil || entry.Edges.User.ID != userid)) {
+		return nil, fmt.Errorf("%w: %s", ErrNoKeyForDID, didID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := v.PrivateKeysForUser(entry.Edges.User.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(didID, didkey.KeyPrefix+":") {
+		return keys[0], nil
+	}
+	for _, k := range keys {
+		if keyDID, err := KeyDID(k); err == nil && keyDID == didID {
+			return k, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrNoKeyForDID, didID)
+}
+
+// applyIssuer sets the DID of the issuer in a credential, as the issuer of the JWT and, if the template
+// generated one, as the issuer of the credential, either inside the "vc" claim or at the top level.
+// The other properties of an issuer object, like its name, are kept.
+func applyIssuer(cred map[string]any, did string) {
+
+	cred["iss"] = did
+
+	target := cred
+	if vc, ok := cred["vc"].(map[string]any); ok {
+		target = vc
+	}
+
+	switch issuer := target["issuer"].(type) {
+	case map[string]any:
+		issuer["id"] = did
+	case string:
+		target["issuer"] = did
+	}
+}
+
 type CredRawData struct {
 	Id      string `json:"id,omitempty"`
 	Type    string `json:"type,omitempty"`
@@ -190,7 +324,7 @@ type CredRawData struct {
 
 func (v *Vault) GetAllCredentials() (creds []*CredRawData) {
 
-	entCredentials, err := v.Client.Credential.Query().All(context.Background())
+	entCredentials, err := v.Client.Credential.Query().All(v.dbContext())
 	if err != nil {
 		return nil
 	}
@@ -212,7 +346,7 @@ func (v *Vault) GetAllCredentials() (creds []*CredRawData) {
 func (v *Vault) CreateOrGetCredential(credData *CredentialData) (rawJsonCred json.RawMessage, err error) {
 
 	// Check if the credential already exists
-	cred, err := v.Client.Credential.Get(context.Background(), credData.Jti)
+	cred, err := v.Client.Credential.Get(v.dbContext(), credData.Jti)
 	if err == nil {
 		// Credential found, just return it
 		return cred.Raw, nil
@@ -222,27 +356,32 @@ func (v *Vault) CreateOrGetCredential(credData *CredentialData) (rawJsonCred jso
 		return nil, err
 	}
 
-	// Generate the credential from the template
-	var b bytes.Buffer
-	err = t.ExecuteTemplate(&b, credData.CredName, credData)
+	// Set the dates according to the issuance policy
+	dates, err := v.Policies.Compute(credData.CredName, time.Now(), issuance.Request{})
 	if err != nil {
 		return nil, err
 	}
+	credData.setDates(dates)
 
-	// The serialized credential
-	rawJsonCred = b.Bytes()
+	// Generate the credential from the template
+	rawJsonCred, tpl, err := v.renderPublished(credData.CredName, credData)
+	if err != nil {
+		return nil, err
+	}
 
 	// Store in DB
 	_, err = v.Client.Credential.
 		Create().
 		SetID(credData.Jti).
 		SetRaw(rawJsonCred).
-		Save(context.Background())
+		SetTemplate(tpl).
+		SetExpiresAt(dates.ExpirationDate).
+		Save(v.dbContext())
 	if err != nil {
-		zlog.Error().Err(err).Msg("failed storing credential")
+		v.logger().Errorw("failed storing credential", zap.Error(err))
 		return nil, err
 	}
-	zlog.Info().Str("jti", credData.Jti).Msg("credential created")
+	v.logger().Infow("credential created", "jti", credData.Jti)
 
 	return rawJsonCred, nil
 
@@ -260,7 +399,7 @@ func (v *Vault) CredentialFromJWT(credSerialized string) (rawJsonCred json.RawMe
 	// Parse the serialized string into the structure, no signature validation yet
 	token, err := jwt.NewParser().ParseUnverified2(credSerialized, cred)
 	if err != nil {
-		zlog.Logger.Error().Err(err).Send()
+		v.logger().Errorw("failed parsing the credential", zap.Error(err))
 		return nil, err
 	}
 
@@ -273,16 +412,11 @@ func (v *Vault) CredentialFromJWT(credSerialized string) (rawJsonCred json.RawMe
 	// Verify the signature
 	err = v.VerifySignature(token.ToBeSignedString, token.Signature, token.Alg(), token.Kid())
 	if err != nil {
-		zlog.Logger.Error().Err(err).Send()
+		v.logger().Errorw("invalid signature of the credential", zap.Error(err))
 		return nil, err
 	}
 
-	// Display the formatted JSON structure
-	st := map[string]any{}
-	json.Unmarshal(token.ClaimBytes, &st)
-	if out, err := json.MarshalIndent(st, "", "   "); err == nil {
-		zlog.Debug().Msg(string(out))
-	}
+	v.logger().Debugw("credential decoded", "claims", json.RawMessage(token.ClaimBytes))
 
 	return nil, nil
 
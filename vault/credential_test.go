@@ -0,0 +1,50 @@
+package vault
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestCreateCredentialBindsHolder(t *testing.T) {
+	v := newTestVault(t)
+
+	if _, err := v.CreateUserWithKey("issuer", "Issuer", "legalperson", "pass"); err != nil {
+		t.Fatal(err)
+	}
+
+	_, raw, err := v.CreateCredentialJWTFromMap(map[string]any{
+		"credName":   "PacketDeliveryCredential",
+		"issuerDID":  "issuer",
+		"subjectDID": "did:key:holder",
+		"claims": map[string]any{
+			"given_name":  "John",
+			"family_name": "Doe",
+			"email":       "john@example.com",
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateCredentialJWTFromMap() error = %v", err)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.Split(string(raw), ".")[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var claims struct {
+		Sub string `json:"sub"`
+		VC  struct {
+			CredentialSubject struct {
+				ID string `json:"id"`
+			} `json:"credentialSubject"`
+		} `json:"vc"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		t.Fatal(err)
+	}
+
+	if claims.Sub != "did:key:holder" || claims.VC.CredentialSubject.ID != "did:key:holder" {
+		t.Errorf("credential not bound to the holder: sub=%q id=%q", claims.Sub, claims.VC.CredentialSubject.ID)
+	}
+}
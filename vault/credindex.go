@@ -0,0 +1,361 @@
+package vault
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/hesusruiz/vcbackend/ent"
+	"github.com/hesusruiz/vcbackend/ent/credential"
+	"github.com/hesusruiz/vcbackend/ent/hook"
+	"github.com/hesusruiz/vcbackend/ent/predicate"
+	"github.com/hesusruiz/vcbackend/internal/logging"
+	"github.com/hesusruiz/vcbackend/internal/vc"
+	"go.uber.org/zap"
+)
+
+// Limits for the size of a page of credentials
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// credentialMetadata is the data extracted from a credential for searching
+type credentialMetadata struct {
+	Types       []string
+	Type        string
+	Issuer      string
+	Subject     string
+	HolderEmail string
+	IssuedAt    *time.Time
+	ExpiresAt   *time.Time
+}
+
+// extractMetadata parses a credential, either a JWT or a JSON-LD credential, and returns its metadata
+func extractMetadata(raw []byte) (*credentialMetadata, error) {
+
+	cred, err := vc.Decode(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &credentialMetadata{
+		Types:       cred.Types(),
+		Type:        cred.Type(),
+		Issuer:      cred.Issuer(),
+		Subject:     cred.SubjectID(),
+		HolderEmail: vc.FirstString(cred.Subject()["email"]),
+		IssuedAt:    cred.IssuedAt(),
+		ExpiresAt:   cred.ExpiresAt(),
+	}, nil
+}
+
+// applyMetadata sets the metadata in a create or update mutation of a credential
+func (meta *credentialMetadata) applyMetadata(m *ent.CredentialMutation) {
+	m.SetTypes(meta.Types)
+	m.SetCredentialType(meta.Type)
+	m.SetIssuer(meta.Issuer)
+	m.SetSubject(meta.Subject)
+	m.SetHolderEmail(meta.HolderEmail)
+	if meta.IssuedAt != nil {
+		m.SetIssuedAt(*meta.IssuedAt)
+	}
+	if _, set := m.ExpiresAt(); !set && meta.ExpiresAt != nil {
+		m.SetExpiresAt(*meta.ExpiresAt)
+	}
+}
+
+// templateName returns the name of the template used to generate a credential
+func templateName(ctx context.Context, m *ent.CredentialMutation) string {
+	if id, ok := m.TemplateID(); ok {
+		if tpl, err := m.Client().CredentialTemplate.Get(ctx, id); err == nil {
+			return tpl.Name
+		}
+	}
+	if data, ok := m.IssuanceData(); ok {
+		return vc.FirstString(data["templateId"], data["credName"])
+	}
+	return ""
+}
+
+// indexCredential is a hook which extracts the metadata of the credentials when they are stored
+func indexCredential(next ent.Mutator) ent.Mutator {
+	return hook.CredentialFunc(func(ctx context.Context, m *ent.CredentialMutation) (ent.Value, error) {
+		if raw, ok := m.Raw(); ok {
+			meta, err := extractMetadata(raw)
+			if err != nil {
+				logging.FromContext(ctx).Named("vault").Warnw("credential can not be indexed", zap.Error(err))
+			} else {
+				meta.applyMetadata(m)
+			}
+			m.SetTemplateName(templateName(ctx, m))
+			m.SetIndexedAt(time.Now())
+		}
+		return next.Mutate(ctx, m)
+	})
+}
+
+// backfillCredentialIndex extracts the metadata of the credentials stored before they were indexed
+func (v *Vault) backfillCredentialIndex() error {
+
+	creds, err := v.Client.Credential.Query().
+		Where(credential.IndexedAtIsNil()).
+		WithTemplate().
+		All(v.dbContext())
+	if err != nil {
+		return err
+	}
+
+	for _, cred := range creds {
+		update := cred.Update().SetIndexedAt(time.Now())
+
+		meta, err := extractMetadata(cred.Raw)
+		if err != nil {
+			v.logger().Warnw("credential can not be indexed", "id", cred.ID, zap.Error(err))
+		} else {
+			if cred.ExpiresAt != nil {
+				meta.ExpiresAt = nil
+			}
+			meta.applyMetadata(update.Mutation())
+		}
+
+		if cred.Edges.Template != nil {
+			update.SetTemplateName(cred.Edges.Template.Name)
+		} else {
+			update.SetTemplateName(vc.FirstString(cred.IssuanceData["templateId"], cred.IssuanceData["credName"]))
+		}
+
+		if err := update.Exec(v.dbContext()); err != nil {
+			return err
+		}
+	}
+
+	if len(creds) > 0 {
+		v.logger().Infow("credential index backfilled", "credentials", len(creds))
+	}
+	return nil
+}
+
+// CredentialQuery are the filters, sorting and page of a credential search.
+// All filters are optional, and Text searches in the id, type, issuer, subject, email and template.
+type CredentialQuery struct {
+	Type          string
+	Subject       string
+	Status        string
+	ExpiresAfter  *time.Time
+	ExpiresBefore *time.Time
+	Text          string
+	Sort          string // created_at (default), issued_at or expires_at
+	Desc          bool
+	Limit         int
+	Cursor        string
+}
+
+// CredentialSummary is the indexed metadata of a credential
+type CredentialSummary struct {
+	ID           string     `json:"id"`
+	Format       string     `json:"format"`
+	Type         string     `json:"type,omitempty"`
+	Types        []string   `json:"types,omitempty"`
+	Issuer       string     `json:"issuer,omitempty"`
+	Subject      string     `json:"subject,omitempty"`
+	HolderEmail  string     `json:"holderEmail,omitempty"`
+	Template     string     `json:"template,omitempty"`
+	Status       string     `json:"status"`
+	IssuedAt     *time.Time `json:"issuedAt,omitempty"`
+	ExpiresAt    *time.Time `json:"expiresAt,omitempty"`
+	RenewalDueAt *time.Time `json:"renewalDueAt,omitempty"`
+	CreatedAt    time.Time  `json:"createdAt"`
+}
+
+// CredentialPage is a page of the results of a credential search. NextCursor is empty in the last page.
+type CredentialPage struct {
+	Items      []*CredentialSummary `json:"items"`
+	NextCursor string               `json:"nextCursor,omitempty"`
+}
+
+// pageCursor is the position after the last credential of a page: the value of the sort field and the id
+type pageCursor struct {
+	Sort  string     `json:"s"`
+	Desc  bool       `json:"d,omitempty"`
+	Value *time.Time `json:"v,omitempty"`
+	ID    string     `json:"id"`
+}
+
+func (c *pageCursor) encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(s string) (*pageCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	c := &pageCursor{}
+	if err := json.Unmarshal(b, c); err != nil || len(c.ID) == 0 {
+		return nil, ErrInvalidCursor
+	}
+	return c, nil
+}
+
+// SearchCredentials returns a page of the credentials matching the query. The results are sorted
+// by the sort field and then by id, with the credentials without a value for the field at the end.
+func (v *Vault) SearchCredentials(q CredentialQuery) (*CredentialPage, error) {
+
+	switch q.Sort {
+	case "":
+		q.Sort = credential.FieldCreatedAt
+	case credential.FieldCreatedAt, credential.FieldIssuedAt, credential.FieldExpiresAt:
+	default:
+		return nil, fmt.Errorf("invalid sort field %q", q.Sort)
+	}
+	if q.Limit <= 0 {
+		q.Limit = DefaultPageSize
+	}
+	if q.Limit > MaxPageSize {
+		q.Limit = MaxPageSize
+	}
+
+	query := v.Client.Credential.Query()
+
+	if len(q.Type) > 0 {
+		query = query.Where(credential.CredentialType(q.Type))
+	}
+	if len(q.Subject) > 0 {
+		query = query.Where(credential.Subject(q.Subject))
+	}
+	if len(q.Status) > 0 {
+		if err := credential.StatusValidator(credential.Status(q.Status)); err != nil {
+			return nil, err
+		}
+		query = query.Where(credential.StatusEQ(credential.Status(q.Status)))
+	}
+	if q.ExpiresAfter != nil {
+		query = query.Where(credential.ExpiresAtGTE(*q.ExpiresAfter))
+	}
+	if q.ExpiresBefore != nil {
+		query = query.Where(credential.ExpiresAtLT(*q.ExpiresBefore))
+	}
+	if text := strings.TrimSpace(q.Text); len(text) > 0 {
+		query = query.Where(credential.Or(
+			predicate.Credential(func(s *sql.Selector) {
+				s.Where(sql.ContainsFold(s.C(credential.FieldID), text))
+			}),
+			credential.CredentialTypeContainsFold(text),
+			credential.IssuerContainsFold(text),
+			credential.SubjectContainsFold(text),
+			credential.HolderEmailContainsFold(text),
+			credential.TemplateNameContainsFold(text),
+		))
+	}
+
+	// Continue after the last credential of the previous page
+	if len(q.Cursor) > 0 {
+		cursor, err := decodeCursor(q.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		if cursor.Sort != q.Sort || cursor.Desc != q.Desc {
+			return nil, fmt.Errorf("%w: the cursor is for a different sort order", ErrInvalidCursor)
+		}
+		query = query.Where(afterCursor(cursor))
+	}
+
+	creds, err := query.
+		Order(orderNullsLast(q.Sort, q.Desc)).
+		Limit(q.Limit + 1).
+		All(v.dbContext())
+	if err != nil {
+		return nil, err
+	}
+
+	page := &CredentialPage{Items: []*CredentialSummary{}}
+	for i, cred := range creds {
+		if i == q.Limit {
+			last := creds[i-1]
+			page.NextCursor = (&pageCursor{Sort: q.Sort, Desc: q.Desc, Value: sortValue(last, q.Sort), ID: last.ID}).encode()
+			break
+		}
+		page.Items = append(page.Items, Summary(cred))
+	}
+
+	return page, nil
+}
+
+// Summary returns the indexed metadata of a credential
+func Summary(cred *ent.Credential) *CredentialSummary {
+	return &CredentialSummary{
+		ID:           cred.ID,
+		Format:       cred.Type,
+		Type:         cred.CredentialType,
+		Types:        cred.Types,
+		Issuer:       cred.Issuer,
+		Subject:      cred.Subject,
+		HolderEmail:  cred.HolderEmail,
+		Template:     cred.TemplateName,
+		Status:       string(cred.Status),
+		IssuedAt:     cred.IssuedAt,
+		ExpiresAt:    cred.ExpiresAt,
+		RenewalDueAt: cred.RenewalDueAt,
+		CreatedAt:    cred.CreatedAt,
+	}
+}
+
+func sortValue(cred *ent.Credential, field string) *time.Time {
+	switch field {
+	case credential.FieldIssuedAt:
+		return cred.IssuedAt
+	case credential.FieldExpiresAt:
+		return cred.ExpiresAt
+	default:
+		return &cred.CreatedAt
+	}
+}
+
+// orderNullsLast sorts by the field and the id, with the null values of the field at the end
+func orderNullsLast(field string, desc bool) ent.OrderFunc {
+	return func(s *sql.Selector) {
+		s.OrderExpr(sql.ExprP(s.C(field) + " IS NULL"))
+		if desc {
+			s.OrderBy(sql.Desc(s.C(field)), sql.Desc(s.C(credential.FieldID)))
+		} else {
+			s.OrderBy(sql.Asc(s.C(field)), sql.Asc(s.C(credential.FieldID)))
+		}
+	}
+}
+
+// afterCursor selects the credentials after the cursor, in the order of orderNullsLast
+func afterCursor(c *pageCursor) predicate.Credential {
+	return func(s *sql.Selector) {
+		field, id := s.C(c.Sort), s.C(credential.FieldID)
+
+		idAfter := sql.GT(id, c.ID)
+		if c.Desc {
+			idAfter = sql.LT(id, c.ID)
+		}
+
+		// After a null value, only other null values with a greater id
+		if c.Value == nil {
+			s.Where(sql.And(sql.IsNull(field), idAfter))
+			return
+		}
+
+		valueAfter := sql.GT(field, *c.Value)
+		if c.Desc {
+			valueAfter = sql.LT(field, *c.Value)
+		}
+		s.Where(sql.Or(
+			valueAfter,
+			sql.And(sql.EQ(field, *c.Value), idAfter),
+			sql.IsNull(field),
+		))
+	}
+}
@@ -0,0 +1,167 @@
+package vault
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func ldpCredential(id string, credType string, subject string, email string, expiration string) []byte {
+	expirationDate := ""
+	if len(expiration) > 0 {
+		expirationDate = fmt.Sprintf(`"expirationDate": %q,`, expiration)
+	}
+	return []byte(fmt.Sprintf(`{
+		"id": %q,
+		"type": ["VerifiableCredential", %q],
+		"issuer": {"id": "did:key:issuer"},
+		"issuanceDate": "2023-01-01T00:00:00Z",
+		%s
+		"credentialSubject": {"id": %q, "email": %q}
+	}`, id, credType, expirationDate, subject, email))
+}
+
+func TestExtractMetadata(t *testing.T) {
+
+	payload := `{"iss": "did:key:issuer", "sub": "did:key:holder", "iat": 1672531200, "exp": 1704067200,
+		"vc": {"type": ["VerifiableCredential", "PacketDeliveryCredential"], "credentialSubject": {"email": "a@b.c"}}}`
+	jwt := "eyJhbGciOiJFUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".sig"
+
+	meta, err := extractMetadata([]byte(jwt))
+	if err != nil {
+		t.Fatalf("extractMetadata() error = %v", err)
+	}
+	if meta.Type != "PacketDeliveryCredential" || meta.Issuer != "did:key:issuer" || meta.Subject != "did:key:holder" ||
+		meta.HolderEmail != "a@b.c" || meta.IssuedAt.Unix() != 1672531200 || meta.ExpiresAt.Unix() != 1704067200 {
+		t.Errorf("extractMetadata() = %+v", meta)
+	}
+
+	meta, err = extractMetadata(ldpCredential("c1", "PacketDeliveryService", "did:key:holder", "a@b.c", "2024-01-01T00:00:00Z"))
+	if err != nil {
+		t.Fatalf("extractMetadata() error = %v", err)
+	}
+	if meta.Type != "PacketDeliveryService" || meta.Issuer != "did:key:issuer" || meta.Subject != "did:key:holder" ||
+		meta.ExpiresAt.Year() != 2024 || len(meta.Types) != 2 {
+		t.Errorf("extractMetadata() = %+v", meta)
+	}
+
+	if _, err := extractMetadata([]byte("not a credential")); err == nil {
+		t.Error("extractMetadata() expected an error")
+	}
+}
+
+func TestSearchCredentials(t *testing.T) {
+	v := newTestVault(t)
+	ctx := context.Background()
+
+	for i := 0; i < 7; i++ {
+		credType := "PacketDeliveryService"
+		if i%2 == 1 {
+			credType = "CustomerCredential"
+		}
+		expiration := ""
+		if i < 5 {
+			expiration = fmt.Sprintf("2024-01-0%dT00:00:00Z", 5-i)
+		}
+		id := fmt.Sprintf("cred%d", i)
+		raw := ldpCredential(id, credType, fmt.Sprintf("did:key:holder%d", i), fmt.Sprintf("user%d@example.com", i), expiration)
+		if err := v.Client.Credential.Create().SetID(id).SetType("ldp_vc").SetRaw(raw).Exec(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Metadata extracted when storing
+	cred, _ := v.CredentialByID("cred0")
+	if cred.CredentialType != "PacketDeliveryService" || cred.Subject != "did:key:holder0" || cred.IndexedAt == nil {
+		t.Errorf("credential not indexed: %+v", cred)
+	}
+
+	// Walk all pages sorted by expiration, with the credentials without expiration at the end
+	var ids []string
+	q := CredentialQuery{Sort: "expires_at", Limit: 3}
+	for {
+		page, err := v.SearchCredentials(q)
+		if err != nil {
+			t.Fatalf("SearchCredentials() error = %v", err)
+		}
+		for _, item := range page.Items {
+			ids = append(ids, item.ID)
+		}
+		if len(page.NextCursor) == 0 {
+			break
+		}
+		q.Cursor = page.NextCursor
+	}
+	want := []string{"cred4", "cred3", "cred2", "cred1", "cred0", "cred5", "cred6"}
+	if fmt.Sprint(ids) != fmt.Sprint(want) {
+		t.Errorf("pages = %v, want %v", ids, want)
+	}
+
+	// Descending order
+	page, err := v.SearchCredentials(CredentialQuery{Sort: "expires_at", Desc: true, Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	page, err = v.SearchCredentials(CredentialQuery{Sort: "expires_at", Desc: true, Limit: 2, Cursor: page.NextCursor})
+	if err != nil || page.Items[0].ID != "cred2" {
+		t.Errorf("second page descending = %v, %v", page, err)
+	}
+
+	// Filters
+	before := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		q    CredentialQuery
+		want int
+	}{
+		{"type", CredentialQuery{Type: "CustomerCredential"}, 3},
+		{"subject", CredentialQuery{Subject: "did:key:holder2"}, 1},
+		{"expiry window", CredentialQuery{ExpiresBefore: &before}, 2},
+		{"text", CredentialQuery{Text: "USER5@"}, 1},
+		{"status", CredentialQuery{Status: "revoked"}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := v.SearchCredentials(tt.q)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(page.Items) != tt.want {
+				t.Errorf("SearchCredentials() returned %d items, want %d", len(page.Items), tt.want)
+			}
+		})
+	}
+
+	// A cursor can not be used with another sort order
+	page, _ = v.SearchCredentials(CredentialQuery{Limit: 1})
+	if _, err := v.SearchCredentials(CredentialQuery{Sort: "expires_at", Cursor: page.NextCursor}); err == nil {
+		t.Error("SearchCredentials() expected an error for a cursor with a different sort")
+	}
+	if _, err := v.SearchCredentials(CredentialQuery{Sort: "raw"}); err == nil {
+		t.Error("SearchCredentials() expected an error for an invalid sort field")
+	}
+}
+
+func TestBackfillCredentialIndex(t *testing.T) {
+	v := newTestVault(t)
+	ctx := context.Background()
+
+	raw := ldpCredential("old", "PacketDeliveryService", "did:key:holder", "a@b.c", "2024-01-01T00:00:00Z")
+	v.Client.Credential.Create().SetID("old").SetType("ldp_vc").SetRaw(raw).ExecX(ctx)
+
+	// Simulate a credential stored before the index existed
+	v.Client.Credential.UpdateOneID("old").
+		ClearIndexedAt().ClearCredentialType().ClearSubject().ClearExpiresAt().
+		ExecX(ctx)
+
+	if err := v.backfillCredentialIndex(); err != nil {
+		t.Fatalf("backfillCredentialIndex() error = %v", err)
+	}
+
+	cred, _ := v.CredentialByID("old")
+	if cred.IndexedAt == nil || cred.CredentialType != "PacketDeliveryService" || cred.Subject != "did:key:holder" || cred.ExpiresAt == nil {
+		t.Errorf("credential not backfilled: %+v", cred)
+	}
+}
@@ -0,0 +1,430 @@
+package vault
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/Masterminds/sprig/v3"
+	"github.com/google/uuid"
+	"github.com/hesusruiz/vcbackend/ent"
+	"github.com/hesusruiz/vcbackend/ent/credentialtemplate"
+	"github.com/hesusruiz/vcutils/yaml"
+	"go.uber.org/zap"
+)
+
+// builtinTemplates are the credential templates shipped with the binary.
+// They are loaded into the database the first time a Vault is opened, so
+// operators can later create new versions of them through the API.
+//
+//go:embed templates/*.tpl templates/*.sample.yaml
+var builtinTemplates embed.FS
+
+var (
+	ErrTemplateNotFound      = errors.New("credential template not found")
+	ErrTemplateNotDraft      = errors.New("only draft templates can be modified")
+	ErrTemplateNotPublished  = errors.New("no published version of the credential template")
+	ErrTemplateInvalid       = errors.New("invalid credential template")
+	ErrTemplateNotArchivable = errors.New("only the published version of a template can be archived")
+)
+
+// seedBuiltinTemplates stores the embedded templates as published version 1,
+// unless a template with the same name already exists in the database.
+func (v *Vault) seedBuiltinTemplates() error {
+
+	files, err := fs.Glob(builtinTemplates, "templates/*.tpl")
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+
+		content, err := builtinTemplates.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		// Each file defines one or more named templates, and each of them is stored
+		names, err := definedTemplateNames(string(content))
+		if err != nil {
+			v.logger().Errorw("invalid builtin template", "file", file, zap.Error(err))
+			return err
+		}
+
+		for _, name := range names {
+
+			exists, err := v.Client.CredentialTemplate.Query().
+				Where(credentialtemplate.Name(name)).
+				Exist(v.dbContext())
+			if err != nil {
+				return err
+			}
+			if exists {
+				continue
+			}
+
+			// Sample data for the template, if it was shipped
+			var sample map[string]any
+			if sampleBytes, err := builtinTemplates.ReadFile(path.Join("templates", name+".sample.yaml")); err == nil {
+				parsed, err := yaml.ParseYamlBytes(sampleBytes)
+				if err != nil {
+					return err
+				}
+				sample, _ = parsed.Data().(map[string]any)
+			}
+
+			_, err = v.Client.CredentialTemplate.Create().
+				SetID(uuid.NewString()).
+				SetName(name).
+				SetVersion(1).
+				SetStatus(credentialtemplate.StatusPublished).
+				SetDescription("Builtin template from " + path.Base(file)).
+				SetContent(string(content)).
+				SetSampleData(sample).
+				SetBuiltin(true).
+				SetPublishedAt(time.Now()).
+				Save(v.dbContext())
+			if err != nil {
+				v.logger().Errorw("failed storing builtin template", "name", name, zap.Error(err))
+				return err
+			}
+			v.logger().Infow("builtin template created", "name", name)
+
+		}
+
+	}
+
+	return nil
+}
+
+// definedTemplateNames parses the template source and returns the names of the
+// templates defined inside it with the {{define}} action
+func definedTemplateNames(content string) ([]string, error) {
+	tpl, err := newTextTemplate("").Parse(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, t := range tpl.Templates() {
+		if t.Name() != "" {
+			names = append(names, t.Name())
+		}
+	}
+	return names, nil
+}
+
+// templateFuncs are the functions of sprig available to the templates. The templates are written by the
+// operators, so they can not use the functions which read the environment or the network of the server,
+// like env, expandenv or getHostByName. The random and date functions are allowed, for the identifiers
+// and the dates of the credentials.
+var templateFuncs = func() template.FuncMap {
+	funcs := sprig.HermeticTxtFuncMap()
+	all := sprig.TxtFuncMap()
+	for _, name := range []string{
+		"now", "date", "dateInZone", "dateModify", "toDate", "mustToDate",
+		"randAlphaNum", "randAlpha", "randAscii", "randNumeric", "randBytes", "uuidv4",
+	} {
+		funcs[name] = all[name]
+	}
+	return funcs
+}()
+
+func newTextTemplate(name string) *template.Template {
+	return template.New(name).Funcs(templateFuncs)
+}
+
+// parseTemplate compiles the content of a stored template. The content can be either
+// a plain template body or wrap the body in a {{define}} action with the template name.
+func parseTemplate(name string, content string) (*template.Template, error) {
+	tpl, err := newTextTemplate(name).Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrTemplateInvalid, err)
+	}
+	return tpl, nil
+}
+
+// ListTemplates returns all the versions of the credential templates, ordered by name and version.
+// If name is not empty, only the versions of the template with that name are returned.
+func (v *Vault) ListTemplates(name string) ([]*ent.CredentialTemplate, error) {
+	query := v.Client.CredentialTemplate.Query()
+	if len(name) > 0 {
+		query = query.Where(credentialtemplate.Name(name))
+	}
+	return query.
+		Order(ent.Asc(credentialtemplate.FieldName), ent.Desc(credentialtemplate.FieldVersion)).
+		All(v.dbContext())
+}
+
+// TemplateByID returns the template version with the given id
+func (v *Vault) TemplateByID(id string) (*ent.CredentialTemplate, error) {
+	tpl, err := v.Client.CredentialTemplate.Get(v.dbContext(), id)
+	if ent.IsNotFound(err) {
+		return nil, ErrTemplateNotFound
+	}
+	return tpl, err
+}
+
+// PublishedTemplate returns the version of the named template which is currently published
+func (v *Vault) PublishedTemplate(name string) (*ent.CredentialTemplate, error) {
+	tpl, err := v.Client.CredentialTemplate.Query().
+		Where(
+			credentialtemplate.Name(name),
+			credentialtemplate.StatusEQ(credentialtemplate.StatusPublished),
+		).
+		Only(v.dbContext())
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: %s", ErrTemplateNotPublished, name)
+	}
+	return tpl, err
+}
+
+// CreateTemplate creates a new draft version of the named template. The version number
+// is one more than the highest existing version with the same name.
+func (v *Vault) CreateTemplate(name string, description string, content string, sample map[string]any) (*ent.CredentialTemplate, error) {
+
+	if len(name) == 0 || len(content) == 0 {
+		return nil, fmt.Errorf("%w: name and content are required", ErrTemplateInvalid)
+	}
+
+	// Make sure the template compiles before storing it
+	if _, err := parseTemplate(name, content); err != nil {
+		return nil, err
+	}
+
+	latest, err := v.Client.CredentialTemplate.Query().
+		Where(credentialtemplate.Name(name)).
+		Order(ent.Desc(credentialtemplate.FieldVersion)).
+		First(v.dbContext())
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	version := 1
+	if latest != nil {
+		version = latest.Version + 1
+	}
+
+	tpl, err := v.Client.CredentialTemplate.Create().
+		SetID(uuid.NewString()).
+		SetName(name).
+		SetVersion(version).
+		SetDescription(description).
+		SetContent(content).
+		SetSampleData(sample).
+		Save(v.dbContext())
+	if err != nil {
+		v.logger().Errorw("failed storing template", "name", name, zap.Error(err))
+		return nil, err
+	}
+	v.logger().Infow("template created", "name", name, "version", version)
+
+	return tpl, nil
+}
+
+// UpdateTemplate modifies a draft version of a template. Published and archived
+// versions are immutable, so credentials can always be traced to the exact template used.
+func (v *Vault) UpdateTemplate(id string, description string, content string, sample map[string]any) (*ent.CredentialTemplate, error) {
+
+	tpl, err := v.TemplateByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if tpl.Status != credentialtemplate.StatusDraft {
+		return nil, ErrTemplateNotDraft
+	}
+
+	update := tpl.Update().
+		SetDescription(description).
+		SetUpdatedAt(time.Now())
+
+	if len(content) > 0 {
+		if _, err := parseTemplate(tpl.Name, content); err != nil {
+			return nil, err
+		}
+		update = update.SetContent(content)
+	}
+	if sample != nil {
+		update = update.SetSampleData(sample)
+	}
+
+	return update.Save(v.dbContext())
+}
+
+// DeleteTemplate deletes a draft version of a template
+func (v *Vault) DeleteTemplate(id string) error {
+
+	tpl, err := v.TemplateByID(id)
+	if err != nil {
+		return err
+	}
+	if tpl.Status != credentialtemplate.StatusDraft {
+		return ErrTemplateNotDraft
+	}
+
+	return v.Client.CredentialTemplate.DeleteOne(tpl).Exec(v.dbContext())
+}
+
+// PublishTemplate makes the version with the given id the one used for issuance.
+// The previously published version of the same template, if any, is archived.
+func (v *Vault) PublishTemplate(id string) (*ent.CredentialTemplate, error) {
+
+	tpl, err := v.TemplateByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if tpl.Status == credentialtemplate.StatusPublished {
+		return tpl, nil
+	}
+
+	tx, err := v.Client.Tx(v.dbContext())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.CredentialTemplate.Update().
+		Where(
+			credentialtemplate.Name(tpl.Name),
+			credentialtemplate.StatusEQ(credentialtemplate.StatusPublished),
+		).
+		SetStatus(credentialtemplate.StatusArchived).
+		SetUpdatedAt(time.Now()).
+		Save(v.dbContext())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	tpl, err = tx.CredentialTemplate.UpdateOneID(id).
+		SetStatus(credentialtemplate.StatusPublished).
+		SetPublishedAt(time.Now()).
+		SetUpdatedAt(time.Now()).
+		Save(v.dbContext())
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	v.logger().Infow("template published", "name", tpl.Name, "version", tpl.Version)
+
+	return tpl, nil
+}
+
+// ArchiveTemplate withdraws the published version of a template so it can not be used anymore for issuance.
+// Only a published version can be archived: the drafts and the versions already archived are rejected with
+// ErrTemplateNotArchivable, and the template is left without a published version.
+func (v *Vault) ArchiveTemplate(id string) (*ent.CredentialTemplate, error) {
+
+	tpl, err := v.TemplateByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// The update only succeeds if the version is still published
+	n, err := v.Client.CredentialTemplate.Update().
+		Where(
+			credentialtemplate.ID(id),
+			credentialtemplate.StatusEQ(credentialtemplate.StatusPublished),
+		).
+		SetStatus(credentialtemplate.StatusArchived).
+		SetUpdatedAt(time.Now()).
+		Save(v.dbContext())
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, fmt.Errorf("%w: the version %d of %s is %s", ErrTemplateNotArchivable, tpl.Version, tpl.Name, tpl.Status)
+	}
+	v.logger().Infow("template archived", "name", tpl.Name, "version", tpl.Version)
+
+	return v.TemplateByID(id)
+}
+
+// RenderTemplate executes the template version with the data received, and checks that
+// the result is a valid YAML or JSON document. It returns the serialized result.
+func (v *Vault) RenderTemplate(tpl *ent.CredentialTemplate, data any) ([]byte, error) {
+
+	compiled, err := parseTemplate(tpl.Name, tpl.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := compiled.ExecuteTemplate(&b, tpl.Name, data); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrTemplateInvalid, err)
+	}
+
+	// Validate the generated document, just in case the template is malformed
+	if _, err := yaml.ParseYamlBytes(b.Bytes()); err != nil {
+		return nil, fmt.Errorf("%w: rendered document is malformed: %v", ErrTemplateInvalid, err)
+	}
+
+	return b.Bytes(), nil
+}
+
+// PreviewTemplate renders a template version without issuing any credential.
+// If data is nil, the sample data stored with the template is used.
+func (v *Vault) PreviewTemplate(id string, data map[string]any) (rendered []byte, parsed any, err error) {
+
+	tpl, err := v.TemplateByID(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if data == nil {
+		data = tpl.SampleData
+	}
+
+	// Add the dates computed by the issuance policy, as it would happen when issuing
+	dates, err := v.IssuanceDates(tpl.Name, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	withDates := make(map[string]any, len(data)+6)
+	for k, val := range data {
+		withDates[k] = val
+	}
+	for k, val := range dates.Fields() {
+		withDates[k] = val
+	}
+	data = withDates
+
+	rendered, err = v.RenderTemplate(tpl, data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	doc, err := yaml.ParseYamlBytes(rendered)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return bytes.TrimSpace(rendered), doc.Data(), nil
+}
+
+// renderPublished renders the published version of the named template, returning also the
+// template version so the issued credential can be linked to it
+func (v *Vault) renderPublished(name string, data any) ([]byte, *ent.CredentialTemplate, error) {
+
+	name = strings.TrimSpace(name)
+	tpl, err := v.PublishedTemplate(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rendered, err := v.RenderTemplate(tpl, data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rendered, tpl, nil
+}
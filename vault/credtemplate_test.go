@@ -0,0 +1,240 @@
+package vault
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hesusruiz/vcbackend/ent/credentialtemplate"
+)
+
+const testTemplate = `{{define "Membership"}}
+iss: {{toJson .issuerDID}}
+sub: {{toJson .subjectDID}}
+exp: {{toJson .exp}}
+member: {{toJson .claims.name}}
+{{end}}`
+
+var testSample = map[string]any{
+	"issuerDID":  "did:key:issuer",
+	"subjectDID": "did:key:holder",
+	"claims":     map[string]any{"name": "John"},
+}
+
+func TestBuiltinTemplates(t *testing.T) {
+	v := newTestVault(t)
+
+	// The embedded templates are the published version 1, with their sample data
+	for _, name := range []string{"PacketDeliveryCredential", "EmployeeCredential", "customervp"} {
+		tpl, err := v.PublishedTemplate(name)
+		if err != nil {
+			t.Fatalf("PublishedTemplate(%s) error = %v", name, err)
+		}
+		if tpl.Version != 1 || !tpl.Builtin {
+			t.Errorf("%s: version = %d, builtin = %v", name, tpl.Version, tpl.Builtin)
+		}
+	}
+	if tpl, _ := v.PublishedTemplate("PacketDeliveryCredential"); tpl.SampleData["credName"] != "PacketDeliveryCredential" {
+		t.Errorf("sample data = %v", tpl.SampleData)
+	}
+
+	// They are not created again when the templates change
+	draft, err := v.CreateTemplate("PacketDeliveryCredential", "v2", testTemplate, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.PublishTemplate(draft.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := v.seedBuiltinTemplates(); err != nil {
+		t.Fatalf("seedBuiltinTemplates() error = %v", err)
+	}
+	if list, _ := v.ListTemplates("PacketDeliveryCredential"); len(list) != 2 {
+		t.Errorf("%d versions after seeding again, want 2", len(list))
+	}
+}
+
+func TestTemplateVersions(t *testing.T) {
+	v := newTestVault(t)
+
+	first, err := v.CreateTemplate("Membership", "first", testTemplate, testSample)
+	if err != nil {
+		t.Fatalf("CreateTemplate() error = %v", err)
+	}
+	second, err := v.CreateTemplate("Membership", "second", testTemplate, testSample)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Version != 1 || second.Version != 2 || first.Status != credentialtemplate.StatusDraft {
+		t.Errorf("versions = %d, %d, status = %s", first.Version, second.Version, first.Status)
+	}
+
+	if list, _ := v.ListTemplates("Membership"); len(list) != 2 || list[0].Version != 2 {
+		t.Errorf("ListTemplates() = %v", list)
+	}
+	if _, err := v.CreateTemplate("Membership", "", "{{.unclosed", nil); !errors.Is(err, ErrTemplateInvalid) {
+		t.Errorf("CreateTemplate() of an invalid template error = %v", err)
+	}
+	if _, err := v.CreateTemplate("", "", testTemplate, nil); !errors.Is(err, ErrTemplateInvalid) {
+		t.Errorf("CreateTemplate() without a name error = %v", err)
+	}
+	if _, err := v.TemplateByID("unknown"); !errors.Is(err, ErrTemplateNotFound) {
+		t.Errorf("TemplateByID() of an unknown template error = %v", err)
+	}
+}
+
+func TestTemplateTransitions(t *testing.T) {
+	v := newTestVault(t)
+
+	first, _ := v.CreateTemplate("Membership", "first", testTemplate, testSample)
+	second, _ := v.CreateTemplate("Membership", "second", testTemplate, testSample)
+
+	// Drafts can be modified, and are not used for issuance
+	if _, err := v.PublishedTemplate("Membership"); !errors.Is(err, ErrTemplateNotPublished) {
+		t.Errorf("PublishedTemplate() without published versions error = %v", err)
+	}
+	updated, err := v.UpdateTemplate(first.ID, "modified", "", nil)
+	if err != nil || updated.Description != "modified" || updated.Content != testTemplate {
+		t.Fatalf("UpdateTemplate() = %v, %v", updated, err)
+	}
+
+	// Publishing a version archives the previously published one
+	if _, err := v.PublishTemplate(first.ID); err != nil {
+		t.Fatalf("PublishTemplate() error = %v", err)
+	}
+	if _, err := v.PublishTemplate(second.ID); err != nil {
+		t.Fatal(err)
+	}
+	published, err := v.PublishedTemplate("Membership")
+	if err != nil || published.ID != second.ID || published.PublishedAt == nil {
+		t.Errorf("PublishedTemplate() = %v, %v", published, err)
+	}
+	if first, _ = v.TemplateByID(first.ID); first.Status != credentialtemplate.StatusArchived {
+		t.Errorf("status of the previous version = %s", first.Status)
+	}
+
+	// Only drafts can be modified or deleted
+	if _, err := v.UpdateTemplate(second.ID, "modified", "", nil); !errors.Is(err, ErrTemplateNotDraft) {
+		t.Errorf("UpdateTemplate() of a published version error = %v", err)
+	}
+	if err := v.DeleteTemplate(first.ID); !errors.Is(err, ErrTemplateNotDraft) {
+		t.Errorf("DeleteTemplate() of an archived version error = %v", err)
+	}
+
+	// Only the published version can be archived
+	if _, err := v.ArchiveTemplate(first.ID); !errors.Is(err, ErrTemplateNotArchivable) {
+		t.Errorf("ArchiveTemplate() of an archived version error = %v", err)
+	}
+	draft, _ := v.CreateTemplate("Membership", "third", testTemplate, nil)
+	if _, err := v.ArchiveTemplate(draft.ID); !errors.Is(err, ErrTemplateNotArchivable) {
+		t.Errorf("ArchiveTemplate() of a draft error = %v", err)
+	}
+	archived, err := v.ArchiveTemplate(second.ID)
+	if err != nil || archived.Status != credentialtemplate.StatusArchived {
+		t.Fatalf("ArchiveTemplate() = %v, %v", archived, err)
+	}
+	if _, err := v.PublishedTemplate("Membership"); !errors.Is(err, ErrTemplateNotPublished) {
+		t.Errorf("PublishedTemplate() after archiving error = %v", err)
+	}
+
+	if err := v.DeleteTemplate(draft.ID); err != nil {
+		t.Errorf("DeleteTemplate() of a draft error = %v", err)
+	}
+	if _, err := v.TemplateByID(draft.ID); !errors.Is(err, ErrTemplateNotFound) {
+		t.Errorf("TemplateByID() of a deleted draft error = %v", err)
+	}
+}
+
+func TestPreviewTemplate(t *testing.T) {
+	v := newTestVault(t)
+
+	tpl, _ := v.CreateTemplate("Membership", "", testTemplate, testSample)
+
+	// Without data, the sample data is rendered, with the dates of the issuance policy
+	rendered, doc, err := v.PreviewTemplate(tpl.ID, nil)
+	if err != nil {
+		t.Fatalf("PreviewTemplate() error = %v", err)
+	}
+	fields, _ := doc.(map[string]any)
+	if fields["member"] != "John" || fields["sub"] != "did:key:holder" || fields["exp"] == nil {
+		t.Errorf("PreviewTemplate() document = %v", doc)
+	}
+	if !strings.HasPrefix(string(rendered), "iss:") {
+		t.Errorf("PreviewTemplate() rendered = %q", rendered)
+	}
+
+	// The data received replaces the sample data
+	_, doc, err = v.PreviewTemplate(tpl.ID, map[string]any{"claims": map[string]any{"name": "Jane"}})
+	if fields, _ := doc.(map[string]any); err != nil || fields["member"] != "Jane" {
+		t.Errorf("PreviewTemplate() with data = %v, %v", doc, err)
+	}
+}
+
+func TestTemplateFunctions(t *testing.T) {
+	v := newTestVault(t)
+
+	// The functions which read the environment of the server are not available
+	for _, content := range []string{`{{env "HOME"}}`, `{{expandenv "$HOME"}}`, `{{getHostByName "localhost"}}`} {
+		if _, err := v.CreateTemplate("Membership", "", content, nil); !errors.Is(err, ErrTemplateInvalid) {
+			t.Errorf("CreateTemplate(%s) error = %v", content, err)
+		}
+	}
+	if _, err := v.CreateTemplate("Membership", "", `id: "{{uuidv4}}"`, nil); err != nil {
+		t.Errorf("CreateTemplate() with uuidv4 error = %v", err)
+	}
+}
+
+func TestBuiltinTemplatesEscaping(t *testing.T) {
+	v := newTestVault(t)
+
+	injected := `John", "admin": "true`
+	tests := []struct {
+		name    string
+		data    func(sample map[string]any)
+		subject func(doc map[string]any) map[string]any
+	}{
+		{
+			"PacketDeliveryCredential",
+			func(sample map[string]any) { sample["claims"].(map[string]any)["given_name"] = injected },
+			func(doc map[string]any) map[string]any {
+				vc, _ := doc["vc"].(map[string]any)
+				subject, _ := vc["credentialSubject"].(map[string]any)
+				return subject
+			},
+		},
+		{
+			"EmployeeCredential",
+			func(sample map[string]any) { sample["claims"].(map[string]any)["given_name"] = injected },
+			func(doc map[string]any) map[string]any {
+				subject, _ := doc["credentialSubject"].(map[string]any)
+				return subject
+			},
+		},
+		{
+			"customervp",
+			func(sample map[string]any) { sample["Given_name"] = injected },
+			func(doc map[string]any) map[string]any {
+				vc, _ := doc["vc"].(map[string]any)
+				subject, _ := vc["credentialSubject"].(map[string]any)
+				return subject
+			},
+		},
+	}
+	for _, tt := range tests {
+		tpl, err := v.PublishedTemplate(tt.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tt.data(tpl.SampleData)
+
+		_, doc, err := v.PreviewTemplate(tpl.ID, tpl.SampleData)
+		if err != nil {
+			t.Fatalf("%s: PreviewTemplate() error = %v", tt.name, err)
+		}
+		fields, _ := doc.(map[string]any)
+		subject := tt.subject(fields)
+		if subject["given_name"] != injected || subject["admin"] != nil {
+			t.Errorf("%s: credential subject = %v", tt.name, subject)
+		}
+	}
+}
@@ -0,0 +1,398 @@
+// Package migrations applies the versioned migrations of the database of the vaults.
+//
+// The migrations are generated from the ent schema for each database, in the directories with the
+// name of the driver, and are embedded in the binary. Each migration has a file with the changes,
+// VERSION_NAME.up.sql, and a file which reverts them, VERSION_NAME.down.sql. The versions applied
+// to a database are recorded in its table schema_migrations.
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+//go:embed sqlite3/*.sql postgres/*.sql mysql/*.sql
+var files embed.FS
+
+// Drivers are the database drivers with migrations
+var Drivers = []string{"sqlite3", "postgres", "mysql"}
+
+// Table is the table where the applied versions are recorded
+const Table = "schema_migrations"
+
+var (
+	// ErrPending is returned when some migrations have not been applied to the database
+	ErrPending = errors.New("the database schema is not migrated")
+	// ErrUnknownVersion is returned when the database has a version which is not in the migrations,
+	// applied by a newer release
+	ErrUnknownVersion = errors.New("the database schema has an unknown version")
+)
+
+// Migration is a version of the schema
+type Migration struct {
+	Version string
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Load returns the migrations of a driver, ordered by version
+func Load(driver string) ([]*Migration, error) {
+
+	entries, err := fs.ReadDir(files, driver)
+	if err != nil {
+		return nil, fmt.Errorf("no migrations for the driver %q", driver)
+	}
+
+	byVersion := map[string]*Migration{}
+	for _, e := range entries {
+		version, name, direction, ok := parseName(e.Name())
+		if !ok {
+			return nil, fmt.Errorf("invalid name of migration %s/%s", driver, e.Name())
+		}
+		content, err := files.ReadFile(path.Join(driver, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		m := byVersion[version]
+		if m == nil {
+			m = &Migration{Version: version, Name: name}
+			byVersion[version] = m
+		}
+		if direction == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if len(m.Up) == 0 {
+			return nil, fmt.Errorf("migration %s/%s has no up file", driver, m.Version)
+		}
+		migrations = append(migrations, m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return migrations, nil
+}
+
+// parseName splits the name of a file like 20230101120000_initial.up.sql
+func parseName(name string) (version string, label string, direction string, ok bool) {
+	base := strings.TrimSuffix(name, ".sql")
+	if base == name {
+		return "", "", "", false
+	}
+	switch {
+	case strings.HasSuffix(base, ".up"):
+		direction, base = "up", strings.TrimSuffix(base, ".up")
+	case strings.HasSuffix(base, ".down"):
+		direction, base = "down", strings.TrimSuffix(base, ".down")
+	default:
+		return "", "", "", false
+	}
+	version, label, _ = strings.Cut(base, "_")
+	if len(version) == 0 || strings.Trim(version, "0123456789") != "" {
+		return "", "", "", false
+	}
+	return version, label, direction, true
+}
+
+// Statements splits the content of a migration file in its statements. The statements end with a
+// semicolon at the end of a line, and the lines starting with -- are comments.
+func Statements(content string) []string {
+	var stmts []string
+	var current strings.Builder
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if len(trimmed) == 0 || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		if current.Len() > 0 {
+			current.WriteString("\n")
+		}
+		current.WriteString(line)
+		if strings.HasSuffix(trimmed, ";") {
+			stmts = append(stmts, strings.TrimSuffix(strings.TrimSpace(current.String()), ";"))
+			current.Reset()
+		}
+	}
+	if s := strings.TrimSpace(current.String()); len(s) > 0 {
+		stmts = append(stmts, s)
+	}
+	return stmts
+}
+
+// Status is a migration with the time when it was applied to the database, if it was
+type Status struct {
+	*Migration
+	AppliedAt string
+}
+
+// Migrator applies the migrations of its driver to a database
+type Migrator struct {
+	db         *sql.DB
+	driver     string
+	migrations []*Migration
+}
+
+// New creates a migrator for the database, with the migrations of the driver
+func New(db *sql.DB, driver string) (*Migrator, error) {
+	migrations, err := Load(driver)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, driver: driver, migrations: migrations}, nil
+}
+
+// Migrations returns all the migrations of the driver
+func (m *Migrator) Migrations() []*Migration {
+	return m.migrations
+}
+
+// placeholder returns the placeholder of the n-th parameter of a statement, from 1
+func (m *Migrator) placeholder(n int) string {
+	if m.driver == "postgres" {
+		return fmt.Sprintf("$%d", n)
+	}
+	return "?"
+}
+
+// init creates the table of the versions if it does not exist
+func (m *Migrator) init(ctx context.Context) error {
+	_, err := m.db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+Table+
+		" (version varchar(32) NOT NULL, name varchar(255) NOT NULL, applied_at varchar(64) NOT NULL, PRIMARY KEY (version))")
+	return err
+}
+
+// applied returns the time when each version was applied
+func (m *Migrator) applied(ctx context.Context) (map[string]string, error) {
+
+	if err := m.init(ctx); err != nil {
+		return nil, err
+	}
+
+	rows, err := m.db.QueryContext(ctx, "SELECT version, applied_at FROM "+Table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := map[string]string{}
+	for rows.Next() {
+		var version, at string
+		if err := rows.Scan(&version, &at); err != nil {
+			return nil, err
+		}
+		applied[version] = at
+	}
+	return applied, rows.Err()
+}
+
+// Status returns all the migrations, with the time when they were applied
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := make([]Status, len(m.migrations))
+	for i, mig := range m.migrations {
+		status[i] = Status{Migration: mig, AppliedAt: applied[mig.Version]}
+	}
+	return status, nil
+}
+
+// Check returns ErrPending if some migration has not been applied, and ErrUnknownVersion if the
+// database has been migrated by a newer release
+func (m *Migrator) Check(ctx context.Context) error {
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return err
+	}
+
+	known := map[string]bool{}
+	pending := 0
+	for _, mig := range m.migrations {
+		known[mig.Version] = true
+		if len(applied[mig.Version]) == 0 {
+			pending++
+		}
+	}
+	for version := range applied {
+		if !known[version] {
+			return fmt.Errorf("%w: %s", ErrUnknownVersion, version)
+		}
+	}
+	if pending > 0 {
+		return fmt.Errorf("%w: %d of %d migrations are pending", ErrPending, pending, len(m.migrations))
+	}
+	return nil
+}
+
+// Version returns the newest version applied to the database, or an empty string if none
+func (m *Migrator) Version(ctx context.Context) (string, error) {
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	version := ""
+	for v := range applied {
+		if v > version {
+			version = v
+		}
+	}
+	return version, nil
+}
+
+// Up applies the pending migrations, in order, and returns them
+func (m *Migrator) Up(ctx context.Context) ([]*Migration, error) {
+	return m.UpTo(ctx, m.migrations[len(m.migrations)-1].Version)
+}
+
+// UpTo applies the pending migrations up to the version, in order, and returns them
+func (m *Migrator) UpTo(ctx context.Context, version string) ([]*Migration, error) {
+
+	if !m.known(version) {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownVersion, version)
+	}
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []*Migration
+	for _, mig := range m.migrations {
+		if mig.Version > version {
+			break
+		}
+		if len(applied[mig.Version]) > 0 {
+			continue
+		}
+		if err := m.apply(ctx, mig, mig.Up, true); err != nil {
+			return done, err
+		}
+		done = append(done, mig)
+	}
+	return done, nil
+}
+
+// Down reverts the last steps migrations applied, and returns them
+func (m *Migrator) Down(ctx context.Context, steps int) ([]*Migration, error) {
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []*Migration
+	for i := len(m.migrations) - 1; i >= 0 && len(done) < steps; i-- {
+		mig := m.migrations[i]
+		if len(applied[mig.Version]) == 0 {
+			continue
+		}
+		if len(mig.Down) == 0 {
+			return done, fmt.Errorf("migration %s can not be reverted", mig.Version)
+		}
+		if err := m.apply(ctx, mig, mig.Down, false); err != nil {
+			return done, err
+		}
+		done = append(done, mig)
+	}
+	return done, nil
+}
+
+// Baseline records the migrations up to the version as applied, without applying them. It is used
+// for the databases created before the migrations, whose schema is already the one of the version.
+func (m *Migrator) Baseline(ctx context.Context, version string) ([]*Migration, error) {
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !m.known(version) {
+		return nil, fmt.Errorf("no migration with the version %q", version)
+	}
+
+	var done []*Migration
+	for _, mig := range m.migrations {
+		if mig.Version > version {
+			break
+		}
+		if len(applied[mig.Version]) > 0 {
+			continue
+		}
+		if err := m.record(ctx, m.db, mig, true); err != nil {
+			return done, err
+		}
+		done = append(done, mig)
+	}
+	return done, nil
+}
+
+// known returns true if there is a migration with the version
+func (m *Migrator) known(version string) bool {
+	for _, mig := range m.migrations {
+		if mig.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+// apply executes the statements of a migration and records its version in a transaction. The
+// changes of the schema are not transactional in MySQL, which commits them implicitly.
+func (m *Migrator) apply(ctx context.Context, mig *Migration, content string, up bool) error {
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, stmt := range Statements(content) {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migration %s_%s: %w", mig.Version, mig.Name, err)
+		}
+	}
+
+	if err := m.record(ctx, tx, mig, up); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// record adds the version of the migration to the applied ones, or removes it when it is reverted
+func (m *Migrator) record(ctx context.Context, db execer, mig *Migration, up bool) error {
+	var err error
+	if up {
+		_, err = db.ExecContext(ctx, "INSERT INTO "+Table+" (version, name, applied_at) VALUES ("+
+			m.placeholder(1)+", "+m.placeholder(2)+", "+m.placeholder(3)+")",
+			mig.Version, mig.Name, time.Now().UTC().Format(time.RFC3339))
+	} else {
+		_, err = db.ExecContext(ctx, "DELETE FROM "+Table+" WHERE version = "+m.placeholder(1), mig.Version)
+	}
+	return err
+}
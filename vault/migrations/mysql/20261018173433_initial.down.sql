@@ -0,0 +1,26 @@
+-- reverse: create "private_keys" table
+DROP TABLE `private_keys`;
+-- reverse: create "issuance_request_events" table
+DROP TABLE `issuance_request_events`;
+-- reverse: create "issuance_requests" table
+DROP TABLE `issuance_requests`;
+-- reverse: create "credentials" table
+DROP TABLE `credentials`;
+-- reverse: create "natural_persons" table
+DROP TABLE `natural_persons`;
+-- reverse: create "credential_templates" table
+DROP TABLE `credential_templates`;
+-- reverse: create "di_ds" table
+DROP TABLE `di_ds`;
+-- reverse: create "public_keys" table
+DROP TABLE `public_keys`;
+-- reverse: create "relying_parties" table
+DROP TABLE `relying_parties`;
+-- reverse: create "audit_entries" table
+DROP TABLE `audit_entries`;
+-- reverse: create "trusted_issuers" table
+DROP TABLE `trusted_issuers`;
+-- reverse: create "users" table
+DROP TABLE `users`;
+-- reverse: create "presentation_nonces" table
+DROP TABLE `presentation_nonces`;
@@ -0,0 +1,26 @@
+-- create "presentation_nonces" table
+CREATE TABLE `presentation_nonces` (`id` varchar(255) NOT NULL, `state` varchar(255) NOT NULL, `client_id` varchar(255) NULL, `audience` varchar(255) NOT NULL, `created_at` timestamp NOT NULL, `expires_at` timestamp NOT NULL, `consumed_at` timestamp NULL, PRIMARY KEY (`id`), INDEX `presentationnonce_state` (`state`), INDEX `presentationnonce_expires_at` (`expires_at`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "users" table
+CREATE TABLE `users` (`id` varchar(255) NOT NULL, `name` varchar(255) NOT NULL, `displayname` varchar(255) NULL, `type` varchar(255) NOT NULL, `password` blob NOT NULL, `roles` json NULL, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, PRIMARY KEY (`id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "trusted_issuers" table
+CREATE TABLE `trusted_issuers` (`id` varchar(255) NOT NULL, `credentials` json NOT NULL, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, PRIMARY KEY (`id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "audit_entries" table
+CREATE TABLE `audit_entries` (`id` bigint NOT NULL AUTO_INCREMENT, `created_at` timestamp NOT NULL, `actor` varchar(255) NOT NULL, `action` varchar(255) NOT NULL, `target_type` varchar(255) NULL, `target` varchar(255) NULL, `outcome` enum('success','failure') NOT NULL, `request_id` varchar(255) NULL, `details` json NULL, `prev_hash` varchar(255) NOT NULL, `hash` varchar(255) NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `hash` (`hash`), INDEX `auditentry_action` (`action`), INDEX `auditentry_actor` (`actor`), INDEX `auditentry_target` (`target`), INDEX `auditentry_created_at` (`created_at`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "relying_parties" table
+CREATE TABLE `relying_parties` (`id` varchar(255) NOT NULL, `name` varchar(255) NULL, `redirect_uris` json NOT NULL, `credential_types` json NULL, `presentation_definition` json NULL, `scope` varchar(255) NULL, `audience` varchar(255) NULL, `token_lifetime` varchar(255) NULL, `signing_alg` varchar(255) NULL, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, PRIMARY KEY (`id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "public_keys" table
+CREATE TABLE `public_keys` (`id` varchar(255) NOT NULL, `kty` varchar(255) NOT NULL, `alg` varchar(255) NULL, `jwk` json NOT NULL, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, PRIMARY KEY (`id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "di_ds" table
+CREATE TABLE `di_ds` (`id` varchar(255) NOT NULL, `method` varchar(255) NULL, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `user_dids` varchar(255) NULL, PRIMARY KEY (`id`), CONSTRAINT `di_ds_users_dids` FOREIGN KEY (`user_dids`) REFERENCES `users` (`id`) ON DELETE SET NULL) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "credential_templates" table
+CREATE TABLE `credential_templates` (`id` varchar(255) NOT NULL, `name` varchar(255) NOT NULL, `version` bigint NOT NULL, `status` enum('draft','published','archived') NOT NULL DEFAULT 'draft', `description` varchar(255) NULL, `content` longtext NOT NULL, `sample_data` json NULL, `builtin` bool NOT NULL DEFAULT false, `published_at` timestamp NULL, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `credentialtemplate_name_version` (`name`, `version`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "natural_persons" table
+CREATE TABLE `natural_persons` (`id` varchar(255) NOT NULL, `name` varchar(255) NOT NULL, `displayname` varchar(255) NULL, `type` varchar(255) NOT NULL, `password` blob NOT NULL, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, PRIMARY KEY (`id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "credentials" table
+CREATE TABLE `credentials` (`id` varchar(255) NOT NULL, `type` varchar(255) NOT NULL DEFAULT 'jwt_vc', `raw` json NOT NULL, `status` enum('active','revoked') NOT NULL DEFAULT 'active', `expires_at` timestamp NULL, `revoked_at` timestamp NULL, `renewal_due_at` timestamp NULL, `issuance_data` json NULL, `types` json NULL, `credential_type` varchar(255) NULL, `issuer` varchar(255) NULL, `subject` varchar(255) NULL, `holder_email` varchar(255) NULL, `template_name` varchar(255) NULL, `issued_at` timestamp NULL, `indexed_at` timestamp NULL, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `credential_successor` varchar(255) NULL, `credential_template_credentials` varchar(255) NULL, `natural_person_credentials` varchar(255) NULL, `user_credentials` varchar(255) NULL, PRIMARY KEY (`id`), UNIQUE INDEX `credential_successor` (`credential_successor`), INDEX `credential_status_expires_at` (`status`, `expires_at`), INDEX `credential_credential_type` (`credential_type`), INDEX `credential_subject` (`subject`), INDEX `credential_holder_email` (`holder_email`), INDEX `credential_issued_at` (`issued_at`), INDEX `credential_created_at` (`created_at`), CONSTRAINT `credentials_credentials_successor` FOREIGN KEY (`credential_successor`) REFERENCES `credentials` (`id`) ON DELETE SET NULL, CONSTRAINT `credentials_credential_templates_credentials` FOREIGN KEY (`credential_template_credentials`) REFERENCES `credential_templates` (`id`) ON DELETE SET NULL, CONSTRAINT `credentials_natural_persons_credentials` FOREIGN KEY (`natural_person_credentials`) REFERENCES `natural_persons` (`id`) ON DELETE SET NULL, CONSTRAINT `credentials_users_credentials` FOREIGN KEY (`user_credentials`) REFERENCES `users` (`id`) ON DELETE SET NULL) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "issuance_requests" table
+CREATE TABLE `issuance_requests` (`id` varchar(255) NOT NULL, `credential_type` varchar(255) NOT NULL, `claims` json NOT NULL, `subject_did` varchar(255) NULL, `status` enum('pending','approved','rejected','issued','failed') NOT NULL DEFAULT 'pending', `requested_by` varchar(255) NOT NULL, `decided_by` varchar(255) NULL, `reason` varchar(255) NULL, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `issuance_request_credential` varchar(255) NULL, PRIMARY KEY (`id`), INDEX `issuancerequest_status` (`status`), CONSTRAINT `issuance_requests_credentials_credential` FOREIGN KEY (`issuance_request_credential`) REFERENCES `credentials` (`id`) ON DELETE SET NULL) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "issuance_request_events" table
+CREATE TABLE `issuance_request_events` (`id` bigint NOT NULL AUTO_INCREMENT, `from_status` varchar(255) NULL, `to_status` varchar(255) NOT NULL, `actor` varchar(255) NOT NULL, `reason` varchar(255) NULL, `created_at` timestamp NOT NULL, `issuance_request_events` varchar(255) NULL, PRIMARY KEY (`id`), CONSTRAINT `issuance_request_events_issuance_requests_events` FOREIGN KEY (`issuance_request_events`) REFERENCES `issuance_requests` (`id`) ON DELETE SET NULL) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "private_keys" table
+CREATE TABLE `private_keys` (`id` varchar(255) NOT NULL, `kty` varchar(255) NOT NULL, `alg` varchar(255) NULL, `jwk` json NOT NULL, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `natural_person_keys` varchar(255) NULL, `user_keys` varchar(255) NULL, PRIMARY KEY (`id`), CONSTRAINT `private_keys_natural_persons_keys` FOREIGN KEY (`natural_person_keys`) REFERENCES `natural_persons` (`id`) ON DELETE SET NULL, CONSTRAINT `private_keys_users_keys` FOREIGN KEY (`user_keys`) REFERENCES `users` (`id`) ON DELETE SET NULL) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,2 @@
+-- reverse: create "rate_counters" table
+DROP TABLE `rate_counters`;
@@ -0,0 +1,2 @@
+-- create "rate_counters" table
+CREATE TABLE `rate_counters` (`id` varchar(255) NOT NULL, `count` bigint NOT NULL DEFAULT 0, `expires_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `ratecounter_expires_at` (`expires_at`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,2 @@
+-- reverse: create "client_certificates" table
+DROP TABLE `client_certificates`;
@@ -0,0 +1,2 @@
+-- create "client_certificates" table
+CREATE TABLE `client_certificates` (`id` varchar(255) NOT NULL, `user` varchar(255) NOT NULL, `subject` varchar(255) NULL, `not_after` timestamp NOT NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `clientcertificate_user` (`user`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
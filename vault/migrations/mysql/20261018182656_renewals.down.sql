@@ -0,0 +1,4 @@
+-- reverse: modify "issuance_requests" table
+ALTER TABLE `issuance_requests` DROP COLUMN `revoke_predecessor`, DROP COLUMN `predecessor`;
+-- reverse: modify "credentials" table
+ALTER TABLE `credentials` DROP COLUMN `renewal_started_at`;
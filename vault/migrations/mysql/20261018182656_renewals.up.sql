@@ -0,0 +1,4 @@
+-- modify "credentials" table
+ALTER TABLE `credentials` ADD COLUMN `renewal_started_at` timestamp NULL;
+-- modify "issuance_requests" table
+ALTER TABLE `issuance_requests` ADD COLUMN `predecessor` varchar(255) NULL, ADD COLUMN `revoke_predecessor` bool NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+-- reverse: modify "relying_parties" table
+ALTER TABLE `relying_parties` DROP COLUMN `service`;
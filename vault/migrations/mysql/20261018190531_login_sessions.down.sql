@@ -0,0 +1,2 @@
+-- reverse: create "login_sessions" table
+DROP TABLE `login_sessions`;
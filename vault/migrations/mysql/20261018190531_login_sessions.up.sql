@@ -0,0 +1,2 @@
+-- create "login_sessions" table
+CREATE TABLE `login_sessions` (`id` varchar(255) NOT NULL, `client_id` varchar(255) NULL, `return_url` varchar(255) NULL, `credential` longtext NULL, `created_at` timestamp NOT NULL, `expires_at` timestamp NOT NULL, `completed_at` timestamp NULL, PRIMARY KEY (`id`), INDEX `loginsession_expires_at` (`expires_at`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,58 @@
+-- reverse: create "private_keys" table
+DROP TABLE "private_keys";
+-- reverse: create "issuance_request_events" table
+DROP TABLE "issuance_request_events";
+-- reverse: create index "issuancerequest_status" to table: "issuance_requests"
+DROP INDEX "issuancerequest_status";
+-- reverse: create "issuance_requests" table
+DROP TABLE "issuance_requests";
+-- reverse: create "di_ds" table
+DROP TABLE "di_ds";
+-- reverse: create index "credential_created_at" to table: "credentials"
+DROP INDEX "credential_created_at";
+-- reverse: create index "credential_issued_at" to table: "credentials"
+DROP INDEX "credential_issued_at";
+-- reverse: create index "credential_holder_email" to table: "credentials"
+DROP INDEX "credential_holder_email";
+-- reverse: create index "credential_subject" to table: "credentials"
+DROP INDEX "credential_subject";
+-- reverse: create index "credential_credential_type" to table: "credentials"
+DROP INDEX "credential_credential_type";
+-- reverse: create index "credential_status_expires_at" to table: "credentials"
+DROP INDEX "credential_status_expires_at";
+-- reverse: create index "credentials_credential_successor_key" to table: "credentials"
+DROP INDEX "credentials_credential_successor_key";
+-- reverse: create "credentials" table
+DROP TABLE "credentials";
+-- reverse: create "users" table
+DROP TABLE "users";
+-- reverse: create "natural_persons" table
+DROP TABLE "natural_persons";
+-- reverse: create index "presentationnonce_expires_at" to table: "presentation_nonces"
+DROP INDEX "presentationnonce_expires_at";
+-- reverse: create index "presentationnonce_state" to table: "presentation_nonces"
+DROP INDEX "presentationnonce_state";
+-- reverse: create "presentation_nonces" table
+DROP TABLE "presentation_nonces";
+-- reverse: create "public_keys" table
+DROP TABLE "public_keys";
+-- reverse: create "relying_parties" table
+DROP TABLE "relying_parties";
+-- reverse: create index "credentialtemplate_name_version" to table: "credential_templates"
+DROP INDEX "credentialtemplate_name_version";
+-- reverse: create "credential_templates" table
+DROP TABLE "credential_templates";
+-- reverse: create "trusted_issuers" table
+DROP TABLE "trusted_issuers";
+-- reverse: create index "auditentry_created_at" to table: "audit_entries"
+DROP INDEX "auditentry_created_at";
+-- reverse: create index "auditentry_target" to table: "audit_entries"
+DROP INDEX "auditentry_target";
+-- reverse: create index "auditentry_actor" to table: "audit_entries"
+DROP INDEX "auditentry_actor";
+-- reverse: create index "auditentry_action" to table: "audit_entries"
+DROP INDEX "auditentry_action";
+-- reverse: create index "audit_entries_hash_key" to table: "audit_entries"
+DROP INDEX "audit_entries_hash_key";
+-- reverse: create "audit_entries" table
+DROP TABLE "audit_entries";
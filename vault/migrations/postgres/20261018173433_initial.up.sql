@@ -0,0 +1,58 @@
+-- create "audit_entries" table
+CREATE TABLE "audit_entries" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "created_at" timestamptz NOT NULL, "actor" character varying NOT NULL, "action" character varying NOT NULL, "target_type" character varying NULL, "target" character varying NULL, "outcome" character varying NOT NULL, "request_id" character varying NULL, "details" jsonb NULL, "prev_hash" character varying NOT NULL, "hash" character varying NOT NULL, PRIMARY KEY ("id"));
+-- create index "audit_entries_hash_key" to table: "audit_entries"
+CREATE UNIQUE INDEX "audit_entries_hash_key" ON "audit_entries" ("hash");
+-- create index "auditentry_action" to table: "audit_entries"
+CREATE INDEX "auditentry_action" ON "audit_entries" ("action");
+-- create index "auditentry_actor" to table: "audit_entries"
+CREATE INDEX "auditentry_actor" ON "audit_entries" ("actor");
+-- create index "auditentry_target" to table: "audit_entries"
+CREATE INDEX "auditentry_target" ON "audit_entries" ("target");
+-- create index "auditentry_created_at" to table: "audit_entries"
+CREATE INDEX "auditentry_created_at" ON "audit_entries" ("created_at");
+-- create "trusted_issuers" table
+CREATE TABLE "trusted_issuers" ("id" character varying NOT NULL, "credentials" jsonb NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create "credential_templates" table
+CREATE TABLE "credential_templates" ("id" character varying NOT NULL, "name" character varying NOT NULL, "version" bigint NOT NULL, "status" character varying NOT NULL DEFAULT 'draft', "description" character varying NULL, "content" text NOT NULL, "sample_data" jsonb NULL, "builtin" boolean NOT NULL DEFAULT false, "published_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "credentialtemplate_name_version" to table: "credential_templates"
+CREATE UNIQUE INDEX "credentialtemplate_name_version" ON "credential_templates" ("name", "version");
+-- create "relying_parties" table
+CREATE TABLE "relying_parties" ("id" character varying NOT NULL, "name" character varying NULL, "redirect_uris" jsonb NOT NULL, "credential_types" jsonb NULL, "presentation_definition" jsonb NULL, "scope" character varying NULL, "audience" character varying NULL, "token_lifetime" character varying NULL, "signing_alg" character varying NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create "public_keys" table
+CREATE TABLE "public_keys" ("id" character varying NOT NULL, "kty" character varying NOT NULL, "alg" character varying NULL, "jwk" jsonb NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create "presentation_nonces" table
+CREATE TABLE "presentation_nonces" ("id" character varying NOT NULL, "state" character varying NOT NULL, "client_id" character varying NULL, "audience" character varying NOT NULL, "created_at" timestamptz NOT NULL, "expires_at" timestamptz NOT NULL, "consumed_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "presentationnonce_state" to table: "presentation_nonces"
+CREATE INDEX "presentationnonce_state" ON "presentation_nonces" ("state");
+-- create index "presentationnonce_expires_at" to table: "presentation_nonces"
+CREATE INDEX "presentationnonce_expires_at" ON "presentation_nonces" ("expires_at");
+-- create "natural_persons" table
+CREATE TABLE "natural_persons" ("id" character varying NOT NULL, "name" character varying NOT NULL, "displayname" character varying NULL, "type" character varying NOT NULL, "password" bytea NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create "users" table
+CREATE TABLE "users" ("id" character varying NOT NULL, "name" character varying NOT NULL, "displayname" character varying NULL, "type" character varying NOT NULL, "password" bytea NOT NULL, "roles" jsonb NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create "credentials" table
+CREATE TABLE "credentials" ("id" character varying NOT NULL, "type" character varying NOT NULL DEFAULT 'jwt_vc', "raw" jsonb NOT NULL, "status" character varying NOT NULL DEFAULT 'active', "expires_at" timestamptz NULL, "revoked_at" timestamptz NULL, "renewal_due_at" timestamptz NULL, "issuance_data" jsonb NULL, "types" jsonb NULL, "credential_type" character varying NULL, "issuer" character varying NULL, "subject" character varying NULL, "holder_email" character varying NULL, "template_name" character varying NULL, "issued_at" timestamptz NULL, "indexed_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "credential_successor" character varying NULL, "credential_template_credentials" character varying NULL, "natural_person_credentials" character varying NULL, "user_credentials" character varying NULL, PRIMARY KEY ("id"), CONSTRAINT "credentials_credentials_successor" FOREIGN KEY ("credential_successor") REFERENCES "credentials" ("id") ON DELETE SET NULL, CONSTRAINT "credentials_credential_templates_credentials" FOREIGN KEY ("credential_template_credentials") REFERENCES "credential_templates" ("id") ON DELETE SET NULL, CONSTRAINT "credentials_natural_persons_credentials" FOREIGN KEY ("natural_person_credentials") REFERENCES "natural_persons" ("id") ON DELETE SET NULL, CONSTRAINT "credentials_users_credentials" FOREIGN KEY ("user_credentials") REFERENCES "users" ("id") ON DELETE SET NULL);
+-- create index "credentials_credential_successor_key" to table: "credentials"
+CREATE UNIQUE INDEX "credentials_credential_successor_key" ON "credentials" ("credential_successor");
+-- create index "credential_status_expires_at" to table: "credentials"
+CREATE INDEX "credential_status_expires_at" ON "credentials" ("status", "expires_at");
+-- create index "credential_credential_type" to table: "credentials"
+CREATE INDEX "credential_credential_type" ON "credentials" ("credential_type");
+-- create index "credential_subject" to table: "credentials"
+CREATE INDEX "credential_subject" ON "credentials" ("subject");
+-- create index "credential_holder_email" to table: "credentials"
+CREATE INDEX "credential_holder_email" ON "credentials" ("holder_email");
+-- create index "credential_issued_at" to table: "credentials"
+CREATE INDEX "credential_issued_at" ON "credentials" ("issued_at");
+-- create index "credential_created_at" to table: "credentials"
+CREATE INDEX "credential_created_at" ON "credentials" ("created_at");
+-- create "di_ds" table
+CREATE TABLE "di_ds" ("id" character varying NOT NULL, "method" character varying NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "user_dids" character varying NULL, PRIMARY KEY ("id"), CONSTRAINT "di_ds_users_dids" FOREIGN KEY ("user_dids") REFERENCES "users" ("id") ON DELETE SET NULL);
+-- create "issuance_requests" table
+CREATE TABLE "issuance_requests" ("id" character varying NOT NULL, "credential_type" character varying NOT NULL, "claims" jsonb NOT NULL, "subject_did" character varying NULL, "status" character varying NOT NULL DEFAULT 'pending', "requested_by" character varying NOT NULL, "decided_by" character varying NULL, "reason" character varying NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "issuance_request_credential" character varying NULL, PRIMARY KEY ("id"), CONSTRAINT "issuance_requests_credentials_credential" FOREIGN KEY ("issuance_request_credential") REFERENCES "credentials" ("id") ON DELETE SET NULL);
+-- create index "issuancerequest_status" to table: "issuance_requests"
+CREATE INDEX "issuancerequest_status" ON "issuance_requests" ("status");
+-- create "issuance_request_events" table
+CREATE TABLE "issuance_request_events" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "from_status" character varying NULL, "to_status" character varying NOT NULL, "actor" character varying NOT NULL, "reason" character varying NULL, "created_at" timestamptz NOT NULL, "issuance_request_events" character varying NULL, PRIMARY KEY ("id"), CONSTRAINT "issuance_request_events_issuance_requests_events" FOREIGN KEY ("issuance_request_events") REFERENCES "issuance_requests" ("id") ON DELETE SET NULL);
+-- create "private_keys" table
+CREATE TABLE "private_keys" ("id" character varying NOT NULL, "kty" character varying NOT NULL, "alg" character varying NULL, "jwk" jsonb NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "natural_person_keys" character varying NULL, "user_keys" character varying NULL, PRIMARY KEY ("id"), CONSTRAINT "private_keys_natural_persons_keys" FOREIGN KEY ("natural_person_keys") REFERENCES "natural_persons" ("id") ON DELETE SET NULL, CONSTRAINT "private_keys_users_keys" FOREIGN KEY ("user_keys") REFERENCES "users" ("id") ON DELETE SET NULL);
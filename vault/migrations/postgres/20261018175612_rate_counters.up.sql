@@ -0,0 +1,4 @@
+-- create "rate_counters" table
+CREATE TABLE "rate_counters" ("id" character varying NOT NULL, "count" bigint NOT NULL DEFAULT 0, "expires_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "ratecounter_expires_at" to table: "rate_counters"
+CREATE INDEX "ratecounter_expires_at" ON "rate_counters" ("expires_at");
@@ -0,0 +1,4 @@
+-- reverse: create index "clientcertificate_user" to table: "client_certificates"
+DROP INDEX "clientcertificate_user";
+-- reverse: create "client_certificates" table
+DROP TABLE "client_certificates";
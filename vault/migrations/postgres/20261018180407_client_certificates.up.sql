@@ -0,0 +1,4 @@
+-- create "client_certificates" table
+CREATE TABLE "client_certificates" ("id" character varying NOT NULL, "user" character varying NOT NULL, "subject" character varying NULL, "not_after" timestamptz NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "clientcertificate_user" to table: "client_certificates"
+CREATE INDEX "clientcertificate_user" ON "client_certificates" ("user");
@@ -0,0 +1,4 @@
+-- modify "credentials" table
+ALTER TABLE "credentials" ADD COLUMN "renewal_started_at" timestamptz NULL;
+-- modify "issuance_requests" table
+ALTER TABLE "issuance_requests" ADD COLUMN "predecessor" character varying NULL, ADD COLUMN "revoke_predecessor" boolean NOT NULL DEFAULT false;
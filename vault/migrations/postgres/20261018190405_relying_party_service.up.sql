@@ -0,0 +1,2 @@
+-- modify "relying_parties" table
+ALTER TABLE "relying_parties" ADD COLUMN "service" character varying NULL;
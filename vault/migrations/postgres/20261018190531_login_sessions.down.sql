@@ -0,0 +1,4 @@
+-- reverse: create index "loginsession_expires_at" to table: "login_sessions"
+DROP INDEX "loginsession_expires_at";
+-- reverse: create "login_sessions" table
+DROP TABLE "login_sessions";
@@ -0,0 +1,4 @@
+-- create "login_sessions" table
+CREATE TABLE "login_sessions" ("id" character varying NOT NULL, "client_id" character varying NULL, "return_url" character varying NULL, "credential" text NULL, "created_at" timestamptz NOT NULL, "expires_at" timestamptz NOT NULL, "completed_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "loginsession_expires_at" to table: "login_sessions"
+CREATE INDEX "loginsession_expires_at" ON "login_sessions" ("expires_at");
@@ -0,0 +1,58 @@
+-- create "audit_entries" table
+CREATE TABLE `audit_entries` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `created_at` datetime NOT NULL, `actor` text NOT NULL, `action` text NOT NULL, `target_type` text NULL, `target` text NULL, `outcome` text NOT NULL, `request_id` text NULL, `details` json NULL, `prev_hash` text NOT NULL, `hash` text NOT NULL);
+-- create index "audit_entries_hash_key" to table: "audit_entries"
+CREATE UNIQUE INDEX `audit_entries_hash_key` ON `audit_entries` (`hash`);
+-- create index "auditentry_action" to table: "audit_entries"
+CREATE INDEX `auditentry_action` ON `audit_entries` (`action`);
+-- create index "auditentry_actor" to table: "audit_entries"
+CREATE INDEX `auditentry_actor` ON `audit_entries` (`actor`);
+-- create index "auditentry_target" to table: "audit_entries"
+CREATE INDEX `auditentry_target` ON `audit_entries` (`target`);
+-- create index "auditentry_created_at" to table: "audit_entries"
+CREATE INDEX `auditentry_created_at` ON `audit_entries` (`created_at`);
+-- create "credentials" table
+CREATE TABLE `credentials` (`id` text NOT NULL, `type` text NOT NULL DEFAULT 'jwt_vc', `raw` json NOT NULL, `status` text NOT NULL DEFAULT 'active', `expires_at` datetime NULL, `revoked_at` datetime NULL, `renewal_due_at` datetime NULL, `issuance_data` json NULL, `types` json NULL, `credential_type` text NULL, `issuer` text NULL, `subject` text NULL, `holder_email` text NULL, `template_name` text NULL, `issued_at` datetime NULL, `indexed_at` datetime NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `credential_successor` text NULL, `credential_template_credentials` text NULL, `natural_person_credentials` text NULL, `user_credentials` text NULL, PRIMARY KEY (`id`), CONSTRAINT `credentials_credentials_successor` FOREIGN KEY (`credential_successor`) REFERENCES `credentials` (`id`) ON DELETE SET NULL, CONSTRAINT `credentials_credential_templates_credentials` FOREIGN KEY (`credential_template_credentials`) REFERENCES `credential_templates` (`id`) ON DELETE SET NULL, CONSTRAINT `credentials_natural_persons_credentials` FOREIGN KEY (`natural_person_credentials`) REFERENCES `natural_persons` (`id`) ON DELETE SET NULL, CONSTRAINT `credentials_users_credentials` FOREIGN KEY (`user_credentials`) REFERENCES `users` (`id`) ON DELETE SET NULL);
+-- create index "credentials_credential_successor_key" to table: "credentials"
+CREATE UNIQUE INDEX `credentials_credential_successor_key` ON `credentials` (`credential_successor`);
+-- create index "credential_status_expires_at" to table: "credentials"
+CREATE INDEX `credential_status_expires_at` ON `credentials` (`status`, `expires_at`);
+-- create index "credential_credential_type" to table: "credentials"
+CREATE INDEX `credential_credential_type` ON `credentials` (`credential_type`);
+-- create index "credential_subject" to table: "credentials"
+CREATE INDEX `credential_subject` ON `credentials` (`subject`);
+-- create index "credential_holder_email" to table: "credentials"
+CREATE INDEX `credential_holder_email` ON `credentials` (`holder_email`);
+-- create index "credential_issued_at" to table: "credentials"
+CREATE INDEX `credential_issued_at` ON `credentials` (`issued_at`);
+-- create index "credential_created_at" to table: "credentials"
+CREATE INDEX `credential_created_at` ON `credentials` (`created_at`);
+-- create "credential_templates" table
+CREATE TABLE `credential_templates` (`id` text NOT NULL, `name` text NOT NULL, `version` integer NOT NULL, `status` text NOT NULL DEFAULT 'draft', `description` text NULL, `content` text NOT NULL, `sample_data` json NULL, `builtin` bool NOT NULL DEFAULT false, `published_at` datetime NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, PRIMARY KEY (`id`));
+-- create index "credentialtemplate_name_version" to table: "credential_templates"
+CREATE UNIQUE INDEX `credentialtemplate_name_version` ON `credential_templates` (`name`, `version`);
+-- create "di_ds" table
+CREATE TABLE `di_ds` (`id` text NOT NULL, `method` text NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `user_dids` text NULL, PRIMARY KEY (`id`), CONSTRAINT `di_ds_users_dids` FOREIGN KEY (`user_dids`) REFERENCES `users` (`id`) ON DELETE SET NULL);
+-- create "issuance_requests" table
+CREATE TABLE `issuance_requests` (`id` text NOT NULL, `credential_type` text NOT NULL, `claims` json NOT NULL, `subject_did` text NULL, `status` text NOT NULL DEFAULT 'pending', `requested_by` text NOT NULL, `decided_by` text NULL, `reason` text NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `issuance_request_credential` text NULL, PRIMARY KEY (`id`), CONSTRAINT `issuance_requests_credentials_credential` FOREIGN KEY (`issuance_request_credential`) REFERENCES `credentials` (`id`) ON DELETE SET NULL);
+-- create index "issuancerequest_status" to table: "issuance_requests"
+CREATE INDEX `issuancerequest_status` ON `issuance_requests` (`status`);
+-- create "issuance_request_events" table
+CREATE TABLE `issuance_request_events` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `from_status` text NULL, `to_status` text NOT NULL, `actor` text NOT NULL, `reason` text NULL, `created_at` datetime NOT NULL, `issuance_request_events` text NULL, CONSTRAINT `issuance_request_events_issuance_requests_events` FOREIGN KEY (`issuance_request_events`) REFERENCES `issuance_requests` (`id`) ON DELETE SET NULL);
+-- create "natural_persons" table
+CREATE TABLE `natural_persons` (`id` text NOT NULL, `name` text NOT NULL, `displayname` text NULL, `type` text NOT NULL, `password` blob NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, PRIMARY KEY (`id`));
+-- create "presentation_nonces" table
+CREATE TABLE `presentation_nonces` (`id` text NOT NULL, `state` text NOT NULL, `client_id` text NULL, `audience` text NOT NULL, `created_at` datetime NOT NULL, `expires_at` datetime NOT NULL, `consumed_at` datetime NULL, PRIMARY KEY (`id`));
+-- create index "presentationnonce_state" to table: "presentation_nonces"
+CREATE INDEX `presentationnonce_state` ON `presentation_nonces` (`state`);
+-- create index "presentationnonce_expires_at" to table: "presentation_nonces"
+CREATE INDEX `presentationnonce_expires_at` ON `presentation_nonces` (`expires_at`);
+-- create "private_keys" table
+CREATE TABLE `private_keys` (`id` text NOT NULL, `kty` text NOT NULL, `alg` text NULL, `jwk` json NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `natural_person_keys` text NULL, `user_keys` text NULL, PRIMARY KEY (`id`), CONSTRAINT `private_keys_natural_persons_keys` FOREIGN KEY (`natural_person_keys`) REFERENCES `natural_persons` (`id`) ON DELETE SET NULL, CONSTRAINT `private_keys_users_keys` FOREIGN KEY (`user_keys`) REFERENCES `users` (`id`) ON DELETE SET NULL);
+-- create "public_keys" table
+CREATE TABLE `public_keys` (`id` text NOT NULL, `kty` text NOT NULL, `alg` text NULL, `jwk` json NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, PRIMARY KEY (`id`));
+-- create "relying_parties" table
+CREATE TABLE `relying_parties` (`id` text NOT NULL, `name` text NULL, `redirect_uris` json NOT NULL, `credential_types` json NULL, `presentation_definition` json NULL, `scope` text NULL, `audience` text NULL, `token_lifetime` text NULL, `signing_alg` text NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, PRIMARY KEY (`id`));
+-- create "trusted_issuers" table
+CREATE TABLE `trusted_issuers` (`id` text NOT NULL, `credentials` json NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, PRIMARY KEY (`id`));
+-- create "users" table
+CREATE TABLE `users` (`id` text NOT NULL, `name` text NOT NULL, `displayname` text NULL, `type` text NOT NULL, `password` blob NOT NULL, `roles` json NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, PRIMARY KEY (`id`));
@@ -0,0 +1,4 @@
+-- reverse: create index "ratecounter_expires_at" to table: "rate_counters"
+DROP INDEX `ratecounter_expires_at`;
+-- reverse: create "rate_counters" table
+DROP TABLE `rate_counters`;
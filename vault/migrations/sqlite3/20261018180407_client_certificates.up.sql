@@ -0,0 +1,4 @@
+-- create "client_certificates" table
+CREATE TABLE `client_certificates` (`id` text NOT NULL, `user` text NOT NULL, `subject` text NULL, `not_after` datetime NOT NULL, `created_at` datetime NOT NULL, PRIMARY KEY (`id`));
+-- create index "clientcertificate_user" to table: "client_certificates"
+CREATE INDEX `clientcertificate_user` ON `client_certificates` (`user`);
@@ -0,0 +1,6 @@
+-- reverse: add column "revoke_predecessor" to table: "issuance_requests"
+ALTER TABLE `issuance_requests` DROP COLUMN `revoke_predecessor`;
+-- reverse: add column "predecessor" to table: "issuance_requests"
+ALTER TABLE `issuance_requests` DROP COLUMN `predecessor`;
+-- reverse: add column "renewal_started_at" to table: "credentials"
+ALTER TABLE `credentials` DROP COLUMN `renewal_started_at`;
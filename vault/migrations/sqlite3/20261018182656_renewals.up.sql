@@ -0,0 +1,16 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- add column "renewal_started_at" to table: "credentials"
+ALTER TABLE `credentials` ADD COLUMN `renewal_started_at` datetime NULL;
+-- create "new_issuance_requests" table
+CREATE TABLE `new_issuance_requests` (`id` text NOT NULL, `credential_type` text NOT NULL, `claims` json NOT NULL, `subject_did` text NULL, `predecessor` text NULL, `revoke_predecessor` bool NOT NULL DEFAULT false, `status` text NOT NULL DEFAULT 'pending', `requested_by` text NOT NULL, `decided_by` text NULL, `reason` text NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `issuance_request_credential` text NULL, PRIMARY KEY (`id`), CONSTRAINT `issuance_requests_credentials_credential` FOREIGN KEY (`issuance_request_credential`) REFERENCES `credentials` (`id`) ON DELETE SET NULL);
+-- copy rows from old table "issuance_requests" to new temporary table "new_issuance_requests"
+INSERT INTO `new_issuance_requests` (`id`, `credential_type`, `claims`, `subject_did`, `status`, `requested_by`, `decided_by`, `reason`, `created_at`, `updated_at`, `issuance_request_credential`) SELECT `id`, `credential_type`, `claims`, `subject_did`, `status`, `requested_by`, `decided_by`, `reason`, `created_at`, `updated_at`, `issuance_request_credential` FROM `issuance_requests`;
+-- drop "issuance_requests" table after copying rows
+DROP TABLE `issuance_requests`;
+-- rename temporary table "new_issuance_requests" to "issuance_requests"
+ALTER TABLE `new_issuance_requests` RENAME TO `issuance_requests`;
+-- create index "issuancerequest_status" to table: "issuance_requests"
+CREATE INDEX `issuancerequest_status` ON `issuance_requests` (`status`);
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,2 @@
+-- reverse: add column "service" to table: "relying_parties"
+ALTER TABLE `relying_parties` DROP COLUMN `service`;
@@ -0,0 +1,2 @@
+-- add column "service" to table: "relying_parties"
+ALTER TABLE `relying_parties` ADD COLUMN `service` text NULL;
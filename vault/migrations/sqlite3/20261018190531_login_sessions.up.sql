@@ -0,0 +1,4 @@
+-- create "login_sessions" table
+CREATE TABLE `login_sessions` (`id` text NOT NULL, `client_id` text NULL, `return_url` text NULL, `credential` text NULL, `created_at` datetime NOT NULL, `expires_at` datetime NOT NULL, `completed_at` datetime NULL, PRIMARY KEY (`id`));
+-- create index "loginsession_expires_at" to table: "login_sessions"
+CREATE INDEX `loginsession_expires_at` ON `login_sessions` (`expires_at`);
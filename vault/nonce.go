@@ -0,0 +1,153 @@
+package vault
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hesusruiz/vcbackend/ent"
+	"github.com/hesusruiz/vcbackend/ent/presentationnonce"
+)
+
+var (
+	ErrNonceRequired = errors.New("the presentation does not have a nonce")
+	ErrNonceUnknown  = errors.New("the nonce of the presentation was not issued by the verifier")
+	ErrNonceExpired  = errors.New("the nonce of the presentation has expired")
+	ErrNonceReplayed = errors.New("the nonce of the presentation was already used")
+	ErrNonceMismatch = errors.New("the nonce of the presentation is for another request")
+)
+
+// nonceRetention is the time during which the expired nonces are kept, so their replays are
+// still reported as such
+const nonceRetention = 24 * time.Hour
+
+// Nonce is a nonce sent in a SIOP request
+type Nonce struct {
+	Value     string
+	State     string
+	ClientID  string
+	Audience  string
+	ExpiresAt time.Time
+}
+
+// IssueNonce returns the nonce for the SIOP request with the state, creating it if there is no valid one.
+// The nonce is stored in the vault, so any instance of the verifier sharing the database can check it.
+func (v *Vault) IssueNonce(state string, clientID string, audience string, lifetime time.Duration) (*Nonce, error) {
+
+	ctx := v.dbContext()
+	now := time.Now()
+
+	// The requests for the same login, in the same or in another device, share the nonce
+	existing, err := v.Client.PresentationNonce.Query().
+		Where(
+			presentationnonce.State(state),
+			presentationnonce.ClientID(clientID),
+			presentationnonce.Audience(audience),
+			presentationnonce.ConsumedAtIsNil(),
+			presentationnonce.ExpiresAtGT(now),
+		).
+		First(ctx)
+	if err == nil {
+		return nonceFromEntry(existing), nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	// Remove the nonces which will not be checked anymore
+	if _, err := v.Client.PresentationNonce.Delete().
+		Where(presentationnonce.ExpiresAtLT(now.Add(-nonceRetention))).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+
+	entry, err := v.Client.PresentationNonce.Create().
+		SetID(base64.RawURLEncoding.EncodeToString(b)).
+		SetState(state).
+		SetClientID(clientID).
+		SetAudience(audience).
+		SetExpiresAt(now.Add(lifetime)).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return nonceFromEntry(entry), nil
+}
+
+// ConsumeNonce checks that the nonce of a presentation was issued for the state and the audience,
+// and marks it as used. The check and the mark are a single update of the database, so the nonce
+// can be used only once even with several instances of the verifier.
+func (v *Vault) ConsumeNonce(nonce string, state string, audience string) (*Nonce, error) {
+
+	if len(nonce) == 0 {
+		return nil, ErrNonceRequired
+	}
+
+	ctx := v.dbContext()
+	now := time.Now()
+
+	n, err := v.Client.PresentationNonce.Update().
+		Where(
+			presentationnonce.ID(nonce),
+			presentationnonce.State(state),
+			presentationnonce.Audience(audience),
+			presentationnonce.ConsumedAtIsNil(),
+			presentationnonce.ExpiresAtGT(now),
+		).
+		SetConsumedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := v.Client.PresentationNonce.Get(ctx, nonce)
+	if ent.IsNotFound(err) {
+		return nil, ErrNonceUnknown
+	}
+	if err != nil {
+		return nil, err
+	}
+	if n == 1 {
+		return nonceFromEntry(entry), nil
+	}
+
+	// Explain why the nonce was not accepted
+	switch {
+	case entry.State != state:
+		return nil, fmt.Errorf("%w: the state does not match", ErrNonceMismatch)
+	case entry.Audience != audience:
+		return nil, fmt.Errorf("%w: the audience does not match", ErrNonceMismatch)
+	case entry.ConsumedAt != nil:
+		return nil, ErrNonceReplayed
+	default:
+		return nil, ErrNonceExpired
+	}
+}
+
+// ActiveNonces returns the number of nonces which have not been used and have not expired,
+// which is the number of SIOP sessions waiting for a presentation
+func (v *Vault) ActiveNonces() (int, error) {
+	return v.Client.PresentationNonce.Query().
+		Where(
+			presentationnonce.ConsumedAtIsNil(),
+			presentationnonce.ExpiresAtGT(time.Now()),
+		).
+		Count(v.dbContext())
+}
+
+func nonceFromEntry(entry *ent.PresentationNonce) *Nonce {
+	return &Nonce{
+		Value:     entry.ID,
+		State:     entry.State,
+		ClientID:  entry.ClientID,
+		Audience:  entry.Audience,
+		ExpiresAt: entry.ExpiresAt,
+	}
+}
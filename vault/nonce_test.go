@@ -0,0 +1,126 @@
+package vault
+
+import (
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hesusruiz/vcutils/yaml"
+)
+
+func TestNonce(t *testing.T) {
+	v := newTestVault(t)
+
+	n, err := v.IssueNonce("state1", "orders", "did:key:verifier", time.Minute)
+	if err != nil {
+		t.Fatalf("IssueNonce() error = %v", err)
+	}
+	if len(n.Value) == 0 || n.ClientID != "orders" {
+		t.Errorf("IssueNonce() = %+v", n)
+	}
+
+	// The requests for the same login share the nonce
+	again, err := v.IssueNonce("state1", "orders", "did:key:verifier", time.Minute)
+	if err != nil || again.Value != n.Value {
+		t.Errorf("IssueNonce() for the same state = %+v, %v, want %s", again, err, n.Value)
+	}
+
+	tests := []struct {
+		name     string
+		nonce    string
+		state    string
+		audience string
+		wantErr  error
+	}{
+		{"required", "", "state1", "did:key:verifier", ErrNonceRequired},
+		{"unknown", "abc", "state1", "did:key:verifier", ErrNonceUnknown},
+		{"other state", n.Value, "state2", "did:key:verifier", ErrNonceMismatch},
+		{"other audience", n.Value, "state1", "did:key:other", ErrNonceMismatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := v.ConsumeNonce(tt.nonce, tt.state, tt.audience); !errors.Is(err, tt.wantErr) {
+				t.Errorf("ConsumeNonce() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	consumed, err := v.ConsumeNonce(n.Value, "state1", "did:key:verifier")
+	if err != nil || consumed.ClientID != "orders" {
+		t.Fatalf("ConsumeNonce() = %+v, %v", consumed, err)
+	}
+	if _, err := v.ConsumeNonce(n.Value, "state1", "did:key:verifier"); !errors.Is(err, ErrNonceReplayed) {
+		t.Errorf("ConsumeNonce() replayed error = %v", err)
+	}
+
+	// A new nonce is issued once the previous one is used
+	next, err := v.IssueNonce("state1", "orders", "did:key:verifier", time.Minute)
+	if err != nil || next.Value == n.Value {
+		t.Errorf("IssueNonce() after consuming = %+v, %v", next, err)
+	}
+
+	expired, _ := v.IssueNonce("state3", "", "did:key:verifier", -time.Second)
+	if _, err := v.ConsumeNonce(expired.Value, "state3", "did:key:verifier"); !errors.Is(err, ErrNonceExpired) {
+		t.Errorf("ConsumeNonce() expired error = %v", err)
+	}
+
+	// Only the nonce issued after the used one is waiting for a presentation
+	if active, err := v.ActiveNonces(); err != nil || active != 1 {
+		t.Errorf("ActiveNonces() = %d, %v, want 1", active, err)
+	}
+}
+
+// TestNonceInstances consumes the same nonce concurrently from two vaults sharing the database,
+// like two instances of the verifier. Only one of them may accept it.
+func TestNonceInstances(t *testing.T) {
+
+	cfg := yaml.New(map[string]any{
+		"store": map[string]any{
+			"driverName":     "sqlite3",
+			"autoMigrate":    true,
+			"dataSourceName": "file:" + filepath.Join(t.TempDir(), "verifier.sqlite") + "?mode=rwc&_fk=1&_busy_timeout=5000",
+		},
+	})
+	var instances []*Vault
+	for i := 0; i < 2; i++ {
+		v, err := New(cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { v.Client.Close() })
+		instances = append(instances, v)
+	}
+
+	n, err := instances[0].IssueNonce("state", "", "did:key:verifier", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	results := make(chan error, 10)
+	for i := 0; i < cap(results); i++ {
+		wg.Add(1)
+		go func(v *Vault) {
+			defer wg.Done()
+			_, err := v.ConsumeNonce(n.Value, "state", "did:key:verifier")
+			results <- err
+		}(instances[i%2])
+	}
+	wg.Wait()
+	close(results)
+
+	accepted := 0
+	for err := range results {
+		switch {
+		case err == nil:
+			accepted++
+		case !errors.Is(err, ErrNonceReplayed):
+			t.Errorf("ConsumeNonce() error = %v", err)
+		}
+	}
+	if accepted != 1 {
+		t.Errorf("the nonce was accepted %d times", accepted)
+	}
+}
@@ -0,0 +1,11 @@
+package vault
+
+import (
+	"context"
+)
+
+// Ping checks that the database of the vault can be queried
+func (v *Vault) Ping(ctx context.Context) error {
+	_, err := v.Client.User.Query().Limit(1).IDs(ctx)
+	return err
+}
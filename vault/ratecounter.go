@@ -0,0 +1,67 @@
+package vault
+
+import (
+	"context"
+	"time"
+
+	"github.com/hesusruiz/vcbackend/ent"
+	"github.com/hesusruiz/vcbackend/ent/ratecounter"
+	"github.com/hesusruiz/vcbackend/internal/ratelimit"
+)
+
+// RateCounter returns a counter of the rate limits stored in the vault, so all the instances of the
+// server sharing the database count the requests of a client together
+func (v *Vault) RateCounter() ratelimit.Counter {
+	return &rateCounter{v: v}
+}
+
+type rateCounter struct {
+	v *Vault
+}
+
+// Add implements ratelimit.Counter. The count is incremented in a single update, and created by the
+// first event of the window.
+func (r *rateCounter) Add(ctx context.Context, key string, window time.Duration, now time.Time, n int) (int, error) {
+
+	client := r.v.Client
+	id := ratelimit.WindowKey(key, window, now)
+
+	if n > 0 {
+		updated, err := client.RateCounter.Update().Where(ratecounter.ID(id)).AddCount(n).Save(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		if updated == 0 {
+			// Remove the counts of the past windows
+			if _, err := client.RateCounter.Delete().Where(ratecounter.ExpiresAtLT(now)).Exec(ctx); err != nil {
+				return 0, err
+			}
+
+			_, end := ratelimit.Window(window, now)
+			err := client.RateCounter.Create().SetID(id).SetCount(n).SetExpiresAt(end).Exec(ctx)
+			if ent.IsConstraintError(err) {
+				// Created by another request at the same time
+				_, err = client.RateCounter.Update().Where(ratecounter.ID(id)).AddCount(n).Save(ctx)
+			}
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	counter, err := client.RateCounter.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return counter.Count, nil
+}
+
+// Reset implements ratelimit.Counter
+func (r *rateCounter) Reset(ctx context.Context, key string, window time.Duration, now time.Time) error {
+	_, err := r.v.Client.RateCounter.Delete().Where(ratecounter.ID(ratelimit.WindowKey(key, window, now))).Exec(ctx)
+	return err
+}
@@ -0,0 +1,47 @@
+package vault
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateCounter(t *testing.T) {
+	ctx := context.Background()
+	v := newTestVault(t)
+	counter := v.RateCounter()
+	now := time.Unix(1000, 0)
+
+	if n, err := counter.Add(ctx, "ip:10.0.0.1", time.Minute, now, 0); err != nil || n != 0 {
+		t.Fatalf("Add() of a new key without events = %d, %v", n, err)
+	}
+	for i := 1; i <= 3; i++ {
+		if n, err := counter.Add(ctx, "ip:10.0.0.1", time.Minute, now, 1); err != nil || n != i {
+			t.Fatalf("Add() = %d, %v, want %d", n, err, i)
+		}
+	}
+	if n, _ := counter.Add(ctx, "ip:10.0.0.1", time.Minute, now, 0); n != 3 {
+		t.Errorf("Add() without events = %d, want 3", n)
+	}
+
+	// Another instance of the server sharing the vault counts with the same counts
+	if n, _ := v.RateCounter().Add(ctx, "ip:10.0.0.1", time.Minute, now, 2); n != 5 {
+		t.Errorf("Add() of another counter = %d, want 5", n)
+	}
+
+	// The next window starts again, and removes the counts of the past ones
+	later := now.Add(time.Minute)
+	if n, _ := counter.Add(ctx, "ip:10.0.0.1", time.Minute, later, 1); n != 1 {
+		t.Errorf("Add() in the next window = %d, want 1", n)
+	}
+	if total, _ := v.Client.RateCounter.Query().Count(ctx); total != 1 {
+		t.Errorf("%d counts stored, want 1", total)
+	}
+
+	if err := counter.Reset(ctx, "ip:10.0.0.1", time.Minute, later); err != nil {
+		t.Fatal(err)
+	}
+	if n, _ := counter.Add(ctx, "ip:10.0.0.1", time.Minute, later, 0); n != 0 {
+		t.Errorf("Add() after Reset = %d, want 0", n)
+	}
+}
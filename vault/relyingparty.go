@@ -0,0 +1,119 @@
+package vault
+
+import (
+	"errors"
+	"time"
+
+	"github.com/hesusruiz/vcbackend/ent"
+	"github.com/hesusruiz/vcbackend/ent/relyingparty"
+	"github.com/hesusruiz/vcbackend/internal/rp"
+)
+
+var ErrRelyingPartyExists = errors.New("the relying party is already registered")
+
+// CreateRelyingParty registers a relying-party client
+func (v *Vault) CreateRelyingParty(c *rp.Client) error {
+
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	err := v.Client.RelyingParty.Create().
+		SetID(c.ID).
+		SetName(c.Name).
+		SetRedirectUris(c.RedirectURIs).
+		SetCredentialTypes(c.CredentialTypes).
+		SetPresentationDefinition(c.PresentationDefinition).
+		SetScope(c.Scope).
+		SetAudience(c.Audience).
+		SetTokenLifetime(c.TokenLifetime).
+		SetSigningAlg(c.SigningAlg).
+		SetService(c.Service).
+		Exec(v.dbContext())
+	if ent.IsConstraintError(err) {
+		return ErrRelyingPartyExists
+	}
+	return err
+}
+
+// UpdateRelyingParty replaces the registration of a relying-party client
+func (v *Vault) UpdateRelyingParty(c *rp.Client) error {
+
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	err := v.Client.RelyingParty.UpdateOneID(c.ID).
+		SetName(c.Name).
+		SetRedirectUris(c.RedirectURIs).
+		SetCredentialTypes(c.CredentialTypes).
+		SetPresentationDefinition(c.PresentationDefinition).
+		SetScope(c.Scope).
+		SetAudience(c.Audience).
+		SetTokenLifetime(c.TokenLifetime).
+		SetSigningAlg(c.SigningAlg).
+		SetService(c.Service).
+		SetUpdatedAt(time.Now()).
+		Exec(v.dbContext())
+	if ent.IsNotFound(err) {
+		return rp.ErrNotFound
+	}
+	return err
+}
+
+// DeleteRelyingParty removes the registration of a relying-party client
+func (v *Vault) DeleteRelyingParty(id string) error {
+	err := v.Client.RelyingParty.DeleteOneID(id).Exec(v.dbContext())
+	if ent.IsNotFound(err) {
+		return rp.ErrNotFound
+	}
+	return err
+}
+
+// RelyingParty returns a relying-party client, or rp.ErrNotFound
+func (v *Vault) RelyingParty(id string) (*rp.Client, error) {
+	entry, err := v.Client.RelyingParty.Get(v.dbContext(), id)
+	if ent.IsNotFound(err) {
+		return nil, rp.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &rp.Client{
+		ID:                     entry.ID,
+		Name:                   entry.Name,
+		RedirectURIs:           entry.RedirectUris,
+		CredentialTypes:        entry.CredentialTypes,
+		PresentationDefinition: entry.PresentationDefinition,
+		Scope:                  entry.Scope,
+		Audience:               entry.Audience,
+		TokenLifetime:          entry.TokenLifetime,
+		SigningAlg:             entry.SigningAlg,
+		Service:                entry.Service,
+	}, nil
+}
+
+// ListRelyingParties returns the ids of a page of the relying-party clients, in order, starting after
+// the given id, and the total number of clients
+func (v *Vault) ListRelyingParties(after string, limit int) ([]string, int, error) {
+
+	total, err := v.Client.RelyingParty.Query().Count(v.dbContext())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if limit <= 0 || limit > MaxPageSize {
+		limit = DefaultPageSize
+	}
+
+	ids, err := v.Client.RelyingParty.Query().
+		Where(relyingparty.IDGT(after)).
+		Order(ent.Asc(relyingparty.FieldID)).
+		Limit(limit).
+		IDs(v.dbContext())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return ids, total, nil
+}
@@ -0,0 +1,60 @@
+package vault
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hesusruiz/vcbackend/internal/rp"
+)
+
+func TestRelyingParties(t *testing.T) {
+	v := newTestVault(t)
+
+	for _, id := range []string{"orders", "billing"} {
+		c := &rp.Client{ID: id, RedirectURIs: []string{"https://" + id + ".example.com/callback"}}
+		if err := v.CreateRelyingParty(c); err != nil {
+			t.Fatalf("CreateRelyingParty(%s) error = %v", id, err)
+		}
+	}
+
+	if err := v.CreateRelyingParty(&rp.Client{ID: "orders", RedirectURIs: []string{"https://x.example.com"}}); !errors.Is(err, ErrRelyingPartyExists) {
+		t.Errorf("CreateRelyingParty() of an existing client error = %v", err)
+	}
+	if err := v.CreateRelyingParty(&rp.Client{ID: "nouris"}); !errors.Is(err, rp.ErrInvalidClient) {
+		t.Errorf("CreateRelyingParty() of an invalid client error = %v", err)
+	}
+
+	ids, total, err := v.ListRelyingParties("", 10)
+	if err != nil || total != 2 || len(ids) != 2 || ids[0] != "billing" {
+		t.Errorf("ListRelyingParties() = %v, %d, %v", ids, total, err)
+	}
+
+	update := &rp.Client{
+		ID:                     "orders",
+		RedirectURIs:           []string{"https://orders.example.com/login"},
+		CredentialTypes:        []string{"PacketDeliveryService"},
+		PresentationDefinition: map[string]any{"id": "orders"},
+		TokenLifetime:          "15m",
+		SigningAlg:             "ES256",
+		Service:                "packetdelivery",
+	}
+	if err := v.UpdateRelyingParty(update); err != nil {
+		t.Fatal(err)
+	}
+	c, err := v.RelyingParty("orders")
+	if err != nil || c.RedirectURIs[0] != "https://orders.example.com/login" || c.CredentialTypes[0] != "PacketDeliveryService" ||
+		c.PresentationDefinition["id"] != "orders" || c.TokenLifetime != "15m" || c.SigningAlg != "ES256" ||
+		c.Service != "packetdelivery" {
+		t.Errorf("RelyingParty() = %+v, %v", c, err)
+	}
+
+	if err := v.DeleteRelyingParty("orders"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.RelyingParty("orders"); !errors.Is(err, rp.ErrNotFound) {
+		t.Errorf("RelyingParty() of a deleted client error = %v", err)
+	}
+	if err := v.UpdateRelyingParty(update); !errors.Is(err, rp.ErrNotFound) {
+		t.Errorf("UpdateRelyingParty() of a deleted client error = %v", err)
+	}
+}
@@ -0,0 +1,274 @@
+package vault
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hesusruiz/vcbackend/ent"
+	"github.com/hesusruiz/vcbackend/ent/credential"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrCredentialNotFound   = errors.New("credential not found")
+	ErrCredentialRevoked    = errors.New("credential is revoked")
+	ErrCredentialRenewed    = errors.New("credential was already renewed")
+	ErrRenewalInProgress    = errors.New("credential is being renewed")
+	ErrRenewalNotSupported  = errors.New("credential can not be renewed by the vault")
+	ErrRenewalDataNotStored = errors.New("the data used for issuing the credential was not stored")
+)
+
+// Fields of the issuance data which are generated for each credential, and so are
+// not copied when a credential is renewed
+var perCredentialFields = []string{"jti", "validFrom", "expirationDate"}
+
+// renewalTimeout is the time after which a renewal which did not complete, like when the server stopped
+// while signing the new credential, can be started again
+const renewalTimeout = 5 * time.Minute
+
+// CredentialByID returns the credential with the given id
+func (v *Vault) CredentialByID(id string) (*ent.Credential, error) {
+	cred, err := v.Client.Credential.Get(v.dbContext(), id)
+	if ent.IsNotFound(err) {
+		return nil, ErrCredentialNotFound
+	}
+	return cred, err
+}
+
+// MarkRenewalCandidates flags the active credentials expiring before the given time
+// from now, which were not renewed yet. It returns the credentials flagged in this call.
+func (v *Vault) MarkRenewalCandidates(before time.Duration) ([]*ent.Credential, error) {
+
+	now := time.Now()
+
+	creds, err := v.Client.Credential.Query().
+		Where(
+			credential.StatusEQ(credential.StatusActive),
+			credential.ExpiresAtNotNil(),
+			credential.ExpiresAtLTE(now.Add(before)),
+			credential.RenewalDueAtIsNil(),
+			credential.Not(credential.HasSuccessor()),
+		).
+		All(v.dbContext())
+	if err != nil {
+		return nil, err
+	}
+
+	for i, cred := range creds {
+		creds[i], err = cred.Update().
+			SetRenewalDueAt(now).
+			Save(v.dbContext())
+		if err != nil {
+			return nil, err
+		}
+		v.logger().Infow("credential due for renewal", "id", cred.ID, "expires", *cred.ExpiresAt)
+	}
+
+	return creds, nil
+}
+
+// RenewalCandidates returns the active credentials flagged for renewal and not yet renewed,
+// ordered by expiration date
+func (v *Vault) RenewalCandidates() ([]*ent.Credential, error) {
+	return v.Client.Credential.Query().
+		Where(
+			credential.StatusEQ(credential.StatusActive),
+			credential.RenewalDueAtNotNil(),
+			credential.Not(credential.HasSuccessor()),
+		).
+		Order(ent.Asc(credential.FieldExpiresAt)).
+		All(v.dbContext())
+}
+
+// RenewalData returns the data for issuing a new credential with the same claims as the
+// credential with the given id. The claims in updated, if any, replace the original ones.
+func (v *Vault) RenewalData(id string, updated map[string]any) (map[string]any, error) {
+
+	cred, err := v.CredentialByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if cred.Status == credential.StatusRevoked {
+		return nil, ErrCredentialRevoked
+	}
+	if renewed, _ := cred.QuerySuccessor().Exist(v.dbContext()); renewed {
+		return nil, ErrCredentialRenewed
+	}
+	if cred.IssuanceData == nil {
+		return nil, ErrRenewalDataNotStored
+	}
+
+	data := make(map[string]any, len(cred.IssuanceData))
+	for k, val := range cred.IssuanceData {
+		data[k] = val
+	}
+	for _, k := range perCredentialFields {
+		delete(data, k)
+	}
+
+	// Replace the claims which were updated
+	if len(updated) > 0 {
+		claims := map[string]any{}
+		if original, ok := data["claims"].(map[string]any); ok {
+			for k, val := range original {
+				claims[k] = val
+			}
+		}
+		for k, val := range updated {
+			claims[k] = val
+		}
+		data["claims"] = claims
+	}
+
+	return data, nil
+}
+
+// RenewCredential issues a new credential with the same claims as the credential with the given id,
+// updated with the claims received. It works for the credentials generated by the vault from a template.
+// The new credential is linked to the old one, which is revoked if revokePredecessor is true.
+func (v *Vault) RenewCredential(id string, updated map[string]any, revokePredecessor bool) (*ent.Credential, error) {
+
+	cred, err := v.CredentialByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if cred.Type != "jwt_vc" {
+		return nil, ErrRenewalNotSupported
+	}
+
+	if err := v.StartRenewal(id); err != nil {
+		return nil, err
+	}
+
+	data, err := v.RenewalData(id, updated)
+	if err != nil {
+		v.AbortRenewal(id)
+		return nil, err
+	}
+
+	newID, _, err := v.CreateCredentialJWTFromMap(data)
+	if err != nil {
+		v.AbortRenewal(id)
+		return nil, err
+	}
+
+	return v.CompleteRenewal(id, newID, revokePredecessor)
+}
+
+// StartRenewal reserves a credential for renewing it, before the new credential is signed, so only one
+// of several concurrent renewals of the credential signs a new one. The others fail with ErrRenewalInProgress,
+// or with ErrCredentialRenewed when the renewal completed. The reservation of a credential which is renewed
+// is kept, and the one of a renewal which did not complete expires after some minutes.
+func (v *Vault) StartRenewal(id string) error {
+
+	now := time.Now()
+
+	n, err := v.Client.Credential.Update().
+		Where(
+			credential.ID(id),
+			credential.Or(
+				credential.RenewalStartedAtIsNil(),
+				credential.RenewalStartedAtLT(now.Add(-renewalTimeout)),
+			),
+		).
+		SetRenewalStartedAt(now).
+		Save(v.dbContext())
+	if err != nil {
+		return err
+	}
+
+	// The credential is checked after reserving it, because its successor is only linked by the renewal
+	// which holds the reservation
+	cred, err := v.CredentialByID(id)
+	if err != nil {
+		return err
+	}
+	renewed, err := cred.QuerySuccessor().Exist(v.dbContext())
+	if err != nil {
+		return err
+	}
+	switch {
+	case renewed:
+		return ErrCredentialRenewed
+	case n == 0:
+		return ErrRenewalInProgress
+	case cred.Status == credential.StatusRevoked:
+		v.AbortRenewal(id)
+		return ErrCredentialRevoked
+	}
+
+	return nil
+}
+
+// AbortRenewal releases the reservation of a credential whose renewal failed, so it can be renewed again
+func (v *Vault) AbortRenewal(id string) {
+	err := v.Client.Credential.UpdateOneID(id).
+		ClearRenewalStartedAt().
+		Exec(v.dbContext())
+	if err != nil {
+		v.logger().Errorw("error releasing the renewal of the credential", "id", id, zap.Error(err))
+	}
+}
+
+// CompleteRenewal links a new credential to the one it renews, revoking the old one if requested
+func (v *Vault) CompleteRenewal(oldID string, newID string, revokePredecessor bool) (*ent.Credential, error) {
+
+	tx, err := v.Client.Tx(v.dbContext())
+	if err != nil {
+		return nil, err
+	}
+
+	renewed, err := tx.Credential.UpdateOneID(newID).
+		SetPredecessorID(oldID).
+		Save(v.dbContext())
+	if ent.IsConstraintError(err) {
+		tx.Rollback()
+		return nil, ErrCredentialRenewed
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if revokePredecessor {
+		err = tx.Credential.UpdateOneID(oldID).
+			SetStatus(credential.StatusRevoked).
+			SetRevokedAt(time.Now()).
+			Exec(v.dbContext())
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	v.logger().Infow("credential renewed", "old", oldID, "new", newID, "revoked", revokePredecessor)
+
+	return renewed.Unwrap(), nil
+}
+
+// RevokeCredential marks the credential as revoked
+func (v *Vault) RevokeCredential(id string) (*ent.Credential, error) {
+
+	cred, err := v.CredentialByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if cred.Status == credential.StatusRevoked {
+		return cred, nil
+	}
+
+	cred, err = cred.Update().
+		SetStatus(credential.StatusRevoked).
+		SetRevokedAt(time.Now()).
+		Save(v.dbContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed revoking credential: %w", err)
+	}
+	v.logger().Infow("credential revoked", "id", id)
+
+	return cred, nil
+}
@@ -0,0 +1,175 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/hesusruiz/vcbackend/ent/credential"
+)
+
+// newRenewableCredential issues a credential with the vault, storing the data for renewing it
+func newRenewableCredential(t *testing.T, v *Vault) string {
+	t.Helper()
+
+	if usr, _ := v.UserByID("issuer"); usr == nil {
+		if _, err := v.CreateUserWithKey("issuer", "Issuer", "legalperson", "pass"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	id, _, err := v.CreateCredentialJWTFromMap(map[string]any{
+		"credName":   "PacketDeliveryCredential",
+		"issuerDID":  "issuer",
+		"subjectDID": "did:key:holder",
+		"claims": map[string]any{
+			"given_name":  "John",
+			"family_name": "Doe",
+			"email":       "john@example.com",
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateCredentialJWTFromMap() error = %v", err)
+	}
+	return id
+}
+
+func TestRenewCredential(t *testing.T) {
+	ctx := context.Background()
+	v := newTestVault(t)
+	id := newRenewableCredential(t, v)
+
+	renewed, err := v.RenewCredential(id, map[string]any{"email": "john.doe@example.com"}, false)
+	if err != nil {
+		t.Fatalf("RenewCredential() error = %v", err)
+	}
+	if renewed.ID == id {
+		t.Fatal("the renewed credential has the id of its predecessor")
+	}
+
+	// The new credential is linked to its predecessor, which is still active
+	predecessor, err := renewed.QueryPredecessor().Only(ctx)
+	if err != nil || predecessor.ID != id || predecessor.Status != credential.StatusActive {
+		t.Errorf("predecessor = %v, %v", predecessor, err)
+	}
+	if successor, err := predecessor.QuerySuccessor().OnlyID(ctx); err != nil || successor != renewed.ID {
+		t.Errorf("successor = %s, %v", successor, err)
+	}
+
+	// The claims updated replace the original ones, and the others are kept
+	claims, _ := renewed.IssuanceData["claims"].(map[string]any)
+	if claims["email"] != "john.doe@example.com" || claims["given_name"] != "John" {
+		t.Errorf("claims of the renewed credential = %v", claims)
+	}
+
+	// A credential is renewed only once
+	if _, err := v.RenewCredential(id, nil, false); !errors.Is(err, ErrCredentialRenewed) {
+		t.Errorf("second RenewCredential() error = %v, want ErrCredentialRenewed", err)
+	}
+	if _, err := v.RenewalData(id, nil); !errors.Is(err, ErrCredentialRenewed) {
+		t.Errorf("RenewalData() of a renewed credential error = %v", err)
+	}
+
+	// The new credential can be renewed in turn
+	if _, err := v.RenewCredential(renewed.ID, nil, false); err != nil {
+		t.Errorf("RenewCredential() of the successor error = %v", err)
+	}
+}
+
+func TestRenewCredentialRevokePredecessor(t *testing.T) {
+	v := newTestVault(t)
+	id := newRenewableCredential(t, v)
+
+	if _, err := v.RenewCredential(id, nil, true); err != nil {
+		t.Fatalf("RenewCredential() error = %v", err)
+	}
+
+	predecessor, err := v.CredentialByID(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if predecessor.Status != credential.StatusRevoked || predecessor.RevokedAt == nil {
+		t.Errorf("predecessor status = %s, revokedAt = %v", predecessor.Status, predecessor.RevokedAt)
+	}
+
+	// Revoked credentials can not be renewed
+	revoked := newRenewableCredential(t, v)
+	if _, err := v.RevokeCredential(revoked); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.RenewCredential(revoked, nil, false); !errors.Is(err, ErrCredentialRevoked) {
+		t.Errorf("RenewCredential() of a revoked credential error = %v", err)
+	}
+	if _, err := v.RenewCredential("unknown", nil, false); !errors.Is(err, ErrCredentialNotFound) {
+		t.Errorf("RenewCredential() of an unknown credential error = %v", err)
+	}
+}
+
+func TestStartRenewal(t *testing.T) {
+	v := newTestVault(t)
+	id := newRenewableCredential(t, v)
+
+	if err := v.StartRenewal(id); err != nil {
+		t.Fatalf("StartRenewal() error = %v", err)
+	}
+
+	// Other renewals fail while the credential is being renewed
+	if err := v.StartRenewal(id); !errors.Is(err, ErrRenewalInProgress) {
+		t.Errorf("second StartRenewal() error = %v, want ErrRenewalInProgress", err)
+	}
+	if _, err := v.RenewCredential(id, nil, false); !errors.Is(err, ErrRenewalInProgress) {
+		t.Errorf("RenewCredential() during a renewal error = %v, want ErrRenewalInProgress", err)
+	}
+
+	// A renewal which failed can be started again
+	v.AbortRenewal(id)
+	if _, err := v.RenewCredential(id, nil, false); err != nil {
+		t.Errorf("RenewCredential() after AbortRenewal error = %v", err)
+	}
+	if err := v.StartRenewal(id); !errors.Is(err, ErrCredentialRenewed) {
+		t.Errorf("StartRenewal() of a renewed credential error = %v, want ErrCredentialRenewed", err)
+	}
+}
+
+// TestRenewCredentialInstances renews the same credential concurrently from two vaults sharing the
+// database, like two instances of the issuer. Only one of them may sign a new credential.
+func TestRenewCredentialInstances(t *testing.T) {
+	ctx := context.Background()
+	instances := newTestInstances(t, 2)
+	id := newRenewableCredential(t, instances[0])
+
+	errs := make(chan error, 4)
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < cap(errs); i++ {
+		wg.Add(1)
+		go func(v *Vault) {
+			defer wg.Done()
+			<-start
+			_, err := v.RenewCredential(id, nil, false)
+			errs <- err
+		}(instances[i%2])
+	}
+	close(start)
+	wg.Wait()
+	close(errs)
+
+	succeeded := 0
+	for err := range errs {
+		switch {
+		case err == nil:
+			succeeded++
+		case !errors.Is(err, ErrRenewalInProgress) && !errors.Is(err, ErrCredentialRenewed):
+			t.Errorf("RenewCredential() error = %v", err)
+		}
+	}
+	if succeeded != 1 {
+		t.Errorf("%d renewals succeeded, want 1", succeeded)
+	}
+
+	// Only one new credential was signed
+	if n, _ := instances[1].Client.Credential.Query().Count(ctx); n != 2 {
+		t.Errorf("%d credentials, want 2", n)
+	}
+}
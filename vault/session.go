@@ -0,0 +1,117 @@
+package vault
+
+import (
+	"errors"
+	"time"
+
+	"github.com/hesusruiz/vcbackend/ent"
+	"github.com/hesusruiz/vcbackend/ent/loginsession"
+)
+
+var ErrSessionNotFound = errors.New("the login does not exist or has expired")
+
+// Session is a SIOP login of the verifier. The sessions are stored in the vault, so a login started by
+// an instance of the verifier can continue in any instance sharing the database.
+type Session struct {
+	State      string
+	ClientID   string
+	ReturnURL  string
+	Credential string
+	ExpiresAt  time.Time
+}
+
+// Completed returns true when the holder has presented a credential in the login
+func (s *Session) Completed() bool {
+	return len(s.Credential) > 0
+}
+
+// StartSession creates the session of a login with the state, waiting for a presentation during the lifetime
+func (v *Vault) StartSession(state string, lifetime time.Duration) error {
+
+	ctx := v.dbContext()
+	now := time.Now()
+
+	// Remove the sessions which have expired
+	if _, err := v.Client.LoginSession.Delete().
+		Where(loginsession.ExpiresAtLT(now)).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return v.Client.LoginSession.Create().
+		SetID(state).
+		SetExpiresAt(now.Add(lifetime)).
+		Exec(ctx)
+}
+
+// SetSessionClient binds a pending login to the relying party and the URL to return to after the login
+func (v *Vault) SetSessionClient(state string, clientID string, returnURL string) error {
+	return v.updateSession(state, func(u *ent.LoginSessionUpdate) {
+		u.SetClientID(clientID).SetReturnURL(returnURL)
+	})
+}
+
+// SetSessionReturnURL sets the URL to return to after a pending login
+func (v *Vault) SetSessionReturnURL(state string, returnURL string) error {
+	return v.updateSession(state, func(u *ent.LoginSessionUpdate) {
+		u.SetReturnURL(returnURL)
+	})
+}
+
+// CompleteSession stores the credential presented in a pending login, which is kept during the lifetime
+// for the page of the login. A login can be completed only once.
+func (v *Vault) CompleteSession(state string, credential string, lifetime time.Duration) error {
+	now := time.Now()
+	return v.updateSession(state, func(u *ent.LoginSessionUpdate) {
+		u.SetCredential(credential).SetCompletedAt(now).SetExpiresAt(now.Add(lifetime))
+	})
+}
+
+// updateSession updates a login which has not expired nor been completed, in a single update of the database
+func (v *Vault) updateSession(state string, set func(u *ent.LoginSessionUpdate)) error {
+
+	u := v.Client.LoginSession.Update().
+		Where(
+			loginsession.ID(state),
+			loginsession.CompletedAtIsNil(),
+			loginsession.ExpiresAtGT(time.Now()),
+		)
+	set(u)
+
+	n, err := u.Save(v.dbContext())
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrSessionNotFound
+	}
+	return nil
+}
+
+// Session returns the login with the state, or ErrSessionNotFound if it has expired
+func (v *Vault) Session(state string) (*Session, error) {
+
+	entry, err := v.Client.LoginSession.Query().
+		Where(
+			loginsession.ID(state),
+			loginsession.ExpiresAtGT(time.Now()),
+		).
+		Only(v.dbContext())
+	if ent.IsNotFound(err) {
+		return nil, ErrSessionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Session{
+		State:     entry.ID,
+		ClientID:  entry.ClientID,
+		ReturnURL: entry.ReturnURL,
+		ExpiresAt: entry.ExpiresAt,
+	}
+	if entry.Credential != nil {
+		s.Credential = *entry.Credential
+	}
+	return s, nil
+}
@@ -0,0 +1,108 @@
+package vault
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestSessionInstances checks that a login started in an instance continues in another one sharing the database
+func TestSessionInstances(t *testing.T) {
+	instances := newTestInstances(t, 2)
+	a, b := instances[0], instances[1]
+
+	if err := a.StartSession("state1", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.SetSessionClient("state1", "orders", "https://orders.example.com/callback"); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := b.Session("state1")
+	if err != nil || s.ClientID != "orders" || s.ReturnURL != "https://orders.example.com/callback" || s.Completed() {
+		t.Fatalf("Session() = %+v, %v", s, err)
+	}
+
+	if err := b.CompleteSession("state1", "credential", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if s, err := a.Session("state1"); err != nil || !s.Completed() || s.Credential != "credential" {
+		t.Errorf("Session() after the presentation = %+v, %v", s, err)
+	}
+
+	// A login is completed only once
+	if err := a.CompleteSession("state1", "other", time.Minute); !errors.Is(err, ErrSessionNotFound) {
+		t.Errorf("CompleteSession() of a completed login error = %v", err)
+	}
+	if err := a.SetSessionReturnURL("state1", "https://other.example.com"); !errors.Is(err, ErrSessionNotFound) {
+		t.Errorf("SetSessionReturnURL() of a completed login error = %v", err)
+	}
+}
+
+func TestSessionExpired(t *testing.T) {
+	v := newTestVault(t)
+
+	if err := v.StartSession("state1", -time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.Session("state1"); !errors.Is(err, ErrSessionNotFound) {
+		t.Errorf("Session() of an expired login error = %v", err)
+	}
+	if err := v.CompleteSession("state1", "credential", time.Minute); !errors.Is(err, ErrSessionNotFound) {
+		t.Errorf("CompleteSession() of an expired login error = %v", err)
+	}
+	if _, err := v.Session("unknown"); !errors.Is(err, ErrSessionNotFound) {
+		t.Errorf("Session() of an unknown login error = %v", err)
+	}
+
+	// The expired sessions are removed when a new one starts
+	if err := v.StartSession("state2", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if n, _ := v.Client.LoginSession.Query().Count(v.dbContext()); n != 1 {
+		t.Errorf("%d sessions stored, want 1", n)
+	}
+}
+
+func TestKeyForDID(t *testing.T) {
+	v := newTestVault(t)
+
+	if _, err := v.CreateNaturalPersonWithKey("holder", "Holder", "pass"); err != nil {
+		t.Fatal(err)
+	}
+	keys, err := v.PrivateKeysForUser("holder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	did, err := KeyDID(keys[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := v.SetDIDForUser("holder", did); err != nil {
+		t.Fatal(err)
+	}
+
+	if k, err := v.KeyForDID("holder", did); err != nil || k.GetKid() != keys[0].GetKid() {
+		t.Errorf("KeyForDID() = %v, %v", k, err)
+	}
+
+	// The DID of another key of the user, and the DIDs of no user
+	other, err := v.NewKeyForUser("holder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, _ := v.PrivateKeyByID(other.ID)
+	otherDID, _ := KeyDID(otherKey)
+	if err := v.SetDIDForUser("holder", otherDID); err != nil {
+		t.Fatal(err)
+	}
+	if k, err := v.KeyForDID("holder", otherDID); err != nil || k.GetKid() != other.ID {
+		t.Errorf("KeyForDID() of the second key = %v, %v", k, err)
+	}
+	if _, err := v.KeyForDID("holder", "did:key:unknown"); !errors.Is(err, ErrNoKeyForDID) {
+		t.Errorf("KeyForDID() of an unknown DID error = %v", err)
+	}
+	if _, err := v.KeyForDID("other", did); !errors.Is(err, ErrNoKeyForDID) {
+		t.Errorf("KeyForDID() of the DID of another user error = %v", err)
+	}
+}
@@ -1,47 +1,48 @@
 {{define "PacketDeliveryCredential"}}
-{{ $now := now | unixEpoch }}
 
-sub: "{{.subjectDID}}"
-jti: "{{.jti}}"
-iss: "{{.issuerDID}}"
-nbf: "{{ $now }}"
-iat: "{{ $now }}"
-exp: "{{ add $now 10000 }}"
+sub: {{toJson .subjectDID}}
+jti: {{toJson .jti}}
+iss: {{toJson .issuerDID}}
+nbf: {{toJson .nbf}}
+iat: {{toJson .iat}}
+exp: {{toJson .exp}}
 nonce: "{{ randBytes 12 }}"
 vc:
     @context:
         - "https://www.w3.org/2018/credentials/v1"
         - "https://pd.i4trust.fiware.io/2022/credentials/employee/v1"
-    id: "{{.jti}}"
-    type: ["VerifiableCredential", "{{.credName}}"]
-    issuer: "{{.issuerDID}}"
-    issuanceDate: "{{ $now }}"
+    id: {{toJson .jti}}
+    type: ["VerifiableCredential", {{toJson .credName}}]
+    issuer: {{toJson .issuerDID}}
+    issuanceDate: {{toJson .issuanceDate}}
+    validFrom: {{toJson .validFrom}}
+    expirationDate: {{toJson .expirationDate}}
 
     credentialSubject:
-        id: "{{.subjectDID}}"
-        given_name: "{{.claims.given_name}}"
-        family_name: "{{.claims.family_name}}"
-        email: "{{.claims.email}}"
+        id: {{toJson .subjectDID}}
+        given_name: {{toJson .claims.given_name}}
+        family_name: {{toJson .claims.family_name}}
+        email: {{toJson .claims.email}}
         roles:
             {{- range .claims.roles}}
-            - target:  {{.target}}
+            - target:  {{toJson .target}}
               names:
                 {{- range .names}}
-                - {{.}}
+                - {{toJson .}}
                 {{- end}}
             {{- end}}
         {{with .verificationMethod}}
         verificationMethod:
             {{range .verificationMethod}}
-            - id: "{{.subjectDID}}#{{.publicKey.kid}}"
+            - id: {{printf "%s#%s" .subjectDID .publicKey.kid | toJson}}
             type: "JwsVerificationKey2020"
-            controller: "{{.subjectDID}}"
+            controller: {{toJson .subjectDID}}
             publicKeyJwk:
-                kid: "{{.publicKey.kid}}"
-                kty: "{{.publicKey.kty}}"
-                crv: "{{.publicKey.crv}}"
-                x: "{{.publicKey.x}}"
-                y: "{{.publicKey.y}}"
+                kid: {{toJson .publicKey.kid}}
+                kty: {{toJson .publicKey.kty}}
+                crv: {{toJson .publicKey.crv}}
+                x: {{toJson .publicKey.x}}
+                y: {{toJson .publicKey.y}}
             {{end}}
         {{end}}
 {{end}}
\ No newline at end of file
@@ -1,9 +1,10 @@
 {{define "customervp"}}
 {
-    "iss": "{{.IssuerDID}}",
-    "sub": "{{.SubjectDID}}",
-    "exp": "2023-03-22T14:00:00Z",
-    "nbf": "2022-03-22T14:00:00Z",
+    "iss": {{toJson .IssuerDID}},
+    "sub": {{toJson .SubjectDID}},
+    "iat": {{toJson .Iat}},
+    "nbf": {{toJson .Nbf}},
+    "exp": {{toJson .Exp}},
     "jti": "https://pdc.i4trust.fiware.io/credentials/1872",
     "vc": {
         "@context": [
@@ -15,16 +16,16 @@
         "issuer": {
             "id": "did:elsi:EU.EORI.NLHAPPYPETS"
         },
-        "issuanceDate": "2022-03-22T14:00:00Z",
-        "validFrom": "2022-03-22T14:00:00Z",
-        "expirationDate": "2023-03-22T14:00:00Z",
+        "issuanceDate": {{toJson .IssuanceDate}},
+        "validFrom": {{toJson .ValidFrom}},
+        "expirationDate": {{toJson .ExpirationDate}},
         "credentialSubject": {
-            "id": "{{.SubjectDID}}",
+            "id": {{toJson .SubjectDID}},
             "verificationMethod": [
                 {
-                    "id": "{{.SubjectDID}}#key1",
+                    "id": {{printf "%s#key1" .SubjectDID | toJson}},
                     "type": "JwsVerificationKey2020",
-                    "controller": "{{.SubjectDID}}",
+                    "controller": {{toJson .SubjectDID}},
                     "publicKeyJwk": {
                     "kid": "key1",
                     "kty": "EC",
@@ -40,11 +41,11 @@
                     "names": ["GoldCustomer"]
                 }
             ],
-            "name": "{{.Name}}",
-            "given_name": "{{.Given_name}}",
-            "family_name": "{{.Family_name}}",
-            "preferred_username": "{{.Preferred_username}}",
-            "email": "{{.Email}}"
+            "name": {{toJson .Name}},
+            "given_name": {{toJson .Given_name}},
+            "family_name": {{toJson .Family_name}},
+            "preferred_username": {{toJson .Preferred_username}},
+            "email": {{toJson .Email}}
         }
     }
 }
@@ -4,38 +4,38 @@
         "https://www.w3.org/2018/credentials/v1",
         "https://marketplace.i4trust.fiware.io/2022/credentials/employee/v1"
     ],
-    "id": "{{.jti}}",
+    "id": {{toJson .jti}},
     "type": ["VerifiableCredential", "EmployeeCredential"],
     "issuer": {
-        "id": "{{.issuer.DID}}"
+        "id": {{toJson .issuer.DID}}
     },
-    "issuanceDate": "2022-03-22T14:00:00Z",
-    "validFrom": "2022-03-22T14:00:00Z",
-    "expirationDate": "2023-03-22T14:00:00Z",
+    "issuanceDate": {{toJson .issuanceDate}},
+    "validFrom": {{toJson .validFrom}},
+    "expirationDate": {{toJson .expirationDate}},
     "credentialSubject": {
-        "id": "{{.subject.DID}}",
+        "id": {{toJson .subject.DID}},
         "verificationMethod": [
             {{range .VerificationMethod}}
             {
-                "id": "{{.subjectDID}}#{{.publicKey.kid}}",
+                "id": {{printf "%s#%s" .subjectDID .publicKey.kid | toJson}},
                 "type": "JwsVerificationKey2020",
-                "controller": "{{.subjectDID}}",
+                "controller": {{toJson .subjectDID}},
                 "publicKeyJwk": {
-                    "kid": "{{.publicKey.kid}}",
-                    "kty": "{{.publicKey.kty}}",
-                    "crv": "{{.publicKey.crv}}",
-                    "x": "{{.publicKey.x}}",
-                    "y": "{{.publicKey.y}}"
+                    "kid": {{toJson .publicKey.kid}},
+                    "kty": {{toJson .publicKey.kty}},
+                    "crv": {{toJson .publicKey.crv}},
+                    "x": {{toJson .publicKey.x}},
+                    "y": {{toJson .publicKey.y}}
                 }
             }
             {{end}}
         ],
         "roles": {{toJson .claims.roles}},
-        "name": "{{.claims.name}}",
-        "given_name": "{{.claims.given_name}}",
-        "family_name": "{{.claims.family_name}}",
-        "preferred_username": "{{.claims.preferred_username}}",
-        "email": "{{.claims.email}}"
+        "name": {{toJson .claims.name}},
+        "given_name": {{toJson .claims.given_name}},
+        "family_name": {{toJson .claims.family_name}},
+        "preferred_username": {{toJson .claims.preferred_username}},
+        "email": {{toJson .claims.email}}
     }
 }
 {{end}}
\ No newline at end of file
@@ -0,0 +1,95 @@
+package vault
+
+import (
+	"errors"
+	"time"
+
+	"github.com/hesusruiz/vcbackend/ent"
+	"github.com/hesusruiz/vcbackend/ent/trustedissuer"
+	"github.com/hesusruiz/vcbackend/internal/til"
+)
+
+var ErrTrustedIssuerExists = errors.New("the issuer is already in the trusted issuers list")
+
+// A Vault with the trusted issuers is a local registry for the verifier
+var _ til.Registry = (*Vault)(nil)
+
+// CreateTrustedIssuer adds an issuer to the Trusted Issuers List
+func (v *Vault) CreateTrustedIssuer(ti *til.TrustedIssuer) error {
+
+	if err := ti.Validate(); err != nil {
+		return err
+	}
+
+	err := v.Client.TrustedIssuer.Create().
+		SetID(ti.DID).
+		SetCredentials(ti.Credentials).
+		Exec(v.dbContext())
+	if ent.IsConstraintError(err) {
+		return ErrTrustedIssuerExists
+	}
+	return err
+}
+
+// UpdateTrustedIssuer replaces the credentials of an issuer in the Trusted Issuers List
+func (v *Vault) UpdateTrustedIssuer(ti *til.TrustedIssuer) error {
+
+	if err := ti.Validate(); err != nil {
+		return err
+	}
+
+	err := v.Client.TrustedIssuer.UpdateOneID(ti.DID).
+		SetCredentials(ti.Credentials).
+		SetUpdatedAt(time.Now()).
+		Exec(v.dbContext())
+	if ent.IsNotFound(err) {
+		return til.ErrNotFound
+	}
+	return err
+}
+
+// DeleteTrustedIssuer removes an issuer from the Trusted Issuers List
+func (v *Vault) DeleteTrustedIssuer(did string) error {
+	err := v.Client.TrustedIssuer.DeleteOneID(did).Exec(v.dbContext())
+	if ent.IsNotFound(err) {
+		return til.ErrNotFound
+	}
+	return err
+}
+
+// TrustedIssuer returns an issuer of the Trusted Issuers List, or til.ErrNotFound
+func (v *Vault) TrustedIssuer(did string) (*til.TrustedIssuer, error) {
+	entry, err := v.Client.TrustedIssuer.Get(v.dbContext(), did)
+	if ent.IsNotFound(err) {
+		return nil, til.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &til.TrustedIssuer{DID: entry.ID, Credentials: entry.Credentials}, nil
+}
+
+// ListTrustedIssuers returns the DIDs of a page of the trusted issuers, in order, starting after the given DID,
+// and the total number of trusted issuers
+func (v *Vault) ListTrustedIssuers(after string, limit int) ([]string, int, error) {
+
+	total, err := v.Client.TrustedIssuer.Query().Count(v.dbContext())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if limit <= 0 || limit > MaxPageSize {
+		limit = DefaultPageSize
+	}
+
+	dids, err := v.Client.TrustedIssuer.Query().
+		Where(trustedissuer.IDGT(after)).
+		Order(ent.Asc(trustedissuer.FieldID)).
+		Limit(limit).
+		IDs(v.dbContext())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return dids, total, nil
+}
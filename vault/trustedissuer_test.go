@@ -0,0 +1,55 @@
+package vault
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hesusruiz/vcbackend/internal/til"
+)
+
+func TestTrustedIssuers(t *testing.T) {
+	v := newTestVault(t)
+
+	for _, did := range []string{"did:key:c", "did:key:a", "did:key:b"} {
+		ti := &til.TrustedIssuer{DID: did, Credentials: []til.Credential{{CredentialsType: "PacketDeliveryService"}}}
+		if err := v.CreateTrustedIssuer(ti); err != nil {
+			t.Fatalf("CreateTrustedIssuer(%s) error = %v", did, err)
+		}
+	}
+
+	if err := v.CreateTrustedIssuer(&til.TrustedIssuer{DID: "did:key:a"}); !errors.Is(err, ErrTrustedIssuerExists) {
+		t.Errorf("CreateTrustedIssuer() of an existing issuer error = %v", err)
+	}
+	if err := v.CreateTrustedIssuer(&til.TrustedIssuer{DID: "nodid"}); !errors.Is(err, til.ErrInvalidIssuer) {
+		t.Errorf("CreateTrustedIssuer() of an invalid issuer error = %v", err)
+	}
+
+	// Pages in order of DID
+	dids, total, err := v.ListTrustedIssuers("", 2)
+	if err != nil || total != 3 || len(dids) != 2 || dids[0] != "did:key:a" {
+		t.Errorf("ListTrustedIssuers() = %v, %d, %v", dids, total, err)
+	}
+	dids, _, _ = v.ListTrustedIssuers(dids[1], 2)
+	if len(dids) != 1 || dids[0] != "did:key:c" {
+		t.Errorf("ListTrustedIssuers() second page = %v", dids)
+	}
+
+	update := &til.TrustedIssuer{DID: "did:key:a", Credentials: []til.Credential{{CredentialsType: "EmployeeCredential"}}}
+	if err := v.UpdateTrustedIssuer(update); err != nil {
+		t.Fatal(err)
+	}
+	ti, err := v.TrustedIssuer("did:key:a")
+	if err != nil || len(ti.Credentials) != 1 || ti.Credentials[0].CredentialsType != "EmployeeCredential" {
+		t.Errorf("TrustedIssuer() = %+v, %v", ti, err)
+	}
+
+	if err := v.DeleteTrustedIssuer("did:key:a"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.TrustedIssuer("did:key:a"); !errors.Is(err, til.ErrNotFound) {
+		t.Errorf("TrustedIssuer() of a deleted issuer error = %v", err)
+	}
+	if err := v.UpdateTrustedIssuer(update); !errors.Is(err, til.ErrNotFound) {
+		t.Errorf("UpdateTrustedIssuer() of a deleted issuer error = %v", err)
+	}
+}
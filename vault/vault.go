@@ -2,34 +2,45 @@ package vault
 
 import (
 	"context"
+	"database/sql"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"os"
 	"strings"
 	"sync"
+	"time"
 
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/hesusruiz/vcbackend/ent"
 	"github.com/hesusruiz/vcbackend/ent/did"
+	"github.com/hesusruiz/vcbackend/ent/hook"
+	"github.com/hesusruiz/vcbackend/ent/privatekey"
 	"github.com/hesusruiz/vcbackend/ent/user"
+	"github.com/hesusruiz/vcbackend/internal/issuance"
 	"github.com/hesusruiz/vcbackend/internal/jwk"
 	"github.com/hesusruiz/vcbackend/internal/jwt"
+	"github.com/hesusruiz/vcbackend/internal/metrics"
+	"github.com/hesusruiz/vcbackend/internal/tracing"
+	"github.com/hesusruiz/vcbackend/vault/migrations"
 	"github.com/hesusruiz/vcutils/yaml"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
 
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
 	_ "github.com/mattn/go-sqlite3"
-	"github.com/rs/zerolog"
-	zlog "github.com/rs/zerolog/log"
 )
 
-func init() {
-	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
-	zlog.Logger = zlog.Output(zerolog.ConsoleWriter{Out: os.Stderr})
-	zlog.Logger = zlog.With().Caller().Logger()
-}
-
 type Vault struct {
-	Client *ent.Client
+	Client   *ent.Client
+	Policies *issuance.Policies
+	ctx      context.Context
+	db       *sql.DB
+	driver   string
 }
 
 type Signable interface {
@@ -67,20 +78,60 @@ func New(cfg *yaml.YAML) (v *Vault, err error) {
 
 	v = &Vault{}
 
+	// The policies for the dates of the credentials issued with this Vault
+	v.Policies, err = issuance.FromConfig(cfg.Map("issuancePolicies"))
+	if err != nil {
+		zap.S().Named("vault").Errorw("invalid issuance policies", zap.Error(err))
+		return nil, err
+	}
+
 	// Get the configured parameters for the database
 	storeDriverName := cfg.String("store.driverName")
 	storeDataSourceName := cfg.String("store.dataSourceName")
 
-	// Open the database
-	v.Client, err = ent.Open(storeDriverName, storeDataSourceName)
+	// Open the database, tracing the statements executed for the requests
+	drv, err := entsql.Open(storeDriverName, storeDataSourceName)
 	if err != nil {
-		zlog.Error().Err(err).Msg("failed opening database")
+		zap.S().Named("vault").Errorw("failed opening database", zap.Error(err))
+		return nil, err
+	}
+	v.Client = ent.NewClient(ent.Driver(tracing.Driver(drv)))
+	v.db, v.driver = drv.DB(), storeDriverName
+
+	// The schema must have been migrated with the migrations of this release, unless configured
+	// to migrate it when opening the vault
+	migrator, err := migrations.New(drv.DB(), storeDriverName)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Bool("store.autoMigrate") {
+		applied, err := migrator.Up(context.Background())
+		if err != nil {
+			zap.S().Named("vault").Errorw("failed migrating the database", "dataSourceName", storeDataSourceName, zap.Error(err))
+			return nil, err
+		}
+		for _, m := range applied {
+			zap.S().Named("vault").Infow("migration applied", "version", m.Version, "name", m.Name)
+		}
+	}
+	if err := migrator.Check(context.Background()); err != nil {
+		zap.S().Named("vault").Errorw("the database is not migrated", "dataSourceName", storeDataSourceName, zap.Error(err))
+		return nil, fmt.Errorf("%w, run `vcbackend migrate up`", err)
+	}
+
+	// Record the changes of credentials, keys and DIDs in the audit log
+	v.useAudit()
+
+	// Make sure the builtin credential templates are available
+	if err := v.seedBuiltinTemplates(); err != nil {
+		zap.S().Named("vault").Errorw("failed storing builtin templates", zap.Error(err))
 		return nil, err
 	}
 
-	// Run the auto migration tool.
-	if err := v.Client.Schema.Create(context.Background()); err != nil {
-		zlog.Error().Err(err).Str("dataSourceName", storeDataSourceName).Msg("failed creating schema resources")
+	// Index the metadata of the credentials when they are stored, and of the ones stored before
+	v.Client.Credential.Use(hook.On(indexCredential, ent.OpCreate))
+	if err := v.backfillCredentialIndex(); err != nil {
+		zap.S().Named("vault").Errorw("failed indexing the credentials", zap.Error(err))
 		return nil, err
 	}
 
@@ -92,6 +143,8 @@ func NewFromDBClient(entClient *ent.Client) (v *Vault) {
 
 	v = &Vault{}
 	v.Client = entClient
+	v.Policies = issuance.New()
+	v.useAudit()
 
 	return v
 }
@@ -112,7 +165,7 @@ func (v *Vault) CreateNaturalPersonWithKey(id string, name string, password stri
 func (v *Vault) CreateUser(userid string, name string, usertype string, password string) (usr *ent.User, err error) {
 
 	// Return an error if the user already exists
-	usr, _ = v.Client.User.Get(context.Background(), userid)
+	usr, _ = v.Client.User.Get(v.dbContext(), userid)
 	if usr != nil {
 		return nil, fmt.Errorf("user already exists")
 	}
@@ -130,12 +183,12 @@ func (v *Vault) CreateUser(userid string, name string, usertype string, password
 		SetName(name).
 		SetType(usertype).
 		SetPassword(hashedPassword).
-		Save(context.Background())
+		Save(v.dbContext())
 	if err != nil {
 		return nil, err
 	}
 
-	zlog.Info().Str("id", userid).Str("name", name).Str("type", usertype).Msg("user created")
+	v.logger().Infow("user created", "id", userid, "userName", name, "type", usertype)
 
 	return usr, nil
 
@@ -153,7 +206,7 @@ func (v *Vault) CreateUserWithKeyX(userid string, name string, usertype string,
 func (v *Vault) CreateUserWithKey(userid string, name string, usertype string, password string) (usr *ent.User, err error) {
 
 	// Return an error if the user already exists
-	usr, _ = v.Client.User.Get(context.Background(), userid)
+	usr, _ = v.Client.User.Get(v.dbContext(), userid)
 	if usr != nil {
 		return nil, fmt.Errorf("user already exists")
 	}
@@ -171,7 +224,7 @@ func (v *Vault) CreateUserWithKey(userid string, name string, usertype string, p
 		SetName(name).
 		SetType(usertype).
 		SetPassword(hashedPassword).
-		Save(context.Background())
+		Save(v.dbContext())
 	if err != nil {
 		return nil, err
 	}
@@ -182,48 +235,65 @@ func (v *Vault) CreateUserWithKey(userid string, name string, usertype string, p
 		return nil, err
 	}
 
-	zlog.Info().Str("id", userid).Str("name", name).Str("type", usertype).Msg("user created")
+	v.logger().Infow("user created", "id", userid, "userName", name, "type", usertype)
 
 	return usr, nil
 
 }
 
-func (v *Vault) SetDIDForUser(userid string, did string) error {
+// ErrDIDOfAnotherUser is returned when binding to a user a DID which is bound to another user
+var ErrDIDOfAnotherUser = errors.New("the DID belongs to another user")
+
+// SetDIDForUser binds the DID to the user, as its current DID. The DIDs bound before are kept,
+// for the credentials issued with them.
+func (v *Vault) SetDIDForUser(userid string, didID string) error {
 	// Get the account
-	usr, err := v.Client.User.Get(context.Background(), userid)
+	usr, err := v.Client.User.Get(v.dbContext(), userid)
 	if err != nil {
-		zlog.Error().Err(err).Str("id", userid).Msg("error retrieving user")
+		v.logger().Errorw("error retrieving user", "id", userid, zap.Error(err))
 		return err
 	}
 
-	// Do nothing if the DID already exists
-	if len(v.Client.DID.Query().AllX(context.Background())) > 0 {
-		zlog.Info().Msg("did already exists")
-		return nil
+	// If the DID already exists, it must be of this user, and becomes its current DID
+	existing, err := v.Client.DID.Query().Where(did.ID(didID)).WithUser().Only(v.dbContext())
+	if err == nil {
+		if existing.Edges.User != nil && existing.Edges.User.ID != userid {
+			v.logger().Infow("did of another user", "did", didID, "id", userid)
+			return fmt.Errorf("%w: %s", ErrDIDOfAnotherUser, didID)
+		}
+		return existing.Update().SetUser(usr).SetUpdatedAt(time.Now()).Exec(v.dbContext())
+	}
+	if !ent.IsNotFound(err) {
+		return err
 	}
 
 	// Add the DID to this user
-	newDID, err := v.Client.DID.Create().SetID(did).Save(context.Background())
+	_, err = v.Client.DID.Create().SetID(didID).SetUser(usr).Save(v.dbContext())
+	if ent.IsConstraintError(err) {
+		// Created at the same time by another request
+		return fmt.Errorf("%w: %s", ErrDIDOfAnotherUser, didID)
+	}
 	if err != nil {
-		zlog.Error().Err(err).Msg("failed storing DID")
+		v.logger().Errorw("failed storing DID", zap.Error(err))
 		return err
 	}
 
-	// Update the user record to point to this key
-	_, err = usr.Update().AddDids(newDID).Save(context.Background())
-
-	return err
+	return nil
 
 }
 
+// GetDIDForUser returns the current DID of the user, the last one bound to it
 func (v *Vault) GetDIDForUser(userid string) (string, error) {
-	return v.Client.DID.Query().Where(did.HasUserWith(user.ID(userid))).FirstID(context.Background())
+	return v.Client.DID.Query().
+		Where(did.HasUserWith(user.ID(userid))).
+		Order(ent.Desc(did.FieldUpdatedAt), ent.Desc(did.FieldCreatedAt)).
+		FirstID(v.dbContext())
 }
 
 func (v *Vault) NewKeyForUser(userid string) (*ent.PrivateKey, error) {
 
 	// Get the account
-	usr, err := v.Client.User.Get(context.Background(), userid)
+	usr, err := v.Client.User.Get(v.dbContext(), userid)
 	if err != nil {
 		return nil, err
 	}
@@ -231,14 +301,14 @@ func (v *Vault) NewKeyForUser(userid string) (*ent.PrivateKey, error) {
 	// Create a new private key, of the preferred type
 	privKey, err := jwk.NewECDSA()
 	if err != nil {
-		zlog.Error().Err(err).Msg("failed creating new native ECDSA key")
+		v.logger().Errorw("failed creating new native ECDSA key", zap.Error(err))
 		return nil, err
 	}
 
 	// Convert to JSON-JWK
 	asJSON, err := privKey.AsJSON()
 	if err != nil {
-		zlog.Error().Err(err).Msg("failed converting key to json")
+		v.logger().Errorw("failed converting key to json", zap.Error(err))
 		return nil, err
 	}
 
@@ -250,22 +320,22 @@ func (v *Vault) NewKeyForUser(userid string) (*ent.PrivateKey, error) {
 		SetKty("EC").
 		SetJwk(asJSON).
 		SetUser(usr).
-		Save(context.Background())
+		Save(v.dbContext())
 	if err != nil {
-		zlog.Error().Err(err).Msg("failed storing key")
+		v.logger().Errorw("failed storing key", zap.Error(err))
 		return nil, err
 	}
-	zlog.Info().Str("kid", kid).Msg("key created")
+	v.logger().Infow("key created", "kid", kid)
 
 	// Update the user record to point to this key
-	usr.Update().AddKeys(dbKey).Save(context.Background())
+	usr.Update().AddKeys(dbKey).Save(v.dbContext())
 
 	// Store the public part of the key in the public key table, to be used for verification
 	pubKey := privKey.PublicJWKKey()
 	// Convert to JSON-JWK
 	asJSON, err = pubKey.AsJSON()
 	if err != nil {
-		zlog.Error().Err(err).Msg("failed converting public key to json")
+		v.logger().Errorw("failed converting public key to json", zap.Error(err))
 		return nil, err
 	}
 
@@ -274,13 +344,13 @@ func (v *Vault) NewKeyForUser(userid string) (*ent.PrivateKey, error) {
 		SetID(kid).
 		SetKty("EC").
 		SetJwk(asJSON).
-		Save(context.Background())
+		Save(v.dbContext())
 	if err != nil {
-		zlog.Error().Err(err).Msg("failed storing public key")
+		v.logger().Errorw("failed storing public key", zap.Error(err))
 		return nil, err
 	}
 
-	zlog.Info().Str("name", userid).Msg("user updated")
+	v.logger().Infow("user updated", "id", userid)
 
 	return dbKey, nil
 
@@ -289,7 +359,7 @@ func (v *Vault) NewKeyForUser(userid string) (*ent.PrivateKey, error) {
 func (v *Vault) AddKeyToUser(userid string, privKey *jwk.JWK) (*ent.PrivateKey, error) {
 
 	// Get the account
-	usr, err := v.Client.User.Get(context.Background(), userid)
+	usr, err := v.Client.User.Get(v.dbContext(), userid)
 	if err != nil {
 		return nil, err
 	}
@@ -297,7 +367,7 @@ func (v *Vault) AddKeyToUser(userid string, privKey *jwk.JWK) (*ent.PrivateKey,
 	// Convert to JSON-JWK
 	asJSON, err := privKey.AsJSON()
 	if err != nil {
-		zlog.Error().Err(err).Msg("failed converting key to json")
+		v.logger().Errorw("failed converting key to json", zap.Error(err))
 		return nil, err
 	}
 
@@ -309,22 +379,22 @@ func (v *Vault) AddKeyToUser(userid string, privKey *jwk.JWK) (*ent.PrivateKey,
 		SetKty("EC").
 		SetJwk(asJSON).
 		SetUser(usr).
-		Save(context.Background())
+		Save(v.dbContext())
 	if err != nil {
-		zlog.Error().Err(err).Msg("failed storing key")
+		v.logger().Errorw("failed storing key", zap.Error(err))
 		return nil, err
 	}
-	zlog.Info().Str("kid", kid).Msg("key created")
+	v.logger().Infow("key created", "kid", kid)
 
 	// Update the user record to point to this key
-	usr.Update().AddKeys(dbKey).Save(context.Background())
+	usr.Update().AddKeys(dbKey).Save(v.dbContext())
 
 	// Store the public part of the key in the public key table, to be used for verification
 	pubKey := privKey.PublicJWKKey()
 	// Convert to JSON-JWK
 	asJSON, err = pubKey.AsJSON()
 	if err != nil {
-		zlog.Error().Err(err).Msg("failed converting public key to json")
+		v.logger().Errorw("failed converting public key to json", zap.Error(err))
 		return nil, err
 	}
 
@@ -333,13 +403,13 @@ func (v *Vault) AddKeyToUser(userid string, privKey *jwk.JWK) (*ent.PrivateKey,
 		SetID(kid).
 		SetKty("EC").
 		SetJwk(asJSON).
-		Save(context.Background())
+		Save(v.dbContext())
 	if err != nil {
-		zlog.Error().Err(err).Msg("failed storing public key")
+		v.logger().Errorw("failed storing public key", zap.Error(err))
 		return nil, err
 	}
 
-	zlog.Info().Str("name", userid).Msg("user updated")
+	v.logger().Infow("user updated", "id", userid)
 
 	return dbKey, nil
 
@@ -349,37 +419,52 @@ func (v *Vault) AddKeyToUser(userid string, privKey *jwk.JWK) (*ent.PrivateKey,
 func (v *Vault) UserByID(id string) (usr *ent.User, err error) {
 
 	// Retrieve user by ID
-	usr, err = v.Client.User.Get(context.Background(), id)
+	usr, err = v.Client.User.Get(v.dbContext(), id)
 
 	// Log if the error is NotFound
 	if ent.IsNotFound(err) {
-		zlog.Debug().Err(err).Str("name", id).Msg("user not found")
+		v.logger().Debugw("user not found", "id", id, zap.Error(err))
 		return nil, nil
 	}
 
 	if err != nil {
-		zlog.Error().Err(err).Str("name", id).Msg("failed querying user")
+		v.logger().Errorw("failed querying user", "id", id, zap.Error(err))
 		return nil, fmt.Errorf("failed querying user: %w", err)
 	}
-	zlog.Info().Str("name", id).Msg("user retrieved")
+	v.logger().Infow("user retrieved", "id", id)
 	return usr, nil
 
 }
 
-// PrivateKeysForUser returns all the private keys belonging to the userid
+// ListUsers returns the users of the types, or all the users if no type is given, ordered by id
+func (v *Vault) ListUsers(usertypes ...string) ([]*ent.User, error) {
+	query := v.Client.User.Query().Order(ent.Asc(user.FieldID))
+	if len(usertypes) > 0 {
+		query = query.Where(user.TypeIn(usertypes...))
+	}
+	return query.All(v.dbContext())
+}
+
+// PrivateKeysForUser returns all the private keys belonging to the userid, the newest first.
+// The first key is the one used for signing, and the others are kept to verify what they signed.
 func (v *Vault) PrivateKeysForUser(userid string) (keys []*jwk.JWK, err error) {
 
 	// Return an error if the user does not exist
 	usr, err := v.UserByID(userid)
 	if err != nil {
-		zlog.Error().Err(err).Str("id", userid).Send()
+		v.logger().Errorw("error retrieving user", "id", userid, zap.Error(err))
 		return nil, err
 	}
+	if usr == nil {
+		return nil, fmt.Errorf("user %s not found", userid)
+	}
 
 	// Get all the keys
-	entKeys, err := usr.QueryKeys().All(context.Background())
+	entKeys, err := usr.QueryKeys().
+		Order(ent.Desc(privatekey.FieldCreatedAt), ent.Asc(privatekey.FieldID)).
+		All(v.dbContext())
 	if err != nil {
-		zlog.Error().Err(err).Str("id", userid).Send()
+		v.logger().Errorw("error retrieving the keys of the user", "id", userid, zap.Error(err))
 		return nil, err
 	}
 
@@ -405,7 +490,7 @@ func (v *Vault) PrivateKeysForUser(userid string) (keys []*jwk.JWK, err error) {
 func (v *Vault) PrivateKeyByID(id string) (jwkKey *jwk.JWK, err error) {
 
 	// Retrieve key by its ID, which should be unique
-	k, err := v.Client.PrivateKey.Get(context.Background(), id)
+	k, err := v.Client.PrivateKey.Get(v.dbContext(), id)
 	if err != nil {
 		return nil, err
 	}
@@ -440,8 +525,7 @@ func (v *Vault) SignWithJWK(k *jwk.JWK, claims any) (signedString string, err er
 	if jsonValue, err = json.Marshal(claims); err != nil {
 		return "", err
 	}
-	fmt.Println("**** Build Claim String ****")
-	fmt.Println(string(jsonValue))
+	v.logger().Debugw("signing claims", "claims", json.RawMessage(jsonValue))
 
 	claim := base64.RawURLEncoding.EncodeToString(jsonValue)
 
@@ -496,9 +580,20 @@ func (v *Vault) SignString(toBeSigned string, kid string) (signedString string,
 	method := jwt.GetSigningMethod(alg)
 
 	// Sign the string
-	if signature, err = method.Sign(toBeSigned, key); err != nil {
+	_, span := tracing.StartChild(v.dbContext(), "vault.sign", trace.WithAttributes(
+		attribute.String("alg", alg),
+		attribute.String("kid", kid),
+	))
+	start := time.Now()
+	signature, err = method.Sign(toBeSigned, key)
+	metrics.VaultSigningDuration.WithLabelValues(alg).Observe(time.Since(start).Seconds())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		span.End()
 		return "", err
 	}
+	span.End()
 
 	// Concatenate the signature with a "." as specified in the JWT standards
 	return strings.Join([]string{toBeSigned, signature}, "."), nil
@@ -540,3 +635,44 @@ func (v *Vault) VerifySignature(signedString string, signature string, alg strin
 	return nil
 
 }
+
+// CheckPassword returns the user if the password is correct, or an error otherwise
+func (v *Vault) CheckPassword(userid string, password string) (*ent.User, error) {
+
+	usr, err := v.UserByID(userid)
+	if err != nil {
+		return nil, err
+	}
+	if usr == nil {
+		return nil, fmt.Errorf("invalid user or password")
+	}
+
+	if err := bcrypt.CompareHashAndPassword(usr.Password, []byte(password)); err != nil {
+		v.logger().Infow("invalid password", "id", userid)
+		return nil, fmt.Errorf("invalid user or password")
+	}
+
+	return usr, nil
+}
+
+// SetUserRoles replaces the roles of the user
+func (v *Vault) SetUserRoles(userid string, roles []string) error {
+	return v.Client.User.UpdateOneID(userid).
+		SetRoles(roles).
+		SetUpdatedAt(time.Now()).
+		Exec(v.dbContext())
+}
+
+// UserHasRole returns true if the user exists and has the given role
+func (v *Vault) UserHasRole(userid string, role string) bool {
+	usr, err := v.UserByID(userid)
+	if err != nil || usr == nil {
+		return false
+	}
+	for _, r := range usr.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
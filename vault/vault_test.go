@@ -0,0 +1,184 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hesusruiz/vcbackend/vault/migrations"
+	"github.com/hesusruiz/vcutils/yaml"
+)
+
+var testDBCounter int64
+
+// newTestVault opens a Vault on a new in-memory database
+func newTestVault(t *testing.T) *Vault {
+	t.Helper()
+
+	dsn := fmt.Sprintf("file:vault%d?mode=memory&cache=shared&_fk=1", atomic.AddInt64(&testDBCounter, 1))
+	v, err := New(yaml.New(map[string]any{
+		"store": map[string]any{
+			"driverName":     "sqlite3",
+			"autoMigrate":    true,
+			"dataSourceName": dsn,
+		},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { v.Client.Close() })
+
+	return v
+}
+
+// newTestInstances opens several Vaults on the same database in a file, like the instances of a server
+// sharing the database
+func newTestInstances(t *testing.T, n int) []*Vault {
+	t.Helper()
+
+	cfg := yaml.New(map[string]any{
+		"store": map[string]any{
+			"driverName":     "sqlite3",
+			"autoMigrate":    true,
+			"dataSourceName": "file:" + filepath.Join(t.TempDir(), "vault.sqlite") + "?mode=rwc&_fk=1&_busy_timeout=5000",
+		},
+	})
+	var instances []*Vault
+	for i := 0; i < n; i++ {
+		v, err := New(cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { v.Client.Close() })
+		instances = append(instances, v)
+	}
+
+	return instances
+}
+
+// TestUnmigrated checks that a vault is not opened on a database without the migrations
+func TestUnmigrated(t *testing.T) {
+
+	_, err := New(yaml.New(map[string]any{
+		"store": map[string]any{
+			"driverName":     "sqlite3",
+			"dataSourceName": "file:unmigrated?mode=memory&cache=shared&_fk=1",
+		},
+	}))
+	if !errors.Is(err, migrations.ErrPending) {
+		t.Errorf("New() = %v, want ErrPending", err)
+	}
+}
+
+func TestSetDIDForUser(t *testing.T) {
+	v := newTestVault(t)
+
+	for _, id := range []string{"issuer", "holder"} {
+		if _, err := v.CreateUser(id, id, "naturalperson", "pass"); err != nil {
+			t.Fatal(err)
+		}
+		if err := v.SetDIDForUser(id, "did:key:"+id); err != nil {
+			t.Fatalf("SetDIDForUser(%s) error = %v", id, err)
+		}
+	}
+
+	// Each user has its own DID
+	for _, id := range []string{"issuer", "holder"} {
+		did, err := v.GetDIDForUser(id)
+		if err != nil || did != "did:key:"+id {
+			t.Errorf("GetDIDForUser(%s) = %s, %v", id, did, err)
+		}
+	}
+
+	// The DID of a user can not be bound to another one
+	if err := v.SetDIDForUser("holder", "did:key:issuer"); !errors.Is(err, ErrDIDOfAnotherUser) {
+		t.Errorf("SetDIDForUser() of the DID of another user error = %v", err)
+	}
+	if did, _ := v.GetDIDForUser("issuer"); did != "did:key:issuer" {
+		t.Errorf("GetDIDForUser() after the conflict = %s", did)
+	}
+
+	// The DID bound last is the current one, also when it was bound before
+	for _, did := range []string{"did:key:holder2", "did:key:holder3", "did:key:holder2"} {
+		if err := v.SetDIDForUser("holder", did); err != nil {
+			t.Fatalf("SetDIDForUser(%s) error = %v", did, err)
+		}
+		if current, err := v.GetDIDForUser("holder"); err != nil || current != did {
+			t.Errorf("GetDIDForUser() = %s, %v, want %s", current, err, did)
+		}
+	}
+}
+
+func TestPing(t *testing.T) {
+	v := newTestVault(t)
+
+	if err := v.Ping(context.Background()); err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+
+	v.Client.Close()
+	if err := v.Ping(context.Background()); err == nil {
+		t.Fatal("Ping() on a closed database should fail")
+	}
+}
+
+func TestListUsers(t *testing.T) {
+	v := newTestVault(t)
+
+	v.CreateUser("did:elsi:b", "B", "issuer", "pass")
+	v.CreateUser("did:elsi:a", "A", "issuer", "pass")
+	v.CreateUser("holder", "Holder", "naturalperson", "pass")
+
+	issuers, err := v.ListUsers("issuer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issuers) != 2 || issuers[0].ID != "did:elsi:a" || issuers[1].ID != "did:elsi:b" {
+		t.Errorf("ListUsers(issuer) = %v", issuers)
+	}
+
+	if all, err := v.ListUsers(); err != nil || len(all) != 3 {
+		t.Errorf("ListUsers() = %d users, %v, want 3", len(all), err)
+	}
+}
+
+// TestKeyRotation checks that the newest key of a user signs, and that the previous keys still verify
+func TestKeyRotation(t *testing.T) {
+	v := newTestVault(t)
+
+	if _, err := v.CreateLegalPersonWithKey("did:elsi:issuer", "Issuer", "pass"); err != nil {
+		t.Fatal(err)
+	}
+	keys, err := v.PrivateKeysForUser("did:elsi:issuer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := keys[0]
+	signed, err := v.SignWithJWK(old, map[string]any{"iss": "did:elsi:issuer"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rotated, err := v.NewKeyForUser("did:elsi:issuer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys, err = v.PrivateKeysForUser("did:elsi:issuer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 || keys[0].GetKid() != rotated.ID || keys[1].GetKid() != old.GetKid() {
+		t.Errorf("PrivateKeysForUser() after rotation = %v, want the new key first", keys)
+	}
+
+	if _, err := v.CredentialFromJWT(signed); err != nil {
+		t.Errorf("the signature of the previous key does not verify: %v", err)
+	}
+
+	if _, err := v.PrivateKeysForUser("nobody"); err == nil {
+		t.Error("PrivateKeysForUser() of an unknown user should fail")
+	}
+}
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/hesusruiz/vcbackend/internal/problem"
+	"github.com/hesusruiz/vcbackend/internal/rp"
+	"github.com/hesusruiz/vcbackend/internal/vc"
+	"github.com/hesusruiz/vcbackend/vault"
+)
+
+// ##########################################
+// ##########################################
+// Relying-party clients of the verifier
+
+// defaultSIOPScope is the scope of the SIOP requests when the client does not set one
+const defaultSIOPScope = "dsba.credentials.presentation.PacketDeliveryService"
+
+func (s *Server) addClientRoutes(verifierRoutes fiber.Router) {
+
+	// Only the administrator can manage the clients
+	auth := s.basicAuth(s.verifierVault, s.adminAuthConfig("Clients"))
+
+	verifierRoutes.Post("/clients", auth, s.VerifierAPICreateClient)
+	verifierRoutes.Get("/clients", auth, s.VerifierAPIListClients)
+	verifierRoutes.Get("/clients/:id", auth, s.VerifierAPIGetClient)
+	verifierRoutes.Put("/clients/:id", auth, s.VerifierAPIUpdateClient)
+	verifierRoutes.Delete("/clients/:id", auth, s.VerifierAPIDeleteClient)
+
+}
+
+// clientError converts the errors of the relying parties to HTTP errors
+func clientError(err error) error {
+	switch {
+	case errors.Is(err, rp.ErrNotFound):
+		return problem.New(fiber.StatusNotFound, problem.ClientNotFound, err.Error())
+	case errors.Is(err, vault.ErrRelyingPartyExists):
+		return problem.New(fiber.StatusConflict, problem.ClientExists, err.Error())
+	case errors.Is(err, rp.ErrInvalidClient):
+		return problem.New(fiber.StatusBadRequest, problem.ClientInvalid, err.Error())
+	case errors.Is(err, rp.ErrInvalidRedirect):
+		return problem.New(fiber.StatusBadRequest, problem.RedirectURIInvalid, err.Error())
+	case errors.Is(err, rp.ErrCredentialType):
+		return problem.New(fiber.StatusForbidden, problem.CredentialTypeRefused, err.Error())
+	default:
+		return err
+	}
+}
+
+// clientBody parses the relying party in the body of the request
+func clientBody(c *fiber.Ctx) (*rp.Client, error) {
+	client := &rp.Client{}
+	if err := c.BodyParser(client); err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	return client, nil
+}
+
+// VerifierAPICreateClient registers a relying party
+func (s *Server) VerifierAPICreateClient(c *fiber.Ctx) error {
+
+	client, err := clientBody(c)
+	if err != nil {
+		return err
+	}
+
+	if err := s.verifierVault.CreateRelyingParty(client); err != nil {
+		return clientError(err)
+	}
+
+	c.Location(s.baseURL(c) + strings.TrimSuffix(c.Path(), "/") + "/" + url.PathEscape(client.ID))
+	return c.SendStatus(fiber.StatusCreated)
+}
+
+// VerifierAPIGetClient returns the registration of a relying party
+func (s *Server) VerifierAPIGetClient(c *fiber.Ctx) error {
+
+	client, err := s.verifierVault.RelyingParty(c.Params("id"))
+	if err != nil {
+		return clientError(err)
+	}
+
+	return c.JSON(client)
+}
+
+// VerifierAPIUpdateClient replaces the registration of a relying party
+func (s *Server) VerifierAPIUpdateClient(c *fiber.Ctx) error {
+
+	client, err := clientBody(c)
+	if err != nil {
+		return err
+	}
+
+	id := c.Params("id")
+	if len(client.ID) == 0 {
+		client.ID = id
+	}
+	if client.ID != id {
+		return fiber.NewError(fiber.StatusBadRequest, "the client id in the body does not match the path")
+	}
+
+	if err := s.verifierVault.UpdateRelyingParty(client); err != nil {
+		return clientError(err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// VerifierAPIDeleteClient removes the registration of a relying party
+func (s *Server) VerifierAPIDeleteClient(c *fiber.Ctx) error {
+
+	if err := s.verifierVault.DeleteRelyingParty(c.Params("id")); err != nil {
+		return clientError(err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// VerifierAPIListClients returns a page of the ids of the relying parties. The next page starts
+// after the last id of the previous one, in the pageAfter parameter.
+func (s *Server) VerifierAPIListClients(c *fiber.Ctx) error {
+
+	pageSize := vault.DefaultPageSize
+	if value := c.Query("pageSize"); len(value) > 0 {
+		n, err := strconv.Atoi(value)
+		if err != nil || n <= 0 {
+			return fiber.NewError(fiber.StatusBadRequest, "pageSize must be a positive number")
+		}
+		pageSize = n
+	}
+	if pageSize > vault.MaxPageSize {
+		pageSize = vault.MaxPageSize
+	}
+
+	ids, total, err := s.verifierVault.ListRelyingParties(c.Query("pageAfter"), pageSize)
+	if err != nil {
+		return err
+	}
+
+	base := s.baseURL(c) + strings.TrimSuffix(c.Path(), "/")
+	items := make([]fiber.Map, 0, len(ids))
+	for _, id := range ids {
+		items = append(items, fiber.Map{
+			"clientId": id,
+			"href":     base + "/" + url.PathEscape(id),
+		})
+	}
+
+	links := fiber.Map{
+		"first": base + "?pageSize=" + strconv.Itoa(pageSize),
+	}
+	if len(ids) == pageSize {
+		links["next"] = base + "?" + url.Values{
+			"pageSize":  {strconv.Itoa(pageSize)},
+			"pageAfter": {ids[len(ids)-1]},
+		}.Encode()
+	}
+
+	return c.JSON(fiber.Map{
+		"self":     s.baseURL(c) + c.OriginalURL(),
+		"items":    items,
+		"total":    total,
+		"pageSize": pageSize,
+		"links":    links,
+	})
+}
+
+// startClientLogin associates the login with the state to the relying party in the client_id parameter
+// of the request, if any, and remembers its redirect URI to return to it after the login
+func (s *Server) startClientLogin(c *fiber.Ctx, state string) (*rp.Client, error) {
+
+	clientID := c.Query("client_id")
+	if len(clientID) == 0 {
+		return nil, nil
+	}
+
+	client, err := s.verifierVault.RelyingParty(clientID)
+	if err != nil {
+		return nil, clientError(err)
+	}
+	redirectURI, err := client.RedirectURI(c.Query("redirect_uri"))
+	if err != nil {
+		return nil, clientError(err)
+	}
+
+	if err := s.verifierVault.WithContext(c.UserContext()).SetSessionClient(state, client.ID, redirectURI); err != nil {
+		return nil, sessionError(err)
+	}
+	return client, nil
+}
+
+// clientForState returns the relying party of the login with the state, or nil
+func (s *Server) clientForState(ctx context.Context, state string) (*rp.Client, error) {
+
+	session, err := s.session(ctx, state)
+	if err != nil || session == nil {
+		return nil, err
+	}
+	return s.clientForSession(session)
+}
+
+// clientForSession returns the relying party of a login, or nil
+func (s *Server) clientForSession(session *vault.Session) (*rp.Client, error) {
+
+	if len(session.ClientID) == 0 {
+		return nil, nil
+	}
+
+	client, err := s.verifierVault.RelyingParty(session.ClientID)
+	if err != nil {
+		return nil, clientError(err)
+	}
+	return client, nil
+}
+
+// siopRequestParams returns the scope of the SIOP request for the relying party, and the
+// presentation definition of the relying party encoded for the request, if it has one
+func siopRequestParams(client *rp.Client) (scope string, presentationDefinition string) {
+
+	scope = defaultSIOPScope
+	if client == nil {
+		return scope, ""
+	}
+	if len(client.Scope) > 0 {
+		scope = client.Scope
+	}
+	if len(client.PresentationDefinition) > 0 {
+		if b, err := json.Marshal(client.PresentationDefinition); err == nil {
+			presentationDefinition = url.QueryEscape(string(b))
+		}
+	}
+	return scope, presentationDefinition
+}
+
+// checkClientCredential checks that the credential presented in the login with the state is of a type
+// required by its relying party
+func (s *Server) checkClientCredential(ctx context.Context, state string, raw []byte) error {
+
+	client, err := s.clientForState(ctx, state)
+	if err != nil || client == nil {
+		return err
+	}
+
+	cred, err := vc.Decode(raw)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	if err := client.Accepts(cred.Types()); err != nil {
+		return clientError(err)
+	}
+	return nil
+}
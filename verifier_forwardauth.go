@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/hesusruiz/vcbackend/internal/config"
+	"github.com/hesusruiz/vcbackend/internal/pep"
+	"github.com/hesusruiz/vcbackend/vault"
+)
+
+// ##########################################
+// ##########################################
+// Forward authentication for the services behind an external proxy (nginx auth_request, Traefik ForwardAuth)
+
+// Headers of the requests of the proxies with the original request and its requirements
+const (
+	headerOriginalURL    = "X-Original-URL"
+	headerOriginalURI    = "X-Original-URI"
+	headerOriginalMethod = "X-Original-Method"
+	headerForwardedURI   = "X-Forwarded-Uri"
+	headerForwardedMeth  = "X-Forwarded-Method"
+	headerRequiredRoles  = "X-Required-Roles"
+	headerRequiredTarget = "X-Required-Target"
+
+	// headerLoginURL is the login page in the 401 responses, for the proxies which do not follow redirections
+	headerLoginURL = "X-Login-URL"
+)
+
+// addForwardAuthRoutes adds the endpoint called by the proxies to authenticate each request, when enabled
+func (s *Server) addForwardAuthRoutes(router fiber.Router) {
+
+	if !s.conf.Verifier.ForwardAuth.Enabled {
+		return
+	}
+
+	var err error
+	s.forwardAuth, err = pep.NewRules(config.RouteList(s.conf.Verifier.ForwardAuth.Routes))
+	if err != nil {
+		panic(err)
+	}
+
+	// The proxies may use the method of the original request
+	router.All("/forwardauth", s.VerifierAPIForwardAuth)
+
+	s.logger.Infow("forward authentication enabled", "path", verifierPrefix+"/forwardauth")
+}
+
+// VerifierAPIForwardAuth validates the access token of the original request and checks the roles
+// required by its route and by the headers set by the proxy. It returns 200 with the identity of the
+// holder in the headers, 403 if the roles are not satisfied, and 401 or a redirection to the login
+// page when there is no valid access token.
+func (s *Server) VerifierAPIForwardAuth(c *fiber.Ctx) error {
+
+	method, host, path := s.originalRequest(c)
+
+	// The requirements of the configured route and the ones stated by the proxy must be satisfied
+	var requirements []*pep.Route
+	matched := s.forwardAuth.Match(method, host, path)
+	if matched != nil {
+		requirements = append(requirements, matched)
+	}
+	if route := pep.Requirements(c.Get(headerRequiredRoles), c.Get(headerRequiredTarget)); route != nil {
+		requirements = append(requirements, route)
+	}
+
+	token := bearerToken(c)
+	if len(token) == 0 {
+		return s.forwardAuthLogin(c, `Bearer realm="verifier"`, "access token required")
+	}
+	claims, err := s.verifierVault.VerifyAccessToken(token, s.conf.Verifier.ID, s.accessTokenAudience())
+	if err != nil {
+		if errors.Is(err, vault.ErrAccessTokenInvalid) || errors.Is(err, vault.ErrAccessTokenExpired) ||
+			errors.Is(err, vault.ErrAccessTokenAudience) {
+			return s.forwardAuthLogin(c, `Bearer realm="verifier", error="invalid_token"`, err.Error())
+		}
+		return err
+	}
+
+	target := ""
+	for _, route := range requirements {
+		if err := route.Authorize(claims); err != nil {
+			return fiber.NewError(fiber.StatusForbidden, err.Error())
+		}
+		if len(route.Target) > 0 {
+			target = route.Target
+		}
+	}
+
+	// The access policy of the service of the configured route, or of the protected resource
+	if err := s.authorizeRoute(matched, claims); err != nil {
+		return err
+	}
+
+	for h, v := range pep.Identity(claims, target) {
+		c.Set(h, v)
+	}
+	return c.SendStatus(fiber.StatusOK)
+}
+
+// originalRequest returns the method, host and path of the request authenticated by the proxy
+func (s *Server) originalRequest(c *fiber.Ctx) (method string, host string, path string) {
+
+	method = c.Get(headerForwardedMeth, c.Get(headerOriginalMethod, fiber.MethodGet))
+	host = c.Get(fiber.HeaderXForwardedHost)
+
+	uri := c.Get(headerForwardedURI, c.Get(headerOriginalURI))
+	if u, err := url.Parse(c.Get(headerOriginalURL)); err == nil && len(u.Host) > 0 {
+		host = u.Host
+		if len(uri) == 0 {
+			uri = u.RequestURI()
+		}
+	}
+	if u, err := url.ParseRequestURI(uri); err == nil {
+		path = u.Path
+	}
+	if len(path) == 0 {
+		path = "/"
+	}
+
+	// The port is not part of the host in the routes
+	if i := strings.LastIndex(host, ":"); i > 0 && !strings.HasSuffix(host, "]") {
+		host = host[:i]
+	}
+	return strings.ToUpper(method), host, path
+}
+
+// forwardAuthLogin answers a request without a valid access token. Browsers are redirected to the login
+// page when the redirection is enabled, and the other clients receive 401 with the login page in a header.
+func (s *Server) forwardAuthLogin(c *fiber.Ctx, challenge string, message string) error {
+
+	loginURL := s.conf.Verifier.ForwardAuth.LoginURL
+	if returnURL := s.returnURL(c); len(returnURL) > 0 {
+		loginURL += "?return=" + url.QueryEscape(returnURL)
+	}
+
+	redirect := s.conf.Verifier.ForwardAuth.Redirect
+	if r := c.Query("redirect"); len(r) > 0 {
+		redirect = r == "true"
+	}
+	if redirect && strings.Contains(c.Get(fiber.HeaderAccept), fiber.MIMETextHTML) {
+		return c.Redirect(loginURL, fiber.StatusFound)
+	}
+
+	c.Set(fiber.HeaderWWWAuthenticate, challenge)
+	c.Set(headerLoginURL, loginURL)
+	return fiber.NewError(fiber.StatusUnauthorized, message)
+}
+
+// returnURL returns the URL of the original request, to return to it after the login,
+// or an empty string when it is not known or its host is not allowed
+func (s *Server) returnURL(c *fiber.Ctx) string {
+
+	returnURL := c.Query("rd", c.Get(headerOriginalURL))
+	if len(returnURL) == 0 {
+		host := c.Get(fiber.HeaderXForwardedHost)
+		if len(host) == 0 {
+			return ""
+		}
+		returnURL = c.Get(fiber.HeaderXForwardedProto, "https") + "://" + host + c.Get(headerForwardedURI, "/")
+	}
+
+	if !s.allowedReturnURL(returnURL) {
+		return ""
+	}
+	return returnURL
+}
+
+// allowedReturnURL checks that the URL is absolute and that its host is one of the hosts
+// configured, so the login can not be used to redirect the users to any site
+func (s *Server) allowedReturnURL(returnURL string) bool {
+
+	u, err := url.Parse(returnURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+		return false
+	}
+	for _, host := range s.conf.Verifier.ForwardAuth.AllowedHosts {
+		if strings.EqualFold(host, u.Hostname()) {
+			return true
+		}
+	}
+	return false
+}
+
+// saveReturnURL remembers the URL to return to after the login with the state, if it is allowed
+func (s *Server) saveReturnURL(ctx context.Context, state string, returnURL string) error {
+	if len(returnURL) > 0 && s.allowedReturnURL(returnURL) {
+		return sessionError(s.verifierVault.WithContext(ctx).SetSessionReturnURL(state, returnURL))
+	}
+	return nil
+}
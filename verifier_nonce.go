@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/hesusruiz/vcbackend/internal/issuance"
+	"github.com/hesusruiz/vcbackend/internal/logging"
+	"github.com/hesusruiz/vcbackend/internal/problem"
+	"github.com/hesusruiz/vcbackend/vault"
+	"go.uber.org/zap"
+)
+
+// ##########################################
+// ##########################################
+// Nonces of the SIOP requests, for the protection against the replay of presentations
+
+// nonceLifetime is the time during which a presentation can be sent for a SIOP request.
+// The configuration is validated at startup.
+func (s *Server) nonceLifetime() time.Duration {
+	lifetime, _ := issuance.ParseDuration(s.conf.Verifier.Nonce.Lifetime)
+	return lifetime
+}
+
+// issueNonce returns the nonce of the SIOP request for the login with the state, bound to the
+// relying party of the login and with the verifier as audience
+func (s *Server) issueNonce(ctx context.Context, state string) (string, error) {
+
+	session, err := s.session(ctx, state)
+	if err != nil {
+		return "", err
+	}
+	if session == nil || session.Completed() {
+		return "", problem.New(fiber.StatusNotFound, problem.SessionNotFound, vault.ErrSessionNotFound.Error())
+	}
+
+	n, err := s.verifierVault.WithContext(ctx).IssueNonce(state, session.ClientID, s.verifierDID, s.nonceLifetime())
+	if err != nil {
+		return "", err
+	}
+	return n.Value, nil
+}
+
+// consumeNonce checks that the presentation for the login with the state carries the nonce of its
+// SIOP request and the verifier as audience, and marks the nonce as used so it can not be replayed
+func (s *Server) consumeNonce(ctx context.Context, state string, nonce string, audience string) error {
+
+	n, err := s.verifierVault.WithContext(ctx).ConsumeNonce(nonce, state, audience)
+	if err != nil {
+		logging.FromContext(ctx).Infow("presentation rejected", "state", state, zap.Error(err))
+		return nonceError(err)
+	}
+
+	// The relying party of the login can not change after the request
+	session, err := s.session(ctx, state)
+	if err != nil {
+		return err
+	}
+	if session == nil {
+		return problem.New(fiber.StatusNotFound, problem.SessionNotFound, vault.ErrSessionNotFound.Error())
+	}
+	if session.ClientID != n.ClientID {
+		logging.FromContext(ctx).Infow("presentation rejected", "state", state, "client", n.ClientID)
+		return fiber.NewError(fiber.StatusBadRequest, "the nonce of the presentation is for another client")
+	}
+	return nil
+}
+
+// nonceError converts the errors of the nonces to HTTP errors
+func nonceError(err error) error {
+	switch {
+	case errors.Is(err, vault.ErrNonceReplayed):
+		return problem.New(fiber.StatusConflict, problem.NonceReplayed, err.Error())
+	case errors.Is(err, vault.ErrNonceRequired):
+		return problem.New(fiber.StatusBadRequest, problem.NonceRequired, err.Error())
+	case errors.Is(err, vault.ErrNonceUnknown):
+		return problem.New(fiber.StatusBadRequest, problem.NonceUnknown, err.Error())
+	case errors.Is(err, vault.ErrNonceExpired):
+		return problem.New(fiber.StatusBadRequest, problem.NonceExpired, err.Error())
+	case errors.Is(err, vault.ErrNonceMismatch):
+		return problem.New(fiber.StatusBadRequest, problem.NonceMismatch, err.Error())
+	default:
+		return err
+	}
+}
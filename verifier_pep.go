@@ -0,0 +1,186 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/hesusruiz/vcbackend/internal/config"
+	"github.com/hesusruiz/vcbackend/internal/issuance"
+	"github.com/hesusruiz/vcbackend/internal/pep"
+	"github.com/hesusruiz/vcbackend/internal/tracing"
+	"github.com/hesusruiz/vcbackend/vault"
+	"go.uber.org/zap"
+)
+
+// ##########################################
+// ##########################################
+// Policy Enforcement Point: reverse proxy to the services protected by the verifier
+
+const accessTokenCookie = "dbsamvf"
+
+// hopByHopHeaders are not forwarded by the proxy
+var hopByHopHeaders = []string{"Connection", "Keep-Alive", "Proxy-Authenticate", "Proxy-Authorization",
+	"Te", "Trailer", "Transfer-Encoding", "Upgrade"}
+
+// accessTokenAudience is the audience of the access tokens created by the verifier
+func (s *Server) accessTokenAudience() string {
+	if audience := s.conf.Verifier.AccessToken.Audience; len(audience) > 0 {
+		return audience
+	}
+	return s.conf.Verifier.ID
+}
+
+// accessTokenLifetime is the time during which the access tokens created by the verifier are valid.
+// The configuration is validated at startup.
+func (s *Server) accessTokenLifetime() time.Duration {
+	lifetime, _ := issuance.ParseDuration(s.conf.Verifier.AccessToken.Lifetime)
+	return lifetime
+}
+
+// addPEPRoutes routes the requests under the prefix of the proxy to the upstream services, when enabled
+func (s *Server) addPEPRoutes() {
+
+	if !s.conf.Verifier.PEP.Enabled {
+		return
+	}
+
+	var err error
+	s.pep, err = pep.NewRouter(config.RouteList(s.conf.Verifier.PEP.Routes))
+	if err != nil {
+		panic(err)
+	}
+	// The timeout is for receiving the headers of the response, so the body can be streamed for any time
+	timeout, err := issuance.ParseDuration(s.conf.Verifier.PEP.Timeout)
+	if err != nil || timeout <= 0 {
+		panic("invalid timeout of the policy enforcement point")
+	}
+	s.pepClient = &http.Client{
+		Transport: &http.Transport{
+			Proxy:                 http.ProxyFromEnvironment,
+			ResponseHeaderTimeout: timeout,
+		},
+		// The redirects are returned to the client
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	prefix := "/" + strings.Trim(s.conf.Verifier.PEP.Prefix, "/")
+	s.All(prefix, s.PEPProxy(prefix))
+	s.All(prefix+"/*", s.PEPProxy(prefix))
+
+	s.logger.Infow("policy enforcement point enabled", "prefix", prefix)
+}
+
+// bearerToken returns the access token in the Authorization header or in the cookie set by the verifier
+func bearerToken(c *fiber.Ctx) string {
+	if auth := c.Get(fiber.HeaderAuthorization); strings.HasPrefix(auth, "Bearer ") {
+		return strings.TrimPrefix(auth, "Bearer ")
+	}
+	return c.Cookies(accessTokenCookie)
+}
+
+// forwardedCookies returns the cookies of the request for the upstream service, without the access token
+func forwardedCookies(c *fiber.Ctx) string {
+	var cookies []string
+	c.Request().Header.VisitAllCookie(func(key, value []byte) {
+		if string(key) != accessTokenCookie {
+			cookies = append(cookies, string(key)+"="+string(value))
+		}
+	})
+	return strings.Join(cookies, "; ")
+}
+
+// PEPProxy validates the access token of each request, checks the roles required by its route and
+// forwards it to the upstream service with the identity of the holder, streaming the response
+func (s *Server) PEPProxy(prefix string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+
+		path := strings.TrimPrefix(c.Path(), prefix)
+		if len(path) == 0 {
+			path = "/"
+		}
+
+		route := s.pep.Match(c.Method(), c.Hostname(), path)
+		if route == nil {
+			return fiber.NewError(fiber.StatusNotFound, "no protected service for the path")
+		}
+
+		// Validate the access token: signature, expiration and audience
+		token := bearerToken(c)
+		if len(token) == 0 {
+			c.Set(fiber.HeaderWWWAuthenticate, `Bearer realm="pep"`)
+			return fiber.NewError(fiber.StatusUnauthorized, "access token required")
+		}
+		claims, err := s.verifierVault.VerifyAccessToken(token, s.conf.Verifier.ID, s.accessTokenAudience())
+		if err != nil {
+			if errors.Is(err, vault.ErrAccessTokenInvalid) || errors.Is(err, vault.ErrAccessTokenExpired) ||
+				errors.Is(err, vault.ErrAccessTokenAudience) {
+				c.Set(fiber.HeaderWWWAuthenticate, `Bearer realm="pep", error="invalid_token"`)
+				return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+			}
+			return err
+		}
+
+		// Check the roles required by the route and the access policy of its service
+		if err := route.Authorize(claims); err != nil {
+			return fiber.NewError(fiber.StatusForbidden, err.Error())
+		}
+		if err := s.authorizeRoute(route, claims); err != nil {
+			return err
+		}
+
+		upstreamURL := route.UpstreamURL(path, string(c.Request().URI().QueryString()))
+		req, err := http.NewRequestWithContext(c.UserContext(), c.Method(), upstreamURL, bytes.NewReader(c.Body()))
+		if err != nil {
+			return err
+		}
+
+		// Forward the headers of the request, except the identity headers, which are set by the proxy,
+		// and the access token, which is not for the upstream service
+		c.Request().Header.VisitAll(func(key, value []byte) {
+			if k := string(key); k != fiber.HeaderHost && k != fiber.HeaderCookie {
+				req.Header.Add(k, string(value))
+			}
+		})
+		for _, h := range append(hopByHopHeaders, pep.IdentityHeaders...) {
+			req.Header.Del(h)
+		}
+		if strings.HasPrefix(req.Header.Get(fiber.HeaderAuthorization), "Bearer ") {
+			req.Header.Del(fiber.HeaderAuthorization)
+		}
+		if cookies := forwardedCookies(c); len(cookies) > 0 {
+			req.Header.Set(fiber.HeaderCookie, cookies)
+		}
+		for h, v := range pep.Identity(claims, route.Target) {
+			req.Header.Set(h, v)
+		}
+		req.Header.Set(fiber.HeaderXForwardedFor, c.IP())
+		req.Header.Set(fiber.HeaderXForwardedHost, c.Hostname())
+		req.Header.Set(fiber.HeaderXForwardedProto, c.Protocol())
+
+		resp, err := tracing.DoHTTP(c.UserContext(), "pep.upstream", s.pepClient, req)
+		if err != nil {
+			s.log(c).Errorw("error calling the protected service", "url", upstreamURL, zap.Error(err))
+			return fiber.NewError(fiber.StatusBadGateway, "the protected service is not available")
+		}
+
+		// Stream the response to the client. The body is closed when it has been sent.
+		c.Status(resp.StatusCode)
+		for k, values := range resp.Header {
+			for _, v := range values {
+				c.Response().Header.Add(k, v)
+			}
+		}
+		for _, h := range hopByHopHeaders {
+			c.Response().Header.Del(h)
+		}
+		c.Response().SetBodyStream(resp.Body, int(resp.ContentLength))
+
+		return nil
+	}
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/hesusruiz/vcbackend/internal/pep"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TestPEPProxyHeaders checks that the upstream service receives the trace context of the request and the
+// identity of the holder, but not the access token
+func TestPEPProxyHeaders(t *testing.T) {
+	s := newTestServer(t, t.TempDir())
+
+	var received http.Header
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+	}))
+	defer upstream.Close()
+
+	var err error
+	s.pep, err = pep.NewRouter([]any{map[string]any{"path": "/orders", "upstream": upstream.URL}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.pepClient = &http.Client{}
+
+	// The span of the request is in its context
+	traceID := trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36}
+	span := trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: trace.SpanID{1}, TraceFlags: trace.FlagsSampled})
+	defer otel.SetTextMapPropagator(otel.GetTextMapPropagator())
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	s.Use(func(c *fiber.Ctx) error {
+		c.SetUserContext(trace.ContextWithSpanContext(c.UserContext(), span))
+		return c.Next()
+	})
+	s.All("/pep/*", s.PEPProxy("/pep"))
+
+	if _, err := s.verifierVault.CreateUserWithKey(s.conf.Verifier.ID, "Verifier", "legalperson", "pass"); err != nil {
+		t.Fatal(err)
+	}
+	token, err := s.verifierVault.CreateAccessTokenWithAlg(policyCredential, s.conf.Verifier.ID, s.accessTokenAudience(), time.Hour, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, auth := range []string{"header", "cookie"} {
+		req := httptest.NewRequest("GET", "/pep/orders/1", nil)
+		req.Header.Set("Cookie", "session=abc; theme=dark")
+		if auth == "header" {
+			req.Header.Set("Authorization", "Bearer "+string(token))
+		} else {
+			req.AddCookie(&http.Cookie{Name: accessTokenCookie, Value: string(token)})
+		}
+
+		resp, err := s.App.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("%s: status = %d", auth, resp.StatusCode)
+		}
+
+		if v := received.Get("Authorization"); len(v) > 0 {
+			t.Errorf("%s: Authorization forwarded: %s", auth, v)
+		}
+		if cookie := received.Get("Cookie"); strings.Contains(cookie, accessTokenCookie) ||
+			!strings.Contains(cookie, "session=abc") || !strings.Contains(cookie, "theme=dark") {
+			t.Errorf("%s: Cookie forwarded = %s", auth, cookie)
+		}
+		if tp := received.Get("traceparent"); !strings.Contains(tp, traceID.String()) {
+			t.Errorf("%s: traceparent forwarded = %s", auth, tp)
+		}
+		if sub := received.Get(pep.HeaderSubject); sub != "did:key:holder" {
+			t.Errorf("%s: %s = %s", auth, pep.HeaderSubject, sub)
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/hesusruiz/vcbackend/internal/issuance"
+	"github.com/hesusruiz/vcbackend/internal/pep"
+	"github.com/hesusruiz/vcbackend/internal/policy"
+	"github.com/hesusruiz/vcbackend/internal/problem"
+	"github.com/hesusruiz/vcbackend/internal/rp"
+	"github.com/hesusruiz/vcbackend/internal/vc"
+	"go.uber.org/zap"
+)
+
+// ##########################################
+// ##########################################
+// Access policies of the services protected by the verifier
+
+// DryRunRequest is the body for testing a policy against a sample credential.
+// The policy is either the one of the service, or the one received in YAML or JSON format.
+type DryRunRequest struct {
+	Service    string          `json:"service"`
+	Policy     string          `json:"policy"`
+	Credential json.RawMessage `json:"credential"`
+	At         *time.Time      `json:"at"`
+}
+
+func (s *Server) addPolicyRoutes(verifierRoutes fiber.Router) {
+
+	// Only the administrator can manage the policies
+	auth := s.basicAuth(s.verifierVault, s.adminAuthConfig("Policies"))
+
+	verifierRoutes.Get("/policies", auth, s.VerifierAPIListPolicies)
+	verifierRoutes.Get("/policies/:service", auth, s.VerifierAPIGetPolicy)
+	verifierRoutes.Post("/policies/reload", auth, s.VerifierAPIReloadPolicies)
+	verifierRoutes.Post("/policies/dryrun", auth, s.VerifierAPIDryRunPolicy)
+
+}
+
+// loadPolicies reads the access policies, if configured, and reloads them periodically when their files change,
+// until the context is done
+func (s *Server) loadPolicies(ctx context.Context) {
+
+	dir := s.conf.Verifier.Policies.Dir
+	if len(dir) == 0 {
+		s.logger.Warnw("no access policies configured, the verifier grants access to any credential accepted")
+		return
+	}
+
+	var err error
+	s.policies, err = policy.LoadDir(dir)
+	if err != nil {
+		panic(err)
+	}
+	s.logger.Infow("access policies loaded", "dir", dir, "policies", len(s.policies.All()))
+
+	interval, err := issuance.ParseDuration(s.conf.Verifier.Policies.ReloadInterval)
+	if err != nil || interval <= 0 {
+		s.logger.Errorw("invalid policy reload interval, hot reload disabled", zap.Error(err))
+		return
+	}
+
+	s.background.Add(1)
+	go func() {
+		defer s.background.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.reloadPolicies()
+			}
+		}
+	}()
+}
+
+// reloadPolicies reads again the policies if they changed. Invalid policies are logged and the previous ones are kept.
+func (s *Server) reloadPolicies() error {
+	reloaded, err := s.policies.Reload()
+	if err != nil {
+		s.logger.Errorw("invalid access policies, keeping the previous ones", zap.Error(err))
+		return err
+	}
+	if reloaded {
+		s.logger.Infow("access policies reloaded", "policies", len(s.policies.All()))
+	}
+	return nil
+}
+
+// protectedService is the service of the protected resource, whose policy is evaluated for the logins
+// without a relying party and for the routes which do not set a service
+func (s *Server) protectedService() string {
+	return s.conf.Verifier.ProtectedResource.Service
+}
+
+// clientService is the service whose policy is evaluated in a login, for its relying party if there is one
+func (s *Server) clientService(client *rp.Client) string {
+	if client != nil {
+		return client.PolicyService()
+	}
+	return s.protectedService()
+}
+
+// routeService is the service whose policy is evaluated for the requests of a route
+func (s *Server) routeService(route *pep.Route) string {
+	if route != nil && len(route.Service) > 0 {
+		return route.Service
+	}
+	return s.protectedService()
+}
+
+// authorize evaluates the policy of a protected service for a credential received by the verifier.
+// Access is denied when the service has no policy, and allowed to any credential when no policies are configured.
+func (s *Server) authorize(service string, raw []byte) (*policy.Decision, error) {
+
+	if s.policies == nil {
+		return &policy.Decision{Service: service, Allow: true, Explanations: []string{"no policies configured"}}, nil
+	}
+
+	cred, err := vc.Decode(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	p, ok := s.policies.Get(service)
+	if !ok {
+		s.logger.Infow("access denied to a service without policy", "service", service)
+		return &policy.Decision{Service: service, Explanations: []string{"no policy for the service " + service}}, nil
+	}
+
+	d := p.Evaluate(cred, time.Now())
+	s.logger.Infow("access policy evaluated", "service", service, "allow", d.Allow, "rule", d.Rule, "explanations", d.Explanations)
+	return d, nil
+}
+
+// authorizeRoute evaluates the policy of the service of a route for the credential in the claims of an access token.
+// It returns an error when access is denied.
+func (s *Server) authorizeRoute(route *pep.Route, claims map[string]any) error {
+
+	if s.policies == nil {
+		return nil
+	}
+
+	raw, err := pep.Credential(claims)
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+	decision, err := s.authorize(s.routeService(route), raw)
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
+	if !decision.Allow {
+		return problem.New(fiber.StatusForbidden, problem.AccessPolicyDenied,
+			"access denied to "+decision.Service+": "+strings.Join(decision.Explanations, "; "))
+	}
+	return nil
+}
+
+// VerifierAPIListPolicies returns all the policies
+func (s *Server) VerifierAPIListPolicies(c *fiber.Ctx) error {
+	if s.policies == nil {
+		return c.JSON([]*policy.Policy{})
+	}
+	return c.JSON(s.policies.All())
+}
+
+// VerifierAPIGetPolicy returns the policy of a service
+func (s *Server) VerifierAPIGetPolicy(c *fiber.Ctx) error {
+	if s.policies != nil {
+		if p, ok := s.policies.Get(c.Params("service")); ok {
+			return c.JSON(p)
+		}
+	}
+	return fiber.NewError(fiber.StatusNotFound, "no policy for the service")
+}
+
+// VerifierAPIReloadPolicies reads again the policies, without waiting for the periodic reload
+func (s *Server) VerifierAPIReloadPolicies(c *fiber.Ctx) error {
+	if s.policies == nil {
+		return fiber.NewError(fiber.StatusConflict, "no policies configured")
+	}
+	if err := s.reloadPolicies(); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity, err.Error())
+	}
+	return c.JSON(s.policies.All())
+}
+
+// VerifierAPIDryRunPolicy evaluates a policy against a sample credential, without granting access
+func (s *Server) VerifierAPIDryRunPolicy(c *fiber.Ctx) error {
+
+	req := &DryRunRequest{}
+	if err := c.BodyParser(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	if len(req.Credential) == 0 {
+		return fiber.NewError(fiber.StatusBadRequest, "credential is required")
+	}
+
+	var p *policy.Policy
+	var err error
+	switch {
+	case len(req.Policy) > 0:
+		if p, err = policy.Parse([]byte(req.Policy)); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	case s.policies != nil:
+		service := req.Service
+		if len(service) == 0 {
+			service = s.protectedService()
+		}
+		var ok bool
+		if p, ok = s.policies.Get(service); !ok {
+			return fiber.NewError(fiber.StatusNotFound, "no policy for the service "+service)
+		}
+	default:
+		return fiber.NewError(fiber.StatusBadRequest, "policy is required")
+	}
+
+	// The credential is either a JSON object or a string with a JWT or a serialized JSON credential
+	raw := []byte(req.Credential)
+	var serialized string
+	if json.Unmarshal(req.Credential, &serialized) == nil {
+		raw = []byte(serialized)
+	}
+	cred, err := vc.Decode(raw)
+	if errors.Is(err, vc.ErrInvalidCredential) {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	if err != nil {
+		return err
+	}
+
+	at := time.Now()
+	if req.At != nil {
+		at = *req.At
+	}
+
+	return c.JSON(p.Evaluate(cred, at))
+}
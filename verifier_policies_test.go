@@ -0,0 +1,61 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/hesusruiz/vcbackend/internal/pep"
+	"github.com/hesusruiz/vcbackend/internal/policy"
+	"github.com/hesusruiz/vcbackend/internal/problem"
+	"github.com/hesusruiz/vcbackend/internal/rp"
+)
+
+const policyCredential = `{
+	"type": ["VerifiableCredential", "PacketDeliveryService"],
+	"credentialSubject": {"id": "did:key:holder", "roles": [{"names": ["P.Info"]}]}
+}`
+
+// TestAuthorizeService checks that the policy evaluated is the one of the relying party or of the route,
+// and that access is denied to the services without policy
+func TestAuthorizeService(t *testing.T) {
+	s := newTestServer(t, t.TempDir())
+
+	var err error
+	if s.policies, err = policy.LoadDir("configs/policies"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		service string
+		allow   bool
+	}{
+		{"without relying party", s.clientService(nil), true},
+		{"relying party with service", s.clientService(&rp.Client{ID: "orders", Service: "packetdelivery"}), true},
+		{"relying party without policy", s.clientService(&rp.Client{ID: "orders"}), false},
+		{"route without service", s.routeService(&pep.Route{}), true},
+		{"route without policy", s.routeService(&pep.Route{Service: "orders"}), false},
+	}
+	for _, tt := range tests {
+		d, err := s.authorize(tt.service, []byte(policyCredential))
+		if err != nil {
+			t.Fatalf("%s: authorize() error = %v", tt.name, err)
+		}
+		if d.Allow != tt.allow {
+			t.Errorf("%s: authorize(%s) = %v, want %v", tt.name, tt.service, d.Allow, tt.allow)
+		}
+	}
+
+	var cred map[string]any
+	json.Unmarshal([]byte(policyCredential), &cred)
+	claims := map[string]any{"verifiableCredential": cred}
+
+	if err := s.authorizeRoute(&pep.Route{Service: "packetdelivery"}, claims); err != nil {
+		t.Errorf("authorizeRoute() error = %v", err)
+	}
+	var pe *problem.Error
+	if err := s.authorizeRoute(&pep.Route{Service: "orders"}, claims); !errors.As(err, &pe) || pe.Code != problem.AccessPolicyDenied {
+		t.Errorf("authorizeRoute() of a service without policy error = %v", err)
+	}
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/hesusruiz/vcbackend/internal/problem"
+	"github.com/hesusruiz/vcbackend/vault"
+)
+
+// ##########################################
+// ##########################################
+// Sessions of the SIOP logins, stored in the verifier vault so any instance can continue a login
+
+// completedSessionLifetime is the time during which the credential presented in a login is kept,
+// for the page of the login to create the access token with it
+const completedSessionLifetime = 10 * time.Second
+
+// startSession creates the session of a new login with the state
+func (s *Server) startSession(ctx context.Context, state string, lifetime time.Duration) error {
+	return s.verifierVault.WithContext(ctx).StartSession(state, lifetime)
+}
+
+// session returns the login with the state, or nil if it does not exist or has expired
+func (s *Server) session(ctx context.Context, state string) (*vault.Session, error) {
+	session, err := s.verifierVault.WithContext(ctx).Session(state)
+	if errors.Is(err, vault.ErrSessionNotFound) {
+		return nil, nil
+	}
+	return session, err
+}
+
+// sessionError converts the errors of the sessions to HTTP errors
+func sessionError(err error) error {
+	if errors.Is(err, vault.ErrSessionNotFound) {
+		return problem.New(fiber.StatusNotFound, problem.SessionNotFound, err.Error())
+	}
+	return err
+}
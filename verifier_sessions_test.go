@@ -0,0 +1,222 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hesusruiz/vcbackend/internal/problem"
+	"github.com/hesusruiz/vcbackend/vault"
+)
+
+// TestLoginInstances checks that a login started by an instance of the verifier can be completed by
+// another one sharing the vault, only with a presentation signed by the holder of the credential
+func TestLoginInstances(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	a := newTestServer(t, dir)
+	b := newTestServer(t, dir)
+	for _, s := range []*Server{a, b} {
+		s.verifierDID = "did:key:verifier"
+	}
+	b.trustedIssuers = b.registryVault
+	b.trustLocalIssuer()
+	b.Post("/authenticationresponse", b.VerifierAPIAuthenticationResponse)
+
+	if _, err := a.verifierVault.CreateUserWithKey(a.conf.Verifier.ID, "Verifier", "legalperson", "pass"); err != nil {
+		t.Fatal(err)
+	}
+
+	// The credential is issued to the DID of the key of the holder in the wallet
+	if _, err := a.walletvault.CreateUserWithKey("holder", "Holder", "naturalperson", "pass"); err != nil {
+		t.Fatal(err)
+	}
+	keys, err := a.walletvault.PrivateKeysForUser("holder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	holderDID, err := vault.KeyDID(keys[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := a.walletvault.SetDIDForUser("holder", holderDID); err != nil {
+		t.Fatal(err)
+	}
+	_, raw, err := a.issuerVault.CreateCredentialJWTFromMap(map[string]any{
+		"credName":   "PacketDeliveryCredential",
+		"issuerDID":  a.conf.Issuer.ID,
+		"subjectDID": holderDID,
+		"claims":     map[string]any{"given_name": "John", "family_name": "Doe", "email": "john@example.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The login is started in the first instance
+	state := "login-state"
+	if err := a.startSession(ctx, state, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.verifierVault.SetSessionReturnURL(state, "https://app.example.com/orders"); err != nil {
+		t.Fatal(err)
+	}
+	nonce, err := a.issueNonce(ctx, state)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	post := func(vpToken string) int {
+		body, _ := json.Marshal(map[string]string{"vp_token": vpToken})
+		req := httptest.NewRequest("POST", "/authenticationresponse?state="+state, strings.NewReader(string(body)))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := b.App.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.StatusCode
+	}
+
+	// A presentation signed by another key is rejected without using the nonce
+	if _, err := a.walletvault.CreateUserWithKey("thief", "Thief", "naturalperson", "pass"); err != nil {
+		t.Fatal(err)
+	}
+	otherKeys, err := a.walletvault.PrivateKeysForUser("thief")
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged, err := a.walletvault.SignWithJWK(otherKeys[0], map[string]any{
+		"iss":   holderDID,
+		"aud":   a.verifierDID,
+		"nonce": nonce,
+		"iat":   time.Now().Unix(),
+		"vp":    map[string]any{"verifiableCredential": []string{string(raw)}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status := post(forged); status != http.StatusBadRequest {
+		t.Errorf("presentation of another key: status = %d, want %d", status, http.StatusBadRequest)
+	}
+	if status := post(""); status != http.StatusBadRequest {
+		t.Errorf("presentation without signature: status = %d, want %d", status, http.StatusBadRequest)
+	}
+
+	// The presentation of the holder is accepted by the second instance, only once
+	vpToken, err := a.signPresentation("holder", raw, nonce, a.verifierDID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status := post(vpToken); status != http.StatusOK {
+		t.Fatalf("presentation of the holder: status = %d, want %d", status, http.StatusOK)
+	}
+	if status := post(vpToken); status != http.StatusConflict {
+		t.Errorf("replayed presentation: status = %d, want %d", status, http.StatusConflict)
+	}
+
+	// The first instance sees the login completed
+	session, err := a.session(ctx, state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session == nil || !session.Completed() {
+		t.Fatalf("session = %+v, want completed", session)
+	}
+	if session.Credential != string(raw) {
+		t.Errorf("session credential = %s, want %s", session.Credential, raw)
+	}
+	if session.ReturnURL != "https://app.example.com/orders" {
+		t.Errorf("session return URL = %s", session.ReturnURL)
+	}
+
+	// The login page of the first instance creates the access token with the credential
+	a.Get("/receivecredential/:state", a.VerifierPageReceiveCredential)
+	resp, err := a.App.Test(httptest.NewRequest("GET", "/receivecredential/"+state, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusFound || resp.Header.Get("Location") != "https://app.example.com/orders" {
+		t.Fatalf("login page: status = %d, location = %s", resp.StatusCode, resp.Header.Get("Location"))
+	}
+	token := strings.TrimPrefix(resp.Header.Get("Authorization"), "Bearer ")
+	claims, err := a.verifierVault.VerifyAccessToken(token, a.conf.Verifier.ID, a.accessTokenAudience())
+	if err != nil {
+		t.Fatalf("access token of the login: %v", err)
+	}
+	if claims["sub"] != holderDID || claims["verifiableCredential"] == nil {
+		t.Errorf("access token claims = %v", claims)
+	}
+}
+
+// TestPresentationRevoked checks that a credential revoked by the issuer is rejected when it is presented
+func TestPresentationRevoked(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t, t.TempDir())
+	s.verifierDID = "did:key:verifier"
+	s.Post("/authenticationresponse", s.VerifierAPIAuthenticationResponse)
+
+	if _, err := s.walletvault.CreateUserWithKey("holder", "Holder", "naturalperson", "pass"); err != nil {
+		t.Fatal(err)
+	}
+	keys, err := s.walletvault.PrivateKeysForUser("holder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	holderDID, err := vault.KeyDID(keys[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.walletvault.SetDIDForUser("holder", holderDID); err != nil {
+		t.Fatal(err)
+	}
+	id, raw, err := s.issuerVault.CreateCredentialJWTFromMap(map[string]any{
+		"credName":   "PacketDeliveryCredential",
+		"issuerDID":  s.conf.Issuer.ID,
+		"subjectDID": holderDID,
+		"claims":     map[string]any{"given_name": "John", "family_name": "Doe", "email": "john@example.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	present := func(state string) (int, string) {
+		if err := s.startSession(ctx, state, time.Minute); err != nil {
+			t.Fatal(err)
+		}
+		nonce, err := s.issueNonce(ctx, state)
+		if err != nil {
+			t.Fatal(err)
+		}
+		vpToken, err := s.signPresentation("holder", raw, nonce, s.verifierDID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := json.Marshal(map[string]string{"vp_token": vpToken})
+		req := httptest.NewRequest("POST", "/authenticationresponse?state="+state, strings.NewReader(string(body)))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := s.App.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p := &problem.Problem{}
+		json.NewDecoder(resp.Body).Decode(p)
+		return resp.StatusCode, p.Code
+	}
+
+	if status, _ := present("before-revocation"); status != http.StatusOK {
+		t.Fatalf("presentation of an active credential: status = %d, want %d", status, http.StatusOK)
+	}
+
+	if _, err := s.issuerVault.RevokeCredential(id); err != nil {
+		t.Fatal(err)
+	}
+	if status, code := present("after-revocation"); status != http.StatusBadRequest || code != problem.CredentialRevoked {
+		t.Errorf("presentation of a revoked credential: status = %d, code = %s", status, code)
+	}
+	if session, _ := s.session(ctx, "after-revocation"); session == nil || session.Completed() {
+		t.Errorf("session of a revoked credential completed")
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/basicauth"
+	"github.com/hesusruiz/vcbackend/internal/problem"
+	"github.com/hesusruiz/vcbackend/internal/rp"
+)
+
+// ##########################################
+// ##########################################
+// Presentations of the credentials of the wallet to the verifiers
+
+// walletAuthConfig authenticates the holders with their passwords in the vault of the wallet
+func (s *Server) walletAuthConfig() basicauth.Config {
+	return basicauth.Config{
+		Realm: "Wallet",
+		Authorizer: func(user string, pass string) bool {
+			_, err := s.walletvault.CheckPassword(user, pass)
+			return err == nil
+		},
+	}
+}
+
+// authenticationResponseURL is the redirect_uri of the SIOP requests of the verifier
+func (s *Server) authenticationResponseURL(c *fiber.Ctx) string {
+	return s.baseURL(c) + verifierPrefix + "/authenticationresponse"
+}
+
+// checkPresentationRedirect checks that the wallet can send a presentation to the redirect URI of an
+// authentication request: the one of this verifier for its DID, or one registered for the relying party
+// of the client_id of the request. The presentations are not sent to other URLs.
+func (s *Server) checkPresentationRedirect(c *fiber.Ctx, clientID string, redirectURI string) error {
+
+	if len(redirectURI) == 0 {
+		return problem.New(fiber.StatusBadRequest, problem.RedirectURIInvalid, "the redirect URI is required")
+	}
+	if clientID == s.verifierDID && redirectURI == s.authenticationResponseURL(c) {
+		return nil
+	}
+
+	client, err := s.verifierVault.WithContext(c.UserContext()).RelyingParty(clientID)
+	if errors.Is(err, rp.ErrNotFound) {
+		return problem.New(fiber.StatusBadRequest, problem.RedirectURIInvalid, rp.ErrInvalidRedirect.Error()+": "+redirectURI)
+	}
+	if err != nil {
+		return err
+	}
+	if _, err := client.RedirectURI(redirectURI); err != nil {
+		return clientError(err)
+	}
+	return nil
+}
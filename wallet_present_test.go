@@ -0,0 +1,84 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hesusruiz/vcbackend/internal/problem"
+	"github.com/hesusruiz/vcbackend/internal/rp"
+)
+
+// TestWalletPresentations checks that the wallet presents only the credentials of the holder
+// authenticated, and only to the verifier or to the registered relying parties
+func TestWalletPresentations(t *testing.T) {
+	s := newTestServer(t, t.TempDir())
+	s.verifierDID = "did:key:verifier"
+	s.addAPIRoutes()
+
+	if _, err := s.walletvault.CreateUserWithKey("holder", "Holder", "naturalperson", "secret"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.verifierVault.CreateRelyingParty(&rp.Client{ID: "orders", RedirectURIs: []string{"https://orders.example.com/callback"}}); err != nil {
+		t.Fatal(err)
+	}
+	credID, _, err := s.issuerVault.CreateCredentialJWTFromMap(map[string]any{
+		"credName":   "PacketDeliveryCredential",
+		"issuerDID":  s.conf.Issuer.ID,
+		"subjectDID": "did:key:other",
+		"claims":     map[string]any{"given_name": "John", "family_name": "Doe", "email": "john@example.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	send := func(method string, path string, body any, auth bool) (int, string) {
+		raw, _ := json.Marshal(body)
+		req := httptest.NewRequest(method, apiPrefix+path, strings.NewReader(string(raw)))
+		req.Header.Set("Content-Type", "application/json")
+		if auth {
+			req.Header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("holder:secret")))
+		}
+		resp, err := s.App.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p := &problem.Problem{}
+		json.NewDecoder(resp.Body).Decode(p)
+		return resp.StatusCode, p.Code
+	}
+
+	if status, _ := send("GET", "/wallet/credentials", nil, false); status != http.StatusUnauthorized {
+		t.Errorf("credentials without authentication: status = %d, want %d", status, http.StatusUnauthorized)
+	}
+
+	tests := []struct {
+		name        string
+		auth        bool
+		clientID    string
+		redirectURI string
+		status      int
+		code        string
+	}{
+		{"without authentication", false, s.verifierDID, "http://example.com" + verifierPrefix + "/authenticationresponse", http.StatusUnauthorized, ""},
+		{"unknown URL", true, s.verifierDID, "https://attacker.example.com/collect", http.StatusBadRequest, problem.RedirectURIInvalid},
+		{"unknown client", true, "attacker", "https://attacker.example.com/collect", http.StatusBadRequest, problem.RedirectURIInvalid},
+		{"URL of another client", true, "orders", "https://attacker.example.com/collect", http.StatusBadRequest, problem.RedirectURIInvalid},
+		{"credential of another holder", true, s.verifierDID, "http://example.com" + verifierPrefix + "/authenticationresponse", http.StatusForbidden, ""},
+	}
+	for _, tt := range tests {
+		status, code := send("POST", "/wallet/presentations", map[string]string{
+			"credentialId": credID,
+			"redirectUri":  tt.redirectURI,
+			"state":        "state",
+			"nonce":        "nonce",
+			"clientId":     tt.clientID,
+		}, tt.auth)
+		if status != tt.status || (len(tt.code) > 0 && code != tt.code) {
+			t.Errorf("%s: status = %d %s, want %d %s", tt.name, status, code, tt.status, tt.code)
+		}
+	}
+}